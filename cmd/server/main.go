@@ -2,16 +2,24 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"github.com/gorilla/mux"
 	"github.com/improbable-eng/grpc-web/go/grpcweb"
 	"github.com/moos3/sparta/internal/auth"
+	"github.com/moos3/sparta/internal/breaker"
 	"github.com/moos3/sparta/internal/config"
 	"github.com/moos3/sparta/internal/db"
 	"github.com/moos3/sparta/internal/email"
-	"github.com/moos3/sparta/internal/interfaces"
+	"github.com/moos3/sparta/internal/httpclient"
+	"github.com/moos3/sparta/internal/jobs"
+	"github.com/moos3/sparta/internal/orchestrator"
+	"github.com/moos3/sparta/internal/pluginhost"
+	"github.com/moos3/sparta/internal/profiles"
+	"github.com/moos3/sparta/internal/registry"
+	"github.com/moos3/sparta/internal/schedules"
 	"github.com/moos3/sparta/internal/server"
-	"github.com/moos3/sparta/plugins"
+	_ "github.com/moos3/sparta/plugins" // registers the scan plugins
 	pb "github.com/moos3/sparta/proto"
 	"google.golang.org/grpc"
 	"log"
@@ -42,10 +50,11 @@ func corsMiddleware(h http.Handler) http.Handler {
 }
 
 func main() {
-	cfg, err := config.Load("config.yaml")
+	configs, err := config.Watch("config.yaml")
 	if err != nil {
 		log.Fatalf("Failed to load config: %v", err)
 	}
+	cfg := configs.Config()
 
 	db, err := db.New(cfg)
 	if err != nil {
@@ -59,85 +68,26 @@ func main() {
 		log.Fatalf("Failed to initialize auth service: %v", err)
 	}
 
-	// Instantiate plugins directly
-	dnsSp := &plugins.ScanDNSPlugin{}
-	dnsSp.SetDatabase(db)
-	if err := dnsSp.Initialize(); err != nil {
-		log.Fatalf("Failed to initialize DNS scan plugin: %v", err)
-	}
-
-	tlsSp := &plugins.ScanTLSPlugin{}
-	tlsSp.SetDatabase(db)
-	if err := tlsSp.Initialize(); err != nil {
-		log.Fatalf("Failed to initialize TLS scan plugin: %v", err)
-	}
-
-	crtSp := &plugins.ScanCrtShPlugin{}
-	crtSp.SetDatabase(db)
-	if err := crtSp.Initialize(); err != nil {
-		log.Fatalf("Failed to initialize CrtSh scan plugin: %v", err)
-	}
-
-	chaosSp := &plugins.ScanChaosPlugin{}
-	chaosSp.SetDatabase(db)
-	chaosSp.SetConfig(cfg)
-	if err := chaosSp.Initialize(); err != nil {
-		log.Fatalf("Failed to initialize Chaos scan plugin: %v", err)
-	}
-
-	shodanSp := &plugins.ScanShodanPlugin{}
-	shodanSp.SetDatabase(db)
-	shodanSp.SetConfig(cfg)
-	if err := shodanSp.Initialize(); err != nil {
-		log.Fatalf("Failed to initialize Shodan scan plugin: %v", err)
-	}
-
-	otxSp := &plugins.ScanOTXPlugin{}
-	otxSp.SetDatabase(db)
-	otxSp.SetConfig(cfg)
-	if err := otxSp.Initialize(); err != nil {
-		log.Fatalf("Failed to initialize OTX scan plugin: %v", err)
-	}
+	// Start external plugin executables and register them alongside the
+	// compiled-in plugins
+	pluginHost := pluginhost.New()
+	pluginHost.Register(cfg)
+	defer pluginHost.Close()
 
-	whoisSp := &plugins.ScanWhoisPlugin{}
-	whoisSp.SetDatabase(db)
-	if err := whoisSp.Initialize(); err != nil {
-		log.Fatalf("Failed to initialize Whois scan plugin: %v", err)
-	}
-
-	abuseChSp := &plugins.ScanAbuseChPlugin{}
-	abuseChSp.SetDatabase(db)
-	abuseChSp.SetConfig(cfg)
-	if err := abuseChSp.Initialize(); err != nil {
-		log.Fatalf("Failed to initialize AbuseCh scan plugin: %v", err)
-	}
-
-	iscSp := &plugins.ScanISCPlugin{}
-	iscSp.SetDatabase(db)
-	iscSp.SetConfig(cfg) // Pass config for API key
-	if err := iscSp.Initialize(); err != nil {
-		log.Fatalf("Failed to initialize ISC scan plugin: %v", err)
-	}
-
-	// Create plugins map
-	pluginMap := map[string]interfaces.GenericPlugin{
-		"ScanDNS":     dnsSp,
-		"ScanTLS":     tlsSp,
-		"ScanCrtSh":   crtSp,
-		"ScanChaos":   chaosSp,
-		"ScanShodan":  shodanSp,
-		"ScanOTX":     otxSp,
-		"ScanWhois":   whoisSp,
-		"ScanAbuseCh": abuseChSp,
-		"ScanISC":     iscSp,
+	// Instantiate every registered plugin; misconfigured ones are reported
+	// by ListPlugins rather than stopping the server
+	if err := registry.Validate(); err != nil {
+		log.Fatalf("Invalid plugin registry: %v", err)
 	}
+	pluginMap, disabledPlugins := registry.Load(db, cfg)
 
 	grpcServer := grpc.NewServer(
 		grpc.UnaryInterceptor(authService.AuthInterceptor),
+		grpc.StreamInterceptor(authService.StreamAuthInterceptor),
 	)
 
-	s := server.New(db, authService, emailService, pluginMap)
-	reportService := server.NewReportService(db, pluginMap)
+	s := server.New(db, cfg, authService, emailService, pluginMap, disabledPlugins)
+	reportService := server.NewReportService(db, cfg, pluginMap)
 
 	pb.RegisterAuthServiceServer(grpcServer, authService)     // Register AuthService
 	pb.RegisterUserServiceServer(grpcServer, s)               // Register UserService
@@ -146,6 +96,40 @@ func main() {
 
 	authService.ScheduleAPIKeyRotation()
 
+	// Run queued scan jobs in the background
+	jobRunner := jobs.NewRunner(jobs.NewStore(db), orchestrator.New(pluginMap, cfg), reportService.FinishScanJob, 4)
+	if cfg.Scan.SubdomainScans.Enabled {
+		jobRunner.MaxSubdomainJobs = cfg.Scan.SubdomainScans.Max
+		jobRunner.SubdomainPlugins = cfg.Scan.SubdomainScans.Plugins
+	}
+	jobRunner.MaxBatchJobs = cfg.Scan.Bulk.MaxRunning
+	jobRunner.Profiles = profiles.NewStore(db, cfg)
+	jobRunner.Start(context.Background())
+
+	// Reload config.yaml when it changes or on SIGHUP, restarting external
+	// plugins whose entries changed and swapping the new config and freshly
+	// initialised plugins into every service; RPCs and jobs already running
+	// finish with the ones they started with
+	configs.OnChange(func(cfg *config.Config) error {
+		// Refuse a proxy or CA bundle every HTTP plugin would fail to load with
+		if _, err := httpclient.New(cfg); err != nil {
+			return err
+		}
+		pluginHost.Reload(cfg)
+		plugins, disabled := registry.Load(db, cfg)
+		breaker.Reconfigure(cfg)
+		s.Reload(cfg, plugins, disabled)
+		reportService.Reload(cfg, plugins)
+		jobRunner.SetOrchestrator(orchestrator.New(plugins, cfg))
+		jobRunner.Profiles.SetConfig(cfg)
+		return nil
+	})
+	s.WatchConfig(configs)
+	go configs.Run(context.Background())
+
+	// Queue scan jobs for recurring schedules; safe to run on every replica
+	schedules.NewScheduler(schedules.NewStore(db), s.SubmitScheduledScan).Start(context.Background())
+
 	// Create a TCP listener for the gRPC server.
 	listener, err := net.Listen("tcp", fmt.Sprintf(":%d", cfg.Server.GRPCPort))
 	if err != nil {
@@ -11,7 +11,6 @@ require (
 	github.com/lib/pq v1.10.9
 	github.com/likexian/whois v1.15.6
 	github.com/miekg/dns v1.1.56
-	github.com/projectdiscovery/chaos-client v0.5.2
 	github.com/sendgrid/sendgrid-go v3.16.1+incompatible
 	github.com/shadowscatcher/shodan v1.0.8
 	github.com/stretchr/testify v1.9.0
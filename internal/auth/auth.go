@@ -159,9 +159,38 @@ func (s *AuthService) VerifyUser(email, password string) (string, string, string
 
 // AuthInterceptor intercepts gRPC calls for authentication and authorization.
 func (s *AuthService) AuthInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	newCtx, err := s.authenticate(ctx, info.FullMethod)
+	if err != nil {
+		return nil, err
+	}
+	return handler(newCtx, req)
+}
+
+// StreamAuthInterceptor applies the same checks as AuthInterceptor to streaming RPCs.
+func (s *AuthService) StreamAuthInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	newCtx, err := s.authenticate(ss.Context(), info.FullMethod)
+	if err != nil {
+		return err
+	}
+	return handler(srv, &authenticatedStream{ServerStream: ss, ctx: newCtx})
+}
+
+// authenticatedStream carries the user info added by authenticate
+type authenticatedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *authenticatedStream) Context() context.Context {
+	return s.ctx
+}
+
+// authenticate verifies the API key and permissions for fullMethod and
+// returns ctx with the user info downstream handlers rely on.
+func (s *AuthService) authenticate(ctx context.Context, fullMethod string) (context.Context, error) {
 	// Allow Login and ValidateInvite methods without authentication
-	if info.FullMethod == "/service.AuthService/Login" || info.FullMethod == "/service.AuthService/ValidateInvite" {
-		return handler(ctx, req)
+	if fullMethod == "/service.AuthService/Login" || fullMethod == "/service.AuthService/ValidateInvite" {
+		return ctx, nil
 	}
 
 	md, ok := metadata.FromIncomingContext(ctx)
@@ -189,7 +218,7 @@ func (s *AuthService) AuthInterceptor(ctx context.Context, req interface{}, info
 	}
 
 	// Casbin authorization check
-	if !s.casbin.Authorize(role, info.FullMethod, "*") {
+	if !s.casbin.Authorize(role, fullMethod, "*") {
 		return nil, status.Error(codes.PermissionDenied, "insufficient permissions")
 	}
 
@@ -198,7 +227,7 @@ func (s *AuthService) AuthInterceptor(ctx context.Context, req interface{}, info
 	newCtx = context.WithValue(newCtx, "role", role)
 	newCtx = context.WithValue(newCtx, "is_admin", isAdmin)
 	newCtx = context.WithValue(newCtx, "is_service_key", isServiceKey)
-	return handler(newCtx, req)
+	return newCtx, nil
 }
 
 // Login handles user login.
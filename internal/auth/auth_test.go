@@ -0,0 +1,98 @@
+// internal/auth/auth_test.go
+package auth
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestAuthInterceptor(t *testing.T) {
+	ctx := context.Background()
+	s := &AuthService{}
+	req := struct{}{}
+	info := &grpc.UnaryServerInfo{FullMethod: "/service.ScanService/ScanDomain"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return req, nil
+	}
+
+	t.Run("MissingMetadata", func(t *testing.T) {
+		_, err := s.AuthInterceptor(ctx, req, info, handler)
+		assert.Error(t, err)
+		st, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.Unauthenticated, st.Code())
+	})
+
+	t.Run("MissingAPIKey", func(t *testing.T) {
+		ctx := metadata.NewIncomingContext(ctx, metadata.Pairs())
+		_, err := s.AuthInterceptor(ctx, req, info, handler)
+		assert.Error(t, err)
+		st, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.Unauthenticated, st.Code())
+	})
+
+	t.Run("PublicMethod", func(t *testing.T) {
+		resp, err := s.AuthInterceptor(ctx, req, &grpc.UnaryServerInfo{FullMethod: "/service.AuthService/Login"}, handler)
+		assert.NoError(t, err)
+		assert.Equal(t, req, resp)
+	})
+}
+
+type fakeServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (f *fakeServerStream) Context() context.Context { return f.ctx }
+
+func TestStreamAuthInterceptor(t *testing.T) {
+	s := &AuthService{}
+	info := &grpc.StreamServerInfo{FullMethod: "/service.ReportService/GenerateReportStream", IsServerStream: true}
+	called := false
+	handler := func(srv interface{}, stream grpc.ServerStream) error {
+		called = true
+		return nil
+	}
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs())
+	err := s.StreamAuthInterceptor(nil, &fakeServerStream{ctx: ctx}, info, handler)
+	st, ok := status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, codes.Unauthenticated, st.Code())
+	assert.False(t, called)
+}
+
+func TestCasbinScanServicePolicies(t *testing.T) {
+	e, err := NewCasbinEnforcer()
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.True(t, e.Authorize("admin", "/service.ScanService/ScanTLS", "*"))
+	assert.True(t, e.Authorize("user", "/service.ScanService/ScanTLS", "*"))
+	assert.True(t, e.Authorize("user", "/service.ScanService/GetTLSScanResultsByDomain", "*"))
+	assert.True(t, e.Authorize("viewer", "/service.ScanService/GetDNSScanResultByID", "*"))
+	assert.True(t, e.Authorize("viewer", "/service.ScanService/ListPlugins", "*"))
+	assert.False(t, e.Authorize("viewer", "/service.ScanService/ScanDomain", "*"))
+	assert.True(t, e.Authorize("user", "/service.ScanService/SubmitScanJob", "*"))
+	assert.True(t, e.Authorize("user", "/service.ScanService/CancelScanJob", "*"))
+	assert.True(t, e.Authorize("viewer", "/service.ScanService/GetScanJob", "*"))
+	assert.False(t, e.Authorize("viewer", "/service.ScanService/CancelScanJob", "*"))
+}
+
+func TestCasbinReportServicePolicies(t *testing.T) {
+	e, err := NewCasbinEnforcer()
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.True(t, e.Authorize("user", "/service.ReportService/GenerateReport", "*"))
+	assert.True(t, e.Authorize("user", "/service.ReportService/GenerateReportStream", "*"))
+	assert.True(t, e.Authorize("viewer", "/service.ReportService/ListReports", "*"))
+	assert.False(t, e.Authorize("viewer", "/service.ReportService/GenerateReportStream", "*"))
+}
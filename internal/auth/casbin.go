@@ -22,7 +22,7 @@ p = sub, obj, act
 [role_definition]
 g = _, _
 
-[effect]
+[policy_effect]
 e = some(where (p.eft == allow))
 
 [matchers]
@@ -41,11 +41,35 @@ m = g(r.sub, p.sub) && keyMatch(r.obj, p.obj) && regexMatch(r.act, p.act)
 
 	// Define policies
 	policies := [][]string{
-		{"admin", "/service.AuthService/*", "*"},
-		{"admin", "/service.UserService/*", "*"},
-		{"user", "/service.UserService/Scan*", "*"},
-		{"user", "/service.UserService/Get*", "*"},
-		{"viewer", "/service.UserService/Get*", "*"},
+		{"admin", "/service.AuthService/*", ".*"},
+		{"admin", "/service.UserService/*", ".*"},
+		{"user", "/service.UserService/Scan*", ".*"},
+		{"user", "/service.UserService/Get*", ".*"},
+		{"viewer", "/service.UserService/Get*", ".*"},
+		{"admin", "/service.ScanService/*", ".*"},
+		{"user", "/service.ScanService/Scan*", ".*"},
+		{"user", "/service.ScanService/Get*", ".*"},
+		{"viewer", "/service.ScanService/Get*", ".*"},
+		{"user", "/service.ScanService/ListPlugins", ".*"},
+		{"viewer", "/service.ScanService/ListPlugins", ".*"},
+		{"user", "/service.ScanService/SubmitScanJob", ".*"},
+		{"user", "/service.ScanService/ListScanJobs", ".*"},
+		{"user", "/service.ScanService/CancelScanJob", ".*"},
+		{"viewer", "/service.ScanService/ListScanJobs", ".*"},
+		{"user", "/service.ScanService/CreateScanSchedule", ".*"},
+		{"user", "/service.ScanService/UpdateScanSchedule", ".*"},
+		{"user", "/service.ScanService/PauseScanSchedule", ".*"},
+		{"user", "/service.ScanService/ListScanSchedules", ".*"},
+		{"viewer", "/service.ScanService/ListScanSchedules", ".*"},
+		{"user", "/service.ScanService/BulkScan", ".*"},
+		{"user", "/service.ScanService/SaveScanProfile", ".*"},
+		{"user", "/service.ScanService/ListScanProfiles", ".*"},
+		{"user", "/service.ScanService/DeleteScanProfile", ".*"},
+		{"viewer", "/service.ScanService/ListScanProfiles", ".*"},
+		{"admin", "/service.ReportService/*", ".*"},
+		{"user", "/service.ReportService/*", ".*"},
+		{"viewer", "/service.ReportService/Get*", ".*"},
+		{"viewer", "/service.ReportService/List*", ".*"},
 	}
 	for _, p := range policies {
 		if _, err := e.AddPolicy(p[0], p[1], p[2]); err != nil {
@@ -0,0 +1,256 @@
+// internal/breaker/breaker.go
+package breaker
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/moos3/sparta/internal/config"
+	"github.com/moos3/sparta/internal/httpretry"
+)
+
+// ErrOpen is wrapped by errors for requests refused because a provider's
+// breaker is open.
+var ErrOpen = errors.New("circuit breaker open")
+
+// State is the position of a breaker.
+type State string
+
+const (
+	StateClosed   State = "closed"    // requests flow and failures are counted
+	StateOpen     State = "open"      // requests are refused until the cooldown ends
+	StateHalfOpen State = "half_open" // one trial request decides whether to close again
+)
+
+// Policy decides when a breaker opens and for how long.
+type Policy struct {
+	Failures int           // consecutive failed requests that open the breaker
+	Cooldown time.Duration // time open before a trial request is let through
+}
+
+// DefaultPolicy is used for providers without a configured breaker.
+var DefaultPolicy = Policy{Failures: 5, Cooldown: time.Minute}
+
+// PolicyFor returns the breaker policy configured for provider, falling back
+// to DefaultPolicy for unset fields or a nil cfg.
+func PolicyFor(cfg *config.Config, provider string) Policy {
+	policy := DefaultPolicy
+	if cfg == nil {
+		return policy
+	}
+	c := cfg.BreakerPolicyFor(provider)
+	if c.Failures > 0 {
+		policy.Failures = c.Failures
+	}
+	if c.Cooldown > 0 {
+		policy.Cooldown = time.Duration(c.Cooldown) * time.Second
+	}
+	return policy
+}
+
+// Breaker stops requests to a provider after it has failed Policy.Failures
+// times in a row. Once the cooldown has passed it lets a single trial request
+// through: success closes the breaker, failure opens it for another cooldown.
+// A failure is a request that was still unavailable after retries or came
+// back with a 5xx status; anything else shows the provider is answering.
+type Breaker struct {
+	Provider string
+	Policy   Policy
+
+	mu        sync.Mutex
+	state     State
+	failures  int
+	openedAt  time.Time
+	lastError string
+	probing   bool
+	now       func() time.Time
+}
+
+// New creates a closed breaker for provider.
+func New(provider string, policy Policy) *Breaker {
+	return &Breaker{Provider: provider, Policy: policy, state: StateClosed, now: time.Now}
+}
+
+// Status is a snapshot of a breaker.
+type Status struct {
+	Provider  string
+	State     State
+	Failures  int       // consecutive failures counted while closed
+	OpenedAt  time.Time // zero unless open or half-open
+	RetryAt   time.Time // when an open breaker lets a trial request through
+	LastError string
+}
+
+// Status returns the breaker's current state. An open breaker whose cooldown
+// has passed is reported as half-open.
+func (b *Breaker) Status() Status {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	s := Status{Provider: b.Provider, State: b.state, Failures: b.failures, LastError: b.lastError}
+	if b.state != StateClosed {
+		s.OpenedAt = b.openedAt
+		s.RetryAt = b.openedAt.Add(b.Policy.Cooldown)
+		if b.state == StateOpen && !b.now().Before(s.RetryAt) {
+			s.State = StateHalfOpen
+		}
+	}
+	return s
+}
+
+// Check returns an error wrapping ErrOpen if a request to the provider would
+// be refused right now. Unlike Allow it does not claim the trial request.
+func (b *Breaker) Check() error {
+	if b == nil {
+		return nil
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.refusal()
+}
+
+// Allow returns an error wrapping ErrOpen if a request should not be sent.
+// A nil error obliges the caller to report the outcome through Success,
+// Failure or Release.
+func (b *Breaker) Allow() error {
+	if b == nil {
+		return nil
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if err := b.refusal(); err != nil {
+		return err
+	}
+	if b.state != StateClosed {
+		b.state = StateHalfOpen
+		b.probing = true
+	}
+	return nil
+}
+
+func (b *Breaker) refusal() error {
+	switch {
+	case b.state == StateOpen && b.now().Before(b.openedAt.Add(b.Policy.Cooldown)):
+		return fmt.Errorf("%w for %s until %s", ErrOpen, b.Provider, b.openedAt.Add(b.Policy.Cooldown).UTC().Format(time.RFC3339))
+	case b.state == StateHalfOpen && b.probing:
+		return fmt.Errorf("%w for %s: trial request in progress", ErrOpen, b.Provider)
+	}
+	return nil
+}
+
+// Success records a request the provider answered and closes the breaker.
+func (b *Breaker) Success() {
+	if b == nil {
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.state, b.failures, b.probing = StateClosed, 0, false
+}
+
+// Failure records a request the provider could not serve, opening the
+// breaker if it was half-open or has now failed Policy.Failures times in a row.
+func (b *Breaker) Failure(err error) {
+	if b == nil {
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.lastError = err.Error()
+	b.failures++
+	if b.state == StateHalfOpen || b.failures >= b.Policy.Failures {
+		b.state, b.openedAt, b.probing = StateOpen, b.now(), false
+	}
+}
+
+// Release gives back a trial request that ended without saying anything about
+// the provider, such as one cancelled by its caller.
+func (b *Breaker) Release() {
+	if b == nil {
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.probing = false
+}
+
+// Transport returns a RoundTripper that refuses requests while the breaker is
+// open and records the outcome of those it sends through base.
+func (b *Breaker) Transport(base http.RoundTripper) http.RoundTripper {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return &transport{breaker: b, base: base}
+}
+
+type transport struct {
+	breaker *Breaker
+	base    http.RoundTripper
+}
+
+func (t *transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if err := t.breaker.Allow(); err != nil {
+		if req.Body != nil {
+			req.Body.Close()
+		}
+		return nil, err
+	}
+	resp, err := t.base.RoundTrip(req)
+	switch {
+	case httpretry.IsUnavailable(err):
+		t.breaker.Failure(err)
+	case err != nil:
+		t.breaker.Release()
+	case resp.StatusCode >= 500:
+		t.breaker.Failure(fmt.Errorf("status %d", resp.StatusCode))
+	default:
+		t.breaker.Success()
+	}
+	return resp, err
+}
+
+var (
+	mu       sync.Mutex
+	breakers = make(map[string]*Breaker)
+)
+
+// For returns the process-wide breaker for provider, creating it with the
+// policy in cfg on first use. Every plugin calling the provider shares it.
+func For(cfg *config.Config, provider string) *Breaker {
+	mu.Lock()
+	defer mu.Unlock()
+	b, ok := breakers[provider]
+	if !ok {
+		b = New(provider, PolicyFor(cfg, provider))
+		breakers[provider] = b
+	}
+	return b
+}
+
+// Reconfigure applies the policies in cfg to every breaker created so far,
+// keeping their state, so a reloaded config takes effect without resetting
+// providers that are failing.
+func Reconfigure(cfg *config.Config) {
+	mu.Lock()
+	defer mu.Unlock()
+	for provider, b := range breakers {
+		b.mu.Lock()
+		b.Policy = PolicyFor(cfg, provider)
+		b.mu.Unlock()
+	}
+}
+
+// List returns the status of every breaker created so far, sorted by provider.
+func List() []Status {
+	mu.Lock()
+	list := make([]Status, 0, len(breakers))
+	for _, b := range breakers {
+		list = append(list, b.Status())
+	}
+	mu.Unlock()
+	sort.Slice(list, func(i, j int) bool { return list[i].Provider < list[j].Provider })
+	return list
+}
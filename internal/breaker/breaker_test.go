@@ -0,0 +1,126 @@
+// internal/breaker/breaker_test.go
+package breaker
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/moos3/sparta/internal/config"
+	"github.com/moos3/sparta/internal/httpretry"
+	"github.com/stretchr/testify/assert"
+)
+
+func testBreaker(clock *time.Time) *Breaker {
+	b := New("otx", Policy{Failures: 2, Cooldown: time.Minute})
+	b.now = func() time.Time { return *clock }
+	return b
+}
+
+func TestOpensAfterConsecutiveFailures(t *testing.T) {
+	clock := time.Date(2026, 3, 14, 15, 0, 0, 0, time.UTC)
+	b := testBreaker(&clock)
+	outage := &httpretry.UnavailableError{Provider: "otx", Attempts: 4, StatusCode: 503}
+
+	b.Failure(outage)
+	b.Success()
+	b.Failure(outage)
+	assert.NoError(t, b.Allow(), "a success in between resets the count")
+
+	b.Failure(outage)
+	assert.ErrorIs(t, b.Allow(), ErrOpen)
+	assert.ErrorIs(t, b.Check(), ErrOpen)
+	st := b.Status()
+	assert.Equal(t, StateOpen, st.State)
+	assert.Equal(t, clock.Add(time.Minute), st.RetryAt)
+	assert.Equal(t, outage.Error(), st.LastError)
+}
+
+func TestHalfOpenLetsOneTrialThrough(t *testing.T) {
+	clock := time.Date(2026, 3, 14, 15, 0, 0, 0, time.UTC)
+	b := testBreaker(&clock)
+	b.Failure(errors.New("down"))
+	b.Failure(errors.New("down"))
+
+	clock = clock.Add(time.Minute)
+	assert.Equal(t, StateHalfOpen, b.Status().State)
+	assert.NoError(t, b.Check())
+	assert.NoError(t, b.Allow())
+	assert.ErrorIs(t, b.Allow(), ErrOpen, "only one trial at a time")
+
+	t.Run("FailedTrialReopens", func(t *testing.T) {
+		b.Failure(errors.New("still down"))
+		assert.Equal(t, StateOpen, b.Status().State)
+		assert.Equal(t, clock, b.Status().OpenedAt)
+	})
+
+	t.Run("SuccessfulTrialCloses", func(t *testing.T) {
+		clock = clock.Add(time.Minute)
+		assert.NoError(t, b.Allow())
+		b.Success()
+		assert.Equal(t, Status{Provider: "otx", State: StateClosed, LastError: "still down"}, b.Status())
+	})
+}
+
+func TestTransport(t *testing.T) {
+	var code int
+	calls := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(code)
+	}))
+	defer srv.Close()
+
+	clock := time.Date(2026, 3, 14, 15, 0, 0, 0, time.UTC)
+	b := testBreaker(&clock)
+	client := &http.Client{Transport: b.Transport(nil)}
+
+	// Client errors show the provider is up
+	code = http.StatusNotFound
+	for i := 0; i < 3; i++ {
+		resp, err := client.Get(srv.URL)
+		if assert.NoError(t, err) {
+			resp.Body.Close()
+		}
+	}
+	assert.Equal(t, StateClosed, b.Status().State)
+
+	code = http.StatusInternalServerError
+	for i := 0; i < 2; i++ {
+		resp, err := client.Get(srv.URL)
+		if assert.NoError(t, err) {
+			resp.Body.Close()
+		}
+	}
+	_, err := client.Get(srv.URL)
+	assert.ErrorIs(t, err, ErrOpen)
+	assert.Equal(t, 5, calls)
+}
+
+func TestPolicyFor(t *testing.T) {
+	assert.Equal(t, DefaultPolicy, PolicyFor(nil, "otx"))
+
+	cfg := &config.Config{}
+	cfg.CircuitBreaker.Failures = 3
+	cfg.CircuitBreaker.Providers = map[string]config.BreakerPolicy{"crtsh": {Cooldown: 300}}
+	assert.Equal(t, Policy{Failures: 3, Cooldown: time.Minute}, PolicyFor(cfg, "otx"))
+	assert.Equal(t, Policy{Failures: 3, Cooldown: 5 * time.Minute}, PolicyFor(cfg, "crtsh"))
+}
+
+func TestReconfigureKeepsState(t *testing.T) {
+	b := For(&config.Config{}, "reconfigure-test")
+	for i := 0; i < DefaultPolicy.Failures; i++ {
+		b.Failure(errors.New("timeout"))
+	}
+	assert.ErrorIs(t, b.Check(), ErrOpen)
+
+	cfg := &config.Config{}
+	cfg.CircuitBreaker.Providers = map[string]config.BreakerPolicy{"reconfigure-test": {Failures: 10, Cooldown: 30}}
+	Reconfigure(cfg)
+	st := b.Status()
+	assert.Equal(t, StateOpen, st.State)
+	assert.Equal(t, 30*time.Second, st.RetryAt.Sub(st.OpenedAt))
+	assert.Same(t, b, For(cfg, "reconfigure-test"))
+}
@@ -2,7 +2,12 @@
 package config
 
 import (
+	"fmt"
 	"os"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
 
 	"gopkg.in/yaml.v2"
 )
@@ -35,6 +40,7 @@ type Config struct {
 	} `yaml:"chaos"`
 	Shodan struct {
 		APIKey       string `yaml:"api_key"`
+		BaseURL      string `yaml:"base_url"`
 		RequestDelay int    `yaml:"request_delay"` // in milliseconds
 	} `yaml:"shodan"`
 	OTX struct {
@@ -52,6 +58,145 @@ type Config struct {
 		BaseURL      string `yaml:"base_url"`
 		RequestDelay int    `yaml:"request_delay"` // in milliseconds
 	} `yaml:"isc"`
+	CrtSh struct {
+		BaseURL string `yaml:"base_url"`
+	} `yaml:"crtsh"`
+	HTTP struct {
+		Proxy     string         `yaml:"proxy"`      // http, https or socks5 URL; unset uses HTTP_PROXY and HTTPS_PROXY from the environment
+		CABundle  string         `yaml:"ca_bundle"`  // PEM file of CAs trusted alongside the system pool
+		UserAgent string         `yaml:"user_agent"` // sent with every provider request
+		Timeout   int            `yaml:"timeout"`    // per request attempt, in seconds
+		Timeouts  map[string]int `yaml:"timeouts"`   // per-provider overrides in seconds
+	} `yaml:"http"`
+	DNS struct {
+		Timeout   int           `yaml:"timeout"`   // per query to one resolver, in seconds
+		Resolvers []DNSResolver `yaml:"resolvers"` // tried in order until one answers
+		// DKIMSelectors replaces the built-in dictionary of DKIM selectors
+		// tried for every domain
+		DKIMSelectors []string `yaml:"dkim_selectors"`
+		// TrustAnchors are the root zone DS records DNSSEC validation starts
+		// from, in zone file syntax; empty uses the IANA root KSKs
+		TrustAnchors []string `yaml:"trust_anchors"`
+	} `yaml:"dns"`
+	Quotas     map[string]ProviderQuota `yaml:"quotas"` // call budgets keyed by provider: otx, isc, abuse_ch, crtsh, shodan, chaos
+	IntelCache struct {
+		TTL       int            `yaml:"ttl"`       // in seconds; negative disables the cache
+		Providers map[string]int `yaml:"providers"` // per-provider TTLs in seconds keyed by otx, shodan or chaos; 0 disables
+	} `yaml:"intel_cache"`
+	Retry struct {
+		RetryPolicy `yaml:",inline"`
+		Providers   map[string]RetryPolicy `yaml:"providers"` // overrides keyed by provider: otx, isc, abuse_ch, crtsh, shodan
+	} `yaml:"retry"`
+	CircuitBreaker struct {
+		BreakerPolicy `yaml:",inline"`
+		Providers     map[string]BreakerPolicy `yaml:"providers"` // overrides keyed by provider: otx, isc, abuse_ch, crtsh, shodan, chaos
+	} `yaml:"circuit_breaker"`
+	Scan struct {
+		Workers        int            `yaml:"workers"`         // plugins run concurrently within one scan
+		PluginTimeout  int            `yaml:"plugin_timeout"`  // in seconds
+		PluginTimeouts map[string]int `yaml:"plugin_timeouts"` // per-plugin overrides keyed by plugin name, in seconds
+		SubdomainScans struct {
+			Enabled bool     `yaml:"enabled"` // queue a scan job for each subdomain found by a scan job
+			Max     int      `yaml:"max"`     // subdomain jobs queued per scan job
+			Plugins []string `yaml:"plugins"` // plugins run by subdomain jobs
+		} `yaml:"subdomain_scans"`
+		Bulk struct {
+			MaxDomains int `yaml:"max_domains"` // domains accepted per BulkScan request
+			MaxRunning int `yaml:"max_running"` // jobs of one batch running at once across all servers
+		} `yaml:"bulk"`
+		Profiles map[string]ScanProfile `yaml:"profiles"` // named plugin sets, alongside the built-in passive, quick and full
+	} `yaml:"scan"`
+	Plugins         map[string]PluginConfig `yaml:"plugins"` // keyed by plugin name
+	ExternalPlugins []ExternalPlugin        `yaml:"external_plugins"`
+}
+
+// PluginConfig turns a plugin on or off and overrides config for it alone.
+type PluginConfig struct {
+	Enabled  *bool             `yaml:"enabled"`  // unset means enabled
+	Settings map[string]string `yaml:"settings"` // dotted config.yaml keys, e.g. "shodan.api_key"
+}
+
+// ExternalPlugin is a scan plugin shipped as a separate executable that the
+// server starts and talks to over the plugin protocol in proto/plugin.
+type ExternalPlugin struct {
+	Path         string            `yaml:"path"`
+	Args         []string          `yaml:"args"`
+	Settings     map[string]string `yaml:"settings"`      // passed to the plugin in the handshake
+	StartTimeout int               `yaml:"start_timeout"` // in seconds, for the plugin to start and complete the handshake
+}
+
+// DNSResolver is an upstream DNS server the DNS plugin queries.
+type DNSResolver struct {
+	Address    string `yaml:"address"`     // host[:port], or the DNS-over-HTTPS URL for https
+	Protocol   string `yaml:"protocol"`    // udp (default), tcp, tls or https
+	ServerName string `yaml:"server_name"` // name to verify the certificate against for tls; defaults to the host
+}
+
+// ProviderQuota caps the calls made to an intelligence provider. Days and
+// months are UTC; zero is unlimited.
+type ProviderQuota struct {
+	Daily   int `yaml:"daily"`
+	Monthly int `yaml:"monthly"`
+}
+
+// RetryPolicy bounds how requests to an intelligence provider are retried
+// when it throttles or fails.
+type RetryPolicy struct {
+	MaxAttempts int `yaml:"max_attempts"` // including the first request
+	BaseDelay   int `yaml:"base_delay"`   // in milliseconds, doubled after each attempt
+	MaxDelay    int `yaml:"max_delay"`    // in milliseconds
+	Budget      int `yaml:"budget"`       // in seconds, across every attempt and wait for one request
+}
+
+// BreakerPolicy decides when calls to an intelligence provider stop being
+// attempted after repeated failures.
+type BreakerPolicy struct {
+	Failures int `yaml:"failures"` // consecutive failed requests that open the breaker
+	Cooldown int `yaml:"cooldown"` // in seconds, before a trial request is let through
+}
+
+// BreakerPolicyFor returns the circuit breaker policy for a provider: its
+// override under circuit_breaker.providers, with unset fields taken from the
+// circuit_breaker section.
+func (c *Config) BreakerPolicyFor(provider string) BreakerPolicy {
+	policy := c.CircuitBreaker.BreakerPolicy
+	override := c.CircuitBreaker.Providers[provider]
+	if override.Failures > 0 {
+		policy.Failures = override.Failures
+	}
+	if override.Cooldown > 0 {
+		policy.Cooldown = override.Cooldown
+	}
+	return policy
+}
+
+// RetryPolicyFor returns the retry policy for a provider: its override under
+// retry.providers, with unset fields taken from the retry section.
+func (c *Config) RetryPolicyFor(provider string) RetryPolicy {
+	policy := c.Retry.RetryPolicy
+	override := c.Retry.Providers[provider]
+	if override.MaxAttempts > 0 {
+		policy.MaxAttempts = override.MaxAttempts
+	}
+	if override.BaseDelay > 0 {
+		policy.BaseDelay = override.BaseDelay
+	}
+	if override.MaxDelay > 0 {
+		policy.MaxDelay = override.MaxDelay
+	}
+	if override.Budget > 0 {
+		policy.Budget = override.Budget
+	}
+	return policy
+}
+
+// ScanProfile selects which plugins a scan runs and how.
+type ScanProfile struct {
+	Description string                       `yaml:"description"`
+	Plugins     []string                     `yaml:"plugins"`      // empty means every loaded plugin
+	PassiveOnly bool                         `yaml:"passive_only"` // drop plugins that contact the target directly
+	Options     map[string]map[string]string `yaml:"options"`      // plugin-specific options keyed by plugin name
+	Timeouts    map[string]int               `yaml:"timeouts"`     // per-plugin timeouts keyed by plugin name, in seconds
 }
 
 func Load(path string) (*Config, error) {
@@ -59,7 +204,11 @@ func Load(path string) (*Config, error) {
 	if err != nil {
 		return nil, err
 	}
+	return parse(data)
+}
 
+// parse decodes config.yaml and fills in defaults.
+func parse(data []byte) (*Config, error) {
 	var cfg Config
 	if err := yaml.Unmarshal(data, &cfg); err != nil {
 		return nil, err
@@ -85,6 +234,151 @@ func Load(path string) (*Config, error) {
 	if cfg.ISC.RequestDelay == 0 {
 		cfg.ISC.RequestDelay = 5000 // Default to 5 seconds to be very polite to external APIs
 	}
+	// Default values for the intelligence response cache
+	if cfg.IntelCache.TTL == 0 {
+		cfg.IntelCache.TTL = 21600 // 6 hours
+	}
+	// Default values for retrying intelligence providers
+	if cfg.Retry.MaxAttempts == 0 {
+		cfg.Retry.MaxAttempts = 4
+	}
+	if cfg.Retry.BaseDelay == 0 {
+		cfg.Retry.BaseDelay = 500
+	}
+	if cfg.Retry.MaxDelay == 0 {
+		cfg.Retry.MaxDelay = 10000
+	}
+	if cfg.Retry.Budget == 0 {
+		cfg.Retry.Budget = 20
+	}
+	if cfg.CircuitBreaker.Failures == 0 {
+		cfg.CircuitBreaker.Failures = 5
+	}
+	if cfg.CircuitBreaker.Cooldown == 0 {
+		cfg.CircuitBreaker.Cooldown = 60
+	}
+	// Default values for scan orchestration
+	if cfg.Scan.Workers == 0 {
+		cfg.Scan.Workers = 4
+	}
+	if cfg.Scan.PluginTimeout == 0 {
+		cfg.Scan.PluginTimeout = 30
+	}
+	if cfg.Scan.SubdomainScans.Max == 0 {
+		cfg.Scan.SubdomainScans.Max = 20
+	}
+	if len(cfg.Scan.SubdomainScans.Plugins) == 0 {
+		cfg.Scan.SubdomainScans.Plugins = []string{"ScanDNS", "ScanTLS"}
+	}
+	if cfg.Scan.Bulk.MaxDomains == 0 {
+		cfg.Scan.Bulk.MaxDomains = 10000
+	}
+	if cfg.Scan.Bulk.MaxRunning == 0 {
+		cfg.Scan.Bulk.MaxRunning = 2
+	}
 
 	return &cfg, nil
 }
+
+// Validate checks the parts of the config that are only used once the
+// server is running, so that a bad reload is refused rather than breaking
+// scans: every plugin's settings must name config keys that can be set.
+func (c *Config) Validate() error {
+	names := make([]string, 0, len(c.Plugins))
+	for name := range c.Plugins {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if _, err := c.ForPlugin(name); err != nil {
+			return fmt.Errorf("plugins.%s.settings: %w", name, err)
+		}
+	}
+	return nil
+}
+
+// IsSet reports whether the dotted YAML key (for example "shodan.api_key")
+// names a field that holds a non-zero value.
+func (c *Config) IsSet(key string) bool {
+	v, err := c.lookup(key)
+	return err == nil && !v.IsZero()
+}
+
+// PluginEnabled reports whether the named plugin is turned on; plugins are on
+// unless plugins.<name>.enabled is false.
+func (c *Config) PluginEnabled(name string) bool {
+	enabled := c.Plugins[name].Enabled
+	return enabled == nil || *enabled
+}
+
+// ForPlugin returns the config the named plugin sees: c with the plugin's
+// settings under plugins applied. c is returned unchanged if it has none.
+func (c *Config) ForPlugin(name string) (*Config, error) {
+	settings := c.Plugins[name].Settings
+	if len(settings) == 0 {
+		return c, nil
+	}
+	plugin := *c
+	keys := make([]string, 0, len(settings))
+	for key := range settings {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		if err := plugin.set(key, settings[key]); err != nil {
+			return nil, err
+		}
+	}
+	return &plugin, nil
+}
+
+// set parses value into the string, integer or boolean field named by the
+// dotted YAML key.
+func (c *Config) set(key, value string) error {
+	v, err := c.lookup(key)
+	if err != nil {
+		return err
+	}
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(value)
+	case reflect.Int, reflect.Int64:
+		n, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return fmt.Errorf("config key %s: %q is not an integer", key, value)
+		}
+		v.SetInt(n)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return fmt.Errorf("config key %s: %q is not a boolean", key, value)
+		}
+		v.SetBool(b)
+	default:
+		return fmt.Errorf("config key %s cannot be set per plugin", key)
+	}
+	return nil
+}
+
+// lookup resolves a dotted YAML key to the matching struct field.
+func (c *Config) lookup(key string) (reflect.Value, error) {
+	v := reflect.ValueOf(c).Elem()
+	for _, part := range strings.Split(key, ".") {
+		if v.Kind() != reflect.Struct {
+			return reflect.Value{}, fmt.Errorf("config key %s: %s is not a section", key, part)
+		}
+		found := false
+		for i := 0; i < v.NumField(); i++ {
+			tag := strings.Split(v.Type().Field(i).Tag.Get("yaml"), ",")[0]
+			if tag == part {
+				v = v.Field(i)
+				found = true
+				break
+			}
+		}
+		if !found {
+			return reflect.Value{}, fmt.Errorf("unknown config key %s", key)
+		}
+	}
+	return v, nil
+}
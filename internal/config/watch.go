@@ -0,0 +1,194 @@
+// internal/config/watch.go
+package config
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"reflect"
+	"sync"
+	"syscall"
+	"time"
+)
+
+// PollInterval is how often a Watcher checks config.yaml for changes.
+const PollInterval = 5 * time.Second
+
+// Version identifies the config a Watcher is serving.
+type Version struct {
+	Number    int       // 1 for the config loaded at startup, incremented by each reload
+	Checksum  string    // SHA-256 of config.yaml as loaded
+	LoadedAt  time.Time // when this version was applied
+	LastError string    // why the most recent reload was refused, if it was
+	FailedAt  time.Time // when the most recent reload was refused
+}
+
+// Watcher serves the current config and reloads it when config.yaml changes
+// or the process receives SIGHUP. A reload is validated and handed to the
+// function set with OnChange before it becomes current; if either fails the
+// previous config stays in place.
+type Watcher struct {
+	path     string
+	interval time.Duration
+
+	mu       sync.Mutex // serialises reloads
+	apply    func(*Config) error
+	refused  string // checksum of the last config refused, not retried until it changes
+	current  *Config
+	version  Version
+	snapshot sync.RWMutex // guards current and version for readers
+}
+
+// Watch loads the config at path and returns a Watcher serving it.
+func Watch(path string) (*Watcher, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	cfg, err := parse(data)
+	if err != nil {
+		return nil, err
+	}
+	return &Watcher{
+		path:     path,
+		interval: PollInterval,
+		current:  cfg,
+		version:  Version{Number: 1, Checksum: checksum(data), LoadedAt: time.Now()},
+	}, nil
+}
+
+// Config returns the current config. Callers must not modify it.
+func (w *Watcher) Config() *Config {
+	w.snapshot.RLock()
+	defer w.snapshot.RUnlock()
+	return w.current
+}
+
+// Version returns the version of the current config.
+func (w *Watcher) Version() Version {
+	w.snapshot.RLock()
+	defer w.snapshot.RUnlock()
+	return w.version
+}
+
+// Path returns the config file being watched.
+func (w *Watcher) Path() string {
+	return w.path
+}
+
+// OnChange sets the function that swaps a reloaded config into the server.
+// It runs before the config becomes current; an error refuses the reload.
+func (w *Watcher) OnChange(apply func(*Config) error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.apply = apply
+}
+
+// Reload reads config.yaml and applies it if it changed since the current
+// version was loaded. Sections that are only read at startup keep their
+// current values, with a warning that they need a restart.
+func (w *Watcher) Reload() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	data, err := os.ReadFile(w.path)
+	if err != nil {
+		return w.refuse("", fmt.Errorf("failed to read config: %w", err))
+	}
+	sum := checksum(data)
+	current := w.Version()
+	switch sum {
+	case current.Checksum:
+		return nil
+	case w.refused:
+		return fmt.Errorf("%s", current.LastError)
+	}
+	cfg, err := parse(data)
+	if err != nil {
+		return w.refuse(sum, fmt.Errorf("failed to parse config: %w", err))
+	}
+	keepStartupSettings(w.Config(), cfg)
+	if err := cfg.Validate(); err != nil {
+		return w.refuse(sum, fmt.Errorf("invalid config: %w", err))
+	}
+	if w.apply != nil {
+		if err := w.apply(cfg); err != nil {
+			return w.refuse(sum, fmt.Errorf("failed to apply config: %w", err))
+		}
+	}
+
+	w.refused = ""
+	w.snapshot.Lock()
+	w.current = cfg
+	w.version = Version{Number: current.Number + 1, Checksum: sum, LoadedAt: time.Now()}
+	w.snapshot.Unlock()
+	log.Printf("Loaded config version %d from %s", current.Number+1, w.path)
+	return nil
+}
+
+// refuse records a failed reload of the config with checksum sum and
+// returns err. Each distinct failure is logged once.
+func (w *Watcher) refuse(sum string, err error) error {
+	w.refused = sum
+	w.snapshot.Lock()
+	repeated := w.version.LastError == err.Error()
+	w.version.LastError = err.Error()
+	w.version.FailedAt = time.Now()
+	number := w.version.Number
+	w.snapshot.Unlock()
+	if !repeated {
+		log.Printf("Config reload refused, keeping version %d: %v", number, err)
+	}
+	return err
+}
+
+// Run reloads the config on SIGHUP and whenever config.yaml changes, until
+// ctx is cancelled.
+func (w *Watcher) Run(ctx context.Context) {
+	hup := make(chan os.Signal, 1)
+	signal.Notify(hup, syscall.SIGHUP)
+	defer signal.Stop(hup)
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-hup:
+			log.Printf("Received SIGHUP, reloading config from %s", w.path)
+		case <-ticker.C:
+		}
+		w.Reload()
+	}
+}
+
+// keepStartupSettings copies into cfg the settings from old that the server
+// only reads at startup, logging the ones that changed.
+func keepStartupSettings(old, cfg *Config) {
+	changed := func(section string, a, b interface{}) {
+		if !reflect.DeepEqual(a, b) {
+			log.Printf("Config section %s changed; the change takes effect after a restart", section)
+		}
+	}
+	changed("database", old.Database, cfg.Database)
+	cfg.Database = old.Database
+	changed("server", old.Server, cfg.Server)
+	cfg.Server = old.Server
+	changed("email", old.Email, cfg.Email)
+	cfg.Email = old.Email
+	changed("auth", old.Auth, cfg.Auth)
+	cfg.Auth = old.Auth
+	changed("scan.subdomain_scans", old.Scan.SubdomainScans, cfg.Scan.SubdomainScans)
+	cfg.Scan.SubdomainScans = old.Scan.SubdomainScans
+	changed("scan.bulk.max_running", old.Scan.Bulk.MaxRunning, cfg.Scan.Bulk.MaxRunning)
+	cfg.Scan.Bulk.MaxRunning = old.Scan.Bulk.MaxRunning
+}
+
+func checksum(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,115 @@
+// internal/config/watch_test.go
+package config
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeConfig(t *testing.T, path, data string) {
+	t.Helper()
+	require.NoError(t, os.WriteFile(path, []byte(data), 0o600))
+}
+
+func TestWatcherReload(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	writeConfig(t, path, "server:\n  grpc_port: 50051\nshodan:\n  api_key: old\n")
+	w, err := Watch(path)
+	require.NoError(t, err)
+	first := w.Config()
+	assert.Equal(t, "old", first.Shodan.APIKey)
+	assert.Equal(t, 1, w.Version().Number)
+
+	var applied *Config
+	w.OnChange(func(cfg *Config) error {
+		applied = cfg
+		return nil
+	})
+
+	t.Run("Unchanged", func(t *testing.T) {
+		assert.NoError(t, w.Reload())
+		assert.Nil(t, applied)
+		assert.Same(t, first, w.Config())
+	})
+
+	t.Run("Changed", func(t *testing.T) {
+		writeConfig(t, path, "server:\n  grpc_port: 6000\nshodan:\n  api_key: new\n  request_delay: 100\n")
+		assert.NoError(t, w.Reload())
+		assert.Same(t, applied, w.Config())
+		assert.Equal(t, "new", w.Config().Shodan.APIKey)
+		assert.Equal(t, 100, w.Config().Shodan.RequestDelay)
+		// Read only at startup, so the old port is kept until a restart
+		assert.Equal(t, 50051, w.Config().Server.GRPCPort)
+		assert.Equal(t, 2, w.Version().Number)
+		assert.Equal(t, "old", first.Shodan.APIKey)
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		applied = nil
+		writeConfig(t, path, "plugins:\n  ScanShodan:\n    settings:\n      shodan.no_such_key: x\n")
+		assert.ErrorContains(t, w.Reload(), "unknown config key shodan.no_such_key")
+		assert.Nil(t, applied)
+		assert.Equal(t, 2, w.Version().Number)
+		assert.Contains(t, w.Version().LastError, "plugins.ScanShodan.settings")
+		assert.False(t, w.Version().FailedAt.IsZero())
+	})
+
+	t.Run("Refused", func(t *testing.T) {
+		writeConfig(t, path, "shodan:\n  api_key: refused\n")
+		w.OnChange(func(cfg *Config) error { return errors.New("bad proxy") })
+		assert.EqualError(t, w.Reload(), "failed to apply config: bad proxy")
+		assert.Equal(t, "new", w.Config().Shodan.APIKey)
+
+		// The same file is not applied again until it changes
+		calls := 0
+		w.OnChange(func(cfg *Config) error {
+			calls++
+			return nil
+		})
+		assert.Error(t, w.Reload())
+		assert.Zero(t, calls)
+		writeConfig(t, path, "shodan:\n  api_key: fixed\n")
+		assert.NoError(t, w.Reload())
+		assert.Equal(t, 1, calls)
+		assert.Equal(t, "fixed", w.Config().Shodan.APIKey)
+		assert.Equal(t, 3, w.Version().Number)
+		assert.Empty(t, w.Version().LastError)
+	})
+
+	t.Run("Unparseable", func(t *testing.T) {
+		writeConfig(t, path, "shodan: [\n")
+		assert.ErrorContains(t, w.Reload(), "failed to parse config")
+		assert.Equal(t, "fixed", w.Config().Shodan.APIKey)
+	})
+}
+
+func TestForPlugin(t *testing.T) {
+	off := false
+	cfg := &Config{Plugins: map[string]PluginConfig{
+		"ScanShodan": {Settings: map[string]string{"shodan.api_key": "secret", "shodan.request_delay": "100"}},
+		"ScanOTX":    {Enabled: &off},
+		"ScanISC":    {Settings: map[string]string{"isc.request_delay": "soon"}},
+	}}
+
+	own, err := cfg.ForPlugin("ScanShodan")
+	require.NoError(t, err)
+	assert.Equal(t, "secret", own.Shodan.APIKey)
+	assert.Equal(t, 100, own.Shodan.RequestDelay)
+	assert.Empty(t, cfg.Shodan.APIKey)
+
+	own, err = cfg.ForPlugin("ScanDNS")
+	assert.NoError(t, err)
+	assert.Same(t, cfg, own)
+
+	_, err = cfg.ForPlugin("ScanISC")
+	assert.EqualError(t, err, `config key isc.request_delay: "soon" is not an integer`)
+	assert.EqualError(t, cfg.Validate(), `plugins.ScanISC.settings: config key isc.request_delay: "soon" is not an integer`)
+
+	assert.True(t, cfg.PluginEnabled("ScanShodan"))
+	assert.False(t, cfg.PluginEnabled("ScanOTX"))
+}
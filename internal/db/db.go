@@ -2,8 +2,10 @@
 package db
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
+	"log"
 	"time"
 
 	_ "github.com/lib/pq"
@@ -17,6 +19,44 @@ type Database interface {
 	Close() error
 }
 
+// Locker is implemented by databases that can take Postgres session-level
+// advisory locks. TryLock reports false if another session holds key; when
+// it reports true the lock is held until unlock is called.
+type Locker interface {
+	TryLock(ctx context.Context, key int64) (unlock func(), ok bool, err error)
+}
+
+// Transactor is implemented by databases that can run statements in one
+// transaction. InTx commits if fn returns nil and rolls back otherwise.
+type Transactor interface {
+	InTx(fn func(tx Database) error) error
+}
+
+// InTx runs fn in a transaction on sqlDB, for Database implementations
+// built on database/sql.
+func InTx(sqlDB *sql.DB, fn func(tx Database) error) error {
+	tx, err := sqlDB.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	if err := fn(txDatabase{tx}); err != nil {
+		tx.Rollback()
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+// txDatabase is a transaction seen as a Database; the transaction ends when
+// InTx returns, not on Close.
+type txDatabase struct {
+	*sql.Tx
+}
+
+func (txDatabase) Close() error { return nil }
+
 type PostgresDB struct {
 	db *sql.DB
 }
@@ -50,6 +90,34 @@ func (p *PostgresDB) Close() error {
 	return p.db.Close()
 }
 
+func (p *PostgresDB) InTx(fn func(tx Database) error) error {
+	return InTx(p.db, fn)
+}
+
+// TryLock takes an advisory lock on a connection reserved until unlock, since
+// session locks belong to the connection that took them.
+func (p *PostgresDB) TryLock(ctx context.Context, key int64) (func(), bool, error) {
+	conn, err := p.db.Conn(ctx)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to reserve connection: %w", err)
+	}
+	var ok bool
+	if err := conn.QueryRowContext(ctx, "SELECT pg_try_advisory_lock($1)", key).Scan(&ok); err != nil {
+		conn.Close()
+		return nil, false, fmt.Errorf("failed to take advisory lock: %w", err)
+	}
+	if !ok {
+		conn.Close()
+		return nil, false, nil
+	}
+	return func() {
+		if _, err := conn.ExecContext(context.Background(), "SELECT pg_advisory_unlock($1)", key); err != nil {
+			log.Printf("Failed to release advisory lock %d: %v", key, err)
+		}
+		conn.Close()
+	}, true, nil
+}
+
 type DNSSecurityResult struct {
 	Records []string
 	Errors  []string
@@ -0,0 +1,199 @@
+// internal/httpclient/factory.go
+package httpclient
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/moos3/sparta/internal/config"
+	"github.com/moos3/sparta/internal/httpretry"
+)
+
+// DefaultUserAgent identifies sparta to providers unless http.user_agent is set.
+const DefaultUserAgent = "sparta/1.0 (+https://github.com/moos3/sparta)"
+
+// DefaultTimeout limits each request attempt unless http.timeout is set.
+const DefaultTimeout = 15 * time.Second
+
+// defaultBaseURLs are the production API roots of each provider.
+var defaultBaseURLs = map[string]string{
+	"abuse_ch": "https://threatfox-api.abuse.ch/api/v1",
+	"chaos":    "https://dns.projectdiscovery.io/dns",
+	"crtsh":    "https://crt.sh",
+	"isc":      "https://isc.sans.edu/api",
+	"otx":      "https://otx.alienvault.com/api/v1",
+	"shodan":   "https://api.shodan.io",
+}
+
+// BaseURL returns the API root requests to provider are sent to: the
+// base_url in the provider's config section if set, otherwise its production
+// API. The result has no trailing slash.
+func BaseURL(cfg *config.Config, provider string) string {
+	base := ""
+	if cfg != nil {
+		switch provider {
+		case "abuse_ch":
+			base = cfg.Abuse.BaseURL
+		case "chaos":
+			base = cfg.Chaos.BaseURL
+		case "crtsh":
+			base = cfg.CrtSh.BaseURL
+		case "isc":
+			base = cfg.ISC.BaseURL
+		case "otx":
+			base = cfg.OTX.BaseURL
+		case "shodan":
+			base = cfg.Shodan.BaseURL
+		}
+	}
+	if base == "" {
+		base = defaultBaseURLs[provider]
+	}
+	return strings.TrimRight(base, "/")
+}
+
+// Factory builds the HTTP clients plugins use to reach intelligence
+// providers. Its clients share one transport, so every outbound request goes
+// through the configured proxy, trusts the configured CA bundle and carries
+// the same User-Agent.
+type Factory struct {
+	cfg       *config.Config
+	transport *http.Transport
+	userAgent string
+}
+
+// New creates a Factory from the http section of cfg. It fails if the proxy
+// URL is invalid or the CA bundle cannot be loaded.
+func New(cfg *config.Config) (*Factory, error) {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	f := &Factory{cfg: cfg, transport: transport, userAgent: DefaultUserAgent}
+	if cfg == nil {
+		return f, nil
+	}
+
+	if cfg.HTTP.Proxy != "" {
+		proxy, err := url.Parse(cfg.HTTP.Proxy)
+		if err != nil {
+			return nil, fmt.Errorf("invalid proxy URL: %w", err)
+		}
+		switch proxy.Scheme {
+		case "http", "https", "socks5":
+		default:
+			return nil, fmt.Errorf("unsupported proxy scheme %q: use http, https or socks5", proxy.Scheme)
+		}
+		transport.Proxy = http.ProxyURL(proxy)
+	}
+
+	if cfg.HTTP.CABundle != "" {
+		pem, err := os.ReadFile(cfg.HTTP.CABundle)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read CA bundle: %w", err)
+		}
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no certificates found in CA bundle %s", cfg.HTTP.CABundle)
+		}
+		transport.TLSClientConfig = &tls.Config{RootCAs: pool, MinVersion: tls.VersionTLS12}
+	}
+
+	if cfg.HTTP.UserAgent != "" {
+		f.userAgent = cfg.HTTP.UserAgent
+	}
+	return f, nil
+}
+
+// With returns a Factory sharing f's transport, and so its proxy, CA bundle
+// and idle connections, that takes timeouts, base URLs and the User-Agent
+// from cfg.
+func (f *Factory) With(cfg *config.Config) *Factory {
+	g := &Factory{cfg: cfg, transport: f.transport, userAgent: DefaultUserAgent}
+	if cfg != nil && cfg.HTTP.UserAgent != "" {
+		g.userAgent = cfg.HTTP.UserAgent
+	}
+	return g
+}
+
+// Timeout returns the limit on each request attempt to provider.
+func (f *Factory) Timeout(provider string) time.Duration {
+	if f.cfg != nil {
+		if seconds := f.cfg.HTTP.Timeouts[provider]; seconds > 0 {
+			return time.Duration(seconds) * time.Second
+		}
+		if f.cfg.HTTP.Timeout > 0 {
+			return time.Duration(f.cfg.HTTP.Timeout) * time.Second
+		}
+	}
+	return DefaultTimeout
+}
+
+// BaseURL returns the API root for provider.
+func (f *Factory) BaseURL(provider string) string {
+	return BaseURL(f.cfg, provider)
+}
+
+// Client returns an HTTP client for provider that retries under its retry
+// policy and limits each attempt to its timeout. Requests addressed to the
+// provider's production API are sent to its configured base URL instead, so
+// libraries with a fixed API root can be pointed at a mock server as well.
+func (f *Factory) Client(provider string) *http.Client {
+	var base http.RoundTripper = f.transport
+	if from, to := defaultBaseURLs[provider], f.BaseURL(provider); from != "" && from != to {
+		if target, err := url.Parse(to); err == nil {
+			from, _ := url.Parse(from)
+			base = &rebase{from: from, to: target, base: base}
+		}
+	}
+	return &http.Client{Transport: &httpretry.Transport{
+		Provider:       provider,
+		Policy:         httpretry.PolicyFor(f.cfg, provider),
+		AttemptTimeout: f.Timeout(provider),
+		Base:           &userAgent{value: f.userAgent, base: base},
+	}}
+}
+
+// Transport returns the shared transport, setting the User-Agent, without
+// the retries and timeouts Client adds, for callers that bound and retry
+// requests themselves.
+func (f *Factory) Transport() http.RoundTripper {
+	return &userAgent{value: f.userAgent, base: f.transport}
+}
+
+// userAgent sets the User-Agent of every request.
+type userAgent struct {
+	value string
+	base  http.RoundTripper
+}
+
+func (u *userAgent) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	req.Header.Set("User-Agent", u.value)
+	return u.base.RoundTrip(req)
+}
+
+// rebase sends requests for URLs under from to the same path under to.
+type rebase struct {
+	from, to *url.URL
+	base     http.RoundTripper
+}
+
+func (r *rebase) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.URL.Host != r.from.Host || !strings.HasPrefix(req.URL.Path, r.from.Path) {
+		return r.base.RoundTrip(req)
+	}
+	req = req.Clone(req.Context())
+	req.URL.Scheme = r.to.Scheme
+	req.URL.Host = r.to.Host
+	req.URL.Path = strings.TrimRight(r.to.Path, "/") + strings.TrimPrefix(req.URL.Path, r.from.Path)
+	req.URL.RawPath = ""
+	req.Host = ""
+	return r.base.RoundTrip(req)
+}
@@ -0,0 +1,89 @@
+// internal/httpclient/factory_test.go
+package httpclient
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/moos3/sparta/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClientRebasesToConfiguredBaseURL(t *testing.T) {
+	var path, agent string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path, agent = r.URL.Path, r.Header.Get("User-Agent")
+	}))
+	defer srv.Close()
+
+	cfg := &config.Config{}
+	cfg.Shodan.BaseURL = srv.URL + "/mock/"
+	f, err := New(cfg)
+	if !assert.NoError(t, err) {
+		return
+	}
+	resp, err := f.Client("shodan").Get("https://api.shodan.io/shodan/host/1.2.3.4")
+	if !assert.NoError(t, err) {
+		return
+	}
+	resp.Body.Close()
+	assert.Equal(t, "/mock/shodan/host/1.2.3.4", path)
+	assert.Equal(t, DefaultUserAgent, agent)
+}
+
+func TestClientSetsConfiguredUserAgent(t *testing.T) {
+	var agent string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		agent = r.Header.Get("User-Agent")
+	}))
+	defer srv.Close()
+
+	cfg := &config.Config{}
+	cfg.HTTP.UserAgent = "acme-scanner/2.0"
+	f, err := New(cfg)
+	if !assert.NoError(t, err) {
+		return
+	}
+	resp, err := f.Client("otx").Get(srv.URL)
+	if !assert.NoError(t, err) {
+		return
+	}
+	resp.Body.Close()
+	assert.Equal(t, "acme-scanner/2.0", agent)
+}
+
+func TestNewRejectsBadSettings(t *testing.T) {
+	cfg := &config.Config{}
+	cfg.HTTP.Proxy = "ftp://proxy.internal:21"
+	_, err := New(cfg)
+	assert.ErrorContains(t, err, "unsupported proxy scheme")
+
+	cfg = &config.Config{}
+	cfg.HTTP.CABundle = filepath.Join(t.TempDir(), "missing.pem")
+	_, err = New(cfg)
+	assert.ErrorContains(t, err, "failed to read CA bundle")
+}
+
+func TestTimeout(t *testing.T) {
+	f, _ := New(nil)
+	assert.Equal(t, DefaultTimeout, f.Timeout("otx"))
+
+	cfg := &config.Config{}
+	cfg.HTTP.Timeout = 30
+	cfg.HTTP.Timeouts = map[string]int{"crtsh": 60}
+	f, _ = New(cfg)
+	assert.Equal(t, 30*time.Second, f.Timeout("otx"))
+	assert.Equal(t, time.Minute, f.Timeout("crtsh"))
+}
+
+func TestBaseURL(t *testing.T) {
+	assert.Equal(t, "https://otx.alienvault.com/api/v1", BaseURL(nil, "otx"))
+
+	cfg := &config.Config{}
+	cfg.CrtSh.BaseURL = "http://localhost:8080/"
+	assert.Equal(t, "http://localhost:8080", BaseURL(cfg, "crtsh"))
+	assert.Equal(t, "https://api.shodan.io", BaseURL(cfg, "shodan"))
+}
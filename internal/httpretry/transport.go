@@ -0,0 +1,235 @@
+// internal/httpretry/transport.go
+package httpretry
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/moos3/sparta/internal/config"
+)
+
+// Policy bounds how a request is retried.
+type Policy struct {
+	MaxAttempts int           // including the first request
+	BaseDelay   time.Duration // backoff before the second attempt, doubled after each
+	MaxDelay    time.Duration // cap on a single backoff
+	Budget      time.Duration // total time across every attempt and wait
+}
+
+// DefaultPolicy is used for providers without configured retries.
+var DefaultPolicy = Policy{
+	MaxAttempts: 4,
+	BaseDelay:   500 * time.Millisecond,
+	MaxDelay:    10 * time.Second,
+	Budget:      20 * time.Second,
+}
+
+// PolicyFor returns the retry policy configured for provider, falling back
+// to DefaultPolicy for unset fields or a nil cfg.
+func PolicyFor(cfg *config.Config, provider string) Policy {
+	policy := DefaultPolicy
+	if cfg == nil {
+		return policy
+	}
+	c := cfg.RetryPolicyFor(provider)
+	if c.MaxAttempts > 0 {
+		policy.MaxAttempts = c.MaxAttempts
+	}
+	if c.BaseDelay > 0 {
+		policy.BaseDelay = time.Duration(c.BaseDelay) * time.Millisecond
+	}
+	if c.MaxDelay > 0 {
+		policy.MaxDelay = time.Duration(c.MaxDelay) * time.Millisecond
+	}
+	if c.Budget > 0 {
+		policy.Budget = time.Duration(c.Budget) * time.Second
+	}
+	return policy
+}
+
+// UnavailableError is returned when a provider still throttles or fails a
+// request once the retry policy is exhausted. It says nothing about the
+// domain being scanned, so results should not be scored on it.
+type UnavailableError struct {
+	Provider   string
+	Attempts   int
+	StatusCode int   // last status received, zero if the last attempt failed outright
+	Err        error // last transport error, if any
+}
+
+func (e *UnavailableError) Error() string {
+	if e.StatusCode != 0 {
+		return fmt.Sprintf("provider %s unavailable after %d attempts: status %d", e.Provider, e.Attempts, e.StatusCode)
+	}
+	return fmt.Sprintf("provider %s unavailable after %d attempts: %v", e.Provider, e.Attempts, e.Err)
+}
+
+func (e *UnavailableError) Unwrap() error {
+	return e.Err
+}
+
+// IsUnavailable reports whether err, or an error it wraps, is an UnavailableError.
+func IsUnavailable(err error) bool {
+	var unavailable *UnavailableError
+	return errors.As(err, &unavailable)
+}
+
+// Transport retries requests that fail in transit or come back 429, 502, 503
+// or 504, with exponential backoff and full jitter. A Retry-After header on a
+// 429 or 503 replaces the backoff; if it asks for longer than the budget has
+// left the request gives up straight away. Requests whose body cannot be
+// replayed are sent once.
+type Transport struct {
+	Provider       string
+	Policy         Policy
+	AttemptTimeout time.Duration     // limit on each attempt; zero leaves it to the request context
+	Base           http.RoundTripper // http.DefaultTransport if nil
+
+	now    func() time.Time
+	jitter func(max time.Duration) time.Duration
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	base := t.Base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	now := t.now
+	if now == nil {
+		now = time.Now
+	}
+	jitter := t.jitter
+	if jitter == nil {
+		jitter = func(max time.Duration) time.Duration { return time.Duration(rand.Int63n(int64(max) + 1)) }
+	}
+	maxAttempts := t.Policy.MaxAttempts
+	if maxAttempts < 1 || (req.Body != nil && req.Body != http.NoBody && req.GetBody == nil) {
+		maxAttempts = 1
+	}
+
+	ctx := req.Context()
+	deadline := now().Add(t.Policy.Budget)
+	for attempt := 1; ; attempt++ {
+		resp, err := t.attempt(base, req, attempt)
+		if ctx.Err() != nil {
+			if resp != nil {
+				resp.Body.Close()
+			}
+			return nil, ctx.Err()
+		}
+		if err == nil && !retryable(resp.StatusCode) {
+			return resp, nil
+		}
+
+		unavailable := &UnavailableError{Provider: t.Provider, Attempts: attempt, Err: err}
+		wait := backoff(t.Policy, attempt, jitter)
+		if resp != nil {
+			unavailable.StatusCode = resp.StatusCode
+			if after, ok := retryAfter(resp, now()); ok {
+				wait = after
+			}
+			io.Copy(io.Discard, io.LimitReader(resp.Body, 4096))
+			resp.Body.Close()
+		}
+		if attempt >= maxAttempts || now().Add(wait).After(deadline) {
+			return nil, unavailable
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// attempt sends one try of req, replaying its body after the first.
+func (t *Transport) attempt(base http.RoundTripper, req *http.Request, n int) (*http.Response, error) {
+	ctx, cancel := req.Context(), context.CancelFunc(func() {})
+	if t.AttemptTimeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, t.AttemptTimeout)
+	}
+	try := req.Clone(ctx)
+	if n > 1 && req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			cancel()
+			return nil, err
+		}
+		try.Body = body
+	}
+	resp, err := base.RoundTrip(try)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	// Keep the attempt's context alive until the caller has read the body
+	resp.Body = &cancelOnClose{ReadCloser: resp.Body, cancel: cancel}
+	return resp, nil
+}
+
+type cancelOnClose struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b *cancelOnClose) Close() error {
+	err := b.ReadCloser.Close()
+	b.cancel()
+	return err
+}
+
+func retryable(status int) bool {
+	switch status {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+// backoff returns a random wait of up to BaseDelay doubled for each attempt
+// so far, capped at MaxDelay.
+func backoff(policy Policy, attempt int, jitter func(time.Duration) time.Duration) time.Duration {
+	limit := policy.BaseDelay
+	for i := 1; i < attempt && limit < policy.MaxDelay; i++ {
+		limit *= 2
+	}
+	if policy.MaxDelay > 0 && limit > policy.MaxDelay {
+		limit = policy.MaxDelay
+	}
+	if limit <= 0 {
+		return 0
+	}
+	return jitter(limit)
+}
+
+// retryAfter reads the Retry-After header of a 429 or 503, given in seconds
+// or as an HTTP date.
+func retryAfter(resp *http.Response, now time.Time) (time.Duration, bool) {
+	if resp.StatusCode != http.StatusTooManyRequests && resp.StatusCode != http.StatusServiceUnavailable {
+		return 0, false
+	}
+	value := resp.Header.Get("Retry-After")
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if at, err := http.ParseTime(value); err == nil {
+		if wait := at.Sub(now); wait > 0 {
+			return wait, true
+		}
+		return 0, true
+	}
+	return 0, false
+}
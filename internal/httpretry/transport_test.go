@@ -0,0 +1,169 @@
+// internal/httpretry/transport_test.go
+package httpretry
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/moos3/sparta/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func testClient(policy Policy) *http.Client {
+	return &http.Client{Transport: &Transport{
+		Provider: "test",
+		Policy:   policy,
+		jitter:   func(max time.Duration) time.Duration { return max },
+	}}
+}
+
+var fastPolicy = Policy{MaxAttempts: 3, BaseDelay: time.Millisecond, MaxDelay: 5 * time.Millisecond, Budget: time.Second}
+
+func TestRetriesUntilSuccess(t *testing.T) {
+	var calls int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		assert.Equal(t, "payload", string(body))
+		if atomic.AddInt32(&calls, 1) < 3 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		w.Write([]byte("ok"))
+	}))
+	defer srv.Close()
+
+	resp, err := testClient(fastPolicy).Post(srv.URL, "text/plain", strings.NewReader("payload"))
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer resp.Body.Close()
+	body, _ := io.ReadAll(resp.Body)
+	assert.Equal(t, "ok", string(body))
+	assert.Equal(t, int32(3), atomic.LoadInt32(&calls))
+}
+
+func TestDoesNotRetryClientErrors(t *testing.T) {
+	var calls int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer srv.Close()
+
+	resp, err := testClient(fastPolicy).Get(srv.URL)
+	if assert.NoError(t, err) {
+		resp.Body.Close()
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	}
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+}
+
+func TestUnavailableAfterMaxAttempts(t *testing.T) {
+	var calls int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer srv.Close()
+
+	_, err := testClient(fastPolicy).Get(srv.URL)
+	assert.True(t, IsUnavailable(err))
+	var unavailable *UnavailableError
+	if assert.True(t, errors.As(err, &unavailable)) {
+		assert.Equal(t, 3, unavailable.Attempts)
+		assert.Equal(t, http.StatusTooManyRequests, unavailable.StatusCode)
+	}
+	assert.Equal(t, int32(3), atomic.LoadInt32(&calls))
+}
+
+func TestRetryAfter(t *testing.T) {
+	t.Run("Honoured", func(t *testing.T) {
+		var calls int32
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if atomic.AddInt32(&calls, 1) == 1 {
+				w.Header().Set("Retry-After", "1")
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+		}))
+		defer srv.Close()
+
+		start := time.Now()
+		resp, err := testClient(Policy{MaxAttempts: 2, BaseDelay: time.Millisecond, Budget: 5 * time.Second}).Get(srv.URL)
+		if assert.NoError(t, err) {
+			resp.Body.Close()
+		}
+		assert.GreaterOrEqual(t, time.Since(start), time.Second)
+	})
+
+	t.Run("BeyondBudgetGivesUp", func(t *testing.T) {
+		var calls int32
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&calls, 1)
+			w.Header().Set("Retry-After", "3600")
+			w.WriteHeader(http.StatusTooManyRequests)
+		}))
+		defer srv.Close()
+
+		start := time.Now()
+		_, err := testClient(fastPolicy).Get(srv.URL)
+		assert.True(t, IsUnavailable(err))
+		assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+		assert.Less(t, time.Since(start), time.Second)
+	})
+
+	t.Run("HTTPDate", func(t *testing.T) {
+		now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+		resp := &http.Response{StatusCode: http.StatusTooManyRequests, Header: http.Header{}}
+		resp.Header.Set("Retry-After", now.Add(90*time.Second).Format(http.TimeFormat))
+		wait, ok := retryAfter(resp, now)
+		assert.True(t, ok)
+		assert.Equal(t, 90*time.Second, wait)
+	})
+}
+
+func TestContextCancelStopsRetrying(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer srv.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	req, _ := http.NewRequestWithContext(ctx, "GET", srv.URL, nil)
+	_, err := testClient(Policy{MaxAttempts: 10, BaseDelay: time.Second, MaxDelay: time.Second, Budget: time.Minute}).Do(req)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.False(t, IsUnavailable(err))
+}
+
+func TestBackoff(t *testing.T) {
+	policy := Policy{BaseDelay: 100 * time.Millisecond, MaxDelay: time.Second}
+	full := func(max time.Duration) time.Duration { return max }
+	assert.Equal(t, 100*time.Millisecond, backoff(policy, 1, full))
+	assert.Equal(t, 400*time.Millisecond, backoff(policy, 3, full))
+	assert.Equal(t, time.Second, backoff(policy, 10, full))
+}
+
+func TestPolicyFor(t *testing.T) {
+	cfg := &config.Config{}
+	cfg.Retry.MaxAttempts = 5
+	cfg.Retry.Budget = 60
+	cfg.Retry.Providers = map[string]config.RetryPolicy{"shodan": {MaxAttempts: 2, BaseDelay: 2000}}
+
+	shodan := PolicyFor(cfg, "shodan")
+	assert.Equal(t, 2, shodan.MaxAttempts)
+	assert.Equal(t, 2*time.Second, shodan.BaseDelay)
+	assert.Equal(t, time.Minute, shodan.Budget)
+	assert.Equal(t, DefaultPolicy.MaxDelay, shodan.MaxDelay)
+
+	assert.Equal(t, 5, PolicyFor(cfg, "otx").MaxAttempts)
+	assert.Equal(t, DefaultPolicy, PolicyFor(nil, "otx"))
+}
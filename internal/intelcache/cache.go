@@ -0,0 +1,175 @@
+// internal/intelcache/cache.go
+package intelcache
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/moos3/sparta/internal/config"
+	"github.com/moos3/sparta/internal/db"
+)
+
+// Key identifies one lookup against an intelligence provider.
+type Key struct {
+	Provider string // e.g. otx, shodan, chaos
+	Endpoint string // the provider API queried
+	Query    string // what was looked up, such as a domain or IP
+}
+
+// Cache keeps provider responses in Postgres so repeated scans of a domain
+// do not spend API quota on data that changes slowly. Responses expire after
+// the provider's TTL. A nil Cache, or one without a database, caches nothing.
+type Cache struct {
+	db  db.Database
+	cfg *config.Config
+	now func() time.Time
+}
+
+// New creates a Cache over database with the TTLs under intel_cache in cfg.
+func New(database db.Database, cfg *config.Config) *Cache {
+	return &Cache{db: database, cfg: cfg, now: time.Now}
+}
+
+// TTL returns how long responses from provider are served from the cache;
+// zero means they are not cached.
+func (c *Cache) TTL(provider string) time.Duration {
+	if c == nil || c.db == nil || c.cfg == nil {
+		return 0
+	}
+	seconds := c.cfg.IntelCache.TTL
+	if override, ok := c.cfg.IntelCache.Providers[provider]; ok {
+		seconds = override
+	}
+	if seconds <= 0 {
+		return 0
+	}
+	return time.Duration(seconds) * time.Second
+}
+
+// Get decodes the cached response for key into dest, reporting when it was
+// fetched. ok is false if there is no response younger than the TTL.
+func (c *Cache) Get(key Key, dest interface{}) (fetchedAt time.Time, ok bool, err error) {
+	ttl := c.TTL(key.Provider)
+	if ttl == 0 {
+		return time.Time{}, false, nil
+	}
+	var response []byte
+	query := `
+		SELECT response, fetched_at FROM intel_cache
+		WHERE provider = $1 AND endpoint = $2 AND query = $3 AND fetched_at > $4
+	`
+	err = c.db.QueryRow(query, key.Provider, key.Endpoint, key.Query, c.now().Add(-ttl)).Scan(&response, &fetchedAt)
+	if err == sql.ErrNoRows {
+		return time.Time{}, false, nil
+	}
+	if err != nil {
+		return time.Time{}, false, fmt.Errorf("failed to read cached %s response: %w", key.Provider, err)
+	}
+	if err := json.Unmarshal(response, dest); err != nil {
+		return time.Time{}, false, fmt.Errorf("failed to decode cached %s response: %w", key.Provider, err)
+	}
+	return fetchedAt, true, nil
+}
+
+// Put stores value as the response for key, replacing any earlier one.
+func (c *Cache) Put(key Key, value interface{}) error {
+	if c.TTL(key.Provider) == 0 {
+		return nil
+	}
+	response, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Errorf("failed to encode %s response: %w", key.Provider, err)
+	}
+	query := `
+		INSERT INTO intel_cache (provider, endpoint, query, response, fetched_at)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (provider, endpoint, query) DO UPDATE SET response = EXCLUDED.response, fetched_at = EXCLUDED.fetched_at
+	`
+	if _, err := c.db.Exec(query, key.Provider, key.Endpoint, key.Query, string(response), c.now()); err != nil {
+		return fmt.Errorf("failed to cache %s response: %w", key.Provider, err)
+	}
+	return nil
+}
+
+// Fetch returns the cached response for key, or calls fetch and caches what
+// it returns. Unless ctx asks for a refresh, a cached response is used while
+// it is younger than the provider's TTL. Failed fetches are not cached, and
+// cache errors are logged rather than failing the lookup. usage, if not nil,
+// records where the response came from.
+func Fetch[T any](ctx context.Context, c *Cache, key Key, usage *Usage, fetch func() (T, error)) (T, error) {
+	if !Refresh(ctx) {
+		var cached T
+		fetchedAt, ok, err := c.Get(key, &cached)
+		if err != nil {
+			log.Printf("%v", err)
+		}
+		if ok {
+			usage.hit(fetchedAt)
+			return cached, nil
+		}
+	}
+	value, err := fetch()
+	if err != nil {
+		return value, err
+	}
+	usage.miss()
+	if err := c.Put(key, value); err != nil {
+		log.Printf("%v", err)
+	}
+	return value, nil
+}
+
+// Usage tallies where the lookups behind one scan result came from.
+type Usage struct {
+	Hits   int       // lookups served from the cache
+	Misses int       // lookups sent to the provider
+	Oldest time.Time // when the oldest cached response used was fetched
+}
+
+func (u *Usage) hit(fetchedAt time.Time) {
+	if u == nil {
+		return
+	}
+	u.Hits++
+	if u.Oldest.IsZero() || fetchedAt.Before(u.Oldest) {
+		u.Oldest = fetchedAt
+	}
+}
+
+func (u *Usage) miss() {
+	if u != nil {
+		u.Misses++
+	}
+}
+
+// Cached reports whether any lookup was served from the cache.
+func (u *Usage) Cached() bool {
+	return u.Hits > 0
+}
+
+// AgeSeconds returns the age of the oldest cached response used, or zero if
+// every lookup went to the provider.
+func (u *Usage) AgeSeconds() int64 {
+	if u.Oldest.IsZero() {
+		return 0
+	}
+	return int64(time.Since(u.Oldest) / time.Second)
+}
+
+type refreshKey struct{}
+
+// WithRefresh returns a context whose lookups bypass cached responses. Fresh
+// responses are still cached for later scans.
+func WithRefresh(ctx context.Context) context.Context {
+	return context.WithValue(ctx, refreshKey{}, true)
+}
+
+// Refresh reports whether ctx asks for cached responses to be bypassed.
+func Refresh(ctx context.Context) bool {
+	refresh, _ := ctx.Value(refreshKey{}).(bool)
+	return refresh
+}
@@ -0,0 +1,105 @@
+// internal/intelcache/cache_test.go
+package intelcache
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/moos3/sparta/internal/config"
+	"github.com/moos3/sparta/internal/testutils"
+	"github.com/stretchr/testify/assert"
+)
+
+func testConfig() *config.Config {
+	cfg := &config.Config{}
+	cfg.IntelCache.TTL = 3600
+	cfg.IntelCache.Providers = map[string]int{"chaos": 0, "shodan": 60}
+	return cfg
+}
+
+var key = Key{Provider: "otx", Endpoint: "domain/general", Query: "example.com"}
+
+func TestTTL(t *testing.T) {
+	c := New(testutils.NewStubDB(), testConfig())
+	assert.Equal(t, time.Hour, c.TTL("otx"))
+	assert.Equal(t, time.Minute, c.TTL("shodan"))
+	assert.Zero(t, c.TTL("chaos"))
+	assert.Zero(t, (*Cache)(nil).TTL("otx"))
+	assert.Zero(t, New(nil, testConfig()).TTL("otx"))
+}
+
+func TestFetch(t *testing.T) {
+	now := time.Now()
+
+	t.Run("Hit", func(t *testing.T) {
+		stubDb := testutils.NewStubDB()
+		get := stubDb.Expect("FROM intel_cache").WillReturnRows([]string{"response", "fetched_at"},
+			[]driver.Value{[]byte(`{"pulses":3}`), now.Add(-10 * time.Minute)})
+
+		var usage Usage
+		got, err := Fetch(context.Background(), New(stubDb, testConfig()), key, &usage, func() (map[string]int, error) {
+			t.Fatal("fetch called on a cache hit")
+			return nil, nil
+		})
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.Equal(t, 3, got["pulses"])
+		assert.Equal(t, "otx", get.Args()[0])
+		assert.True(t, usage.Cached())
+		assert.InDelta(t, 600, usage.AgeSeconds(), 5)
+	})
+
+	t.Run("MissIsCached", func(t *testing.T) {
+		stubDb := testutils.NewStubDB()
+		stubDb.Expect("FROM intel_cache").WillReturnRows([]string{"response", "fetched_at"})
+		put := stubDb.Expect("INSERT INTO intel_cache").WillReturnResult(1)
+
+		var usage Usage
+		got, err := Fetch(context.Background(), New(stubDb, testConfig()), key, &usage, func() (map[string]int, error) {
+			return map[string]int{"pulses": 1}, nil
+		})
+		if assert.NoError(t, err) {
+			assert.Equal(t, 1, got["pulses"])
+			assert.Equal(t, `{"pulses":1}`, put.Args()[3])
+			assert.False(t, usage.Cached())
+			assert.NoError(t, stubDb.ExpectationsWereMet())
+		}
+	})
+
+	t.Run("RefreshBypassesCache", func(t *testing.T) {
+		stubDb := testutils.NewStubDB()
+		stubDb.Expect("INSERT INTO intel_cache").WillReturnResult(1)
+
+		calls := 0
+		_, err := Fetch(WithRefresh(context.Background()), New(stubDb, testConfig()), key, nil, func() (int, error) {
+			calls++
+			return 1, nil
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, 1, calls)
+		assert.NoError(t, stubDb.ExpectationsWereMet())
+	})
+
+	t.Run("ErrorsAreNotCached", func(t *testing.T) {
+		stubDb := testutils.NewStubDB()
+		stubDb.Expect("FROM intel_cache").WillReturnRows([]string{"response", "fetched_at"})
+
+		_, err := Fetch(context.Background(), New(stubDb, testConfig()), key, nil, func() (int, error) {
+			return 0, errors.New("status 500")
+		})
+		assert.EqualError(t, err, "status 500")
+		assert.NoError(t, stubDb.ExpectationsWereMet())
+	})
+
+	t.Run("Disabled", func(t *testing.T) {
+		got, err := Fetch(context.Background(), New(testutils.NewStubDB(), testConfig()), Key{Provider: "chaos"}, nil, func() (int, error) {
+			return 7, nil
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, 7, got)
+	})
+}
@@ -2,101 +2,147 @@ package interfaces
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"github.com/moos3/sparta/internal/config"
 	"github.com/moos3/sparta/internal/db"
 	"github.com/moos3/sparta/proto"
+	protobuf "google.golang.org/protobuf/proto"
 )
 
-// Plugin defines the base interface for all scan plugins.
-type Plugin interface {
-	Initialize() error
-	Name() string
-	SetDatabase(db db.Database)
-}
-
-// Generic Plugin interface for server-side usage
+// GenericPlugin is the contract every scan plugin implements.
 type GenericPlugin interface {
 	Initialize() error
-	Scan(ctx context.Context, domain string, options string) (interface{}, error)
+	Scan(ctx context.Context, req ScanRequest) (*ScanResult, error)
 	SetDatabase(db db.Database)
 	SetConfig(config *config.Config) error
 }
 
-type DNSScanPlugin interface {
-	Plugin
-	ScanDomain(domain string) (proto.DNSSecurityResult, error)
-	InsertDNSScanResult(domain string, result proto.DNSSecurityResult) (string, error)
-	GetDNSScanResultsByDomain(domain string) ([]DNSScanResult, error)
-	GetDNSScanResultByID(id string) (DNSScanResult, error)
+// HealthChecker is implemented by plugins that can tell whether they are
+// able to scan right now, such as external plugins whose process may have
+// exited.
+type HealthChecker interface {
+	Health(ctx context.Context) error
+}
+
+// Artifact names a kind of data one plugin produces and another consumes.
+type Artifact string
+
+const (
+	ArtifactIPs        Artifact = "ips"        // resolved A and AAAA addresses
+	ArtifactMX         Artifact = "mx"         // mail exchanger hostnames
+	ArtifactNS         Artifact = "ns"         // name server hostnames
+	ArtifactSubdomains Artifact = "subdomains" // hostnames under the scanned domain
+)
+
+// ScanRequest describes a single plugin invocation.
+type ScanRequest struct {
+	Domain   string
+	RunID    string                // ID of the scan run the result is stored under
+	ParentID string                // ID of the DNS scan result this scan is attached to, if any
+	Options  map[string]string     // plugin-specific options
+	Inputs   map[Artifact][]string // artifacts produced by the plugins this one depends on
+	Deadline time.Time             // zero means no deadline beyond the caller's context
+}
+
+// Input returns the values of an artifact passed to this scan, or nil if no
+// plugin produced it.
+func (r ScanRequest) Input(kind Artifact) []string {
+	return r.Inputs[kind]
+}
+
+// Context returns ctx bounded by the request deadline, if one is set.
+func (r ScanRequest) Context(ctx context.Context) (context.Context, context.CancelFunc) {
+	if r.Deadline.IsZero() {
+		return context.WithCancel(ctx)
+	}
+	return context.WithDeadline(ctx, r.Deadline)
 }
 
-type TLSScanPlugin interface {
-	Plugin
-	ScanTLS(domain, dnsScanID string) (proto.TLSSecurityResult, error)
-	InsertTLSScanResult(domain, dnsScanID string, result proto.TLSSecurityResult) (string, error)
-	GetTLSScanResultsByDomain(domain string) ([]TLSScanResult, error)
+// ScanStatus is the outcome of a plugin invocation.
+type ScanStatus string
+
+const (
+	ScanStatusSucceeded ScanStatus = "succeeded"
+	ScanStatusFailed    ScanStatus = "failed"
+	ScanStatusSkipped   ScanStatus = "skipped"
+)
+
+// ScanResult is the envelope a plugin returns from Scan.
+type ScanResult struct {
+	Plugin     string
+	ID         string // ID of the stored result, empty if it was not stored
+	Status     ScanStatus
+	Result     protobuf.Message
+	Errors     []string
+	TimedOut   bool                  // the plugin did not finish before its deadline
+	Outputs    map[Artifact][]string // artifacts for plugins that depend on this one
+	StartedAt  time.Time
+	FinishedAt time.Time
 }
 
-type CrtShScanPlugin interface {
-	Plugin
-	ScanCrtSh(domain, dnsScanID string) (proto.CrtShSecurityResult, error)
-	InsertCrtShScanResult(domain, dnsScanID string, result proto.CrtShSecurityResult) (string, error)
-	GetCrtShScanResultsByDomain(domain string) ([]CrtShScanResult, error)
+// NewScanResult starts a result envelope for the named plugin.
+func NewScanResult(plugin string) *ScanResult {
+	return &ScanResult{Plugin: plugin, StartedAt: time.Now()}
 }
 
-type ChaosScanPlugin interface {
-	Plugin
-	ScanChaos(ctx context.Context, domain, dnsScanID string) (proto.ChaosSecurityResult, error)
-	InsertChaosScanResult(domain, dnsScanID string, result proto.ChaosSecurityResult) (string, error)
-	GetChaosScanResultsByDomain(domain string) ([]ChaosScanResult, error)
-	SetConfig(cfg *config.Config)
+// Succeed records a successful scan and returns the envelope.
+func (r *ScanResult) Succeed(id string, result protobuf.Message) *ScanResult {
+	r.ID = id
+	r.Result = result
+	r.Status = ScanStatusSucceeded
+	r.FinishedAt = time.Now()
+	return r
 }
 
-type ShodanScanPlugin interface {
-	Plugin
-	ScanShodan(domain, dnsScanID string) (proto.ShodanSecurityResult, error)
-	InsertShodanScanResult(domain, dnsScanID string, result proto.ShodanSecurityResult) (string, error)
-	GetShodanScanResultsByDomain(domain string) ([]ShodanScanResult, error)
-	SetConfig(cfg *config.Config)
+// Fail records a failed scan and returns the envelope.
+func (r *ScanResult) Fail(err error) *ScanResult {
+	r.Errors = append(r.Errors, err.Error())
+	r.Status = ScanStatusFailed
+	r.FinishedAt = time.Now()
+	return r
 }
 
-type OTXScanPlugin interface {
-	Plugin
-	ScanOTX(domain, dnsScanID string) (proto.OTXSecurityResult, error)
-	InsertOTXScanResult(domain, dnsScanID string, result proto.OTXSecurityResult) (string, error)
-	GetOTXScanResultsByDomain(domain string) ([]OTXScanResult, error)
-	SetConfig(cfg *config.Config)
+// TimeOut records a scan that did not finish within timeout and returns the envelope.
+func (r *ScanResult) TimeOut(timeout time.Duration) *ScanResult {
+	r.Errors = append(r.Errors, fmt.Sprintf("%s timed out after %s", r.Plugin, timeout))
+	r.TimedOut = true
+	r.Status = ScanStatusFailed
+	r.FinishedAt = time.Now()
+	return r
 }
 
-type WhoisScanPlugin interface {
-	Plugin
-	ScanWhois(domain, dnsScanID string) (proto.WhoisSecurityResult, error)
-	InsertWhoisScanResult(domain, dnsScanID string, result proto.WhoisSecurityResult) (string, error)
-	GetWhoisScanResultsByDomain(domain string) ([]WhoisScanResult, error)
+// Skip records a scan that was not attempted and returns the envelope.
+func (r *ScanResult) Skip(reason string) *ScanResult {
+	r.Errors = append(r.Errors, reason)
+	r.Status = ScanStatusSkipped
+	r.FinishedAt = time.Now()
+	return r
 }
 
-type AbuseChScanPlugin interface {
-	Plugin
-	ScanAbuseCh(domain, dnsScanID string) (proto.AbuseChSecurityResult, error)
-	InsertAbuseChScanResult(domain, dnsScanID string, result proto.AbuseChSecurityResult) (string, error)
-	GetAbuseChScanResultsByDomain(domain string) ([]AbuseChScanResult, error)
+// Produce adds values of an artifact to the result and returns the envelope.
+func (r *ScanResult) Produce(kind Artifact, values ...string) *ScanResult {
+	if len(values) == 0 {
+		return r
+	}
+	if r.Outputs == nil {
+		r.Outputs = make(map[Artifact][]string)
+	}
+	r.Outputs[kind] = append(r.Outputs[kind], values...)
+	return r
 }
 
-type ISCScanPlugin interface {
-	Plugin
-	ScanISC(ctx context.Context, domain, dnsScanID string) (*proto.ISCSecurityResult, error)
-	InsertISCScanResult(domain, dnsScanID string, result *proto.ISCSecurityResult) (string, error)
-	GetISCScanResultsByDomain(domain string) ([]ISCScanResult, error)
-	SetConfig(cfg *config.Config) // ISC plugin also needs config for API key/URL
+// Duration returns how long the scan took.
+func (r *ScanResult) Duration() time.Duration {
+	return r.FinishedAt.Sub(r.StartedAt)
 }
 
 type DNSScanResult struct {
 	ID        string
 	Domain    string
 	DNSScanID string
-	Result    proto.DNSSecurityResult
+	Result    *proto.DNSSecurityResult
 	CreatedAt time.Time
 }
 
@@ -104,7 +150,7 @@ type TLSScanResult struct {
 	ID        string
 	Domain    string
 	DNSScanID string
-	Result    proto.TLSSecurityResult
+	Result    *proto.TLSSecurityResult
 	CreatedAt time.Time
 }
 
@@ -112,7 +158,7 @@ type CrtShScanResult struct {
 	ID        string
 	Domain    string
 	DNSScanID string
-	Result    proto.CrtShSecurityResult
+	Result    *proto.CrtShSecurityResult
 	CreatedAt time.Time
 }
 
@@ -120,7 +166,7 @@ type ChaosScanResult struct {
 	ID        string
 	Domain    string
 	DNSScanID string
-	Result    proto.ChaosSecurityResult
+	Result    *proto.ChaosSecurityResult
 	CreatedAt time.Time
 }
 
@@ -128,7 +174,7 @@ type ShodanScanResult struct {
 	ID        string
 	Domain    string
 	DNSScanID string
-	Result    proto.ShodanSecurityResult
+	Result    *proto.ShodanSecurityResult
 	CreatedAt time.Time
 }
 
@@ -136,7 +182,7 @@ type OTXScanResult struct {
 	ID        string
 	Domain    string
 	DNSScanID string
-	Result    proto.OTXSecurityResult
+	Result    *proto.OTXSecurityResult
 	CreatedAt time.Time
 }
 
@@ -144,7 +190,7 @@ type WhoisScanResult struct {
 	ID        string
 	Domain    string
 	DNSScanID string
-	Result    proto.WhoisSecurityResult
+	Result    *proto.WhoisSecurityResult
 	CreatedAt time.Time
 }
 
@@ -152,7 +198,7 @@ type AbuseChScanResult struct {
 	ID        string
 	Domain    string
 	DNSScanID string
-	Result    proto.AbuseChSecurityResult
+	Result    *proto.AbuseChSecurityResult
 	CreatedAt time.Time
 }
 
@@ -160,6 +206,6 @@ type ISCScanResult struct {
 	ID        string
 	Domain    string
 	DNSScanID string
-	Result    proto.ISCSecurityResult
+	Result    *proto.ISCSecurityResult
 	CreatedAt time.Time
 }
@@ -0,0 +1,162 @@
+// internal/jobs/batch.go
+package jobs
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+// batchInsertSize is the number of jobs inserted per statement, keeping
+// each statement well under Postgres's limit on bind parameters.
+const batchInsertSize = 500
+
+// BatchDomain is one domain of a bulk scan along with its tags.
+type BatchDomain struct {
+	Domain string
+	Tags   []string
+}
+
+// Batch is a bulk scan: a group of jobs submitted together, one per domain.
+type Batch struct {
+	ID        string
+	UserID    string
+	Total     int
+	Counts    map[Status]int // jobs in each status
+	CreatedAt time.Time
+	Summary   BatchSummary
+}
+
+// Done reports whether every job of the batch has finished.
+func (b *Batch) Done() bool {
+	return b.Counts[StatusQueued] == 0 && b.Counts[StatusRunning] == 0
+}
+
+// BatchSummary aggregates the reports and failures of a batch's finished jobs.
+type BatchSummary struct {
+	Reports      int            // jobs that produced a report
+	AverageScore float64        // over Reports
+	MinScore     int            // over Reports
+	MaxScore     int            // over Reports
+	RiskTiers    map[string]int // reports in each risk tier
+	Failed       []*Job         // failed jobs, ordered by domain
+}
+
+// SubmitBatch queues one job per domain for userID, each running the given
+// plugins under the named scan profile, and returns the batch grouping them.
+func (s *Store) SubmitBatch(userID, profile string, domains []BatchDomain, plugins []string) (*Batch, error) {
+	batch := &Batch{
+		ID:        uuid.New().String(),
+		UserID:    userID,
+		Total:     len(domains),
+		Counts:    map[Status]int{StatusQueued: len(domains)},
+		CreatedAt: time.Now(),
+	}
+	query := `INSERT INTO scan_batches (id, user_id, total, created_at) VALUES ($1, $2, $3, $4)`
+	if _, err := s.db.Exec(query, batch.ID, userID, batch.Total, batch.CreatedAt); err != nil {
+		return nil, fmt.Errorf("failed to insert scan batch: %w", err)
+	}
+
+	for start := 0; start < len(domains); start += batchInsertSize {
+		chunk := domains[start:min(start+batchInsertSize, len(domains))]
+		ids := make([]string, len(chunk))
+		values := make([]string, len(chunk))
+		args := []interface{}{userID, batch.ID, batch.CreatedAt, profile}
+		for i, d := range chunk {
+			ids[i] = uuid.New().String()
+			n := len(args)
+			values[i] = fmt.Sprintf("($%d, $1, $%d, $%d, $2, $4, 'queued', $3)", n+1, n+2, n+3)
+			args = append(args, ids[i], d.Domain, pq.Array(d.Tags))
+		}
+		query := `INSERT INTO scan_jobs (id, user_id, domain, tags, batch_id, profile, status, created_at) VALUES ` + strings.Join(values, ", ")
+		if _, err := s.db.Exec(query, args...); err != nil {
+			return nil, fmt.Errorf("failed to insert scan jobs for batch %s: %w", batch.ID, err)
+		}
+		query = `
+			INSERT INTO scan_job_plugins (job_id, plugin)
+			SELECT job_id, plugin FROM unnest($1::uuid[]) AS job_id CROSS JOIN unnest($2::text[]) AS plugin
+		`
+		if _, err := s.db.Exec(query, pq.Array(ids), pq.Array(plugins)); err != nil {
+			return nil, fmt.Errorf("failed to insert plugins for batch %s: %w", batch.ID, err)
+		}
+	}
+	return batch, nil
+}
+
+// GetBatch returns a batch with the progress of its jobs and a summary of
+// their results.
+func (s *Store) GetBatch(userID, id string) (*Batch, error) {
+	batch := &Batch{ID: id, Counts: make(map[Status]int)}
+	query := `SELECT user_id, total, created_at FROM scan_batches WHERE id = $1 AND user_id = $2`
+	err := s.db.QueryRow(query, id, userID).Scan(&batch.UserID, &batch.Total, &batch.CreatedAt)
+	if err == sql.ErrNoRows {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get scan batch: %w", err)
+	}
+
+	query = `
+		SELECT j.status, COALESCE(r.risk_tier, ''), COUNT(*), COUNT(r.id),
+			COALESCE(SUM(r.score), 0), COALESCE(MIN(r.score), 0), COALESCE(MAX(r.score), 0)
+		FROM scan_jobs j
+		LEFT JOIN reports r ON r.id = j.report_id
+		WHERE j.batch_id = $1
+		GROUP BY 1, 2
+	`
+	rows, err := s.db.Query(query, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to summarise scan batch: %w", err)
+	}
+	defer rows.Close()
+
+	sum := &batch.Summary
+	sum.RiskTiers = make(map[string]int)
+	var total int
+	for rows.Next() {
+		var status Status
+		var tier string
+		var jobs, reports, scoreSum, minScore, maxScore int
+		if err := rows.Scan(&status, &tier, &jobs, &reports, &scoreSum, &minScore, &maxScore); err != nil {
+			return nil, fmt.Errorf("failed to scan batch summary row: %w", err)
+		}
+		batch.Counts[status] += jobs
+		if reports == 0 {
+			continue
+		}
+		sum.RiskTiers[tier] += reports
+		if sum.Reports == 0 || minScore < sum.MinScore {
+			sum.MinScore = minScore
+		}
+		if maxScore > sum.MaxScore {
+			sum.MaxScore = maxScore
+		}
+		sum.Reports += reports
+		total += scoreSum
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	if sum.Reports > 0 {
+		sum.AverageScore = float64(total) / float64(sum.Reports)
+	}
+
+	query = `SELECT ` + jobColumns + ` FROM scan_jobs WHERE batch_id = $1 AND status = 'failed' ORDER BY domain`
+	failed, err := s.db.Query(query, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list failed jobs of scan batch: %w", err)
+	}
+	defer failed.Close()
+	for failed.Next() {
+		job, err := scanJob(failed)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan job row: %w", err)
+		}
+		sum.Failed = append(sum.Failed, job)
+	}
+	return batch, failed.Err()
+}
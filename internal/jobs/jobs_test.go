@@ -0,0 +1,315 @@
+// internal/jobs/jobs_test.go
+package jobs
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/moos3/sparta/internal/config"
+	"github.com/moos3/sparta/internal/db"
+	"github.com/moos3/sparta/internal/interfaces"
+	"github.com/moos3/sparta/internal/orchestrator"
+	"github.com/moos3/sparta/internal/testutils"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	insertJobQuery    = "INSERT INTO scan_jobs"
+	upsertPluginQuery = "INSERT INTO scan_job_plugins"
+	finishJobQuery    = "UPDATE scan_jobs SET status = $2"
+	jobPluginsQuery   = "FROM scan_job_plugins"
+	createRunQuery    = "INSERT INTO scan_runs"
+	attachRunQuery    = "UPDATE scan_jobs SET scan_run_id = $2"
+	finishRunQuery    = "UPDATE scan_runs SET status = $2"
+)
+
+var pluginCols = []string{"plugin", "status", "result_id", "error", "timed_out", "started_at", "finished_at"}
+
+type stubPlugin struct {
+	id         string
+	err        error
+	subdomains []string
+	calls      int
+}
+
+func (p *stubPlugin) Initialize() error                  { return nil }
+func (p *stubPlugin) SetDatabase(db.Database)            {}
+func (p *stubPlugin) SetConfig(cfg *config.Config) error { return nil }
+
+func (p *stubPlugin) Scan(ctx context.Context, req interfaces.ScanRequest) (*interfaces.ScanResult, error) {
+	p.calls++
+	res := interfaces.NewScanResult("stub")
+	if p.err != nil {
+		return res.Fail(p.err), p.err
+	}
+	return res.Succeed(p.id, nil).Produce(interfaces.ArtifactSubdomains, p.subdomains...), nil
+}
+
+// expectRun expects a worker to record the scan run for a job
+func expectRun(stubDb *testutils.StubDB) {
+	stubDb.Expect(createRunQuery).WillReturnResult(1)
+	stubDb.Expect(attachRunQuery).WillReturnResult(1)
+	stubDb.Expect(finishRunQuery).WillReturnResult(1)
+}
+
+func TestSubmit(t *testing.T) {
+	stubDb := testutils.NewStubDB()
+	insert := stubDb.Expect(insertJobQuery).WillReturnResult(1)
+	stubDb.Expect(upsertPluginQuery).WillReturnResult(1)
+	stubDb.Expect(upsertPluginQuery).WillReturnResult(1)
+
+	job, err := NewStore(stubDb).Submit("user-1", "example.com", "full", false, []string{"ScanDNS", "ScanTLS"})
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, StatusQueued, job.Status)
+	assert.Len(t, job.Plugins, 2)
+	assert.Equal(t, "user-1", insert.Args()[1])
+	assert.NoError(t, stubDb.ExpectationsWereMet())
+}
+
+func TestRequestCancel(t *testing.T) {
+	jobCols := []string{"id", "user_id", "domain", "status", "report_id", "error", "cancel_requested", "created_at", "started_at", "finished_at", "parent_id", "scan_run_id", "batch_id", "tags", "profile", "refresh"}
+
+	t.Run("NotFound", func(t *testing.T) {
+		stubDb := testutils.NewStubDB()
+		stubDb.Expect("UPDATE scan_jobs SET cancel_requested = TRUE").WillReturnRows([]string{"status"})
+		stubDb.Expect("FROM scan_jobs WHERE id = $1 AND user_id = $2").WillReturnRows(jobCols)
+
+		_, err := NewStore(stubDb).RequestCancel("user-1", "job-1")
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+
+	t.Run("AlreadyFinished", func(t *testing.T) {
+		stubDb := testutils.NewStubDB()
+		now := time.Now()
+		stubDb.Expect("UPDATE scan_jobs SET cancel_requested = TRUE").WillReturnRows([]string{"status"})
+		stubDb.Expect("FROM scan_jobs WHERE id = $1 AND user_id = $2").WillReturnRows(jobCols,
+			[]driver.Value{"job-1", "user-1", "example.com", "succeeded", "report-1", "", false, now, now, now, nil, nil, nil, "{}", "full", false})
+		stubDb.Expect("FROM scan_job_plugins").WillReturnRows(pluginCols)
+
+		_, err := NewStore(stubDb).RequestCancel("user-1", "job-1")
+		assert.ErrorIs(t, err, ErrFinished)
+	})
+}
+
+func TestProcess(t *testing.T) {
+	job := &Job{ID: "job-1", UserID: "user-1", Domain: "example.com"}
+
+	t.Run("Succeeded", func(t *testing.T) {
+		stubDb := testutils.NewStubDB()
+		stubDb.Expect(jobPluginsQuery).WillReturnRows(pluginCols)
+		expectRun(stubDb)
+		for i := 0; i < 3; i++ {
+			stubDb.Expect(upsertPluginQuery).WillReturnResult(1)
+		}
+		finish := stubDb.Expect(finishJobQuery).WillReturnResult(1)
+
+		orch := orchestrator.New(map[string]interfaces.GenericPlugin{"ScanDNS": &stubPlugin{id: "dns-1"}}, nil)
+		var finishedRun *orchestrator.Run
+		r := NewRunner(NewStore(stubDb), orch, func(job *Job, run *orchestrator.Run) (string, error) {
+			finishedRun = run
+			return "report-1", nil
+		}, 1)
+		r.HeartbeatInterval = time.Hour
+
+		r.process(context.Background(), job)
+		assert.NoError(t, stubDb.ExpectationsWereMet())
+		assert.Equal(t, "dns-1", finishedRun.DNSScanID)
+		assert.NotEmpty(t, finishedRun.ID)
+		assert.Equal(t, []driver.Value{"job-1", "succeeded", "report-1", "", finish.Args()[4]}, finish.Args())
+	})
+
+	t.Run("DNSFailed", func(t *testing.T) {
+		stubDb := testutils.NewStubDB()
+		stubDb.Expect(jobPluginsQuery).WillReturnRows(pluginCols)
+		expectRun(stubDb)
+		for i := 0; i < 5; i++ {
+			stubDb.Expect(upsertPluginQuery).WillReturnResult(1)
+		}
+		finish := stubDb.Expect(finishJobQuery).WillReturnResult(1)
+
+		orch := orchestrator.New(map[string]interfaces.GenericPlugin{
+			"ScanDNS": &stubPlugin{err: fmt.Errorf("lookup failed")},
+			"ScanTLS": &stubPlugin{id: "tls-1"},
+		}, nil)
+		r := NewRunner(NewStore(stubDb), orch, func(job *Job, run *orchestrator.Run) (string, error) {
+			t.Fatal("finish called for a failed job")
+			return "", nil
+		}, 1)
+		r.HeartbeatInterval = time.Hour
+
+		r.process(context.Background(), job)
+		assert.Equal(t, "failed", finish.Args()[1])
+		assert.Contains(t, finish.Args()[3], "lookup failed")
+	})
+
+	t.Run("RunsRecordedPluginsAndQueuesSubdomains", func(t *testing.T) {
+		stubDb := testutils.NewStubDB()
+		stubDb.Expect(jobPluginsQuery).WillReturnRows(pluginCols,
+			[]driver.Value{"ScanDNS", "queued", "", "", false, nil, nil},
+			[]driver.Value{"ScanCrtSh", "queued", "", "", false, nil, nil})
+		expectRun(stubDb)
+		for i := 0; i < 6; i++ {
+			stubDb.Expect(upsertPluginQuery).WillReturnResult(1)
+		}
+		stubDb.Expect(finishJobQuery).WillReturnResult(1)
+		child := stubDb.Expect(insertJobQuery).WillReturnResult(1)
+		for i := 0; i < 2; i++ {
+			stubDb.Expect(upsertPluginQuery).WillReturnResult(1)
+		}
+
+		tls := &stubPlugin{id: "tls-1"}
+		crtsh := &stubPlugin{id: "crtsh-1", subdomains: []string{"www.example.com", "*.example.com", "example.org", "WWW.example.com."}}
+		orch := orchestrator.New(map[string]interfaces.GenericPlugin{
+			"ScanDNS":   &stubPlugin{id: "dns-1"},
+			"ScanCrtSh": crtsh,
+			"ScanTLS":   tls,
+		}, nil)
+		r := NewRunner(NewStore(stubDb), orch, func(job *Job, run *orchestrator.Run) (string, error) {
+			return "report-1", nil
+		}, 1)
+		r.HeartbeatInterval = time.Hour
+		r.MaxSubdomainJobs = 5
+		r.SubdomainPlugins = []string{"ScanDNS", "ScanTLS"}
+
+		r.process(context.Background(), job)
+		assert.NoError(t, stubDb.ExpectationsWereMet())
+		assert.Equal(t, 0, tls.calls)
+		assert.Equal(t, 1, crtsh.calls)
+		assert.Equal(t, "www.example.com", child.Args()[2])
+		assert.Equal(t, "job-1", child.Args()[5])
+	})
+}
+
+func TestSubmitBatch(t *testing.T) {
+	stubDb := testutils.NewStubDB()
+	stubDb.Expect("INSERT INTO scan_batches").WillReturnResult(1)
+	insert := stubDb.Expect(insertJobQuery).WillReturnResult(2)
+	plugins := stubDb.Expect(upsertPluginQuery).WillReturnResult(4)
+
+	batch, err := NewStore(stubDb).SubmitBatch("user-1", "passive", []BatchDomain{
+		{Domain: "a.example.com", Tags: []string{"prod"}},
+		{Domain: "b.example.com"},
+	}, []string{"ScanDNS", "ScanTLS"})
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.NoError(t, stubDb.ExpectationsWereMet())
+	assert.Equal(t, 2, batch.Total)
+	assert.False(t, batch.Done())
+	// shared user, batch, time and profile, then id, domain and tags per job
+	assert.Len(t, insert.Args(), 4+2*3)
+	assert.Equal(t, "passive", insert.Args()[3])
+	assert.Equal(t, "a.example.com", insert.Args()[5])
+	assert.Equal(t, "b.example.com", insert.Args()[8])
+	assert.Len(t, plugins.Args(), 2)
+}
+
+func TestGetBatch(t *testing.T) {
+	t.Run("NotFound", func(t *testing.T) {
+		stubDb := testutils.NewStubDB()
+		stubDb.Expect("FROM scan_batches").WillReturnRows([]string{"user_id", "total", "created_at"})
+
+		_, err := NewStore(stubDb).GetBatch("user-1", "batch-1")
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+
+	t.Run("Summary", func(t *testing.T) {
+		stubDb := testutils.NewStubDB()
+		now := time.Now()
+		stubDb.Expect("FROM scan_batches").WillReturnRows([]string{"user_id", "total", "created_at"},
+			[]driver.Value{"user-1", int64(4), now})
+		stubDb.Expect("GROUP BY 1, 2").WillReturnRows([]string{"status", "risk_tier", "jobs", "reports", "sum", "min", "max"},
+			[]driver.Value{"succeeded", "Low", int64(2), int64(2), int64(170), int64(80), int64(90)},
+			[]driver.Value{"succeeded", "High", int64(1), int64(1), int64(40), int64(40), int64(40)},
+			[]driver.Value{"failed", "", int64(1), int64(0), int64(0), int64(0), int64(0)})
+		jobCols := []string{"id", "user_id", "domain", "status", "report_id", "error", "cancel_requested", "created_at", "started_at", "finished_at", "parent_id", "scan_run_id", "batch_id", "tags", "profile", "refresh"}
+		stubDb.Expect("status = 'failed'").WillReturnRows(jobCols,
+			[]driver.Value{"job-4", "user-1", "d.example.com", "failed", nil, "lookup failed", false, now, now, now, nil, nil, "batch-1", "{prod}", "passive", false})
+
+		batch, err := NewStore(stubDb).GetBatch("user-1", "batch-1")
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.True(t, batch.Done())
+		assert.Equal(t, 3, batch.Counts[StatusSucceeded])
+		assert.Equal(t, 1, batch.Counts[StatusFailed])
+		assert.Equal(t, 3, batch.Summary.Reports)
+		assert.InDelta(t, 70.0, batch.Summary.AverageScore, 0.001)
+		assert.Equal(t, 40, batch.Summary.MinScore)
+		assert.Equal(t, 90, batch.Summary.MaxScore)
+		assert.Equal(t, map[string]int{"Low": 2, "High": 1}, batch.Summary.RiskTiers)
+		if assert.Len(t, batch.Summary.Failed, 1) {
+			assert.Equal(t, "d.example.com", batch.Summary.Failed[0].Domain)
+			assert.Equal(t, []string{"prod"}, batch.Summary.Failed[0].Tags)
+			assert.Equal(t, "passive", batch.Summary.Failed[0].Profile)
+		}
+	})
+}
+
+func TestClaim(t *testing.T) {
+	const (
+		candidateQuery = "FOR UPDATE SKIP LOCKED"
+		lockQuery      = "SELECT pg_advisory_xact_lock(hashtext($1))"
+		countQuery     = "SELECT COUNT(*) FROM scan_jobs WHERE batch_id = $1"
+		claimQuery     = "UPDATE scan_jobs SET status = 'running'"
+	)
+	candidateCols := []string{"id", "batch_id", "queued"}
+	jobCols := []string{"id", "user_id", "domain", "status", "report_id", "error", "cancel_requested", "created_at", "started_at", "finished_at", "parent_id", "scan_run_id", "batch_id", "tags", "profile", "refresh"}
+	now := time.Now()
+
+	t.Run("BatchWithRoom", func(t *testing.T) {
+		stubDb := testutils.NewStubDB()
+		stubDb.Expect(candidateQuery).WillReturnRows(candidateCols, []driver.Value{"job-1", "batch-1", true})
+		lock := stubDb.Expect(lockQuery).WillReturnResult(1)
+		stubDb.Expect(countQuery).WillReturnRows([]string{"count"}, []driver.Value{int64(1)})
+		claim := stubDb.Expect(claimQuery).WillReturnRows(jobCols,
+			[]driver.Value{"job-1", "user-1", "a.example.com", "running", nil, "", false, now, now, nil, nil, nil, "batch-1", "{}", "full", false})
+
+		job, err := NewStore(stubDb).claim(now, 2)
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.NoError(t, stubDb.ExpectationsWereMet())
+		assert.Equal(t, "job-1", job.ID)
+		assert.Equal(t, []driver.Value{"batch-1"}, lock.Args())
+		assert.Equal(t, "job-1", claim.Args()[0])
+	})
+
+	t.Run("BatchFilledByAnotherWorker", func(t *testing.T) {
+		stubDb := testutils.NewStubDB()
+		stubDb.Expect(candidateQuery).WillReturnRows(candidateCols, []driver.Value{"job-1", "batch-1", true})
+		stubDb.Expect(lockQuery).WillReturnResult(1)
+		stubDb.Expect(countQuery).WillReturnRows([]string{"count"}, []driver.Value{int64(2)})
+
+		job, err := NewStore(stubDb).claim(now, 2)
+		assert.NoError(t, err)
+		assert.Nil(t, job)
+		assert.NoError(t, stubDb.ExpectationsWereMet())
+	})
+
+	t.Run("StaleJobSkipsBatchLimit", func(t *testing.T) {
+		stubDb := testutils.NewStubDB()
+		stubDb.Expect(candidateQuery).WillReturnRows(candidateCols, []driver.Value{"job-1", "batch-1", false})
+		stubDb.Expect(claimQuery).WillReturnRows(jobCols,
+			[]driver.Value{"job-1", "user-1", "a.example.com", "running", nil, "", false, now, now, nil, nil, nil, "batch-1", "{}", "full", false})
+
+		job, err := NewStore(stubDb).claim(now, 2)
+		assert.NoError(t, err)
+		assert.NotNil(t, job)
+		assert.NoError(t, stubDb.ExpectationsWereMet())
+	})
+
+	t.Run("Empty", func(t *testing.T) {
+		stubDb := testutils.NewStubDB()
+		stubDb.Expect(candidateQuery).WillReturnRows(candidateCols)
+
+		job, err := NewStore(stubDb).claim(now, 2)
+		assert.NoError(t, err)
+		assert.Nil(t, job)
+	})
+}
@@ -0,0 +1,251 @@
+// internal/jobs/runner.go
+package jobs
+
+import (
+	"context"
+	"log"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/moos3/sparta/internal/intelcache"
+	"github.com/moos3/sparta/internal/interfaces"
+	"github.com/moos3/sparta/internal/orchestrator"
+	"github.com/moos3/sparta/internal/profiles"
+	"github.com/moos3/sparta/internal/runs"
+)
+
+// FinishFunc stores the outcome of a successful run and returns the ID of
+// the report it produced.
+type FinishFunc func(job *Job, run *orchestrator.Run) (string, error)
+
+// Runner executes queued scan jobs in the background.
+type Runner struct {
+	store        *Store
+	mu           sync.RWMutex // guards orchestrator, which a config reload replaces
+	orchestrator *orchestrator.Orchestrator
+	finish       FinishFunc
+	workers      int
+
+	PollInterval      time.Duration // how often an idle worker checks the queue
+	HeartbeatInterval time.Duration // how often a running job heartbeats and checks for cancellation
+	StaleAfter        time.Duration // how long without a heartbeat before a running job is reclaimed
+
+	MaxSubdomainJobs int      // subdomain jobs queued per finished job; zero disables them
+	SubdomainPlugins []string // plugins run by subdomain jobs
+
+	MaxBatchJobs int // jobs of one bulk scan batch running at once across all servers; zero is unlimited
+
+	Profiles *profiles.Store // resolves each job's scan profile; nil runs jobs with the orchestrator as given
+}
+
+// NewRunner creates a Runner with the given number of workers.
+func NewRunner(store *Store, orch *orchestrator.Orchestrator, finish FinishFunc, workers int) *Runner {
+	if workers < 1 {
+		workers = 1
+	}
+	return &Runner{
+		store:             store,
+		orchestrator:      orch,
+		finish:            finish,
+		workers:           workers,
+		PollInterval:      2 * time.Second,
+		HeartbeatInterval: 2 * time.Second,
+		StaleAfter:        time.Minute,
+	}
+}
+
+// SetOrchestrator replaces the orchestrator jobs are run with, as when the
+// config is reloaded. Jobs already running finish with the one they started
+// with.
+func (r *Runner) SetOrchestrator(orch *orchestrator.Orchestrator) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.orchestrator = orch
+}
+
+// Start launches the workers. They stop when ctx is cancelled.
+func (r *Runner) Start(ctx context.Context) {
+	for i := 0; i < r.workers; i++ {
+		go r.work(ctx)
+	}
+	log.Printf("Started %d scan job workers", r.workers)
+}
+
+func (r *Runner) work(ctx context.Context) {
+	for ctx.Err() == nil {
+		job, err := r.store.claim(time.Now().Add(-r.StaleAfter), r.MaxBatchJobs)
+		if err != nil {
+			log.Printf("Failed to claim scan job: %v", err)
+		}
+		if job != nil {
+			r.process(ctx, job)
+			continue
+		}
+		select {
+		case <-ctx.Done():
+		case <-time.After(r.PollInterval):
+		}
+	}
+}
+
+// process runs a claimed job to completion and records its outcome.
+func (r *Runner) process(ctx context.Context, job *Job) {
+	if job.CancelRequested {
+		// Reclaimed from a worker that died after cancellation was requested
+		if err := r.store.skipQueuedPlugins(job.ID, "scan cancelled"); err != nil {
+			log.Printf("%v", err)
+		}
+		r.complete(job.ID, StatusCancelled, "", "scan cancelled")
+		return
+	}
+
+	// Run only the plugins recorded when the job was submitted, with the
+	// options and timeouts of its profile
+	r.mu.RLock()
+	orch := r.orchestrator
+	r.mu.RUnlock()
+	if r.Profiles != nil {
+		profile, err := r.Profiles.Get(job.UserID, job.Profile)
+		if err != nil {
+			r.complete(job.ID, StatusFailed, "", err.Error())
+			return
+		}
+		orch = profile.Apply(orch)
+	}
+	if plugins, err := r.store.plugins(job.ID); err != nil {
+		log.Printf("%v", err)
+	} else if len(plugins) > 0 {
+		names := make([]string, 0, len(plugins))
+		for _, p := range plugins {
+			names = append(names, p.Plugin)
+		}
+		orch = orch.Only(names)
+	}
+
+	profile := job.Profile
+	if profile == "" {
+		profile = runs.ProfileFull
+	}
+	if job.ParentID != "" {
+		profile = runs.ProfileSubdomain
+	}
+	scanRun, err := r.store.runs.Create(job.Domain, job.UserID, profile)
+	if err != nil {
+		r.complete(job.ID, StatusFailed, "", err.Error())
+		return
+	}
+	job.ScanRunID = scanRun.ID
+	if err := r.store.attachRun(job.ID, scanRun.ID); err != nil {
+		log.Printf("%v", err)
+	}
+
+	jobCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	if job.Refresh {
+		jobCtx = intelcache.WithRefresh(jobCtx)
+	}
+	var cancelled atomic.Bool
+	go r.watch(jobCtx, job.ID, func() {
+		cancelled.Store(true)
+		cancel()
+	})
+
+	log.Printf("Running scan job %s for %s", job.ID, job.Domain)
+	run, err := orch.Run(jobCtx, scanRun.ID, job.Domain, func(ev orchestrator.Event) {
+		if err := r.store.updatePlugin(job.ID, ev); err != nil {
+			log.Printf("%v", err)
+		}
+	})
+
+	switch {
+	case ctx.Err() != nil:
+		// Shutting down: leave the job running so another worker reclaims it
+		// once its heartbeat goes stale.
+		log.Printf("Scan job %s interrupted by shutdown", job.ID)
+		r.finishRun(scanRun.ID, runs.StatusFailed, "interrupted by shutdown")
+	case cancelled.Load():
+		r.finishRun(scanRun.ID, runs.StatusFailed, "scan cancelled")
+		r.complete(job.ID, StatusCancelled, "", "scan cancelled")
+	case err != nil:
+		r.finishRun(scanRun.ID, runs.StatusFailed, err.Error())
+		r.complete(job.ID, StatusFailed, "", err.Error())
+	default:
+		r.finishRun(scanRun.ID, runs.StatusSucceeded, "")
+		reportID, err := r.finish(job, run)
+		if err != nil {
+			r.complete(job.ID, StatusFailed, "", "failed to store report: "+err.Error())
+			return
+		}
+		r.complete(job.ID, StatusSucceeded, reportID, "")
+		r.queueSubdomains(job, run)
+	}
+}
+
+// queueSubdomains submits a job for each subdomain the run discovered, up to
+// MaxSubdomainJobs. Jobs that were themselves queued for a subdomain, and
+// jobs of a bulk scan batch, do not queue further jobs.
+func (r *Runner) queueSubdomains(job *Job, run *orchestrator.Run) {
+	if r.MaxSubdomainJobs <= 0 || job.ParentID != "" || job.BatchID != "" {
+		return
+	}
+	queued := 0
+	seen := make(map[string]bool)
+	for _, name := range run.Outputs[interfaces.ArtifactSubdomains] {
+		name = strings.TrimSuffix(strings.ToLower(strings.TrimSpace(name)), ".")
+		if seen[name] || strings.HasPrefix(name, "*.") || !strings.HasSuffix(name, "."+job.Domain) {
+			continue
+		}
+		seen[name] = true
+		if queued >= r.MaxSubdomainJobs {
+			log.Printf("Not queueing further subdomains of %s after %d", job.Domain, queued)
+			return
+		}
+		if _, err := r.store.SubmitChild(job, name, r.SubdomainPlugins); err != nil {
+			log.Printf("Failed to queue subdomain scan for %s: %v", name, err)
+			continue
+		}
+		queued++
+	}
+	if queued > 0 {
+		log.Printf("Queued %d subdomain scans for %s", queued, job.Domain)
+	}
+}
+
+// watch heartbeats a running job and calls cancel once cancellation is requested.
+func (r *Runner) watch(ctx context.Context, id string, cancel func()) {
+	ticker := time.NewTicker(r.HeartbeatInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		cancelRequested, err := r.store.heartbeat(id)
+		if err != nil {
+			log.Printf("%v", err)
+			continue
+		}
+		if cancelRequested {
+			log.Printf("Cancelling scan job %s", id)
+			cancel()
+			return
+		}
+	}
+}
+
+func (r *Runner) finishRun(id string, status runs.Status, errMsg string) {
+	if err := r.store.runs.Finish(id, status, errMsg); err != nil {
+		log.Printf("%v", err)
+	}
+}
+
+func (r *Runner) complete(id string, status Status, reportID, errMsg string) {
+	if err := r.store.finish(id, status, reportID, errMsg); err != nil {
+		log.Printf("%v", err)
+		return
+	}
+	log.Printf("Scan job %s %s", id, status)
+}
@@ -0,0 +1,372 @@
+// internal/jobs/store.go
+package jobs
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/moos3/sparta/internal/db"
+	"github.com/moos3/sparta/internal/orchestrator"
+	"github.com/moos3/sparta/internal/runs"
+)
+
+var (
+	// ErrNotFound is returned when a job does not exist or belongs to another user.
+	ErrNotFound = errors.New("scan job not found")
+	// ErrFinished is returned when cancelling a job that has already finished.
+	ErrFinished = errors.New("scan job already finished")
+)
+
+// Status is the state of a scan job as a whole.
+type Status string
+
+const (
+	StatusQueued    Status = "queued"
+	StatusRunning   Status = "running"
+	StatusSucceeded Status = "succeeded"
+	StatusFailed    Status = "failed"
+	StatusCancelled Status = "cancelled"
+)
+
+// Job is a scan of one domain queued by a user.
+type Job struct {
+	ID              string
+	ParentID        string // job whose scan found this job's domain as a subdomain
+	ScanRunID       string // scan run holding the results, set once a worker starts the job
+	BatchID         string // bulk scan the job belongs to, if any
+	Tags            []string
+	Profile         string // scan profile the job runs with
+	Refresh         bool   // bypass cached intelligence lookups
+	UserID          string
+	Domain          string
+	Status          Status
+	ReportID        string
+	Error           string
+	CancelRequested bool
+	CreatedAt       time.Time
+	StartedAt       time.Time // zero until a worker claims the job
+	FinishedAt      time.Time // zero until the job finishes
+	Plugins         []PluginRun
+}
+
+// PluginRun is the progress of one plugin within a job.
+type PluginRun struct {
+	Plugin     string
+	State      orchestrator.PluginState
+	ResultID   string
+	Error      string
+	TimedOut   bool
+	StartedAt  time.Time
+	FinishedAt time.Time
+}
+
+// Store persists scan jobs in Postgres. The scan_jobs table doubles as the
+// work queue: workers claim rows with FOR UPDATE SKIP LOCKED so any number of
+// server instances can share it.
+type Store struct {
+	db   db.Database
+	runs *runs.Store
+}
+
+// NewStore creates a Store over database.
+func NewStore(database db.Database) *Store {
+	return &Store{db: database, runs: runs.NewStore(database)}
+}
+
+// Submit queues a scan of domain for userID under the named scan profile,
+// recording every plugin as queued. Only the given plugins are run. With
+// refresh set the scan bypasses cached intelligence lookups.
+func (s *Store) Submit(userID, domain, profile string, refresh bool, plugins []string) (*Job, error) {
+	return s.submit(userID, "", domain, profile, refresh, plugins)
+}
+
+// SubmitChild queues a scan of a subdomain found by parent, on behalf of the
+// same user and under the same profile.
+func (s *Store) SubmitChild(parent *Job, domain string, plugins []string) (*Job, error) {
+	return s.submit(parent.UserID, parent.ID, domain, parent.Profile, parent.Refresh, plugins)
+}
+
+func (s *Store) submit(userID, parentID, domain, profile string, refresh bool, plugins []string) (*Job, error) {
+	job := &Job{
+		ID:        uuid.New().String(),
+		ParentID:  parentID,
+		UserID:    userID,
+		Domain:    domain,
+		Profile:   profile,
+		Refresh:   refresh,
+		Status:    StatusQueued,
+		CreatedAt: time.Now(),
+	}
+	query := `
+		INSERT INTO scan_jobs (id, user_id, domain, status, created_at, parent_id, profile, refresh)
+		VALUES ($1, $2, $3, $4, $5, NULLIF($6, '')::uuid, $7, $8)
+	`
+	if _, err := s.db.Exec(query, job.ID, userID, domain, string(StatusQueued), job.CreatedAt, parentID, profile, refresh); err != nil {
+		return nil, fmt.Errorf("failed to insert scan job: %w", err)
+	}
+	for _, name := range plugins {
+		if err := s.updatePlugin(job.ID, orchestrator.Event{Plugin: name, State: orchestrator.StateQueued}); err != nil {
+			return nil, err
+		}
+		job.Plugins = append(job.Plugins, PluginRun{Plugin: name, State: orchestrator.StateQueued})
+	}
+	return job, nil
+}
+
+const jobColumns = `id, user_id, domain, status, report_id, error, cancel_requested, created_at, started_at, finished_at, parent_id, scan_run_id, batch_id, tags, profile, refresh`
+
+// Get returns the job with the given ID, including per-plugin progress.
+func (s *Store) Get(userID, id string) (*Job, error) {
+	query := `SELECT ` + jobColumns + ` FROM scan_jobs WHERE id = $1 AND user_id = $2`
+	job, err := scanJob(s.db.QueryRow(query, id, userID))
+	if err == sql.ErrNoRows {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get scan job: %w", err)
+	}
+	if job.Plugins, err = s.plugins(id); err != nil {
+		return nil, err
+	}
+	return job, nil
+}
+
+// List returns the user's most recent jobs, newest first. Plugin progress is
+// not included; use Get for that.
+func (s *Store) List(userID string, limit int) ([]*Job, error) {
+	query := `SELECT ` + jobColumns + ` FROM scan_jobs WHERE user_id = $1 ORDER BY created_at DESC LIMIT $2`
+	rows, err := s.db.Query(query, userID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list scan jobs: %w", err)
+	}
+	defer rows.Close()
+
+	var jobs []*Job
+	for rows.Next() {
+		job, err := scanJob(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan job row: %w", err)
+		}
+		jobs = append(jobs, job)
+	}
+	return jobs, rows.Err()
+}
+
+// RequestCancel flags a job for cancellation. A queued job is cancelled
+// immediately; a running job is cancelled by its worker, which polls the
+// flag with every heartbeat.
+func (s *Store) RequestCancel(userID, id string) (*Job, error) {
+	query := `
+		UPDATE scan_jobs
+		SET cancel_requested = TRUE,
+			status = CASE WHEN status = 'queued' THEN 'cancelled' ELSE status END,
+			finished_at = CASE WHEN status = 'queued' THEN $3 ELSE finished_at END
+		WHERE id = $1 AND user_id = $2 AND status IN ('queued', 'running')
+		RETURNING status
+	`
+	var status Status
+	err := s.db.QueryRow(query, id, userID, time.Now()).Scan(&status)
+	if err == sql.ErrNoRows {
+		if _, err := s.Get(userID, id); err != nil {
+			return nil, err
+		}
+		return nil, ErrFinished
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to cancel scan job: %w", err)
+	}
+	if status == StatusCancelled {
+		if err := s.skipQueuedPlugins(id, "scan cancelled"); err != nil {
+			return nil, err
+		}
+	}
+	return s.Get(userID, id)
+}
+
+// claim takes the oldest queued job off the queue, along with any running
+// job whose worker stopped heartbeating before staleBefore. Jobs outside a
+// batch go first, and a batch job is only claimed while fewer than
+// maxBatchRunning jobs of its batch are running, so a large batch cannot
+// starve other scans or exceed provider rate limits. Claims from one batch
+// take a transaction-scoped advisory lock, so replicas claiming at once
+// cannot together exceed the limit. It returns nil if there is nothing to
+// do.
+func (s *Store) claim(staleBefore time.Time, maxBatchRunning int) (*Job, error) {
+	transactor, ok := s.db.(db.Transactor)
+	if !ok {
+		return nil, errors.New("failed to claim scan job: database does not support transactions")
+	}
+	var job *Job
+	err := transactor.InTx(func(tx db.Database) error {
+		query := `
+			SELECT j.id, j.batch_id, j.status = 'queued' FROM scan_jobs j
+			WHERE (j.status = 'queued' AND (j.batch_id IS NULL OR $2 <= 0 OR (
+					SELECT COUNT(*) FROM scan_jobs b WHERE b.batch_id = j.batch_id AND b.status = 'running'
+				) < $2))
+				OR (j.status = 'running' AND j.heartbeat_at < $1)
+			ORDER BY j.batch_id IS NOT NULL, j.created_at
+			LIMIT 1
+			FOR UPDATE SKIP LOCKED
+		`
+		var id string
+		var batchID sql.NullString
+		var queued bool
+		err := tx.QueryRow(query, staleBefore, maxBatchRunning).Scan(&id, &batchID, &queued)
+		if err == sql.ErrNoRows {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		if queued && batchID.Valid && maxBatchRunning > 0 {
+			// Workers claiming from the same batch at once all counted its
+			// running jobs before any of them committed; take turns, then
+			// count again with the others' claims visible
+			if _, err := tx.Exec(`SELECT pg_advisory_xact_lock(hashtext($1))`, batchID.String); err != nil {
+				return err
+			}
+			var running int
+			err := tx.QueryRow(`SELECT COUNT(*) FROM scan_jobs WHERE batch_id = $1 AND status = 'running'`, batchID.String).Scan(&running)
+			if err != nil {
+				return err
+			}
+			if running >= maxBatchRunning {
+				// Full after all; the next poll looks for another job
+				return nil
+			}
+		}
+
+		query = `
+			UPDATE scan_jobs
+			SET status = 'running', started_at = COALESCE(started_at, $2), heartbeat_at = $2
+			WHERE id = $1
+			RETURNING ` + jobColumns
+		job, err = scanJob(tx.QueryRow(query, id, time.Now()))
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim scan job: %w", err)
+	}
+	return job, nil
+}
+
+// heartbeat marks the job as alive and reports whether cancellation was requested.
+func (s *Store) heartbeat(id string) (bool, error) {
+	var cancelRequested bool
+	err := s.db.QueryRow(`UPDATE scan_jobs SET heartbeat_at = $2 WHERE id = $1 RETURNING cancel_requested`, id, time.Now()).Scan(&cancelRequested)
+	if err != nil {
+		return false, fmt.Errorf("failed to update scan job heartbeat: %w", err)
+	}
+	return cancelRequested, nil
+}
+
+// attachRun records the scan run a worker started for the job.
+func (s *Store) attachRun(jobID, runID string) error {
+	if _, err := s.db.Exec(`UPDATE scan_jobs SET scan_run_id = $2 WHERE id = $1`, jobID, runID); err != nil {
+		return fmt.Errorf("failed to attach scan run to scan job %s: %w", jobID, err)
+	}
+	return nil
+}
+
+// updatePlugin records a plugin state change.
+func (s *Store) updatePlugin(jobID string, ev orchestrator.Event) error {
+	var resultID, errMsg string
+	var timedOut bool
+	var startedAt, finishedAt sql.NullTime
+	switch {
+	case ev.State == orchestrator.StateRunning:
+		startedAt = sql.NullTime{Time: time.Now(), Valid: true}
+	case ev.Result != nil:
+		resultID = ev.Result.ID
+		errMsg = strings.Join(ev.Result.Errors, "; ")
+		timedOut = ev.Result.TimedOut
+		if ev.State != orchestrator.StateSkipped {
+			startedAt = sql.NullTime{Time: ev.Result.StartedAt, Valid: true}
+		}
+		finishedAt = sql.NullTime{Time: ev.Result.FinishedAt, Valid: true}
+	}
+	query := `
+		INSERT INTO scan_job_plugins (job_id, plugin, status, result_id, error, timed_out, started_at, finished_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		ON CONFLICT (job_id, plugin) DO UPDATE
+		SET status = EXCLUDED.status, result_id = EXCLUDED.result_id, error = EXCLUDED.error, timed_out = EXCLUDED.timed_out,
+			started_at = COALESCE(EXCLUDED.started_at, scan_job_plugins.started_at),
+			finished_at = EXCLUDED.finished_at
+	`
+	if _, err := s.db.Exec(query, jobID, ev.Plugin, string(ev.State), resultID, errMsg, timedOut, startedAt, finishedAt); err != nil {
+		return fmt.Errorf("failed to update %s state for scan job %s: %w", ev.Plugin, jobID, err)
+	}
+	return nil
+}
+
+// skipQueuedPlugins marks the plugins of a job that never started as skipped.
+func (s *Store) skipQueuedPlugins(jobID, reason string) error {
+	query := `UPDATE scan_job_plugins SET status = 'skipped', error = $2, finished_at = $3 WHERE job_id = $1 AND status = 'queued'`
+	if _, err := s.db.Exec(query, jobID, reason, time.Now()); err != nil {
+		return fmt.Errorf("failed to skip plugins for scan job %s: %w", jobID, err)
+	}
+	return nil
+}
+
+// finish records the final state of a job.
+func (s *Store) finish(id string, status Status, reportID, errMsg string) error {
+	query := `
+		UPDATE scan_jobs
+		SET status = $2, report_id = NULLIF($3, '')::uuid, error = $4, finished_at = $5
+		WHERE id = $1
+	`
+	if _, err := s.db.Exec(query, id, string(status), reportID, errMsg, time.Now()); err != nil {
+		return fmt.Errorf("failed to finish scan job %s: %w", id, err)
+	}
+	return nil
+}
+
+func (s *Store) plugins(jobID string) ([]PluginRun, error) {
+	query := `
+		SELECT plugin, status, result_id, error, timed_out, started_at, finished_at
+		FROM scan_job_plugins
+		WHERE job_id = $1
+		ORDER BY plugin
+	`
+	rows, err := s.db.Query(query, jobID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query scan job plugins: %w", err)
+	}
+	defer rows.Close()
+
+	var runs []PluginRun
+	for rows.Next() {
+		var r PluginRun
+		var startedAt, finishedAt sql.NullTime
+		if err := rows.Scan(&r.Plugin, &r.State, &r.ResultID, &r.Error, &r.TimedOut, &startedAt, &finishedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan plugin row: %w", err)
+		}
+		r.StartedAt, r.FinishedAt = startedAt.Time, finishedAt.Time
+		runs = append(runs, r)
+	}
+	return runs, rows.Err()
+}
+
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanJob(row rowScanner) (*Job, error) {
+	var job Job
+	var reportID, parentID, scanRunID, batchID sql.NullString
+	var startedAt, finishedAt sql.NullTime
+	err := row.Scan(&job.ID, &job.UserID, &job.Domain, &job.Status, &reportID, &job.Error,
+		&job.CancelRequested, &job.CreatedAt, &startedAt, &finishedAt, &parentID, &scanRunID, &batchID, pq.Array(&job.Tags), &job.Profile, &job.Refresh)
+	if err != nil {
+		return nil, err
+	}
+	job.ReportID, job.ParentID, job.ScanRunID, job.BatchID = reportID.String, parentID.String, scanRunID.String, batchID.String
+	job.StartedAt, job.FinishedAt = startedAt.Time, finishedAt.Time
+	return &job, nil
+}
@@ -0,0 +1,403 @@
+// internal/orchestrator/orchestrator.go
+package orchestrator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/moos3/sparta/internal/config"
+	"github.com/moos3/sparta/internal/interfaces"
+	"github.com/moos3/sparta/internal/registry"
+)
+
+// DNSPlugin is the plugin every scan starts with; its stored result is the
+// parent of every other plugin's result.
+const DNSPlugin = "ScanDNS"
+
+const (
+	defaultWorkers = 4
+	defaultTimeout = 30 * time.Second
+)
+
+// ErrDNSNotLoaded is returned by Run when the DNS plugin is not loaded.
+var ErrDNSNotLoaded = errors.New("DNS plugin not loaded")
+
+// PluginState is the progress of a single plugin within a scan.
+type PluginState string
+
+const (
+	StateQueued    PluginState = "queued"
+	StateRunning   PluginState = "running"
+	StateSucceeded PluginState = "succeeded"
+	StateFailed    PluginState = "failed"
+	StateSkipped   PluginState = "skipped"
+)
+
+// Event reports a plugin changing state. Result is set once the plugin has
+// finished, failed or been skipped.
+type Event struct {
+	Plugin string
+	State  PluginState
+	Result *interfaces.ScanResult
+}
+
+// Run is the outcome of scanning one domain with every loaded plugin.
+type Run struct {
+	ID        string // scan run the plugin results are stored under
+	Domain    string
+	DNSScanID string
+	Results   map[string]*interfaces.ScanResult
+	Outputs   map[interfaces.Artifact][]string // artifacts produced by the plugins that succeeded
+}
+
+// Orchestrator runs the loaded plugins against a domain.
+type Orchestrator struct {
+	plugins  map[string]interfaces.GenericPlugin
+	workers  int
+	timeout  time.Duration
+	timeouts map[string]time.Duration
+	options  map[string]map[string]string              // plugin-specific options keyed by plugin name
+	lookup   func(name string) (registry.Plugin, bool) // plugin metadata, registry.Lookup outside tests
+}
+
+// New creates an Orchestrator over the loaded plugins. The worker pool size
+// and plugin timeouts come from the scan section of cfg; a nil cfg uses the
+// defaults.
+func New(plugins map[string]interfaces.GenericPlugin, cfg *config.Config) *Orchestrator {
+	o := &Orchestrator{
+		plugins:  plugins,
+		workers:  defaultWorkers,
+		timeout:  defaultTimeout,
+		timeouts: make(map[string]time.Duration),
+		lookup:   registry.Lookup,
+	}
+	if cfg != nil {
+		if cfg.Scan.Workers > 0 {
+			o.workers = cfg.Scan.Workers
+		}
+		if cfg.Scan.PluginTimeout > 0 {
+			o.timeout = time.Duration(cfg.Scan.PluginTimeout) * time.Second
+		}
+		for name, seconds := range cfg.Scan.PluginTimeouts {
+			if seconds > 0 {
+				o.timeouts[name] = time.Duration(seconds) * time.Second
+			}
+		}
+	}
+	return o
+}
+
+// Only returns a copy of the Orchestrator restricted to the named plugins.
+// Names that are not loaded are ignored.
+func (o *Orchestrator) Only(names []string) *Orchestrator {
+	restricted := *o
+	restricted.plugins = make(map[string]interfaces.GenericPlugin, len(names))
+	for _, name := range names {
+		if plugin, ok := o.plugins[name]; ok {
+			restricted.plugins[name] = plugin
+		}
+	}
+	return &restricted
+}
+
+// PassiveOnly returns a copy of the Orchestrator restricted to the plugins
+// that never contact the target directly.
+func (o *Orchestrator) PassiveOnly() *Orchestrator {
+	var names []string
+	for name := range o.plugins {
+		if meta, ok := o.lookup(name); ok && meta.Passive {
+			names = append(names, name)
+		}
+	}
+	return o.Only(names)
+}
+
+// WithTimeouts returns a copy of the Orchestrator with the given per-plugin
+// timeouts replacing the configured ones.
+func (o *Orchestrator) WithTimeouts(timeouts map[string]time.Duration) *Orchestrator {
+	changed := *o
+	changed.timeouts = make(map[string]time.Duration, len(o.timeouts)+len(timeouts))
+	for name, t := range o.timeouts {
+		changed.timeouts[name] = t
+	}
+	for name, t := range timeouts {
+		if t > 0 {
+			changed.timeouts[name] = t
+		}
+	}
+	return &changed
+}
+
+// WithOptions returns a copy of the Orchestrator that passes the given
+// options, keyed by plugin name, to the plugins it runs.
+func (o *Orchestrator) WithOptions(options map[string]map[string]string) *Orchestrator {
+	changed := *o
+	changed.options = options
+	return &changed
+}
+
+// Timeout returns how long the named plugin may run before it is abandoned.
+func (o *Orchestrator) Timeout(name string) time.Duration {
+	if t, ok := o.timeouts[name]; ok {
+		return t
+	}
+	return o.timeout
+}
+
+// Plugins returns the names of the loaded plugins in the order Run scans them.
+func (o *Orchestrator) Plugins() []string {
+	names := make([]string, 0, len(o.plugins))
+	for name := range o.plugins {
+		if name != DNSPlugin {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	if _, ok := o.plugins[DNSPlugin]; ok {
+		names = append([]string{DNSPlugin}, names...)
+	}
+	return names
+}
+
+// Run scans domain with the DNS plugin and then every other loaded plugin,
+// storing every result under the scan run runID and passing the stored DNS
+// result ID to the others as their parent. The other plugins form a
+// dependency graph: a plugin starts once every plugin it depends on, or that
+// produces one of its inputs, has finished, and receives the artifacts those
+// plugins produced. Plugins whose dependencies are met run concurrently on a
+// bounded pool of workers, each under its own timeout. A failed dependency
+// does not block its dependents; they run with whatever inputs are available.
+// notify, if not nil, is called for every state change; calls are never
+// concurrent. If the DNS scan fails the remaining plugins are skipped and an
+// error is returned; if ctx is cancelled the plugins that have not started
+// yet are skipped.
+func (o *Orchestrator) Run(ctx context.Context, runID, domain string, notify func(Event)) (*Run, error) {
+	if notify == nil {
+		notify = func(Event) {}
+	}
+	dnsPlugin, ok := o.plugins[DNSPlugin]
+	if !ok {
+		return nil, ErrDNSNotLoaded
+	}
+
+	names := o.Plugins()
+	for _, name := range names {
+		notify(Event{Plugin: name, State: StateQueued})
+	}
+
+	run := &Run{
+		ID:      runID,
+		Domain:  domain,
+		Results: make(map[string]*interfaces.ScanResult, len(names)),
+		Outputs: make(map[interfaces.Artifact][]string),
+	}
+	var mu sync.Mutex
+	record := func(ev Event) {
+		mu.Lock()
+		defer mu.Unlock()
+		if ev.Result != nil {
+			run.Results[ev.Plugin] = ev.Result
+		}
+		notify(ev)
+	}
+	skip := func(name, reason string) {
+		record(Event{Plugin: name, State: StateSkipped, Result: interfaces.NewScanResult(name).Skip(reason)})
+	}
+
+	record(Event{Plugin: DNSPlugin, State: StateRunning})
+	dnsRes, err := o.scan(ctx, DNSPlugin, dnsPlugin, interfaces.ScanRequest{Domain: domain, RunID: runID, Options: o.options[DNSPlugin]})
+	if err == nil && dnsRes.ID == "" {
+		err = fmt.Errorf("failed to store DNS scan: %s", strings.Join(dnsRes.Errors, "; "))
+		dnsRes.Status = interfaces.ScanStatusFailed
+	}
+	record(Event{Plugin: DNSPlugin, State: stateOf(dnsRes), Result: dnsRes})
+	if err != nil {
+		for _, name := range names[1:] {
+			skip(name, "DNS scan failed")
+		}
+		return run, fmt.Errorf("DNS scan failed: %w", err)
+	}
+	run.DNSScanID = dnsRes.ID
+	run.addOutputs(dnsRes)
+
+	upstream, inputs := o.graph(names)
+	finished := map[string]bool{DNSPlugin: true}
+	pending := names[1:]
+	type outcome struct {
+		name string
+		res  *interfaces.ScanResult
+	}
+	done := make(chan outcome)
+	running := 0
+	for len(pending) > 0 || running > 0 {
+		var waiting []string
+		for _, name := range pending {
+			switch {
+			case ctx.Err() != nil:
+				skip(name, "scan cancelled")
+			case running >= o.workers || !ready(upstream[name], finished):
+				waiting = append(waiting, name)
+			default:
+				req := interfaces.ScanRequest{Domain: domain, RunID: runID, ParentID: run.DNSScanID, Options: o.options[name], Inputs: run.inputs(inputs[name])}
+				record(Event{Plugin: name, State: StateRunning})
+				running++
+				go func(name string) {
+					res, err := o.scan(ctx, name, o.plugins[name], req)
+					if err != nil {
+						log.Printf("%s scan failed for %s: %v", name, domain, err)
+					} else {
+						log.Printf("%s scan for %s finished in %s", name, domain, res.Duration())
+					}
+					record(Event{Plugin: name, State: stateOf(res), Result: res})
+					done <- outcome{name, res}
+				}(name)
+			}
+		}
+		pending = waiting
+		if running == 0 {
+			// Nothing is running and nothing else can start
+			for _, name := range pending {
+				skip(name, "dependency cycle")
+			}
+			break
+		}
+		out := <-done
+		running--
+		finished[out.name] = true
+		run.addOutputs(out.res)
+	}
+	return run, nil
+}
+
+// graph returns, for each of the named plugins, the plugins that must finish
+// before it starts and the artifacts it consumes. The DNS plugin always runs
+// first, so it is left out of the upstream lists.
+func (o *Orchestrator) graph(names []string) (map[string][]string, map[string][]interfaces.Artifact) {
+	list := make([]registry.Plugin, 0, len(names))
+	inputs := make(map[string][]interfaces.Artifact, len(names))
+	for _, name := range names {
+		meta, ok := o.lookup(name)
+		if !ok {
+			meta = registry.Plugin{Name: name}
+		}
+		list = append(list, meta)
+		inputs[name] = meta.Inputs
+	}
+	upstream := registry.Upstream(list)
+	for name, deps := range upstream {
+		kept := deps[:0]
+		for _, dep := range deps {
+			if dep != DNSPlugin {
+				kept = append(kept, dep)
+			}
+		}
+		upstream[name] = kept
+	}
+	return upstream, inputs
+}
+
+func ready(deps []string, finished map[string]bool) bool {
+	for _, dep := range deps {
+		if !finished[dep] {
+			return false
+		}
+	}
+	return true
+}
+
+// addOutputs merges the artifacts of a successful scan into the run.
+func (r *Run) addOutputs(res *interfaces.ScanResult) {
+	if res == nil || res.Status != interfaces.ScanStatusSucceeded {
+		return
+	}
+	for kind, values := range res.Outputs {
+		seen := make(map[string]bool, len(r.Outputs[kind]))
+		for _, v := range r.Outputs[kind] {
+			seen[v] = true
+		}
+		for _, v := range values {
+			if !seen[v] {
+				seen[v] = true
+				r.Outputs[kind] = append(r.Outputs[kind], v)
+			}
+		}
+	}
+}
+
+// inputs copies the requested artifacts produced so far, for passing to a plugin.
+func (r *Run) inputs(kinds []interfaces.Artifact) map[interfaces.Artifact][]string {
+	if len(kinds) == 0 {
+		return nil
+	}
+	in := make(map[interfaces.Artifact][]string, len(kinds))
+	for _, kind := range kinds {
+		if values := r.Outputs[kind]; len(values) > 0 {
+			in[kind] = append([]string(nil), values...)
+		}
+	}
+	return in
+}
+
+// scan invokes a plugin under its timeout. A plugin that overruns is
+// abandoned and recorded as timed out, and a plugin that failed without
+// returning an envelope still has a failed result recorded for it.
+func (o *Orchestrator) scan(ctx context.Context, name string, plugin interfaces.GenericPlugin, req interfaces.ScanRequest) (*interfaces.ScanResult, error) {
+	timeout := o.Timeout(name)
+	scanCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	req.Deadline, _ = scanCtx.Deadline()
+
+	type outcome struct {
+		res *interfaces.ScanResult
+		err error
+	}
+	done := make(chan outcome, 1)
+	started := time.Now()
+	go func() {
+		res, err := plugin.Scan(scanCtx, req)
+		done <- outcome{res, err}
+	}()
+
+	var res *interfaces.ScanResult
+	var err error
+	select {
+	case out := <-done:
+		res, err = out.res, out.err
+	case <-scanCtx.Done():
+		select {
+		case out := <-done:
+			res, err = out.res, out.err
+		default:
+			err = scanCtx.Err()
+		}
+	}
+	if ctx.Err() == nil && errors.Is(err, context.DeadlineExceeded) {
+		timedOut := interfaces.NewScanResult(name)
+		timedOut.StartedAt = started
+		return timedOut.TimeOut(timeout), context.DeadlineExceeded
+	}
+	if res != nil {
+		return res, err
+	}
+	if err == nil {
+		err = fmt.Errorf("%s returned no result", name)
+	}
+	return interfaces.NewScanResult(name).Fail(err), err
+}
+
+func stateOf(res *interfaces.ScanResult) PluginState {
+	switch res.Status {
+	case interfaces.ScanStatusSucceeded:
+		return StateSucceeded
+	case interfaces.ScanStatusSkipped:
+		return StateSkipped
+	default:
+		return StateFailed
+	}
+}
@@ -0,0 +1,188 @@
+// internal/orchestrator/orchestrator_test.go
+package orchestrator
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/moos3/sparta/internal/config"
+	"github.com/moos3/sparta/internal/db"
+	"github.com/moos3/sparta/internal/interfaces"
+	"github.com/moos3/sparta/internal/registry"
+	"github.com/stretchr/testify/assert"
+)
+
+type fakePlugin struct {
+	scan func(ctx context.Context, req interfaces.ScanRequest) (*interfaces.ScanResult, error)
+	reqs []interfaces.ScanRequest
+}
+
+func (f *fakePlugin) Initialize() error                  { return nil }
+func (f *fakePlugin) SetDatabase(db.Database)            {}
+func (f *fakePlugin) SetConfig(cfg *config.Config) error { return nil }
+
+func (f *fakePlugin) Scan(ctx context.Context, req interfaces.ScanRequest) (*interfaces.ScanResult, error) {
+	f.reqs = append(f.reqs, req)
+	return f.scan(ctx, req)
+}
+
+func succeeding(name, id string) *fakePlugin {
+	return &fakePlugin{scan: func(ctx context.Context, req interfaces.ScanRequest) (*interfaces.ScanResult, error) {
+		return interfaces.NewScanResult(name).Succeed(id, nil), nil
+	}}
+}
+
+func TestRun(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("DNSPluginNotLoaded", func(t *testing.T) {
+		_, err := New(map[string]interfaces.GenericPlugin{}, nil).Run(ctx, "run-1", "example.com", nil)
+		assert.ErrorIs(t, err, ErrDNSNotLoaded)
+	})
+
+	t.Run("PassesDNSScanID", func(t *testing.T) {
+		tls := succeeding("ScanTLS", "tls-1")
+		o := New(map[string]interfaces.GenericPlugin{"ScanDNS": succeeding("ScanDNS", "dns-1"), "ScanTLS": tls}, nil)
+
+		var events []Event
+		run, err := o.Run(ctx, "run-1", "example.com", func(ev Event) { events = append(events, ev) })
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.Equal(t, "dns-1", run.DNSScanID)
+		if assert.Len(t, tls.reqs, 1) {
+			assert.Equal(t, "example.com", tls.reqs[0].Domain)
+			assert.Equal(t, "run-1", tls.reqs[0].RunID)
+			assert.Equal(t, "dns-1", tls.reqs[0].ParentID)
+			assert.False(t, tls.reqs[0].Deadline.IsZero())
+		}
+		assert.Equal(t, interfaces.ScanStatusSucceeded, run.Results["ScanTLS"].Status)
+
+		var states []PluginState
+		for _, ev := range events {
+			states = append(states, ev.State)
+		}
+		assert.Equal(t, []PluginState{StateQueued, StateQueued, StateRunning, StateSucceeded, StateRunning, StateSucceeded}, states)
+	})
+
+	t.Run("DNSFailureSkipsOthers", func(t *testing.T) {
+		dns := &fakePlugin{scan: func(ctx context.Context, req interfaces.ScanRequest) (*interfaces.ScanResult, error) {
+			return nil, fmt.Errorf("lookup failed")
+		}}
+		tls := succeeding("ScanTLS", "tls-1")
+		run, err := New(map[string]interfaces.GenericPlugin{"ScanDNS": dns, "ScanTLS": tls}, nil).Run(ctx, "run-1", "example.com", nil)
+		assert.Error(t, err)
+		assert.Empty(t, tls.reqs)
+		assert.Equal(t, interfaces.ScanStatusFailed, run.Results["ScanDNS"].Status)
+		assert.Equal(t, interfaces.ScanStatusSkipped, run.Results["ScanTLS"].Status)
+	})
+
+	t.Run("CancelSkipsRemaining", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		cfg := &config.Config{}
+		cfg.Scan.Workers = 1
+		otx := &fakePlugin{scan: func(ctx context.Context, req interfaces.ScanRequest) (*interfaces.ScanResult, error) {
+			cancel()
+			return interfaces.NewScanResult("ScanOTX").Succeed("otx-1", nil), nil
+		}}
+		tls := succeeding("ScanTLS", "tls-1")
+		o := New(map[string]interfaces.GenericPlugin{"ScanDNS": succeeding("ScanDNS", "dns-1"), "ScanOTX": otx, "ScanTLS": tls}, cfg)
+		run, err := o.Run(ctx, "run-1", "example.com", nil)
+		assert.NoError(t, err)
+		assert.Empty(t, tls.reqs)
+		assert.Equal(t, interfaces.ScanStatusSkipped, run.Results["ScanTLS"].Status)
+	})
+
+	t.Run("RunsPluginsConcurrently", func(t *testing.T) {
+		slow := func(name string) *fakePlugin {
+			return &fakePlugin{scan: func(ctx context.Context, req interfaces.ScanRequest) (*interfaces.ScanResult, error) {
+				time.Sleep(100 * time.Millisecond)
+				return interfaces.NewScanResult(name).Succeed(name+"-1", nil), nil
+			}}
+		}
+		o := New(map[string]interfaces.GenericPlugin{
+			"ScanDNS":    succeeding("ScanDNS", "dns-1"),
+			"ScanTLS":    slow("ScanTLS"),
+			"ScanShodan": slow("ScanShodan"),
+			"ScanOTX":    slow("ScanOTX"),
+		}, nil)
+
+		started := time.Now()
+		run, err := o.Run(ctx, "run-1", "example.com", nil)
+		assert.NoError(t, err)
+		assert.Less(t, time.Since(started), 250*time.Millisecond)
+		assert.Len(t, run.Results, 4)
+	})
+
+	t.Run("TimeoutIsRecordedAsFailure", func(t *testing.T) {
+		cfg := &config.Config{}
+		cfg.Scan.PluginTimeouts = map[string]int{"ScanTLS": 1}
+		block := make(chan struct{})
+		defer close(block)
+		hung := &fakePlugin{scan: func(ctx context.Context, req interfaces.ScanRequest) (*interfaces.ScanResult, error) {
+			<-block // ignores ctx, like a plugin stuck in a call without context support
+			return nil, nil
+		}}
+		o := New(map[string]interfaces.GenericPlugin{"ScanDNS": succeeding("ScanDNS", "dns-1"), "ScanTLS": hung}, cfg)
+		assert.Equal(t, time.Second, o.Timeout("ScanTLS"))
+		assert.Equal(t, defaultTimeout, o.Timeout("ScanDNS"))
+
+		run, err := o.Run(ctx, "run-1", "example.com", nil)
+		assert.NoError(t, err)
+		res := run.Results["ScanTLS"]
+		assert.Equal(t, interfaces.ScanStatusFailed, res.Status)
+		assert.True(t, res.TimedOut)
+		assert.Equal(t, []string{"ScanTLS timed out after 1s"}, res.Errors)
+	})
+
+	t.Run("DependenciesFeedInputs", func(t *testing.T) {
+		dns := &fakePlugin{scan: func(ctx context.Context, req interfaces.ScanRequest) (*interfaces.ScanResult, error) {
+			return interfaces.NewScanResult("ScanDNS").Succeed("dns-1", nil).Produce(interfaces.ArtifactIPs, "192.0.2.1", "192.0.2.2"), nil
+		}}
+		var order []string
+		subs := &fakePlugin{scan: func(ctx context.Context, req interfaces.ScanRequest) (*interfaces.ScanResult, error) {
+			time.Sleep(50 * time.Millisecond)
+			order = append(order, "ScanCrtSh")
+			return interfaces.NewScanResult("ScanCrtSh").Succeed("crtsh-1", nil).Produce(interfaces.ArtifactSubdomains, "www.example.com"), nil
+		}}
+		consumer := &fakePlugin{scan: func(ctx context.Context, req interfaces.ScanRequest) (*interfaces.ScanResult, error) {
+			order = append(order, "ScanAlpha")
+			return interfaces.NewScanResult("ScanAlpha").Succeed("alpha-1", nil), nil
+		}}
+		o := New(map[string]interfaces.GenericPlugin{"ScanDNS": dns, "ScanCrtSh": subs, "ScanAlpha": consumer}, nil)
+		meta := map[string]registry.Plugin{
+			"ScanDNS":   {Name: "ScanDNS", Outputs: []interfaces.Artifact{interfaces.ArtifactIPs}},
+			"ScanCrtSh": {Name: "ScanCrtSh", Outputs: []interfaces.Artifact{interfaces.ArtifactSubdomains}},
+			"ScanAlpha": {Name: "ScanAlpha", Inputs: []interfaces.Artifact{interfaces.ArtifactIPs, interfaces.ArtifactSubdomains}},
+		}
+		o.lookup = func(name string) (registry.Plugin, bool) {
+			p, ok := meta[name]
+			return p, ok
+		}
+
+		run, err := o.Run(ctx, "run-1", "example.com", nil)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"ScanCrtSh", "ScanAlpha"}, order)
+		if assert.Len(t, consumer.reqs, 1) {
+			assert.Equal(t, []string{"192.0.2.1", "192.0.2.2"}, consumer.reqs[0].Input(interfaces.ArtifactIPs))
+			assert.Equal(t, []string{"www.example.com"}, consumer.reqs[0].Input(interfaces.ArtifactSubdomains))
+		}
+		assert.Equal(t, []string{"www.example.com"}, run.Outputs[interfaces.ArtifactSubdomains])
+	})
+
+	t.Run("CycleIsSkipped", func(t *testing.T) {
+		a, b := succeeding("ScanA", "a-1"), succeeding("ScanB", "b-1")
+		o := New(map[string]interfaces.GenericPlugin{"ScanDNS": succeeding("ScanDNS", "dns-1"), "ScanA": a, "ScanB": b}, nil)
+		o.lookup = func(name string) (registry.Plugin, bool) {
+			return registry.Plugin{Name: name, Dependencies: []string{map[string]string{"ScanA": "ScanB", "ScanB": "ScanA"}[name]}}, true
+		}
+
+		run, err := o.Run(ctx, "run-1", "example.com", nil)
+		assert.NoError(t, err)
+		assert.Empty(t, a.reqs)
+		assert.Equal(t, interfaces.ScanStatusSkipped, run.Results["ScanB"].Status)
+	})
+}
@@ -0,0 +1,512 @@
+// internal/pluginhost/host.go
+package pluginhost
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/moos3/sparta/internal/config"
+	"github.com/moos3/sparta/internal/db"
+	"github.com/moos3/sparta/internal/interfaces"
+	"github.com/moos3/sparta/internal/registry"
+	"github.com/moos3/sparta/pluginsdk"
+	"github.com/moos3/sparta/proto"
+	pluginpb "github.com/moos3/sparta/proto/plugin"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultStartTimeout = 10 * time.Second
+	exitGrace           = 2 * time.Second // wait for a process whose connection dropped to exit
+)
+
+// Host runs the external plugins listed under external_plugins in the config.
+type Host struct {
+	mu      sync.Mutex
+	plugins []*Plugin
+}
+
+// New creates a Host with no plugins running.
+func New() *Host {
+	return &Host{}
+}
+
+// Register starts each external plugin in cfg, asks it to describe itself
+// and registers it with the registry under the name it gives. A plugin that
+// fails to start or clashes with a registered name is logged and left out,
+// so one broken executable does not stop the server.
+func (h *Host) Register(cfg *config.Config) {
+	h.register(cfg.ExternalPlugins)
+}
+
+// Reload brings the running plugins in line with a reloaded config: plugins
+// whose external_plugins entry is unchanged keep running, those removed or
+// changed are stopped and unregistered, and new or changed entries are
+// started and registered as Register does. Scans still running on a stopped
+// plugin fail.
+func (h *Host) Reload(cfg *config.Config) {
+	h.mu.Lock()
+	stale := h.plugins
+	var kept []*Plugin
+	var added []config.ExternalPlugin
+	for _, spec := range cfg.ExternalPlugins {
+		i := indexOf(stale, spec)
+		if i < 0 {
+			added = append(added, spec)
+			continue
+		}
+		kept = append(kept, stale[i])
+		stale = append(stale[:i:i], stale[i+1:]...)
+	}
+	h.plugins = kept
+	h.mu.Unlock()
+
+	for _, p := range stale {
+		registry.Unregister(p.Name())
+		p.Close()
+		log.Printf("Stopped external plugin %s from %s", p.Name(), p.spec.Path)
+	}
+	h.register(added)
+}
+
+// indexOf returns the index of the plugin started from spec, or -1.
+func indexOf(plugins []*Plugin, spec config.ExternalPlugin) int {
+	for i, p := range plugins {
+		if reflect.DeepEqual(p.spec, spec) {
+			return i
+		}
+	}
+	return -1
+}
+
+// register starts and registers the plugins in specs.
+func (h *Host) register(specs []config.ExternalPlugin) {
+	for _, spec := range specs {
+		p, err := Launch(spec)
+		if err != nil {
+			log.Printf("Failed to start external plugin %s: %v", spec.Path, err)
+			continue
+		}
+		if _, dup := registry.Lookup(p.info.GetName()); dup {
+			log.Printf("External plugin %s is named %s, which is already registered", spec.Path, p.info.GetName())
+			p.Close()
+			continue
+		}
+		registry.Register(p.Registration())
+		h.mu.Lock()
+		h.plugins = append(h.plugins, p)
+		h.mu.Unlock()
+		log.Printf("Started external plugin %s v%s from %s", p.info.GetName(), p.info.GetVersion(), spec.Path)
+	}
+}
+
+// Close stops every plugin process.
+func (h *Host) Close() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for _, p := range h.plugins {
+		p.Close()
+	}
+}
+
+// Plugin is an external plugin process seen as a GenericPlugin. If the
+// process dies, the scan it was running fails and the next scan starts it
+// again; the server itself is unaffected.
+type Plugin struct {
+	spec config.ExternalPlugin
+	info *pluginpb.DescribeResponse
+
+	mu     sync.Mutex
+	db     db.Database
+	proc   *process
+	closed bool
+}
+
+// process is one run of a plugin executable and the connection to it.
+type process struct {
+	cmd    *exec.Cmd
+	conn   *grpc.ClientConn
+	client pluginpb.PluginClient
+	exited chan struct{} // closed once the process has exited
+	err    error         // exit status, valid after exited is closed
+}
+
+// Launch starts the plugin executable in spec, completes the handshake and
+// reads its description.
+func Launch(spec config.ExternalPlugin) (*Plugin, error) {
+	p := &Plugin{spec: spec}
+	proc, err := p.start()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), p.startTimeout())
+	defer cancel()
+	info, err := proc.client.Describe(ctx, &pluginpb.DescribeRequest{})
+	if err != nil {
+		proc.stop()
+		return nil, fmt.Errorf("failed to describe plugin: %w", err)
+	}
+	if info.GetName() == "" {
+		proc.stop()
+		return nil, errors.New("plugin described itself without a name")
+	}
+	p.info, p.proc = info, proc
+	return p, nil
+}
+
+func (p *Plugin) startTimeout() time.Duration {
+	if p.spec.StartTimeout > 0 {
+		return time.Duration(p.spec.StartTimeout) * time.Second
+	}
+	return defaultStartTimeout
+}
+
+// start runs the executable, waits for it to announce its address on the
+// first line of stdout, connects and performs the handshake.
+func (p *Plugin) start() (*process, error) {
+	name := filepath.Base(p.spec.Path)
+	cmd := exec.Command(p.spec.Path, p.spec.Args...)
+	cmd.Env = append(os.Environ(), pluginsdk.CookieKey+"="+pluginsdk.CookieValue)
+	cmd.Stderr = &logWriter{prefix: "[plugin " + name + "] "}
+	// A pipe of our own rather than StdoutPipe, which Wait closes while it
+	// may still be read
+	stdout, w, err := os.Pipe()
+	if err != nil {
+		return nil, fmt.Errorf("failed to open plugin stdout: %w", err)
+	}
+	cmd.Stdout = w
+	err = cmd.Start()
+	w.Close()
+	if err != nil {
+		stdout.Close()
+		return nil, fmt.Errorf("failed to start plugin: %w", err)
+	}
+	proc := &process{cmd: cmd, exited: make(chan struct{})}
+	go func() {
+		proc.err = cmd.Wait()
+		close(proc.exited)
+		log.Printf("External plugin %s exited: %v", name, proc.err)
+	}()
+
+	lines := make(chan string, 1)
+	go func() {
+		scanner := bufio.NewScanner(stdout)
+		if scanner.Scan() {
+			lines <- scanner.Text()
+		}
+		close(lines)
+		// Anything else the plugin prints goes to the log
+		for scanner.Scan() {
+			log.Printf("[plugin %s] %s", name, scanner.Text())
+		}
+		stdout.Close()
+	}()
+
+	var line string
+	select {
+	case l, ok := <-lines:
+		if !ok {
+			proc.stop()
+			return nil, errors.New("plugin exited before announcing its address")
+		}
+		line = l
+	case <-time.After(p.startTimeout()):
+		proc.stop()
+		return nil, fmt.Errorf("plugin did not announce its address within %s", p.startTimeout())
+	}
+
+	target, err := parseAnnouncement(line)
+	if err != nil {
+		proc.stop()
+		return nil, err
+	}
+	proc.conn, err = grpc.NewClient(target, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		proc.stop()
+		return nil, fmt.Errorf("failed to connect to plugin: %w", err)
+	}
+	proc.client = pluginpb.NewPluginClient(proc.conn)
+
+	ctx, cancel := context.WithTimeout(context.Background(), p.startTimeout())
+	defer cancel()
+	req := &pluginpb.HandshakeRequest{ProtocolVersion: pluginsdk.ProtocolVersion}
+	keys := make([]string, 0, len(p.spec.Settings))
+	for key := range p.spec.Settings {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		req.Settings = append(req.Settings, &pluginpb.Setting{Key: key, Value: p.spec.Settings[key]})
+	}
+	resp, err := proc.client.Handshake(ctx, req)
+	if err != nil {
+		proc.stop()
+		return nil, fmt.Errorf("plugin handshake failed: %w", err)
+	}
+	if resp.GetProtocolVersion() != pluginsdk.ProtocolVersion {
+		proc.stop()
+		return nil, fmt.Errorf("plugin speaks protocol version %d, server %d", resp.GetProtocolVersion(), pluginsdk.ProtocolVersion)
+	}
+	return proc, nil
+}
+
+// parseAnnouncement turns "<version>|<network>|<address>" into a gRPC target.
+func parseAnnouncement(line string) (string, error) {
+	parts := strings.Split(strings.TrimSpace(line), "|")
+	if len(parts) != 3 {
+		return "", fmt.Errorf("unexpected plugin announcement %q", line)
+	}
+	if version, err := strconv.Atoi(parts[0]); err != nil || version != pluginsdk.ProtocolVersion {
+		return "", fmt.Errorf("plugin speaks protocol version %s, server %d", parts[0], pluginsdk.ProtocolVersion)
+	}
+	switch parts[1] {
+	case "tcp":
+		return "passthrough:///" + parts[2], nil
+	case "unix":
+		return "unix://" + parts[2], nil
+	}
+	return "", fmt.Errorf("unsupported plugin network %q", parts[1])
+}
+
+func (proc *process) running() bool {
+	select {
+	case <-proc.exited:
+		return false
+	default:
+		return true
+	}
+}
+
+// stop closes the connection and kills the process if it is still running.
+func (proc *process) stop() {
+	if proc.conn != nil {
+		proc.conn.Close()
+	}
+	if proc.running() {
+		proc.cmd.Process.Kill()
+	}
+	<-proc.exited
+}
+
+// running returns a live process, restarting the executable if the last one
+// exited.
+func (p *Plugin) running() (*process, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.closed {
+		return nil, errors.New("plugin host is shut down")
+	}
+	if p.proc != nil && p.proc.running() {
+		return p.proc, nil
+	}
+	log.Printf("Restarting external plugin %s", p.info.GetName())
+	proc, err := p.start()
+	if err != nil {
+		return nil, fmt.Errorf("failed to restart plugin: %w", err)
+	}
+	p.proc = proc
+	return proc, nil
+}
+
+// Close stops the plugin process.
+func (p *Plugin) Close() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.closed = true
+	if p.proc != nil {
+		p.proc.stop()
+	}
+}
+
+// Registration returns the registry entry for the plugin.
+func (p *Plugin) Registration() registry.Plugin {
+	artifacts := func(kinds []string) []interfaces.Artifact {
+		var out []interfaces.Artifact
+		for _, kind := range kinds {
+			out = append(out, interfaces.Artifact(kind))
+		}
+		return out
+	}
+	return registry.Plugin{
+		Name:         p.info.GetName(),
+		Version:      p.info.GetVersion(),
+		Description:  p.info.GetDescription(),
+		Passive:      p.info.GetPassive(),
+		Dependencies: p.info.GetDependencies(),
+		Inputs:       artifacts(p.info.GetInputs()),
+		Outputs:      artifacts(p.info.GetOutputs()),
+		External:     true,
+		New:          func() interfaces.GenericPlugin { return p },
+	}
+}
+
+// Name returns the name the plugin described itself with.
+func (p *Plugin) Name() string {
+	return p.info.GetName()
+}
+
+// Initialize does nothing; the plugin was configured by the handshake.
+func (p *Plugin) Initialize() error {
+	return nil
+}
+
+// SetDatabase sets the database the plugin's results are stored in.
+func (p *Plugin) SetDatabase(db db.Database) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.db = db
+}
+
+// SetConfig does nothing; external plugins only see the settings in their
+// external_plugins entry.
+func (p *Plugin) SetConfig(cfg *config.Config) error {
+	return nil
+}
+
+// Health reports whether the plugin process is running and answers that it
+// can scan.
+func (p *Plugin) Health(ctx context.Context) error {
+	p.mu.Lock()
+	proc := p.proc
+	p.mu.Unlock()
+	if proc == nil || !proc.running() {
+		return errors.New("plugin process is not running")
+	}
+	resp, err := proc.client.Health(ctx, &pluginpb.HealthRequest{})
+	if err != nil {
+		return fmt.Errorf("plugin health check failed: %w", err)
+	}
+	if !resp.GetHealthy() {
+		return fmt.Errorf("plugin unhealthy: %s", resp.GetMessage())
+	}
+	return nil
+}
+
+// Scan implements the GenericPlugin interface. It sends the scan to the
+// plugin process and stores the result it returns.
+func (p *Plugin) Scan(ctx context.Context, req interfaces.ScanRequest) (*interfaces.ScanResult, error) {
+	name := p.info.GetName()
+	res := interfaces.NewScanResult(name)
+	p.mu.Lock()
+	database := p.db
+	p.mu.Unlock()
+	if database == nil {
+		err := fmt.Errorf("database connection not provided")
+		return res.Fail(err), err
+	}
+	ctx, cancel := req.Context(ctx)
+	defer cancel()
+
+	proc, err := p.running()
+	if err != nil {
+		return res.Fail(err), err
+	}
+	scanReq := &pluginpb.ScanRequest{Domain: req.Domain, RunId: req.RunID}
+	for key, value := range req.Options {
+		scanReq.Options = append(scanReq.Options, &pluginpb.Setting{Key: key, Value: value})
+	}
+	for kind, values := range req.Inputs {
+		scanReq.Inputs = append(scanReq.Inputs, &pluginpb.Artifact{Kind: string(kind), Values: values})
+	}
+	resp, err := proc.client.Scan(ctx, scanReq)
+	if err != nil {
+		if status.Code(err) == codes.Unavailable {
+			// The connection drops a moment before the exit is reaped; a
+			// process still running but unreachable is replaced on the next scan
+			select {
+			case <-proc.exited:
+			case <-time.After(exitGrace):
+				proc.stop()
+			}
+		}
+		if !proc.running() {
+			err = fmt.Errorf("plugin %s exited during scan: %v", name, proc.err)
+		} else {
+			err = fmt.Errorf("plugin %s scan failed: %w", name, err)
+		}
+		return res.Fail(err), err
+	}
+
+	res.Errors = append(res.Errors, resp.GetErrors()...)
+	switch resp.GetStatus() {
+	case string(interfaces.ScanStatusSkipped):
+		res.Status = interfaces.ScanStatusSkipped
+		res.FinishedAt = time.Now()
+		return res, nil
+	case string(interfaces.ScanStatusFailed):
+		err := fmt.Errorf("plugin %s reported failure", name)
+		return res.Fail(err), err
+	}
+
+	result := resp.GetResult()
+	if len(result) == 0 {
+		result = []byte("{}")
+	}
+	if !json.Valid(result) {
+		err := fmt.Errorf("plugin %s returned a result that is not JSON", name)
+		return res.Fail(err), err
+	}
+	domain := strings.TrimSuffix(strings.TrimSpace(strings.ToLower(req.Domain)), ".")
+	id, err := p.insertResult(database, req.RunID, domain, req.ParentID, result)
+	if err != nil {
+		res.Errors = append(res.Errors, fmt.Sprintf("failed to store result: %v", err))
+		log.Printf("Failed to store %s scan result for %s: %v", name, domain, err)
+	}
+	for _, out := range resp.GetOutputs() {
+		res.Produce(interfaces.Artifact(out.GetKind()), out.GetValues()...)
+	}
+	return res.Succeed(id, &proto.ExternalSecurityResult{Plugin: name, Result: string(result)}), nil
+}
+
+// insertResult stores an external plugin's result in external_scan_results.
+func (p *Plugin) insertResult(database db.Database, scanRunID, domain, dnsScanID string, result []byte) (string, error) {
+	id := uuid.New().String()
+	query := `
+		INSERT INTO external_scan_results (id, scan_run_id, plugin, domain, dns_scan_id, result, created_at)
+		VALUES ($1, $2, $3, $4, NULLIF($5, '')::uuid, $6, $7)
+	`
+	if _, err := database.Exec(query, id, scanRunID, p.info.GetName(), domain, dnsScanID, result, time.Now()); err != nil {
+		return "", fmt.Errorf("failed to insert external scan result: %w", err)
+	}
+	return id, nil
+}
+
+// logWriter sends a plugin's stderr to the server log line by line.
+type logWriter struct {
+	prefix string
+	mu     sync.Mutex
+	buf    []byte
+}
+
+func (w *logWriter) Write(b []byte) (int, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.buf = append(w.buf, b...)
+	for {
+		i := bytes.IndexByte(w.buf, '\n')
+		if i < 0 {
+			break
+		}
+		log.Print(w.prefix + string(w.buf[:i]))
+		w.buf = w.buf[i+1:]
+	}
+	return len(b), nil
+}
@@ -0,0 +1,246 @@
+// internal/pluginhost/host_test.go
+package pluginhost
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/moos3/sparta/internal/config"
+	"github.com/moos3/sparta/internal/interfaces"
+	"github.com/moos3/sparta/internal/registry"
+	"github.com/moos3/sparta/internal/testutils"
+	"github.com/moos3/sparta/pluginsdk"
+	"github.com/moos3/sparta/proto"
+	pluginpb "github.com/moos3/sparta/proto/plugin"
+	"github.com/stretchr/testify/assert"
+)
+
+// The test binary doubles as the plugin executable: started by the host with
+// this variable set, it serves testScanner instead of running the tests.
+const helperEnv = "PLUGINHOST_TEST_PLUGIN"
+
+func TestMain(m *testing.M) {
+	if os.Getenv(helperEnv) == "1" {
+		pluginsdk.Serve(&testScanner{})
+		os.Exit(0)
+	}
+	os.Exit(m.Run())
+}
+
+type testScanner struct {
+	greeting string
+}
+
+func (s *testScanner) Describe() *pluginpb.DescribeResponse {
+	return &pluginpb.DescribeResponse{
+		Name:         "AcmeScanner",
+		Version:      "0.1.0",
+		Passive:      true,
+		Dependencies: []string{"ScanDNS"},
+		Outputs:      []string{"subdomains"},
+	}
+}
+
+func (s *testScanner) Configure(settings map[string]string) error {
+	s.greeting = settings["greeting"]
+	return nil
+}
+
+func (s *testScanner) Scan(ctx context.Context, req *pluginpb.ScanRequest) (*pluginpb.ScanResponse, error) {
+	switch req.GetDomain() {
+	case "crash.example.com":
+		os.Exit(3)
+	case "panic.example.com":
+		panic("boom")
+	case "skip.example.com":
+		return &pluginpb.ScanResponse{Status: "skipped", Errors: []string{"nothing to do"}}, nil
+	}
+	return &pluginpb.ScanResponse{
+		Status:  "succeeded",
+		Result:  []byte(`{"greeting":"` + s.greeting + `"}`),
+		Outputs: []*pluginpb.Artifact{{Kind: "subdomains", Values: []string{"www." + req.GetDomain()}}},
+	}, nil
+}
+
+func (s *testScanner) Health(ctx context.Context) error {
+	if s.greeting == "" {
+		return errors.New("no greeting configured")
+	}
+	return nil
+}
+
+func launchTestPlugin(t *testing.T) *Plugin {
+	t.Setenv(helperEnv, "1")
+	p, err := Launch(config.ExternalPlugin{Path: os.Args[0], Settings: map[string]string{"greeting": "hello"}})
+	if err != nil {
+		t.Fatalf("failed to launch plugin: %v", err)
+	}
+	t.Cleanup(p.Close)
+	return p
+}
+
+func TestLaunch(t *testing.T) {
+	p := launchTestPlugin(t)
+	reg := p.Registration()
+	assert.Equal(t, "AcmeScanner", reg.Name)
+	assert.True(t, reg.External)
+	assert.Equal(t, []interfaces.Artifact{interfaces.ArtifactSubdomains}, reg.Outputs)
+	assert.Same(t, p, reg.New())
+	assert.NoError(t, p.Health(context.Background()))
+}
+
+func TestReload(t *testing.T) {
+	t.Setenv(helperEnv, "1")
+	spec := func(greeting string) *config.Config {
+		return &config.Config{ExternalPlugins: []config.ExternalPlugin{
+			{Path: os.Args[0], Settings: map[string]string{"greeting": greeting}},
+		}}
+	}
+	h := New()
+	t.Cleanup(func() {
+		h.Close()
+		registry.Unregister("AcmeScanner")
+	})
+	current := func() *Plugin {
+		reg, ok := registry.Lookup("AcmeScanner")
+		if !ok {
+			return nil
+		}
+		return reg.New().(*Plugin)
+	}
+
+	h.Register(spec("hello"))
+	first := current()
+	if !assert.NotNil(t, first) {
+		return
+	}
+
+	// An unchanged entry keeps its process
+	h.Reload(spec("hello"))
+	assert.Same(t, first, current())
+
+	// Changed settings restart the plugin under the same name
+	h.Reload(spec("bonjour"))
+	second := current()
+	if assert.NotNil(t, second) {
+		assert.NotSame(t, first, second)
+		assert.Equal(t, "bonjour", second.spec.Settings["greeting"])
+		assert.NoError(t, second.Health(context.Background()))
+	}
+	assert.True(t, first.closed)
+
+	// A removed entry is stopped and unregistered
+	h.Reload(&config.Config{})
+	assert.Nil(t, current())
+	assert.Empty(t, h.plugins)
+}
+
+func TestReloadFromWatcher(t *testing.T) {
+	t.Setenv(helperEnv, "1")
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	write := func(greeting string) {
+		data := fmt.Sprintf("external_plugins:\n  - path: %q\n    settings:\n      greeting: %s\n", os.Args[0], greeting)
+		if err := os.WriteFile(path, []byte(data), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	write("hello")
+	w, err := config.Watch(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	h := New()
+	t.Cleanup(func() {
+		h.Close()
+		registry.Unregister("AcmeScanner")
+	})
+	h.Register(w.Config())
+	w.OnChange(func(cfg *config.Config) error {
+		h.Reload(cfg)
+		return nil
+	})
+	first := h.plugins[0]
+
+	write("bonjour")
+	if !assert.NoError(t, w.Reload()) {
+		return
+	}
+	if assert.Len(t, h.plugins, 1) {
+		assert.NotSame(t, first, h.plugins[0])
+		assert.Equal(t, "bonjour", h.plugins[0].spec.Settings["greeting"])
+	}
+	assert.True(t, first.closed)
+	reg, ok := registry.Lookup("AcmeScanner")
+	if assert.True(t, ok) {
+		assert.Same(t, h.plugins[0], reg.New())
+	}
+}
+
+func TestScan(t *testing.T) {
+	p := launchTestPlugin(t)
+	stubDb := testutils.NewStubDB()
+	p.SetDatabase(stubDb)
+
+	insert := stubDb.Expect("INSERT INTO external_scan_results").WillReturnResult(1)
+	res, err := p.Scan(context.Background(), interfaces.ScanRequest{Domain: "example.com", RunID: "run-1"})
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, interfaces.ScanStatusSucceeded, res.Status)
+	assert.Equal(t, `{"greeting":"hello"}`, res.Result.(*proto.ExternalSecurityResult).GetResult())
+	assert.Equal(t, []string{"www.example.com"}, res.Outputs[interfaces.ArtifactSubdomains])
+	assert.Equal(t, "AcmeScanner", insert.Args()[2])
+
+	res, err = p.Scan(context.Background(), interfaces.ScanRequest{Domain: "skip.example.com"})
+	assert.NoError(t, err)
+	assert.Equal(t, interfaces.ScanStatusSkipped, res.Status)
+	assert.Equal(t, []string{"nothing to do"}, res.Errors)
+
+	res, err = p.Scan(context.Background(), interfaces.ScanRequest{Domain: "panic.example.com"})
+	assert.ErrorContains(t, err, "plugin panicked")
+	assert.Equal(t, interfaces.ScanStatusFailed, res.Status)
+	assert.NoError(t, stubDb.ExpectationsWereMet())
+}
+
+func TestScanSurvivesPluginCrash(t *testing.T) {
+	p := launchTestPlugin(t)
+	stubDb := testutils.NewStubDB()
+	p.SetDatabase(stubDb)
+
+	res, err := p.Scan(context.Background(), interfaces.ScanRequest{Domain: "crash.example.com"})
+	assert.Error(t, err)
+	assert.Equal(t, interfaces.ScanStatusFailed, res.Status)
+	assert.Error(t, p.Health(context.Background()))
+
+	// The next scan starts the plugin again
+	stubDb.Expect("INSERT INTO external_scan_results").WillReturnResult(1)
+	res, err = p.Scan(context.Background(), interfaces.ScanRequest{Domain: "example.com"})
+	assert.NoError(t, err)
+	assert.Equal(t, interfaces.ScanStatusSucceeded, res.Status)
+	assert.NoError(t, p.Health(context.Background()))
+}
+
+func TestLaunchRejectsBadExecutables(t *testing.T) {
+	_, err := Launch(config.ExternalPlugin{Path: "/nonexistent/plugin"})
+	assert.ErrorContains(t, err, "failed to start plugin")
+
+	// The test binary without the helper variable runs the tests and prints
+	// their outcome instead of an address
+	_, err = Launch(config.ExternalPlugin{Path: os.Args[0], Args: []string{"-test.run=^$"}, StartTimeout: 5})
+	assert.ErrorContains(t, err, "unexpected plugin announcement")
+}
+
+func TestParseAnnouncement(t *testing.T) {
+	target, err := parseAnnouncement("1|tcp|127.0.0.1:4100\n")
+	assert.NoError(t, err)
+	assert.Equal(t, "passthrough:///127.0.0.1:4100", target)
+
+	_, err = parseAnnouncement("2|tcp|127.0.0.1:4100")
+	assert.ErrorContains(t, err, "protocol version 2")
+	_, err = parseAnnouncement("hello")
+	assert.Error(t, err)
+}
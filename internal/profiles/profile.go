@@ -0,0 +1,158 @@
+// internal/profiles/profile.go
+package profiles
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"time"
+
+	"github.com/moos3/sparta/internal/config"
+	"github.com/moos3/sparta/internal/orchestrator"
+	"github.com/moos3/sparta/internal/registry"
+)
+
+// Names of the built-in profiles.
+const (
+	Passive = "passive" // only plugins that never contact the target
+	Quick   = "quick"   // DNS, TLS and WHOIS under short timeouts
+	Full    = "full"    // every loaded plugin
+)
+
+// Where a profile is defined.
+const (
+	SourceBuiltIn = "builtin"
+	SourceConfig  = "config"
+	SourceUser    = "user"
+)
+
+// Profile is a named selection of plugins, along with the options and
+// timeouts they run with.
+type Profile struct {
+	Name        string
+	Description string
+	Plugins     []string                     // empty means every loaded plugin
+	PassiveOnly bool                         // drop plugins that contact the target directly
+	Options     map[string]map[string]string // plugin-specific options keyed by plugin name
+	Timeouts    map[string]time.Duration     // per-plugin timeouts keyed by plugin name
+	Source      string
+	OwnerID     string // set on profiles users define through the API
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+var builtIn = map[string]*Profile{
+	Passive: {
+		Name:        Passive,
+		Description: "Only plugins that never contact the domain; skips the TLS handshake, HSTS request and DNSSEC checks",
+		PassiveOnly: true,
+		Source:      SourceBuiltIn,
+	},
+	Quick: {
+		Name:        Quick,
+		Description: "DNS, TLS and WHOIS checks with ten-second timeouts",
+		Plugins:     []string{orchestrator.DNSPlugin, "ScanTLS", "ScanWhois"},
+		Timeouts: map[string]time.Duration{
+			orchestrator.DNSPlugin: 10 * time.Second,
+			"ScanTLS":              10 * time.Second,
+			"ScanWhois":            10 * time.Second,
+		},
+		Source: SourceBuiltIn,
+	},
+	Full: {
+		Name:        Full,
+		Description: "Every loaded plugin",
+		Source:      SourceBuiltIn,
+	},
+}
+
+// BuiltIn returns the built-in profiles sorted by name.
+func BuiltIn() []*Profile {
+	list := make([]*Profile, 0, len(builtIn))
+	for _, p := range builtIn {
+		list = append(list, p)
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].Name < list[j].Name })
+	return list
+}
+
+// fromConfig converts a profile defined under scan.profiles.
+func fromConfig(name string, c config.ScanProfile) *Profile {
+	p := &Profile{
+		Name:        name,
+		Description: c.Description,
+		Plugins:     c.Plugins,
+		PassiveOnly: c.PassiveOnly,
+		Options:     c.Options,
+		Source:      SourceConfig,
+	}
+	if len(c.Timeouts) > 0 {
+		p.Timeouts = make(map[string]time.Duration, len(c.Timeouts))
+		for plugin, seconds := range c.Timeouts {
+			p.Timeouts[plugin] = time.Duration(seconds) * time.Second
+		}
+	}
+	return p
+}
+
+// Apply returns o restricted to the profile's plugins, with its options and
+// timeouts. The DNS plugin always stays, as every scan starts with it; in a
+// passive-only profile it skips its DNSSEC checks and MTA-STS policy fetch.
+func (p *Profile) Apply(o *orchestrator.Orchestrator) *orchestrator.Orchestrator {
+	if len(p.Plugins) > 0 {
+		o = o.Only(append([]string{orchestrator.DNSPlugin}, p.Plugins...))
+	}
+	options := p.Options
+	if p.PassiveOnly {
+		o = o.PassiveOnly()
+		options = make(map[string]map[string]string, len(p.Options)+1)
+		for plugin, opts := range p.Options {
+			options[plugin] = opts
+		}
+		dns := map[string]string{"dnssec": "false", "mta_sts_policy": "false"}
+		for key, value := range p.Options[orchestrator.DNSPlugin] {
+			if _, active := dns[key]; !active {
+				dns[key] = value
+			}
+		}
+		options[orchestrator.DNSPlugin] = dns
+	}
+	return o.WithOptions(options).WithTimeouts(p.Timeouts)
+}
+
+var namePattern = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]{0,62}$`)
+
+// lookup finds plugin metadata; registry.Lookup outside tests.
+var lookup = registry.Lookup
+
+// validate checks a profile's name and that it names registered plugins.
+func (p *Profile) validate() error {
+	if !namePattern.MatchString(p.Name) {
+		return fmt.Errorf("%w: name must be 1-63 lowercase letters, digits, '-' or '_'", ErrInvalid)
+	}
+	known := func(plugin string) error {
+		if _, ok := lookup(plugin); !ok {
+			return fmt.Errorf("%w: unknown plugin %s", ErrInvalid, plugin)
+		}
+		return nil
+	}
+	for _, plugin := range p.Plugins {
+		if err := known(plugin); err != nil {
+			return err
+		}
+	}
+	for plugin := range p.Options {
+		if err := known(plugin); err != nil {
+			return err
+		}
+	}
+	for plugin, timeout := range p.Timeouts {
+		if err := known(plugin); err != nil {
+			return err
+		}
+		if timeout < time.Second {
+			return fmt.Errorf("%w: timeout for %s must be at least one second", ErrInvalid, plugin)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,155 @@
+// internal/profiles/profiles_test.go
+package profiles
+
+import (
+	"context"
+	"database/sql/driver"
+	"testing"
+	"time"
+
+	"github.com/moos3/sparta/internal/config"
+	"github.com/moos3/sparta/internal/db"
+	"github.com/moos3/sparta/internal/interfaces"
+	"github.com/moos3/sparta/internal/orchestrator"
+	"github.com/moos3/sparta/internal/registry"
+	"github.com/moos3/sparta/internal/testutils"
+	"github.com/stretchr/testify/assert"
+)
+
+type fakePlugin struct {
+	name string
+	reqs []interfaces.ScanRequest
+}
+
+func (f *fakePlugin) Initialize() error                  { return nil }
+func (f *fakePlugin) SetDatabase(db.Database)            {}
+func (f *fakePlugin) SetConfig(cfg *config.Config) error { return nil }
+
+func (f *fakePlugin) Scan(ctx context.Context, req interfaces.ScanRequest) (*interfaces.ScanResult, error) {
+	f.reqs = append(f.reqs, req)
+	return interfaces.NewScanResult(f.name).Succeed(f.name+"-1", nil), nil
+}
+
+func init() {
+	for name, passive := range map[string]bool{"ScanDNS": true, "ScanTLS": false, "ScanOTX": true, "ScanWhois": true} {
+		registry.Register(registry.Plugin{Name: name, Passive: passive, New: func() interfaces.GenericPlugin { return nil }})
+	}
+}
+
+func loaded() (*orchestrator.Orchestrator, map[string]*fakePlugin) {
+	fakes := map[string]*fakePlugin{}
+	plugins := map[string]interfaces.GenericPlugin{}
+	for _, name := range []string{"ScanDNS", "ScanTLS", "ScanOTX"} {
+		fakes[name] = &fakePlugin{name: name}
+		plugins[name] = fakes[name]
+	}
+	return orchestrator.New(plugins, nil), fakes
+}
+
+func TestApply(t *testing.T) {
+	t.Run("Passive", func(t *testing.T) {
+		o, fakes := loaded()
+		o = builtIn[Passive].Apply(o)
+		assert.Equal(t, []string{"ScanDNS", "ScanOTX"}, o.Plugins())
+
+		_, err := o.Run(context.Background(), "run-1", "example.com", nil)
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.Empty(t, fakes["ScanTLS"].reqs)
+		if assert.Len(t, fakes["ScanDNS"].reqs, 1) {
+			assert.Equal(t, "false", fakes["ScanDNS"].reqs[0].Options["dnssec"])
+			assert.Equal(t, "false", fakes["ScanDNS"].reqs[0].Options["mta_sts_policy"])
+		}
+	})
+
+	t.Run("QuickKeepsLoadedPluginsWithShortTimeouts", func(t *testing.T) {
+		o, _ := loaded()
+		o = builtIn[Quick].Apply(o)
+		assert.Equal(t, []string{"ScanDNS", "ScanTLS"}, o.Plugins())
+		assert.Equal(t, 10*time.Second, o.Timeout("ScanTLS"))
+	})
+
+	t.Run("CustomAlwaysRunsDNS", func(t *testing.T) {
+		o, fakes := loaded()
+		p := &Profile{Plugins: []string{"ScanOTX"}, Options: map[string]map[string]string{"ScanOTX": {"sections": "general"}}}
+		o = p.Apply(o)
+		assert.Equal(t, []string{"ScanDNS", "ScanOTX"}, o.Plugins())
+
+		_, err := o.Run(context.Background(), "run-1", "example.com", nil)
+		if assert.NoError(t, err) && assert.Len(t, fakes["ScanOTX"].reqs, 1) {
+			assert.Equal(t, "general", fakes["ScanOTX"].reqs[0].Options["sections"])
+		}
+	})
+}
+
+var profileCols = []string{"owner_id", "name", "description", "plugins", "passive_only", "options", "timeouts", "created_at", "updated_at"}
+
+func TestGet(t *testing.T) {
+	cfg := &config.Config{}
+	cfg.Scan.Profiles = map[string]config.ScanProfile{
+		"mail":  {Plugins: []string{"ScanDNS"}, Timeouts: map[string]int{"ScanDNS": 5}},
+		"quick": {Plugins: []string{"ScanTLS"}},
+	}
+
+	t.Run("DefaultIsFull", func(t *testing.T) {
+		p, err := NewStore(testutils.NewStubDB(), cfg).Get("user-1", "")
+		if assert.NoError(t, err) {
+			assert.Equal(t, Full, p.Name)
+		}
+	})
+
+	t.Run("ConfigCannotReplaceBuiltIn", func(t *testing.T) {
+		p, err := NewStore(testutils.NewStubDB(), cfg).Get("user-1", "quick")
+		if assert.NoError(t, err) {
+			assert.Equal(t, SourceBuiltIn, p.Source)
+		}
+	})
+
+	t.Run("Config", func(t *testing.T) {
+		p, err := NewStore(testutils.NewStubDB(), cfg).Get("user-1", "mail")
+		if assert.NoError(t, err) {
+			assert.Equal(t, SourceConfig, p.Source)
+			assert.Equal(t, 5*time.Second, p.Timeouts["ScanDNS"])
+		}
+	})
+
+	t.Run("User", func(t *testing.T) {
+		stubDb := testutils.NewStubDB()
+		now := time.Now()
+		query := stubDb.Expect("FROM scan_profiles WHERE owner_id = $1 AND name = $2").WillReturnRows(profileCols,
+			[]driver.Value{"user-1", "edge", "", "{ScanDNS,ScanOTX}", true, []byte(`{"ScanOTX":{"sections":"general"}}`), []byte(`{"ScanOTX":20}`), now, now})
+
+		p, err := NewStore(stubDb, cfg).Get("user-1", "edge")
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.Equal(t, []driver.Value{"user-1", "edge"}, query.Args())
+		assert.Equal(t, SourceUser, p.Source)
+		assert.Equal(t, []string{"ScanDNS", "ScanOTX"}, p.Plugins)
+		assert.True(t, p.PassiveOnly)
+		assert.Equal(t, "general", p.Options["ScanOTX"]["sections"])
+		assert.Equal(t, 20*time.Second, p.Timeouts["ScanOTX"])
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		stubDb := testutils.NewStubDB()
+		stubDb.Expect("FROM scan_profiles").WillReturnRows(profileCols)
+		_, err := NewStore(stubDb, cfg).Get("user-1", "stealth")
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+}
+
+func TestSaveValidates(t *testing.T) {
+	store := NewStore(testutils.NewStubDB(), nil)
+	for _, p := range []*Profile{
+		{Name: "Bad Name"},
+		{Name: "full"},
+		{Name: "edge", Plugins: []string{"ScanNope"}},
+		{Name: "edge", Options: map[string]map[string]string{"ScanNope": {"a": "b"}}},
+		{Name: "edge", Timeouts: map[string]time.Duration{"ScanTLS": 0}},
+	} {
+		_, err := store.Save(p)
+		assert.ErrorIs(t, err, ErrInvalid, p.Name)
+	}
+}
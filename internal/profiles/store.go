@@ -0,0 +1,210 @@
+// internal/profiles/store.go
+package profiles
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/moos3/sparta/internal/config"
+	"github.com/moos3/sparta/internal/db"
+)
+
+var (
+	// ErrNotFound is returned when no profile has the requested name.
+	ErrNotFound = errors.New("scan profile not found")
+	// ErrInvalid is wrapped by errors for profiles that cannot be saved.
+	ErrInvalid = errors.New("invalid scan profile")
+)
+
+// Store resolves profile names to the built-in profiles, those defined in
+// config, and those users define through the API, in that order of
+// precedence. Only user-defined profiles are stored in Postgres; each user
+// sees only their own.
+type Store struct {
+	db     db.Database
+	mu     sync.RWMutex
+	config map[string]*Profile
+}
+
+// NewStore creates a Store over database with the profiles defined under
+// scan.profiles in cfg. A config profile may not reuse a built-in name.
+func NewStore(database db.Database, cfg *config.Config) *Store {
+	s := &Store{db: database}
+	s.SetConfig(cfg)
+	return s
+}
+
+// SetConfig replaces the config profiles with those under scan.profiles in
+// cfg, as when the config is reloaded.
+func (s *Store) SetConfig(cfg *config.Config) {
+	profiles := make(map[string]*Profile)
+	if cfg != nil {
+		for name, c := range cfg.Scan.Profiles {
+			if _, ok := builtIn[name]; ok {
+				log.Printf("Ignoring scan profile %s in config: the name is reserved for a built-in profile", name)
+				continue
+			}
+			profiles[name] = fromConfig(name, c)
+		}
+	}
+	s.mu.Lock()
+	s.config = profiles
+	s.mu.Unlock()
+}
+
+// configured returns the current config profiles.
+func (s *Store) configured() map[string]*Profile {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.config
+}
+
+const profileColumns = `owner_id, name, description, plugins, passive_only, options, timeouts, created_at, updated_at`
+
+// Get returns the profile userID scans with under name. An empty name is
+// the full profile.
+func (s *Store) Get(userID, name string) (*Profile, error) {
+	if name == "" {
+		name = Full
+	}
+	if p, ok := builtIn[name]; ok {
+		return p, nil
+	}
+	if p, ok := s.configured()[name]; ok {
+		return p, nil
+	}
+	query := `SELECT ` + profileColumns + ` FROM scan_profiles WHERE owner_id = $1 AND name = $2`
+	p, err := scanProfile(s.db.QueryRow(query, userID, name))
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("%w: %s", ErrNotFound, name)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get scan profile: %w", err)
+	}
+	return p, nil
+}
+
+// List returns the built-in and config profiles followed by userID's own,
+// each group sorted by name.
+func (s *Store) List(userID string) ([]*Profile, error) {
+	list := BuiltIn()
+	configured := s.configured()
+	names := make([]string, 0, len(configured))
+	for name := range configured {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		list = append(list, configured[name])
+	}
+
+	rows, err := s.db.Query(`SELECT `+profileColumns+` FROM scan_profiles WHERE owner_id = $1 ORDER BY name`, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list scan profiles: %w", err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		p, err := scanProfile(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan profile row: %w", err)
+		}
+		list = append(list, p)
+	}
+	return list, rows.Err()
+}
+
+// Save creates or replaces one of p.OwnerID's profiles. Built-in and config
+// profile names are reserved.
+func (s *Store) Save(p *Profile) (*Profile, error) {
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+	if _, ok := builtIn[p.Name]; ok {
+		return nil, fmt.Errorf("%w: %s is a built-in profile", ErrInvalid, p.Name)
+	}
+	if _, ok := s.configured()[p.Name]; ok {
+		return nil, fmt.Errorf("%w: %s is defined in the server config", ErrInvalid, p.Name)
+	}
+
+	options, err := json.Marshal(p.Options)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal profile options: %w", err)
+	}
+	seconds := make(map[string]int64, len(p.Timeouts))
+	for plugin, t := range p.Timeouts {
+		seconds[plugin] = int64(t / time.Second)
+	}
+	timeouts, err := json.Marshal(seconds)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal profile timeouts: %w", err)
+	}
+
+	saved := *p
+	saved.Source = SourceUser
+	saved.UpdatedAt = time.Now()
+	query := `
+		INSERT INTO scan_profiles (owner_id, name, description, plugins, passive_only, options, timeouts, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $8)
+		ON CONFLICT (owner_id, name) DO UPDATE SET
+			description = EXCLUDED.description, plugins = EXCLUDED.plugins, passive_only = EXCLUDED.passive_only,
+			options = EXCLUDED.options, timeouts = EXCLUDED.timeouts, updated_at = EXCLUDED.updated_at
+		RETURNING created_at
+	`
+	err = s.db.QueryRow(query, p.OwnerID, p.Name, p.Description, pq.Array(p.Plugins), p.PassiveOnly,
+		string(options), string(timeouts), saved.UpdatedAt).Scan(&saved.CreatedAt)
+	if err != nil {
+		return nil, fmt.Errorf("failed to save scan profile: %w", err)
+	}
+	return &saved, nil
+}
+
+// Delete removes one of userID's profiles.
+func (s *Store) Delete(userID, name string) error {
+	res, err := s.db.Exec(`DELETE FROM scan_profiles WHERE owner_id = $1 AND name = $2`, userID, name)
+	if err != nil {
+		return fmt.Errorf("failed to delete scan profile: %w", err)
+	}
+	if n, err := res.RowsAffected(); err == nil && n == 0 {
+		return fmt.Errorf("%w: %s", ErrNotFound, name)
+	}
+	return nil
+}
+
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanProfile(row rowScanner) (*Profile, error) {
+	p := &Profile{Source: SourceUser}
+	var options, timeouts []byte
+	err := row.Scan(&p.OwnerID, &p.Name, &p.Description, pq.Array(&p.Plugins), &p.PassiveOnly,
+		&options, &timeouts, &p.CreatedAt, &p.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+	if len(options) > 0 {
+		if err := json.Unmarshal(options, &p.Options); err != nil {
+			return nil, fmt.Errorf("failed to decode options of profile %s: %w", p.Name, err)
+		}
+	}
+	if len(timeouts) > 0 {
+		var seconds map[string]int64
+		if err := json.Unmarshal(timeouts, &seconds); err != nil {
+			return nil, fmt.Errorf("failed to decode timeouts of profile %s: %w", p.Name, err)
+		}
+		for plugin, n := range seconds {
+			if p.Timeouts == nil {
+				p.Timeouts = make(map[string]time.Duration, len(seconds))
+			}
+			p.Timeouts[plugin] = time.Duration(n) * time.Second
+		}
+	}
+	return p, nil
+}
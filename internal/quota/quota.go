@@ -0,0 +1,248 @@
+// internal/quota/quota.go
+package quota
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/moos3/sparta/internal/config"
+	"github.com/moos3/sparta/internal/db"
+)
+
+// ErrExhausted is wrapped by errors for calls refused because a provider's
+// daily or monthly budget is spent.
+var ErrExhausted = errors.New("provider quota exhausted")
+
+// Tracker counts calls to intelligence providers per UTC day in Postgres, so
+// every server instance shares the counts and they survive restarts, and
+// refuses calls once a budget under quotas in the config is spent. A nil
+// Tracker, or one without a database, counts and refuses nothing.
+type Tracker struct {
+	db  db.Database
+	mu  sync.RWMutex
+	cfg *config.Config
+	now func() time.Time
+}
+
+// New creates a Tracker over database with the budgets in cfg.
+func New(database db.Database, cfg *config.Config) *Tracker {
+	return &Tracker{db: database, cfg: cfg, now: time.Now}
+}
+
+// Usage is a provider's consumption today and this month. Limits of zero are
+// unlimited.
+type Usage struct {
+	Provider     string
+	DailyCalls   int
+	DailyLimit   int
+	MonthlyCalls int
+	MonthlyLimit int
+}
+
+// DailyRemaining returns the calls left today, or -1 if there is no daily limit.
+func (u Usage) DailyRemaining() int {
+	return remaining(u.DailyCalls, u.DailyLimit)
+}
+
+// MonthlyRemaining returns the calls left this month, or -1 if there is no
+// monthly limit.
+func (u Usage) MonthlyRemaining() int {
+	return remaining(u.MonthlyCalls, u.MonthlyLimit)
+}
+
+// Exhausted reports whether either budget is spent.
+func (u Usage) Exhausted() bool {
+	return u.DailyRemaining() == 0 || u.MonthlyRemaining() == 0
+}
+
+func remaining(calls, limit int) int {
+	if limit <= 0 {
+		return -1
+	}
+	if calls >= limit {
+		return 0
+	}
+	return limit - calls
+}
+
+func (t *Tracker) enabled() bool {
+	return t != nil && t.db != nil
+}
+
+// SetConfig replaces the budgets with those in cfg, as when the config is
+// reloaded.
+func (t *Tracker) SetConfig(cfg *config.Config) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.cfg = cfg
+}
+
+func (t *Tracker) limit(provider string) config.ProviderQuota {
+	if t == nil {
+		return config.ProviderQuota{}
+	}
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	if t.cfg == nil {
+		return config.ProviderQuota{}
+	}
+	return t.cfg.Quotas[provider]
+}
+
+// period returns the start of the current UTC day and month.
+func (t *Tracker) period() (day, month time.Time) {
+	now := t.now().UTC()
+	day = time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	month = time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+	return day, month
+}
+
+// Use counts one call to provider. Once the provider's daily or monthly
+// budget is spent it returns an error wrapping ErrExhausted and the call is
+// not counted. Failing to record a call is logged rather than refusing it.
+func (t *Tracker) Use(provider string) error {
+	if !t.enabled() {
+		return nil
+	}
+	limit := t.limit(provider)
+	day, month := t.period()
+	// The insert only happens while the month has budget left, and the
+	// update only while the day has
+	query := `
+		WITH month AS (
+			SELECT COALESCE(SUM(calls), 0) AS calls FROM provider_usage WHERE provider = $1 AND day >= $3
+		)
+		INSERT INTO provider_usage (provider, day, calls)
+		SELECT $1, $2, 1 FROM month WHERE $5 = 0 OR month.calls < $5
+		ON CONFLICT (provider, day) DO UPDATE SET calls = provider_usage.calls + 1
+		WHERE $4 = 0 OR provider_usage.calls < $4
+		RETURNING calls
+	`
+	var calls int
+	err := t.db.QueryRow(query, provider, day, month, limit.Daily, limit.Monthly).Scan(&calls)
+	if err == sql.ErrNoRows {
+		return fmt.Errorf("%w: %s", ErrExhausted, provider)
+	}
+	if err != nil {
+		log.Printf("Failed to record %s call: %v", provider, err)
+	}
+	return nil
+}
+
+// Check returns an error wrapping ErrExhausted if provider's budget is spent,
+// so a scan can be refused before it starts. Providers without a budget are
+// never refused.
+func (t *Tracker) Check(provider string) error {
+	if !t.enabled() {
+		return nil
+	}
+	if limit := t.limit(provider); limit.Daily <= 0 && limit.Monthly <= 0 {
+		return nil
+	}
+	usage, err := t.Usage(provider)
+	if err != nil {
+		log.Printf("%v", err)
+		return nil
+	}
+	if usage.Exhausted() {
+		return fmt.Errorf("%w: %s", ErrExhausted, provider)
+	}
+	return nil
+}
+
+// Usage returns provider's consumption today and this month.
+func (t *Tracker) Usage(provider string) (*Usage, error) {
+	limit := t.limit(provider)
+	usage := &Usage{Provider: provider, DailyLimit: limit.Daily, MonthlyLimit: limit.Monthly}
+	if !t.enabled() {
+		return usage, nil
+	}
+	day, month := t.period()
+	query := `
+		SELECT COALESCE(SUM(calls) FILTER (WHERE day = $2), 0), COALESCE(SUM(calls), 0)
+		FROM provider_usage WHERE provider = $1 AND day >= $3
+	`
+	if err := t.db.QueryRow(query, provider, day, month).Scan(&usage.DailyCalls, &usage.MonthlyCalls); err != nil {
+		return nil, fmt.Errorf("failed to get %s usage: %w", provider, err)
+	}
+	return usage, nil
+}
+
+// List returns the usage of every provider with a budget or with calls this
+// month, sorted by provider.
+func (t *Tracker) List() ([]*Usage, error) {
+	names := make(map[string]bool)
+	if t != nil {
+		t.mu.RLock()
+		if t.cfg != nil {
+			for provider := range t.cfg.Quotas {
+				names[provider] = true
+			}
+		}
+		t.mu.RUnlock()
+	}
+	if t.enabled() {
+		_, month := t.period()
+		rows, err := t.db.Query(`SELECT DISTINCT provider FROM provider_usage WHERE day >= $1`, month)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list provider usage: %w", err)
+		}
+		defer rows.Close()
+		for rows.Next() {
+			var provider string
+			if err := rows.Scan(&provider); err != nil {
+				return nil, fmt.Errorf("failed to scan provider row: %w", err)
+			}
+			names[provider] = true
+		}
+		if err := rows.Err(); err != nil {
+			return nil, fmt.Errorf("failed to list provider usage: %w", err)
+		}
+	}
+
+	providers := make([]string, 0, len(names))
+	for provider := range names {
+		providers = append(providers, provider)
+	}
+	sort.Strings(providers)
+	list := make([]*Usage, 0, len(providers))
+	for _, provider := range providers {
+		usage, err := t.Usage(provider)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, usage)
+	}
+	return list, nil
+}
+
+// Transport returns a RoundTripper that counts each request sent through
+// base against provider's budget and refuses requests once it is spent.
+func (t *Tracker) Transport(provider string, base http.RoundTripper) http.RoundTripper {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return &transport{tracker: t, provider: provider, base: base}
+}
+
+type transport struct {
+	tracker  *Tracker
+	provider string
+	base     http.RoundTripper
+}
+
+func (q *transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if err := q.tracker.Use(q.provider); err != nil {
+		if req.Body != nil {
+			req.Body.Close()
+		}
+		return nil, err
+	}
+	return q.base.RoundTrip(req)
+}
@@ -0,0 +1,88 @@
+// internal/quota/quota_test.go
+package quota
+
+import (
+	"database/sql/driver"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/moos3/sparta/internal/config"
+	"github.com/moos3/sparta/internal/testutils"
+	"github.com/stretchr/testify/assert"
+)
+
+func testTracker(stubDb *testutils.StubDB) *Tracker {
+	cfg := &config.Config{}
+	cfg.Quotas = map[string]config.ProviderQuota{"shodan": {Daily: 100, Monthly: 1000}}
+	t := New(stubDb, cfg)
+	t.now = func() time.Time { return time.Date(2026, 3, 14, 15, 0, 0, 0, time.UTC) }
+	return t
+}
+
+func TestUse(t *testing.T) {
+	t.Run("Counted", func(t *testing.T) {
+		stubDb := testutils.NewStubDB()
+		insert := stubDb.Expect("INSERT INTO provider_usage").WillReturnRows([]string{"calls"}, []driver.Value{int64(5)})
+
+		assert.NoError(t, testTracker(stubDb).Use("shodan"))
+		args := insert.Args()
+		assert.Equal(t, "shodan", args[0])
+		assert.Equal(t, time.Date(2026, 3, 14, 0, 0, 0, 0, time.UTC), args[1])
+		assert.Equal(t, time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC), args[2])
+		assert.Equal(t, []driver.Value{100, 1000}, args[3:])
+	})
+
+	t.Run("Exhausted", func(t *testing.T) {
+		stubDb := testutils.NewStubDB()
+		stubDb.Expect("INSERT INTO provider_usage").WillReturnRows([]string{"calls"})
+		assert.ErrorIs(t, testTracker(stubDb).Use("shodan"), ErrExhausted)
+	})
+
+	t.Run("NilTracker", func(t *testing.T) {
+		var tracker *Tracker
+		assert.NoError(t, tracker.Use("shodan"))
+		assert.NoError(t, tracker.Check("shodan"))
+	})
+}
+
+func TestCheck(t *testing.T) {
+	stubDb := testutils.NewStubDB()
+	stubDb.Expect("FROM provider_usage WHERE provider = $1").WillReturnRows([]string{"day", "month"}, []driver.Value{int64(12), int64(1000)})
+	tracker := testTracker(stubDb)
+	assert.ErrorIs(t, tracker.Check("shodan"), ErrExhausted)
+	// Providers without a budget are not looked up
+	assert.NoError(t, tracker.Check("otx"))
+}
+
+func TestList(t *testing.T) {
+	stubDb := testutils.NewStubDB()
+	stubDb.Expect("SELECT DISTINCT provider").WillReturnRows([]string{"provider"}, []driver.Value{"otx"}, []driver.Value{"shodan"})
+	stubDb.Expect("FROM provider_usage WHERE provider = $1").WillReturnRows([]string{"day", "month"}, []driver.Value{int64(3), int64(40)})
+	stubDb.Expect("FROM provider_usage WHERE provider = $1").WillReturnRows([]string{"day", "month"}, []driver.Value{int64(30), int64(900)})
+
+	list, err := testTracker(stubDb).List()
+	if !assert.NoError(t, err) || !assert.Len(t, list, 2) {
+		return
+	}
+	assert.Equal(t, "otx", list[0].Provider)
+	assert.Equal(t, -1, list[0].DailyRemaining())
+	assert.Equal(t, "shodan", list[1].Provider)
+	assert.Equal(t, 70, list[1].DailyRemaining())
+	assert.Equal(t, 100, list[1].MonthlyRemaining())
+	assert.False(t, list[1].Exhausted())
+}
+
+func TestTransportRefusesOnceExhausted(t *testing.T) {
+	calls := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) { calls++ }))
+	defer srv.Close()
+
+	stubDb := testutils.NewStubDB()
+	stubDb.Expect("INSERT INTO provider_usage").WillReturnRows([]string{"calls"})
+	client := &http.Client{Transport: testTracker(stubDb).Transport("shodan", nil)}
+	_, err := client.Get(srv.URL)
+	assert.ErrorIs(t, err, ErrExhausted)
+	assert.Zero(t, calls)
+}
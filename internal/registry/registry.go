@@ -0,0 +1,243 @@
+// internal/registry/registry.go
+package registry
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/moos3/sparta/internal/config"
+	"github.com/moos3/sparta/internal/db"
+	"github.com/moos3/sparta/internal/interfaces"
+)
+
+// Plugin describes a scan plugin, either compiled into the server or run as
+// an external executable.
+type Plugin struct {
+	Name           string
+	Version        string
+	Description    string
+	RequiredConfig []string              // dotted config.yaml keys, e.g. "shodan.api_key"
+	Passive        bool                  // true if the plugin never contacts the target directly under a passive-only profile's options
+	Dependencies   []string              // names of plugins whose results this plugin needs
+	Inputs         []interfaces.Artifact // artifacts the plugin consumes when available
+	Outputs        []interfaces.Artifact // artifacts the plugin produces
+	External       bool                  // true if the plugin runs as a separate executable
+	New            func() interfaces.GenericPlugin
+}
+
+var (
+	mu      sync.RWMutex
+	plugins = make(map[string]Plugin)
+)
+
+// Register makes a plugin available to the server. It is meant to be called
+// from the init function of the plugin's source file and panics if the
+// plugin is incomplete or registered twice.
+func Register(p Plugin) {
+	mu.Lock()
+	defer mu.Unlock()
+	if p.Name == "" || p.New == nil {
+		panic("registry: plugin registered without a name or constructor")
+	}
+	if _, dup := plugins[p.Name]; dup {
+		panic(fmt.Sprintf("registry: plugin %s registered twice", p.Name))
+	}
+	plugins[p.Name] = p
+}
+
+// Unregister removes the plugin with the given name. The plugin host uses
+// it to replace an external plugin whose executable or settings changed.
+func Unregister(name string) {
+	mu.Lock()
+	defer mu.Unlock()
+	delete(plugins, name)
+}
+
+// Lookup returns the registered plugin with the given name.
+func Lookup(name string) (Plugin, bool) {
+	mu.RLock()
+	defer mu.RUnlock()
+	p, ok := plugins[name]
+	return p, ok
+}
+
+// List returns all registered plugins sorted by name.
+func List() []Plugin {
+	mu.RLock()
+	defer mu.RUnlock()
+	list := make([]Plugin, 0, len(plugins))
+	for _, p := range plugins {
+		list = append(list, p)
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].Name < list[j].Name })
+	return list
+}
+
+// MissingConfig returns the required config keys that are not set in cfg,
+// taking the plugin's own settings under plugins into account.
+func (p Plugin) MissingConfig(cfg *config.Config) []string {
+	if cfg != nil {
+		if own, err := cfg.ForPlugin(p.Name); err == nil {
+			cfg = own
+		}
+	}
+	var missing []string
+	for _, key := range p.RequiredConfig {
+		if cfg == nil || !cfg.IsSet(key) {
+			missing = append(missing, key)
+		}
+	}
+	return missing
+}
+
+// Load creates and initialises every registered plugin turned on in cfg,
+// handing each the database and its own view of the config. A plugin that is
+// turned off, missing required config or fails to start is left out of the
+// returned plugins; the reason is returned keyed by its name so it can be
+// reported instead of stopping the server.
+func Load(db db.Database, cfg *config.Config) (map[string]interfaces.GenericPlugin, map[string]string) {
+	loaded := make(map[string]interfaces.GenericPlugin)
+	disabled := make(map[string]string)
+	for _, info := range List() {
+		plugin, err := info.load(db, cfg)
+		if err != nil {
+			disabled[info.Name] = err.Error()
+			log.Printf("Plugin %s %v", info.Name, err)
+			continue
+		}
+		loaded[info.Name] = plugin
+		log.Printf("Loaded plugin %s v%s", info.Name, info.Version)
+	}
+	return loaded, disabled
+}
+
+// load runs the lifecycle the server expects: SetDatabase, SetConfig and
+// Initialize.
+func (p Plugin) load(db db.Database, cfg *config.Config) (interfaces.GenericPlugin, error) {
+	if !cfg.PluginEnabled(p.Name) {
+		return nil, fmt.Errorf("disabled in config")
+	}
+	own, err := cfg.ForPlugin(p.Name)
+	if err != nil {
+		return nil, fmt.Errorf("disabled: invalid settings: %w", err)
+	}
+	if missing := p.MissingConfig(own); len(missing) > 0 {
+		return nil, fmt.Errorf("disabled: missing %s", strings.Join(missing, ", "))
+	}
+	plugin := p.New()
+	plugin.SetDatabase(db)
+	if err := plugin.SetConfig(own); err != nil {
+		return nil, fmt.Errorf("disabled: %w", err)
+	}
+	if err := plugin.Initialize(); err != nil {
+		return nil, fmt.Errorf("disabled: %w", err)
+	}
+	return plugin, nil
+}
+
+// Validate checks that every declared dependency is itself registered and
+// that the plugins do not depend on each other in a cycle.
+func Validate() error {
+	mu.RLock()
+	defer mu.RUnlock()
+	for _, p := range plugins {
+		for _, dep := range p.Dependencies {
+			if _, ok := plugins[dep]; !ok {
+				return fmt.Errorf("plugin %s depends on unregistered plugin %s", p.Name, dep)
+			}
+		}
+	}
+	list := make([]Plugin, 0, len(plugins))
+	for _, p := range plugins {
+		list = append(list, p)
+	}
+	if cycle := findCycle(Upstream(list)); cycle != nil {
+		return fmt.Errorf("plugin dependency cycle: %s", strings.Join(cycle, " -> "))
+	}
+	return nil
+}
+
+// Upstream returns, for each of the given plugins, the names of the others
+// that must finish before it starts: its declared dependencies plus every
+// plugin producing one of its inputs. Dependencies outside the given set are
+// left out, as are self-references.
+func Upstream(list []Plugin) map[string][]string {
+	producers := make(map[interfaces.Artifact][]string)
+	names := make(map[string]bool, len(list))
+	for _, p := range list {
+		names[p.Name] = true
+		for _, kind := range p.Outputs {
+			producers[kind] = append(producers[kind], p.Name)
+		}
+	}
+	graph := make(map[string][]string, len(list))
+	for _, p := range list {
+		seen := map[string]bool{p.Name: true}
+		deps := []string{}
+		add := func(name string) {
+			if names[name] && !seen[name] {
+				seen[name] = true
+				deps = append(deps, name)
+			}
+		}
+		for _, dep := range p.Dependencies {
+			add(dep)
+		}
+		for _, kind := range p.Inputs {
+			for _, name := range producers[kind] {
+				add(name)
+			}
+		}
+		sort.Strings(deps)
+		graph[p.Name] = deps
+	}
+	return graph
+}
+
+// findCycle returns the plugins forming a dependency cycle, with the first
+// repeated at the end, or nil if graph is acyclic.
+func findCycle(graph map[string][]string) []string {
+	const (
+		visiting = 1
+		visited  = 2
+	)
+	state := make(map[string]int, len(graph))
+	var path []string
+	var visit func(name string) []string
+	visit = func(name string) []string {
+		switch state[name] {
+		case visited:
+			return nil
+		case visiting:
+			for i, n := range path {
+				if n == name {
+					return append(append([]string{}, path[i:]...), name)
+				}
+			}
+		}
+		state[name] = visiting
+		path = append(path, name)
+		for _, dep := range graph[name] {
+			if cycle := visit(dep); cycle != nil {
+				return cycle
+			}
+		}
+		path = path[:len(path)-1]
+		state[name] = visited
+		return nil
+	}
+	names := make([]string, 0, len(graph))
+	for name := range graph {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if cycle := visit(name); cycle != nil {
+			return cycle
+		}
+	}
+	return nil
+}
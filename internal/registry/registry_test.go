@@ -0,0 +1,138 @@
+// internal/registry/registry_test.go
+package registry
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/moos3/sparta/internal/config"
+	"github.com/moos3/sparta/internal/db"
+	"github.com/moos3/sparta/internal/interfaces"
+	"github.com/stretchr/testify/assert"
+)
+
+func reset(t *testing.T) {
+	mu.Lock()
+	saved := plugins
+	plugins = make(map[string]Plugin)
+	mu.Unlock()
+	t.Cleanup(func() {
+		mu.Lock()
+		plugins = saved
+		mu.Unlock()
+	})
+}
+
+func newNil() interfaces.GenericPlugin { return nil }
+
+func TestRegister(t *testing.T) {
+	reset(t)
+	Register(Plugin{Name: "ScanB", New: newNil})
+	Register(Plugin{Name: "ScanA", New: newNil, Dependencies: []string{"ScanB"}})
+
+	list := List()
+	if assert.Len(t, list, 2) {
+		assert.Equal(t, "ScanA", list[0].Name)
+		assert.Equal(t, "ScanB", list[1].Name)
+	}
+	_, ok := Lookup("ScanA")
+	assert.True(t, ok)
+	assert.NoError(t, Validate())
+
+	assert.Panics(t, func() { Register(Plugin{Name: "ScanA", New: newNil}) })
+	assert.Panics(t, func() { Register(Plugin{Name: "ScanC"}) })
+	Unregister("ScanA")
+	_, ok = Lookup("ScanA")
+	assert.False(t, ok)
+	assert.NotPanics(t, func() { Register(Plugin{Name: "ScanA", New: newNil}) })
+}
+
+func TestValidateMissingDependency(t *testing.T) {
+	reset(t)
+	Register(Plugin{Name: "ScanTLS", New: newNil, Dependencies: []string{"ScanDNS"}})
+	assert.EqualError(t, Validate(), "plugin ScanTLS depends on unregistered plugin ScanDNS")
+}
+
+func TestMissingConfig(t *testing.T) {
+	p := Plugin{Name: "ScanShodan", RequiredConfig: []string{"shodan.api_key", "shodan.request_delay"}}
+	cfg := &config.Config{}
+	cfg.Shodan.RequestDelay = 2500
+
+	assert.Equal(t, []string{"shodan.api_key"}, p.MissingConfig(cfg))
+	cfg.Shodan.APIKey = "secret"
+	assert.Empty(t, p.MissingConfig(cfg))
+	assert.Equal(t, p.RequiredConfig, p.MissingConfig(nil))
+	assert.Equal(t, []string{"shodan.no_such_key"}, Plugin{RequiredConfig: []string{"shodan.no_such_key"}}.MissingConfig(cfg))
+}
+
+func TestUpstream(t *testing.T) {
+	graph := Upstream([]Plugin{
+		{Name: "ScanDNS", Outputs: []interfaces.Artifact{interfaces.ArtifactIPs, interfaces.ArtifactMX}},
+		{Name: "ScanTLS", Dependencies: []string{"ScanDNS"}, Inputs: []interfaces.Artifact{interfaces.ArtifactIPs}},
+		{Name: "ScanCrtSh", Dependencies: []string{"ScanDNS"}, Outputs: []interfaces.Artifact{interfaces.ArtifactSubdomains}},
+		{Name: "ScanSub", Dependencies: []string{"ScanGone"}, Inputs: []interfaces.Artifact{interfaces.ArtifactSubdomains, interfaces.ArtifactIPs}},
+	})
+	assert.Empty(t, graph["ScanDNS"])
+	assert.Equal(t, []string{"ScanDNS"}, graph["ScanTLS"])
+	assert.Equal(t, []string{"ScanCrtSh", "ScanDNS"}, graph["ScanSub"])
+}
+
+func TestValidateCycle(t *testing.T) {
+	reset(t)
+	Register(Plugin{Name: "ScanA", New: newNil, Inputs: []interfaces.Artifact{"b"}, Outputs: []interfaces.Artifact{"a"}})
+	Register(Plugin{Name: "ScanB", New: newNil, Inputs: []interfaces.Artifact{"a"}, Outputs: []interfaces.Artifact{"b"}})
+	assert.EqualError(t, Validate(), "plugin dependency cycle: ScanA -> ScanB -> ScanA")
+}
+
+type stubPlugin struct {
+	cfg     *config.Config
+	initErr error
+}
+
+func (p *stubPlugin) Initialize() error { return p.initErr }
+func (p *stubPlugin) Scan(ctx context.Context, req interfaces.ScanRequest) (*interfaces.ScanResult, error) {
+	return nil, nil
+}
+func (p *stubPlugin) SetDatabase(db db.Database) {}
+func (p *stubPlugin) SetConfig(cfg *config.Config) error {
+	p.cfg = cfg
+	return nil
+}
+
+func TestLoad(t *testing.T) {
+	reset(t)
+	off := false
+	Register(Plugin{Name: "ScanDNS", New: func() interfaces.GenericPlugin { return &stubPlugin{} }})
+	Register(Plugin{Name: "ScanShodan", RequiredConfig: []string{"shodan.api_key"}, New: func() interfaces.GenericPlugin { return &stubPlugin{} }})
+	Register(Plugin{Name: "ScanOTX", New: func() interfaces.GenericPlugin { return &stubPlugin{} }})
+	Register(Plugin{Name: "ScanTLS", New: func() interfaces.GenericPlugin { return &stubPlugin{initErr: errors.New("no roots")} }})
+	Register(Plugin{Name: "ScanISC", New: func() interfaces.GenericPlugin { return &stubPlugin{} }})
+	cfg := &config.Config{Plugins: map[string]config.PluginConfig{
+		"ScanOTX": {Enabled: &off},
+		"ScanISC": {Settings: map[string]string{"isc.request_delay": "soon"}},
+	}}
+
+	loaded, disabled := Load(nil, cfg)
+	assert.Len(t, loaded, 1)
+	assert.Contains(t, loaded, "ScanDNS")
+	assert.Equal(t, map[string]string{
+		"ScanShodan": "disabled: missing shodan.api_key",
+		"ScanOTX":    "disabled in config",
+		"ScanTLS":    "disabled: no roots",
+		"ScanISC":    `disabled: invalid settings: config key isc.request_delay: "soon" is not an integer`,
+	}, disabled)
+
+	// Settings override the config for that plugin alone
+	cfg.Plugins["ScanShodan"] = config.PluginConfig{Settings: map[string]string{"shodan.api_key": "secret", "shodan.request_delay": "100"}}
+	loaded, disabled = Load(nil, cfg)
+	if assert.Contains(t, loaded, "ScanShodan") {
+		own := loaded["ScanShodan"].(*stubPlugin).cfg
+		assert.Equal(t, "secret", own.Shodan.APIKey)
+		assert.Equal(t, 100, own.Shodan.RequestDelay)
+	}
+	assert.NotContains(t, disabled, "ScanShodan")
+	assert.Empty(t, cfg.Shodan.APIKey)
+	assert.Same(t, cfg, loaded["ScanDNS"].(*stubPlugin).cfg)
+	assert.Empty(t, Plugin{Name: "ScanShodan", RequiredConfig: []string{"shodan.api_key"}}.MissingConfig(cfg))
+}
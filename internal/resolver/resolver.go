@@ -0,0 +1,223 @@
+// internal/resolver/resolver.go
+package resolver
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/miekg/dns"
+	"github.com/moos3/sparta/internal/config"
+	"github.com/moos3/sparta/internal/httpclient"
+)
+
+// DefaultTimeout bounds each query to one upstream when dns.timeout is unset.
+const DefaultTimeout = 5 * time.Second
+
+// DefaultUpstream is queried when dns.resolvers is empty.
+var DefaultUpstream = config.DNSResolver{Address: "8.8.8.8:53", Protocol: "udp"}
+
+// maxResponseSize is the largest DNS message a DNS-over-HTTPS upstream may
+// return.
+const maxResponseSize = 65535
+
+// Resolver sends DNS queries to the upstreams under dns.resolvers in order,
+// moving on to the next when one cannot be reached, times out or answers
+// SERVFAIL or REFUSED.
+type Resolver struct {
+	upstreams []upstream
+	timeout   time.Duration
+}
+
+// upstream is one configured resolver with its address normalised.
+type upstream struct {
+	config.DNSResolver
+	client *dns.Client  // udp, tcp and tls
+	tcp    *dns.Client  // udp only: retries truncated answers
+	http   *http.Client // https only
+}
+
+// New creates a Resolver from the dns section of cfg. DNS-over-HTTPS
+// upstreams are reached through the shared outbound HTTP settings, so they
+// use the configured proxy, CA bundle and User-Agent. It fails if a
+// resolver has an unknown protocol or an unusable address.
+func New(cfg *config.Config) (*Resolver, error) {
+	r := &Resolver{timeout: DefaultTimeout}
+	resolvers := []config.DNSResolver{DefaultUpstream}
+	if cfg != nil {
+		if cfg.DNS.Timeout > 0 {
+			r.timeout = time.Duration(cfg.DNS.Timeout) * time.Second
+		}
+		if len(cfg.DNS.Resolvers) > 0 {
+			resolvers = cfg.DNS.Resolvers
+		}
+	}
+	var transport http.RoundTripper
+	for _, c := range resolvers {
+		if c.Protocol == "https" && transport == nil {
+			f, err := httpclient.New(cfg)
+			if err != nil {
+				return nil, fmt.Errorf("DNS resolver %q: %w", c.Address, err)
+			}
+			transport = f.Transport()
+		}
+		u, err := newUpstream(c, r.timeout, transport)
+		if err != nil {
+			return nil, err
+		}
+		r.upstreams = append(r.upstreams, u)
+	}
+	return r, nil
+}
+
+func newUpstream(c config.DNSResolver, timeout time.Duration, transport http.RoundTripper) (upstream, error) {
+	if c.Protocol == "" {
+		c.Protocol = "udp"
+	}
+	u := upstream{DNSResolver: c}
+	switch c.Protocol {
+	case "udp", "tcp":
+		u.Address = withPort(c.Address, "53")
+		u.client = &dns.Client{Net: c.Protocol, Timeout: timeout}
+		if c.Protocol == "udp" {
+			u.tcp = &dns.Client{Net: "tcp", Timeout: timeout}
+		}
+	case "tls":
+		u.Address = withPort(c.Address, "853")
+		if u.ServerName == "" {
+			u.ServerName, _, _ = net.SplitHostPort(u.Address)
+		}
+		u.client = &dns.Client{Net: "tcp-tls", Timeout: timeout, TLSConfig: &tls.Config{ServerName: u.ServerName, MinVersion: tls.VersionTLS12}}
+	case "https":
+		endpoint, err := url.Parse(c.Address)
+		if err != nil || (endpoint.Scheme != "https" && endpoint.Scheme != "http") || endpoint.Host == "" {
+			return upstream{}, fmt.Errorf("DNS resolver %q: https resolvers need a URL such as https://dns.example/dns-query", c.Address)
+		}
+		u.http = &http.Client{Transport: transport, Timeout: timeout}
+	default:
+		return upstream{}, fmt.Errorf("DNS resolver %q: unsupported protocol %q: use udp, tcp, tls or https", c.Address, c.Protocol)
+	}
+	if u.Address == "" {
+		return upstream{}, fmt.Errorf("DNS resolver without an address")
+	}
+	return u, nil
+}
+
+// withPort adds port to address if it has none.
+func withPort(address, port string) string {
+	if address == "" {
+		return ""
+	}
+	if _, _, err := net.SplitHostPort(address); err == nil {
+		return address
+	}
+	return net.JoinHostPort(strings.Trim(address, "[]"), port)
+}
+
+// Upstreams returns the resolvers queried, in order, as protocol://address.
+func (r *Resolver) Upstreams() []string {
+	list := make([]string, 0, len(r.upstreams))
+	for _, u := range r.upstreams {
+		list = append(list, u.String())
+	}
+	return list
+}
+
+// Exchange sends m to each upstream in turn and returns the first answer
+// that is not SERVFAIL or REFUSED. A UDP answer with the truncated bit set
+// is retried over TCP on the same upstream. If every upstream refuses or
+// fails the query, the last answer received is returned, or an error if
+// there was none.
+func (r *Resolver) Exchange(ctx context.Context, m *dns.Msg) (*dns.Msg, error) {
+	var last *dns.Msg
+	var errs []string
+	for _, u := range r.upstreams {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		resp, err := r.exchange(ctx, u, m)
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("%s: %v", u, err))
+			continue
+		}
+		if resp.Rcode == dns.RcodeServerFailure || resp.Rcode == dns.RcodeRefused {
+			errs = append(errs, fmt.Sprintf("%s: %s", u, dns.RcodeToString[resp.Rcode]))
+			last = resp
+			continue
+		}
+		return resp, nil
+	}
+	if last != nil {
+		return last, nil
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return nil, fmt.Errorf("no DNS resolver answered: %s", strings.Join(errs, "; "))
+}
+
+// exchange sends m to one upstream within the per-query timeout.
+func (r *Resolver) exchange(ctx context.Context, u upstream, m *dns.Msg) (*dns.Msg, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+	if u.http != nil {
+		return u.exchangeHTTPS(ctx, m)
+	}
+	resp, _, err := u.client.ExchangeContext(ctx, m, u.Address)
+	if err != nil {
+		return nil, err
+	}
+	if resp.Truncated && u.tcp != nil {
+		resp, _, err = u.tcp.ExchangeContext(ctx, m, u.Address)
+	}
+	return resp, err
+}
+
+// exchangeHTTPS sends m as an RFC 8484 DNS-over-HTTPS POST.
+func (u upstream) exchangeHTTPS(ctx context.Context, m *dns.Msg) (*dns.Msg, error) {
+	// The message ID is zero on the wire so responses can be cached
+	query := m.Copy()
+	query.Id = 0
+	packed, err := query.Pack()
+	if err != nil {
+		return nil, fmt.Errorf("failed to pack query: %w", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, u.Address, bytes.NewReader(packed))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/dns-message")
+	req.Header.Set("Accept", "application/dns-message")
+	resp, err := u.http.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected HTTP status %s", resp.Status)
+	}
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseSize))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response: %w", err)
+	}
+	answer := new(dns.Msg)
+	if err := answer.Unpack(body); err != nil {
+		return nil, fmt.Errorf("failed to unpack response: %w", err)
+	}
+	answer.Id = m.Id
+	return answer, nil
+}
+
+func (u upstream) String() string {
+	if u.Protocol == "https" {
+		return u.Address
+	}
+	return u.Protocol + "://" + u.Address
+}
@@ -0,0 +1,198 @@
+// internal/resolver/resolver_test.go
+package resolver
+
+import (
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/miekg/dns"
+	"github.com/moos3/sparta/internal/config"
+	"github.com/moos3/sparta/pluginstest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func query(name string, qtype uint16) *dns.Msg {
+	m := new(dns.Msg)
+	m.SetQuestion(dns.Fqdn(name), qtype)
+	return m
+}
+
+// rcode answers every query with an empty reply carrying code.
+func rcode(code int) dns.HandlerFunc {
+	return func(w dns.ResponseWriter, r *dns.Msg) {
+		m := new(dns.Msg)
+		m.SetRcode(r, code)
+		w.WriteMsg(m)
+	}
+}
+
+// serve starts handler on a local UDP and TCP port and returns the address.
+func serve(t *testing.T, handler dns.Handler) string {
+	return pluginstest.StubDNS(t, &config.Config{}, handler)
+}
+
+func TestNew(t *testing.T) {
+	r, err := New(nil)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"udp://8.8.8.8:53"}, r.Upstreams())
+	assert.Equal(t, DefaultTimeout, r.timeout)
+
+	cfg := &config.Config{}
+	cfg.DNS.Timeout = 2
+	cfg.DNS.Resolvers = []config.DNSResolver{
+		{Address: "10.0.0.53"},
+		{Address: "10.0.0.53", Protocol: "tcp"},
+		{Address: "1.1.1.1", Protocol: "tls", ServerName: "cloudflare-dns.com"},
+		{Address: "[2001:db8::53]", Protocol: "tls"},
+		{Address: "https://dns.example/dns-query", Protocol: "https"},
+	}
+	r, err = New(cfg)
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"udp://10.0.0.53:53",
+		"tcp://10.0.0.53:53",
+		"tls://1.1.1.1:853",
+		"tls://[2001:db8::53]:853",
+		"https://dns.example/dns-query",
+	}, r.Upstreams())
+	assert.Equal(t, 2*time.Second, r.timeout)
+	assert.Equal(t, "cloudflare-dns.com", r.upstreams[2].client.TLSConfig.ServerName)
+	assert.Equal(t, "2001:db8::53", r.upstreams[3].client.TLSConfig.ServerName)
+
+	for _, bad := range []config.DNSResolver{
+		{Address: "10.0.0.53", Protocol: "quic"},
+		{Address: "dns.example", Protocol: "https"},
+		{Protocol: "udp"},
+	} {
+		cfg.DNS.Resolvers = []config.DNSResolver{bad}
+		_, err := New(cfg)
+		assert.Error(t, err, "%+v", bad)
+	}
+	cfg.DNS.Resolvers = []config.DNSResolver{{Address: "https://dns.example/dns-query", Protocol: "https"}}
+	cfg.HTTP.Proxy = "ftp://proxy.example"
+	_, err = New(cfg)
+	assert.ErrorContains(t, err, "unsupported proxy scheme")
+}
+
+func TestExchange(t *testing.T) {
+	zone := pluginstest.Zone(t, "example.com. 300 IN A 192.0.2.1")
+
+	t.Run("FallsBack", func(t *testing.T) {
+		// An unreachable resolver and one answering SERVFAIL are skipped
+		closed, err := net.ListenPacket("udp", "127.0.0.1:0")
+		require.NoError(t, err)
+		closedAddr := closed.LocalAddr().String()
+		closed.Close()
+
+		cfg := &config.Config{}
+		cfg.DNS.Timeout = 1
+		cfg.DNS.Resolvers = []config.DNSResolver{
+			{Address: closedAddr},
+			{Address: serve(t, rcode(dns.RcodeServerFailure))},
+			{Address: serve(t, zone)},
+		}
+		r, err := New(cfg)
+		require.NoError(t, err)
+		resp, err := r.Exchange(context.Background(), query("example.com", dns.TypeA))
+		require.NoError(t, err)
+		if assert.Len(t, resp.Answer, 1) {
+			assert.Equal(t, "192.0.2.1", resp.Answer[0].(*dns.A).A.String())
+		}
+	})
+
+	t.Run("NXDOMAINIsAnAnswer", func(t *testing.T) {
+		cfg := &config.Config{}
+		cfg.DNS.Resolvers = []config.DNSResolver{{Address: serve(t, zone)}, {Address: serve(t, rcode(dns.RcodeServerFailure))}}
+		r, err := New(cfg)
+		require.NoError(t, err)
+		resp, err := r.Exchange(context.Background(), query("missing.example.com", dns.TypeA))
+		require.NoError(t, err)
+		assert.Equal(t, dns.RcodeNameError, resp.Rcode)
+	})
+
+	t.Run("AllFail", func(t *testing.T) {
+		cfg := &config.Config{}
+		cfg.DNS.Resolvers = []config.DNSResolver{{Address: serve(t, rcode(dns.RcodeRefused))}}
+		r, err := New(cfg)
+		require.NoError(t, err)
+		resp, err := r.Exchange(context.Background(), query("example.com", dns.TypeA))
+		require.NoError(t, err)
+		assert.Equal(t, dns.RcodeRefused, resp.Rcode)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		_, err = r.Exchange(ctx, query("example.com", dns.TypeA))
+		assert.ErrorIs(t, err, context.Canceled)
+	})
+
+	t.Run("RetriesTruncatedOverTCP", func(t *testing.T) {
+		var mu sync.Mutex
+		var protocols []string
+		addr := serve(t, dns.HandlerFunc(func(w dns.ResponseWriter, r *dns.Msg) {
+			mu.Lock()
+			protocols = append(protocols, w.RemoteAddr().Network())
+			mu.Unlock()
+			m := new(dns.Msg)
+			m.SetReply(r)
+			if w.RemoteAddr().Network() == "udp" {
+				m.Truncated = true
+			} else {
+				rr, _ := dns.NewRR("example.com. 300 IN TXT \"v=spf1 -all\"")
+				m.Answer = append(m.Answer, rr)
+			}
+			w.WriteMsg(m)
+		}))
+		cfg := &config.Config{}
+		cfg.DNS.Resolvers = []config.DNSResolver{{Address: addr}}
+		r, err := New(cfg)
+		require.NoError(t, err)
+		resp, err := r.Exchange(context.Background(), query("example.com", dns.TypeTXT))
+		require.NoError(t, err)
+		assert.False(t, resp.Truncated)
+		assert.Len(t, resp.Answer, 1)
+		mu.Lock()
+		assert.Equal(t, []string{"udp", "tcp"}, protocols)
+		mu.Unlock()
+	})
+
+	t.Run("HTTPS", func(t *testing.T) {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "application/dns-message", r.Header.Get("Content-Type"))
+			assert.Equal(t, "sparta-test", r.Header.Get("User-Agent"))
+			body, _ := io.ReadAll(r.Body)
+			req := new(dns.Msg)
+			if !assert.NoError(t, req.Unpack(body)) {
+				return
+			}
+			assert.Zero(t, req.Id)
+			m := new(dns.Msg)
+			m.SetReply(req)
+			rr, _ := dns.NewRR("example.com. 300 IN A 192.0.2.7")
+			m.Answer = append(m.Answer, rr)
+			packed, _ := m.Pack()
+			w.Header().Set("Content-Type", "application/dns-message")
+			w.Write(packed)
+		}))
+		t.Cleanup(srv.Close)
+
+		cfg := &config.Config{}
+		cfg.DNS.Resolvers = []config.DNSResolver{{Address: srv.URL + "/dns-query", Protocol: "https"}}
+		cfg.HTTP.UserAgent = "sparta-test"
+		r, err := New(cfg)
+		require.NoError(t, err)
+		m := query("example.com", dns.TypeA)
+		resp, err := r.Exchange(context.Background(), m)
+		require.NoError(t, err)
+		assert.Equal(t, m.Id, resp.Id)
+		if assert.Len(t, resp.Answer, 1) {
+			assert.Equal(t, "192.0.2.7", resp.Answer[0].(*dns.A).A.String())
+		}
+	})
+}
@@ -0,0 +1,183 @@
+// internal/runs/store.go
+package runs
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/moos3/sparta/internal/db"
+	pb "github.com/moos3/sparta/proto"
+	protobuf "google.golang.org/protobuf/proto"
+)
+
+// ErrNotFound is returned when a scan run does not exist.
+var ErrNotFound = errors.New("scan run not found")
+
+// Status is the state of a scan run.
+type Status string
+
+const (
+	StatusRunning   Status = "running"
+	StatusSucceeded Status = "succeeded"
+	StatusFailed    Status = "failed"
+)
+
+const (
+	ProfileFull      = "full"      // runs under the default scan profile, which runs every loaded plugin
+	ProfileSubdomain = "subdomain" // runs queued for a subdomain found by another scan
+)
+
+// ScanRun groups the plugin results produced by one scan of a domain. Every
+// stored plugin result references the run it belongs to.
+type ScanRun struct {
+	ID          string
+	Domain      string
+	RequestedBy string // user who started the run, empty for system runs
+	Profile     string // scan profile name, ProfileSubdomain, or the plugin name for single-plugin runs
+	Status      Status
+	Error       string
+	StartedAt   time.Time
+	FinishedAt  time.Time // zero until the run finishes
+}
+
+// Result is one plugin result stored under a run.
+type Result struct {
+	Plugin    string
+	ID        string
+	Result    protobuf.Message
+	CreatedAt time.Time
+}
+
+// resultTables lists the table each plugin stores its results in, along with
+// the message the stored JSON decodes into.
+var resultTables = []struct {
+	plugin string
+	table  string
+	new    func() protobuf.Message
+}{
+	{"ScanDNS", "dns_scan_results", func() protobuf.Message { return &pb.DNSSecurityResult{} }},
+	{"ScanTLS", "tls_scan_results", func() protobuf.Message { return &pb.TLSSecurityResult{} }},
+	{"ScanCrtSh", "crtsh_scan_results", func() protobuf.Message { return &pb.CrtShSecurityResult{} }},
+	{"ScanChaos", "chaos_scan_results", func() protobuf.Message { return &pb.ChaosSecurityResult{} }},
+	{"ScanShodan", "shodan_scan_results", func() protobuf.Message { return &pb.ShodanSecurityResult{} }},
+	{"ScanOTX", "otx_scan_results", func() protobuf.Message { return &pb.OTXSecurityResult{} }},
+	{"ScanWhois", "whois_scan_results", func() protobuf.Message { return &pb.WhoisSecurityResult{} }},
+	{"ScanAbuseCh", "abusech_scan_results", func() protobuf.Message { return &pb.AbuseChSecurityResult{} }},
+	{"ScanISC", "isc_scan_results", func() protobuf.Message { return &pb.ISCSecurityResult{} }},
+}
+
+// externalTable holds the results of external plugins, each row naming its plugin.
+const externalTable = "external_scan_results"
+
+// Store persists scan runs in Postgres.
+type Store struct {
+	db db.Database
+}
+
+// NewStore creates a Store over database.
+func NewStore(database db.Database) *Store {
+	return &Store{db: database}
+}
+
+// Create records the start of a run.
+func (s *Store) Create(domain, requestedBy, profile string) (*ScanRun, error) {
+	run := &ScanRun{
+		ID:          uuid.New().String(),
+		Domain:      domain,
+		RequestedBy: requestedBy,
+		Profile:     profile,
+		Status:      StatusRunning,
+		StartedAt:   time.Now(),
+	}
+	query := `
+		INSERT INTO scan_runs (id, domain, requested_by, profile, status, started_at)
+		VALUES ($1, $2, NULLIF($3, ''), $4, $5, $6)
+	`
+	if _, err := s.db.Exec(query, run.ID, domain, requestedBy, profile, string(StatusRunning), run.StartedAt); err != nil {
+		return nil, fmt.Errorf("failed to insert scan run: %w", err)
+	}
+	return run, nil
+}
+
+// Finish records the outcome of a run.
+func (s *Store) Finish(id string, status Status, errMsg string) error {
+	query := `UPDATE scan_runs SET status = $2, error = $3, finished_at = $4 WHERE id = $1`
+	if _, err := s.db.Exec(query, id, string(status), errMsg, time.Now()); err != nil {
+		return fmt.Errorf("failed to finish scan run %s: %w", id, err)
+	}
+	return nil
+}
+
+// Get returns the run with the given ID.
+func (s *Store) Get(id string) (*ScanRun, error) {
+	query := `
+		SELECT id, domain, COALESCE(requested_by, ''), profile, status, error, started_at, finished_at
+		FROM scan_runs
+		WHERE id = $1
+	`
+	var run ScanRun
+	var finishedAt sql.NullTime
+	err := s.db.QueryRow(query, id).Scan(&run.ID, &run.Domain, &run.RequestedBy, &run.Profile,
+		&run.Status, &run.Error, &run.StartedAt, &finishedAt)
+	if err == sql.ErrNoRows {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get scan run: %w", err)
+	}
+	run.FinishedAt = finishedAt.Time
+	return &run, nil
+}
+
+// Results returns every plugin result stored under the run, oldest first.
+func (s *Store) Results(id string) ([]Result, error) {
+	query := ""
+	for i, t := range resultTables {
+		if i > 0 {
+			query += " UNION ALL "
+		}
+		query += fmt.Sprintf(`SELECT %d, id::text, result, created_at, '' FROM %s WHERE scan_run_id = $1`, i, t.table)
+	}
+	query += fmt.Sprintf(` UNION ALL SELECT %d, id::text, result, created_at, plugin FROM %s WHERE scan_run_id = $1`, len(resultTables), externalTable)
+	query += " ORDER BY 4"
+
+	rows, err := s.db.Query(query, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query scan run results: %w", err)
+	}
+	defer rows.Close()
+
+	var results []Result
+	for rows.Next() {
+		var table int
+		var r Result
+		var resultJSON []byte
+		var createdAt sql.NullTime
+		var external string
+		if err := rows.Scan(&table, &r.ID, &resultJSON, &createdAt, &external); err != nil {
+			return nil, fmt.Errorf("failed to scan result row: %w", err)
+		}
+		if table == len(resultTables) {
+			r.Plugin = external
+			r.Result = &pb.ExternalSecurityResult{Plugin: external, Result: string(resultJSON)}
+			r.CreatedAt = createdAt.Time
+			results = append(results, r)
+			continue
+		}
+		if table < 0 || table > len(resultTables) {
+			return nil, fmt.Errorf("unexpected result table %d", table)
+		}
+		r.Plugin = resultTables[table].plugin
+		r.Result = resultTables[table].new()
+		if err := json.Unmarshal(resultJSON, r.Result); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal %s result: %w", r.Plugin, err)
+		}
+		r.CreatedAt = createdAt.Time
+		results = append(results, r)
+	}
+	return results, rows.Err()
+}
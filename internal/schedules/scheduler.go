@@ -0,0 +1,111 @@
+// internal/schedules/scheduler.go
+package schedules
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/moos3/sparta/internal/db"
+)
+
+// lockKey is the advisory lock held by the server that is firing schedules.
+const lockKey int64 = 0x7370617274610001
+
+// SubmitFunc queues a scan for a due schedule and returns the job ID.
+type SubmitFunc func(sch *Schedule) (string, error)
+
+// Scheduler queues scan jobs for due schedules. Any number of servers can run
+// one: each poll takes a Postgres advisory lock so only one server fires
+// schedules at a time, and each run is claimed with a conditional update so
+// a run is never queued twice even without the lock.
+type Scheduler struct {
+	store  *Store
+	submit SubmitFunc
+
+	PollInterval time.Duration // how often due schedules are checked
+	BatchSize    int           // schedules fired per poll at most
+}
+
+// NewScheduler creates a Scheduler that queues jobs with submit.
+func NewScheduler(store *Store, submit SubmitFunc) *Scheduler {
+	return &Scheduler{
+		store:        store,
+		submit:       submit,
+		PollInterval: 30 * time.Second,
+		BatchSize:    100,
+	}
+}
+
+// Start launches the scheduler. It stops when ctx is cancelled.
+func (s *Scheduler) Start(ctx context.Context) {
+	go func() {
+		ticker := time.NewTicker(s.PollInterval)
+		defer ticker.Stop()
+		for {
+			s.poll(ctx)
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+	log.Printf("Started scan scheduler, polling every %s", s.PollInterval)
+}
+
+// poll fires due schedules if no other server is doing so.
+func (s *Scheduler) poll(ctx context.Context) {
+	if locker, ok := s.store.db.(db.Locker); ok {
+		unlock, held, err := locker.TryLock(ctx, lockKey)
+		if err != nil {
+			log.Printf("Failed to lock scan scheduler: %v", err)
+			return
+		}
+		if !held {
+			return
+		}
+		defer unlock()
+	}
+	s.fire(time.Now())
+}
+
+// fire queues a job for every schedule due at now.
+func (s *Scheduler) fire(now time.Time) {
+	due, err := s.store.due(now, s.BatchSize)
+	if err != nil {
+		log.Printf("Failed to list due scan schedules: %v", err)
+		return
+	}
+	for _, sch := range due {
+		next, err := sch.next(sch.NextRunAt, now)
+		if err != nil {
+			// Left due, it would come up on every poll; pause it until fixed
+			log.Printf("Pausing scan schedule %s with invalid timing: %v", sch.ID, err)
+			if err := s.store.pauseInvalid(sch.ID, err.Error()); err != nil {
+				log.Printf("%v", err)
+			}
+			continue
+		}
+		won, err := s.store.advance(sch, next, now)
+		if err != nil {
+			log.Printf("%v", err)
+			continue
+		}
+		if !won {
+			continue // another server fired it
+		}
+
+		jobID, err := s.submit(sch)
+		var errMsg string
+		if err != nil {
+			errMsg = err.Error()
+			log.Printf("Failed to queue scan for schedule %s (%s): %v", sch.ID, sch.Domain, err)
+		} else {
+			log.Printf("Queued scan job %s for schedule %s (%s), next run %s", jobID, sch.ID, sch.Domain, next.Format(time.RFC3339))
+		}
+		if err := s.store.recordRun(sch.ID, jobID, errMsg); err != nil {
+			log.Printf("%v", err)
+		}
+	}
+}
@@ -0,0 +1,121 @@
+// internal/schedules/schedules_test.go
+package schedules
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/moos3/sparta/internal/testutils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCronNext(t *testing.T) {
+	from := time.Date(2025, 3, 14, 10, 7, 30, 0, time.UTC) // a Friday
+	tests := []struct {
+		expr string
+		want time.Time
+	}{
+		{"* * * * *", time.Date(2025, 3, 14, 10, 8, 0, 0, time.UTC)},
+		{"*/15 * * * *", time.Date(2025, 3, 14, 10, 15, 0, 0, time.UTC)},
+		{"0 3 * * *", time.Date(2025, 3, 15, 3, 0, 0, 0, time.UTC)},
+		{"30 9 * * 1-5", time.Date(2025, 3, 17, 9, 30, 0, 0, time.UTC)},
+		{"0 0 1 */3 *", time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC)},
+		{"0 12 13,20 * 5", time.Date(2025, 3, 14, 12, 0, 0, 0, time.UTC)}, // day of month or Friday
+		{"0 0 * * 7", time.Date(2025, 3, 16, 0, 0, 0, 0, time.UTC)},
+		{"@monthly", time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC)},
+		{"0 0 29 2 *", time.Date(2028, 2, 29, 0, 0, 0, 0, time.UTC)},
+	}
+	for _, tt := range tests {
+		c, err := ParseCron(tt.expr)
+		if !assert.NoError(t, err, tt.expr) {
+			continue
+		}
+		assert.Equal(t, tt.want, c.Next(from), tt.expr)
+	}
+	// Parses, but February never has a 30th
+	c, err := ParseCron("0 0 30 2 *")
+	if assert.NoError(t, err) {
+		assert.True(t, c.Next(from).IsZero())
+	}
+}
+
+func TestParseCronErrors(t *testing.T) {
+	for _, expr := range []string{"", "* * * *", "60 * * * *", "* 24 * * *", "* * 0 * *", "*/0 * * * *", "5-1 * * * *", "a * * * *"} {
+		_, err := ParseCron(expr)
+		assert.Error(t, err, expr)
+	}
+}
+
+func TestCreateValidatesTiming(t *testing.T) {
+	store := NewStore(testutils.NewStubDB())
+	for _, tc := range []struct {
+		cron     string
+		interval time.Duration
+	}{
+		{"", 0},
+		{"", 30 * time.Second},
+		{"0 3 * * *", time.Hour},
+		{"bogus", 0},
+		{"0 0 30 2 *", 0},
+	} {
+		_, err := store.Create("user-1", "example.com", tc.cron, tc.interval, "full")
+		assert.ErrorIs(t, err, ErrInvalid)
+	}
+}
+
+var scheduleCols = []string{"id", "owner_id", "domain", "cron_expr", "interval_seconds", "profile", "paused",
+	"next_run_at", "last_run_at", "last_job_id", "last_error", "created_at", "updated_at"}
+
+func TestFire(t *testing.T) {
+	now := time.Date(2025, 3, 14, 10, 0, 30, 0, time.UTC)
+	due := now.Add(-30 * time.Second)
+	row := func(id string, cron string, interval int64) []driver.Value {
+		return []driver.Value{id, "user-1", id + ".example.com", cron, interval, "full", false, due, nil, "", "", due, due}
+	}
+
+	stubDb := testutils.NewStubDB()
+	stubDb.Expect("WHERE NOT paused AND next_run_at <= $1").WillReturnRows(scheduleCols,
+		row("a", "", 3600), row("b", "0 * * * *", 0), row("c", "", 3600))
+	advanceA := stubDb.Expect("SET next_run_at = $3").WillReturnResult(1)
+	advanceB := stubDb.Expect("SET next_run_at = $3").WillReturnResult(1)
+	stubDb.Expect("SET next_run_at = $3").WillReturnResult(0) // c was fired by another server
+	recordA := stubDb.Expect("SET last_job_id").WillReturnResult(1)
+	recordB := stubDb.Expect("SET last_job_id").WillReturnResult(1)
+
+	var submitted []string
+	s := NewScheduler(NewStore(stubDb), func(sch *Schedule) (string, error) {
+		submitted = append(submitted, sch.ID)
+		if sch.ID == "b" {
+			return "", fmt.Errorf("DNS plugin not loaded")
+		}
+		return "job-" + sch.ID, nil
+	})
+	s.fire(now)
+
+	assert.NoError(t, stubDb.ExpectationsWereMet())
+	assert.Equal(t, []string{"a", "b"}, submitted)
+	assert.Equal(t, due.Add(time.Hour), advanceA.Args()[2])
+	assert.Equal(t, time.Date(2025, 3, 14, 11, 0, 0, 0, time.UTC), advanceB.Args()[2])
+	assert.Equal(t, []driver.Value{"a", "job-a", ""}, recordA.Args())
+	assert.Equal(t, []driver.Value{"b", "", "DNS plugin not loaded"}, recordB.Args())
+}
+
+func TestFirePausesScheduleThatNeverMatches(t *testing.T) {
+	now := time.Date(2025, 3, 14, 10, 0, 30, 0, time.UTC)
+	stubDb := testutils.NewStubDB()
+	stubDb.Expect("WHERE NOT paused AND next_run_at <= $1").WillReturnRows(scheduleCols,
+		[]driver.Value{"a", "user-1", "example.com", "0 0 30 2 *", int64(0), "full", false, time.Time{}, nil, "", "", now, now})
+	pause := stubDb.Expect("SET paused = TRUE, last_error = $2").WillReturnResult(1)
+
+	s := NewScheduler(NewStore(stubDb), func(sch *Schedule) (string, error) {
+		t.Errorf("queued a scan for schedule %s", sch.ID)
+		return "", nil
+	})
+	s.fire(now)
+
+	assert.NoError(t, stubDb.ExpectationsWereMet())
+	assert.Equal(t, "a", pause.Args()[0])
+	assert.Contains(t, pause.Args()[1], "never matches")
+}
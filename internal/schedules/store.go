@@ -0,0 +1,265 @@
+// internal/schedules/store.go
+package schedules
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/moos3/sparta/internal/db"
+)
+
+var (
+	// ErrNotFound is returned when a schedule does not exist.
+	ErrNotFound = errors.New("scan schedule not found")
+	// ErrInvalid is wrapped by errors for schedules with bad timing.
+	ErrInvalid = errors.New("invalid scan schedule")
+)
+
+// MinInterval is the shortest interval a schedule may repeat at.
+const MinInterval = time.Minute
+
+// Schedule repeats a scan of a domain on a cron expression or a fixed
+// interval. Exactly one of Cron and Interval is set.
+type Schedule struct {
+	ID        string
+	OwnerID   string // user the scheduled jobs are submitted as
+	Domain    string
+	Cron      string
+	Interval  time.Duration
+	Profile   string
+	Paused    bool
+	NextRunAt time.Time
+	LastRunAt time.Time // zero until the schedule first fires
+	LastJobID string
+	LastError string // why the last run could not be queued, if it failed
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// next returns when the schedule should fire after its run due at due,
+// given that it is now now. Interval schedules keep their phase unless they
+// have fallen more than a whole interval behind. A cron expression that
+// never matches, such as February 30th, is an ErrInvalid error.
+func (s *Schedule) next(due, now time.Time) (time.Time, error) {
+	if s.Cron != "" {
+		c, err := ParseCron(s.Cron)
+		if err != nil {
+			return time.Time{}, err
+		}
+		next := c.Next(now)
+		if next.IsZero() {
+			return time.Time{}, fmt.Errorf("%w: cron expression %q never matches", ErrInvalid, s.Cron)
+		}
+		return next, nil
+	}
+	next := due.Add(s.Interval)
+	if !next.After(now) {
+		next = now.Add(s.Interval)
+	}
+	return next, nil
+}
+
+// validate checks the timing fields and returns the first run time after now.
+func (s *Schedule) validate(now time.Time) (time.Time, error) {
+	switch {
+	case s.Cron != "" && s.Interval != 0:
+		return time.Time{}, fmt.Errorf("%w: set either a cron expression or an interval, not both", ErrInvalid)
+	case s.Cron != "":
+		if _, err := ParseCron(s.Cron); err != nil {
+			return time.Time{}, fmt.Errorf("%w: %v", ErrInvalid, err)
+		}
+	case s.Interval < MinInterval:
+		return time.Time{}, fmt.Errorf("%w: interval must be at least %s", ErrInvalid, MinInterval)
+	}
+	return s.next(now, now)
+}
+
+// Store persists schedules in Postgres.
+type Store struct {
+	db db.Database
+}
+
+// NewStore creates a Store over database.
+func NewStore(database db.Database) *Store {
+	return &Store{db: database}
+}
+
+const scheduleColumns = `id, owner_id, domain, cron_expr, interval_seconds, profile, paused, next_run_at, last_run_at, COALESCE(last_job_id::text, ''), last_error, created_at, updated_at`
+
+// Create stores a new schedule, due at the first time its timing matches.
+func (s *Store) Create(ownerID, domain, cronExpr string, interval time.Duration, profile string) (*Schedule, error) {
+	now := time.Now()
+	sch := &Schedule{
+		ID:        uuid.New().String(),
+		OwnerID:   ownerID,
+		Domain:    domain,
+		Cron:      cronExpr,
+		Interval:  interval,
+		Profile:   profile,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	next, err := sch.validate(now)
+	if err != nil {
+		return nil, err
+	}
+	sch.NextRunAt = next
+
+	query := `
+		INSERT INTO scan_schedules (id, owner_id, domain, cron_expr, interval_seconds, profile, next_run_at, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $8)
+	`
+	if _, err := s.db.Exec(query, sch.ID, ownerID, domain, cronExpr, int64(interval/time.Second), profile, next, now); err != nil {
+		return nil, fmt.Errorf("failed to insert scan schedule: %w", err)
+	}
+	return sch, nil
+}
+
+// Get returns the schedule with the given ID.
+func (s *Store) Get(id string) (*Schedule, error) {
+	sch, err := scanSchedule(s.db.QueryRow(`SELECT `+scheduleColumns+` FROM scan_schedules WHERE id = $1`, id))
+	if err == sql.ErrNoRows {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get scan schedule: %w", err)
+	}
+	return sch, nil
+}
+
+// List returns ownerID's schedules ordered by domain, or every schedule if
+// ownerID is empty.
+func (s *Store) List(ownerID string) ([]*Schedule, error) {
+	query := `SELECT ` + scheduleColumns + ` FROM scan_schedules WHERE $1 = '' OR owner_id = $1 ORDER BY domain, created_at`
+	return s.query(query, ownerID)
+}
+
+// Update replaces the timing and profile of a schedule and reschedules its
+// next run from now.
+func (s *Store) Update(id, cronExpr string, interval time.Duration, profile string) (*Schedule, error) {
+	now := time.Now()
+	next, err := (&Schedule{Cron: cronExpr, Interval: interval}).validate(now)
+	if err != nil {
+		return nil, err
+	}
+	query := `
+		UPDATE scan_schedules
+		SET cron_expr = $2, interval_seconds = $3, profile = $4, next_run_at = $5, updated_at = $6
+		WHERE id = $1
+		RETURNING ` + scheduleColumns
+	return s.update(query, id, cronExpr, int64(interval/time.Second), profile, next, now)
+}
+
+// SetPaused pauses or resumes a schedule. A resumed schedule next fires at
+// its first match after now rather than catching up on missed runs.
+func (s *Store) SetPaused(id string, paused bool) (*Schedule, error) {
+	sch, err := s.Get(id)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	next, err := sch.next(now, now)
+	if err != nil {
+		return nil, err
+	}
+	query := `
+		UPDATE scan_schedules
+		SET paused = $2, next_run_at = CASE WHEN $2 THEN next_run_at ELSE $3 END, updated_at = $4
+		WHERE id = $1
+		RETURNING ` + scheduleColumns
+	return s.update(query, id, paused, next, now)
+}
+
+// due returns up to limit unpaused schedules whose next run is at or before now.
+func (s *Store) due(now time.Time, limit int) ([]*Schedule, error) {
+	query := `SELECT ` + scheduleColumns + ` FROM scan_schedules WHERE NOT paused AND next_run_at <= $1 ORDER BY next_run_at LIMIT $2`
+	return s.query(query, now, limit)
+}
+
+// advance moves a due schedule on to next. It only succeeds if the schedule
+// is still due at the time sch was read, so when several servers race for
+// the same run exactly one of them wins it.
+func (s *Store) advance(sch *Schedule, next, now time.Time) (bool, error) {
+	query := `
+		UPDATE scan_schedules
+		SET next_run_at = $3, last_run_at = $4
+		WHERE id = $1 AND next_run_at = $2 AND NOT paused
+	`
+	res, err := s.db.Exec(query, sch.ID, sch.NextRunAt, next, now)
+	if err != nil {
+		return false, fmt.Errorf("failed to advance scan schedule %s: %w", sch.ID, err)
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to advance scan schedule %s: %w", sch.ID, err)
+	}
+	return n == 1, nil
+}
+
+// pauseInvalid pauses a schedule whose timing can no longer be worked out,
+// recording why, so it stops coming up as due.
+func (s *Store) pauseInvalid(id, errMsg string) error {
+	query := `UPDATE scan_schedules SET paused = TRUE, last_error = $2, updated_at = $3 WHERE id = $1`
+	if _, err := s.db.Exec(query, id, errMsg, time.Now()); err != nil {
+		return fmt.Errorf("failed to pause scan schedule %s: %w", id, err)
+	}
+	return nil
+}
+
+// recordRun stores the job a schedule queued, or why it could not.
+func (s *Store) recordRun(id, jobID, errMsg string) error {
+	query := `UPDATE scan_schedules SET last_job_id = NULLIF($2, '')::uuid, last_error = $3 WHERE id = $1`
+	if _, err := s.db.Exec(query, id, jobID, errMsg); err != nil {
+		return fmt.Errorf("failed to record run of scan schedule %s: %w", id, err)
+	}
+	return nil
+}
+
+func (s *Store) update(query string, args ...interface{}) (*Schedule, error) {
+	sch, err := scanSchedule(s.db.QueryRow(query, args...))
+	if err == sql.ErrNoRows {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to update scan schedule: %w", err)
+	}
+	return sch, nil
+}
+
+func (s *Store) query(query string, args ...interface{}) ([]*Schedule, error) {
+	rows, err := s.db.Query(query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query scan schedules: %w", err)
+	}
+	defer rows.Close()
+	var list []*Schedule
+	for rows.Next() {
+		sch, err := scanSchedule(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan schedule row: %w", err)
+		}
+		list = append(list, sch)
+	}
+	return list, rows.Err()
+}
+
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanSchedule(row rowScanner) (*Schedule, error) {
+	var sch Schedule
+	var intervalSeconds int64
+	var lastRunAt sql.NullTime
+	err := row.Scan(&sch.ID, &sch.OwnerID, &sch.Domain, &sch.Cron, &intervalSeconds, &sch.Profile, &sch.Paused,
+		&sch.NextRunAt, &lastRunAt, &sch.LastJobID, &sch.LastError, &sch.CreatedAt, &sch.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+	sch.Interval = time.Duration(intervalSeconds) * time.Second
+	sch.LastRunAt = lastRunAt.Time
+	return &sch, nil
+}
@@ -4,6 +4,7 @@ import (
 	"time"
 
 	pb "github.com/moos3/sparta/proto"
+	protobuf "google.golang.org/protobuf/proto"
 )
 
 type RiskScore struct {
@@ -23,6 +24,34 @@ type DomainScanResults struct {
 	ISC     *pb.ISCSecurityResult // New: ISC Scan Result
 }
 
+// Add records a plugin result for scoring. Result types the scorer does not
+// know about are ignored.
+func (r *DomainScanResults) Add(result protobuf.Message) {
+	switch v := result.(type) {
+	case *pb.DNSSecurityResult:
+		r.DNS = v
+	case *pb.TLSSecurityResult:
+		r.TLS = v
+	case *pb.CrtShSecurityResult:
+		r.CrtSh = v
+	case *pb.ChaosSecurityResult:
+		r.Chaos = v
+	case *pb.ShodanSecurityResult:
+		r.Shodan = v
+	case *pb.OTXSecurityResult:
+		r.OTX = v
+	case *pb.WhoisSecurityResult:
+		r.Whois = v
+	case *pb.AbuseChSecurityResult:
+		r.AbuseCh = v
+	case *pb.ISCSecurityResult:
+		r.ISC = v
+	}
+}
+
+// CalculateRiskScore scores results. Requests an intelligence provider could
+// not serve, listed in a result's Unavailable field, say nothing about the
+// domain and are not scored.
 func CalculateRiskScore(results *DomainScanResults) RiskScore {
 	score := 0
 	now := time.Now()
@@ -32,12 +61,46 @@ func CalculateRiskScore(results *DomainScanResults) RiskScore {
 		if !results.DNS.SpfValid {
 			score += 20 // Missing or invalid SPF increases risk
 		}
+		if spf := results.DNS.SpfEvaluation; spf != nil {
+			if spf.PermissiveAll {
+				score += 10 // +all lets anyone send as the domain
+			}
+			if len(spf.BroadRanges) > 0 {
+				score += 5 // Overly broad ranges authorise senders the domain does not control
+			}
+		}
 		if !results.DNS.DmarcValid {
 			score += 20 // Missing or invalid DMARC increases risk
 		}
-		if !results.DNS.DnssecEnabled || !results.DNS.DnssecValid {
+		// Checks a passive scan leaves out are not held against the domain
+		if !results.DNS.DnssecSkipped && (!results.DNS.DnssecEnabled || !results.DNS.DnssecValid) {
 			score += 15 // Lack of DNSSEC or invalid DNSSEC increases risk
 		}
+		// MTA-STS and TLS-RPT only protect mail the domain receives
+		if len(results.DNS.MxRecords) > 0 {
+			switch mta := results.DNS.MtaSts; {
+			case mta == nil || !mta.RecordValid:
+				score += 5 // Without an MTA-STS policy, mail can be downgraded to plaintext
+			case results.DNS.MtaStsPolicySkipped:
+				// Only the record was looked up
+			case len(mta.Errors) > 0 || mta.Mode != "enforce":
+				score += 5 // A policy that is broken or not enforced does not stop downgrades
+			case len(mta.UnmatchedMx) > 0:
+				score += 10 // Enforcing senders refuse MX hosts the policy does not cover
+			}
+			if tlsRPT := results.DNS.TlsRpt; tlsRPT == nil || !tlsRPT.Valid {
+				score += 2 // No reports of failed TLS delivery
+			}
+		}
+		if bimi := results.DNS.Bimi; bimi != nil && !bimi.Valid {
+			score += 2 // A broken BIMI record is ignored by mailbox providers
+		}
+		for _, dane := range results.DNS.Dane {
+			if !dane.Valid {
+				score += 10 // Unusable TLSA records can make DANE senders defer mail
+				break
+			}
+		}
 		if len(results.DNS.Errors) > 0 {
 			score += 10 * len(results.DNS.Errors) // Errors indicate issues
 		}
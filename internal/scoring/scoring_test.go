@@ -0,0 +1,33 @@
+// internal/scoring/scoring_test.go
+package scoring
+
+import (
+	"testing"
+
+	pb "github.com/moos3/sparta/proto"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMailPolicyScoringNeedsMX(t *testing.T) {
+	tests := []struct {
+		name string
+		mx   []string
+		want int
+	}{
+		{"NoMX", nil, 0},
+		{"WithMX", []string{"mx.example.com"}, 7}, // missing MTA-STS and TLS-RPT
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dns := &pb.DNSSecurityResult{
+				SpfValid:      true,
+				DmarcValid:    true,
+				DnssecEnabled: true,
+				DnssecValid:   true,
+				MxRecords:     tt.mx,
+			}
+			got := CalculateRiskScore(&DomainScanResults{DNS: dns})
+			assert.Equal(t, tt.want, got.Score)
+		})
+	}
+}
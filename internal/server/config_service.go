@@ -0,0 +1,38 @@
+// internal/server/config_service.go
+package server
+
+import (
+	"context"
+
+	pb "github.com/moos3/sparta/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// DescribeConfig returns the version of the config the server is running
+// with and why the most recent reload was refused, if one was. Admin only.
+func (s *Server) DescribeConfig(ctx context.Context, req *pb.DescribeConfigRequest) (*pb.DescribeConfigResponse, error) {
+	if _, ok := ctx.Value("user_id").(string); !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing user ID")
+	}
+	if !s.isAdmin(ctx) {
+		return nil, status.Error(codes.PermissionDenied, "admin role required")
+	}
+	if s.configs == nil {
+		return nil, status.Error(codes.Unavailable, "config is not being watched")
+	}
+
+	v := s.configs.Version()
+	resp := &pb.DescribeConfigResponse{
+		Version:   int32(v.Number),
+		Checksum:  v.Checksum,
+		LoadedAt:  timestamppb.New(v.LoadedAt),
+		Path:      s.configs.Path(),
+		LastError: v.LastError,
+	}
+	if !v.FailedAt.IsZero() {
+		resp.FailedAt = timestamppb.New(v.FailedAt)
+	}
+	return resp, nil
+}
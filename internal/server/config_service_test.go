@@ -0,0 +1,73 @@
+// internal/server/config_service_test.go
+package server
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/moos3/sparta/internal/config"
+	"github.com/moos3/sparta/internal/interfaces"
+	"github.com/moos3/sparta/internal/profiles"
+	"github.com/moos3/sparta/internal/quota"
+	pb "github.com/moos3/sparta/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestDescribeConfig(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	require.NoError(t, os.WriteFile(path, []byte("shodan:\n  api_key: secret\n"), 0o600))
+	watcher, err := config.Watch(path)
+	require.NoError(t, err)
+	user := context.WithValue(context.Background(), "user_id", "user-1")
+	admin := context.WithValue(user, "role", "admin")
+
+	t.Run("NotAdmin", func(t *testing.T) {
+		s := &Server{configs: watcher}
+		_, err := s.DescribeConfig(context.WithValue(user, "role", "user"), &pb.DescribeConfigRequest{})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("NotWatched", func(t *testing.T) {
+		s := &Server{}
+		_, err := s.DescribeConfig(admin, &pb.DescribeConfigRequest{})
+		assert.Equal(t, codes.Unavailable, status.Code(err))
+	})
+
+	t.Run("Success", func(t *testing.T) {
+		s := &Server{}
+		s.WatchConfig(watcher)
+		resp, err := s.DescribeConfig(admin, &pb.DescribeConfigRequest{})
+		require.NoError(t, err)
+		assert.Equal(t, int32(1), resp.Version)
+		assert.Equal(t, watcher.Version().Checksum, resp.Checksum)
+		assert.Equal(t, path, resp.Path)
+		assert.Empty(t, resp.LastError)
+		assert.Nil(t, resp.FailedAt)
+	})
+}
+
+func TestServerReload(t *testing.T) {
+	s := &Server{
+		plugins:  map[string]interfaces.GenericPlugin{},
+		profiles: profiles.NewStore(nil, nil),
+		quota:    quota.New(nil, nil),
+	}
+	cfg := &config.Config{}
+	cfg.Scan.Profiles = map[string]config.ScanProfile{"tls-only": {Plugins: []string{"ScanTLS"}}}
+	plugins := map[string]interfaces.GenericPlugin{"ScanTLS": &MockTLSScanPlugin{}}
+
+	s.Reload(cfg, plugins, map[string]string{"ScanShodan": "disabled: missing shodan.api_key"})
+	loaded, disabled := s.loadedPlugins()
+	assert.Equal(t, plugins, loaded)
+	assert.Equal(t, "disabled: missing shodan.api_key", disabled["ScanShodan"])
+	assert.Same(t, cfg, s.currentConfig())
+	profile, err := s.profiles.Get("user-1", "tls-only")
+	if assert.NoError(t, err) {
+		assert.Equal(t, []string{"ScanTLS"}, s.profilePlugins(profile))
+	}
+}
@@ -3,20 +3,28 @@ package server
 
 import (
 	"context"
+	"database/sql/driver"
 	"fmt"
 	"testing"
 	"time"
 
+	"github.com/moos3/sparta/internal/breaker"
+	"github.com/moos3/sparta/internal/config"
+	"github.com/moos3/sparta/internal/db"
+	"github.com/moos3/sparta/internal/interfaces"
+	"github.com/moos3/sparta/internal/quota"
+	"github.com/moos3/sparta/internal/registry"
+	"github.com/moos3/sparta/internal/runs"
+	"github.com/moos3/sparta/internal/testutils"
 	pb "github.com/moos3/sparta/proto"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
-	//"github.com/moos3/sparta/internal/config"
-	"github.com/moos3/sparta/internal/db"
-	"github.com/moos3/sparta/internal/testutils"
 )
 
+const dnsScanExistsQuery = "SELECT EXISTS (SELECT 1 FROM dns_scan_results WHERE id = $1)"
+
 type MockTLSScanPlugin struct {
 	mock.Mock
 }
@@ -26,43 +34,41 @@ func (m *MockTLSScanPlugin) Initialize() error {
 	return args.Error(0)
 }
 
-func (m *MockTLSScanPlugin) Name() string {
-	args := m.Called()
-	return args.String(0)
+func (m *MockTLSScanPlugin) Scan(ctx context.Context, req interfaces.ScanRequest) (*interfaces.ScanResult, error) {
+	args := m.Called(ctx, req)
+	result, _ := args.Get(0).(*interfaces.ScanResult)
+	return result, args.Error(1)
 }
 
 func (m *MockTLSScanPlugin) SetDatabase(db db.Database) {
 	m.Called(db)
 }
 
-func (m *MockTLSScanPlugin) ScanTLS(domain string, dnsScanID string) (db.TLSSecurityResult, error) {
-	args := m.Called(domain, dnsScanID)
-	return args.Get(0).(db.TLSSecurityResult), args.Error(1)
+func (m *MockTLSScanPlugin) SetConfig(cfg *config.Config) error {
+	args := m.Called(cfg)
+	return args.Error(0)
+}
+
+func (m *MockTLSScanPlugin) ScanTLS(ctx context.Context, domain string, dnsScanID string) (*pb.TLSSecurityResult, error) {
+	args := m.Called(ctx, domain, dnsScanID)
+	result, _ := args.Get(0).(*pb.TLSSecurityResult)
+	return result, args.Error(1)
 }
 
-func (m *MockTLSScanPlugin) InsertTLSScanResult(domain string, dnsScanID string, result db.TLSSecurityResult) (string, error) {
-	args := m.Called(domain, dnsScanID, result)
+func (m *MockTLSScanPlugin) InsertTLSScanResult(scanRunID, domain, dnsScanID string, result *pb.TLSSecurityResult) (string, error) {
+	args := m.Called(scanRunID, domain, dnsScanID, result)
 	return args.String(0), args.Error(1)
 }
 
-func (m *MockTLSScanPlugin) GetTLSScanResultsByDomain(domain string) ([]struct {
-	ID        string
-	Domain    string
-	DNSScanID string
-	Result    db.TLSSecurityResult
-	CreatedAt time.Time
-}, error) {
+func (m *MockTLSScanPlugin) GetTLSScanResultsByDomain(domain string) ([]interfaces.TLSScanResult, error) {
 	args := m.Called(domain)
-	return args.Get(0).([]struct {
-		ID        string
-		Domain    string
-		DNSScanID string
-		Result    db.TLSSecurityResult
-		CreatedAt time.Time
-	}), args.Error(1)
+	results, _ := args.Get(0).([]interfaces.TLSScanResult)
+	return results, args.Error(1)
 }
 
-// Other mock plugins (MockCrtShScanPlugin, etc.) omitted for brevity
+func newTLSTestServer(database db.Database, plugin *MockTLSScanPlugin) *Server {
+	return &Server{db: database, runs: runs.NewStore(database), plugins: map[string]interfaces.GenericPlugin{"ScanTLS": plugin}}
+}
 
 func TestScanTLS(t *testing.T) {
 	ctx := context.Background()
@@ -77,7 +83,7 @@ func TestScanTLS(t *testing.T) {
 	})
 
 	t.Run("InvalidDomain", func(t *testing.T) {
-		s := &Server{TlsPlugin: &MockTLSScanPlugin{}}
+		s := newTLSTestServer(nil, &MockTLSScanPlugin{})
 		_, err := s.ScanTLS(ctx, &pb.ScanTLSRequest{Domain: "", DnsScanId: "scan-123"})
 		assert.Error(t, err)
 		st, ok := status.FromError(err)
@@ -85,74 +91,87 @@ func TestScanTLS(t *testing.T) {
 		assert.Equal(t, codes.InvalidArgument, st.Code())
 	})
 
-	t.Run("InvalidDnsScanID", func(t *testing.T) {
-		mockDb := testutils.NewMockDatabase()
+	t.Run("WithoutDnsScanID", func(t *testing.T) {
+		stubDb := testutils.NewStubDB()
+		create := stubDb.Expect(createRunQuery).WillReturnResult(1)
+		stubDb.Expect(finishRunQuery).WillReturnResult(1)
 		mockPlugin := &MockTLSScanPlugin{}
-		mockRow := &testutils.MockRow{}
-		s := &Server{Db: mockDb, TlsPlugin: mockPlugin}
-		query := "SELECT EXISTS (SELECT 1 FROM dns_scan_results WHERE id = $1)"
-		mockDb.On("QueryRow", query, "scan-123").Return(mockRow).Once()
-		mockRow.On("Scan", mock.Anything).Return(fmt.Errorf("db error")).Once()
+		s := newTLSTestServer(stubDb, mockPlugin)
+		result := &pb.TLSSecurityResult{TlsVersion: "TLS 1.3"}
+		mockPlugin.On("ScanTLS", mock.Anything, "example.com", "").Return(result, nil).Once()
+		mockPlugin.On("InsertTLSScanResult", mock.Anything, "example.com", "", result).Return("tls-scan-123", nil).Once()
+
+		resp, err := s.ScanTLS(context.WithValue(ctx, "user_id", "user-1"), &pb.ScanTLSRequest{Domain: "example.com"})
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.Equal(t, resp.ScanRunId, create.Args()[0])
+		assert.Equal(t, "user-1", create.Args()[2])
+		assert.Equal(t, "ScanTLS", create.Args()[3])
+		assert.NoError(t, stubDb.ExpectationsWereMet())
+		mockPlugin.AssertExpectations(t)
+	})
+
+	t.Run("InvalidDnsScanID", func(t *testing.T) {
+		stubDb := testutils.NewStubDB()
+		stubDb.Expect(dnsScanExistsQuery).WillReturnError(fmt.Errorf("db error"))
+		s := newTLSTestServer(stubDb, &MockTLSScanPlugin{})
 
 		_, err := s.ScanTLS(ctx, &pb.ScanTLSRequest{Domain: "example.com", DnsScanId: "scan-123"})
 		assert.Error(t, err)
 		st, ok := status.FromError(err)
 		assert.True(t, ok)
 		assert.Equal(t, codes.Internal, st.Code())
-		mockDb.AssertExpectations(t)
-		mockRow.AssertExpectations(t)
+		assert.NoError(t, stubDb.ExpectationsWereMet())
+	})
+
+	t.Run("UnknownDnsScanID", func(t *testing.T) {
+		stubDb := testutils.NewStubDB()
+		stubDb.Expect(dnsScanExistsQuery).WillReturnRows([]string{"exists"}, []driver.Value{false})
+		s := newTLSTestServer(stubDb, &MockTLSScanPlugin{})
+
+		_, err := s.ScanTLS(ctx, &pb.ScanTLSRequest{Domain: "example.com", DnsScanId: "scan-123"})
+		assert.Error(t, err)
+		st, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.NotFound, st.Code())
+		assert.NoError(t, stubDb.ExpectationsWereMet())
 	})
 
 	t.Run("Success", func(t *testing.T) {
-		mockDb := testutils.NewMockDatabase()
+		stubDb := testutils.NewStubDB()
+		stubDb.Expect(dnsScanExistsQuery).WillReturnRows([]string{"exists"}, []driver.Value{true})
+		expectScanRun(stubDb)
 		mockPlugin := &MockTLSScanPlugin{}
-		mockRow := &testutils.MockRow{}
-		s := &Server{Db: mockDb, TlsPlugin: mockPlugin}
-		result := db.TLSSecurityResult{TLSVersion: "TLSv1.3", CertificateValid: true}
-		query := "SELECT EXISTS (SELECT 1 FROM dns_scan_results WHERE id = $1)"
-		mockDb.On("QueryRow", query, "scan-123").Return(mockRow).Once()
-		mockRow.On("Scan", mock.Anything).Run(func(args mock.Arguments) {
-			*args.Get(0).(*bool) = true
-		}).Return(nil).Once()
-		mockPlugin.On("ScanTLS", "example.com", "scan-123").Return(result, nil).Once()
-		mockPlugin.On("InsertTLSScanResult", "example.com", "scan-123", result).Return("tls-scan-123", nil).Once()
-
-		resp, err := s.ScanTLS(ctx, &pb.ScanTLSRequest{Domain: "example.com", DnsScanId: "scan-123"})
+		s := newTLSTestServer(stubDb, mockPlugin)
+		result := &pb.TLSSecurityResult{TlsVersion: "TLS 1.3", CertificateValid: true}
+		mockPlugin.On("ScanTLS", mock.Anything, "example.com", "scan-123").Return(result, nil).Once()
+		mockPlugin.On("InsertTLSScanResult", mock.Anything, "example.com", "scan-123", result).Return("tls-scan-123", nil).Once()
+
+		resp, err := s.ScanTLS(ctx, &pb.ScanTLSRequest{Domain: "Example.com", DnsScanId: "scan-123"})
 		if !assert.NoError(t, err) {
-			t.Logf("ScanTLS failed: %v", err)
-			return
-		}
-		if !assert.NotNil(t, resp) {
-			t.Log("Response is nil")
 			return
 		}
 		assert.Equal(t, "tls-scan-123", resp.ScanId)
-		assert.Equal(t, "TLSv1.3", resp.Result.TlsVersion)
+		assert.Equal(t, "TLS 1.3", resp.Result.TlsVersion)
 		assert.True(t, resp.Result.CertificateValid)
-		mockDb.AssertExpectations(t)
-		mockRow.AssertExpectations(t)
+		assert.NoError(t, stubDb.ExpectationsWereMet())
 		mockPlugin.AssertExpectations(t)
 	})
 
 	t.Run("ScanError", func(t *testing.T) {
-		mockDb := testutils.NewMockDatabase()
+		stubDb := testutils.NewStubDB()
+		stubDb.Expect(dnsScanExistsQuery).WillReturnRows([]string{"exists"}, []driver.Value{true})
+		expectScanRun(stubDb)
 		mockPlugin := &MockTLSScanPlugin{}
-		mockRow := &testutils.MockRow{}
-		s := &Server{Db: mockDb, TlsPlugin: mockPlugin}
-		query := "SELECT EXISTS (SELECT 1 FROM dns_scan_results WHERE id = $1)"
-		mockDb.On("QueryRow", query, "scan-123").Return(mockRow).Once()
-		mockRow.On("Scan", mock.Anything).Run(func(args mock.Arguments) {
-			*args.Get(0).(*bool) = true
-		}).Return(nil).Once()
-		mockPlugin.On("ScanTLS", "example.com", "scan-123").Return(db.TLSSecurityResult{}, fmt.Errorf("scan error")).Once()
+		s := newTLSTestServer(stubDb, mockPlugin)
+		mockPlugin.On("ScanTLS", mock.Anything, "example.com", "scan-123").Return(nil, fmt.Errorf("scan error")).Once()
 
 		_, err := s.ScanTLS(ctx, &pb.ScanTLSRequest{Domain: "example.com", DnsScanId: "scan-123"})
 		assert.Error(t, err)
 		st, ok := status.FromError(err)
 		assert.True(t, ok)
 		assert.Equal(t, codes.Internal, st.Code())
-		mockDb.AssertExpectations(t)
-		mockRow.AssertExpectations(t)
 		mockPlugin.AssertExpectations(t)
 	})
 }
@@ -170,7 +189,7 @@ func TestGetTLSScanResultsByDomain(t *testing.T) {
 	})
 
 	t.Run("InvalidDomain", func(t *testing.T) {
-		s := &Server{TlsPlugin: &MockTLSScanPlugin{}}
+		s := newTLSTestServer(nil, &MockTLSScanPlugin{})
 		_, err := s.GetTLSScanResultsByDomain(ctx, &pb.GetTLSScanResultsByDomainRequest{Domain: ""})
 		assert.Error(t, err)
 		st, ok := status.FromError(err)
@@ -180,20 +199,14 @@ func TestGetTLSScanResultsByDomain(t *testing.T) {
 
 	t.Run("Success", func(t *testing.T) {
 		mockPlugin := &MockTLSScanPlugin{}
-		s := &Server{TlsPlugin: mockPlugin}
+		s := newTLSTestServer(nil, mockPlugin)
 		createdAt := time.Now()
-		results := []struct {
-			ID        string
-			Domain    string
-			DNSScanID string
-			Result    db.TLSSecurityResult
-			CreatedAt time.Time
-		}{
+		results := []interfaces.TLSScanResult{
 			{
 				ID:        "tls-scan-123",
 				Domain:    "example.com",
 				DNSScanID: "scan-123",
-				Result:    db.TLSSecurityResult{TLSVersion: "TLSv1.3", CertificateValid: true},
+				Result:    &pb.TLSSecurityResult{TlsVersion: "TLS 1.3", CertificateValid: true},
 				CreatedAt: createdAt,
 			},
 		}
@@ -201,31 +214,21 @@ func TestGetTLSScanResultsByDomain(t *testing.T) {
 
 		resp, err := s.GetTLSScanResultsByDomain(ctx, &pb.GetTLSScanResultsByDomainRequest{Domain: "example.com"})
 		if !assert.NoError(t, err) {
-			t.Logf("GetTLSScanResultsByDomain failed: %v", err)
-			return
-		}
-		if !assert.NotNil(t, resp) {
-			t.Log("Response is nil")
 			return
 		}
 		assert.Len(t, resp.Results, 1)
 		assert.Equal(t, "tls-scan-123", resp.Results[0].Id)
-		assert.Equal(t, "TLSv1.3", resp.Results[0].Result.TlsVersion)
+		assert.Equal(t, "scan-123", resp.Results[0].DnsScanId)
+		assert.Equal(t, "TLS 1.3", resp.Results[0].Result.TlsVersion)
 		assert.True(t, resp.Results[0].Result.CertificateValid)
-		assert.Equal(t, createdAt.Format(time.RFC3339), resp.Results[0].CreatedAt)
+		assert.True(t, createdAt.Equal(resp.Results[0].CreatedAt.AsTime()))
 		mockPlugin.AssertExpectations(t)
 	})
 
 	t.Run("Error", func(t *testing.T) {
 		mockPlugin := &MockTLSScanPlugin{}
-		s := &Server{TlsPlugin: mockPlugin}
-		mockPlugin.On("GetTLSScanResultsByDomain", "example.com").Return([]struct {
-			ID        string
-			Domain    string
-			DNSScanID string
-			Result    db.TLSSecurityResult
-			CreatedAt time.Time
-		}{}, fmt.Errorf("retrieve error")).Once()
+		s := newTLSTestServer(nil, mockPlugin)
+		mockPlugin.On("GetTLSScanResultsByDomain", "example.com").Return(nil, fmt.Errorf("retrieve error")).Once()
 
 		_, err := s.GetTLSScanResultsByDomain(ctx, &pb.GetTLSScanResultsByDomainRequest{Domain: "example.com"})
 		assert.Error(t, err)
@@ -236,4 +239,99 @@ func TestGetTLSScanResultsByDomain(t *testing.T) {
 	})
 }
 
-// Other tests (TestScanCrtSh, TestScanChaos, etc.) omitted for brevity
+type MockCrtShScanPlugin struct {
+	MockTLSScanPlugin
+}
+
+func (m *MockCrtShScanPlugin) ScanCrtSh(ctx context.Context, domain, dnsScanID string) (*pb.CrtShSecurityResult, error) {
+	args := m.Called(ctx, domain, dnsScanID)
+	result, _ := args.Get(0).(*pb.CrtShSecurityResult)
+	return result, args.Error(1)
+}
+
+func (m *MockCrtShScanPlugin) InsertCrtShScanResult(scanRunID, domain, dnsScanID string, result *pb.CrtShSecurityResult) (string, error) {
+	args := m.Called(scanRunID, domain, dnsScanID, result)
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockCrtShScanPlugin) GetCrtShScanResultsByDomain(domain string) ([]interfaces.CrtShScanResult, error) {
+	args := m.Called(domain)
+	results, _ := args.Get(0).([]interfaces.CrtShScanResult)
+	return results, args.Error(1)
+}
+
+func TestScanRefusedByProvider(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("BreakerOpen", func(t *testing.T) {
+		b := breaker.For(nil, "crtsh")
+		for i := 0; i < b.Policy.Failures; i++ {
+			b.Failure(fmt.Errorf("status 503"))
+		}
+		t.Cleanup(b.Success)
+		mockPlugin := &MockCrtShScanPlugin{}
+		s := &Server{plugins: map[string]interfaces.GenericPlugin{"ScanCrtSh": mockPlugin}}
+
+		_, err := s.ScanCrtSh(ctx, &pb.ScanCrtShRequest{Domain: "example.com"})
+		assert.Equal(t, codes.Unavailable, status.Code(err))
+		mockPlugin.AssertNotCalled(t, "ScanCrtSh", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("QuotaExhausted", func(t *testing.T) {
+		cfg := &config.Config{Quotas: map[string]config.ProviderQuota{"crtsh": {Daily: 10}}}
+		stubDb := testutils.NewStubDB()
+		stubDb.Expect("FROM provider_usage WHERE provider = $1").WillReturnRows([]string{"daily", "monthly"}, []driver.Value{int64(10), int64(10)})
+		mockPlugin := &MockCrtShScanPlugin{}
+		s := &Server{config: cfg, quota: quota.New(stubDb, cfg), plugins: map[string]interfaces.GenericPlugin{"ScanCrtSh": mockPlugin}}
+
+		_, err := s.ScanCrtSh(ctx, &pb.ScanCrtShRequest{Domain: "example.com"})
+		assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+		assert.NoError(t, stubDb.ExpectationsWereMet())
+		mockPlugin.AssertNotCalled(t, "ScanCrtSh", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestListPlugins(t *testing.T) {
+	if _, ok := registry.Lookup("ScanTLS"); !ok {
+		registry.Register(registry.Plugin{
+			Name:         "ScanTLS",
+			Version:      "1.0.0",
+			Dependencies: []string{"ScanDNS"},
+			New:          func() interfaces.GenericPlugin { return &MockTLSScanPlugin{} },
+		})
+	}
+	if _, ok := registry.Lookup("ScanShodan"); !ok {
+		registry.Register(registry.Plugin{
+			Name:           "ScanShodan",
+			Version:        "1.0.0",
+			RequiredConfig: []string{"shodan.api_key"},
+			Passive:        true,
+			New:            func() interfaces.GenericPlugin { return &MockTLSScanPlugin{} },
+		})
+	}
+	s := newTLSTestServer(nil, &MockTLSScanPlugin{})
+	s.config = &config.Config{}
+	s.disabled = map[string]string{"ScanShodan": "disabled: missing shodan.api_key"}
+
+	resp, err := s.ListPlugins(context.Background(), &pb.ListPluginsRequest{})
+	if !assert.NoError(t, err) {
+		return
+	}
+	plugins := make(map[string]*pb.PluginInfo)
+	for _, p := range resp.Plugins {
+		plugins[p.Name] = p
+	}
+	if assert.Contains(t, plugins, "ScanTLS") {
+		assert.True(t, plugins["ScanTLS"].Loaded)
+		assert.True(t, plugins["ScanTLS"].Configured)
+		assert.Equal(t, []string{"ScanDNS"}, plugins["ScanTLS"].Dependencies)
+	}
+	if assert.Contains(t, plugins, "ScanShodan") {
+		assert.False(t, plugins["ScanShodan"].Loaded)
+		assert.False(t, plugins["ScanShodan"].Configured)
+		assert.Equal(t, []string{"shodan.api_key"}, plugins["ScanShodan"].MissingConfig)
+		assert.True(t, plugins["ScanShodan"].Enabled)
+		assert.False(t, plugins["ScanShodan"].Healthy)
+		assert.Equal(t, "disabled: missing shodan.api_key", plugins["ScanShodan"].HealthMessage)
+	}
+}
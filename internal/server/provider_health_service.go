@@ -0,0 +1,47 @@
+// internal/server/provider_health_service.go
+package server
+
+import (
+	"context"
+
+	"github.com/moos3/sparta/internal/breaker"
+	pb "github.com/moos3/sparta/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// GetProviderHealth returns the circuit breaker state of each intelligence
+// provider. A provider not called since the server started is reported closed.
+func (s *Server) GetProviderHealth(ctx context.Context, req *pb.GetProviderHealthRequest) (*pb.GetProviderHealthResponse, error) {
+	if _, ok := ctx.Value("user_id").(string); !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing user ID")
+	}
+
+	list := breaker.List()
+	if provider := req.GetProvider(); provider != "" {
+		filtered := []breaker.Status{{Provider: provider, State: breaker.StateClosed}}
+		for _, st := range list {
+			if st.Provider == provider {
+				filtered[0] = st
+			}
+		}
+		list = filtered
+	}
+
+	resp := &pb.GetProviderHealthResponse{}
+	for _, st := range list {
+		health := &pb.ProviderHealth{
+			Provider:            st.Provider,
+			State:               string(st.State),
+			ConsecutiveFailures: int32(st.Failures),
+			LastError:           st.LastError,
+		}
+		if !st.OpenedAt.IsZero() {
+			health.OpenedAt = timestamppb.New(st.OpenedAt)
+			health.RetryAt = timestamppb.New(st.RetryAt)
+		}
+		resp.Providers = append(resp.Providers, health)
+	}
+	return resp, nil
+}
@@ -0,0 +1,48 @@
+// internal/server/provider_usage_service.go
+package server
+
+import (
+	"context"
+
+	"github.com/moos3/sparta/internal/quota"
+	pb "github.com/moos3/sparta/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetProviderUsage returns how much of each intelligence provider's daily
+// and monthly budget has been spent
+func (s *Server) GetProviderUsage(ctx context.Context, req *pb.GetProviderUsageRequest) (*pb.GetProviderUsageResponse, error) {
+	if _, ok := ctx.Value("user_id").(string); !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing user ID")
+	}
+
+	var list []*quota.Usage
+	if req.GetProvider() != "" {
+		usage, err := s.quota.Usage(req.GetProvider())
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get provider usage: %v", err)
+		}
+		list = append(list, usage)
+	} else {
+		var err error
+		if list, err = s.quota.List(); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to list provider usage: %v", err)
+		}
+	}
+
+	resp := &pb.GetProviderUsageResponse{}
+	for _, u := range list {
+		resp.Providers = append(resp.Providers, &pb.ProviderUsage{
+			Provider:         u.Provider,
+			DailyCalls:       int32(u.DailyCalls),
+			DailyLimit:       int32(u.DailyLimit),
+			DailyRemaining:   int32(u.DailyRemaining()),
+			MonthlyCalls:     int32(u.MonthlyCalls),
+			MonthlyLimit:     int32(u.MonthlyLimit),
+			MonthlyRemaining: int32(u.MonthlyRemaining()),
+			Exhausted:        u.Exhausted(),
+		})
+	}
+	return resp, nil
+}
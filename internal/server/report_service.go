@@ -3,107 +3,294 @@ package server
 import (
 	"context"
 	"database/sql"
-	"fmt"
+	"errors"
 	"log"
+	"sync"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/moos3/sparta/internal/config"
 	"github.com/moos3/sparta/internal/db"
+	"github.com/moos3/sparta/internal/intelcache"
 	"github.com/moos3/sparta/internal/interfaces"
+	"github.com/moos3/sparta/internal/jobs"
+	"github.com/moos3/sparta/internal/orchestrator"
+	"github.com/moos3/sparta/internal/profiles"
+	"github.com/moos3/sparta/internal/runs"
+	"github.com/moos3/sparta/internal/scoring"
 	pb "github.com/moos3/sparta/proto"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	protobuf "google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type ReportService struct {
-	db      db.Database
-	plugins map[string]interfaces.GenericPlugin
+	db       db.Database
+	mu       sync.RWMutex // guards config and plugins, which a reload swaps
+	config   *config.Config
+	plugins  map[string]interfaces.GenericPlugin
+	runs     *runs.Store
+	profiles *profiles.Store
 	pb.UnimplementedReportServiceServer
 }
 
-func NewReportService(db db.Database, plugins map[string]interfaces.GenericPlugin) *ReportService {
+func NewReportService(db db.Database, cfg *config.Config, plugins map[string]interfaces.GenericPlugin) *ReportService {
 	return &ReportService{
-		db:      db,
-		plugins: plugins,
+		db:       db,
+		config:   cfg,
+		plugins:  plugins,
+		runs:     runs.NewStore(db),
+		profiles: profiles.NewStore(db, cfg),
 	}
 }
 
+// Reload swaps in a reloaded config and the plugins loaded with it. Reports
+// already being generated finish with the config and plugins they started
+// with.
+func (s *ReportService) Reload(cfg *config.Config, plugins map[string]interfaces.GenericPlugin) {
+	s.profiles.SetConfig(cfg)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.config, s.plugins = cfg, plugins
+}
+
+// current returns the config and plugins reports are generated with.
+func (s *ReportService) current() (*config.Config, map[string]interfaces.GenericPlugin) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.config, s.plugins
+}
+
 func (s *ReportService) GenerateReport(ctx context.Context, req *pb.GenerateReportRequest) (*pb.GenerateReportResponse, error) {
 	userID, ok := ctx.Value("user_id").(string)
 	if !ok {
 		return nil, status.Error(codes.Unauthenticated, "missing user ID")
 	}
 
-	domain := req.GetDomain()
+	domain := normalizeDomain(req.GetDomain())
 	if domain == "" {
 		return nil, status.Error(codes.InvalidArgument, "domain is required")
 	}
 
-	// Generate DNS scan
-	dnsScanID := uuid.New().String()
-	var dnsResult pb.DNSSecurityResult
-	if plugin, exists := s.plugins["ScanDNS"]; exists {
-		result, err := plugin.Scan(ctx, domain, "")
-		if err != nil {
-			log.Printf("DNS scan failed for %s: %v", domain, err)
-		} else if dnsRes, ok := result.(*pb.DNSSecurityResult); ok {
-			dnsResult = *dnsRes
-		}
+	cfg, plugins := s.current()
+	if _, ok := plugins[orchestrator.DNSPlugin]; !ok {
+		return nil, status.Error(codes.Unavailable, "DNS plugin not loaded")
+	}
+	profile, err := scanProfile(s.profiles, userID, req.GetProfile())
+	if err != nil {
+		return nil, err
+	}
+	scanRun, err := s.runs.Create(domain, userID, profile.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to start scan run: %v", err)
 	}
 
-	// Store DNS scan result
-	query := `
-		INSERT INTO dns_scans (id, user_id, domain, spf_record, spf_valid, dkim_record, dkim_valid, dmarc_record, dmarc_policy, dmarc_valid, dnssec_enabled, created_at)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
-	`
-	_, err := s.db.Exec(query, dnsScanID, userID, domain, dnsResult.GetSpfRecord(), dnsResult.GetSpfValid(),
-		dnsResult.GetDkimRecord(), dnsResult.GetDkimValid(), dnsResult.GetDmarcRecord(),
-		dnsResult.GetDmarcPolicy(), dnsResult.GetDmarcValid(), dnsResult.GetDnssecEnabled(), time.Now())
+	if req.GetRefresh() {
+		ctx = intelcache.WithRefresh(ctx)
+	}
+
+	// Run the DNS scan first, then the profile's other plugins against its stored result
+	run, err := profile.Apply(orchestrator.New(plugins, cfg)).Run(ctx, scanRun.ID, domain, nil)
+	if err == nil && ctx.Err() != nil {
+		// The client went away and the remaining plugins were skipped; a
+		// report of the partial results would understate the risk
+		s.finishRun(scanRun.ID, errScanCancelled)
+		return nil, status.FromContextError(ctx.Err()).Err()
+	}
+	s.finishRun(scanRun.ID, err)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "%v", err)
+	}
+	resp, err := s.storeReport(userID, profile.Name, run)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to store DNS scan: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to store report: %v", err)
 	}
+	return resp, nil
+}
 
-	// Run other scans
-	for name, plugin := range s.plugins {
-		if name != "ScanDNS" {
-			// Pass dns_scan_id as a string
-			_, err := plugin.Scan(ctx, domain, fmt.Sprintf("dns_scan_id=%s", dnsScanID))
-			if err != nil {
-				log.Printf("%s scan failed for %s: %v", name, domain, err)
-			}
-		}
+// FinishScanJob stores the report for a completed scan job. It is the
+// jobs.FinishFunc the job runner calls.
+func (s *ReportService) FinishScanJob(job *jobs.Job, run *orchestrator.Run) (string, error) {
+	profile := job.Profile
+	if profile == "" {
+		profile = runs.ProfileFull
+	}
+	resp, err := s.storeReport(job.UserID, profile, run)
+	if err != nil {
+		return "", err
 	}
+	return resp.ReportId, nil
+}
 
-	// Calculate risk score
-	riskScore := 100 // Simplified; integrate scoring logic
-	riskTier := "Low"
-	if riskScore < 50 {
-		riskTier = "High"
-	} else if riskScore < 75 {
-		riskTier = "Medium"
+// errScanCancelled is recorded on the scan run of a report whose client
+// cancelled before every plugin ran.
+var errScanCancelled = errors.New("scan cancelled")
+
+// finishRun records the outcome of a report's scan run
+func (s *ReportService) finishRun(id string, runErr error) {
+	runStatus, errMsg := runs.StatusSucceeded, ""
+	if runErr != nil {
+		runStatus, errMsg = runs.StatusFailed, runErr.Error()
+	}
+	if err := s.runs.Finish(id, runStatus, errMsg); err != nil {
+		log.Printf("%v", err)
 	}
+}
+
+// storeReport scores a completed run and stores it as a report owned by
+// userID, recording the profile the run scanned with
+func (s *ReportService) storeReport(userID, profile string, run *orchestrator.Run) (*pb.GenerateReportResponse, error) {
+	risk := scoreRun(run)
 
 	// Store report
 	reportID := uuid.New().String()
-	query = `
-		INSERT INTO reports (id, user_id, domain, dns_scan_id, score, risk_tier, created_at)
-		VALUES ($1, $2, $3, $4, $5, $6, $7)
+	query := `
+		INSERT INTO reports (id, user_id, domain, dns_scan_id, scan_run_id, profile, score, risk_tier, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
 	`
-	_, err = s.db.Exec(query, reportID, userID, domain, dnsScanID, riskScore, riskTier, time.Now())
+	_, err := s.db.Exec(query, reportID, userID, run.Domain, run.DNSScanID, run.ID, profile, risk.Score, risk.RiskTier, time.Now())
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to store report: %v", err)
+		return nil, err
 	}
 
 	return &pb.GenerateReportResponse{
 		ReportId:  reportID,
-		DnsScanId: dnsScanID,
-		Score:     int32(riskScore),
-		RiskTier:  riskTier,
+		DnsScanId: run.DNSScanID,
+		ScanRunId: run.ID,
+		Profile:   profile,
+		Score:     int32(risk.Score),
+		RiskTier:  risk.RiskTier,
 		CreatedAt: timestamppb.Now(),
 	}, nil
 }
 
+// GenerateReportStream runs the same scan as GenerateReport but streams an
+// event as each plugin changes state, with its result and the risk score so
+// far. The final event carries the stored report.
+func (s *ReportService) GenerateReportStream(req *pb.GenerateReportRequest, stream pb.ReportService_GenerateReportStreamServer) error {
+	ctx, cancel := context.WithCancel(stream.Context())
+	defer cancel()
+	userID, ok := ctx.Value("user_id").(string)
+	if !ok {
+		return status.Error(codes.Unauthenticated, "missing user ID")
+	}
+
+	domain := normalizeDomain(req.GetDomain())
+	if domain == "" {
+		return status.Error(codes.InvalidArgument, "domain is required")
+	}
+
+	cfg, plugins := s.current()
+	if _, ok := plugins[orchestrator.DNSPlugin]; !ok {
+		return status.Error(codes.Unavailable, "DNS plugin not loaded")
+	}
+	profile, err := scanProfile(s.profiles, userID, req.GetProfile())
+	if err != nil {
+		return err
+	}
+	scanRun, err := s.runs.Create(domain, userID, profile.Name)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to start scan run: %v", err)
+	}
+
+	if req.GetRefresh() {
+		ctx = intelcache.WithRefresh(ctx)
+	}
+
+	// Events are delivered one at a time, so the running results need no lock
+	results := &scoring.DomainScanResults{}
+	var sendErr error
+	run, err := profile.Apply(orchestrator.New(plugins, cfg)).Run(ctx, scanRun.ID, domain, func(ev orchestrator.Event) {
+		if sendErr != nil {
+			return
+		}
+		event := &pb.ReportProgressEvent{Plugin: ev.Plugin, Status: string(ev.State)}
+		if ev.Result != nil {
+			event.ResultId = ev.Result.ID
+			event.Errors = ev.Result.Errors
+			event.TimedOut = ev.Result.TimedOut
+			if ev.State == orchestrator.StateSucceeded {
+				results.Add(ev.Result.Result)
+				setPartialResult(event, ev.Result.Result)
+			}
+		}
+		risk := scoring.CalculateRiskScore(results)
+		event.Score = int32(risk.Score)
+		event.RiskTier = risk.RiskTier
+		if sendErr = stream.Send(event); sendErr != nil {
+			// The client has gone away; stop the plugins that are still running
+			cancel()
+		}
+	})
+	if err == nil && sendErr != nil {
+		err = sendErr
+	}
+	if err == nil && ctx.Err() != nil {
+		// As in GenerateReport, partial results are not stored as a report
+		s.finishRun(scanRun.ID, errScanCancelled)
+		return status.FromContextError(ctx.Err()).Err()
+	}
+	s.finishRun(scanRun.ID, err)
+	if sendErr != nil {
+		return sendErr
+	}
+	if err != nil {
+		return status.Errorf(codes.Internal, "%v", err)
+	}
+
+	resp, err := s.storeReport(userID, profile.Name, run)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to store report: %v", err)
+	}
+	return stream.Send(&pb.ReportProgressEvent{
+		Done:      true,
+		ReportId:  resp.ReportId,
+		DnsScanId: resp.DnsScanId,
+		ScanRunId: resp.ScanRunId,
+		Profile:   resp.Profile,
+		Score:     resp.Score,
+		RiskTier:  resp.RiskTier,
+	})
+}
+
+// scoreRun scores the results of the plugins that succeeded in run
+func scoreRun(run *orchestrator.Run) scoring.RiskScore {
+	results := &scoring.DomainScanResults{}
+	for _, res := range run.Results {
+		if res.Status == interfaces.ScanStatusSucceeded {
+			results.Add(res.Result)
+		}
+	}
+	return scoring.CalculateRiskScore(results)
+}
+
+// setPartialResult attaches a plugin result to the matching event field
+func setPartialResult(event *pb.ReportProgressEvent, result protobuf.Message) {
+	switch v := result.(type) {
+	case *pb.DNSSecurityResult:
+		event.DnsResult = v
+	case *pb.TLSSecurityResult:
+		event.TlsResult = v
+	case *pb.CrtShSecurityResult:
+		event.CrtshResult = v
+	case *pb.ChaosSecurityResult:
+		event.ChaosResult = v
+	case *pb.ShodanSecurityResult:
+		event.ShodanResult = v
+	case *pb.OTXSecurityResult:
+		event.OtxResult = v
+	case *pb.WhoisSecurityResult:
+		event.WhoisResult = v
+	case *pb.AbuseChSecurityResult:
+		event.AbusechResult = v
+	case *pb.ISCSecurityResult:
+		event.IscResult = v
+	case *pb.ExternalSecurityResult:
+		event.ExternalResult = v
+	}
+}
+
 func (s *ReportService) ListReports(ctx context.Context, req *pb.ListReportsRequest) (*pb.ListReportsResponse, error) {
 	userID, ok := ctx.Value("user_id").(string)
 	if !ok {
@@ -111,7 +298,7 @@ func (s *ReportService) ListReports(ctx context.Context, req *pb.ListReportsRequ
 	}
 
 	query := `
-		SELECT id, domain, dns_scan_id, score, risk_tier, created_at
+		SELECT id, domain, dns_scan_id, COALESCE(scan_run_id::text, ''), profile, score, risk_tier, created_at
 		FROM reports
 		WHERE user_id = $1
 	`
@@ -131,7 +318,7 @@ func (s *ReportService) ListReports(ctx context.Context, req *pb.ListReportsRequ
 	for rows.Next() {
 		var r pb.Report
 		var createdAt time.Time
-		if err := rows.Scan(&r.ReportId, &r.Domain, &r.DnsScanId, &r.Score, &r.RiskTier, &createdAt); err != nil {
+		if err := rows.Scan(&r.ReportId, &r.Domain, &r.DnsScanId, &r.ScanRunId, &r.Profile, &r.Score, &r.RiskTier, &createdAt); err != nil {
 			return nil, status.Errorf(codes.Internal, "failed to scan report: %v", err)
 		}
 		r.CreatedAt = timestamppb.New(createdAt)
@@ -153,13 +340,13 @@ func (s *ReportService) GetReportById(ctx context.Context, req *pb.GetReportById
 	}
 
 	query := `
-		SELECT id, domain, dns_scan_id, score, risk_tier, created_at
+		SELECT id, domain, dns_scan_id, COALESCE(scan_run_id::text, ''), profile, score, risk_tier, created_at
 		FROM reports
 		WHERE id = $1 AND user_id = $2
 	`
 	var r pb.Report
 	var createdAt time.Time
-	err := s.db.QueryRow(query, reportID, userID).Scan(&r.ReportId, &r.Domain, &r.DnsScanId, &r.Score, &r.RiskTier, &createdAt)
+	err := s.db.QueryRow(query, reportID, userID).Scan(&r.ReportId, &r.Domain, &r.DnsScanId, &r.ScanRunId, &r.Profile, &r.Score, &r.RiskTier, &createdAt)
 	if err == sql.ErrNoRows {
 		return nil, status.Error(codes.NotFound, "report not found")
 	}
@@ -0,0 +1,201 @@
+// internal/server/report_service_test.go
+package server
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/moos3/sparta/internal/interfaces"
+	"github.com/moos3/sparta/internal/testutils"
+	pb "github.com/moos3/sparta/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const insertReportQuery = "INSERT INTO reports (id, user_id, domain, dns_scan_id, scan_run_id, profile, score, risk_tier, created_at)"
+
+// scanRequestFor matches a ScanRequest by domain and parent, ignoring the
+// deadline the orchestrator sets
+func scanRequestFor(domain, parentID string) interface{} {
+	return mock.MatchedBy(func(req interfaces.ScanRequest) bool {
+		return req.Domain == domain && req.ParentID == parentID
+	})
+}
+
+func TestGenerateReport(t *testing.T) {
+	ctx := context.WithValue(context.Background(), "user_id", "user-1")
+
+	t.Run("Unauthenticated", func(t *testing.T) {
+		s := NewReportService(nil, nil, nil)
+		_, err := s.GenerateReport(context.Background(), &pb.GenerateReportRequest{Domain: "example.com"})
+		st, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.Unauthenticated, st.Code())
+	})
+
+	t.Run("DNSPluginNotLoaded", func(t *testing.T) {
+		s := NewReportService(nil, nil, map[string]interfaces.GenericPlugin{})
+		_, err := s.GenerateReport(ctx, &pb.GenerateReportRequest{Domain: "example.com"})
+		st, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.Unavailable, st.Code())
+	})
+
+	t.Run("PassesDNSScanIDToOtherPlugins", func(t *testing.T) {
+		dnsPlugin := &MockDNSScanPlugin{}
+		tlsPlugin := &MockTLSScanPlugin{}
+		dnsRes := interfaces.NewScanResult("ScanDNS").Succeed("dns-1", &pb.DNSSecurityResult{})
+		tlsRes := interfaces.NewScanResult("ScanTLS").Succeed("tls-1", &pb.TLSSecurityResult{})
+		dnsPlugin.On("Scan", mock.Anything, scanRequestFor("example.com", "")).Return(dnsRes, nil).Once()
+		tlsPlugin.On("Scan", mock.Anything, scanRequestFor("example.com", "dns-1")).Return(tlsRes, nil).Once()
+
+		stubDb := testutils.NewStubDB()
+		create := stubDb.Expect(createRunQuery).WillReturnResult(1)
+		stubDb.Expect(finishRunQuery).WillReturnResult(1)
+		insert := stubDb.Expect(insertReportQuery).WillReturnResult(1)
+		s := NewReportService(stubDb, nil, map[string]interfaces.GenericPlugin{"ScanDNS": dnsPlugin, "ScanTLS": tlsPlugin})
+
+		resp, err := s.GenerateReport(ctx, &pb.GenerateReportRequest{Domain: "Example.com"})
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.Equal(t, "dns-1", resp.DnsScanId)
+		assert.Equal(t, create.Args()[0], resp.ScanRunId)
+		assert.NoError(t, stubDb.ExpectationsWereMet())
+		assert.Equal(t, "dns-1", insert.Args()[3])
+		assert.Equal(t, resp.ScanRunId, insert.Args()[4])
+		dnsPlugin.AssertExpectations(t)
+		tlsPlugin.AssertExpectations(t)
+	})
+
+	t.Run("DNSScanError", func(t *testing.T) {
+		dnsPlugin := &MockDNSScanPlugin{}
+		dnsRes := interfaces.NewScanResult("ScanDNS").Fail(fmt.Errorf("lookup failed"))
+		dnsPlugin.On("Scan", mock.Anything, scanRequestFor("example.com", "")).Return(dnsRes, fmt.Errorf("lookup failed")).Once()
+		stubDb := testutils.NewStubDB()
+		stubDb.Expect(createRunQuery).WillReturnResult(1)
+		finish := stubDb.Expect(finishRunQuery).WillReturnResult(1)
+		s := NewReportService(stubDb, nil, map[string]interfaces.GenericPlugin{"ScanDNS": dnsPlugin})
+
+		_, err := s.GenerateReport(ctx, &pb.GenerateReportRequest{Domain: "example.com"})
+		st, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.Internal, st.Code())
+		assert.Equal(t, "failed", finish.Args()[1])
+		dnsPlugin.AssertExpectations(t)
+	})
+
+	t.Run("CancelledDoesNotStoreReport", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		dnsPlugin := &MockDNSScanPlugin{}
+		tlsPlugin := &MockTLSScanPlugin{}
+		dnsRes := interfaces.NewScanResult("ScanDNS").Succeed("dns-1", &pb.DNSSecurityResult{})
+		// The client cancels while the DNS scan runs, so ScanTLS never does
+		dnsPlugin.On("Scan", mock.Anything, scanRequestFor("example.com", "")).Run(func(mock.Arguments) { cancel() }).Return(dnsRes, nil).Once()
+		stubDb := testutils.NewStubDB()
+		stubDb.Expect(createRunQuery).WillReturnResult(1)
+		finish := stubDb.Expect(finishRunQuery).WillReturnResult(1)
+		s := NewReportService(stubDb, nil, map[string]interfaces.GenericPlugin{"ScanDNS": dnsPlugin, "ScanTLS": tlsPlugin})
+
+		_, err := s.GenerateReport(ctx, &pb.GenerateReportRequest{Domain: "example.com"})
+		assert.Equal(t, codes.Canceled, status.Code(err))
+		assert.NoError(t, stubDb.ExpectationsWereMet())
+		assert.Equal(t, "failed", finish.Args()[1])
+		assert.Equal(t, "scan cancelled", finish.Args()[2])
+		tlsPlugin.AssertNotCalled(t, "Scan", mock.Anything, mock.Anything)
+	})
+}
+
+type fakeReportStream struct {
+	grpc.ServerStream
+	ctx    context.Context
+	events []*pb.ReportProgressEvent
+}
+
+func (f *fakeReportStream) Context() context.Context { return f.ctx }
+
+func (f *fakeReportStream) Send(ev *pb.ReportProgressEvent) error {
+	f.events = append(f.events, ev)
+	return nil
+}
+
+func TestGenerateReportStream(t *testing.T) {
+	ctx := context.WithValue(context.Background(), "user_id", "user-1")
+
+	t.Run("Unauthenticated", func(t *testing.T) {
+		s := NewReportService(nil, nil, nil)
+		err := s.GenerateReportStream(&pb.GenerateReportRequest{Domain: "example.com"}, &fakeReportStream{ctx: context.Background()})
+		st, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.Unauthenticated, st.Code())
+	})
+
+	t.Run("StreamsPluginEventsThenReport", func(t *testing.T) {
+		dnsPlugin := &MockDNSScanPlugin{}
+		tlsPlugin := &MockTLSScanPlugin{}
+		dnsRes := interfaces.NewScanResult("ScanDNS").Succeed("dns-1", &pb.DNSSecurityResult{SpfValid: true, DmarcValid: true})
+		tlsRes := interfaces.NewScanResult("ScanTLS").Succeed("tls-1", &pb.TLSSecurityResult{TlsVersion: "TLS 1.0"})
+		dnsPlugin.On("Scan", mock.Anything, scanRequestFor("example.com", "")).Return(dnsRes, nil).Once()
+		tlsPlugin.On("Scan", mock.Anything, scanRequestFor("example.com", "dns-1")).Return(tlsRes, nil).Once()
+
+		stubDb := testutils.NewStubDB()
+		stubDb.Expect(createRunQuery).WillReturnResult(1)
+		stubDb.Expect(finishRunQuery).WillReturnResult(1)
+		insert := stubDb.Expect(insertReportQuery).WillReturnResult(1)
+		s := NewReportService(stubDb, nil, map[string]interfaces.GenericPlugin{"ScanDNS": dnsPlugin, "ScanTLS": tlsPlugin})
+		stream := &fakeReportStream{ctx: ctx}
+
+		err := s.GenerateReportStream(&pb.GenerateReportRequest{Domain: "example.com"}, stream)
+		if !assert.NoError(t, err) {
+			return
+		}
+		// queued x2, running and succeeded for each plugin, then the report
+		if !assert.Len(t, stream.events, 7) {
+			return
+		}
+		dnsDone := stream.events[3]
+		assert.Equal(t, "ScanDNS", dnsDone.Plugin)
+		assert.Equal(t, "succeeded", dnsDone.Status)
+		assert.NotNil(t, dnsDone.DnsResult)
+
+		tlsDone := stream.events[5]
+		assert.Equal(t, "tls-1", tlsDone.ResultId)
+		assert.NotNil(t, tlsDone.TlsResult)
+		assert.Greater(t, tlsDone.Score, dnsDone.Score)
+
+		final := stream.events[6]
+		assert.True(t, final.Done)
+		assert.NotEmpty(t, final.ReportId)
+		assert.Equal(t, "dns-1", final.DnsScanId)
+		assert.Equal(t, tlsDone.Score, final.Score)
+		assert.NotEmpty(t, final.ScanRunId)
+		assert.Equal(t, final.ReportId, insert.Args()[0])
+	})
+
+	t.Run("CancelledDoesNotStoreReport", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		dnsPlugin := &MockDNSScanPlugin{}
+		tlsPlugin := &MockTLSScanPlugin{}
+		dnsRes := interfaces.NewScanResult("ScanDNS").Succeed("dns-1", &pb.DNSSecurityResult{})
+		dnsPlugin.On("Scan", mock.Anything, scanRequestFor("example.com", "")).Run(func(mock.Arguments) { cancel() }).Return(dnsRes, nil).Once()
+		stubDb := testutils.NewStubDB()
+		stubDb.Expect(createRunQuery).WillReturnResult(1)
+		finish := stubDb.Expect(finishRunQuery).WillReturnResult(1)
+		s := NewReportService(stubDb, nil, map[string]interfaces.GenericPlugin{"ScanDNS": dnsPlugin, "ScanTLS": tlsPlugin})
+		stream := &fakeReportStream{ctx: ctx}
+
+		err := s.GenerateReportStream(&pb.GenerateReportRequest{Domain: "example.com"}, stream)
+		assert.Equal(t, codes.Canceled, status.Code(err))
+		assert.NoError(t, stubDb.ExpectationsWereMet())
+		assert.Equal(t, "scan cancelled", finish.Args()[2])
+		for _, ev := range stream.events {
+			assert.False(t, ev.Done)
+		}
+	})
+}
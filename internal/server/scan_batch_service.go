@@ -0,0 +1,284 @@
+// internal/server/scan_batch_service.go
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"net"
+	"sort"
+	"strings"
+
+	"github.com/moos3/sparta/internal/jobs"
+	"github.com/moos3/sparta/internal/orchestrator"
+	pb "github.com/moos3/sparta/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const defaultMaxBulkDomains = 10000
+
+// BulkScan queues a scan job for every domain in the request, grouped into a
+// batch whose progress GetScanBatch reports
+func (s *Server) BulkScan(ctx context.Context, req *pb.BulkScanRequest) (*pb.BulkScanResponse, error) {
+	userID, ok := ctx.Value("user_id").(string)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing user ID")
+	}
+	plugins, _ := s.loadedPlugins()
+	if _, ok := plugins[orchestrator.DNSPlugin]; !ok {
+		return nil, status.Error(codes.Unavailable, "DNS plugin not loaded")
+	}
+	profile, err := scanProfile(s.profiles, userID, req.GetProfile())
+	if err != nil {
+		return nil, err
+	}
+
+	list := newBulkDomainList(req.GetTags())
+	for _, d := range req.GetDomains() {
+		list.add(d, nil)
+	}
+	if len(req.GetCsv()) > 0 {
+		if err := list.addCSV(req.GetCsv()); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid CSV: %v", err)
+		}
+	}
+	if len(list.domains) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "no valid domains to scan")
+	}
+	maxDomains := defaultMaxBulkDomains
+	if cfg := s.currentConfig(); cfg != nil && cfg.Scan.Bulk.MaxDomains > 0 {
+		maxDomains = cfg.Scan.Bulk.MaxDomains
+	}
+	if len(list.domains) > maxDomains {
+		return nil, status.Errorf(codes.InvalidArgument, "%d domains exceeds the limit of %d per batch", len(list.domains), maxDomains)
+	}
+
+	batch, err := s.jobs.SubmitBatch(userID, profile.Name, list.domains, s.profilePlugins(profile))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to submit bulk scan: %v", err)
+	}
+	return &pb.BulkScanResponse{
+		Batch:      scanBatchToProto(batch),
+		Rejected:   list.rejected,
+		Duplicates: int32(list.duplicates),
+	}, nil
+}
+
+// GetScanBatch returns the progress of a batch and a summary of its results
+func (s *Server) GetScanBatch(ctx context.Context, req *pb.GetScanBatchRequest) (*pb.GetScanBatchResponse, error) {
+	userID, ok := ctx.Value("user_id").(string)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing user ID")
+	}
+	if req.GetBatchId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "batch ID is required")
+	}
+
+	batch, err := s.jobs.GetBatch(userID, req.GetBatchId())
+	if err != nil {
+		return nil, scanJobError(err)
+	}
+	return &pb.GetScanBatchResponse{Batch: scanBatchToProto(batch)}, nil
+}
+
+// bulkDomainList normalizes and dedupes the domains of a bulk scan, merging
+// the tags of duplicates and recording inputs that are not domains
+type bulkDomainList struct {
+	commonTags []string
+	domains    []jobs.BatchDomain
+	index      map[string]int
+	rejected   []*pb.BulkScanRejected
+	duplicates int
+}
+
+func newBulkDomainList(tags []string) *bulkDomainList {
+	return &bulkDomainList{commonTags: tags, index: make(map[string]int)}
+}
+
+func (l *bulkDomainList) add(input string, tags []string) {
+	domain := bulkDomainName(input)
+	if domain == "" {
+		return
+	}
+	if reason := checkDomainName(domain); reason != "" {
+		l.rejected = append(l.rejected, &pb.BulkScanRejected{Input: input, Reason: reason})
+		return
+	}
+	i, seen := l.index[domain]
+	if seen {
+		l.duplicates++
+	} else {
+		i = len(l.domains)
+		l.index[domain] = i
+		l.domains = append(l.domains, jobs.BatchDomain{Domain: domain})
+		tags = append(tags, l.commonTags...)
+	}
+	l.domains[i].Tags = mergeTags(l.domains[i].Tags, tags)
+}
+
+// addCSV adds the rows of a CSV upload. A header row naming a "domain"
+// column is optional; without one the first column is the domain and the
+// rest are tags.
+func (l *bulkDomainList) addCSV(data []byte) error {
+	r := csv.NewReader(bytes.NewReader(data))
+	r.FieldsPerRecord = -1
+	r.TrimLeadingSpace = true
+	r.Comment = '#'
+
+	domainCol, tagCols := 0, []int(nil)
+	first := true
+	for {
+		record, err := r.Read()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if first {
+			first = false
+			if header, ok := csvHeader(record); ok {
+				domainCol, tagCols = header.domain, header.tags
+				continue
+			}
+		}
+		if domainCol >= len(record) {
+			continue
+		}
+		var tags []string
+		for i, cell := range record {
+			if i == domainCol || (tagCols != nil && !containsInt(tagCols, i)) {
+				continue
+			}
+			tags = append(tags, strings.Split(cell, ";")...)
+		}
+		l.add(record[domainCol], tags)
+	}
+}
+
+type csvColumns struct {
+	domain int
+	tags   []int
+}
+
+// csvHeader reports whether record is a header row, and if so which columns
+// hold the domain and the tags
+func csvHeader(record []string) (csvColumns, bool) {
+	cols := csvColumns{domain: -1, tags: []int{}}
+	for i, name := range record {
+		switch strings.ToLower(strings.TrimSpace(name)) {
+		case "domain":
+			cols.domain = i
+		case "tags", "tag":
+			cols.tags = append(cols.tags, i)
+		}
+	}
+	return cols, cols.domain >= 0
+}
+
+// bulkDomainName normalizes a pasted list entry, which may be a URL rather
+// than a bare domain
+func bulkDomainName(input string) string {
+	domain := strings.TrimSpace(input)
+	if i := strings.Index(domain, "://"); i >= 0 {
+		domain = domain[i+3:]
+	}
+	if i := strings.IndexAny(domain, "/?#"); i >= 0 {
+		domain = domain[:i]
+	}
+	if host, _, err := net.SplitHostPort(domain); err == nil {
+		domain = host
+	}
+	return normalizeDomain(domain)
+}
+
+// checkDomainName returns why domain is not a valid host name, or ""
+func checkDomainName(domain string) string {
+	if len(domain) > 253 {
+		return "domain is longer than 253 characters"
+	}
+	labels := strings.Split(domain, ".")
+	if len(labels) < 2 {
+		return "domain must have at least two labels"
+	}
+	for _, label := range labels {
+		if label == "" || len(label) > 63 {
+			return fmt.Sprintf("invalid label %q", label)
+		}
+		if label[0] == '-' || label[len(label)-1] == '-' {
+			return fmt.Sprintf("label %q starts or ends with a hyphen", label)
+		}
+		for _, c := range label {
+			if !(c >= 'a' && c <= 'z' || c >= '0' && c <= '9' || c == '-' || c == '_') {
+				return fmt.Sprintf("label %q contains %q", label, c)
+			}
+		}
+	}
+	return ""
+}
+
+// mergeTags adds tags to existing, trimming them and dropping blanks and
+// duplicates, and returns the result sorted
+func mergeTags(existing, tags []string) []string {
+	for _, tag := range tags {
+		tag = strings.TrimSpace(tag)
+		if tag != "" && !containsString(existing, tag) {
+			existing = append(existing, tag)
+		}
+	}
+	sort.Strings(existing)
+	return existing
+}
+
+func containsString(list []string, v string) bool {
+	for _, s := range list {
+		if s == v {
+			return true
+		}
+	}
+	return false
+}
+
+func containsInt(list []int, v int) bool {
+	for _, n := range list {
+		if n == v {
+			return true
+		}
+	}
+	return false
+}
+
+func scanBatchToProto(b *jobs.Batch) *pb.ScanBatch {
+	out := &pb.ScanBatch{
+		BatchId:   b.ID,
+		Total:     int32(b.Total),
+		Queued:    int32(b.Counts[jobs.StatusQueued]),
+		Running:   int32(b.Counts[jobs.StatusRunning]),
+		Succeeded: int32(b.Counts[jobs.StatusSucceeded]),
+		Failed:    int32(b.Counts[jobs.StatusFailed]),
+		Cancelled: int32(b.Counts[jobs.StatusCancelled]),
+		Done:      b.Done(),
+		CreatedAt: timestampOrNil(b.CreatedAt),
+		Summary: &pb.ScanBatchSummary{
+			Reports:      int32(b.Summary.Reports),
+			AverageScore: b.Summary.AverageScore,
+			MinScore:     int32(b.Summary.MinScore),
+			MaxScore:     int32(b.Summary.MaxScore),
+		},
+	}
+	tiers := make([]string, 0, len(b.Summary.RiskTiers))
+	for tier := range b.Summary.RiskTiers {
+		tiers = append(tiers, tier)
+	}
+	sort.Strings(tiers)
+	for _, tier := range tiers {
+		out.Summary.RiskTiers = append(out.Summary.RiskTiers, &pb.RiskTierCount{RiskTier: tier, Count: int32(b.Summary.RiskTiers[tier])})
+	}
+	for _, job := range b.Summary.Failed {
+		out.Summary.FailedDomains = append(out.Summary.FailedDomains, &pb.ScanBatchFailure{Domain: job.Domain, JobId: job.ID, Error: job.Error})
+	}
+	return out
+}
@@ -0,0 +1,46 @@
+// internal/server/scan_batch_service_test.go
+package server
+
+import (
+	"testing"
+
+	"github.com/moos3/sparta/internal/jobs"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBulkDomainList(t *testing.T) {
+	list := newBulkDomainList([]string{"q3"})
+	for _, d := range []string{"Example.com", "https://www.example.com/login", "not a domain", "localhost", ""} {
+		list.add(d, nil)
+	}
+	csv := "domain,owner,tags\n" +
+		"example.com,alice,prod;web\n" +
+		"api.example.com,bob,\n" +
+		"# comment\n" +
+		"EXAMPLE.COM.,carol,edge\n"
+	if !assert.NoError(t, list.addCSV([]byte(csv))) {
+		return
+	}
+
+	assert.Equal(t, []jobs.BatchDomain{
+		{Domain: "example.com", Tags: []string{"edge", "prod", "q3", "web"}},
+		{Domain: "www.example.com", Tags: []string{"q3"}},
+		{Domain: "api.example.com", Tags: []string{"q3"}},
+	}, list.domains)
+	assert.Equal(t, 2, list.duplicates)
+	if assert.Len(t, list.rejected, 2) {
+		assert.Equal(t, "not a domain", list.rejected[0].Input)
+		assert.Equal(t, "localhost", list.rejected[1].Input)
+	}
+}
+
+func TestBulkDomainListCSVWithoutHeader(t *testing.T) {
+	list := newBulkDomainList(nil)
+	if !assert.NoError(t, list.addCSV([]byte("example.org, prod, eu\nexample.net\n"))) {
+		return
+	}
+	assert.Equal(t, []jobs.BatchDomain{
+		{Domain: "example.org", Tags: []string{"eu", "prod"}},
+		{Domain: "example.net"},
+	}, list.domains)
+}
@@ -0,0 +1,150 @@
+// internal/server/scan_job_service.go
+package server
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/moos3/sparta/internal/jobs"
+	"github.com/moos3/sparta/internal/orchestrator"
+	pb "github.com/moos3/sparta/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const defaultScanJobLimit = 50
+
+// SubmitScanJob queues a scan of a domain and returns without waiting for it
+func (s *Server) SubmitScanJob(ctx context.Context, req *pb.SubmitScanJobRequest) (*pb.SubmitScanJobResponse, error) {
+	userID, ok := ctx.Value("user_id").(string)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing user ID")
+	}
+	domain := normalizeDomain(req.GetDomain())
+	if domain == "" {
+		return nil, status.Error(codes.InvalidArgument, "domain is required")
+	}
+	plugins, _ := s.loadedPlugins()
+	if _, ok := plugins[orchestrator.DNSPlugin]; !ok {
+		return nil, status.Error(codes.Unavailable, "DNS plugin not loaded")
+	}
+	profile, err := scanProfile(s.profiles, userID, req.GetProfile())
+	if err != nil {
+		return nil, err
+	}
+
+	job, err := s.jobs.Submit(userID, domain, profile.Name, req.GetRefresh(), s.profilePlugins(profile))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to submit scan job: %v", err)
+	}
+	return &pb.SubmitScanJobResponse{Job: scanJobToProto(job)}, nil
+}
+
+// GetScanJob returns a job along with the state of each of its plugins
+func (s *Server) GetScanJob(ctx context.Context, req *pb.GetScanJobRequest) (*pb.GetScanJobResponse, error) {
+	userID, ok := ctx.Value("user_id").(string)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing user ID")
+	}
+	if req.GetJobId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "job ID is required")
+	}
+
+	job, err := s.jobs.Get(userID, req.GetJobId())
+	if err != nil {
+		return nil, scanJobError(err)
+	}
+	return &pb.GetScanJobResponse{Job: scanJobToProto(job)}, nil
+}
+
+// ListScanJobs returns the caller's most recent jobs, newest first
+func (s *Server) ListScanJobs(ctx context.Context, req *pb.ListScanJobsRequest) (*pb.ListScanJobsResponse, error) {
+	userID, ok := ctx.Value("user_id").(string)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing user ID")
+	}
+	limit := int(req.GetLimit())
+	if limit <= 0 || limit > defaultScanJobLimit {
+		limit = defaultScanJobLimit
+	}
+
+	list, err := s.jobs.List(userID, limit)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list scan jobs: %v", err)
+	}
+	resp := &pb.ListScanJobsResponse{}
+	for _, job := range list {
+		resp.Jobs = append(resp.Jobs, scanJobToProto(job))
+	}
+	return resp, nil
+}
+
+// CancelScanJob cancels a queued job, or asks the worker running it to stop
+func (s *Server) CancelScanJob(ctx context.Context, req *pb.CancelScanJobRequest) (*pb.CancelScanJobResponse, error) {
+	userID, ok := ctx.Value("user_id").(string)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing user ID")
+	}
+	if req.GetJobId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "job ID is required")
+	}
+
+	job, err := s.jobs.RequestCancel(userID, req.GetJobId())
+	if err != nil {
+		return nil, scanJobError(err)
+	}
+	return &pb.CancelScanJobResponse{Job: scanJobToProto(job)}, nil
+}
+
+func scanJobError(err error) error {
+	switch {
+	case errors.Is(err, jobs.ErrNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, jobs.ErrFinished):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	default:
+		return status.Errorf(codes.Internal, "%v", err)
+	}
+}
+
+func scanJobToProto(job *jobs.Job) *pb.ScanJob {
+	out := &pb.ScanJob{
+		JobId:           job.ID,
+		Domain:          job.Domain,
+		Status:          string(job.Status),
+		ReportId:        job.ReportID,
+		Error:           job.Error,
+		CancelRequested: job.CancelRequested,
+		CreatedAt:       timestampOrNil(job.CreatedAt),
+		StartedAt:       timestampOrNil(job.StartedAt),
+		FinishedAt:      timestampOrNil(job.FinishedAt),
+		ParentJobId:     job.ParentID,
+		ScanRunId:       job.ScanRunID,
+		BatchId:         job.BatchID,
+		Tags:            job.Tags,
+		Profile:         job.Profile,
+		Refresh:         job.Refresh,
+	}
+	for _, p := range job.Plugins {
+		out.Plugins = append(out.Plugins, &pb.ScanJobPlugin{
+			Plugin:     p.Plugin,
+			Status:     string(p.State),
+			ResultId:   p.ResultID,
+			Error:      p.Error,
+			TimedOut:   p.TimedOut,
+			StartedAt:  timestampOrNil(p.StartedAt),
+			FinishedAt: timestampOrNil(p.FinishedAt),
+		})
+	}
+	return out
+}
+
+// timestampOrNil converts t, leaving unset times out of the response
+func timestampOrNil(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t)
+}
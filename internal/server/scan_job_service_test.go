@@ -0,0 +1,105 @@
+// internal/server/scan_job_service_test.go
+package server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/moos3/sparta/internal/interfaces"
+	"github.com/moos3/sparta/internal/jobs"
+	"github.com/moos3/sparta/internal/profiles"
+	"github.com/moos3/sparta/internal/testutils"
+	pb "github.com/moos3/sparta/proto"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestSubmitScanJob(t *testing.T) {
+	ctx := context.WithValue(context.Background(), "user_id", "user-1")
+
+	t.Run("Unauthenticated", func(t *testing.T) {
+		s := &Server{}
+		_, err := s.SubmitScanJob(context.Background(), &pb.SubmitScanJobRequest{Domain: "example.com"})
+		st, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.Unauthenticated, st.Code())
+	})
+
+	t.Run("DNSPluginNotLoaded", func(t *testing.T) {
+		s := &Server{plugins: map[string]interfaces.GenericPlugin{}}
+		_, err := s.SubmitScanJob(ctx, &pb.SubmitScanJobRequest{Domain: "example.com"})
+		st, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.Unavailable, st.Code())
+	})
+
+	t.Run("Success", func(t *testing.T) {
+		stubDb := testutils.NewStubDB()
+		insert := stubDb.Expect("INSERT INTO scan_jobs").WillReturnResult(1)
+		stubDb.Expect("INSERT INTO scan_job_plugins").WillReturnResult(1)
+		stubDb.Expect("INSERT INTO scan_job_plugins").WillReturnResult(1)
+		s := &Server{
+			jobs:     jobs.NewStore(stubDb),
+			profiles: profiles.NewStore(stubDb, nil),
+			plugins:  map[string]interfaces.GenericPlugin{"ScanDNS": &MockDNSScanPlugin{}, "ScanTLS": &MockTLSScanPlugin{}},
+		}
+
+		resp, err := s.SubmitScanJob(ctx, &pb.SubmitScanJobRequest{Domain: "Example.com."})
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.NotEmpty(t, resp.Job.JobId)
+		assert.Equal(t, "queued", resp.Job.Status)
+		assert.Equal(t, "full", resp.Job.Profile)
+		assert.Equal(t, "example.com", insert.Args()[2])
+		if assert.Len(t, resp.Job.Plugins, 2) {
+			assert.Equal(t, "ScanDNS", resp.Job.Plugins[0].Plugin)
+		}
+		assert.NoError(t, stubDb.ExpectationsWereMet())
+	})
+}
+
+func TestSubmitScanJobWithProfile(t *testing.T) {
+	ctx := context.WithValue(context.Background(), "user_id", "user-1")
+	stubDb := testutils.NewStubDB()
+	insert := stubDb.Expect("INSERT INTO scan_jobs").WillReturnResult(1)
+	stubDb.Expect("INSERT INTO scan_job_plugins").WillReturnResult(1)
+	stubDb.Expect("INSERT INTO scan_job_plugins").WillReturnResult(1)
+	s := &Server{
+		jobs:     jobs.NewStore(stubDb),
+		profiles: profiles.NewStore(stubDb, nil),
+		plugins: map[string]interfaces.GenericPlugin{
+			"ScanDNS":    &MockDNSScanPlugin{},
+			"ScanTLS":    &MockTLSScanPlugin{},
+			"ScanShodan": &MockTLSScanPlugin{}, // only the name matters when submitting
+		},
+	}
+
+	resp, err := s.SubmitScanJob(ctx, &pb.SubmitScanJobRequest{Domain: "example.com", Profile: "quick"})
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, "quick", insert.Args()[6])
+	var plugins []string
+	for _, p := range resp.Job.Plugins {
+		plugins = append(plugins, p.Plugin)
+	}
+	assert.Equal(t, []string{"ScanDNS", "ScanTLS"}, plugins)
+	assert.NoError(t, stubDb.ExpectationsWereMet())
+}
+
+func TestGetScanJob(t *testing.T) {
+	ctx := context.WithValue(context.Background(), "user_id", "user-1")
+
+	t.Run("NotFound", func(t *testing.T) {
+		stubDb := testutils.NewStubDB()
+		stubDb.Expect("FROM scan_jobs WHERE id = $1 AND user_id = $2").WillReturnRows([]string{"id"})
+		s := &Server{jobs: jobs.NewStore(stubDb)}
+
+		_, err := s.GetScanJob(ctx, &pb.GetScanJobRequest{JobId: "job-1"})
+		st, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.NotFound, st.Code())
+	})
+}
@@ -0,0 +1,152 @@
+// internal/server/scan_profile_service.go
+package server
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"time"
+
+	"github.com/moos3/sparta/internal/orchestrator"
+	"github.com/moos3/sparta/internal/profiles"
+	pb "github.com/moos3/sparta/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// SaveScanProfile creates or replaces one of the caller's scan profiles
+func (s *Server) SaveScanProfile(ctx context.Context, req *pb.SaveScanProfileRequest) (*pb.SaveScanProfileResponse, error) {
+	userID, ok := ctx.Value("user_id").(string)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing user ID")
+	}
+	if req.GetProfile() == nil {
+		return nil, status.Error(codes.InvalidArgument, "profile is required")
+	}
+
+	profile := scanProfileFromProto(req.GetProfile())
+	profile.OwnerID = userID
+	saved, err := s.profiles.Save(profile)
+	if err != nil {
+		return nil, scanProfileError(err)
+	}
+	return &pb.SaveScanProfileResponse{Profile: scanProfileToProto(saved)}, nil
+}
+
+// ListScanProfiles returns the built-in and config profiles along with the
+// caller's own
+func (s *Server) ListScanProfiles(ctx context.Context, req *pb.ListScanProfilesRequest) (*pb.ListScanProfilesResponse, error) {
+	userID, ok := ctx.Value("user_id").(string)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing user ID")
+	}
+
+	list, err := s.profiles.List(userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list scan profiles: %v", err)
+	}
+	resp := &pb.ListScanProfilesResponse{}
+	for _, p := range list {
+		resp.Profiles = append(resp.Profiles, scanProfileToProto(p))
+	}
+	return resp, nil
+}
+
+// DeleteScanProfile removes one of the caller's scan profiles
+func (s *Server) DeleteScanProfile(ctx context.Context, req *pb.DeleteScanProfileRequest) (*pb.DeleteScanProfileResponse, error) {
+	userID, ok := ctx.Value("user_id").(string)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing user ID")
+	}
+	if req.GetName() == "" {
+		return nil, status.Error(codes.InvalidArgument, "profile name is required")
+	}
+
+	if err := s.profiles.Delete(userID, req.GetName()); err != nil {
+		return nil, scanProfileError(err)
+	}
+	return &pb.DeleteScanProfileResponse{}, nil
+}
+
+// scanProfile resolves the profile userID asked to scan with, defaulting to
+// a full scan
+func scanProfile(store *profiles.Store, userID, name string) (*profiles.Profile, error) {
+	profile, err := store.Get(userID, name)
+	if err != nil {
+		return nil, scanProfileError(err)
+	}
+	return profile, nil
+}
+
+// profilePlugins returns the loaded plugins a scan under profile runs
+func (s *Server) profilePlugins(profile *profiles.Profile) []string {
+	plugins, _ := s.loadedPlugins()
+	return profile.Apply(orchestrator.New(plugins, s.currentConfig())).Plugins()
+}
+
+func scanProfileError(err error) error {
+	switch {
+	case errors.Is(err, profiles.ErrNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, profiles.ErrInvalid):
+		return status.Error(codes.InvalidArgument, err.Error())
+	default:
+		return status.Errorf(codes.Internal, "%v", err)
+	}
+}
+
+func scanProfileFromProto(p *pb.ScanProfile) *profiles.Profile {
+	out := &profiles.Profile{
+		Name:        p.GetName(),
+		Description: p.GetDescription(),
+		Plugins:     p.GetPlugins(),
+		PassiveOnly: p.GetPassiveOnly(),
+	}
+	for _, opt := range p.GetOptions() {
+		if out.Options == nil {
+			out.Options = make(map[string]map[string]string)
+		}
+		if out.Options[opt.GetPlugin()] == nil {
+			out.Options[opt.GetPlugin()] = make(map[string]string)
+		}
+		out.Options[opt.GetPlugin()][opt.GetKey()] = opt.GetValue()
+	}
+	for _, t := range p.GetTimeouts() {
+		if out.Timeouts == nil {
+			out.Timeouts = make(map[string]time.Duration)
+		}
+		out.Timeouts[t.GetPlugin()] = time.Duration(t.GetSeconds()) * time.Second
+	}
+	return out
+}
+
+func scanProfileToProto(p *profiles.Profile) *pb.ScanProfile {
+	out := &pb.ScanProfile{
+		Name:        p.Name,
+		Description: p.Description,
+		Plugins:     p.Plugins,
+		PassiveOnly: p.PassiveOnly,
+		Source:      p.Source,
+		CreatedAt:   timestampOrNil(p.CreatedAt),
+		UpdatedAt:   timestampOrNil(p.UpdatedAt),
+	}
+	for _, plugin := range sortedKeys(p.Options) {
+		opts := p.Options[plugin]
+		for _, key := range sortedKeys(opts) {
+			out.Options = append(out.Options, &pb.ScanProfileOption{Plugin: plugin, Key: key, Value: opts[key]})
+		}
+	}
+	for _, plugin := range sortedKeys(p.Timeouts) {
+		out.Timeouts = append(out.Timeouts, &pb.ScanProfileTimeout{Plugin: plugin, Seconds: int32(p.Timeouts[plugin] / time.Second)})
+	}
+	return out
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,64 @@
+// internal/server/scan_profile_service_test.go
+package server
+
+import (
+	"context"
+	"database/sql/driver"
+	"testing"
+	"time"
+
+	"github.com/moos3/sparta/internal/profiles"
+	"github.com/moos3/sparta/internal/testutils"
+	pb "github.com/moos3/sparta/proto"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var profileCols = []string{"owner_id", "name", "description", "plugins", "passive_only", "options", "timeouts", "created_at", "updated_at"}
+
+func TestSaveScanProfile(t *testing.T) {
+	ctx := context.WithValue(context.Background(), "user_id", "user-1")
+
+	t.Run("BuiltInName", func(t *testing.T) {
+		s := &Server{profiles: profiles.NewStore(testutils.NewStubDB(), nil)}
+		_, err := s.SaveScanProfile(ctx, &pb.SaveScanProfileRequest{Profile: &pb.ScanProfile{Name: "passive"}})
+		st, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.InvalidArgument, st.Code())
+	})
+
+	t.Run("Success", func(t *testing.T) {
+		stubDb := testutils.NewStubDB()
+		save := stubDb.Expect("INSERT INTO scan_profiles").WillReturnRows([]string{"created_at"}, []driver.Value{time.Now()})
+		s := &Server{profiles: profiles.NewStore(stubDb, nil)}
+
+		resp, err := s.SaveScanProfile(ctx, &pb.SaveScanProfileRequest{Profile: &pb.ScanProfile{Name: "edge", PassiveOnly: true}})
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.Equal(t, "user", resp.Profile.Source)
+		assert.Equal(t, "user-1", save.Args()[0])
+		assert.NoError(t, stubDb.ExpectationsWereMet())
+	})
+}
+
+func TestListScanProfiles(t *testing.T) {
+	ctx := context.WithValue(context.Background(), "user_id", "user-1")
+	stubDb := testutils.NewStubDB()
+	stubDb.Expect("FROM scan_profiles WHERE owner_id = $1 ORDER BY name").WillReturnRows(profileCols)
+	s := &Server{profiles: profiles.NewStore(stubDb, nil)}
+
+	resp, err := s.ListScanProfiles(ctx, &pb.ListScanProfilesRequest{})
+	if !assert.NoError(t, err) {
+		return
+	}
+	var names []string
+	for _, p := range resp.Profiles {
+		names = append(names, p.Name)
+	}
+	assert.Equal(t, []string{"full", "passive", "quick"}, names)
+	if assert.Len(t, resp.Profiles[2].Timeouts, 3) {
+		assert.Equal(t, &pb.ScanProfileTimeout{Plugin: "ScanDNS", Seconds: 10}, resp.Profiles[2].Timeouts[0])
+	}
+}
@@ -0,0 +1,91 @@
+// internal/server/scan_run_service.go
+package server
+
+import (
+	"context"
+	"errors"
+
+	"github.com/moos3/sparta/internal/runs"
+	pb "github.com/moos3/sparta/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetScanRun returns a scan run along with every plugin result stored under
+// it. Runs are visible to the user who started them and to admins.
+func (s *Server) GetScanRun(ctx context.Context, req *pb.GetScanRunRequest) (*pb.GetScanRunResponse, error) {
+	userID, ok := ctx.Value("user_id").(string)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing user ID")
+	}
+	if req.GetScanRunId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "scan run ID is required")
+	}
+
+	run, err := s.runs.Get(req.GetScanRunId())
+	if errors.Is(err, runs.ErrNotFound) {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "%v", err)
+	}
+	if run.RequestedBy != userID && !s.isAdmin(ctx) {
+		return nil, status.Error(codes.PermissionDenied, "cannot view another user's scan run")
+	}
+
+	results, err := s.runs.Results(run.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "%v", err)
+	}
+	out := scanRunToProto(run)
+	for _, r := range results {
+		out.Results = append(out.Results, scanRunResultToProto(r))
+	}
+	return &pb.GetScanRunResponse{Run: out}, nil
+}
+
+func scanRunToProto(run *runs.ScanRun) *pb.ScanRun {
+	return &pb.ScanRun{
+		Id:          run.ID,
+		Domain:      run.Domain,
+		RequestedBy: run.RequestedBy,
+		Profile:     run.Profile,
+		Status:      string(run.Status),
+		Error:       run.Error,
+		StartedAt:   timestampOrNil(run.StartedAt),
+		FinishedAt:  timestampOrNil(run.FinishedAt),
+	}
+}
+
+// scanRunResultToProto converts a stored result, setting the field that
+// matches its message type
+func scanRunResultToProto(r runs.Result) *pb.ScanRunResult {
+	out := &pb.ScanRunResult{
+		Plugin:    r.Plugin,
+		ResultId:  r.ID,
+		CreatedAt: timestampOrNil(r.CreatedAt),
+	}
+	switch v := r.Result.(type) {
+	case *pb.DNSSecurityResult:
+		out.DnsResult = v
+	case *pb.TLSSecurityResult:
+		out.TlsResult = v
+	case *pb.CrtShSecurityResult:
+		out.CrtshResult = v
+	case *pb.ChaosSecurityResult:
+		out.ChaosResult = v
+	case *pb.ShodanSecurityResult:
+		out.ShodanResult = v
+	case *pb.OTXSecurityResult:
+		out.OtxResult = v
+	case *pb.WhoisSecurityResult:
+		out.WhoisResult = v
+	case *pb.AbuseChSecurityResult:
+		out.AbusechResult = v
+	case *pb.ISCSecurityResult:
+		out.IscResult = v
+	case *pb.ExternalSecurityResult:
+		out.ExternalResult = v
+	}
+	return out
+}
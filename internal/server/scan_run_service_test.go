@@ -0,0 +1,71 @@
+// internal/server/scan_run_service_test.go
+package server
+
+import (
+	"context"
+	"database/sql/driver"
+	"testing"
+	"time"
+
+	"github.com/moos3/sparta/internal/runs"
+	"github.com/moos3/sparta/internal/testutils"
+	pb "github.com/moos3/sparta/proto"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var scanRunCols = []string{"id", "domain", "requested_by", "profile", "status", "error", "started_at", "finished_at"}
+
+func TestGetScanRun(t *testing.T) {
+	ctx := context.WithValue(context.Background(), "user_id", "user-1")
+	now := time.Now()
+
+	t.Run("NotFound", func(t *testing.T) {
+		stubDb := testutils.NewStubDB()
+		stubDb.Expect("FROM scan_runs").WillReturnRows(scanRunCols)
+		s := &Server{runs: runs.NewStore(stubDb)}
+
+		_, err := s.GetScanRun(ctx, &pb.GetScanRunRequest{ScanRunId: "run-1"})
+		st, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.NotFound, st.Code())
+	})
+
+	t.Run("OtherUsersRun", func(t *testing.T) {
+		stubDb := testutils.NewStubDB()
+		stubDb.Expect("FROM scan_runs").WillReturnRows(scanRunCols,
+			[]driver.Value{"run-1", "example.com", "user-2", "full", "succeeded", "", now, now})
+		s := &Server{runs: runs.NewStore(stubDb)}
+
+		_, err := s.GetScanRun(ctx, &pb.GetScanRunRequest{ScanRunId: "run-1"})
+		st, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.PermissionDenied, st.Code())
+	})
+
+	t.Run("Success", func(t *testing.T) {
+		stubDb := testutils.NewStubDB()
+		stubDb.Expect("FROM scan_runs").WillReturnRows(scanRunCols,
+			[]driver.Value{"run-1", "example.com", "user-1", "ScanTLS", "succeeded", "", now, now})
+		stubDb.Expect("UNION ALL").WillReturnRows([]string{"plugin", "id", "result", "created_at", "external"},
+			[]driver.Value{int64(1), "tls-1", []byte(`{"tls_version":"TLS 1.3"}`), now, ""},
+			[]driver.Value{int64(9), "ext-1", []byte(`{"findings":[]}`), now, "AcmeScanner"})
+		s := &Server{runs: runs.NewStore(stubDb)}
+
+		resp, err := s.GetScanRun(ctx, &pb.GetScanRunRequest{ScanRunId: "run-1"})
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.Equal(t, "ScanTLS", resp.Run.Profile)
+		assert.Equal(t, "succeeded", resp.Run.Status)
+		if assert.Len(t, resp.Run.Results, 2) {
+			assert.Equal(t, "ScanTLS", resp.Run.Results[0].Plugin)
+			assert.Equal(t, "tls-1", resp.Run.Results[0].ResultId)
+			assert.Equal(t, "TLS 1.3", resp.Run.Results[0].TlsResult.GetTlsVersion())
+			assert.Equal(t, "AcmeScanner", resp.Run.Results[1].Plugin)
+			assert.Equal(t, `{"findings":[]}`, resp.Run.Results[1].ExternalResult.GetResult())
+		}
+		assert.NoError(t, stubDb.ExpectationsWereMet())
+	})
+}
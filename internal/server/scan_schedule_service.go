@@ -0,0 +1,159 @@
+// internal/server/scan_schedule_service.go
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/moos3/sparta/internal/orchestrator"
+	"github.com/moos3/sparta/internal/schedules"
+	pb "github.com/moos3/sparta/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// CreateScanSchedule stores a schedule that scans a domain on a cron
+// expression or a fixed interval
+func (s *Server) CreateScanSchedule(ctx context.Context, req *pb.CreateScanScheduleRequest) (*pb.CreateScanScheduleResponse, error) {
+	userID, ok := ctx.Value("user_id").(string)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing user ID")
+	}
+	domain := normalizeDomain(req.GetDomain())
+	if domain == "" {
+		return nil, status.Error(codes.InvalidArgument, "domain is required")
+	}
+	profile, err := scanProfile(s.profiles, userID, req.GetProfile())
+	if err != nil {
+		return nil, err
+	}
+
+	sch, err := s.schedules.Create(userID, domain, req.GetCron(), time.Duration(req.GetIntervalSeconds())*time.Second, profile.Name)
+	if err != nil {
+		return nil, scanScheduleError(err)
+	}
+	return &pb.CreateScanScheduleResponse{Schedule: scanScheduleToProto(sch)}, nil
+}
+
+// UpdateScanSchedule replaces a schedule's timing and profile
+func (s *Server) UpdateScanSchedule(ctx context.Context, req *pb.UpdateScanScheduleRequest) (*pb.UpdateScanScheduleResponse, error) {
+	current, err := s.ownedSchedule(ctx, req.GetScheduleId())
+	if err != nil {
+		return nil, err
+	}
+	// The profile is resolved for the schedule's owner, whose jobs it queues
+	profile, err := scanProfile(s.profiles, current.OwnerID, req.GetProfile())
+	if err != nil {
+		return nil, err
+	}
+
+	sch, err := s.schedules.Update(req.GetScheduleId(), req.GetCron(), time.Duration(req.GetIntervalSeconds())*time.Second, profile.Name)
+	if err != nil {
+		return nil, scanScheduleError(err)
+	}
+	return &pb.UpdateScanScheduleResponse{Schedule: scanScheduleToProto(sch)}, nil
+}
+
+// PauseScanSchedule pauses or resumes a schedule
+func (s *Server) PauseScanSchedule(ctx context.Context, req *pb.PauseScanScheduleRequest) (*pb.PauseScanScheduleResponse, error) {
+	if _, err := s.ownedSchedule(ctx, req.GetScheduleId()); err != nil {
+		return nil, err
+	}
+	sch, err := s.schedules.SetPaused(req.GetScheduleId(), req.GetPaused())
+	if err != nil {
+		return nil, scanScheduleError(err)
+	}
+	return &pb.PauseScanScheduleResponse{Schedule: scanScheduleToProto(sch)}, nil
+}
+
+// ListScanSchedules returns the caller's schedules, or every schedule for admins
+func (s *Server) ListScanSchedules(ctx context.Context, req *pb.ListScanSchedulesRequest) (*pb.ListScanSchedulesResponse, error) {
+	userID, ok := ctx.Value("user_id").(string)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing user ID")
+	}
+	owner := userID
+	if s.isAdmin(ctx) {
+		owner = ""
+	}
+
+	list, err := s.schedules.List(owner)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list scan schedules: %v", err)
+	}
+	resp := &pb.ListScanSchedulesResponse{}
+	for _, sch := range list {
+		resp.Schedules = append(resp.Schedules, scanScheduleToProto(sch))
+	}
+	return resp, nil
+}
+
+// SubmitScheduledScan queues the scan job for a due schedule. It is the
+// schedules.SubmitFunc the scheduler calls.
+func (s *Server) SubmitScheduledScan(sch *schedules.Schedule) (string, error) {
+	plugins, _ := s.loadedPlugins()
+	if _, ok := plugins[orchestrator.DNSPlugin]; !ok {
+		return "", fmt.Errorf("DNS plugin not loaded")
+	}
+	profile, err := s.profiles.Get(sch.OwnerID, sch.Profile)
+	if err != nil {
+		return "", err
+	}
+	job, err := s.jobs.Submit(sch.OwnerID, sch.Domain, profile.Name, false, s.profilePlugins(profile))
+	if err != nil {
+		return "", err
+	}
+	return job.ID, nil
+}
+
+// ownedSchedule returns a schedule the caller may change: their own, or any
+// schedule for admins
+func (s *Server) ownedSchedule(ctx context.Context, id string) (*schedules.Schedule, error) {
+	userID, ok := ctx.Value("user_id").(string)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing user ID")
+	}
+	if id == "" {
+		return nil, status.Error(codes.InvalidArgument, "schedule ID is required")
+	}
+	sch, err := s.schedules.Get(id)
+	if err != nil {
+		return nil, scanScheduleError(err)
+	}
+	if sch.OwnerID != userID && !s.isAdmin(ctx) {
+		return nil, status.Error(codes.PermissionDenied, "cannot change another user's scan schedule")
+	}
+	return sch, nil
+}
+
+func scanScheduleError(err error) error {
+	switch {
+	case errors.Is(err, schedules.ErrNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, schedules.ErrInvalid):
+		return status.Error(codes.InvalidArgument, err.Error())
+	default:
+		return status.Errorf(codes.Internal, "%v", err)
+	}
+}
+
+func scanScheduleToProto(sch *schedules.Schedule) *pb.ScanSchedule {
+	return &pb.ScanSchedule{
+		ScheduleId:      sch.ID,
+		Domain:          sch.Domain,
+		Cron:            sch.Cron,
+		IntervalSeconds: int64(sch.Interval / time.Second),
+		Profile:         sch.Profile,
+		OwnerId:         sch.OwnerID,
+		Paused:          sch.Paused,
+		NextRunAt:       timestamppb.New(sch.NextRunAt),
+		LastRunAt:       timestampOrNil(sch.LastRunAt),
+		LastJobId:       sch.LastJobID,
+		LastError:       sch.LastError,
+		CreatedAt:       timestampOrNil(sch.CreatedAt),
+		UpdatedAt:       timestampOrNil(sch.UpdatedAt),
+	}
+}
@@ -0,0 +1,75 @@
+// internal/server/scan_schedule_service_test.go
+package server
+
+import (
+	"context"
+	"database/sql/driver"
+	"testing"
+	"time"
+
+	"github.com/moos3/sparta/internal/profiles"
+	"github.com/moos3/sparta/internal/schedules"
+	"github.com/moos3/sparta/internal/testutils"
+	pb "github.com/moos3/sparta/proto"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestCreateScanSchedule(t *testing.T) {
+	ctx := context.WithValue(context.Background(), "user_id", "user-1")
+
+	t.Run("InvalidCron", func(t *testing.T) {
+		stubDb := testutils.NewStubDB()
+		s := &Server{schedules: schedules.NewStore(stubDb), profiles: profiles.NewStore(stubDb, nil)}
+		_, err := s.CreateScanSchedule(ctx, &pb.CreateScanScheduleRequest{Domain: "example.com", Cron: "every day"})
+		st, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.InvalidArgument, st.Code())
+	})
+
+	t.Run("UnknownProfile", func(t *testing.T) {
+		stubDb := testutils.NewStubDB()
+		stubDb.Expect("FROM scan_profiles WHERE owner_id = $1 AND name = $2").WillReturnRows(profileCols)
+		s := &Server{profiles: profiles.NewStore(stubDb, nil)}
+		_, err := s.CreateScanSchedule(ctx, &pb.CreateScanScheduleRequest{Domain: "example.com", Cron: "@daily", Profile: "stealth"})
+		st, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.NotFound, st.Code())
+	})
+
+	t.Run("Success", func(t *testing.T) {
+		stubDb := testutils.NewStubDB()
+		insert := stubDb.Expect("INSERT INTO scan_schedules").WillReturnResult(1)
+		s := &Server{schedules: schedules.NewStore(stubDb), profiles: profiles.NewStore(stubDb, nil)}
+
+		resp, err := s.CreateScanSchedule(ctx, &pb.CreateScanScheduleRequest{Domain: "Example.com", IntervalSeconds: 3600})
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.Equal(t, "example.com", resp.Schedule.Domain)
+		assert.Equal(t, "full", resp.Schedule.Profile)
+		assert.WithinDuration(t, time.Now().Add(time.Hour), resp.Schedule.NextRunAt.AsTime(), time.Minute)
+		assert.Equal(t, "user-1", insert.Args()[1])
+		assert.NoError(t, stubDb.ExpectationsWereMet())
+	})
+}
+
+func TestPauseScanSchedule(t *testing.T) {
+	ctx := context.WithValue(context.Background(), "user_id", "user-1")
+	cols := []string{"id", "owner_id", "domain", "cron_expr", "interval_seconds", "profile", "paused",
+		"next_run_at", "last_run_at", "last_job_id", "last_error", "created_at", "updated_at"}
+	now := time.Now()
+
+	t.Run("OtherUsersSchedule", func(t *testing.T) {
+		stubDb := testutils.NewStubDB()
+		stubDb.Expect("FROM scan_schedules WHERE id = $1").WillReturnRows(cols,
+			[]driver.Value{"sch-1", "user-2", "example.com", "@daily", int64(0), "full", false, now, nil, "", "", now, now})
+		s := &Server{schedules: schedules.NewStore(stubDb)}
+
+		_, err := s.PauseScanSchedule(ctx, &pb.PauseScanScheduleRequest{ScheduleId: "sch-1", Paused: true})
+		st, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.PermissionDenied, st.Code())
+	})
+}
@@ -0,0 +1,721 @@
+package server
+
+import (
+	"context"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/moos3/sparta/internal/breaker"
+	"github.com/moos3/sparta/internal/intelcache"
+	"github.com/moos3/sparta/internal/interfaces"
+	"github.com/moos3/sparta/internal/registry"
+	"github.com/moos3/sparta/internal/runs"
+	pb "github.com/moos3/sparta/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// The ScanService RPCs look plugins up by name in the plugin map and use the
+// typed methods below, which the concrete plugins implement alongside
+// interfaces.GenericPlugin.
+
+type dnsScanner interface {
+	ScanDomain(ctx context.Context, domain string) (*pb.DNSSecurityResult, error)
+	InsertDNSScanResult(scanRunID, domain string, result *pb.DNSSecurityResult) (string, error)
+	GetDNSScanResultsByDomain(domain string) ([]interfaces.DNSScanResult, error)
+	GetDNSScanResultByID(dnsScanID string) (interfaces.DNSScanResult, error)
+}
+
+type tlsScanner interface {
+	ScanTLS(ctx context.Context, domain, dnsScanID string) (*pb.TLSSecurityResult, error)
+	InsertTLSScanResult(scanRunID, domain, dnsScanID string, result *pb.TLSSecurityResult) (string, error)
+	GetTLSScanResultsByDomain(domain string) ([]interfaces.TLSScanResult, error)
+}
+
+type crtShScanner interface {
+	ScanCrtSh(ctx context.Context, domain, dnsScanID string) (*pb.CrtShSecurityResult, error)
+	InsertCrtShScanResult(scanRunID, domain, dnsScanID string, result *pb.CrtShSecurityResult) (string, error)
+	GetCrtShScanResultsByDomain(domain string) ([]interfaces.CrtShScanResult, error)
+}
+
+type chaosScanner interface {
+	ScanChaos(ctx context.Context, domain, dnsScanID string) (*pb.ChaosSecurityResult, error)
+	InsertChaosScanResult(scanRunID, domain, dnsScanID string, result *pb.ChaosSecurityResult) (string, error)
+	GetChaosScanResultsByDomain(domain string) ([]interfaces.ChaosScanResult, error)
+}
+
+type shodanScanner interface {
+	ScanShodan(ctx context.Context, domain, dnsScanID string) (*pb.ShodanSecurityResult, error)
+	InsertShodanScanResult(scanRunID, domain, dnsScanID string, result *pb.ShodanSecurityResult) (string, error)
+	GetShodanScanResultsByDomain(domain string) ([]interfaces.ShodanScanResult, error)
+}
+
+type otxScanner interface {
+	ScanOTX(ctx context.Context, domain, dnsScanID string) (*pb.OTXSecurityResult, error)
+	InsertOTXScanResult(scanRunID, domain, dnsScanID string, result *pb.OTXSecurityResult) (string, error)
+	GetOTXScanResultsByDomain(domain string) ([]interfaces.OTXScanResult, error)
+}
+
+type whoisScanner interface {
+	ScanWhois(ctx context.Context, domain, dnsScanID string) (*pb.WhoisSecurityResult, error)
+	InsertWhoisScanResult(scanRunID, domain, dnsScanID string, result *pb.WhoisSecurityResult) (string, error)
+	GetWhoisScanResultsByDomain(domain string) ([]interfaces.WhoisScanResult, error)
+}
+
+type abuseChScanner interface {
+	ScanAbuseCh(ctx context.Context, domain, dnsScanID string) (*pb.AbuseChSecurityResult, error)
+	InsertAbuseChScanResult(scanRunID, domain, dnsScanID string, result *pb.AbuseChSecurityResult) (string, error)
+	GetAbuseChScanResultsByDomain(domain string) ([]interfaces.AbuseChScanResult, error)
+}
+
+type iscScanner interface {
+	ScanISC(ctx context.Context, domain, dnsScanID string) (*pb.ISCSecurityResult, error)
+	InsertISCScanResult(scanRunID, domain, dnsScanID string, result *pb.ISCSecurityResult) (string, error)
+	GetISCScanResultsByDomain(domain string) ([]interfaces.ISCScanResult, error)
+}
+
+// lookupPlugin returns the named plugin as T, or an Unavailable error if it
+// is not loaded or does not implement T.
+func lookupPlugin[T any](s *Server, name, label string) (T, error) {
+	var zero T
+	plugins, _ := s.loadedPlugins()
+	p, ok := plugins[name]
+	if !ok || p == nil {
+		return zero, status.Errorf(codes.Unavailable, "%s plugin not loaded", label)
+	}
+	typed, ok := p.(T)
+	if !ok {
+		return zero, status.Errorf(codes.Unavailable, "%s plugin does not support this operation", label)
+	}
+	return typed, nil
+}
+
+// providerReady refuses a single-plugin scan that could not reach provider,
+// as the job path skips it: Unavailable while its circuit breaker is open and
+// ResourceExhausted once its budget is spent.
+func (s *Server) providerReady(provider string) error {
+	if err := breaker.For(s.currentConfig(), provider).Check(); err != nil {
+		return status.Error(codes.Unavailable, err.Error())
+	}
+	if err := s.quota.Check(provider); err != nil {
+		return status.Error(codes.ResourceExhausted, err.Error())
+	}
+	return nil
+}
+
+// normalizeDomain lowercases the domain and strips whitespace and any trailing dot
+func normalizeDomain(domain string) string {
+	return strings.TrimSuffix(strings.TrimSpace(strings.ToLower(domain)), ".")
+}
+
+// startScanRun checks the domain and the DNS scan a single-plugin request
+// refers to, if any, and records a run to hold the plugin's result
+func (s *Server) startScanRun(ctx context.Context, domain, dnsScanID, plugin string) (*runs.ScanRun, error) {
+	if domain == "" {
+		return nil, status.Error(codes.InvalidArgument, "domain is required")
+	}
+	if dnsScanID != "" {
+		exists, err := s.checkDNSScanID(dnsScanID)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to validate DNS scan ID: %v", err)
+		}
+		if !exists {
+			return nil, status.Errorf(codes.NotFound, "DNS scan %s not found", dnsScanID)
+		}
+	}
+	userID, _ := ctx.Value("user_id").(string)
+	run, err := s.runs.Create(domain, userID, plugin)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to start scan run: %v", err)
+	}
+	return run, nil
+}
+
+// finishScanRun records the outcome of a single-plugin run
+func (s *Server) finishScanRun(run *runs.ScanRun, scanErr error) {
+	runStatus, errMsg := runs.StatusSucceeded, ""
+	if scanErr != nil {
+		runStatus, errMsg = runs.StatusFailed, scanErr.Error()
+	}
+	if err := s.runs.Finish(run.ID, runStatus, errMsg); err != nil {
+		log.Printf("%v", err)
+	}
+}
+
+// ScanDomain runs the DNS plugin and stores the result
+func (s *Server) ScanDomain(ctx context.Context, req *pb.ScanDomainRequest) (*pb.ScanDomainResponse, error) {
+	plugin, err := lookupPlugin[dnsScanner](s, "ScanDNS", "DNS")
+	if err != nil {
+		return nil, err
+	}
+	domain := normalizeDomain(req.GetDomain())
+	run, err := s.startScanRun(ctx, domain, "", "ScanDNS")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := plugin.ScanDomain(ctx, domain)
+	if err != nil {
+		s.finishScanRun(run, err)
+		return nil, status.Errorf(codes.Internal, "failed to scan DNS: %v", err)
+	}
+	scanID, err := plugin.InsertDNSScanResult(run.ID, domain, result)
+	s.finishScanRun(run, err)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to store DNS scan result: %v", err)
+	}
+	return &pb.ScanDomainResponse{ScanId: scanID, Result: result, ScanRunId: run.ID}, nil
+}
+
+// ScanTLS runs the TLS plugin against a domain, optionally tied to an
+// earlier DNS scan
+func (s *Server) ScanTLS(ctx context.Context, req *pb.ScanTLSRequest) (*pb.ScanTLSResponse, error) {
+	plugin, err := lookupPlugin[tlsScanner](s, "ScanTLS", "TLS")
+	if err != nil {
+		return nil, err
+	}
+	domain := normalizeDomain(req.GetDomain())
+	run, err := s.startScanRun(ctx, domain, req.GetDnsScanId(), "ScanTLS")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := plugin.ScanTLS(ctx, domain, req.GetDnsScanId())
+	if err != nil {
+		s.finishScanRun(run, err)
+		return nil, status.Errorf(codes.Internal, "failed to scan TLS: %v", err)
+	}
+	scanID, err := plugin.InsertTLSScanResult(run.ID, domain, req.GetDnsScanId(), result)
+	s.finishScanRun(run, err)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to store TLS scan result: %v", err)
+	}
+	return &pb.ScanTLSResponse{ScanId: scanID, Result: result, ScanRunId: run.ID}, nil
+}
+
+// ScanCrtSh runs the crt.sh plugin against a domain, optionally tied to an
+// earlier DNS scan
+func (s *Server) ScanCrtSh(ctx context.Context, req *pb.ScanCrtShRequest) (*pb.ScanCrtShResponse, error) {
+	plugin, err := lookupPlugin[crtShScanner](s, "ScanCrtSh", "crt.sh")
+	if err != nil {
+		return nil, err
+	}
+	if err := s.providerReady("crtsh"); err != nil {
+		return nil, err
+	}
+	domain := normalizeDomain(req.GetDomain())
+	run, err := s.startScanRun(ctx, domain, req.GetDnsScanId(), "ScanCrtSh")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := plugin.ScanCrtSh(ctx, domain, req.GetDnsScanId())
+	if err != nil {
+		s.finishScanRun(run, err)
+		return nil, status.Errorf(codes.Internal, "failed to scan crt.sh: %v", err)
+	}
+	scanID, err := plugin.InsertCrtShScanResult(run.ID, domain, req.GetDnsScanId(), result)
+	s.finishScanRun(run, err)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to store crt.sh scan result: %v", err)
+	}
+	return &pb.ScanCrtShResponse{ScanId: scanID, Result: result, ScanRunId: run.ID}, nil
+}
+
+// ScanChaos runs the Chaos plugin against a domain, optionally tied to an
+// earlier DNS scan
+func (s *Server) ScanChaos(ctx context.Context, req *pb.ScanChaosRequest) (*pb.ScanChaosResponse, error) {
+	plugin, err := lookupPlugin[chaosScanner](s, "ScanChaos", "Chaos")
+	if err != nil {
+		return nil, err
+	}
+	if err := s.providerReady("chaos"); err != nil {
+		return nil, err
+	}
+	domain := normalizeDomain(req.GetDomain())
+	run, err := s.startScanRun(ctx, domain, req.GetDnsScanId(), "ScanChaos")
+	if err != nil {
+		return nil, err
+	}
+
+	if req.GetRefresh() {
+		ctx = intelcache.WithRefresh(ctx)
+	}
+	result, err := plugin.ScanChaos(ctx, domain, req.GetDnsScanId())
+	if err != nil {
+		s.finishScanRun(run, err)
+		return nil, status.Errorf(codes.Internal, "failed to scan Chaos: %v", err)
+	}
+	scanID, err := plugin.InsertChaosScanResult(run.ID, domain, req.GetDnsScanId(), result)
+	s.finishScanRun(run, err)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to store Chaos scan result: %v", err)
+	}
+	return &pb.ScanChaosResponse{ScanId: scanID, Result: result, ScanRunId: run.ID}, nil
+}
+
+// ScanShodan runs the Shodan plugin against a domain, optionally tied to an
+// earlier DNS scan
+func (s *Server) ScanShodan(ctx context.Context, req *pb.ScanShodanRequest) (*pb.ScanShodanResponse, error) {
+	plugin, err := lookupPlugin[shodanScanner](s, "ScanShodan", "Shodan")
+	if err != nil {
+		return nil, err
+	}
+	if err := s.providerReady("shodan"); err != nil {
+		return nil, err
+	}
+	domain := normalizeDomain(req.GetDomain())
+	run, err := s.startScanRun(ctx, domain, req.GetDnsScanId(), "ScanShodan")
+	if err != nil {
+		return nil, err
+	}
+
+	if req.GetRefresh() {
+		ctx = intelcache.WithRefresh(ctx)
+	}
+	result, err := plugin.ScanShodan(ctx, domain, req.GetDnsScanId())
+	if err != nil {
+		s.finishScanRun(run, err)
+		return nil, status.Errorf(codes.Internal, "failed to scan Shodan: %v", err)
+	}
+	scanID, err := plugin.InsertShodanScanResult(run.ID, domain, req.GetDnsScanId(), result)
+	s.finishScanRun(run, err)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to store Shodan scan result: %v", err)
+	}
+	return &pb.ScanShodanResponse{ScanId: scanID, Result: result, ScanRunId: run.ID}, nil
+}
+
+// ScanOTX runs the OTX plugin against a domain, optionally tied to an
+// earlier DNS scan
+func (s *Server) ScanOTX(ctx context.Context, req *pb.ScanOTXRequest) (*pb.ScanOTXResponse, error) {
+	plugin, err := lookupPlugin[otxScanner](s, "ScanOTX", "OTX")
+	if err != nil {
+		return nil, err
+	}
+	if err := s.providerReady("otx"); err != nil {
+		return nil, err
+	}
+	domain := normalizeDomain(req.GetDomain())
+	run, err := s.startScanRun(ctx, domain, req.GetDnsScanId(), "ScanOTX")
+	if err != nil {
+		return nil, err
+	}
+
+	if req.GetRefresh() {
+		ctx = intelcache.WithRefresh(ctx)
+	}
+	result, err := plugin.ScanOTX(ctx, domain, req.GetDnsScanId())
+	if err != nil {
+		s.finishScanRun(run, err)
+		return nil, status.Errorf(codes.Internal, "failed to scan OTX: %v", err)
+	}
+	scanID, err := plugin.InsertOTXScanResult(run.ID, domain, req.GetDnsScanId(), result)
+	s.finishScanRun(run, err)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to store OTX scan result: %v", err)
+	}
+	return &pb.ScanOTXResponse{ScanId: scanID, Result: result, ScanRunId: run.ID}, nil
+}
+
+// ScanWhois runs the Whois plugin against a domain, optionally tied to an
+// earlier DNS scan
+func (s *Server) ScanWhois(ctx context.Context, req *pb.ScanWhoisRequest) (*pb.ScanWhoisResponse, error) {
+	plugin, err := lookupPlugin[whoisScanner](s, "ScanWhois", "Whois")
+	if err != nil {
+		return nil, err
+	}
+	domain := normalizeDomain(req.GetDomain())
+	run, err := s.startScanRun(ctx, domain, req.GetDnsScanId(), "ScanWhois")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := plugin.ScanWhois(ctx, domain, req.GetDnsScanId())
+	if err != nil {
+		s.finishScanRun(run, err)
+		return nil, status.Errorf(codes.Internal, "failed to scan Whois: %v", err)
+	}
+	scanID, err := plugin.InsertWhoisScanResult(run.ID, domain, req.GetDnsScanId(), result)
+	s.finishScanRun(run, err)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to store Whois scan result: %v", err)
+	}
+	return &pb.ScanWhoisResponse{ScanId: scanID, Result: result, ScanRunId: run.ID}, nil
+}
+
+// ScanAbuseCh runs the AbuseCh plugin against a domain, optionally tied to an
+// earlier DNS scan
+func (s *Server) ScanAbuseCh(ctx context.Context, req *pb.ScanAbuseChRequest) (*pb.ScanAbuseChResponse, error) {
+	plugin, err := lookupPlugin[abuseChScanner](s, "ScanAbuseCh", "AbuseCh")
+	if err != nil {
+		return nil, err
+	}
+	if err := s.providerReady("abuse_ch"); err != nil {
+		return nil, err
+	}
+	domain := normalizeDomain(req.GetDomain())
+	run, err := s.startScanRun(ctx, domain, req.GetDnsScanId(), "ScanAbuseCh")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := plugin.ScanAbuseCh(ctx, domain, req.GetDnsScanId())
+	if err != nil {
+		s.finishScanRun(run, err)
+		return nil, status.Errorf(codes.Internal, "failed to scan AbuseCh: %v", err)
+	}
+	scanID, err := plugin.InsertAbuseChScanResult(run.ID, domain, req.GetDnsScanId(), result)
+	s.finishScanRun(run, err)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to store AbuseCh scan result: %v", err)
+	}
+	return &pb.ScanAbuseChResponse{ScanId: scanID, Result: result, ScanRunId: run.ID}, nil
+}
+
+// ScanISC runs the ISC plugin against a domain, optionally tied to an
+// earlier DNS scan
+func (s *Server) ScanISC(ctx context.Context, req *pb.ScanISCRequest) (*pb.ScanISCResponse, error) {
+	plugin, err := lookupPlugin[iscScanner](s, "ScanISC", "ISC")
+	if err != nil {
+		return nil, err
+	}
+	if err := s.providerReady("isc"); err != nil {
+		return nil, err
+	}
+	domain := normalizeDomain(req.GetDomain())
+	run, err := s.startScanRun(ctx, domain, req.GetDnsScanId(), "ScanISC")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := plugin.ScanISC(ctx, domain, req.GetDnsScanId())
+	if err != nil {
+		s.finishScanRun(run, err)
+		return nil, status.Errorf(codes.Internal, "failed to scan ISC: %v", err)
+	}
+	scanID, err := plugin.InsertISCScanResult(run.ID, domain, req.GetDnsScanId(), result)
+	s.finishScanRun(run, err)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to store ISC scan result: %v", err)
+	}
+	return &pb.ScanISCResponse{ScanId: scanID, Result: result, ScanRunId: run.ID}, nil
+}
+
+// GetDNSScanResultsByDomain returns stored DNS scan results for a domain, newest first
+func (s *Server) GetDNSScanResultsByDomain(ctx context.Context, req *pb.GetDNSScanResultsByDomainRequest) (*pb.GetDNSScanResultsByDomainResponse, error) {
+	plugin, err := lookupPlugin[dnsScanner](s, "ScanDNS", "DNS")
+	if err != nil {
+		return nil, err
+	}
+	domain := normalizeDomain(req.GetDomain())
+	if domain == "" {
+		return nil, status.Error(codes.InvalidArgument, "domain is required")
+	}
+	results, err := plugin.GetDNSScanResultsByDomain(domain)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to retrieve DNS scan results: %v", err)
+	}
+	resp := &pb.GetDNSScanResultsByDomainResponse{}
+	for i := range results {
+		resp.Results = append(resp.Results, dnsScanResultToProto(&results[i]))
+	}
+	return resp, nil
+}
+
+// GetDNSScanResultByID returns a single stored DNS scan result
+func (s *Server) GetDNSScanResultByID(ctx context.Context, req *pb.GetDNSScanResultByIDRequest) (*pb.GetDNSScanResultByIDResponse, error) {
+	plugin, err := lookupPlugin[dnsScanner](s, "ScanDNS", "DNS")
+	if err != nil {
+		return nil, err
+	}
+	dnsScanID := strings.TrimSpace(req.GetDnsScanId())
+	if dnsScanID == "" {
+		return nil, status.Error(codes.InvalidArgument, "DNS scan ID is required")
+	}
+	exists, err := s.checkDNSScanID(dnsScanID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to validate DNS scan ID: %v", err)
+	}
+	if !exists {
+		return nil, status.Errorf(codes.NotFound, "DNS scan %s not found", dnsScanID)
+	}
+	result, err := plugin.GetDNSScanResultByID(dnsScanID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to retrieve DNS scan result: %v", err)
+	}
+	return &pb.GetDNSScanResultByIDResponse{Result: dnsScanResultToProto(&result)}, nil
+}
+
+// GetTLSScanResultsByDomain returns stored TLS scan results for a domain, newest first
+func (s *Server) GetTLSScanResultsByDomain(ctx context.Context, req *pb.GetTLSScanResultsByDomainRequest) (*pb.GetTLSScanResultsByDomainResponse, error) {
+	plugin, err := lookupPlugin[tlsScanner](s, "ScanTLS", "TLS")
+	if err != nil {
+		return nil, err
+	}
+	domain := normalizeDomain(req.GetDomain())
+	if domain == "" {
+		return nil, status.Error(codes.InvalidArgument, "domain is required")
+	}
+	results, err := plugin.GetTLSScanResultsByDomain(domain)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to retrieve TLS scan results: %v", err)
+	}
+	resp := &pb.GetTLSScanResultsByDomainResponse{}
+	for i := range results {
+		r := &results[i]
+		resp.Results = append(resp.Results, &pb.TLSScanResult{
+			Id:        r.ID,
+			Domain:    r.Domain,
+			DnsScanId: r.DNSScanID,
+			Result:    r.Result,
+			CreatedAt: timestamppb.New(r.CreatedAt),
+		})
+	}
+	return resp, nil
+}
+
+// GetCrtShScanResultsByDomain returns stored crt.sh scan results for a domain, newest first
+func (s *Server) GetCrtShScanResultsByDomain(ctx context.Context, req *pb.GetCrtShScanResultsByDomainRequest) (*pb.GetCrtShScanResultsByDomainResponse, error) {
+	plugin, err := lookupPlugin[crtShScanner](s, "ScanCrtSh", "crt.sh")
+	if err != nil {
+		return nil, err
+	}
+	domain := normalizeDomain(req.GetDomain())
+	if domain == "" {
+		return nil, status.Error(codes.InvalidArgument, "domain is required")
+	}
+	results, err := plugin.GetCrtShScanResultsByDomain(domain)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to retrieve crt.sh scan results: %v", err)
+	}
+	resp := &pb.GetCrtShScanResultsByDomainResponse{}
+	for i := range results {
+		r := &results[i]
+		resp.Results = append(resp.Results, &pb.CrtShScanResult{
+			Id:        r.ID,
+			Domain:    r.Domain,
+			DnsScanId: r.DNSScanID,
+			Result:    r.Result,
+			CreatedAt: timestamppb.New(r.CreatedAt),
+		})
+	}
+	return resp, nil
+}
+
+// GetChaosScanResultsByDomain returns stored Chaos scan results for a domain, newest first
+func (s *Server) GetChaosScanResultsByDomain(ctx context.Context, req *pb.GetChaosScanResultsByDomainRequest) (*pb.GetChaosScanResultsByDomainResponse, error) {
+	plugin, err := lookupPlugin[chaosScanner](s, "ScanChaos", "Chaos")
+	if err != nil {
+		return nil, err
+	}
+	domain := normalizeDomain(req.GetDomain())
+	if domain == "" {
+		return nil, status.Error(codes.InvalidArgument, "domain is required")
+	}
+	results, err := plugin.GetChaosScanResultsByDomain(domain)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to retrieve Chaos scan results: %v", err)
+	}
+	resp := &pb.GetChaosScanResultsByDomainResponse{}
+	for i := range results {
+		r := &results[i]
+		resp.Results = append(resp.Results, &pb.ChaosScanResult{
+			Id:        r.ID,
+			Domain:    r.Domain,
+			DnsScanId: r.DNSScanID,
+			Result:    r.Result,
+			CreatedAt: timestamppb.New(r.CreatedAt),
+		})
+	}
+	return resp, nil
+}
+
+// GetShodanScanResultsByDomain returns stored Shodan scan results for a domain, newest first
+func (s *Server) GetShodanScanResultsByDomain(ctx context.Context, req *pb.GetShodanScanResultsByDomainRequest) (*pb.GetShodanScanResultsByDomainResponse, error) {
+	plugin, err := lookupPlugin[shodanScanner](s, "ScanShodan", "Shodan")
+	if err != nil {
+		return nil, err
+	}
+	domain := normalizeDomain(req.GetDomain())
+	if domain == "" {
+		return nil, status.Error(codes.InvalidArgument, "domain is required")
+	}
+	results, err := plugin.GetShodanScanResultsByDomain(domain)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to retrieve Shodan scan results: %v", err)
+	}
+	resp := &pb.GetShodanScanResultsByDomainResponse{}
+	for i := range results {
+		r := &results[i]
+		resp.Results = append(resp.Results, &pb.ShodanScanResult{
+			Id:        r.ID,
+			Domain:    r.Domain,
+			DnsScanId: r.DNSScanID,
+			Result:    r.Result,
+			CreatedAt: timestamppb.New(r.CreatedAt),
+		})
+	}
+	return resp, nil
+}
+
+// GetOTXScanResultsByDomain returns stored OTX scan results for a domain, newest first
+func (s *Server) GetOTXScanResultsByDomain(ctx context.Context, req *pb.GetOTXScanResultsByDomainRequest) (*pb.GetOTXScanResultsByDomainResponse, error) {
+	plugin, err := lookupPlugin[otxScanner](s, "ScanOTX", "OTX")
+	if err != nil {
+		return nil, err
+	}
+	domain := normalizeDomain(req.GetDomain())
+	if domain == "" {
+		return nil, status.Error(codes.InvalidArgument, "domain is required")
+	}
+	results, err := plugin.GetOTXScanResultsByDomain(domain)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to retrieve OTX scan results: %v", err)
+	}
+	resp := &pb.GetOTXScanResultsByDomainResponse{}
+	for i := range results {
+		r := &results[i]
+		resp.Results = append(resp.Results, &pb.OTXScanResult{
+			Id:        r.ID,
+			Domain:    r.Domain,
+			DnsScanId: r.DNSScanID,
+			Result:    r.Result,
+			CreatedAt: timestamppb.New(r.CreatedAt),
+		})
+	}
+	return resp, nil
+}
+
+// GetWhoisScanResultsByDomain returns stored Whois scan results for a domain, newest first
+func (s *Server) GetWhoisScanResultsByDomain(ctx context.Context, req *pb.GetWhoisScanResultsByDomainRequest) (*pb.GetWhoisScanResultsByDomainResponse, error) {
+	plugin, err := lookupPlugin[whoisScanner](s, "ScanWhois", "Whois")
+	if err != nil {
+		return nil, err
+	}
+	domain := normalizeDomain(req.GetDomain())
+	if domain == "" {
+		return nil, status.Error(codes.InvalidArgument, "domain is required")
+	}
+	results, err := plugin.GetWhoisScanResultsByDomain(domain)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to retrieve Whois scan results: %v", err)
+	}
+	resp := &pb.GetWhoisScanResultsByDomainResponse{}
+	for i := range results {
+		r := &results[i]
+		resp.Results = append(resp.Results, &pb.WhoisScanResult{
+			Id:        r.ID,
+			Domain:    r.Domain,
+			DnsScanId: r.DNSScanID,
+			Result:    r.Result,
+			CreatedAt: timestamppb.New(r.CreatedAt),
+		})
+	}
+	return resp, nil
+}
+
+// GetAbuseChScanResultsByDomain returns stored AbuseCh scan results for a domain, newest first
+func (s *Server) GetAbuseChScanResultsByDomain(ctx context.Context, req *pb.GetAbuseChScanResultsByDomainRequest) (*pb.GetAbuseChScanResultsByDomainResponse, error) {
+	plugin, err := lookupPlugin[abuseChScanner](s, "ScanAbuseCh", "AbuseCh")
+	if err != nil {
+		return nil, err
+	}
+	domain := normalizeDomain(req.GetDomain())
+	if domain == "" {
+		return nil, status.Error(codes.InvalidArgument, "domain is required")
+	}
+	results, err := plugin.GetAbuseChScanResultsByDomain(domain)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to retrieve AbuseCh scan results: %v", err)
+	}
+	resp := &pb.GetAbuseChScanResultsByDomainResponse{}
+	for i := range results {
+		r := &results[i]
+		resp.Results = append(resp.Results, &pb.AbuseChScanResult{
+			Id:        r.ID,
+			Domain:    r.Domain,
+			DnsScanId: r.DNSScanID,
+			Result:    r.Result,
+			CreatedAt: timestamppb.New(r.CreatedAt),
+		})
+	}
+	return resp, nil
+}
+
+// GetISCScanResultsByDomain returns stored ISC scan results for a domain, newest first
+func (s *Server) GetISCScanResultsByDomain(ctx context.Context, req *pb.GetISCScanResultsByDomainRequest) (*pb.GetISCScanResultsByDomainResponse, error) {
+	plugin, err := lookupPlugin[iscScanner](s, "ScanISC", "ISC")
+	if err != nil {
+		return nil, err
+	}
+	domain := normalizeDomain(req.GetDomain())
+	if domain == "" {
+		return nil, status.Error(codes.InvalidArgument, "domain is required")
+	}
+	results, err := plugin.GetISCScanResultsByDomain(domain)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to retrieve ISC scan results: %v", err)
+	}
+	resp := &pb.GetISCScanResultsByDomainResponse{}
+	for i := range results {
+		r := &results[i]
+		resp.Results = append(resp.Results, &pb.ISCScanResult{
+			Id:        r.ID,
+			Domain:    r.Domain,
+			DnsScanId: r.DNSScanID,
+			Result:    r.Result,
+			CreatedAt: timestamppb.New(r.CreatedAt),
+		})
+	}
+	return resp, nil
+}
+
+func dnsScanResultToProto(r *interfaces.DNSScanResult) *pb.DNSScanResult {
+	return &pb.DNSScanResult{
+		Id:        r.ID,
+		Domain:    r.Domain,
+		DnsScanId: r.DNSScanID,
+		Result:    r.Result,
+		CreatedAt: timestamppb.New(r.CreatedAt),
+	}
+}
+
+// ListPlugins reports every registered scan plugin along with whether it was
+// loaded by this server, whether its required configuration is present and
+// whether it is healthy.
+func (s *Server) ListPlugins(ctx context.Context, req *pb.ListPluginsRequest) (*pb.ListPluginsResponse, error) {
+	cfg := s.currentConfig()
+	plugins, disabled := s.loadedPlugins()
+	resp := &pb.ListPluginsResponse{}
+	for _, info := range registry.List() {
+		plugin, loaded := plugins[info.Name]
+		missing := info.MissingConfig(cfg)
+		healthy, message := loaded, disabled[info.Name]
+		if checker, ok := plugin.(interfaces.HealthChecker); ok && loaded {
+			checkCtx, cancel := context.WithTimeout(ctx, 2*time.Second)
+			if err := checker.Health(checkCtx); err != nil {
+				healthy, message = false, err.Error()
+			}
+			cancel()
+		}
+		resp.Plugins = append(resp.Plugins, &pb.PluginInfo{
+			Name:           info.Name,
+			Version:        info.Version,
+			Description:    info.Description,
+			RequiredConfig: info.RequiredConfig,
+			Passive:        info.Passive,
+			Dependencies:   info.Dependencies,
+			Loaded:         loaded,
+			Configured:     len(missing) == 0,
+			MissingConfig:  missing,
+			External:       info.External,
+			Healthy:        healthy,
+			HealthMessage:  message,
+			Enabled:        cfg == nil || cfg.PluginEnabled(info.Name),
+		})
+	}
+	return resp, nil
+}
@@ -8,13 +8,20 @@ import (
 	"google.golang.org/protobuf/types/known/timestamppb"
 	"log"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/moos3/sparta/internal/auth"
+	"github.com/moos3/sparta/internal/config"
 	"github.com/moos3/sparta/internal/db"
 	"github.com/moos3/sparta/internal/email"
 	"github.com/moos3/sparta/internal/interfaces"
+	"github.com/moos3/sparta/internal/jobs"
+	"github.com/moos3/sparta/internal/profiles"
+	"github.com/moos3/sparta/internal/quota"
+	"github.com/moos3/sparta/internal/runs"
+	"github.com/moos3/sparta/internal/schedules"
 	"github.com/moos3/sparta/internal/scoring"
 	pb "github.com/moos3/sparta/proto"
 	"google.golang.org/grpc/codes"
@@ -25,22 +32,68 @@ import (
 type Server struct {
 	pb.UnimplementedUserServiceServer
 	pb.UnimplementedScanServiceServer
-	db      db.Database
-	auth    *auth.AuthService
-	email   *email.Service
-	plugins map[string]interfaces.GenericPlugin
+	db        db.Database
+	auth      *auth.AuthService
+	email     *email.Service
+	mu        sync.RWMutex // guards config, plugins and disabled, which a reload swaps
+	config    *config.Config
+	plugins   map[string]interfaces.GenericPlugin
+	disabled  map[string]string // why each plugin left out of plugins was not loaded
+	configs   *config.Watcher   // reports the config version; nil if the config is not watched
+	jobs      *jobs.Store
+	runs      *runs.Store
+	schedules *schedules.Store
+	profiles  *profiles.Store
+	quota     *quota.Tracker
 }
 
 // New creates a new Server instance with the provided dependencies
-func New(db db.Database, auth *auth.AuthService, email *email.Service, plugins map[string]interfaces.GenericPlugin) *Server {
+func New(db db.Database, cfg *config.Config, auth *auth.AuthService, email *email.Service, plugins map[string]interfaces.GenericPlugin, disabled map[string]string) *Server {
 	return &Server{
-		db:      db,
-		auth:    auth,
-		email:   email,
-		plugins: plugins,
+		db:        db,
+		config:    cfg,
+		auth:      auth,
+		email:     email,
+		plugins:   plugins,
+		disabled:  disabled,
+		jobs:      jobs.NewStore(db),
+		runs:      runs.NewStore(db),
+		schedules: schedules.NewStore(db),
+		profiles:  profiles.NewStore(db, cfg),
+		quota:     quota.New(db, cfg),
 	}
 }
 
+// Reload swaps in a reloaded config and the plugins loaded with it. RPCs
+// already running finish with the config and plugins they started with.
+func (s *Server) Reload(cfg *config.Config, plugins map[string]interfaces.GenericPlugin, disabled map[string]string) {
+	s.profiles.SetConfig(cfg)
+	s.quota.SetConfig(cfg)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.config, s.plugins, s.disabled = cfg, plugins, disabled
+}
+
+// WatchConfig makes DescribeConfig report the versions served by w.
+func (s *Server) WatchConfig(w *config.Watcher) {
+	s.configs = w
+}
+
+// currentConfig returns the config RPCs run with.
+func (s *Server) currentConfig() *config.Config {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.config
+}
+
+// loadedPlugins returns the plugins RPCs run with, and why each registered
+// plugin not among them was left out.
+func (s *Server) loadedPlugins() (map[string]interfaces.GenericPlugin, map[string]string) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.plugins, s.disabled
+}
+
 // --- API Key Management Methods (MOVED FROM AUTH SERVICE) ---
 func (s *Server) CreateAPIKey(ctx context.Context, req *pb.CreateAPIKeyRequest) (*pb.CreateAPIKeyResponse, error) {
 	// Only admin can create API keys for other users.
@@ -246,10 +299,8 @@ func (s *Server) getAuthUserID(ctx context.Context) (string, error) {
 	return userID, nil
 }
 
-// checkDNSScanID remains the same, but it's now private (lowercase) as it's a helper for other methods not exposed in UserService.
-// This function was originally in internal/server/bak/dns.go which is no longer the active Server implementation.
-// So, I need to assume it's moved to server.go if needed.
-// It's not directly part of the UserService interface, but used by scan plugins.
+// checkDNSScanID reports whether a DNS scan result with the given ID exists.
+// The ScanService RPCs use it to validate the dns_scan_id a scan is attached to.
 func (s *Server) checkDNSScanID(dnsScanID string) (bool, error) {
 	if dnsScanID == "" {
 		return false, fmt.Errorf("DNS scan ID is empty")
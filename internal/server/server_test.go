@@ -3,20 +3,25 @@ package server
 
 import (
 	"context"
-	// "database/sql"
+	"database/sql/driver"
 	"fmt"
-	"google.golang.org/grpc"
 	"testing"
-	"time"
 
+	"github.com/moos3/sparta/internal/config"
 	"github.com/moos3/sparta/internal/db"
+	"github.com/moos3/sparta/internal/interfaces"
+	"github.com/moos3/sparta/internal/runs"
+	"github.com/moos3/sparta/internal/testutils"
 	pb "github.com/moos3/sparta/proto"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
-	// "github.com/moos3/sparta/internal/testutils"
+)
+
+const (
+	createRunQuery = "INSERT INTO scan_runs"
+	finishRunQuery = "UPDATE scan_runs SET status = $2"
 )
 
 type MockDNSScanPlugin struct {
@@ -28,75 +33,53 @@ func (m *MockDNSScanPlugin) Initialize() error {
 	return args.Error(0)
 }
 
-func (m *MockDNSScanPlugin) Name() string {
-	args := m.Called()
-	return args.String(0)
+func (m *MockDNSScanPlugin) Scan(ctx context.Context, req interfaces.ScanRequest) (*interfaces.ScanResult, error) {
+	args := m.Called(ctx, req)
+	result, _ := args.Get(0).(*interfaces.ScanResult)
+	return result, args.Error(1)
 }
 
 func (m *MockDNSScanPlugin) SetDatabase(db db.Database) {
 	m.Called(db)
 }
 
-func (m *MockDNSScanPlugin) ScanDomain(domain string) (db.DNSSecurityResult, error) {
-	args := m.Called(domain)
-	return args.Get(0).(db.DNSSecurityResult), args.Error(1)
+func (m *MockDNSScanPlugin) SetConfig(cfg *config.Config) error {
+	args := m.Called(cfg)
+	return args.Error(0)
+}
+
+func (m *MockDNSScanPlugin) ScanDomain(ctx context.Context, domain string) (*pb.DNSSecurityResult, error) {
+	args := m.Called(ctx, domain)
+	result, _ := args.Get(0).(*pb.DNSSecurityResult)
+	return result, args.Error(1)
 }
 
-func (m *MockDNSScanPlugin) InsertDNSScanResult(domain string, result db.DNSSecurityResult) (string, error) {
-	args := m.Called(domain, result)
+func (m *MockDNSScanPlugin) InsertDNSScanResult(scanRunID, domain string, result *pb.DNSSecurityResult) (string, error) {
+	args := m.Called(scanRunID, domain, result)
 	return args.String(0), args.Error(1)
 }
 
-func (m *MockDNSScanPlugin) GetDNSScanResultsByDomain(domain string) ([]struct {
-	ID        string
-	Domain    string
-	Result    db.DNSSecurityResult
-	CreatedAt time.Time
-}, error) {
+func (m *MockDNSScanPlugin) GetDNSScanResultsByDomain(domain string) ([]interfaces.DNSScanResult, error) {
 	args := m.Called(domain)
-	return args.Get(0).([]struct {
-		ID        string
-		Domain    string
-		Result    db.DNSSecurityResult
-		CreatedAt time.Time
-	}), args.Error(1)
+	results, _ := args.Get(0).([]interfaces.DNSScanResult)
+	return results, args.Error(1)
 }
 
-func TestAuthInterceptor(t *testing.T) {
-	ctx := context.Background()
-	s := &Server{}
-	req := struct{}{}
-	info := &grpc.UnaryServerInfo{}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return req, nil
-	}
-
-	t.Run("MissingMetadata", func(t *testing.T) {
-		_, err := s.AuthInterceptor(ctx, req, info, handler)
-		assert.Error(t, err)
-		st, ok := status.FromError(err)
-		assert.True(t, ok)
-		assert.Equal(t, codes.Unavailable, st.Code())
-	})
+func (m *MockDNSScanPlugin) GetDNSScanResultByID(dnsScanID string) (interfaces.DNSScanResult, error) {
+	args := m.Called(dnsScanID)
+	result, _ := args.Get(0).(interfaces.DNSScanResult)
+	return result, args.Error(1)
+}
 
-	t.Run("MissingAPIKey", func(t *testing.T) {
-		ctx = metadata.NewIncomingContext(ctx, metadata.Pairs())
-		_, err := s.AuthInterceptor(ctx, req, info, handler)
-		assert.Error(t, err)
-		st, ok := status.FromError(err)
-		assert.True(t, ok)
-		assert.Equal(t, codes.Unavailable, st.Code())
-	})
+func newDNSTestServer(database db.Database, plugin *MockDNSScanPlugin) *Server {
+	return &Server{db: database, runs: runs.NewStore(database), plugins: map[string]interfaces.GenericPlugin{"ScanDNS": plugin}}
+}
 
-	t.Run("ValidAPIKey", func(t *testing.T) {
-		ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("x-api-key", "test-key"))
-		resp, err := s.AuthInterceptor(ctx, req, info, handler)
-		assert.NoError(t, err)
-		assert.Equal(t, req, resp)
-		apiKey, ok := ctx.Value(string("api_key")).(string)
-		assert.True(t, ok)
-		assert.Equal(t, "test-key", apiKey)
-	})
+// expectScanRun expects a single-plugin RPC to record a scan run, and returns
+// the statement that records its outcome
+func expectScanRun(stubDb *testutils.StubDB) *testutils.StubStatement {
+	stubDb.Expect(createRunQuery).WillReturnResult(1)
+	return stubDb.Expect(finishRunQuery).WillReturnResult(1)
 }
 
 func TestScanDomain(t *testing.T) {
@@ -112,8 +95,7 @@ func TestScanDomain(t *testing.T) {
 	})
 
 	t.Run("InvalidDomain", func(t *testing.T) {
-		mockPlugin := &MockDNSScanPlugin{}
-		s := &Server{DnsPlugin: mockPlugin}
+		s := newDNSTestServer(nil, &MockDNSScanPlugin{})
 		_, err := s.ScanDomain(ctx, &pb.ScanDomainRequest{Domain: ""})
 		assert.Error(t, err)
 		st, ok := status.FromError(err)
@@ -122,30 +104,51 @@ func TestScanDomain(t *testing.T) {
 	})
 
 	t.Run("Success", func(t *testing.T) {
+		stubDb := testutils.NewStubDB()
+		finish := expectScanRun(stubDb)
 		mockPlugin := &MockDNSScanPlugin{}
-		s := &Server{DnsPlugin: mockPlugin}
-		result := db.DNSSecurityResult{SPFRecord: "v=spf1 include:_spf.google.com ~all"}
-		mockPlugin.On("ScanDomain", "example.com").Return(result, nil).Once()
-		mockPlugin.On("InsertDNSScanResult", "example.com", result).Return("scan-123", nil).Once()
+		s := newDNSTestServer(stubDb, mockPlugin)
+		result := &pb.DNSSecurityResult{SpfRecord: "v=spf1 include:_spf.google.com ~all"}
+		mockPlugin.On("ScanDomain", mock.Anything, "example.com").Return(result, nil).Once()
+		mockPlugin.On("InsertDNSScanResult", mock.Anything, "example.com", result).Return("scan-123", nil).Once()
 
-		resp, err := s.ScanDomain(ctx, &pb.ScanDomainRequest{Domain: "example.com"})
+		resp, err := s.ScanDomain(ctx, &pb.ScanDomainRequest{Domain: "example.com."})
 		if !assert.NoError(t, err) {
-			t.Logf("ScanDomain failed: %v", err)
-			return
-		}
-		if !assert.NotNil(t, resp) {
-			t.Log("Response is nil")
 			return
 		}
 		assert.Equal(t, "scan-123", resp.ScanId)
 		assert.Equal(t, "v=spf1 include:_spf.google.com ~all", resp.Result.SpfRecord)
+		assert.Equal(t, resp.ScanRunId, mockPlugin.Calls[1].Arguments[0])
+		assert.Equal(t, "succeeded", finish.Args()[1])
+		assert.NoError(t, stubDb.ExpectationsWereMet())
 		mockPlugin.AssertExpectations(t)
 	})
 
 	t.Run("ScanError", func(t *testing.T) {
+		stubDb := testutils.NewStubDB()
+		finish := expectScanRun(stubDb)
+		mockPlugin := &MockDNSScanPlugin{}
+		s := newDNSTestServer(stubDb, mockPlugin)
+		mockPlugin.On("ScanDomain", mock.Anything, "example.com").Return(nil, fmt.Errorf("scan error")).Once()
+
+		_, err := s.ScanDomain(ctx, &pb.ScanDomainRequest{Domain: "example.com"})
+		assert.Error(t, err)
+		st, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.Internal, st.Code())
+		assert.Equal(t, "failed", finish.Args()[1])
+		assert.Equal(t, "scan error", finish.Args()[2])
+		mockPlugin.AssertExpectations(t)
+	})
+
+	t.Run("StoreError", func(t *testing.T) {
+		stubDb := testutils.NewStubDB()
+		expectScanRun(stubDb)
 		mockPlugin := &MockDNSScanPlugin{}
-		s := &Server{DnsPlugin: mockPlugin}
-		mockPlugin.On("ScanDomain", "example.com").Return(db.DNSSecurityResult{}, fmt.Errorf("scan error")).Once()
+		s := newDNSTestServer(stubDb, mockPlugin)
+		result := &pb.DNSSecurityResult{}
+		mockPlugin.On("ScanDomain", mock.Anything, "example.com").Return(result, nil).Once()
+		mockPlugin.On("InsertDNSScanResult", mock.Anything, "example.com", result).Return("", fmt.Errorf("insert error")).Once()
 
 		_, err := s.ScanDomain(ctx, &pb.ScanDomainRequest{Domain: "example.com"})
 		assert.Error(t, err)
@@ -155,3 +158,49 @@ func TestScanDomain(t *testing.T) {
 		mockPlugin.AssertExpectations(t)
 	})
 }
+
+func TestGetDNSScanResultByID(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("MissingID", func(t *testing.T) {
+		s := newDNSTestServer(nil, &MockDNSScanPlugin{})
+		_, err := s.GetDNSScanResultByID(ctx, &pb.GetDNSScanResultByIDRequest{})
+		st, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.InvalidArgument, st.Code())
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		stubDb := testutils.NewStubDB()
+		stubDb.Expect(dnsScanExistsQuery).WillReturnRows([]string{"exists"}, []driver.Value{false})
+		s := newDNSTestServer(stubDb, &MockDNSScanPlugin{})
+
+		_, err := s.GetDNSScanResultByID(ctx, &pb.GetDNSScanResultByIDRequest{DnsScanId: "scan-123"})
+		st, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.NotFound, st.Code())
+		assert.NoError(t, stubDb.ExpectationsWereMet())
+	})
+
+	t.Run("Success", func(t *testing.T) {
+		stubDb := testutils.NewStubDB()
+		stubDb.Expect(dnsScanExistsQuery).WillReturnRows([]string{"exists"}, []driver.Value{true})
+		mockPlugin := &MockDNSScanPlugin{}
+		s := newDNSTestServer(stubDb, mockPlugin)
+		stored := interfaces.DNSScanResult{
+			ID:        "scan-123",
+			Domain:    "example.com",
+			DNSScanID: "scan-123",
+			Result:    &pb.DNSSecurityResult{DmarcPolicy: "reject"},
+		}
+		mockPlugin.On("GetDNSScanResultByID", "scan-123").Return(stored, nil).Once()
+
+		resp, err := s.GetDNSScanResultByID(ctx, &pb.GetDNSScanResultByIDRequest{DnsScanId: "scan-123"})
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.Equal(t, "scan-123", resp.Result.Id)
+		assert.Equal(t, "reject", resp.Result.Result.DmarcPolicy)
+		mockPlugin.AssertExpectations(t)
+	})
+}
@@ -5,7 +5,6 @@ import (
 	"database/sql"
 	"time"
 
-	"github.com/moos3/sparta/internal/db"
 	"github.com/stretchr/testify/mock"
 )
 
@@ -46,9 +45,9 @@ func (m *MockDatabase) DeleteUser(userID string) error {
 	return args.Error(0)
 }
 
-func (m *MockDatabase) ListUsers() ([]db.User, error) {
+func (m *MockDatabase) ListUsers() ([]User, error) {
 	args := m.Called()
-	return args.Get(0).([]db.User), args.Error(1)
+	return args.Get(0).([]User), args.Error(1)
 }
 
 func (m *MockDatabase) Exec(query string, args ...interface{}) (sql.Result, error) {
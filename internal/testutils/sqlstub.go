@@ -0,0 +1,203 @@
+// internal/testutils/sqlstub.go
+package testutils
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/moos3/sparta/internal/db"
+)
+
+// StubDB is a db.Database backed by a scripted database/sql driver. Tests
+// register the statements they expect, in order, together with the rows or
+// error each statement should produce.
+type StubDB struct {
+	*sql.DB
+	mu       sync.Mutex
+	expected []*StubStatement
+}
+
+// StubStatement is a single scripted statement
+type StubStatement struct {
+	query        string
+	columns      []string
+	rows         [][]driver.Value
+	rowsAffected int64
+	err          error
+	args         []driver.Value
+	used         bool
+}
+
+var (
+	stubOnce     sync.Once
+	stubSeq      atomic.Int64
+	stubRegistry sync.Map
+)
+
+// NewStubDB creates an empty StubDB
+func NewStubDB() *StubDB {
+	stubOnce.Do(func() {
+		sql.Register("sparta-stub", stubDriver{})
+	})
+	name := fmt.Sprintf("stub-%d", stubSeq.Add(1))
+	s := &StubDB{}
+	stubRegistry.Store(name, s)
+	conn, err := sql.Open("sparta-stub", name)
+	if err != nil {
+		panic(err)
+	}
+	s.DB = conn
+	return s
+}
+
+// InTx runs fn in a transaction; statements inside it are matched like any
+// other.
+func (s *StubDB) InTx(fn func(tx db.Database) error) error {
+	return db.InTx(s.DB, fn)
+}
+
+// Expect registers a statement whose normalized text contains query
+func (s *StubDB) Expect(query string) *StubStatement {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	st := &StubStatement{query: normalizeQuery(query)}
+	s.expected = append(s.expected, st)
+	return st
+}
+
+// WillReturnRows sets the rows returned by the statement
+func (st *StubStatement) WillReturnRows(columns []string, rows ...[]driver.Value) *StubStatement {
+	st.columns = columns
+	st.rows = rows
+	return st
+}
+
+// WillReturnResult sets the number of rows affected by the statement
+func (st *StubStatement) WillReturnResult(rowsAffected int64) *StubStatement {
+	st.rowsAffected = rowsAffected
+	return st
+}
+
+// WillReturnError makes the statement fail with err
+func (st *StubStatement) WillReturnError(err error) *StubStatement {
+	st.err = err
+	return st
+}
+
+// Args returns the arguments the statement was executed with
+func (st *StubStatement) Args() []driver.Value {
+	return st.args
+}
+
+// ExpectationsWereMet reports statements that were registered but never executed
+func (s *StubDB) ExpectationsWereMet() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var missing []string
+	for _, st := range s.expected {
+		if !st.used {
+			missing = append(missing, st.query)
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("statements not executed: %s", strings.Join(missing, "; "))
+	}
+	return nil
+}
+
+func (s *StubDB) match(query string, args []driver.NamedValue) (*StubStatement, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	normalized := normalizeQuery(query)
+	for _, st := range s.expected {
+		if st.used || !strings.Contains(normalized, st.query) {
+			continue
+		}
+		st.used = true
+		for _, a := range args {
+			st.args = append(st.args, a.Value)
+		}
+		return st, nil
+	}
+	return nil, fmt.Errorf("unexpected statement: %s", normalized)
+}
+
+func normalizeQuery(query string) string {
+	return strings.Join(strings.Fields(query), " ")
+}
+
+type stubDriver struct{}
+
+func (stubDriver) Open(name string) (driver.Conn, error) {
+	v, ok := stubRegistry.Load(name)
+	if !ok {
+		return nil, fmt.Errorf("unknown stub database %q", name)
+	}
+	return &stubConn{db: v.(*StubDB)}, nil
+}
+
+type stubConn struct {
+	db *StubDB
+}
+
+func (c *stubConn) Prepare(query string) (driver.Stmt, error) {
+	return nil, fmt.Errorf("prepared statements are not supported")
+}
+
+func (c *stubConn) Close() error { return nil }
+
+func (c *stubConn) Begin() (driver.Tx, error) { return stubTx{}, nil }
+
+func (c *stubConn) CheckNamedValue(*driver.NamedValue) error { return nil }
+
+func (c *stubConn) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	st, err := c.db.match(query, args)
+	if err != nil {
+		return nil, err
+	}
+	if st.err != nil {
+		return nil, st.err
+	}
+	return &stubRows{columns: st.columns, rows: st.rows}, nil
+}
+
+func (c *stubConn) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	st, err := c.db.match(query, args)
+	if err != nil {
+		return nil, err
+	}
+	if st.err != nil {
+		return nil, st.err
+	}
+	return driver.RowsAffected(st.rowsAffected), nil
+}
+
+type stubTx struct{}
+
+func (stubTx) Commit() error   { return nil }
+func (stubTx) Rollback() error { return nil }
+
+type stubRows struct {
+	columns []string
+	rows    [][]driver.Value
+	pos     int
+}
+
+func (r *stubRows) Columns() []string { return r.columns }
+
+func (r *stubRows) Close() error { return nil }
+
+func (r *stubRows) Next(dest []driver.Value) error {
+	if r.pos >= len(r.rows) {
+		return io.EOF
+	}
+	copy(dest, r.rows[r.pos])
+	r.pos++
+	return nil
+}
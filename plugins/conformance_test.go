@@ -0,0 +1,122 @@
+// plugins/conformance_test.go
+package plugins
+
+import (
+	"context"
+	"testing"
+
+	"github.com/moos3/sparta/internal/config"
+	"github.com/moos3/sparta/internal/interfaces"
+	"github.com/moos3/sparta/pluginstest"
+	"github.com/moos3/sparta/proto"
+)
+
+func TestCrtShConformance(t *testing.T) {
+	cfg := &config.Config{}
+	pluginstest.StubProvider(t, cfg, "crtsh", pluginstest.JSON(`[{
+		"id": 1, "common_name": "example.com", "issuer_name": "C=US, O=Let's Encrypt",
+		"not_before": "2026-01-01T00:00:00", "not_after": "2026-04-01T00:00:00",
+		"serial_number": "04a1", "name_value": "example.com\nwww.example.com"
+	}]`))
+	pluginstest.Run(t, pluginstest.Case{
+		Name:   "ScanCrtSh",
+		New:    func() interfaces.GenericPlugin { return &ScanCrtShPlugin{} },
+		Config: cfg,
+	})
+}
+
+func TestAbuseChConformance(t *testing.T) {
+	cfg := &config.Config{}
+	pluginstest.StubProvider(t, cfg, "abuse_ch", pluginstest.JSON(`{"query_status": "ok", "data": [{
+		"ioc": "example.com", "ioc_type": "domain", "threat_type": "botnet_cc", "confidence": 75,
+		"first_seen": "2026-01-01 00:00:00", "last_seen": "2026-02-01 00:00:00", "tags": ["c2"]
+	}]}`))
+	pluginstest.Run(t, pluginstest.Case{
+		Name:   "ScanAbuseCh",
+		New:    func() interfaces.GenericPlugin { return &ScanAbuseChPlugin{} },
+		Config: cfg,
+	})
+}
+
+func TestChaosConformance(t *testing.T) {
+	cfg := &config.Config{}
+	cfg.Chaos.APIKey = "test-key"
+	pluginstest.StubProvider(t, cfg, "chaos", pluginstest.JSON(`{"domain": "example.com", "subdomains": ["www", "mail"], "count": 2}`))
+	pluginstest.Run(t, pluginstest.Case{
+		Name:   "ScanChaos",
+		New:    func() interfaces.GenericPlugin { return &ScanChaosPlugin{} },
+		Config: cfg,
+	})
+}
+
+func TestOTXConformance(t *testing.T) {
+	cfg := &config.Config{}
+	cfg.OTX.APIKey = "test-key"
+	cfg.IntelCache.TTL = -1
+	pluginstest.StubProvider(t, cfg, "otx", pluginstest.JSON(`{"pulse_info": {"count": 0}, "data": []}`))
+	pluginstest.Run(t, pluginstest.Case{
+		Name:    "ScanOTX",
+		New:     func() interfaces.GenericPlugin { return &ScanOTXPlugin{} },
+		Config:  cfg,
+		Request: interfaces.ScanRequest{Inputs: map[interfaces.Artifact][]string{interfaces.ArtifactIPs: {"192.0.2.1"}}},
+	})
+}
+
+func TestISCConformance(t *testing.T) {
+	cfg := &config.Config{}
+	cfg.ISC.APIKey = "test-key"
+	cfg.ISC.RequestDelay = 1
+	pluginstest.StubProvider(t, cfg, "isc", pluginstest.JSON(`{}`))
+	pluginstest.Run(t, pluginstest.Case{
+		Name:    "ScanISC",
+		New:     func() interfaces.GenericPlugin { return &ScanISCPlugin{} },
+		Config:  cfg,
+		Request: interfaces.ScanRequest{Inputs: map[interfaces.Artifact][]string{interfaces.ArtifactIPs: {"192.0.2.1"}}},
+	})
+}
+
+func TestDNSConformance(t *testing.T) {
+	cfg := &config.Config{}
+	pluginstest.StubDNS(t, cfg, pluginstest.Zone(t,
+		"example.com. 300 IN A 192.0.2.1",
+		"example.com. 300 IN MX 10 mail.example.com.",
+		"example.com. 300 IN NS ns1.example.com.",
+		`example.com. 300 IN TXT "v=spf1 mx -all"`,
+		`_dmarc.example.com. 300 IN TXT "v=DMARC1; p=reject; rua=mailto:dmarc@example.com"`,
+		txtRecord("selector1._domainkey.example.com.", "v=DKIM1; k=ed25519; p="+ed25519Key(t)),
+	))
+	pluginstest.Run(t, pluginstest.Case{
+		Name:   "ScanDNS",
+		New:    func() interfaces.GenericPlugin { return &ScanDNSPlugin{} },
+		Config: cfg,
+	})
+
+	t.Run("Records", func(t *testing.T) {
+		p := &ScanDNSPlugin{}
+		p.SetDatabase(pluginstest.NewMemoryDB())
+		if err := p.SetConfig(cfg); err != nil {
+			t.Fatalf("SetConfig: %v", err)
+		}
+		if err := p.Initialize(); err != nil {
+			t.Fatalf("Initialize: %v", err)
+		}
+		res, err := p.Scan(context.Background(), interfaces.ScanRequest{
+			Domain:  "example.com",
+			RunID:   "run-1",
+			Options: map[string]string{"dnssec": "false"},
+		})
+		if err != nil {
+			t.Fatalf("Scan: %v", err)
+		}
+		result := res.Result.(*proto.DNSSecurityResult)
+		if !result.SpfValid {
+			t.Errorf("SPF record %q reported invalid", result.SpfRecord)
+		}
+		if !result.DmarcValid || result.DmarcPolicy != "reject" {
+			t.Errorf("DMARC valid %v with policy %q, want valid with policy reject (%s)", result.DmarcValid, result.DmarcPolicy, result.DmarcValidationError)
+		}
+		if len(result.Errors) > 0 {
+			t.Errorf("scan reported errors %v", result.Errors)
+		}
+	})
+}
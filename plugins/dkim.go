@@ -0,0 +1,328 @@
+// plugins/dkim.go
+package plugins
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+
+	"github.com/miekg/dns"
+	"github.com/moos3/sparta/internal/resolver"
+	"github.com/moos3/sparta/proto"
+)
+
+// DefaultDKIMSelectors is the dictionary of selectors tried on every domain
+// when dns.dkim_selectors is empty: the defaults of common mail providers,
+// mailing services and signing software.
+var DefaultDKIMSelectors = []string{
+	"default", "dkim", "mail", "email", "smtp", "key1", "key2",
+	"google", "selector1", "selector2", "k1", "k2", "k3", "s1", "s2",
+	"s1024", "s2048", "sig1", "mandrill", "mxvault", "mailjet", "smtpapi",
+	"amazonses", "everlytickey1", "everlytickey2", "zoho", "zmail",
+	"protonmail", "protonmail2", "protonmail3", "fm1", "fm2", "fm3",
+	"mesmtp", "cm", "pm",
+}
+
+// Where a selector came from, in the order selectors are tried
+const (
+	dkimSourceRemembered = "remembered" // found on an earlier scan of the domain
+	dkimSourceOption     = "option"     // listed in the scan's dkim_selectors option
+	dkimSourceDictionary = "dictionary" // dns.dkim_selectors or DefaultDKIMSelectors
+)
+
+// dkimWorkers bounds the selector queries in flight for one domain.
+const dkimWorkers = 8
+
+// minRSABits is the smallest RSA key RFC 8301 lets verifiers accept.
+const minRSABits = 1024
+
+// selectorPattern matches a selector: one or more dot-separated DNS labels.
+var selectorPattern = regexp.MustCompile(`^[a-z0-9_]([a-z0-9_-]{0,61}[a-z0-9_])?(\.[a-z0-9_]([a-z0-9_-]{0,61}[a-z0-9_])?)*$`)
+
+// dkimCandidate is a selector to query and where it came from.
+type dkimCandidate struct {
+	selector string
+	source   string
+}
+
+// dkimCandidates merges the selectors to try, in the order given, dropping
+// duplicates and names that are not valid in DNS.
+func dkimCandidates(remembered, option, dictionary []string) []dkimCandidate {
+	seen := make(map[string]bool)
+	var candidates []dkimCandidate
+	add := func(selectors []string, source string) {
+		for _, s := range selectors {
+			s = strings.Trim(strings.ToLower(strings.TrimSpace(s)), ".")
+			if s == "" || seen[s] {
+				continue
+			}
+			if !selectorPattern.MatchString(s) {
+				log.Printf("Skipping invalid DKIM selector %q", s)
+				continue
+			}
+			seen[s] = true
+			candidates = append(candidates, dkimCandidate{selector: s, source: source})
+		}
+	}
+	add(remembered, dkimSourceRemembered)
+	add(option, dkimSourceOption)
+	add(dictionary, dkimSourceDictionary)
+	return candidates
+}
+
+// splitSelectors splits the dkim_selectors scan option on commas and spaces.
+func splitSelectors(option string) []string {
+	return strings.FieldsFunc(option, func(r rune) bool {
+		return r == ',' || unicode.IsSpace(r)
+	})
+}
+
+// dkimDictionary returns the selectors tried on every domain.
+func (p *ScanDNSPlugin) dkimDictionary() []string {
+	if p.config != nil && len(p.config.DNS.DKIMSelectors) > 0 {
+		return p.config.DNS.DKIMSelectors
+	}
+	return DefaultDKIMSelectors
+}
+
+// rememberedSelectors returns the selectors found on earlier scans of domain,
+// most recently seen first.
+func (p *ScanDNSPlugin) rememberedSelectors(domain string) []string {
+	if p.db == nil {
+		return nil
+	}
+	rows, err := p.db.Query(`
+		SELECT selector FROM dkim_selectors
+		WHERE domain = $1
+		ORDER BY last_seen DESC
+	`, strings.TrimSuffix(domain, "."))
+	if err != nil {
+		log.Printf("Failed to load DKIM selectors for %s: %v", domain, err)
+		return nil
+	}
+	defer rows.Close()
+	var selectors []string
+	for rows.Next() {
+		var s string
+		if err := rows.Scan(&s); err != nil {
+			log.Printf("Failed to load DKIM selectors for %s: %v", domain, err)
+			return selectors
+		}
+		selectors = append(selectors, s)
+	}
+	return selectors
+}
+
+// rememberSelectors records the selectors that published a key on domain so
+// its next scan tries them first.
+func (p *ScanDNSPlugin) rememberSelectors(domain string, selectors []*proto.DKIMSelector) {
+	if p.db == nil {
+		return
+	}
+	domain = strings.TrimSuffix(domain, ".")
+	for _, s := range selectors {
+		_, err := p.db.Exec(`
+			INSERT INTO dkim_selectors (domain, selector, last_seen)
+			VALUES ($1, $2, $3)
+			ON CONFLICT (domain, selector) DO UPDATE SET last_seen = EXCLUDED.last_seen
+		`, domain, s.Selector, time.Now())
+		if err != nil {
+			log.Printf("Failed to remember DKIM selector %s for %s: %v", s.Selector, domain, err)
+		}
+	}
+}
+
+// discoverDKIM queries <selector>._domainkey.<domain> for every candidate and
+// returns the selectors that publish a DKIM record, validated, in candidate
+// order. It fails only if no query was answered.
+func discoverDKIM(ctx context.Context, rs *resolver.Resolver, domain string, candidates []dkimCandidate) ([]*proto.DKIMSelector, error) {
+	found := make([]*proto.DKIMSelector, len(candidates))
+	errs := make([]error, len(candidates))
+	sem := make(chan struct{}, dkimWorkers)
+	var wg sync.WaitGroup
+	for i, c := range candidates {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int, c dkimCandidate) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			found[i], errs[i] = lookupDKIMSelector(ctx, rs, domain, c)
+		}(i, c)
+	}
+	wg.Wait()
+
+	var selectors []*proto.DKIMSelector
+	var firstErr error
+	answered := false
+	for i := range candidates {
+		if errs[i] != nil {
+			if firstErr == nil {
+				firstErr = errs[i]
+			}
+			continue
+		}
+		answered = true
+		if found[i] != nil {
+			selectors = append(selectors, found[i])
+		}
+	}
+	if !answered && firstErr != nil {
+		return nil, firstErr
+	}
+	return selectors, nil
+}
+
+// lookupDKIMSelector returns the DKIM record published under one selector,
+// or nil if there is none.
+func lookupDKIMSelector(ctx context.Context, rs *resolver.Resolver, domain string, c dkimCandidate) (*proto.DKIMSelector, error) {
+	m := new(dns.Msg)
+	m.SetQuestion(dns.Fqdn(c.selector+"._domainkey."+strings.TrimSuffix(domain, ".")), dns.TypeTXT)
+	r, err := rs.Exchange(ctx, m)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, ans := range r.Answer {
+		txt, ok := ans.(*dns.TXT)
+		if !ok {
+			continue
+		}
+		// Keys longer than 255 bytes are split across strings
+		record := strings.Join(txt.Txt, "")
+		if !isDKIMRecord(record) {
+			continue
+		}
+		keyType, bits, validationError := validateDKIMRecord(record)
+		return &proto.DKIMSelector{
+			Selector:        c.selector,
+			Record:          record,
+			Valid:           validationError == "",
+			ValidationError: validationError,
+			KeyType:         keyType,
+			KeyBits:         int32(bits),
+			Source:          c.source,
+		}, nil
+	}
+	return nil, nil
+}
+
+// recordTags parses the tag=value list of a DKIM, MTA-STS, TLS-RPT or BIMI
+// record.
+func recordTags(record string) map[string]string {
+	tags := make(map[string]string)
+	for _, part := range strings.Split(record, ";") {
+		name, value, ok := strings.Cut(part, "=")
+		if !ok {
+			continue
+		}
+		tags[strings.TrimSpace(name)] = strings.TrimSpace(value)
+	}
+	return tags
+}
+
+// isDKIMRecord reports whether a TXT record is a DKIM key record. The v= tag
+// is optional, so records without it count if they carry a p= tag.
+func isDKIMRecord(record string) bool {
+	if strings.HasPrefix(record, "v=DKIM1") {
+		return true
+	}
+	_, ok := recordTags(record)["p"]
+	return ok
+}
+
+// validateDKIMRecord checks DKIM record format and public key, returning the
+// key type and size and why the record is invalid, if it is.
+func validateDKIMRecord(record string) (string, int, string) {
+	tags := recordTags(record)
+	if v, ok := tags["v"]; ok && v != "DKIM1" {
+		return "", 0, "Invalid DKIM version"
+	}
+
+	keyType := tags["k"]
+	if keyType == "" {
+		keyType = "rsa"
+	}
+	pubKey, ok := tags["p"]
+	if !ok {
+		return keyType, 0, "Missing public key"
+	}
+	// The key may be folded with whitespace
+	pubKey = strings.Join(strings.Fields(pubKey), "")
+	if pubKey == "" {
+		return keyType, 0, "Key revoked (empty p=)"
+	}
+
+	// Decode and validate public key
+	pubKeyBytes, err := base64.StdEncoding.DecodeString(pubKey)
+	if err != nil {
+		return keyType, 0, "Invalid public key encoding: " + err.Error()
+	}
+	switch keyType {
+	case "rsa":
+		key, err := parseRSAPublicKey(pubKeyBytes)
+		if err != nil {
+			return keyType, 0, "Invalid public key format: " + err.Error()
+		}
+		bits := key.N.BitLen()
+		if bits < minRSABits {
+			return keyType, bits, fmt.Sprintf("RSA key too short: %d bits, need at least %d", bits, minRSABits)
+		}
+		return keyType, bits, ""
+	case "ed25519":
+		// RFC 8463 publishes the raw 32-byte key rather than a PKIX structure
+		if len(pubKeyBytes) != ed25519.PublicKeySize {
+			return keyType, 0, fmt.Sprintf("Invalid public key format: ed25519 key is %d bytes, want %d", len(pubKeyBytes), ed25519.PublicKeySize)
+		}
+		return keyType, ed25519.PublicKeySize * 8, ""
+	default:
+		return keyType, 0, "Unsupported key type: " + keyType
+	}
+}
+
+// parseRSAPublicKey parses an RSA key published as a SubjectPublicKeyInfo
+// or, as some signers do, a bare PKCS #1 RSAPublicKey.
+func parseRSAPublicKey(der []byte) (*rsa.PublicKey, error) {
+	key, err := x509.ParsePKIXPublicKey(der)
+	if err != nil {
+		if pkcs1, pkcs1Err := x509.ParsePKCS1PublicKey(der); pkcs1Err == nil {
+			return pkcs1, nil
+		}
+		return nil, err
+	}
+	rsaKey, ok := key.(*rsa.PublicKey)
+	if !ok {
+		return nil, fmt.Errorf("key is %T, not RSA", key)
+	}
+	return rsaKey, nil
+}
+
+// summarizeDKIM fills in the DKIM fields of result from the selectors found
+// after trying tried of them. The single-record fields describe the first
+// valid key, or the first key found if none is valid.
+func summarizeDKIM(result *proto.DNSSecurityResult, selectors []*proto.DKIMSelector, tried int) {
+	result.DkimSelectors = selectors
+	result.DkimSelectorsTried = int32(tried)
+	if len(selectors) == 0 {
+		result.DkimValidationError = fmt.Sprintf("No DKIM record found for %d selectors", tried)
+		return
+	}
+	summary := selectors[0]
+	for _, s := range selectors {
+		if s.Valid {
+			summary = s
+			break
+		}
+	}
+	result.DkimRecord = summary.Record
+	result.DkimValid = summary.Valid
+	result.DkimValidationError = summary.ValidationError
+}
@@ -0,0 +1,164 @@
+// plugins/dkim_test.go
+package plugins
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"fmt"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/moos3/sparta/internal/config"
+	"github.com/moos3/sparta/internal/interfaces"
+	"github.com/moos3/sparta/pluginstest"
+	"github.com/moos3/sparta/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// rsaKey returns a freshly generated RSA public key of the given size as
+// base64 SubjectPublicKeyInfo.
+func rsaKey(t *testing.T, bits int) string {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, bits)
+	require.NoError(t, err)
+	der, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	require.NoError(t, err)
+	return base64.StdEncoding.EncodeToString(der)
+}
+
+func ed25519Key(t *testing.T) string {
+	t.Helper()
+	pub, _, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	return base64.StdEncoding.EncodeToString(pub)
+}
+
+// txtRecord returns a zone-file TXT record for value, split into strings
+// short enough for DNS.
+func txtRecord(name, value string) string {
+	var parts []string
+	for len(value) > 200 {
+		parts = append(parts, `"`+value[:200]+`"`)
+		value = value[200:]
+	}
+	parts = append(parts, `"`+value+`"`)
+	return fmt.Sprintf("%s 300 IN TXT %s", name, strings.Join(parts, " "))
+}
+
+func TestValidateDKIMRecord(t *testing.T) {
+	rsa2048 := rsaKey(t, 2048)
+	// Too short to generate, so built by hand as a bare PKCS #1 key
+	short := base64.StdEncoding.EncodeToString(x509.MarshalPKCS1PublicKey(&rsa.PublicKey{
+		N: new(big.Int).Lsh(big.NewInt(1), 511), E: 65537,
+	}))
+
+	tests := []struct {
+		name    string
+		record  string
+		keyType string
+		bits    int
+		err     string
+	}{
+		{"RSA", "v=DKIM1; k=rsa; p=" + rsa2048, "rsa", 2048, ""},
+		{"DefaultKeyType", "v=DKIM1; p=" + rsa2048, "rsa", 2048, ""},
+		{"Ed25519", "v=DKIM1; k=ed25519; p=" + ed25519Key(t), "ed25519", 256, ""},
+		{"Ed25519WrongSize", "v=DKIM1; k=ed25519; p=" + rsa2048, "ed25519", 0, "Invalid public key format: ed25519 key is 294 bytes, want 32"},
+		{"ShortRSA", "v=DKIM1; k=rsa; p=" + short, "rsa", 512, "RSA key too short: 512 bits, need at least 1024"},
+		{"Revoked", "v=DKIM1; k=rsa; p=", "rsa", 0, "Key revoked (empty p=)"},
+		{"MissingKey", "v=DKIM1; k=rsa", "rsa", 0, "Missing public key"},
+		{"UnsupportedKeyType", "v=DKIM1; k=dsa; p=" + rsa2048, "dsa", 0, "Unsupported key type: dsa"},
+		{"BadVersion", "v=DKIM2; p=" + rsa2048, "", 0, "Invalid DKIM version"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			keyType, bits, err := validateDKIMRecord(tt.record)
+			assert.Equal(t, tt.keyType, keyType)
+			assert.Equal(t, tt.bits, bits)
+			assert.Equal(t, tt.err, err)
+		})
+	}
+
+	_, _, err := validateDKIMRecord("v=DKIM1; p=!!")
+	assert.Contains(t, err, "Invalid public key encoding")
+}
+
+func TestDKIMCandidates(t *testing.T) {
+	candidates := dkimCandidates([]string{"s1"}, splitSelectors("Custom, s1 bad/name"), []string{"default", "custom", "s2"})
+	assert.Equal(t, []dkimCandidate{
+		{"s1", dkimSourceRemembered},
+		{"custom", dkimSourceOption},
+		{"default", dkimSourceDictionary},
+		{"s2", dkimSourceDictionary},
+	}, candidates)
+}
+
+func TestDKIMDiscovery(t *testing.T) {
+	cfg := &config.Config{}
+	cfg.DNS.DKIMSelectors = []string{"default", "selector1", "missing"}
+	rsaRecord := "v=DKIM1; k=rsa; p=" + rsaKey(t, 2048)
+	edRecord := "v=DKIM1; k=ed25519; p=" + ed25519Key(t)
+	pluginstest.StubDNS(t, cfg, pluginstest.Zone(t,
+		"example.com. 300 IN A 192.0.2.1",
+		txtRecord("selector1._domainkey.example.com.", rsaRecord),
+		txtRecord("custom._domainkey.example.com.", edRecord),
+		`default._domainkey.example.com. 300 IN TXT "v=DKIM1; p="`,
+	))
+
+	db := pluginstest.NewMemoryDB()
+	p := &ScanDNSPlugin{}
+	p.SetDatabase(db)
+	require.NoError(t, p.SetConfig(cfg))
+	require.NoError(t, p.Initialize())
+
+	res, err := p.Scan(context.Background(), interfaces.ScanRequest{
+		Domain:  "example.com",
+		RunID:   "run-1",
+		Options: map[string]string{"dkim_selectors": "custom,selector1", "dnssec": "false"},
+	})
+	require.NoError(t, err)
+	result := res.Result.(*proto.DNSSecurityResult)
+
+	assert.Equal(t, int32(4), result.DkimSelectorsTried)
+	require.Len(t, result.DkimSelectors, 3)
+	custom, selector1, def := result.DkimSelectors[0], result.DkimSelectors[1], result.DkimSelectors[2]
+	assert.Equal(t, "custom", custom.Selector)
+	assert.Equal(t, dkimSourceOption, custom.Source)
+	assert.Equal(t, "ed25519", custom.KeyType)
+	assert.Equal(t, int32(256), custom.KeyBits)
+	assert.True(t, custom.Valid)
+
+	assert.Equal(t, "selector1", selector1.Selector)
+	assert.Equal(t, rsaRecord, selector1.Record)
+	assert.Equal(t, int32(2048), selector1.KeyBits)
+	assert.True(t, selector1.Valid)
+
+	assert.Equal(t, "default", def.Selector)
+	assert.Equal(t, dkimSourceDictionary, def.Source)
+	assert.False(t, def.Valid)
+	assert.Equal(t, "Key revoked (empty p=)", def.ValidationError)
+
+	// The summary fields describe the first valid key
+	assert.True(t, result.DkimValid)
+	assert.Equal(t, edRecord, result.DkimRecord)
+	assert.Empty(t, result.DkimValidationError)
+
+	var remembered []string
+	for _, row := range db.Rows("dkim_selectors") {
+		assert.Equal(t, "example.com", row["domain"])
+		remembered = append(remembered, row["selector"].(string))
+	}
+	assert.Equal(t, []string{"custom", "selector1", "default"}, remembered)
+}
+
+func TestSummarizeDKIMNoneFound(t *testing.T) {
+	result := &proto.DNSSecurityResult{}
+	summarizeDKIM(result, nil, 36)
+	assert.False(t, result.DkimValid)
+	assert.Equal(t, "No DKIM record found for 36 selectors", result.DkimValidationError)
+}
@@ -0,0 +1,426 @@
+// plugins/dnssec.go
+package plugins
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/miekg/dns"
+	"github.com/moos3/sparta/internal/resolver"
+	"github.com/moos3/sparta/proto"
+)
+
+// RootTrustAnchors are the DS records of the IANA root zone KSKs, from
+// https://data.iana.org/root-anchors/root-anchors.xml, used when
+// dns.trust_anchors is empty.
+var RootTrustAnchors = []string{
+	". IN DS 20326 8 2 E06D44B80B8F1D39A95C0B0D7C65D08458E880409BBC683457104237C7F8EC8D",
+	". IN DS 38696 8 2 683D2D0ACB8C9B712A1948B27F741219298D0A450D612C483AF444A4C0FB2B16",
+}
+
+// Zone statuses along the chain of trust
+const (
+	dnssecSecure   = "secure"
+	dnssecInsecure = "insecure"
+	dnssecBogus    = "bogus"
+)
+
+// Finding severities
+const (
+	severityCritical = "critical"
+	severityHigh     = "high"
+	severityMedium   = "medium"
+	severityLow      = "low"
+)
+
+// minDNSSECRSABits is the smallest RSA zone key not reported as weak.
+const minDNSSECRSABits = 2048
+
+// weakAlgorithms are the DNSKEY algorithms RFC 8624 says not to sign with.
+var weakAlgorithms = map[uint8]string{
+	dns.RSAMD5:           severityCritical,
+	dns.DSA:              severityHigh,
+	dns.DSANSEC3SHA1:     severityHigh,
+	dns.RSASHA1:          severityHigh,
+	dns.RSASHA1NSEC3SHA1: severityHigh,
+	dns.ECCGOST:          severityMedium,
+}
+
+// parseTrustAnchors parses DS records in zone file syntax, defaulting to
+// RootTrustAnchors.
+func parseTrustAnchors(records []string) ([]*dns.DS, error) {
+	if len(records) == 0 {
+		records = RootTrustAnchors
+	}
+	var anchors []*dns.DS
+	for _, record := range records {
+		rr, err := dns.NewRR(record)
+		if err != nil {
+			return nil, fmt.Errorf("invalid trust anchor %q: %w", record, err)
+		}
+		ds, ok := rr.(*dns.DS)
+		if !ok || ds.Hdr.Name != "." {
+			return nil, fmt.Errorf("trust anchor %q is not a DS record for the root zone", record)
+		}
+		anchors = append(anchors, ds)
+	}
+	return anchors, nil
+}
+
+// dnssecValidator walks the chain of trust from the root trust anchors
+// down through each zone cut to a domain, recording every zone and problem
+// it finds on the way.
+type dnssecValidator struct {
+	ctx     context.Context
+	rs      *resolver.Resolver
+	anchors []*dns.DS
+	now     time.Time
+	result  *proto.DNSSecurityResult
+}
+
+// validateDNSSEC validates domain's chain of trust and fills in the DNSSEC
+// fields of result. DnssecEnabled is set when the domain's zone is signed,
+// DnssecValid only when every zone from the root down and the domain's
+// addresses validate. It fails if the resolver cannot be queried or strips
+// the DNSSEC records validation needs.
+func validateDNSSEC(ctx context.Context, rs *resolver.Resolver, anchors []*dns.DS, domain string, result *proto.DNSSecurityResult) error {
+	v := &dnssecValidator{ctx: ctx, rs: rs, anchors: anchors, now: time.Now(), result: result}
+	enabled, valid, err := v.walk(dns.Fqdn(domain))
+	if err != nil {
+		return err
+	}
+	result.DnssecEnabled = enabled
+	result.DnssecValid = valid
+	if !valid {
+		result.DnssecValidationError = v.reason(enabled)
+	}
+	return nil
+}
+
+// reason summarises why validation did not succeed.
+func (v *dnssecValidator) reason(enabled bool) string {
+	for _, severity := range []string{severityCritical, severityHigh} {
+		for _, f := range v.result.DnssecFindings {
+			if f.Severity == severity {
+				return f.Message
+			}
+		}
+	}
+	if !enabled {
+		return "No DS or DNSKEY records found"
+	}
+	return "DNSSEC validation failed"
+}
+
+func (v *dnssecValidator) finding(zone, code, severity, format string, args ...interface{}) {
+	v.result.DnssecFindings = append(v.result.DnssecFindings, &proto.DNSSECFinding{
+		Zone:     zone,
+		Code:     code,
+		Severity: severity,
+		Message:  fmt.Sprintf(format, args...),
+	})
+}
+
+// query sends a DNSSEC-enabled query with checking disabled, so a
+// validating resolver hands back data it would reject for us to diagnose.
+func (v *dnssecValidator) query(name string, qtype uint16) (*dns.Msg, error) {
+	m := new(dns.Msg)
+	m.SetQuestion(name, qtype)
+	m.SetEdns0(4096, true)
+	m.CheckingDisabled = true
+	r, err := v.rs.Exchange(v.ctx, m)
+	if err != nil {
+		return nil, err
+	}
+	if r.Rcode != dns.RcodeSuccess && r.Rcode != dns.RcodeNameError {
+		return nil, fmt.Errorf("%s %s query answered %s", name, dns.TypeToString[qtype], dns.RcodeToString[r.Rcode])
+	}
+	return r, nil
+}
+
+// rrset returns the records of type qtype owned by name in rrs and the
+// signatures covering them.
+func rrset(rrs []dns.RR, name string, qtype uint16) ([]dns.RR, []*dns.RRSIG) {
+	var set []dns.RR
+	var sigs []*dns.RRSIG
+	for _, rr := range rrs {
+		if !strings.EqualFold(rr.Header().Name, name) {
+			continue
+		}
+		if sig, ok := rr.(*dns.RRSIG); ok {
+			if sig.TypeCovered == qtype {
+				sigs = append(sigs, sig)
+			}
+		} else if rr.Header().Rrtype == qtype {
+			set = append(set, rr)
+		}
+	}
+	return set, sigs
+}
+
+// walk validates each zone from the root to the one holding domain, then
+// domain's addresses. It reports whether domain's zone is signed and
+// whether everything validated.
+func (v *dnssecValidator) walk(domain string) (bool, bool, error) {
+	keys, status, err := v.zoneKeys(".", v.anchors)
+	if err != nil {
+		return false, false, err
+	}
+	if status != dnssecSecure {
+		return true, false, nil
+	}
+
+	zone := "."
+	labels := dns.SplitDomainName(domain)
+	for i := len(labels) - 1; i >= 0; i-- {
+		name := dns.Fqdn(strings.Join(labels[i:], "."))
+		resp, err := v.query(name, dns.TypeDS)
+		if err != nil {
+			return false, false, err
+		}
+		ds, sigs := rrset(resp.Answer, name, dns.TypeDS)
+		if len(ds) == 0 {
+			// No DS: name is inside zone, or an unsigned delegation from it
+			apex, signed, err := v.apex(name)
+			if err != nil {
+				return false, false, err
+			}
+			if !apex {
+				continue
+			}
+			v.result.DnssecChain = append(v.result.DnssecChain, &proto.DNSSECZone{Zone: name, Status: dnssecInsecure})
+			if signed {
+				v.finding(name, "missing_ds", severityHigh, "%s is signed but %s has no DS record for it, so its signatures cannot be trusted", name, zone)
+			}
+			return signed, false, nil
+		}
+		if !v.verify(zone, "DS "+name, ds, sigs, keys) {
+			v.result.DnssecChain = append(v.result.DnssecChain, &proto.DNSSECZone{Zone: name, Status: dnssecBogus})
+			return true, false, nil
+		}
+		anchors := make([]*dns.DS, 0, len(ds))
+		for _, rr := range ds {
+			anchors = append(anchors, rr.(*dns.DS))
+		}
+		keys, status, err = v.zoneKeys(name, anchors)
+		if err != nil {
+			return false, false, err
+		}
+		if status != dnssecSecure {
+			return true, false, nil
+		}
+		zone = name
+	}
+
+	// The zone holding domain publishes NSEC records unless it uses NSEC3
+	if last := v.result.DnssecChain[len(v.result.DnssecChain)-1]; last.Denial == "nsec" && zone != "." {
+		v.finding(zone, "nsec_zone_walking", severityLow, "%s uses NSEC, which lets anyone list every name in the zone", zone)
+	}
+
+	resp, err := v.query(domain, dns.TypeA)
+	if err != nil {
+		return false, false, err
+	}
+	if addrs, sigs := rrset(resp.Answer, domain, dns.TypeA); len(addrs) > 0 {
+		if !v.verify(zone, "A "+domain, addrs, sigs, keys) {
+			return true, false, nil
+		}
+	}
+	return true, true, nil
+}
+
+// apex reports whether name is the apex of a zone and whether that zone
+// publishes DNSKEY records.
+func (v *dnssecValidator) apex(name string) (bool, bool, error) {
+	resp, err := v.query(name, dns.TypeSOA)
+	if err != nil {
+		return false, false, err
+	}
+	if soa, _ := rrset(resp.Answer, name, dns.TypeSOA); len(soa) == 0 {
+		return false, false, nil
+	}
+	resp, err = v.query(name, dns.TypeDNSKEY)
+	if err != nil {
+		return false, false, err
+	}
+	keys, _ := rrset(resp.Answer, name, dns.TypeDNSKEY)
+	return true, len(keys) > 0, nil
+}
+
+// zoneKeys fetches zone's DNSKEY records, checks that one matches a DS
+// record from the parent and signs the rest, and returns them with the
+// zone's status.
+func (v *dnssecValidator) zoneKeys(zone string, ds []*dns.DS) ([]*dns.DNSKEY, string, error) {
+	info := &proto.DNSSECZone{Zone: zone, Status: dnssecBogus}
+	v.result.DnssecChain = append(v.result.DnssecChain, info)
+
+	resp, err := v.query(zone, dns.TypeDNSKEY)
+	if err != nil {
+		return nil, "", err
+	}
+	set, sigs := rrset(resp.Answer, zone, dns.TypeDNSKEY)
+	if len(set) == 0 {
+		if zone == "." {
+			return nil, "", fmt.Errorf("resolver returned no DNSKEY records for the root zone; it must support DNSSEC")
+		}
+		v.finding(zone, "dnskey_missing", severityCritical, "%s has DS records in its parent but publishes no DNSKEY records", zone)
+		return nil, dnssecBogus, nil
+	}
+	keys := make([]*dns.DNSKEY, 0, len(set))
+	for _, rr := range set {
+		key := rr.(*dns.DNSKEY)
+		keys = append(keys, key)
+		info.KeyTags = append(info.KeyTags, int32(key.KeyTag()))
+	}
+	v.algorithms(zone, info, keys)
+	if err := v.denial(zone, info); err != nil {
+		return nil, "", err
+	}
+
+	// The DNSKEY set must be signed by a key the parent vouches for
+	var trusted []*dns.DNSKEY
+	var mismatched []string
+	for _, d := range ds {
+		if d.DigestType == dns.SHA1 {
+			v.finding(zone, "weak_digest", severityMedium, "DS %d for %s uses a SHA-1 digest", d.KeyTag, zone)
+		}
+		for _, key := range keys {
+			if key.KeyTag() != d.KeyTag || key.Algorithm != d.Algorithm {
+				continue
+			}
+			digest := key.ToDS(d.DigestType)
+			if digest == nil {
+				v.finding(zone, "unsupported_digest", severityMedium, "DS %d for %s uses unsupported digest type %d", d.KeyTag, zone, d.DigestType)
+			} else if strings.EqualFold(digest.Digest, d.Digest) {
+				trusted = append(trusted, key)
+			} else {
+				mismatched = append(mismatched, fmt.Sprint(d.KeyTag))
+			}
+		}
+	}
+	if len(trusted) == 0 {
+		if len(mismatched) > 0 {
+			v.finding(zone, "ds_mismatch", severityCritical, "digest of DNSKEY %s for %s does not match its DS record", strings.Join(mismatched, ", "), zone)
+		} else {
+			v.finding(zone, "ds_mismatch", severityCritical, "no DNSKEY for %s matches the key tags of its DS records", zone)
+		}
+		return nil, dnssecBogus, nil
+	}
+	if !v.verify(zone, "DNSKEY "+zone, set, sigs, trusted) {
+		return nil, dnssecBogus, nil
+	}
+	info.Status = dnssecSecure
+	return keys, dnssecSecure, nil
+}
+
+// algorithms records zone's key algorithms, reporting deprecated ones and
+// short RSA keys.
+func (v *dnssecValidator) algorithms(zone string, info *proto.DNSSECZone, keys []*dns.DNSKEY) {
+	seen := make(map[uint8]bool)
+	for _, key := range keys {
+		name := dns.AlgorithmToString[key.Algorithm]
+		if name == "" {
+			name = fmt.Sprint(key.Algorithm)
+		}
+		if !seen[key.Algorithm] {
+			seen[key.Algorithm] = true
+			info.Algorithms = append(info.Algorithms, name)
+			if severity, weak := weakAlgorithms[key.Algorithm]; weak {
+				v.finding(zone, "weak_algorithm", severity, "%s signs with %s, which RFC 8624 says not to use", zone, name)
+			}
+		}
+		if bits := rsaKeyBits(key); bits > 0 && bits < minDNSSECRSABits {
+			v.finding(zone, "weak_key", severityMedium, "DNSKEY %d for %s is a %d-bit RSA key", key.KeyTag(), zone, bits)
+		}
+	}
+	sort.Strings(info.Algorithms)
+}
+
+// rsaKeyBits returns the modulus size of an RSA DNSKEY, or 0 for other
+// algorithms.
+func rsaKeyBits(key *dns.DNSKEY) int {
+	switch key.Algorithm {
+	case dns.RSAMD5, dns.RSASHA1, dns.RSASHA1NSEC3SHA1, dns.RSASHA256, dns.RSASHA512:
+	default:
+		return 0
+	}
+	// RFC 3110: exponent length, exponent, modulus
+	raw, err := base64.StdEncoding.DecodeString(key.PublicKey)
+	if err != nil || len(raw) < 3 {
+		return 0
+	}
+	explen, off := int(raw[0]), 1
+	if explen == 0 {
+		explen, off = int(raw[1])<<8|int(raw[2]), 3
+	}
+	if off+explen >= len(raw) {
+		return 0
+	}
+	return new(big.Int).SetBytes(raw[off+explen:]).BitLen()
+}
+
+// denial records how zone proves names do not exist, reporting NSEC3
+// settings RFC 9276 advises against.
+func (v *dnssecValidator) denial(zone string, info *proto.DNSSECZone) error {
+	resp, err := v.query(zone, dns.TypeNSEC3PARAM)
+	if err != nil {
+		return err
+	}
+	params, _ := rrset(resp.Answer, zone, dns.TypeNSEC3PARAM)
+	if len(params) == 0 {
+		info.Denial = "nsec"
+		return nil
+	}
+	p := params[0].(*dns.NSEC3PARAM)
+	info.Denial = "nsec3"
+	info.Nsec3Iterations = int32(p.Iterations)
+	if p.Salt != "-" {
+		info.Nsec3Salt = strings.ToLower(p.Salt)
+	}
+	if p.Iterations > 0 {
+		v.finding(zone, "nsec3_iterations", severityMedium, "%s uses %d NSEC3 iterations; RFC 9276 recommends 0", zone, p.Iterations)
+	}
+	if info.Nsec3Salt != "" {
+		v.finding(zone, "nsec3_salt", severityLow, "%s salts its NSEC3 hashes; RFC 9276 recommends no salt", zone)
+	}
+	return nil
+}
+
+// verify checks that one of sigs, made by a key of zone in keys, is valid
+// now and signs set, recording why not as a finding.
+func (v *dnssecValidator) verify(zone, what string, set []dns.RR, sigs []*dns.RRSIG, keys []*dns.DNSKEY) bool {
+	if len(sigs) == 0 {
+		v.finding(zone, "signature_missing", severityCritical, "%s is not signed", what)
+		return false
+	}
+	code, message := "signature_invalid", fmt.Sprintf("no signature over %s was made by a trusted key of %s", what, zone)
+	for _, sig := range sigs {
+		for _, key := range keys {
+			if key.KeyTag() != sig.KeyTag || key.Algorithm != sig.Algorithm || !strings.EqualFold(sig.SignerName, zone) {
+				continue
+			}
+			if err := sig.Verify(key, set); err != nil {
+				code, message = "signature_invalid", fmt.Sprintf("signature over %s by key %d does not verify: %v", what, sig.KeyTag, err)
+				continue
+			}
+			if !sig.ValidityPeriod(v.now) {
+				inception := time.Unix(int64(sig.Inception), 0).UTC()
+				expiration := time.Unix(int64(sig.Expiration), 0).UTC()
+				if v.now.Before(inception) {
+					code, message = "signature_not_yet_valid", fmt.Sprintf("signature over %s by key %d is not valid until %s", what, sig.KeyTag, inception.Format(time.RFC3339))
+				} else {
+					code, message = "signature_expired", fmt.Sprintf("signature over %s by key %d expired at %s", what, sig.KeyTag, expiration.Format(time.RFC3339))
+				}
+				continue
+			}
+			return true
+		}
+	}
+	v.finding(zone, code, severityCritical, "%s", message)
+	return false
+}
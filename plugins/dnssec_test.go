@@ -0,0 +1,246 @@
+// plugins/dnssec_test.go
+package plugins
+
+import (
+	"context"
+	"crypto"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/miekg/dns"
+	"github.com/moos3/sparta/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testZone is a signed zone with a key-signing and a zone-signing key.
+type testZone struct {
+	ksk, zsk       *dns.DNSKEY
+	kskKey, zskKey crypto.Signer
+}
+
+func newDNSKEY(t *testing.T, name string, flags uint16, alg uint8, bits int) (*dns.DNSKEY, crypto.Signer) {
+	t.Helper()
+	key := &dns.DNSKEY{
+		Hdr:       dns.RR_Header{Name: name, Rrtype: dns.TypeDNSKEY, Class: dns.ClassINET, Ttl: 3600},
+		Flags:     flags,
+		Protocol:  3,
+		Algorithm: alg,
+	}
+	priv, err := key.Generate(bits)
+	require.NoError(t, err)
+	return key, priv.(crypto.Signer)
+}
+
+func newTestZone(t *testing.T, name string, alg uint8, bits int) *testZone {
+	z := &testZone{}
+	z.ksk, z.kskKey = newDNSKEY(t, name, 257, alg, bits)
+	z.zsk, z.zskKey = newDNSKEY(t, name, 256, alg, bits)
+	return z
+}
+
+// treeOptions changes how signedTree builds example.test.
+type treeOptions struct {
+	alg         uint8 // key algorithm, ECDSAP256SHA256 by default
+	bits        int
+	badDigest   bool // the DS in test. has the right key tag but a wrong digest
+	noDS        bool // test. delegates to example.test. without a DS
+	unsigned    bool // example.test. publishes no keys or signatures
+	expired     bool // the signature over the A record has expired
+	nsec3Params string
+}
+
+// signedTree returns the root trust anchor and the records of a root zone,
+// the test. TLD and example.test., each signed and delegated to the next.
+func signedTree(t *testing.T, o treeOptions) (string, []string) {
+	t.Helper()
+	if o.alg == 0 {
+		o.alg, o.bits = dns.ECDSAP256SHA256, 256
+	}
+	now := time.Now()
+	from, until := now.Add(-time.Hour), now.Add(24*time.Hour)
+	root := newTestZone(t, ".", dns.ECDSAP256SHA256, 256)
+	tld := newTestZone(t, "test.", dns.ECDSAP256SHA256, 256)
+	zone := newTestZone(t, "example.test.", o.alg, o.bits)
+
+	var records []string
+	add := func(key *dns.DNSKEY, priv crypto.Signer, from, until time.Time, rrs ...dns.RR) {
+		sig := &dns.RRSIG{
+			Inception:  uint32(from.Unix()),
+			Expiration: uint32(until.Unix()),
+			KeyTag:     key.KeyTag(),
+			SignerName: key.Hdr.Name,
+			Algorithm:  key.Algorithm,
+		}
+		require.NoError(t, sig.Sign(priv, rrs))
+		for _, rr := range rrs {
+			records = append(records, rr.String())
+		}
+		records = append(records, sig.String())
+	}
+	record := func(s string) dns.RR {
+		rr, err := dns.NewRR(s)
+		require.NoError(t, err)
+		return rr
+	}
+
+	add(root.ksk, root.kskKey, from, until, root.ksk, root.zsk)
+	add(tld.ksk, tld.kskKey, from, until, tld.ksk, tld.zsk)
+	add(root.zsk, root.zskKey, from, until, tld.ksk.ToDS(dns.SHA256))
+	records = append(records, "example.test. 300 IN SOA ns.example.test. admin.example.test. 1 3600 600 86400 300")
+	if !o.noDS {
+		ds := zone.ksk.ToDS(dns.SHA256)
+		if o.badDigest {
+			ds.Digest = strings.Repeat("00", 32)
+		}
+		add(tld.zsk, tld.zskKey, from, until, ds)
+	}
+	if o.unsigned {
+		records = append(records, "example.test. 300 IN A 192.0.2.1")
+		return root.ksk.ToDS(dns.SHA256).String(), records
+	}
+	add(zone.ksk, zone.kskKey, from, until, zone.ksk, zone.zsk)
+	if o.expired {
+		from, until = now.Add(-48*time.Hour), now.Add(-24*time.Hour)
+	}
+	add(zone.zsk, zone.zskKey, from, until, record("example.test. 300 IN A 192.0.2.1"))
+	if o.nsec3Params != "" {
+		add(zone.zsk, zone.zskKey, from, until, record("example.test. 300 IN NSEC3PARAM "+o.nsec3Params))
+	}
+	return root.ksk.ToDS(dns.SHA256).String(), records
+}
+
+// validateTree runs DNSSEC validation of the example.test. signedTree
+// builds.
+func validateTree(t *testing.T, o treeOptions) (*proto.DNSSecurityResult, error) {
+	t.Helper()
+	anchor, records := signedTree(t, o)
+	return validate(t, anchor, records)
+}
+
+// validate runs DNSSEC validation of example.test. against records.
+func validate(t *testing.T, anchor string, records []string) (*proto.DNSSecurityResult, error) {
+	t.Helper()
+	anchors, err := parseTrustAnchors([]string{anchor})
+	require.NoError(t, err)
+	result := &proto.DNSSecurityResult{}
+	err = validateDNSSEC(context.Background(), zoneResolver(t, records...), anchors, "example.test.", result)
+	return result, err
+}
+
+// findingCodes lists the findings of result as zone/code.
+func findingCodes(result *proto.DNSSecurityResult) []string {
+	var codes []string
+	for _, f := range result.DnssecFindings {
+		codes = append(codes, f.Zone+"/"+f.Code)
+	}
+	return codes
+}
+
+func TestValidateDNSSEC(t *testing.T) {
+	t.Run("Secure", func(t *testing.T) {
+		result, err := validateTree(t, treeOptions{})
+		require.NoError(t, err)
+		assert.True(t, result.DnssecEnabled)
+		assert.True(t, result.DnssecValid)
+		assert.Empty(t, result.DnssecValidationError)
+		require.Len(t, result.DnssecChain, 3)
+		for i, zone := range []string{".", "test.", "example.test."} {
+			assert.Equal(t, zone, result.DnssecChain[i].Zone)
+			assert.Equal(t, dnssecSecure, result.DnssecChain[i].Status)
+			assert.Equal(t, []string{"ECDSAP256SHA256"}, result.DnssecChain[i].Algorithms)
+			assert.Len(t, result.DnssecChain[i].KeyTags, 2)
+		}
+		assert.Equal(t, []string{"example.test./nsec_zone_walking"}, findingCodes(result))
+	})
+
+	t.Run("DigestMismatch", func(t *testing.T) {
+		result, err := validateTree(t, treeOptions{badDigest: true})
+		require.NoError(t, err)
+		assert.True(t, result.DnssecEnabled)
+		assert.False(t, result.DnssecValid)
+		assert.Equal(t, []string{"example.test./ds_mismatch"}, findingCodes(result))
+		assert.Equal(t, result.DnssecFindings[0].Message, result.DnssecValidationError)
+		assert.Equal(t, severityCritical, result.DnssecFindings[0].Severity)
+		assert.Equal(t, dnssecBogus, result.DnssecChain[2].Status)
+	})
+
+	t.Run("ExpiredSignature", func(t *testing.T) {
+		result, err := validateTree(t, treeOptions{expired: true})
+		require.NoError(t, err)
+		assert.False(t, result.DnssecValid)
+		assert.Contains(t, findingCodes(result), "example.test./signature_expired")
+		assert.Contains(t, result.DnssecValidationError, "signature over A example.test. by key")
+	})
+
+	t.Run("MissingDS", func(t *testing.T) {
+		result, err := validateTree(t, treeOptions{noDS: true})
+		require.NoError(t, err)
+		assert.True(t, result.DnssecEnabled)
+		assert.False(t, result.DnssecValid)
+		assert.Equal(t, []string{"example.test./missing_ds"}, findingCodes(result))
+		assert.Equal(t, dnssecInsecure, result.DnssecChain[2].Status)
+	})
+
+	t.Run("Unsigned", func(t *testing.T) {
+		result, err := validateTree(t, treeOptions{noDS: true, unsigned: true})
+		require.NoError(t, err)
+		assert.False(t, result.DnssecEnabled)
+		assert.False(t, result.DnssecValid)
+		assert.Equal(t, "No DS or DNSKEY records found", result.DnssecValidationError)
+		assert.Empty(t, result.DnssecFindings)
+	})
+
+	t.Run("WeakAlgorithm", func(t *testing.T) {
+		result, err := validateTree(t, treeOptions{alg: dns.RSASHA1, bits: 1024})
+		require.NoError(t, err)
+		assert.True(t, result.DnssecValid)
+		assert.Equal(t, []string{"RSASHA1"}, result.DnssecChain[2].Algorithms)
+		assert.Equal(t, []string{
+			"example.test./weak_algorithm",
+			"example.test./weak_key",
+			"example.test./weak_key",
+			"example.test./nsec_zone_walking",
+		}, findingCodes(result))
+		assert.Equal(t, severityHigh, result.DnssecFindings[0].Severity)
+	})
+
+	t.Run("NSEC3", func(t *testing.T) {
+		result, err := validateTree(t, treeOptions{nsec3Params: "1 0 10 AABB"})
+		require.NoError(t, err)
+		assert.True(t, result.DnssecValid)
+		zone := result.DnssecChain[2]
+		assert.Equal(t, "nsec3", zone.Denial)
+		assert.Equal(t, int32(10), zone.Nsec3Iterations)
+		assert.Equal(t, "aabb", zone.Nsec3Salt)
+		assert.Equal(t, []string{"example.test./nsec3_iterations", "example.test./nsec3_salt"}, findingCodes(result))
+	})
+
+	t.Run("WrongTrustAnchor", func(t *testing.T) {
+		_, records := signedTree(t, treeOptions{})
+		other, _ := signedTree(t, treeOptions{})
+		result, err := validate(t, other, records)
+		require.NoError(t, err)
+		assert.False(t, result.DnssecValid)
+		assert.Equal(t, []string{"./ds_mismatch"}, findingCodes(result))
+	})
+
+	t.Run("ResolverWithoutDNSSEC", func(t *testing.T) {
+		anchor, _ := signedTree(t, treeOptions{})
+		_, err := validate(t, anchor, []string{"example.test. 300 IN A 192.0.2.1"})
+		assert.ErrorContains(t, err, "no DNSKEY records for the root zone")
+	})
+}
+
+func TestParseTrustAnchors(t *testing.T) {
+	anchors, err := parseTrustAnchors(nil)
+	require.NoError(t, err)
+	require.Len(t, anchors, 2)
+	assert.Equal(t, uint16(20326), anchors[0].KeyTag)
+
+	_, err = parseTrustAnchors([]string{"example.com. IN DS 1 8 2 AABB"})
+	assert.ErrorContains(t, err, "not a DS record for the root zone")
+	_, err = parseTrustAnchors([]string{"nonsense"})
+	assert.Error(t, err)
+}
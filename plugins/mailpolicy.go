@@ -0,0 +1,367 @@
+// plugins/mailpolicy.go
+package plugins
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/miekg/dns"
+	"github.com/moos3/sparta/internal/resolver"
+	"github.com/moos3/sparta/proto"
+)
+
+// mtaSTSPolicyURL returns where domain's MTA-STS policy is published.
+// Tests point it at a local server.
+var mtaSTSPolicyURL = func(domain string) string {
+	return "https://mta-sts." + domain + "/.well-known/mta-sts.txt"
+}
+
+// maxMTASTSPolicySize is the largest policy file read, as RFC 8461 suggests.
+const maxMTASTSPolicySize = 64 * 1024
+
+// maxMTASTSMaxAge is the longest max_age RFC 8461 allows, about a year.
+const maxMTASTSMaxAge = 31557600
+
+// maxDANEHosts bounds the MX hosts checked for TLSA records.
+const maxDANEHosts = 10
+
+var mtaSTSIDPattern = regexp.MustCompile(`^[A-Za-z0-9]{1,32}$`)
+
+// txtRecords returns the TXT records at name beginning with prefix, compared
+// case-insensitively, with split strings joined.
+func txtRecords(ctx context.Context, rs *resolver.Resolver, name, prefix string) ([]string, error) {
+	m := new(dns.Msg)
+	m.SetQuestion(dns.Fqdn(name), dns.TypeTXT)
+	r, err := rs.Exchange(ctx, m)
+	if err != nil {
+		return nil, err
+	}
+	var records []string
+	for _, ans := range r.Answer {
+		if txt, ok := ans.(*dns.TXT); ok {
+			record := strings.Join(txt.Txt, "")
+			if strings.HasPrefix(strings.ToLower(record), strings.ToLower(prefix)) {
+				records = append(records, record)
+			}
+		}
+	}
+	return records, nil
+}
+
+// checkMTASTS looks up domain's _mta-sts record and, if fetch is set, its
+// policy, checking that the policy covers every host in mx. It returns nil
+// if the domain publishes no record.
+func checkMTASTS(ctx context.Context, rs *resolver.Resolver, client *http.Client, domain string, mx []string, fetch bool) (*proto.MTASTSResult, error) {
+	records, err := txtRecords(ctx, rs, "_mta-sts."+domain, "v=STSv1")
+	if err != nil {
+		return nil, err
+	}
+	if len(records) == 0 {
+		return nil, nil
+	}
+	res := &proto.MTASTSResult{Record: records[0]}
+	if len(records) > 1 {
+		res.Errors = append(res.Errors, fmt.Sprintf("%d _mta-sts records published, senders ignore them all", len(records)))
+	}
+	res.Id = recordTags(records[0])["id"]
+	if !mtaSTSIDPattern.MatchString(res.Id) {
+		res.Errors = append(res.Errors, fmt.Sprintf("invalid policy id %q: must be 1 to 32 letters and digits", res.Id))
+	}
+	res.RecordValid = len(res.Errors) == 0
+	if !fetch || client == nil {
+		return res, nil
+	}
+
+	policy, err := fetchMTASTSPolicy(ctx, client, domain)
+	if err != nil {
+		res.Errors = append(res.Errors, fmt.Sprintf("failed to fetch policy: %v", err))
+		return res, nil
+	}
+	res.Policy = policy
+	parseMTASTSPolicy(policy, res)
+	if res.Mode != "none" {
+		for _, host := range mx {
+			host = strings.TrimSuffix(host, ".")
+			if host != "" && !mtaSTSCovers(res.MxPatterns, host) {
+				res.UnmatchedMx = append(res.UnmatchedMx, host)
+			}
+		}
+	}
+	return res, nil
+}
+
+// fetchMTASTSPolicy fetches domain's policy file. Redirects are not
+// followed and the certificate must be valid, as RFC 8461 requires.
+func fetchMTASTSPolicy(ctx context.Context, client *http.Client, domain string) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, mtaSTSPolicyURL(domain), nil)
+	if err != nil {
+		return "", err
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("unexpected HTTP status %s", resp.Status)
+	}
+	if mediaType, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type")); err != nil || mediaType != "text/plain" {
+		return "", fmt.Errorf("policy served as %q, not text/plain", resp.Header.Get("Content-Type"))
+	}
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxMTASTSPolicySize+1))
+	if err != nil {
+		return "", fmt.Errorf("failed to read policy: %w", err)
+	}
+	if len(body) > maxMTASTSPolicySize {
+		return "", fmt.Errorf("policy is larger than %d bytes", maxMTASTSPolicySize)
+	}
+	return string(body), nil
+}
+
+// parseMTASTSPolicy fills in res from the key: value lines of a policy.
+func parseMTASTSPolicy(policy string, res *proto.MTASTSResult) {
+	recordErrors := len(res.Errors)
+	var version string
+	hasMaxAge := false
+	for _, line := range strings.Split(policy, "\n") {
+		key, value, ok := strings.Cut(strings.TrimSpace(line), ":")
+		if !ok {
+			continue
+		}
+		value = strings.TrimSpace(value)
+		switch strings.TrimSpace(key) {
+		case "version":
+			version = value
+		case "mode":
+			res.Mode = value
+		case "mx":
+			res.MxPatterns = append(res.MxPatterns, strings.ToLower(value))
+		case "max_age":
+			n, err := strconv.ParseInt(value, 10, 64)
+			if err != nil || n < 0 || n > maxMTASTSMaxAge {
+				res.Errors = append(res.Errors, fmt.Sprintf("invalid max_age %q: must be 0 to %d seconds", value, maxMTASTSMaxAge))
+				continue
+			}
+			res.MaxAge, hasMaxAge = n, true
+		}
+	}
+
+	fail := func(format string, args ...interface{}) {
+		res.Errors = append(res.Errors, fmt.Sprintf(format, args...))
+	}
+	if version != "STSv1" {
+		fail("policy version is %q, want STSv1", version)
+	}
+	switch res.Mode {
+	case "enforce", "testing", "none":
+	default:
+		fail("invalid mode %q: must be enforce, testing or none", res.Mode)
+	}
+	if !hasMaxAge {
+		fail("policy has no valid max_age")
+	}
+	if len(res.MxPatterns) == 0 && res.Mode != "none" {
+		fail("policy lists no mx patterns")
+	}
+	res.PolicyValid = len(res.Errors) == recordErrors
+}
+
+// mtaSTSCovers reports whether an mx pattern covers host. A leading "*."
+// matches exactly one label.
+func mtaSTSCovers(patterns []string, host string) bool {
+	host = strings.ToLower(host)
+	for _, pattern := range patterns {
+		if suffix, ok := strings.CutPrefix(pattern, "*."); ok {
+			if label, rest, found := strings.Cut(host, "."); found && label != "" && rest == suffix {
+				return true
+			}
+		} else if pattern == host {
+			return true
+		}
+	}
+	return false
+}
+
+// checkTLSRPT looks up domain's _smtp._tls reporting record. It returns nil
+// if the domain publishes none.
+func checkTLSRPT(ctx context.Context, rs *resolver.Resolver, domain string) (*proto.TLSRPTResult, error) {
+	records, err := txtRecords(ctx, rs, "_smtp._tls."+domain, "v=TLSRPTv1")
+	if err != nil {
+		return nil, err
+	}
+	if len(records) == 0 {
+		return nil, nil
+	}
+	res := &proto.TLSRPTResult{Record: records[0]}
+	if len(records) > 1 {
+		res.Errors = append(res.Errors, fmt.Sprintf("%d _smtp._tls records published, senders ignore them all", len(records)))
+	}
+	rua := recordTags(records[0])["rua"]
+	if rua == "" {
+		res.Errors = append(res.Errors, "record has no rua report destination")
+	}
+	for _, dest := range strings.Split(rua, ",") {
+		dest = strings.TrimSpace(dest)
+		if dest == "" {
+			continue
+		}
+		u, err := url.Parse(dest)
+		if err != nil || (u.Scheme != "mailto" && u.Scheme != "https") || (u.Opaque == "" && u.Host == "") {
+			res.Errors = append(res.Errors, fmt.Sprintf("invalid rua destination %q: must be a mailto: or https: URI", dest))
+			continue
+		}
+		res.Rua = append(res.Rua, dest)
+	}
+	res.Valid = len(res.Errors) == 0
+	return res, nil
+}
+
+// checkBIMI looks up domain's default._bimi record. BIMI logos are only
+// shown for domains whose DMARC policy is quarantine or reject. It returns
+// nil if the domain publishes no record.
+func checkBIMI(ctx context.Context, rs *resolver.Resolver, domain, dmarcPolicy string) (*proto.BIMIResult, error) {
+	records, err := txtRecords(ctx, rs, "default._bimi."+domain, "v=BIMI1")
+	if err != nil {
+		return nil, err
+	}
+	if len(records) == 0 {
+		return nil, nil
+	}
+	res := &proto.BIMIResult{Record: records[0]}
+	if len(records) > 1 {
+		res.Errors = append(res.Errors, fmt.Sprintf("%d default._bimi records published", len(records)))
+	}
+	tags := recordTags(records[0])
+	res.LogoUrl, res.AuthorityUrl = tags["l"], tags["a"]
+	if res.LogoUrl == "" && res.AuthorityUrl == "" {
+		// A record with both empty declines to show a logo
+		res.Valid = len(res.Errors) == 0
+		return res, nil
+	}
+	if u, err := url.Parse(res.LogoUrl); err != nil || u.Scheme != "https" || !strings.HasSuffix(strings.ToLower(u.Path), ".svg") {
+		res.Errors = append(res.Errors, fmt.Sprintf("logo l=%q must be an https URL of an SVG file", res.LogoUrl))
+	}
+	if res.AuthorityUrl == "" {
+		res.Errors = append(res.Errors, "record has no a= mark certificate, which most mailbox providers require")
+	} else if u, err := url.Parse(res.AuthorityUrl); err != nil || u.Scheme != "https" {
+		res.Errors = append(res.Errors, fmt.Sprintf("mark certificate a=%q must be an https URL", res.AuthorityUrl))
+	}
+	if dmarcPolicy != "quarantine" && dmarcPolicy != "reject" {
+		res.Errors = append(res.Errors, "BIMI needs a DMARC policy of quarantine or reject")
+	}
+	res.Valid = len(res.Errors) == 0
+	return res, nil
+}
+
+// checkDANE looks up the TLSA records for SMTP on each MX host, returning
+// results only for hosts that publish some. Whether an answer is DNSSEC
+// authenticated is taken from the resolver, so it is only meaningful with a
+// validating resolver.
+func checkDANE(ctx context.Context, rs *resolver.Resolver, mx []string) ([]*proto.DANEResult, error) {
+	var results []*proto.DANEResult
+	for i, host := range mx {
+		host = strings.TrimSuffix(host, ".")
+		if i >= maxDANEHosts || host == "" {
+			// Stop at the limit; a null MX has no host to check
+			break
+		}
+		m := new(dns.Msg)
+		m.SetQuestion(dns.Fqdn("_25._tcp."+host), dns.TypeTLSA)
+		m.SetEdns0(4096, true)
+		r, err := rs.Exchange(ctx, m)
+		if err != nil {
+			return results, fmt.Errorf("TLSA lookup for %s: %w", host, err)
+		}
+		res := &proto.DANEResult{MxHost: host, DnssecAuthenticated: r.AuthenticatedData}
+		for _, ans := range r.Answer {
+			tlsa, ok := ans.(*dns.TLSA)
+			if !ok {
+				continue
+			}
+			res.Records = append(res.Records, fmt.Sprintf("%d %d %d %s", tlsa.Usage, tlsa.Selector, tlsa.MatchingType, tlsa.Certificate))
+			if err := validateTLSA(tlsa); err != "" {
+				res.Errors = append(res.Errors, err)
+			}
+		}
+		if len(res.Records) == 0 {
+			continue
+		}
+		if !res.DnssecAuthenticated {
+			res.Errors = append(res.Errors, "TLSA records are not DNSSEC authenticated, so senders ignore them")
+		}
+		res.Valid = len(res.Errors) == 0
+		results = append(results, res)
+	}
+	return results, nil
+}
+
+// validateTLSA checks one TLSA record's fields for use with SMTP, returning
+// why it is unusable.
+func validateTLSA(tlsa *dns.TLSA) string {
+	record := fmt.Sprintf("TLSA %d %d %d", tlsa.Usage, tlsa.Selector, tlsa.MatchingType)
+	switch tlsa.Usage {
+	case 2, 3:
+	case 0, 1:
+		return record + ": PKIX usages are not used for SMTP (RFC 7672); use 2 (DANE-TA) or 3 (DANE-EE)"
+	default:
+		return fmt.Sprintf("%s: unknown usage %d", record, tlsa.Usage)
+	}
+	if tlsa.Selector > 1 {
+		return fmt.Sprintf("%s: unknown selector %d", record, tlsa.Selector)
+	}
+	data, err := hex.DecodeString(tlsa.Certificate)
+	if err != nil {
+		return record + ": certificate association data is not hex"
+	}
+	want := map[uint8]int{1: 32, 2: 64}
+	switch tlsa.MatchingType {
+	case 0:
+	case 1, 2:
+		if len(data) != want[tlsa.MatchingType] {
+			return fmt.Sprintf("%s: digest is %d bytes, want %d", record, len(data), want[tlsa.MatchingType])
+		}
+	default:
+		return fmt.Sprintf("%s: unknown matching type %d", record, tlsa.MatchingType)
+	}
+	return ""
+}
+
+// checkMailPolicies fills in the MTA-STS, TLS-RPT, BIMI and DANE results
+// for domain, after its MX and DMARC lookups.
+func (p *ScanDNSPlugin) checkMailPolicies(ctx context.Context, domain string, opts dnsScanOptions, result *proto.DNSSecurityResult) {
+	domain = strings.TrimSuffix(domain, ".")
+	mtaSTS, err := checkMTASTS(ctx, p.resolver, p.policyClient, domain, result.MxRecords, opts.mtaSTSPolicy)
+	if err != nil {
+		result.Errors = append(result.Errors, fmt.Sprintf("MTA-STS lookup error: %v", err))
+	} else {
+		result.MtaSts = mtaSTS
+	}
+
+	tlsRPT, err := checkTLSRPT(ctx, p.resolver, domain)
+	if err != nil {
+		result.Errors = append(result.Errors, fmt.Sprintf("TLS-RPT lookup error: %v", err))
+	} else {
+		result.TlsRpt = tlsRPT
+	}
+
+	bimi, err := checkBIMI(ctx, p.resolver, domain, result.DmarcPolicy)
+	if err != nil {
+		result.Errors = append(result.Errors, fmt.Sprintf("BIMI lookup error: %v", err))
+	} else {
+		result.Bimi = bimi
+	}
+
+	dane, err := checkDANE(ctx, p.resolver, result.MxRecords)
+	if err != nil {
+		result.Errors = append(result.Errors, fmt.Sprintf("DANE lookup error: %v", err))
+	}
+	result.Dane = dane
+}
@@ -0,0 +1,261 @@
+// plugins/mailpolicy_test.go
+package plugins
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/miekg/dns"
+	"github.com/moos3/sparta/internal/config"
+	"github.com/moos3/sparta/internal/interfaces"
+	"github.com/moos3/sparta/internal/resolver"
+	"github.com/moos3/sparta/pluginstest"
+	"github.com/moos3/sparta/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// policyServer serves policy as the MTA-STS policy of every domain.
+func policyServer(t *testing.T, contentType, policy string) *http.Client {
+	t.Helper()
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/.well-known/mta-sts.txt", r.URL.Path)
+		w.Header().Set("Content-Type", contentType)
+		fmt.Fprint(w, policy)
+	}))
+	t.Cleanup(srv.Close)
+	orig := mtaSTSPolicyURL
+	mtaSTSPolicyURL = func(string) string { return srv.URL + "/.well-known/mta-sts.txt" }
+	t.Cleanup(func() { mtaSTSPolicyURL = orig })
+	return srv.Client()
+}
+
+func TestCheckMTASTS(t *testing.T) {
+	ctx := context.Background()
+	rs := zoneResolver(t, `_mta-sts.example.com. 300 IN TXT "v=STSv1; id=20240101T000000"`)
+	mx := []string{"mx1.example.com.", "backup.example.net."}
+
+	t.Run("Enforce", func(t *testing.T) {
+		client := policyServer(t, "text/plain; charset=utf-8",
+			"version: STSv1\r\nmode: enforce\r\nmx: *.example.com\r\nmax_age: 604800\r\n")
+		res, err := checkMTASTS(ctx, rs, client, "example.com", mx, true)
+		require.NoError(t, err)
+		assert.True(t, res.RecordValid)
+		assert.Equal(t, "20240101T000000", res.Id)
+		assert.True(t, res.PolicyValid)
+		assert.Equal(t, "enforce", res.Mode)
+		assert.Equal(t, int64(604800), res.MaxAge)
+		assert.Equal(t, []string{"*.example.com"}, res.MxPatterns)
+		assert.Equal(t, []string{"backup.example.net"}, res.UnmatchedMx)
+		assert.Empty(t, res.Errors)
+	})
+
+	t.Run("InvalidPolicy", func(t *testing.T) {
+		client := policyServer(t, "text/plain", "version: STSv1\nmode: strict\nmax_age: 99999999\n")
+		res, err := checkMTASTS(ctx, rs, client, "example.com", mx, true)
+		require.NoError(t, err)
+		assert.True(t, res.RecordValid)
+		assert.False(t, res.PolicyValid)
+		assert.Equal(t, []string{
+			`invalid max_age "99999999": must be 0 to 31557600 seconds`,
+			`invalid mode "strict": must be enforce, testing or none`,
+			"policy has no valid max_age",
+			"policy lists no mx patterns",
+		}, res.Errors)
+	})
+
+	t.Run("WrongContentType", func(t *testing.T) {
+		client := policyServer(t, "text/html", "version: STSv1\n")
+		res, err := checkMTASTS(ctx, rs, client, "example.com", mx, true)
+		require.NoError(t, err)
+		assert.Empty(t, res.Policy)
+		assert.False(t, res.PolicyValid)
+		assert.Equal(t, []string{`failed to fetch policy: policy served as "text/html", not text/plain`}, res.Errors)
+	})
+
+	t.Run("Passive", func(t *testing.T) {
+		client := policyServer(t, "text/plain", "")
+		mtaSTSPolicyURL = func(string) string {
+			t.Error("policy fetched with mta_sts_policy off")
+			return ""
+		}
+		res, err := checkMTASTS(ctx, rs, client, "example.com", mx, false)
+		require.NoError(t, err)
+		assert.True(t, res.RecordValid)
+		assert.Empty(t, res.Policy)
+	})
+
+	t.Run("BadRecord", func(t *testing.T) {
+		rs := zoneResolver(t,
+			`_mta-sts.example.com. 300 IN TXT "v=STSv1; id=not-valid!"`,
+			`_mta-sts.example.com. 300 IN TXT "v=STSv1; id=2"`,
+		)
+		res, err := checkMTASTS(ctx, rs, nil, "example.com", mx, true)
+		require.NoError(t, err)
+		assert.False(t, res.RecordValid)
+		assert.Len(t, res.Errors, 2)
+	})
+
+	t.Run("NoRecord", func(t *testing.T) {
+		res, err := checkMTASTS(ctx, zoneResolver(t), nil, "example.org", mx, true)
+		require.NoError(t, err)
+		assert.Nil(t, res)
+	})
+}
+
+func TestMTASTSCovers(t *testing.T) {
+	patterns := []string{"mail.example.com", "*.mx.example.com"}
+	assert.True(t, mtaSTSCovers(patterns, "Mail.Example.com"))
+	assert.True(t, mtaSTSCovers(patterns, "a.mx.example.com"))
+	assert.False(t, mtaSTSCovers(patterns, "a.b.mx.example.com"))
+	assert.False(t, mtaSTSCovers(patterns, "mx.example.com"))
+	assert.False(t, mtaSTSCovers(patterns, "other.example.com"))
+}
+
+func TestCheckTLSRPT(t *testing.T) {
+	ctx := context.Background()
+	rs := zoneResolver(t,
+		`_smtp._tls.example.com. 300 IN TXT "v=TLSRPTv1; rua=mailto:tls@example.com,https://reports.example.com/tls"`,
+		`_smtp._tls.bad.example. 300 IN TXT "v=TLSRPTv1; rua=ftp://reports.example.com"`,
+	)
+	res, err := checkTLSRPT(ctx, rs, "example.com")
+	require.NoError(t, err)
+	assert.True(t, res.Valid)
+	assert.Equal(t, []string{"mailto:tls@example.com", "https://reports.example.com/tls"}, res.Rua)
+
+	res, err = checkTLSRPT(ctx, rs, "bad.example")
+	require.NoError(t, err)
+	assert.False(t, res.Valid)
+	assert.Equal(t, []string{`invalid rua destination "ftp://reports.example.com": must be a mailto: or https: URI`}, res.Errors)
+
+	res, err = checkTLSRPT(ctx, rs, "none.example")
+	require.NoError(t, err)
+	assert.Nil(t, res)
+}
+
+func TestCheckBIMI(t *testing.T) {
+	ctx := context.Background()
+	rs := zoneResolver(t,
+		`default._bimi.example.com. 300 IN TXT "v=BIMI1; l=https://example.com/logo.svg; a=https://example.com/vmc.pem"`,
+		`default._bimi.nocert.example. 300 IN TXT "v=BIMI1; l=http://nocert.example/logo.png"`,
+		`default._bimi.declined.example. 300 IN TXT "v=BIMI1; l=; a="`,
+	)
+	res, err := checkBIMI(ctx, rs, "example.com", "reject")
+	require.NoError(t, err)
+	assert.True(t, res.Valid)
+	assert.Equal(t, "https://example.com/logo.svg", res.LogoUrl)
+	assert.Equal(t, "https://example.com/vmc.pem", res.AuthorityUrl)
+
+	res, err = checkBIMI(ctx, rs, "example.com", "none")
+	require.NoError(t, err)
+	assert.False(t, res.Valid)
+	assert.Equal(t, []string{"BIMI needs a DMARC policy of quarantine or reject"}, res.Errors)
+
+	res, err = checkBIMI(ctx, rs, "nocert.example", "quarantine")
+	require.NoError(t, err)
+	assert.False(t, res.Valid)
+	assert.Len(t, res.Errors, 2)
+
+	res, err = checkBIMI(ctx, rs, "declined.example", "none")
+	require.NoError(t, err)
+	assert.True(t, res.Valid)
+}
+
+func TestScanMailPolicies(t *testing.T) {
+	cfg := &config.Config{}
+	pluginstest.StubDNS(t, cfg, pluginstest.Zone(t,
+		"example.com. 300 IN A 192.0.2.1",
+		"example.com. 300 IN MX 10 mail.example.com.",
+		`_dmarc.example.com. 300 IN TXT "v=DMARC1; p=quarantine"`,
+		`default._bimi.example.com. 300 IN TXT "v=BIMI1; l=https://example.com/logo.svg; a=https://example.com/vmc.pem"`,
+		`_mta-sts.example.com. 300 IN TXT "v=STSv1; id=1"`,
+		`_smtp._tls.example.com. 300 IN TXT "v=TLSRPTv1; rua=mailto:tls@example.com"`,
+	))
+	p := &ScanDNSPlugin{}
+	p.SetDatabase(pluginstest.NewMemoryDB())
+	require.NoError(t, p.SetConfig(cfg))
+	require.NoError(t, p.Initialize())
+
+	res, err := p.Scan(context.Background(), interfaces.ScanRequest{
+		Domain:  "example.com",
+		RunID:   "run-1",
+		Options: map[string]string{"dnssec": "false", "mta_sts_policy": "false"},
+	})
+	require.NoError(t, err)
+	result := res.Result.(*proto.DNSSecurityResult)
+
+	assert.True(t, result.DnssecSkipped)
+	assert.True(t, result.MtaStsPolicySkipped)
+	assert.Equal(t, "quarantine", result.DmarcPolicy)
+	require.NotNil(t, result.Bimi)
+	assert.True(t, result.Bimi.Valid, "BIMI errors: %v", result.Bimi.Errors)
+	require.NotNil(t, result.MtaSts)
+	assert.True(t, result.MtaSts.RecordValid)
+	require.NotNil(t, result.TlsRpt)
+	assert.True(t, result.TlsRpt.Valid)
+	assert.Empty(t, result.Dane)
+	assert.Empty(t, result.Errors)
+}
+
+// authenticatedResolver returns a resolver answering from records with the
+// AD bit set, as a validating resolver would.
+func authenticatedResolver(t *testing.T, records ...string) *resolver.Resolver {
+	t.Helper()
+	zone := pluginstest.Zone(t, records...)
+	cfg := &config.Config{}
+	pluginstest.StubDNS(t, cfg, dns.HandlerFunc(func(w dns.ResponseWriter, r *dns.Msg) {
+		zone.ServeDNS(adWriter{w}, r)
+	}))
+	rs, err := resolver.New(cfg)
+	require.NoError(t, err)
+	return rs
+}
+
+type adWriter struct{ dns.ResponseWriter }
+
+func (w adWriter) WriteMsg(m *dns.Msg) error {
+	m.AuthenticatedData = true
+	return w.ResponseWriter.WriteMsg(m)
+}
+
+func TestCheckDANE(t *testing.T) {
+	ctx := context.Background()
+	digest := strings.Repeat("ab", 32)
+	records := []string{
+		"_25._tcp.mx1.example.com. 300 IN TLSA 3 1 1 " + digest,
+		"_25._tcp.mx2.example.com. 300 IN TLSA 1 1 1 " + digest,
+	}
+	mx := []string{"mx1.example.com.", "mx2.example.com.", "mx3.example.com."}
+
+	results, err := checkDANE(ctx, authenticatedResolver(t, records...), mx)
+	require.NoError(t, err)
+	require.Len(t, results, 2)
+	assert.Equal(t, "mx1.example.com", results[0].MxHost)
+	assert.Equal(t, []string{"3 1 1 " + digest}, results[0].Records)
+	assert.True(t, results[0].DnssecAuthenticated)
+	assert.True(t, results[0].Valid)
+	assert.False(t, results[1].Valid)
+	assert.Contains(t, results[1].Errors[0], "PKIX usages are not used for SMTP")
+
+	results, err = checkDANE(ctx, zoneResolver(t, records...), mx[:1])
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	assert.False(t, results[0].DnssecAuthenticated)
+	assert.Equal(t, []string{"TLSA records are not DNSSEC authenticated, so senders ignore them"}, results[0].Errors)
+}
+
+func TestValidateTLSA(t *testing.T) {
+	tlsa := func(usage, selector, matching uint8, data string) *dns.TLSA {
+		return &dns.TLSA{Usage: usage, Selector: selector, MatchingType: matching, Certificate: data}
+	}
+	assert.Empty(t, validateTLSA(tlsa(2, 0, 1, strings.Repeat("00", 32))))
+	assert.Empty(t, validateTLSA(tlsa(3, 1, 2, strings.Repeat("00", 64))))
+	assert.Equal(t, "TLSA 3 1 1: digest is 20 bytes, want 32", validateTLSA(tlsa(3, 1, 1, strings.Repeat("00", 20))))
+	assert.Equal(t, "TLSA 3 2 1: unknown selector 2", validateTLSA(tlsa(3, 2, 1, "")))
+	assert.Equal(t, "TLSA 3 1 9: unknown matching type 9", validateTLSA(tlsa(3, 1, 9, "00")))
+	assert.Equal(t, "TLSA 3 1 0: certificate association data is not hex", validateTLSA(tlsa(3, 1, 0, "zz")))
+}
@@ -0,0 +1,81 @@
+// plugins/provider.go
+package plugins
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+
+	"github.com/moos3/sparta/internal/breaker"
+	"github.com/moos3/sparta/internal/config"
+	"github.com/moos3/sparta/internal/httpclient"
+	"github.com/moos3/sparta/internal/httpretry"
+	"github.com/moos3/sparta/internal/quota"
+)
+
+// outboundKey holds the settings a factory's transport is built from.
+type outboundKey struct {
+	proxy, caBundle string
+}
+
+var (
+	outboundMu sync.Mutex
+	outbound   = make(map[outboundKey]*httpclient.Factory)
+)
+
+// outboundFactory returns an HTTP client factory for cfg. Plugins whose
+// configs share a proxy and CA bundle, including across config reloads,
+// share one transport and its connections.
+func outboundFactory(cfg *config.Config) (*httpclient.Factory, error) {
+	var key outboundKey
+	if cfg != nil {
+		key = outboundKey{cfg.HTTP.Proxy, cfg.HTTP.CABundle}
+	}
+	outboundMu.Lock()
+	defer outboundMu.Unlock()
+	if f, ok := outbound[key]; ok {
+		return f.With(cfg), nil
+	}
+	f, err := httpclient.New(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to configure outbound HTTP: %w", err)
+	}
+	outbound[key] = f
+	return f, nil
+}
+
+// providerClient returns an HTTP client for an intelligence provider from the
+// shared factory, counting each request against the provider's budget in
+// quotas and refusing requests while the provider's circuit breaker is open.
+func providerClient(cfg *config.Config, quotas *quota.Tracker, provider string) (*http.Client, error) {
+	f, err := outboundFactory(cfg)
+	if err != nil {
+		return nil, err
+	}
+	client := f.Client(provider)
+	client.Transport = breaker.For(cfg, provider).Transport(quotas.Transport(provider, client.Transport))
+	return client, nil
+}
+
+// providerReady returns why a scan against provider should be skipped: its
+// budget is spent or its circuit breaker is open. A nil error means the scan
+// can go ahead.
+func providerReady(cfg *config.Config, quotas *quota.Tracker, provider string) error {
+	if err := breaker.For(cfg, provider).Check(); err != nil {
+		return err
+	}
+	return quotas.Check(provider)
+}
+
+// providerError records a failed provider request under unavailable when
+// the provider could not serve it even after retries, its budget is spent or
+// its circuit breaker is open, and under errs otherwise.
+func providerError(errs, unavailable *[]string, err error, format string, args ...interface{}) {
+	msg := fmt.Sprintf(format, args...)
+	if httpretry.IsUnavailable(err) || errors.Is(err, quota.ErrExhausted) || errors.Is(err, breaker.ErrOpen) {
+		*unavailable = append(*unavailable, msg)
+		return
+	}
+	*errs = append(*errs, msg)
+}
@@ -16,15 +16,32 @@ import (
 	"github.com/google/uuid"
 	"github.com/moos3/sparta/internal/config"
 	"github.com/moos3/sparta/internal/db"
+	"github.com/moos3/sparta/internal/httpclient"
 	"github.com/moos3/sparta/internal/interfaces"
+	"github.com/moos3/sparta/internal/quota"
+	"github.com/moos3/sparta/internal/registry"
 	"github.com/moos3/sparta/proto"
 )
 
-// ScanAbuseChPlugin implements the ScanAbuseChPlugin interface
+// ScanAbuseChPlugin implements the GenericPlugin interface
 type ScanAbuseChPlugin struct {
 	name    string
 	db      db.Database
 	conifig *config.Config
+	client  *http.Client
+	baseURL string
+	quota   *quota.Tracker
+}
+
+func init() {
+	registry.Register(registry.Plugin{
+		Name:         "ScanAbuseCh",
+		Version:      "1.0.0",
+		Description:  "Searches abuse.ch ThreatFox for indicators of compromise",
+		Passive:      true,
+		Dependencies: []string{"ScanDNS"},
+		New:          func() interfaces.GenericPlugin { return &ScanAbuseChPlugin{} },
+	})
 }
 
 // Name returns the plugin name
@@ -36,6 +53,13 @@ func (p *ScanAbuseChPlugin) Name() string {
 // Initialize sets up the plugin
 func (p *ScanAbuseChPlugin) Initialize() error {
 	p.name = "ScanAbuseCh"
+	p.quota = quota.New(p.db, p.conifig)
+	client, err := providerClient(p.conifig, p.quota, "abuse_ch")
+	if err != nil {
+		return err
+	}
+	p.client = client
+	p.baseURL = httpclient.BaseURL(p.conifig, "abuse_ch")
 	if p.db == nil {
 		log.Printf("Warning: database connection not provided for plugin %s", p.name)
 	} else {
@@ -73,7 +97,7 @@ type ThreatFoxResponse struct {
 }
 
 // ScanAbuseCh queries ThreatFox API for IOCs
-func (p *ScanAbuseChPlugin) ScanAbuseCh(domain, dnsScanID string) (*proto.AbuseChSecurityResult, error) {
+func (p *ScanAbuseChPlugin) ScanAbuseCh(ctx context.Context, domain, dnsScanID string) (*proto.AbuseChSecurityResult, error) {
 	if p.db == nil {
 		return nil, fmt.Errorf("database connection not provided")
 	}
@@ -87,7 +111,7 @@ func (p *ScanAbuseChPlugin) ScanAbuseCh(domain, dnsScanID string) (*proto.AbuseC
 	domain = strings.TrimSuffix(domain, ".")
 
 	// Query ThreatFox API
-	url := "https://threatfox-api.abuse.ch/api/v1/"
+	url := p.baseURL + "/"
 	payload := map[string]string{
 		"query":       "search_ioc",
 		"search_term": domain,
@@ -98,9 +122,15 @@ func (p *ScanAbuseChPlugin) ScanAbuseCh(domain, dnsScanID string) (*proto.AbuseC
 		return result, nil
 	}
 
-	resp, err := http.Post(url, "application/json", bytes.NewBuffer(payloadBytes))
+	req, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewBuffer(payloadBytes))
+	if err != nil {
+		result.Errors = append(result.Errors, fmt.Sprintf("Failed to create HTTP request: %v", err))
+		return result, nil
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := p.client.Do(req)
 	if err != nil {
-		result.Errors = append(result.Errors, fmt.Sprintf("ThreatFox API request failed: %v", err))
+		providerError(&result.Errors, &result.Unavailable, err, "ThreatFox API request failed: %v", err)
 		return result, nil
 	}
 	defer resp.Body.Close()
@@ -145,20 +175,11 @@ func (p *ScanAbuseChPlugin) ScanAbuseCh(domain, dnsScanID string) (*proto.AbuseC
 		})
 	}
 
-	// Store result
-	id, err := p.InsertAbuseChScanResult(domain, dnsScanID, result)
-	if err != nil {
-		result.Errors = append(result.Errors, fmt.Sprintf("Database storage error: %v", err))
-		log.Printf("Failed to store AbuseCh scan result for %s: %v", domain, err)
-	} else {
-		log.Printf("Stored AbuseCh scan result for %s with ID: %s", domain, id)
-	}
-
 	return result, nil
 }
 
 // InsertAbuseChScanResult inserts an AbuseCh scan result into the database
-func (p *ScanAbuseChPlugin) InsertAbuseChScanResult(domain, dnsScanID string, result *proto.AbuseChSecurityResult) (string, error) {
+func (p *ScanAbuseChPlugin) InsertAbuseChScanResult(scanRunID, domain, dnsScanID string, result *proto.AbuseChSecurityResult) (string, error) {
 	if p.db == nil {
 		return "", fmt.Errorf("database connection not provided")
 	}
@@ -168,10 +189,10 @@ func (p *ScanAbuseChPlugin) InsertAbuseChScanResult(domain, dnsScanID string, re
 		return "", fmt.Errorf("failed to marshal result: %w", err)
 	}
 	query := `
-		INSERT INTO abusech_scan_results (id, domain, dns_scan_id, result, created_at)
-		VALUES ($1, $2, $3, $4, $5)
+		INSERT INTO abusech_scan_results (id, scan_run_id, domain, dns_scan_id, result, created_at)
+		VALUES ($1, $2, $3, NULLIF($4, '')::uuid, $5, $6)
 	`
-	_, err = p.db.Exec(query, id, domain, dnsScanID, resultJSON, time.Now())
+	_, err = p.db.Exec(query, id, scanRunID, domain, dnsScanID, resultJSON, time.Now())
 	if err != nil {
 		return "", fmt.Errorf("failed to insert AbuseCh scan result: %w", err)
 	}
@@ -184,7 +205,7 @@ func (p *ScanAbuseChPlugin) GetAbuseChScanResultsByDomain(domain string) ([]inte
 		return nil, fmt.Errorf("database connection not provided")
 	}
 	query := `
-		SELECT id, domain, dns_scan_id, result, created_at
+		SELECT id, domain, COALESCE(dns_scan_id::text, ''), result, created_at
 		FROM abusech_scan_results
 		WHERE domain = $1
 		ORDER BY created_at DESC
@@ -206,22 +227,35 @@ func (p *ScanAbuseChPlugin) GetAbuseChScanResultsByDomain(domain string) ([]inte
 		if err := json.Unmarshal(resultJSON, &scanResult); err != nil {
 			return nil, fmt.Errorf("failed to unmarshal result: %w", err)
 		}
-		r.Result = scanResult
+		r.Result = &scanResult
 		results = append(results, r)
 	}
 	return results, nil
 }
 
-// Scan implements the GenericPlugin interface
-func (p *ScanAbuseChPlugin) Scan(ctx context.Context, domain, dnsScanID string) (interface{}, error) {
-	return p.ScanAbuseCh(domain, dnsScanID)
-}
-
-// InsertResult implements the GenericPlugin interface
-func (p *ScanAbuseChPlugin) InsertResult(domain, dnsScanID string, result interface{}) (string, error) {
-	abuseChResult, ok := result.(*proto.AbuseChSecurityResult)
-	if !ok {
-		return "", fmt.Errorf("invalid result type")
+// Scan implements the GenericPlugin interface. It runs the scan and stores the result.
+func (p *ScanAbuseChPlugin) Scan(ctx context.Context, req interfaces.ScanRequest) (*interfaces.ScanResult, error) {
+	res := interfaces.NewScanResult("ScanAbuseCh")
+	if err := providerReady(p.conifig, p.quota, "abuse_ch"); err != nil {
+		return res.Skip(err.Error()), nil
+	}
+	ctx, cancel := req.Context(ctx)
+	defer cancel()
+	result, err := p.ScanAbuseCh(ctx, req.Domain, req.ParentID)
+	if err == nil {
+		err = ctx.Err()
+	}
+	if err != nil {
+		return res.Fail(err), err
+	}
+	domain := strings.TrimSuffix(strings.TrimSpace(strings.ToLower(req.Domain)), ".")
+	id, err := p.InsertAbuseChScanResult(req.RunID, domain, req.ParentID, result)
+	if err != nil {
+		result.Errors = append(result.Errors, fmt.Sprintf("Database storage error: %v", err))
+		res.Errors = append(res.Errors, fmt.Sprintf("failed to store result: %v", err))
+		log.Printf("Failed to store AbuseCh scan result for %s: %v", domain, err)
+	} else {
+		log.Printf("Stored AbuseCh scan result for %s with ID: %s", domain, id)
 	}
-	return p.InsertAbuseChScanResult(domain, dnsScanID, abuseChResult)
+	return res.Succeed(id, result), nil
 }
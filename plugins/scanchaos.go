@@ -6,24 +6,44 @@ import (
 	"encoding/json"
 	"fmt"
 	"log"
+	"net/http"
 	"strings"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/moos3/sparta/internal/config"
 	"github.com/moos3/sparta/internal/db"
+	"github.com/moos3/sparta/internal/httpclient"
+	"github.com/moos3/sparta/internal/intelcache"
 	"github.com/moos3/sparta/internal/interfaces"
+	"github.com/moos3/sparta/internal/quota"
+	"github.com/moos3/sparta/internal/registry"
 	"github.com/moos3/sparta/proto"
-	"github.com/projectdiscovery/chaos-client/pkg/chaos"
 	"golang.org/x/time/rate"
 )
 
 type ScanChaosPlugin struct {
 	name        string
 	db          db.Database
-	client      *chaos.Client
+	client      *http.Client
+	baseURL     string
 	rateLimiter *rate.Limiter
 	config      *config.Config
+	cache       *intelcache.Cache
+	quota       *quota.Tracker
+}
+
+func init() {
+	registry.Register(registry.Plugin{
+		Name:           "ScanChaos",
+		Version:        "1.0.0",
+		Description:    "Enumerates subdomains from the ProjectDiscovery Chaos dataset",
+		RequiredConfig: []string{"chaos.api_key"},
+		Passive:        true,
+		Dependencies:   []string{"ScanDNS"},
+		Outputs:        []interfaces.Artifact{interfaces.ArtifactSubdomains},
+		New:            func() interfaces.GenericPlugin { return &ScanChaosPlugin{} },
+	})
 }
 
 func (p *ScanChaosPlugin) Initialize() error {
@@ -39,13 +59,21 @@ func (p *ScanChaosPlugin) Initialize() error {
 	if p.db == nil {
 		log.Printf("Warning: database connection not provided for plugin %s", p.name)
 	}
-	p.client = chaos.New(p.config.Chaos.APIKey)
+	p.quota = quota.New(p.db, p.config)
+	client, err := providerClient(p.config, p.quota, "chaos")
+	if err != nil {
+		return err
+	}
+	p.client = client
+	p.baseURL = httpclient.BaseURL(p.config, "chaos")
+	p.cache = intelcache.New(p.db, p.config)
 	p.rateLimiter = rate.NewLimiter(rate.Every(time.Duration(p.config.Chaos.RequestDelay)*time.Millisecond), 1)
 	return nil
 }
 
+// Name returns the plugin name
 func (p *ScanChaosPlugin) Name() string {
-	return p.name
+	return "ScanChaos"
 }
 
 func (p *ScanChaosPlugin) SetDatabase(db db.Database) {
@@ -66,50 +94,59 @@ func (p *ScanChaosPlugin) ScanChaos(ctx context.Context, domain, dnsScanID strin
 	if p.db == nil {
 		return nil, fmt.Errorf("database not initialized for plugin %s", p.name)
 	}
-	// Validate dnsScanID
-	var exists bool
-	err := p.db.QueryRow("SELECT EXISTS (SELECT 1 FROM dns_scan_results WHERE id = $1)", dnsScanID).Scan(&exists)
-	if err != nil {
-		return nil, fmt.Errorf("failed to validate DNS scan ID: %v", err)
-	}
-	if !exists {
-		return nil, fmt.Errorf("invalid DNS scan ID: %s", dnsScanID)
-	}
-
-	// Rate-limited Chaos API call
-	if err := p.rateLimiter.Wait(ctx); err != nil {
-		return nil, fmt.Errorf("rate limiter error: %v", err)
-	}
 	result := &proto.ChaosSecurityResult{
 		Subdomains: []string{},
 	}
 
-	subdomains := p.client.GetSubdomains(&chaos.SubdomainsRequest{Domain: domain})
-	for item := range subdomains {
-		if item.Error != nil {
-			log.Printf("Error retrieving subdomains for %s: %v", domain, item.Error)
-			result.Errors = append(result.Errors, fmt.Sprintf("Error retrieving subdomain: %v", item.Error))
-			continue
-		}
-		if item.Subdomain != "" {
-			log.Printf("Discovered subdomain: %s", item.Subdomain)
-			result.Subdomains = append(result.Subdomains, item.Subdomain)
+	// Reuse a listing still in the cache
+	var usage intelcache.Usage
+	key := intelcache.Key{Provider: "chaos", Endpoint: "subdomains", Query: domain}
+	subdomains, err := intelcache.Fetch(ctx, p.cache, key, &usage, func() ([]string, error) {
+		// Rate-limited Chaos API call
+		if err := p.rateLimiter.Wait(ctx); err != nil {
+			return nil, fmt.Errorf("rate limiter error: %v", err)
 		}
-	}
-
-	// Store result
-	id, err := p.InsertChaosScanResult(domain, dnsScanID, result)
+		return p.querySubdomains(ctx, domain)
+	})
 	if err != nil {
-		result.Errors = append(result.Errors, fmt.Sprintf("Database storage error: %v", err))
-		log.Printf("Failed to store Chaos scan result for %s: %v", domain, err)
+		log.Printf("Error retrieving subdomains for %s: %v", domain, err)
+		providerError(&result.Errors, &result.Unavailable, err, "Error retrieving subdomains: %v", err)
 	} else {
-		log.Printf("Stored Chaos scan result for %s with ID: %s", domain, id)
+		log.Printf("Discovered %d subdomains for %s", len(subdomains), domain)
+		result.Subdomains = append(result.Subdomains, subdomains...)
 	}
 
+	result.Cached, result.CacheAgeSeconds = usage.Cached(), usage.AgeSeconds()
 	return result, nil
 }
 
-func (p *ScanChaosPlugin) InsertChaosScanResult(domain, dnsScanID string, result *proto.ChaosSecurityResult) (string, error) {
+// querySubdomains lists the subdomains Chaos knows of under domain, as
+// labels relative to it
+func (p *ScanChaosPlugin) querySubdomains(ctx context.Context, domain string) ([]string, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/%s/subdomains", p.baseURL, domain), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Authorization", p.config.Chaos.APIKey)
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query Chaos: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("Chaos returned status %d", resp.StatusCode)
+	}
+	var body struct {
+		Subdomains []string `json:"subdomains"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return nil, fmt.Errorf("failed to decode Chaos response: %w", err)
+	}
+	return body.Subdomains, nil
+}
+
+func (p *ScanChaosPlugin) InsertChaosScanResult(scanRunID, domain, dnsScanID string, result *proto.ChaosSecurityResult) (string, error) {
 	if p.db == nil {
 		return "", fmt.Errorf("database not initialized for plugin %s", p.name)
 	}
@@ -119,10 +156,10 @@ func (p *ScanChaosPlugin) InsertChaosScanResult(domain, dnsScanID string, result
 		return "", fmt.Errorf("failed to marshal result: %w", err)
 	}
 	query := `
-		INSERT INTO chaos_scan_results (id, domain, dns_scan_id, result, created_at)
-		VALUES ($1, $2, $3, $4, $5)
+		INSERT INTO chaos_scan_results (id, scan_run_id, domain, dns_scan_id, result, created_at)
+		VALUES ($1, $2, $3, NULLIF($4, '')::uuid, $5, $6)
 	`
-	_, err = p.db.Exec(query, id, domain, dnsScanID, resultJSON, time.Now())
+	_, err = p.db.Exec(query, id, scanRunID, domain, dnsScanID, resultJSON, time.Now())
 	if err != nil {
 		return "", fmt.Errorf("failed to insert Chaos scan result: %w", err)
 	}
@@ -134,7 +171,7 @@ func (p *ScanChaosPlugin) GetChaosScanResultsByDomain(domain string) ([]interfac
 		return nil, fmt.Errorf("database not initialized for plugin %s", p.name)
 	}
 	query := `
-		SELECT id, domain, dns_scan_id, result, created_at
+		SELECT id, domain, COALESCE(dns_scan_id::text, ''), result, created_at
 		FROM chaos_scan_results
 		WHERE domain = $1
 		ORDER BY created_at DESC
@@ -156,22 +193,42 @@ func (p *ScanChaosPlugin) GetChaosScanResultsByDomain(domain string) ([]interfac
 		if err := json.Unmarshal(resultJSON, &scanResult); err != nil {
 			return nil, fmt.Errorf("failed to unmarshal result: %w", err)
 		}
-		r.Result = scanResult
+		r.Result = &scanResult
 		results = append(results, r)
 	}
 	return results, nil
 }
 
-// Scan implements the GenericPlugin interface
-func (p *ScanChaosPlugin) Scan(ctx context.Context, domain, dnsScanID string) (interface{}, error) {
-	return p.ScanChaos(ctx, domain, dnsScanID)
-}
-
-// InsertResult implements the GenericPlugin interface
-func (p *ScanChaosPlugin) InsertResult(domain, dnsScanID string, result interface{}) (string, error) {
-	chaosResult, ok := result.(*proto.ChaosSecurityResult)
-	if !ok {
-		return "", fmt.Errorf("invalid result type")
+// Scan implements the GenericPlugin interface. It runs the scan and stores the result.
+func (p *ScanChaosPlugin) Scan(ctx context.Context, req interfaces.ScanRequest) (*interfaces.ScanResult, error) {
+	res := interfaces.NewScanResult("ScanChaos")
+	if err := providerReady(p.config, p.quota, "chaos"); err != nil {
+		return res.Skip(err.Error()), nil
+	}
+	ctx, cancel := req.Context(ctx)
+	defer cancel()
+	result, err := p.ScanChaos(ctx, req.Domain, req.ParentID)
+	if err == nil {
+		err = ctx.Err()
+	}
+	if err != nil {
+		return res.Fail(err), err
+	}
+	domain := strings.TrimSuffix(strings.TrimSpace(strings.ToLower(req.Domain)), ".")
+	id, err := p.InsertChaosScanResult(req.RunID, domain, req.ParentID, result)
+	if err != nil {
+		result.Errors = append(result.Errors, fmt.Sprintf("Database storage error: %v", err))
+		res.Errors = append(res.Errors, fmt.Sprintf("failed to store result: %v", err))
+		log.Printf("Failed to store Chaos scan result for %s: %v", domain, err)
+	} else {
+		log.Printf("Stored Chaos scan result for %s with ID: %s", domain, id)
+	}
+	// Chaos returns labels relative to the domain
+	for _, sub := range result.Subdomains {
+		if !strings.HasSuffix(sub, "."+domain) {
+			sub = sub + "." + domain
+		}
+		res.Produce(interfaces.ArtifactSubdomains, sub)
 	}
-	return p.InsertChaosScanResult(domain, dnsScanID, chaosResult)
+	return res.Succeed(id, result), nil
 }
@@ -13,18 +13,36 @@ import (
 	"github.com/google/uuid"
 	"github.com/moos3/sparta/internal/config"
 	"github.com/moos3/sparta/internal/db"
+	"github.com/moos3/sparta/internal/httpclient"
 	"github.com/moos3/sparta/internal/interfaces"
+	"github.com/moos3/sparta/internal/quota"
+	"github.com/moos3/sparta/internal/registry"
 	"github.com/moos3/sparta/proto"
 	"golang.org/x/time/rate"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
-// ScanCrtShPlugin implements the CrtShScanPlugin interface
+// ScanCrtShPlugin implements the GenericPlugin interface
 type ScanCrtShPlugin struct {
 	name        string
 	db          db.Database
 	rateLimiter *rate.Limiter
 	config      *config.Config
+	client      *http.Client
+	baseURL     string
+	quota       *quota.Tracker
+}
+
+func init() {
+	registry.Register(registry.Plugin{
+		Name:         "ScanCrtSh",
+		Version:      "1.0.0",
+		Description:  "Searches certificate transparency logs via crt.sh",
+		Passive:      true,
+		Dependencies: []string{"ScanDNS"},
+		Outputs:      []interfaces.Artifact{interfaces.ArtifactSubdomains},
+		New:          func() interfaces.GenericPlugin { return &ScanCrtShPlugin{} },
+	})
 }
 
 // Name returns the plugin name
@@ -37,6 +55,13 @@ func (p *ScanCrtShPlugin) Name() string {
 func (p *ScanCrtShPlugin) Initialize() error {
 	p.name = "ScanCrtSh"
 	p.rateLimiter = rate.NewLimiter(10, 10) // 10 requests per second
+	p.quota = quota.New(p.db, p.config)
+	client, err := providerClient(p.config, p.quota, "crtsh")
+	if err != nil {
+		return err
+	}
+	p.client = client
+	p.baseURL = httpclient.BaseURL(p.config, "crtsh")
 	if p.db == nil {
 		log.Printf("Warning: database connection not provided for plugin %s", p.name)
 	} else {
@@ -59,7 +84,7 @@ func (p *ScanCrtShPlugin) SetConfig(cfg *config.Config) error {
 }
 
 // ScanCrtSh queries crt.sh for certificate and subdomain information
-func (p *ScanCrtShPlugin) ScanCrtSh(domain string, dnsScanID string) (*proto.CrtShSecurityResult, error) {
+func (p *ScanCrtShPlugin) ScanCrtSh(ctx context.Context, domain string, dnsScanID string) (*proto.CrtShSecurityResult, error) {
 	if p.db == nil {
 		return nil, fmt.Errorf("database connection not provided")
 	}
@@ -72,28 +97,19 @@ func (p *ScanCrtShPlugin) ScanCrtSh(domain string, dnsScanID string) (*proto.Crt
 	domain = strings.TrimSpace(strings.ToLower(domain))
 
 	// Query crt.sh for certificates
-	certs, subdomains, err := p.queryCrtSh(domain)
+	certs, subdomains, err := p.queryCrtSh(ctx, domain)
 	if err != nil {
-		result.Errors = append(result.Errors, fmt.Sprintf("crt.sh query error: %v", err))
+		providerError(&result.Errors, &result.Unavailable, err, "crt.sh query error: %v", err)
 	} else {
 		result.Certificates = certs
 		result.Subdomains = subdomains
 	}
 
-	// Store result
-	id, err := p.InsertCrtShScanResult(domain, dnsScanID, result)
-	if err != nil {
-		result.Errors = append(result.Errors, fmt.Sprintf("Database storage error: %v", err))
-		log.Printf("Failed to store crt.sh scan result for %s: %v", domain, err)
-	} else {
-		log.Printf("Stored crt.sh scan result for %s with ID: %s", domain, id)
-	}
-
 	return result, nil
 }
 
 // InsertCrtShScanResult inserts a crt.sh scan result into the database
-func (p *ScanCrtShPlugin) InsertCrtShScanResult(domain string, dnsScanID string, result *proto.CrtShSecurityResult) (string, error) {
+func (p *ScanCrtShPlugin) InsertCrtShScanResult(scanRunID, domain, dnsScanID string, result *proto.CrtShSecurityResult) (string, error) {
 	if p.db == nil {
 		return "", fmt.Errorf("database connection not provided")
 	}
@@ -103,10 +119,10 @@ func (p *ScanCrtShPlugin) InsertCrtShScanResult(domain string, dnsScanID string,
 		return "", fmt.Errorf("failed to marshal result: %w", err)
 	}
 	query := `
-		INSERT INTO crtsh_scan_results (id, domain, dns_scan_id, result, created_at)
-		VALUES ($1, $2, $3, $4, $5)
+		INSERT INTO crtsh_scan_results (id, scan_run_id, domain, dns_scan_id, result, created_at)
+		VALUES ($1, $2, $3, NULLIF($4, '')::uuid, $5, $6)
 	`
-	_, err = p.db.Exec(query, id, domain, dnsScanID, resultJSON, time.Now())
+	_, err = p.db.Exec(query, id, scanRunID, domain, dnsScanID, resultJSON, time.Now())
 	if err != nil {
 		return "", fmt.Errorf("failed to insert crt.sh scan result: %w", err)
 	}
@@ -119,7 +135,7 @@ func (p *ScanCrtShPlugin) GetCrtShScanResultsByDomain(domain string) ([]interfac
 		return nil, fmt.Errorf("database connection not provided")
 	}
 	query := `
-		SELECT id, domain, dns_scan_id, result, created_at
+		SELECT id, domain, COALESCE(dns_scan_id::text, ''), result, created_at
 		FROM crtsh_scan_results
 		WHERE domain = $1
 		ORDER BY created_at DESC
@@ -141,31 +157,42 @@ func (p *ScanCrtShPlugin) GetCrtShScanResultsByDomain(domain string) ([]interfac
 		if err := json.Unmarshal(resultJSON, &scanResult); err != nil {
 			return nil, fmt.Errorf("failed to unmarshal result: %w", err)
 		}
-		r.Result = scanResult
+		r.Result = &scanResult
 		results = append(results, r)
 	}
 	return results, nil
 }
 
-// Scan implements the GenericPlugin interface
-func (p *ScanCrtShPlugin) Scan(ctx context.Context, domain, dnsScanID string) (interface{}, error) {
-	return p.ScanCrtSh(domain, dnsScanID)
-}
-
-// InsertResult implements the GenericPlugin interface
-func (p *ScanCrtShPlugin) InsertResult(domain, dnsScanID string, result interface{}) (string, error) {
-	crtShResult, ok := result.(*proto.CrtShSecurityResult)
-	if !ok {
-		return "", fmt.Errorf("invalid result type")
+// Scan implements the GenericPlugin interface. It runs the scan and stores the result.
+func (p *ScanCrtShPlugin) Scan(ctx context.Context, req interfaces.ScanRequest) (*interfaces.ScanResult, error) {
+	res := interfaces.NewScanResult("ScanCrtSh")
+	if err := providerReady(p.config, p.quota, "crtsh"); err != nil {
+		return res.Skip(err.Error()), nil
 	}
-	return p.InsertCrtShScanResult(domain, dnsScanID, crtShResult)
+	ctx, cancel := req.Context(ctx)
+	defer cancel()
+	result, err := p.ScanCrtSh(ctx, req.Domain, req.ParentID)
+	if err == nil {
+		err = ctx.Err()
+	}
+	if err != nil {
+		return res.Fail(err), err
+	}
+	domain := strings.TrimSuffix(strings.TrimSpace(strings.ToLower(req.Domain)), ".")
+	id, err := p.InsertCrtShScanResult(req.RunID, domain, req.ParentID, result)
+	if err != nil {
+		result.Errors = append(result.Errors, fmt.Sprintf("Database storage error: %v", err))
+		res.Errors = append(res.Errors, fmt.Sprintf("failed to store result: %v", err))
+		log.Printf("Failed to store crt.sh scan result for %s: %v", domain, err)
+	} else {
+		log.Printf("Stored crt.sh scan result for %s with ID: %s", domain, id)
+	}
+	res.Produce(interfaces.ArtifactSubdomains, result.Subdomains...)
+	return res.Succeed(id, result), nil
 }
 
 // queryCrtSh queries crt.sh API for certificates and subdomains
-func (p *ScanCrtShPlugin) queryCrtSh(domain string) ([]*proto.CrtShCertificate, []string, error) {
-	ctx := context.Background()
-	client := &http.Client{Timeout: 10 * time.Second}
-
+func (p *ScanCrtShPlugin) queryCrtSh(ctx context.Context, domain string) ([]*proto.CrtShCertificate, []string, error) {
 	// Rate limit
 	if err := p.rateLimiter.Wait(ctx); err != nil {
 		return nil, nil, fmt.Errorf("rate limit error: %v", err)
@@ -173,10 +200,14 @@ func (p *ScanCrtShPlugin) queryCrtSh(domain string) ([]*proto.CrtShCertificate,
 
 	// Query crt.sh
 	query := url.QueryEscape("%." + domain)
-	url := fmt.Sprintf("https://crt.sh/?q=%s&output=json", query)
-	resp, err := client.Get(url)
+	url := fmt.Sprintf("%s/?q=%s&output=json", p.baseURL, query)
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create request: %v", err)
+	}
+	resp, err := p.client.Do(req)
 	if err != nil {
-		return nil, nil, fmt.Errorf("failed to query crt.sh: %v", err)
+		return nil, nil, fmt.Errorf("failed to query crt.sh: %w", err)
 	}
 	defer resp.Body.Close()
 
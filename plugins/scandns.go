@@ -2,12 +2,11 @@ package plugins
 
 import (
 	"context"
-	"crypto/x509"
 	"database/sql"
-	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"log"
+	"net/http"
 	"strings"
 	"time"
 
@@ -16,14 +15,36 @@ import (
 	"github.com/moos3/sparta/internal/config"
 	"github.com/moos3/sparta/internal/db"
 	"github.com/moos3/sparta/internal/interfaces"
+	"github.com/moos3/sparta/internal/registry"
+	"github.com/moos3/sparta/internal/resolver"
 	"github.com/moos3/sparta/proto"
 )
 
-// ScanDNSPlugin implements the DNSScanPlugin interface
+// ScanDNSPlugin implements the GenericPlugin interface
 type ScanDNSPlugin struct {
-	name   string
-	db     db.Database
-	config *config.Config
+	name     string
+	db       db.Database
+	config   *config.Config
+	resolver *resolver.Resolver
+	anchors  []*dns.DS // root DS records DNSSEC validation starts from
+	// policyClient fetches MTA-STS policies through the shared outbound
+	// HTTP settings
+	policyClient *http.Client
+}
+
+// ScanDNS is registered as passive because passive-only profiles keep it
+// and set its "dnssec" and "mta_sts_policy" options to "false"; with the
+// default options it validates DNSSEC and fetches the MTA-STS policy from
+// the domain's web server.
+func init() {
+	registry.Register(registry.Plugin{
+		Name:        "ScanDNS",
+		Version:     "1.0.0",
+		Description: "Checks SPF, DKIM, DMARC, DNSSEC, MTA-STS, TLS-RPT, BIMI and DANE records; passive only with the dnssec and mta_sts_policy options off, as passive profiles set them",
+		Passive:     true,
+		Outputs:     []interfaces.Artifact{interfaces.ArtifactIPs, interfaces.ArtifactMX, interfaces.ArtifactNS},
+		New:         func() interfaces.GenericPlugin { return &ScanDNSPlugin{} },
+	})
 }
 
 // Name returns the plugin name
@@ -40,6 +61,33 @@ func (p *ScanDNSPlugin) Initialize() error {
 	} else {
 		log.Printf("Initialized plugin %s with database connection", p.name)
 	}
+
+	// Query the resolvers under dns in config.yaml, in order
+	r, err := resolver.New(p.config)
+	if err != nil {
+		return fmt.Errorf("invalid DNS resolver config: %w", err)
+	}
+	p.resolver = r
+	var trustAnchors []string
+	if p.config != nil {
+		trustAnchors = p.config.DNS.TrustAnchors
+	}
+	anchors, err := parseTrustAnchors(trustAnchors)
+	if err != nil {
+		return fmt.Errorf("invalid DNSSEC trust anchor config: %w", err)
+	}
+	p.anchors = anchors
+
+	f, err := outboundFactory(p.config)
+	if err != nil {
+		return err
+	}
+	p.policyClient = f.Client("mta_sts")
+	// RFC 8461: policy fetches must not follow redirects
+	p.policyClient.CheckRedirect = func(*http.Request, []*http.Request) error {
+		return http.ErrUseLastResponse
+	}
+	log.Printf("Plugin %s resolving through %s", p.name, strings.Join(r.Upstreams(), ", "))
 	return nil
 }
 
@@ -56,14 +104,45 @@ func (p *ScanDNSPlugin) SetConfig(cfg *config.Config) error {
 	return nil
 }
 
-// ScanDomain performs DNS security checks and stores results
-func (p *ScanDNSPlugin) ScanDomain(domain string) (*proto.DNSSecurityResult, error) {
+// ScanDomain performs DNS security checks
+func (p *ScanDNSPlugin) ScanDomain(ctx context.Context, domain string) (*proto.DNSSecurityResult, error) {
+	return p.scanDomain(ctx, domain, scanOptions(nil))
+}
+
+// dnsScanOptions are the checks a scan makes beyond the DNS lookups every
+// scan does.
+type dnsScanOptions struct {
+	dnssec        bool     // walk the DNSSEC chain of trust
+	mtaSTSPolicy  bool     // fetch the MTA-STS policy over HTTPS from the domain
+	dkimSelectors []string // tried after remembered selectors, before the dictionary
+}
+
+// scanOptions reads the "dnssec", "mta_sts_policy" and "dkim_selectors"
+// scan options. The first two are on unless set to "false".
+func scanOptions(options map[string]string) dnsScanOptions {
+	return dnsScanOptions{
+		dnssec:        options["dnssec"] != "false",
+		mtaSTSPolicy:  options["mta_sts_policy"] != "false",
+		dkimSelectors: splitSelectors(options["dkim_selectors"]),
+	}
+}
+
+// scanDomain performs DNS security checks, leaving out the DNSSEC queries
+// and MTA-STS policy fetch unless opts asks for them. DKIM keys are looked
+// for under the selectors remembered for the domain, then those in opts,
+// then the dictionary.
+func (p *ScanDNSPlugin) scanDomain(ctx context.Context, domain string, opts dnsScanOptions) (*proto.DNSSecurityResult, error) {
 	if p.db == nil {
 		return nil, fmt.Errorf("database connection not provided")
 	}
+	if p.resolver == nil {
+		return nil, fmt.Errorf("plugin %s not initialized", "ScanDNS")
+	}
 
 	result := &proto.DNSSecurityResult{
-		Errors: []string{},
+		Errors:              []string{},
+		DnssecSkipped:       !opts.dnssec,
+		MtaStsPolicySkipped: !opts.mtaSTSPolicy,
 	}
 
 	// Normalize domain
@@ -72,32 +151,28 @@ func (p *ScanDNSPlugin) ScanDomain(domain string) (*proto.DNSSecurityResult, err
 		domain = domain + "."
 	}
 
-	// DNS client
-	client := new(dns.Client)
-	server := "8.8.8.8:53" // Google DNS
-
 	// Lookup SPF
-	spfRecord, spfValid, spfPolicy, err := lookupSPF(client, server, domain)
+	spfRecord, spfPolicy, spfEvaluation, err := evaluateSPF(ctx, p.resolver, domain)
 	if err != nil {
 		result.Errors = append(result.Errors, fmt.Sprintf("SPF lookup error: %v", err))
 	} else {
 		result.SpfRecord = spfRecord
-		result.SpfValid = spfValid
+		result.SpfValid = isSPFValid(spfRecord, spfPolicy, spfEvaluation)
 		result.SpfPolicy = spfPolicy
+		result.SpfEvaluation = spfEvaluation
 	}
 
 	// Lookup DKIM
-	dkimRecord, dkimValid, dkimError, err := lookupAndValidateDKIM(client, server, domain)
+	candidates := dkimCandidates(p.rememberedSelectors(domain), opts.dkimSelectors, p.dkimDictionary())
+	selectors, err := discoverDKIM(ctx, p.resolver, domain, candidates)
 	if err != nil {
 		result.Errors = append(result.Errors, fmt.Sprintf("DKIM lookup error: %v", err))
 	} else {
-		result.DkimRecord = dkimRecord
-		result.DkimValid = dkimValid
-		result.DkimValidationError = dkimError
+		summarizeDKIM(result, selectors, len(candidates))
 	}
 
 	// Lookup DMARC
-	dmarcRecord, dmarcPolicy, dmarcValid, dmarcError, err := lookupAndValidateDMARC(client, server, domain)
+	dmarcRecord, dmarcPolicy, dmarcValid, dmarcError, err := lookupAndValidateDMARC(ctx, p.resolver, domain)
 	if err != nil {
 		result.Errors = append(result.Errors, fmt.Sprintf("DMARC lookup error: %v", err))
 	} else {
@@ -108,17 +183,15 @@ func (p *ScanDNSPlugin) ScanDomain(domain string) (*proto.DNSSecurityResult, err
 	}
 
 	// Check DNSSEC
-	dnssecEnabled, dnssecValid, dnssecError, err := checkAndValidateDNSSEC(client, server, domain)
-	if err != nil {
-		result.Errors = append(result.Errors, fmt.Sprintf("DNSSEC check error: %v", err))
-	} else {
-		result.DnssecEnabled = dnssecEnabled
-		result.DnssecValid = dnssecValid
-		result.DnssecValidationError = dnssecError
+	if opts.dnssec {
+		// Walk the chain of trust from the root down to the domain
+		if err := validateDNSSEC(ctx, p.resolver, p.anchors, domain, result); err != nil {
+			result.Errors = append(result.Errors, fmt.Sprintf("DNSSEC check error: %v", err))
+		}
 	}
 
 	// Lookup IPs
-	ips, err := lookupIPs(client, server, domain)
+	ips, err := lookupIPs(ctx, p.resolver, domain)
 	if err != nil {
 		result.Errors = append(result.Errors, fmt.Sprintf("IP lookup error: %v", err))
 	} else {
@@ -126,7 +199,7 @@ func (p *ScanDNSPlugin) ScanDomain(domain string) (*proto.DNSSecurityResult, err
 	}
 
 	// Lookup MX
-	mxRecords, err := lookupMX(client, server, domain)
+	mxRecords, err := lookupMX(ctx, p.resolver, domain)
 	if err != nil {
 		result.Errors = append(result.Errors, fmt.Sprintf("MX lookup error: %v", err))
 	} else {
@@ -134,28 +207,21 @@ func (p *ScanDNSPlugin) ScanDomain(domain string) (*proto.DNSSecurityResult, err
 	}
 
 	// Lookup NS
-	nsRecords, err := lookupNS(client, server, domain)
+	nsRecords, err := lookupNS(ctx, p.resolver, domain)
 	if err != nil {
 		result.Errors = append(result.Errors, fmt.Sprintf("NS lookup error: %v", err))
 	} else {
 		result.NsRecords = nsRecords
 	}
 
-	// Store result
-	domainTrimmed := strings.TrimSuffix(domain, ".")
-	id, err := p.InsertDNSScanResult(domainTrimmed, result)
-	if err != nil {
-		result.Errors = append(result.Errors, fmt.Sprintf("Database storage error: %v", err))
-		log.Printf("Failed to store scan result for %s: %v", domainTrimmed, err)
-	} else {
-		log.Printf("Stored scan result for %s with ID: %s", domainTrimmed, id)
-	}
+	// Check mail transport security
+	p.checkMailPolicies(ctx, domain, opts, result)
 
 	return result, nil
 }
 
 // InsertDNSScanResult inserts a DNS scan result into the database
-func (p *ScanDNSPlugin) InsertDNSScanResult(domain string, result *proto.DNSSecurityResult) (string, error) {
+func (p *ScanDNSPlugin) InsertDNSScanResult(scanRunID, domain string, result *proto.DNSSecurityResult) (string, error) {
 	if p.db == nil {
 		return "", fmt.Errorf("database connection not provided")
 	}
@@ -165,10 +231,10 @@ func (p *ScanDNSPlugin) InsertDNSScanResult(domain string, result *proto.DNSSecu
 		return "", fmt.Errorf("failed to marshal result: %w", err)
 	}
 	query := `
-		INSERT INTO dns_scan_results (id, domain, result, created_at)
-		VALUES ($1, $2, $3, $4)
+		INSERT INTO dns_scan_results (id, scan_run_id, domain, result, created_at)
+		VALUES ($1, $2, $3, $4, $5)
 	`
-	_, err = p.db.Exec(query, id, domain, resultJSON, time.Now())
+	_, err = p.db.Exec(query, id, scanRunID, domain, resultJSON, time.Now())
 	if err != nil {
 		return "", fmt.Errorf("failed to insert DNS scan result: %w", err)
 	}
@@ -203,7 +269,7 @@ func (p *ScanDNSPlugin) GetDNSScanResultsByDomain(domain string) ([]interfaces.D
 		if err := json.Unmarshal(resultJSON, &scanResult); err != nil {
 			return nil, fmt.Errorf("failed to unmarshal result: %w", err)
 		}
-		r.Result = scanResult
+		r.Result = &scanResult
 		results = append(results, r)
 	}
 	return results, nil
@@ -232,128 +298,48 @@ func (p *ScanDNSPlugin) GetDNSScanResultByID(dnsScanID string) (interfaces.DNSSc
 	if err := json.Unmarshal(resultJSON, &scanResult); err != nil {
 		return interfaces.DNSScanResult{}, fmt.Errorf("failed to unmarshal result: %w", err)
 	}
-	r.Result = scanResult
+	r.Result = &scanResult
 	return r, nil
 }
 
-// Scan implements the GenericPlugin interface
-func (p *ScanDNSPlugin) Scan(ctx context.Context, domain, dnsScanID string) (interface{}, error) {
-	return p.ScanDomain(domain)
-}
-
-// InsertResult implements the GenericPlugin interface
-func (p *ScanDNSPlugin) InsertResult(domain, dnsScanID string, result interface{}) (string, error) {
-	dnsResult, ok := result.(*proto.DNSSecurityResult)
-	if !ok {
-		return "", fmt.Errorf("invalid result type")
-	}
-	return p.InsertDNSScanResult(domain, dnsResult)
-}
-
-// lookupSPF queries TXT records for SPF
-func lookupSPF(client *dns.Client, server, domain string) (string, bool, string, error) {
-	m := new(dns.Msg)
-	m.SetQuestion(domain, dns.TypeTXT)
-	r, _, err := client.Exchange(m, server)
-	if err != nil {
-		return "", false, "", err
-	}
-
-	for _, ans := range r.Answer {
-		if txt, ok := ans.(*dns.TXT); ok {
-			for _, record := range txt.Txt {
-				if strings.HasPrefix(record, "v=spf1") {
-					policy := extractSPFPolicy(record)
-					return record, isSPFValid(record), policy, nil
-				}
-			}
-		}
-	}
-	return "", false, "", nil
-}
-
-// extractSPFPolicy extracts the SPF policy
-func extractSPFPolicy(record string) string {
-	parts := strings.Fields(record)
-	for _, part := range parts {
-		if part == "-all" || part == "~all" || part == "+all" || part == "?all" {
-			return part
-		}
-	}
-	return ""
-}
-
-// isSPFValid performs basic SPF validation
-func isSPFValid(record string) bool {
-	return strings.HasPrefix(record, "v=spf1") && (strings.Contains(record, "-all") || strings.Contains(record, "~all"))
-}
-
-// lookupAndValidateDKIM queries and validates DKIM records
-func lookupAndValidateDKIM(client *dns.Client, server, domain string) (string, bool, string, error) {
-	dkimDomain := "default._domainkey." + strings.TrimSuffix(domain, ".")
-	m := new(dns.Msg)
-	m.SetQuestion(dkimDomain, dns.TypeTXT)
-	r, _, err := client.Exchange(m, server)
-	if err != nil {
-		return "", false, "", err
-	}
-
-	for _, ans := range r.Answer {
-		if txt, ok := ans.(*dns.TXT); ok {
-			for _, record := range txt.Txt {
-				if strings.HasPrefix(record, "v=DKIM1") {
-					validationError := validateDKIMRecord(record)
-					return record, validationError == "", validationError, nil
-				}
-			}
-		}
-	}
-	return "", false, "No DKIM record found", nil
-}
-
-// validateDKIMRecord checks DKIM record format and public key
-func validateDKIMRecord(record string) string {
-	if !strings.HasPrefix(record, "v=DKIM1") {
-		return "Invalid DKIM version"
-	}
-
-	parts := strings.Split(record, ";")
-	var keyType, pubKey string
-	for _, part := range parts {
-		part = strings.TrimSpace(part)
-		if strings.HasPrefix(part, "k=") {
-			keyType = strings.TrimPrefix(part, "k=")
-		} else if strings.HasPrefix(part, "p=") {
-			pubKey = strings.TrimPrefix(part, "p=")
-		}
-	}
-
-	if keyType != "rsa" {
-		return "Unsupported key type: " + keyType
+// Scan implements the GenericPlugin interface. It runs the scan and stores the
+// result. The "dnssec" and "mta_sts_policy" options set to "false" skip the
+// DNSSEC checks and the MTA-STS policy fetch, for scans that must stay
+// passive, and "dkim_selectors" lists DKIM selectors to try besides the
+// dictionary, separated by commas or spaces. Selectors that publish a key
+// are remembered for the domain's next scan.
+func (p *ScanDNSPlugin) Scan(ctx context.Context, req interfaces.ScanRequest) (*interfaces.ScanResult, error) {
+	res := interfaces.NewScanResult("ScanDNS")
+	ctx, cancel := req.Context(ctx)
+	defer cancel()
+	result, err := p.scanDomain(ctx, req.Domain, scanOptions(req.Options))
+	if err == nil {
+		err = ctx.Err()
 	}
-	if pubKey == "" {
-		return "Missing public key"
-	}
-
-	// Decode and validate public key
-	pubKeyBytes, err := base64.StdEncoding.DecodeString(pubKey)
 	if err != nil {
-		return "Invalid public key encoding: " + err.Error()
+		return res.Fail(err), err
 	}
-	_, err = x509.ParsePKIXPublicKey(pubKeyBytes)
+	domain := strings.TrimSuffix(strings.TrimSpace(strings.ToLower(req.Domain)), ".")
+	id, err := p.InsertDNSScanResult(req.RunID, domain, result)
 	if err != nil {
-		return "Invalid public key format: " + err.Error()
+		result.Errors = append(result.Errors, fmt.Sprintf("Database storage error: %v", err))
+		res.Errors = append(res.Errors, fmt.Sprintf("failed to store result: %v", err))
+		log.Printf("Failed to store DNS scan result for %s: %v", domain, err)
+	} else {
+		log.Printf("Stored DNS scan result for %s with ID: %s", domain, id)
 	}
-
-	return ""
+	p.rememberSelectors(domain, result.DkimSelectors)
+	res.Produce(interfaces.ArtifactIPs, result.IpAddresses...)
+	res.Produce(interfaces.ArtifactMX, trimDots(result.MxRecords)...)
+	res.Produce(interfaces.ArtifactNS, trimDots(result.NsRecords)...)
+	return res.Succeed(id, result), nil
 }
 
 // lookupAndValidateDMARC queries and validates DMARC records
-func lookupAndValidateDMARC(client *dns.Client, server, domain string) (string, string, bool, string, error) {
-	dmarcDomain := "_dmarc." + strings.TrimSuffix(domain, ".")
+func lookupAndValidateDMARC(ctx context.Context, rs *resolver.Resolver, domain string) (string, string, bool, string, error) {
 	m := new(dns.Msg)
-	m.SetQuestion(dmarcDomain, dns.TypeTXT)
-	r, _, err := client.Exchange(m, server)
+	m.SetQuestion(dns.Fqdn("_dmarc."+strings.TrimSuffix(domain, ".")), dns.TypeTXT)
+	r, err := rs.Exchange(ctx, m)
 	if err != nil {
 		return "", "", false, "", err
 	}
@@ -412,87 +398,13 @@ func validateDMARCRecord(record string) (string, bool, string) {
 	return policy, true, ""
 }
 
-// checkAndValidateDNSSEC checks and validates DNSSEC
-func checkAndValidateDNSSEC(client *dns.Client, server, domain string) (bool, bool, string, error) {
-	// Check for DS or DNSKEY records
-	m := new(dns.Msg)
-	m.SetQuestion(domain, dns.TypeDS)
-	m.SetEdns0(4096, true) // Enable DNSSEC
-	r, _, err := client.Exchange(m, server)
-	if err != nil {
-		return false, false, "", err
-	}
-	hasDS := len(r.Answer) > 0
-
-	// Query DNSKEY records
-	m = new(dns.Msg)
-	m.SetQuestion(domain, dns.TypeDNSKEY)
-	m.SetEdns0(4096, true)
-	r, _, err = client.Exchange(m, server)
-	if err != nil {
-		return false, false, "", err
-	}
-	hasDNSKEY := len(r.Answer) > 0
-
-	if !hasDS && !hasDNSKEY {
-		return false, false, "No DS or DNSKEY records found", nil
-	}
-
-	// Collect DNSKEYs
-	var dnskeys []*dns.DNSKEY
-	for _, ans := range r.Answer {
-		if key, ok := ans.(*dns.DNSKEY); ok {
-			dnskeys = append(dnskeys, key)
-		}
-	}
-	if len(dnskeys) == 0 {
-		return true, false, "No DNSKEY records found", nil
-	}
-
-	// Query A records with RRSIG
-	m = new(dns.Msg)
-	m.SetQuestion(domain, dns.TypeA)
-	m.SetEdns0(4096, true)
-	r, _, err = client.Exchange(m, server)
-	if err != nil {
-		return true, false, "Failed to query A records: " + err.Error(), nil
-	}
-
-	// Collect A records
-	var aRecords []dns.RR
-	for _, ans := range r.Answer {
-		if _, ok := ans.(*dns.A); ok {
-			aRecords = append(aRecords, ans)
-		}
-	}
-	if len(aRecords) == 0 {
-		return true, false, "No A records found", nil
-	}
-
-	// Find RRSIG for A records
-	for _, sig := range r.Answer {
-		if rrsig, ok := sig.(*dns.RRSIG); ok && rrsig.TypeCovered == dns.TypeA {
-			for _, dnskey := range dnskeys {
-				err := rrsig.Verify(dnskey, aRecords)
-				if err == nil {
-					return true, true, "", nil
-				}
-				log.Printf("DNSSEC verification failed with DNSKEY: %v", err)
-			}
-			return true, false, "DNSSEC signature verification failed for all DNSKEYs", nil
-		}
-	}
-
-	return true, false, "No valid RRSIG found for A records", nil
-}
-
 // lookupIPs queries A and AAAA records
-func lookupIPs(client *dns.Client, server, domain string) ([]string, error) {
+func lookupIPs(ctx context.Context, rs *resolver.Resolver, domain string) ([]string, error) {
 	var ips []string
 
 	m := new(dns.Msg)
 	m.SetQuestion(domain, dns.TypeA)
-	r, _, err := client.Exchange(m, server)
+	r, err := rs.Exchange(ctx, m)
 	if err != nil {
 		return nil, err
 	}
@@ -503,7 +415,7 @@ func lookupIPs(client *dns.Client, server, domain string) ([]string, error) {
 	}
 
 	m.SetQuestion(domain, dns.TypeAAAA)
-	r, _, err = client.Exchange(m, server)
+	r, err = rs.Exchange(ctx, m)
 	if err != nil {
 		return nil, err
 	}
@@ -517,10 +429,10 @@ func lookupIPs(client *dns.Client, server, domain string) ([]string, error) {
 }
 
 // lookupMX queries MX records
-func lookupMX(client *dns.Client, server, domain string) ([]string, error) {
+func lookupMX(ctx context.Context, rs *resolver.Resolver, domain string) ([]string, error) {
 	m := new(dns.Msg)
 	m.SetQuestion(domain, dns.TypeMX)
-	r, _, err := client.Exchange(m, server)
+	r, err := rs.Exchange(ctx, m)
 	if err != nil {
 		return nil, err
 	}
@@ -535,10 +447,10 @@ func lookupMX(client *dns.Client, server, domain string) ([]string, error) {
 }
 
 // lookupNS queries NS records
-func lookupNS(client *dns.Client, server, domain string) ([]string, error) {
+func lookupNS(ctx context.Context, rs *resolver.Resolver, domain string) ([]string, error) {
 	m := new(dns.Msg)
 	m.SetQuestion(domain, dns.TypeNS)
-	r, _, err := client.Exchange(m, server)
+	r, err := rs.Exchange(ctx, m)
 	if err != nil {
 		return nil, err
 	}
@@ -551,3 +463,12 @@ func lookupNS(client *dns.Client, server, domain string) ([]string, error) {
 	}
 	return nsRecords, nil
 }
+
+// trimDots strips the trailing dot from fully qualified hostnames
+func trimDots(names []string) []string {
+	trimmed := make([]string, 0, len(names))
+	for _, name := range names {
+		trimmed = append(trimmed, strings.TrimSuffix(name, "."))
+	}
+	return trimmed
+}
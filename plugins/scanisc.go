@@ -14,7 +14,10 @@ import (
 	"github.com/google/uuid"
 	"github.com/moos3/sparta/internal/config"
 	"github.com/moos3/sparta/internal/db"
+	"github.com/moos3/sparta/internal/httpclient"
 	"github.com/moos3/sparta/internal/interfaces"
+	"github.com/moos3/sparta/internal/quota"
+	"github.com/moos3/sparta/internal/registry"
 	"github.com/moos3/sparta/proto"
 	"golang.org/x/time/rate"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -33,13 +36,36 @@ type ISCAPIResponse struct {
 	Errors      []string `json:"errors"`
 }
 
+// ISCIPResponse represents the reputation of a single IP in the ISC API
+type ISCIPResponse struct {
+	IP      string `json:"ip"`
+	Count   int    `json:"count"`
+	Attacks int    `json:"attacks"`
+	ASName  string `json:"as_name"`
+}
+
 // ScanISCPlugin implements the ISC scan plugin
 type ScanISCPlugin struct {
 	name        string
 	db          db.Database
 	client      *http.Client
+	baseURL     string
 	rateLimiter *rate.Limiter
 	config      *config.Config
+	quota       *quota.Tracker
+}
+
+func init() {
+	registry.Register(registry.Plugin{
+		Name:           "ScanISC",
+		Version:        "1.0.0",
+		Description:    "Looks up reported incidents in the SANS Internet Storm Center",
+		RequiredConfig: []string{"isc.api_key"},
+		Passive:        true,
+		Dependencies:   []string{"ScanDNS"},
+		Inputs:         []interfaces.Artifact{interfaces.ArtifactIPs},
+		New:            func() interfaces.GenericPlugin { return &ScanISCPlugin{} },
+	})
 }
 
 // Name returns the plugin name
@@ -56,10 +82,15 @@ func (p *ScanISCPlugin) Initialize() error {
 		// (e.g., just database interaction without API calls). Here, we'll indicate it.
 	}
 
-	// Create HTTP client with timeout
-	p.client = &http.Client{
-		Timeout: 15 * time.Second,
+	// Create HTTP client that counts calls against the provider's quota and
+	// retries throttled requests
+	p.quota = quota.New(p.db, p.config)
+	client, err := providerClient(p.config, p.quota, "isc")
+	if err != nil {
+		return err
 	}
+	p.client = client
+	p.baseURL = httpclient.BaseURL(p.config, "isc")
 	log.Printf("Initialized HTTP client for plugin %s", p.name)
 
 	// Initialize rate limiter (requests per second = 1000ms / delay)
@@ -92,19 +123,24 @@ func (p *ScanISCPlugin) SetConfig(cfg *config.Config) error {
 	return nil
 }
 
+// maxISCIPLookups caps how many resolved IPs are looked up per scan
+const maxISCIPLookups = 5
+
 // ScanISC queries a hypothetical SANS ISC API for incident reports related to a domain
 func (p *ScanISCPlugin) ScanISC(ctx context.Context, domain string, dnsScanID string) (*proto.ISCSecurityResult, error) {
+	return p.scanISC(ctx, domain, nil)
+}
+
+// scanISC looks up incidents reported for domain and the reputation of each
+// of its resolved IPs
+func (p *ScanISCPlugin) scanISC(ctx context.Context, domain string, ips []string) (*proto.ISCSecurityResult, error) {
 	result := &proto.ISCSecurityResult{
 		Errors: []string{},
 	}
 
 	if p.config == nil || p.config.ISC.APIKey == "" {
+		// The result is still stored by Scan to record the attempt.
 		result.Errors = append(result.Errors, "ISC API key not configured. Skipping API scan.")
-		// We still store results, even if empty or with errors, to record the attempt.
-		_, err := p.InsertISCScanResult(domain, dnsScanID, result)
-		if err != nil {
-			log.Printf("Failed to store partial ISC scan result (no API key): %v", err)
-		}
 		return result, nil
 	}
 
@@ -120,52 +156,57 @@ func (p *ScanISCPlugin) ScanISC(ctx context.Context, domain string, dnsScanID st
 		domain = strings.TrimSuffix(domain, ".")
 	}
 
+	p.queryDomain(ctx, domain, result)
+	for _, ip := range limit(ips, maxISCIPLookups) {
+		if ctx.Err() != nil {
+			break
+		}
+		p.queryIP(ctx, ip, result)
+	}
+
+	return result, nil
+}
+
+// queryDomain adds the incidents reported for domain to result
+func (p *ScanISCPlugin) queryDomain(ctx context.Context, domain string, result *proto.ISCSecurityResult) {
 	// Rate limit
 	if err := p.rateLimiter.Wait(ctx); err != nil {
 		result.Errors = append(result.Errors, fmt.Sprintf("Rate limit error: %v", err))
-		return result, nil
+		return
 	}
 
 	// Hypothetical ISC API URL
-	apiURL := fmt.Sprintf("%s/v1/domain_info/%s?apikey=%s", p.config.ISC.BaseURL, domain, p.config.ISC.APIKey)
-	if p.config.ISC.BaseURL == "" {
-		apiURL = fmt.Sprintf("https://mock.isc.sans.edu/api/v1/domain_info/%s?apikey=%s", domain, p.config.ISC.APIKey) // Fallback mock URL
-	}
+	apiURL := fmt.Sprintf("%s/v1/domain_info/%s?apikey=%s", p.baseURL, domain, p.config.ISC.APIKey)
 
 	req, err := http.NewRequestWithContext(ctx, "GET", apiURL, nil)
 	if err != nil {
 		result.Errors = append(result.Errors, fmt.Sprintf("Failed to create HTTP request: %v", err))
-		return result, nil
+		return
 	}
 
 	resp, err := p.client.Do(req)
 	if err != nil {
-		result.Errors = append(result.Errors, fmt.Sprintf("ISC API request failed: %v", err))
-		return result, nil
+		providerError(&result.Errors, &result.Unavailable, err, "ISC API request failed: %v", err)
+		return
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
 		bodyBytes, _ := io.ReadAll(resp.Body)
 		result.Errors = append(result.Errors, fmt.Sprintf("ISC API returned status %d: %s", resp.StatusCode, string(bodyBytes)))
-		// Still try to store the partial result with the error
-		_, err = p.InsertISCScanResult(domain, dnsScanID, result)
-		if err != nil {
-			log.Printf("Failed to store partial ISC scan result (API error): %v", err)
-		}
-		return result, nil
+		return
 	}
 
 	body, err := io.ReadAll(resp.Body)
 	if err != nil {
 		result.Errors = append(result.Errors, fmt.Sprintf("Failed to read API response: %v", err))
-		return result, nil
+		return
 	}
 
 	var iscResp ISCAPIResponse
 	if err := json.Unmarshal(body, &iscResp); err != nil {
 		result.Errors = append(result.Errors, fmt.Sprintf("Failed to unmarshal API response: %v", err))
-		return result, nil
+		return
 	}
 
 	result.OverallRisk = iscResp.OverallRisk
@@ -185,20 +226,49 @@ func (p *ScanISCPlugin) ScanISC(ctx context.Context, domain string, dnsScanID st
 		result.Errors = append(result.Errors, iscResp.Errors...)
 	}
 
-	// Store result
-	id, err := p.InsertISCScanResult(domain, dnsScanID, result)
+}
+
+// queryIP adds the reputation of a resolved IP to result
+func (p *ScanISCPlugin) queryIP(ctx context.Context, ip string, result *proto.ISCSecurityResult) {
+	if err := p.rateLimiter.Wait(ctx); err != nil {
+		result.Errors = append(result.Errors, fmt.Sprintf("Rate limit error: %v", err))
+		return
+	}
+
+	apiURL := fmt.Sprintf("%s/v1/ip_info/%s?apikey=%s", p.baseURL, ip, p.config.ISC.APIKey)
+
+	req, err := http.NewRequestWithContext(ctx, "GET", apiURL, nil)
 	if err != nil {
-		result.Errors = append(result.Errors, fmt.Sprintf("Database storage error: %v", err))
-		log.Printf("Failed to store ISC scan result for %s: %v", domain, err)
-	} else {
-		log.Printf("Stored ISC scan result for %s with ID: %s", domain, id)
+		result.Errors = append(result.Errors, fmt.Sprintf("Failed to create HTTP request: %v", err))
+		return
+	}
+	resp, err := p.client.Do(req)
+	if err != nil {
+		providerError(&result.Errors, &result.Unavailable, err, "ISC IP lookup for %s failed: %v", ip, err)
+		return
 	}
+	defer resp.Body.Close()
 
-	return result, nil
+	if resp.StatusCode != http.StatusOK {
+		result.Errors = append(result.Errors, fmt.Sprintf("ISC IP lookup for %s returned status %d", ip, resp.StatusCode))
+		return
+	}
+
+	var ipResp ISCIPResponse
+	if err := json.NewDecoder(resp.Body).Decode(&ipResp); err != nil {
+		result.Errors = append(result.Errors, fmt.Sprintf("Failed to unmarshal IP lookup for %s: %v", ip, err))
+		return
+	}
+	result.IpReputation = append(result.IpReputation, &proto.ISCIPReputation{
+		Ip:      ip,
+		Count:   int32(ipResp.Count),
+		Attacks: int32(ipResp.Attacks),
+		AsName:  ipResp.ASName,
+	})
 }
 
 // InsertISCScanResult inserts an ISC scan result into the database
-func (p *ScanISCPlugin) InsertISCScanResult(domain string, dnsScanID string, result *proto.ISCSecurityResult) (string, error) {
+func (p *ScanISCPlugin) InsertISCScanResult(scanRunID, domain, dnsScanID string, result *proto.ISCSecurityResult) (string, error) {
 	if p.db == nil {
 		return "", fmt.Errorf("database connection not provided")
 	}
@@ -208,10 +278,10 @@ func (p *ScanISCPlugin) InsertISCScanResult(domain string, dnsScanID string, res
 		return "", fmt.Errorf("failed to marshal result: %w", err)
 	}
 	query := `
-		INSERT INTO isc_scan_results (id, domain, dns_scan_id, result, created_at)
-		VALUES ($1, $2, $3, $4, $5)
+		INSERT INTO isc_scan_results (id, scan_run_id, domain, dns_scan_id, result, created_at)
+		VALUES ($1, $2, $3, NULLIF($4, '')::uuid, $5, $6)
 	`
-	_, err = p.db.Exec(query, id, domain, dnsScanID, resultJSON, time.Now())
+	_, err = p.db.Exec(query, id, scanRunID, domain, dnsScanID, resultJSON, time.Now())
 	if err != nil {
 		return "", fmt.Errorf("failed to insert ISC scan result: %w", err)
 	}
@@ -224,7 +294,7 @@ func (p *ScanISCPlugin) GetISCScanResultsByDomain(domain string) ([]interfaces.I
 		return nil, fmt.Errorf("database connection not provided")
 	}
 	query := `
-		SELECT id, domain, dns_scan_id, result, created_at
+		SELECT id, domain, COALESCE(dns_scan_id::text, ''), result, created_at
 		FROM isc_scan_results
 		WHERE domain = $1
 		ORDER BY created_at DESC
@@ -246,22 +316,35 @@ func (p *ScanISCPlugin) GetISCScanResultsByDomain(domain string) ([]interfaces.I
 		if err := json.Unmarshal(resultJSON, &scanResult); err != nil {
 			return nil, fmt.Errorf("failed to unmarshal result: %w", err)
 		}
-		r.Result = scanResult
+		r.Result = &scanResult
 		results = append(results, r)
 	}
 	return results, nil
 }
 
-// Scan implements the GenericPlugin interface
-func (p *ScanISCPlugin) Scan(ctx context.Context, domain, dnsScanID string) (interface{}, error) {
-	return p.ScanISC(ctx, domain, dnsScanID)
-}
-
-// InsertResult implements the GenericPlugin interface
-func (p *ScanISCPlugin) InsertResult(domain, dnsScanID string, result interface{}) (string, error) {
-	iscResult, ok := result.(*proto.ISCSecurityResult)
-	if !ok {
-		return "", fmt.Errorf("invalid result type for ISC plugin")
+// Scan implements the GenericPlugin interface. It runs the scan and stores the result.
+func (p *ScanISCPlugin) Scan(ctx context.Context, req interfaces.ScanRequest) (*interfaces.ScanResult, error) {
+	res := interfaces.NewScanResult("ScanISC")
+	if err := providerReady(p.config, p.quota, "isc"); err != nil {
+		return res.Skip(err.Error()), nil
+	}
+	ctx, cancel := req.Context(ctx)
+	defer cancel()
+	result, err := p.scanISC(ctx, req.Domain, req.Input(interfaces.ArtifactIPs))
+	if err == nil {
+		err = ctx.Err()
+	}
+	if err != nil {
+		return res.Fail(err), err
+	}
+	domain := strings.TrimSuffix(strings.TrimSpace(strings.ToLower(req.Domain)), ".")
+	id, err := p.InsertISCScanResult(req.RunID, domain, req.ParentID, result)
+	if err != nil {
+		result.Errors = append(result.Errors, fmt.Sprintf("Database storage error: %v", err))
+		res.Errors = append(res.Errors, fmt.Sprintf("failed to store result: %v", err))
+		log.Printf("Failed to store ISC scan result for %s: %v", domain, err)
+	} else {
+		log.Printf("Stored ISC scan result for %s with ID: %s", domain, id)
 	}
-	return p.InsertISCScanResult(domain, dnsScanID, iscResult)
+	return res.Succeed(id, result), nil
 }
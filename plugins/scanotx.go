@@ -15,7 +15,11 @@ import (
 	"github.com/google/uuid"
 	"github.com/moos3/sparta/internal/config"
 	"github.com/moos3/sparta/internal/db"
+	"github.com/moos3/sparta/internal/httpclient"
+	"github.com/moos3/sparta/internal/intelcache"
 	"github.com/moos3/sparta/internal/interfaces"
+	"github.com/moos3/sparta/internal/quota"
+	"github.com/moos3/sparta/internal/registry"
 	"github.com/moos3/sparta/proto"
 	"golang.org/x/time/rate"
 )
@@ -25,8 +29,24 @@ type ScanOTXPlugin struct {
 	name        string
 	db          db.Database
 	client      *http.Client
+	baseURL     string
+	cache       *intelcache.Cache
 	rateLimiter *rate.Limiter
 	config      *config.Config
+	quota       *quota.Tracker
+}
+
+func init() {
+	registry.Register(registry.Plugin{
+		Name:           "ScanOTX",
+		Version:        "1.0.0",
+		Description:    "Collects threat intelligence from AlienVault OTX",
+		RequiredConfig: []string{"otx.api_key"},
+		Passive:        true,
+		Dependencies:   []string{"ScanDNS"},
+		Inputs:         []interfaces.Artifact{interfaces.ArtifactIPs},
+		New:            func() interfaces.GenericPlugin { return &ScanOTXPlugin{} },
+	})
 }
 
 // Name returns the plugin name
@@ -46,10 +66,16 @@ func (p *ScanOTXPlugin) Initialize() error {
 		return fmt.Errorf("OTX API key not provided")
 	}
 
-	// Create HTTP client with timeout
-	p.client = &http.Client{
-		Timeout: 10 * time.Second,
+	// Create HTTP client that counts calls against the provider's quota and
+	// retries throttled requests
+	p.quota = quota.New(p.db, p.config)
+	client, err := providerClient(p.config, p.quota, "otx")
+	if err != nil {
+		return err
 	}
+	p.client = client
+	p.baseURL = httpclient.BaseURL(p.config, "otx")
+	p.cache = intelcache.New(p.db, p.config)
 	log.Printf("Initialized HTTP client for plugin %s", p.name)
 
 	// Initialize rate limiter (requests per second = 1000ms / delay)
@@ -73,8 +99,16 @@ func (p *ScanOTXPlugin) SetConfig(cfg *config.Config) error {
 	return nil
 }
 
+// maxOTXIPLookups caps how many resolved IPs are looked up per scan
+const maxOTXIPLookups = 5
+
 // ScanOTX queries AlienVault OTX API for threat intelligence
-func (p *ScanOTXPlugin) ScanOTX(domain string, dnsScanID string) (*proto.OTXSecurityResult, error) {
+func (p *ScanOTXPlugin) ScanOTX(ctx context.Context, domain string, dnsScanID string) (*proto.OTXSecurityResult, error) {
+	return p.scanOTX(ctx, domain, nil)
+}
+
+// scanOTX queries OTX for domain and the reputation of each of its resolved IPs
+func (p *ScanOTXPlugin) scanOTX(ctx context.Context, domain string, ips []string) (*proto.OTXSecurityResult, error) {
 	if p.db == nil {
 		return nil, fmt.Errorf("database connection not provided")
 	}
@@ -90,59 +124,81 @@ func (p *ScanOTXPlugin) ScanOTX(domain string, dnsScanID string) (*proto.OTXSecu
 	domain = strings.TrimSpace(strings.ToLower(domain))
 
 	// Rate limit
-	if err := p.rateLimiter.Wait(context.Background()); err != nil {
+	if err := p.rateLimiter.Wait(ctx); err != nil {
 		result.Errors = append(result.Errors, fmt.Sprintf("Rate limit error: %v", err))
 		return result, nil
 	}
 
+	// Responses still in the cache are reused rather than queried again
+	var usage intelcache.Usage
+	key := func(endpoint, query string) intelcache.Key {
+		return intelcache.Key{Provider: "otx", Endpoint: endpoint, Query: query}
+	}
+
 	// Query OTX API for general domain info
-	generalInfo, err := p.queryOTXGeneral(domain)
+	generalInfo, err := intelcache.Fetch(ctx, p.cache, key("domain/general", domain), &usage, func() (*proto.OTXGeneralInfo, error) {
+		return p.queryOTXGeneral(ctx, domain)
+	})
 	if err != nil {
-		result.Errors = append(result.Errors, fmt.Sprintf("OTX general query error: %v", err))
+		providerError(&result.Errors, &result.Unavailable, err, "OTX general query error: %v", err)
 	} else {
 		result.GeneralInfo = generalInfo
 	}
 
 	// Query OTX API for malware
-	malware, err := p.queryOTXMalware(domain)
+	malware, err := intelcache.Fetch(ctx, p.cache, key("domain/malware", domain), &usage, func() ([]*proto.OTXMalware, error) {
+		return p.queryOTXMalware(ctx, domain)
+	})
 	if err != nil {
-		result.Errors = append(result.Errors, fmt.Sprintf("OTX malware query error: %v", err))
+		providerError(&result.Errors, &result.Unavailable, err, "OTX malware query error: %v", err)
 	} else {
 		result.Malware = malware
 	}
 
 	// Query OTX API for URLs
-	urls, err := p.queryOTXURLs(domain)
+	urls, err := intelcache.Fetch(ctx, p.cache, key("domain/url_list", domain), &usage, func() ([]*proto.OTXURL, error) {
+		return p.queryOTXURLs(ctx, domain)
+	})
 	if err != nil {
-		result.Errors = append(result.Errors, fmt.Sprintf("OTX URLs query error: %v", err))
+		providerError(&result.Errors, &result.Unavailable, err, "OTX URLs query error: %v", err)
 	} else {
 		result.Urls = urls
 	}
 
 	// Query OTX API for passive DNS
-	passiveDNS, err := p.queryOTXPassiveDNS(domain)
+	passiveDNS, err := intelcache.Fetch(ctx, p.cache, key("domain/passive_dns", domain), &usage, func() ([]*proto.OTXPassiveDNS, error) {
+		return p.queryOTXPassiveDNS(ctx, domain)
+	})
 	if err != nil {
-		result.Errors = append(result.Errors, fmt.Sprintf("OTX passive DNS query error: %v", err))
+		providerError(&result.Errors, &result.Unavailable, err, "OTX passive DNS query error: %v", err)
 	} else {
 		result.PassiveDns = passiveDNS
 	}
 
-	// Store result
-	id, err := p.InsertOTXScanResult(domain, dnsScanID, result)
-	if err != nil {
-		result.Errors = append(result.Errors, fmt.Sprintf("Database storage error: %v", err))
-		log.Printf("Failed to store OTX scan result for %s: %v", domain, err)
-	} else {
-		log.Printf("Stored OTX scan result for %s with ID: %s", domain, id)
+	// Query OTX API for the reputation of each resolved IP
+	for _, ip := range limit(ips, maxOTXIPLookups) {
+		if err := p.rateLimiter.Wait(ctx); err != nil {
+			result.Errors = append(result.Errors, fmt.Sprintf("Rate limit error: %v", err))
+			break
+		}
+		reputation, err := intelcache.Fetch(ctx, p.cache, key("ip/general", ip), &usage, func() (*proto.OTXIPReputation, error) {
+			return p.queryOTXIP(ctx, ip)
+		})
+		if err != nil {
+			providerError(&result.Errors, &result.Unavailable, err, "OTX IP query error for %s: %v", ip, err)
+			continue
+		}
+		result.IpReputation = append(result.IpReputation, reputation)
 	}
 
+	result.Cached, result.CacheAgeSeconds = usage.Cached(), usage.AgeSeconds()
 	return result, nil
 }
 
 // queryOTXGeneral queries the OTX general endpoint
-func (p *ScanOTXPlugin) queryOTXGeneral(domain string) (*proto.OTXGeneralInfo, error) {
-	url := fmt.Sprintf("%sindicators/domain/%s/general", p.config.OTX.BaseURL, domain)
-	req, err := http.NewRequest("GET", url, nil)
+func (p *ScanOTXPlugin) queryOTXGeneral(ctx context.Context, domain string) (*proto.OTXGeneralInfo, error) {
+	url := fmt.Sprintf("%s/indicators/domain/%s/general", p.baseURL, domain)
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -178,9 +234,9 @@ func (p *ScanOTXPlugin) queryOTXGeneral(domain string) (*proto.OTXGeneralInfo, e
 }
 
 // queryOTXMalware queries the OTX malware endpoint
-func (p *ScanOTXPlugin) queryOTXMalware(domain string) ([]*proto.OTXMalware, error) {
-	url := fmt.Sprintf("%sindicators/domain/%s/malware", p.config.OTX.BaseURL, domain)
-	req, err := http.NewRequest("GET", url, nil)
+func (p *ScanOTXPlugin) queryOTXMalware(ctx context.Context, domain string) ([]*proto.OTXMalware, error) {
+	url := fmt.Sprintf("%s/indicators/domain/%s/malware", p.baseURL, domain)
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -225,9 +281,9 @@ func (p *ScanOTXPlugin) queryOTXMalware(domain string) ([]*proto.OTXMalware, err
 }
 
 // queryOTXURLs queries the OTX URLs endpoint
-func (p *ScanOTXPlugin) queryOTXURLs(domain string) ([]*proto.OTXURL, error) {
-	url := fmt.Sprintf("%sindicators/domain/%s/url_list", p.config.OTX.BaseURL, domain)
-	req, err := http.NewRequest("GET", url, nil)
+func (p *ScanOTXPlugin) queryOTXURLs(ctx context.Context, domain string) ([]*proto.OTXURL, error) {
+	url := fmt.Sprintf("%s/indicators/domain/%s/url_list", p.baseURL, domain)
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -272,9 +328,9 @@ func (p *ScanOTXPlugin) queryOTXURLs(domain string) ([]*proto.OTXURL, error) {
 }
 
 // queryOTXPassiveDNS queries the OTX passive DNS endpoint
-func (p *ScanOTXPlugin) queryOTXPassiveDNS(domain string) ([]*proto.OTXPassiveDNS, error) {
-	url := fmt.Sprintf("%sindicators/domain/%s/passive_dns", p.config.OTX.BaseURL, domain)
-	req, err := http.NewRequest("GET", url, nil)
+func (p *ScanOTXPlugin) queryOTXPassiveDNS(ctx context.Context, domain string) ([]*proto.OTXPassiveDNS, error) {
+	url := fmt.Sprintf("%s/indicators/domain/%s/passive_dns", p.baseURL, domain)
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -322,8 +378,55 @@ func (p *ScanOTXPlugin) queryOTXPassiveDNS(domain string) ([]*proto.OTXPassiveDN
 	return passiveDNS, nil
 }
 
+// queryOTXIP queries the OTX general endpoint for an IP address
+func (p *ScanOTXPlugin) queryOTXIP(ctx context.Context, ip string) (*proto.OTXIPReputation, error) {
+	section := "IPv4"
+	if strings.Contains(ip, ":") {
+		section = "IPv6"
+	}
+	url := fmt.Sprintf("%s/indicators/%s/%s/general", p.baseURL, section, ip)
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("X-OTX-API-KEY", p.config.OTX.APIKey)
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("OTX IP query failed: status %d", resp.StatusCode)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var general struct {
+		PulseInfo struct {
+			Count int `json:"count"`
+		} `json:"pulse_info"`
+		Reputation  int    `json:"reputation"`
+		CountryName string `json:"country_name"`
+	}
+	if err := json.Unmarshal(body, &general); err != nil {
+		return nil, err
+	}
+
+	return &proto.OTXIPReputation{
+		Ip:          ip,
+		PulseCount:  int32(general.PulseInfo.Count),
+		Reputation:  int32(general.Reputation),
+		CountryName: general.CountryName,
+	}, nil
+}
+
 // InsertOTXScanResult inserts an OTX scan result into the database
-func (p *ScanOTXPlugin) InsertOTXScanResult(domain string, dnsScanID string, result *proto.OTXSecurityResult) (string, error) {
+func (p *ScanOTXPlugin) InsertOTXScanResult(scanRunID, domain, dnsScanID string, result *proto.OTXSecurityResult) (string, error) {
 	if p.db == nil {
 		return "", fmt.Errorf("database connection not provided")
 	}
@@ -333,10 +436,10 @@ func (p *ScanOTXPlugin) InsertOTXScanResult(domain string, dnsScanID string, res
 		return "", fmt.Errorf("failed to marshal result: %w", err)
 	}
 	query := `
-		INSERT INTO otx_scan_results (id, domain, dns_scan_id, result, created_at)
-		VALUES ($1, $2, $3, $4, $5)
+		INSERT INTO otx_scan_results (id, scan_run_id, domain, dns_scan_id, result, created_at)
+		VALUES ($1, $2, $3, NULLIF($4, '')::uuid, $5, $6)
 	`
-	_, err = p.db.Exec(query, id, domain, dnsScanID, resultJSON, time.Now())
+	_, err = p.db.Exec(query, id, scanRunID, domain, dnsScanID, resultJSON, time.Now())
 	if err != nil {
 		return "", fmt.Errorf("failed to insert OTX scan result: %w", err)
 	}
@@ -349,7 +452,7 @@ func (p *ScanOTXPlugin) GetOTXScanResultsByDomain(domain string) ([]interfaces.O
 		return nil, fmt.Errorf("database connection not provided")
 	}
 	query := `
-		SELECT id, domain, dns_scan_id, result, created_at
+		SELECT id, domain, COALESCE(dns_scan_id::text, ''), result, created_at
 		FROM otx_scan_results
 		WHERE domain = $1
 		ORDER BY created_at DESC
@@ -371,22 +474,35 @@ func (p *ScanOTXPlugin) GetOTXScanResultsByDomain(domain string) ([]interfaces.O
 		if err := json.Unmarshal(resultJSON, &scanResult); err != nil {
 			return nil, fmt.Errorf("failed to unmarshal result: %w", err)
 		}
-		r.Result = scanResult
+		r.Result = &scanResult
 		results = append(results, r)
 	}
 	return results, nil
 }
 
-// Scan implements the GenericPlugin interface
-func (p *ScanOTXPlugin) Scan(ctx context.Context, domain, dnsScanID string) (interface{}, error) {
-	return p.ScanOTX(domain, dnsScanID)
-}
-
-// InsertResult implements the GenericPlugin interface
-func (p *ScanOTXPlugin) InsertResult(domain, dnsScanID string, result interface{}) (string, error) {
-	otxResult, ok := result.(*proto.OTXSecurityResult)
-	if !ok {
-		return "", fmt.Errorf("invalid result type")
+// Scan implements the GenericPlugin interface. It runs the scan and stores the result.
+func (p *ScanOTXPlugin) Scan(ctx context.Context, req interfaces.ScanRequest) (*interfaces.ScanResult, error) {
+	res := interfaces.NewScanResult("ScanOTX")
+	if err := providerReady(p.config, p.quota, "otx"); err != nil {
+		return res.Skip(err.Error()), nil
+	}
+	ctx, cancel := req.Context(ctx)
+	defer cancel()
+	result, err := p.scanOTX(ctx, req.Domain, req.Input(interfaces.ArtifactIPs))
+	if err == nil {
+		err = ctx.Err()
+	}
+	if err != nil {
+		return res.Fail(err), err
+	}
+	domain := strings.TrimSuffix(strings.TrimSpace(strings.ToLower(req.Domain)), ".")
+	id, err := p.InsertOTXScanResult(req.RunID, domain, req.ParentID, result)
+	if err != nil {
+		result.Errors = append(result.Errors, fmt.Sprintf("Database storage error: %v", err))
+		res.Errors = append(res.Errors, fmt.Sprintf("failed to store result: %v", err))
+		log.Printf("Failed to store OTX scan result for %s: %v", domain, err)
+	} else {
+		log.Printf("Stored OTX scan result for %s with ID: %s", domain, id)
 	}
-	return p.InsertOTXScanResult(domain, dnsScanID, otxResult)
+	return res.Succeed(id, result), nil
 }
@@ -6,28 +6,46 @@ import (
 	"fmt"
 	"log"
 	"net"
-	"net/http"
 	"strings"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/moos3/sparta/internal/config"
 	"github.com/moos3/sparta/internal/db"
+	"github.com/moos3/sparta/internal/intelcache"
 	"github.com/moos3/sparta/internal/interfaces"
+	"github.com/moos3/sparta/internal/quota"
+	"github.com/moos3/sparta/internal/registry"
 	"github.com/moos3/sparta/proto"
 	"github.com/shadowscatcher/shodan"
+	"github.com/shadowscatcher/shodan/models"
 	"github.com/shadowscatcher/shodan/search"
 	"golang.org/x/time/rate"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
-// ScanShodanPlugin implements the ShodanScanPlugin interface
+// ScanShodanPlugin implements the GenericPlugin interface
 type ScanShodanPlugin struct {
 	name        string
 	db          db.Database
 	client      *shodan.Client
 	rateLimiter *rate.Limiter
 	config      *config.Config
+	cache       *intelcache.Cache
+	quota       *quota.Tracker
+}
+
+func init() {
+	registry.Register(registry.Plugin{
+		Name:           "ScanShodan",
+		Version:        "1.0.0",
+		Description:    "Looks up exposed hosts and services in Shodan",
+		RequiredConfig: []string{"shodan.api_key"},
+		Passive:        true,
+		Dependencies:   []string{"ScanDNS"},
+		Inputs:         []interfaces.Artifact{interfaces.ArtifactIPs},
+		New:            func() interfaces.GenericPlugin { return &ScanShodanPlugin{} },
+	})
 }
 
 // Name returns the plugin name
@@ -51,15 +69,19 @@ func (p *ScanShodanPlugin) Initialize() error {
 		return fmt.Errorf("Shodan API key not provided")
 	}
 
-	// Create HTTP client with timeout
-	httpClient := &http.Client{
-		Timeout: 10 * time.Second,
+	// Create HTTP client that counts calls against the provider's quota and
+	// retries throttled requests
+	p.quota = quota.New(p.db, p.config)
+	httpClient, err := providerClient(p.config, p.quota, "shodan")
+	if err != nil {
+		return err
 	}
 	client, err := shodan.GetClient(p.config.Shodan.APIKey, httpClient, true)
 	if err != nil {
 		return fmt.Errorf("failed to initialize Shodan client: %w", err)
 	}
 	p.client = client
+	p.cache = intelcache.New(p.db, p.config)
 	log.Printf("Initialized Shodan client for plugin %s", p.name)
 
 	// Initialize rate limiter (requests per second = 1000ms / delay)
@@ -83,8 +105,17 @@ func (p *ScanShodanPlugin) SetConfig(cfg *config.Config) error {
 	return nil
 }
 
+// maxShodanHostLookups caps how many resolved IPs are looked up per scan
+const maxShodanHostLookups = 5
+
 // ScanShodan queries Shodan API for host information
-func (p *ScanShodanPlugin) ScanShodan(domain string, dnsScanID string) (*proto.ShodanSecurityResult, error) {
+func (p *ScanShodanPlugin) ScanShodan(ctx context.Context, domain string, dnsScanID string) (*proto.ShodanSecurityResult, error) {
+	return p.scanShodan(ctx, domain, nil)
+}
+
+// scanShodan searches Shodan for hosts serving domain and looks up each of
+// the resolved IPs
+func (p *ScanShodanPlugin) scanShodan(ctx context.Context, domain string, ips []string) (*proto.ShodanSecurityResult, error) {
 	if p.db == nil {
 		return nil, fmt.Errorf("database connection not provided")
 	}
@@ -100,7 +131,7 @@ func (p *ScanShodanPlugin) ScanShodan(domain string, dnsScanID string) (*proto.S
 	domain = strings.TrimSpace(strings.ToLower(domain))
 
 	// Rate limit
-	if err := p.rateLimiter.Wait(context.Background()); err != nil {
+	if err := p.rateLimiter.Wait(ctx); err != nil {
 		result.Errors = append(result.Errors, fmt.Sprintf("Rate limit error: %v", err))
 		return result, nil
 	}
@@ -111,124 +142,151 @@ func (p *ScanShodanPlugin) ScanShodan(domain string, dnsScanID string) (*proto.S
 			Hostname: fmt.Sprintf("%s", domain),
 		},
 	}
-	hosts, err := p.client.Search(context.Background(), params)
+	var usage intelcache.Usage
+	hosts, err := intelcache.Fetch(ctx, p.cache, intelcache.Key{Provider: "shodan", Endpoint: "search", Query: "hostname:" + domain}, &usage,
+		func() (models.SearchResult, error) { return p.client.Search(ctx, params) })
 	if err != nil {
-		result.Errors = append(result.Errors, fmt.Sprintf("Shodan API query error: %v", err))
-		return result, nil
+		providerError(&result.Errors, &result.Unavailable, err, "Shodan API query error: %v", err)
 	}
 
 	// Collect host information
+	seen := make(map[string]bool)
 	for _, host := range hosts.Matches {
-		ipStr := ""
-		if host.IP != nil {
-			ip := net.IPv4(byte(*host.IP>>24), byte(*host.IP>>16), byte(*host.IP>>8), byte(*host.IP)).String()
-			ipStr = ip
-		}
-		osStr := ""
-		if host.OS != nil {
-			osStr = *host.OS
-		}
-		asnStr := ""
-		if host.ASN != nil {
-			asnStr = *host.ASN
-		}
-		orgStr := ""
-		if host.Org != nil {
-			orgStr = *host.Org
+		h := shodanHost(host, result)
+		seen[net.JoinHostPort(h.Ip, fmt.Sprint(h.Port))] = true
+		result.Hosts = append(result.Hosts, h)
+	}
+
+	// Look up the resolved IPs, which the hostname search misses when
+	// Shodan has not seen the name in a banner or certificate
+	for _, ip := range limit(ips, maxShodanHostLookups) {
+		if err := p.rateLimiter.Wait(ctx); err != nil {
+			result.Errors = append(result.Errors, fmt.Sprintf("Rate limit error: %v", err))
+			break
 		}
-		ispStr := ""
-		if host.ISP != nil {
-			ispStr = *host.ISP
+		info, err := intelcache.Fetch(ctx, p.cache, intelcache.Key{Provider: "shodan", Endpoint: "host", Query: ip}, &usage,
+			func() (models.Host, error) { return p.client.Host(ctx, search.HostParams{IP: ip}) })
+		if err != nil {
+			providerError(&result.Errors, &result.Unavailable, err, "Shodan host lookup error for %s: %v", ip, err)
+			continue
 		}
-		var ssl *proto.ShodanSSL
-		if host.SSL != nil && host.SSL.Cert.Issuer.CN != "" {
-			issuer := ""
-			if host.SSL.Cert.Issuer.CN != "" {
-				issuer = host.SSL.Cert.Issuer.CN
-			}
-			subject := ""
-			if host.SSL.Cert.Subject.CN != "" {
-				subject = host.SSL.Cert.Subject.CN
-			}
-			var expires, notAfter *timestamppb.Timestamp
-			if host.SSL.Cert.Expires != "" {
-				parsedTime, err := time.Parse(time.RFC3339, host.SSL.Cert.Expires)
-				if err != nil {
-					result.Errors = append(result.Errors, fmt.Sprintf("Failed to parse SSL expires time: %v", err))
-				} else {
-					expires = timestamppb.New(parsedTime)
-					notAfter = timestamppb.New(parsedTime)
-				}
+		for _, service := range info.Services {
+			h := shodanHost(*service, result)
+			if h.Ip == "" {
+				h.Ip = ip
 			}
-			ssl = &proto.ShodanSSL{
-				Issuer:   issuer,
-				Subject:  subject,
-				Expires:  expires,
-				NotAfter: notAfter,
+			key := net.JoinHostPort(h.Ip, fmt.Sprint(h.Port))
+			if !seen[key] {
+				seen[key] = true
+				result.Hosts = append(result.Hosts, h)
 			}
 		}
-		location := &proto.ShodanLocation{
-			City:        "",
-			CountryName: "",
-			Latitude:    0.0,
-			Longitude:   0.0,
-		}
-		if host.Location.City != nil {
-			location.City = *host.Location.City
-		}
-		if host.Location.CountryName != nil {
-			location.CountryName = *host.Location.CountryName
-		}
-		if host.Location.Latitude != nil {
-			location.Latitude = float32(*host.Location.Latitude)
+	}
+
+	result.Cached, result.CacheAgeSeconds = usage.Cached(), usage.AgeSeconds()
+	return result, nil
+}
+
+// shodanHost converts a Shodan service banner, recording parse errors on result
+func shodanHost(host models.Service, result *proto.ShodanSecurityResult) *proto.ShodanHost {
+	ipStr := ""
+	if host.IP != nil {
+		ip := net.IPv4(byte(*host.IP>>24), byte(*host.IP>>16), byte(*host.IP>>8), byte(*host.IP)).String()
+		ipStr = ip
+	}
+	osStr := ""
+	if host.OS != nil {
+		osStr = *host.OS
+	}
+	asnStr := ""
+	if host.ASN != nil {
+		asnStr = *host.ASN
+	}
+	orgStr := ""
+	if host.Org != nil {
+		orgStr = *host.Org
+	}
+	ispStr := ""
+	if host.ISP != nil {
+		ispStr = *host.ISP
+	}
+	var ssl *proto.ShodanSSL
+	if host.SSL != nil && host.SSL.Cert.Issuer.CN != "" {
+		issuer := ""
+		if host.SSL.Cert.Issuer.CN != "" {
+			issuer = host.SSL.Cert.Issuer.CN
 		}
-		if host.Location.Longitude != nil {
-			location.Longitude = float32(*host.Location.Longitude)
+		subject := ""
+		if host.SSL.Cert.Subject.CN != "" {
+			subject = host.SSL.Cert.Subject.CN
 		}
-		var timestamp *timestamppb.Timestamp
-		if host.Timestamp != "" {
-			parsedTime, err := time.Parse(time.RFC3339, host.Timestamp)
+		var expires, notAfter *timestamppb.Timestamp
+		if host.SSL.Cert.Expires != "" {
+			parsedTime, err := time.Parse(time.RFC3339, host.SSL.Cert.Expires)
 			if err != nil {
-				result.Errors = append(result.Errors, fmt.Sprintf("Failed to parse host timestamp: %v", err))
+				result.Errors = append(result.Errors, fmt.Sprintf("Failed to parse SSL expires time: %v", err))
 			} else {
-				timestamp = timestamppb.New(parsedTime)
+				expires = timestamppb.New(parsedTime)
+				notAfter = timestamppb.New(parsedTime)
 			}
 		}
-		shodanMeta := &proto.ShodanMetadata{
-			Module: host.Shodan.Module,
+		ssl = &proto.ShodanSSL{
+			Issuer:   issuer,
+			Subject:  subject,
+			Expires:  expires,
+			NotAfter: notAfter,
 		}
-		result.Hosts = append(result.Hosts, &proto.ShodanHost{
-			Ip:         ipStr,
-			Port:       int32(host.Port),
-			Hostnames:  host.Hostnames,
-			Os:         osStr,
-			Banner:     host.Data,
-			Tags:       host.Tags,
-			Location:   location,
-			Ssl:        ssl,
-			Domains:    host.Domains,
-			Asn:        asnStr,
-			Org:        orgStr,
-			Isp:        ispStr,
-			Timestamp:  timestamp,
-			ShodanMeta: shodanMeta,
-		})
 	}
-
-	// Store result
-	id, err := p.InsertShodanScanResult(domain, dnsScanID, result)
-	if err != nil {
-		result.Errors = append(result.Errors, fmt.Sprintf("Database storage error: %v", err))
-		log.Printf("Failed to store Shodan scan result for %s: %v", domain, err)
-	} else {
-		log.Printf("Stored Shodan scan result for %s with ID: %s", domain, id)
+	location := &proto.ShodanLocation{
+		City:        "",
+		CountryName: "",
+		Latitude:    0.0,
+		Longitude:   0.0,
+	}
+	if host.Location.City != nil {
+		location.City = *host.Location.City
+	}
+	if host.Location.CountryName != nil {
+		location.CountryName = *host.Location.CountryName
+	}
+	if host.Location.Latitude != nil {
+		location.Latitude = float32(*host.Location.Latitude)
+	}
+	if host.Location.Longitude != nil {
+		location.Longitude = float32(*host.Location.Longitude)
+	}
+	var timestamp *timestamppb.Timestamp
+	if host.Timestamp != "" {
+		parsedTime, err := time.Parse(time.RFC3339, host.Timestamp)
+		if err != nil {
+			result.Errors = append(result.Errors, fmt.Sprintf("Failed to parse host timestamp: %v", err))
+		} else {
+			timestamp = timestamppb.New(parsedTime)
+		}
+	}
+	shodanMeta := &proto.ShodanMetadata{
+		Module: host.Shodan.Module,
+	}
+	return &proto.ShodanHost{
+		Ip:         ipStr,
+		Port:       int32(host.Port),
+		Hostnames:  host.Hostnames,
+		Os:         osStr,
+		Banner:     host.Data,
+		Tags:       host.Tags,
+		Location:   location,
+		Ssl:        ssl,
+		Domains:    host.Domains,
+		Asn:        asnStr,
+		Org:        orgStr,
+		Isp:        ispStr,
+		Timestamp:  timestamp,
+		ShodanMeta: shodanMeta,
 	}
-
-	return result, nil
 }
 
 // InsertShodanScanResult inserts a Shodan scan result into the database
-func (p *ScanShodanPlugin) InsertShodanScanResult(domain string, dnsScanID string, result *proto.ShodanSecurityResult) (string, error) {
+func (p *ScanShodanPlugin) InsertShodanScanResult(scanRunID, domain, dnsScanID string, result *proto.ShodanSecurityResult) (string, error) {
 	if p.db == nil {
 		return "", fmt.Errorf("database connection not provided")
 	}
@@ -238,10 +296,10 @@ func (p *ScanShodanPlugin) InsertShodanScanResult(domain string, dnsScanID strin
 		return "", fmt.Errorf("failed to marshal result: %w", err)
 	}
 	query := `
-		INSERT INTO shodan_scan_results (id, domain, dns_scan_id, result, created_at)
-		VALUES ($1, $2, $3, $4, $5)
+		INSERT INTO shodan_scan_results (id, scan_run_id, domain, dns_scan_id, result, created_at)
+		VALUES ($1, $2, $3, NULLIF($4, '')::uuid, $5, $6)
 	`
-	_, err = p.db.Exec(query, id, domain, dnsScanID, resultJSON, time.Now())
+	_, err = p.db.Exec(query, id, scanRunID, domain, dnsScanID, resultJSON, time.Now())
 	if err != nil {
 		return "", fmt.Errorf("failed to insert Shodan scan result: %w", err)
 	}
@@ -254,7 +312,7 @@ func (p *ScanShodanPlugin) GetShodanScanResultsByDomain(domain string) ([]interf
 		return nil, fmt.Errorf("database connection not provided")
 	}
 	query := `
-		SELECT id, domain, dns_scan_id, result, created_at
+		SELECT id, domain, COALESCE(dns_scan_id::text, ''), result, created_at
 		FROM shodan_scan_results
 		WHERE domain = $1
 		ORDER BY created_at DESC
@@ -276,22 +334,35 @@ func (p *ScanShodanPlugin) GetShodanScanResultsByDomain(domain string) ([]interf
 		if err := json.Unmarshal(resultJSON, &scanResult); err != nil {
 			return nil, fmt.Errorf("failed to unmarshal result: %w", err)
 		}
-		r.Result = scanResult
+		r.Result = &scanResult
 		results = append(results, r)
 	}
 	return results, nil
 }
 
-// Scan implements the GenericPlugin interface
-func (p *ScanShodanPlugin) Scan(ctx context.Context, domain, dnsScanID string) (interface{}, error) {
-	return p.ScanShodan(domain, dnsScanID)
-}
-
-// InsertResult implements the GenericPlugin interface
-func (p *ScanShodanPlugin) InsertResult(domain, dnsScanID string, result interface{}) (string, error) {
-	shodanResult, ok := result.(*proto.ShodanSecurityResult)
-	if !ok {
-		return "", fmt.Errorf("invalid result type")
+// Scan implements the GenericPlugin interface. It runs the scan and stores the result.
+func (p *ScanShodanPlugin) Scan(ctx context.Context, req interfaces.ScanRequest) (*interfaces.ScanResult, error) {
+	res := interfaces.NewScanResult("ScanShodan")
+	if err := providerReady(p.config, p.quota, "shodan"); err != nil {
+		return res.Skip(err.Error()), nil
+	}
+	ctx, cancel := req.Context(ctx)
+	defer cancel()
+	result, err := p.scanShodan(ctx, req.Domain, req.Input(interfaces.ArtifactIPs))
+	if err == nil {
+		err = ctx.Err()
+	}
+	if err != nil {
+		return res.Fail(err), err
+	}
+	domain := strings.TrimSuffix(strings.TrimSpace(strings.ToLower(req.Domain)), ".")
+	id, err := p.InsertShodanScanResult(req.RunID, domain, req.ParentID, result)
+	if err != nil {
+		result.Errors = append(result.Errors, fmt.Sprintf("Database storage error: %v", err))
+		res.Errors = append(res.Errors, fmt.Sprintf("failed to store result: %v", err))
+		log.Printf("Failed to store Shodan scan result for %s: %v", domain, err)
+	} else {
+		log.Printf("Stored Shodan scan result for %s with ID: %s", domain, id)
 	}
-	return p.InsertShodanScanResult(domain, dnsScanID, shodanResult)
+	return res.Succeed(id, result), nil
 }
@@ -10,6 +10,7 @@ import (
 	"log"
 	"net"
 	"net/http"
+	"net/smtp"
 	"strings"
 	"time"
 
@@ -17,17 +18,30 @@ import (
 	"github.com/moos3/sparta/internal/config"
 	"github.com/moos3/sparta/internal/db"
 	"github.com/moos3/sparta/internal/interfaces"
+	"github.com/moos3/sparta/internal/registry"
 	"github.com/moos3/sparta/proto"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
-// ScanTLSPlugin implements the TLSScanPlugin interface
+// ScanTLSPlugin implements the GenericPlugin interface
 type ScanTLSPlugin struct {
 	name   string
 	db     db.Database
 	config *config.Config
 }
 
+func init() {
+	registry.Register(registry.Plugin{
+		Name:         "ScanTLS",
+		Version:      "1.0.0",
+		Description:  "Inspects the TLS handshake and certificate served by the domain",
+		Passive:      false,
+		Dependencies: []string{"ScanDNS"},
+		Inputs:       []interfaces.Artifact{interfaces.ArtifactIPs, interfaces.ArtifactMX},
+		New:          func() interfaces.GenericPlugin { return &ScanTLSPlugin{} },
+	})
+}
+
 // Name returns the plugin name
 func (p *ScanTLSPlugin) Name() string {
 	log.Printf("ScanTLSPlugin.Name called, returning: ScanTLS")
@@ -57,8 +71,19 @@ func (p *ScanTLSPlugin) SetConfig(cfg *config.Config) error {
 	return nil
 }
 
+// maxTLSEndpoints caps how many resolved IPs and MX hosts are probed per scan
+const maxTLSEndpoints = 4
+
 // ScanTLS performs TLS configuration assessment
-func (p *ScanTLSPlugin) ScanTLS(domain string, dnsScanID string) (*proto.TLSSecurityResult, error) {
+func (p *ScanTLSPlugin) ScanTLS(ctx context.Context, domain string, dnsScanID string) (*proto.TLSSecurityResult, error) {
+	return p.scanTLS(ctx, domain, nil, nil)
+}
+
+// scanTLS assesses the TLS configuration served for domain. When the
+// resolved IPs are known each is probed with the domain as SNI and the first
+// successful handshake supplies the certificate details; otherwise the domain
+// itself is dialed. Each MX host is probed with STARTTLS.
+func (p *ScanTLSPlugin) scanTLS(ctx context.Context, domain string, ips, mxHosts []string) (*proto.TLSSecurityResult, error) {
 	if p.db == nil {
 		return nil, fmt.Errorf("database connection not provided")
 	}
@@ -68,20 +93,53 @@ func (p *ScanTLSPlugin) ScanTLS(domain string, dnsScanID string) (*proto.TLSSecu
 	}
 
 	// Normalize domain
-	domain = strings.TrimSpace(strings.ToLower(domain))
-	if !strings.HasSuffix(domain, ":443") {
-		domain = domain + ":443"
+	domain = strings.TrimSuffix(strings.TrimSuffix(strings.TrimSpace(strings.ToLower(domain)), ":443"), ".")
+
+	// Dial TLS connections, by IP when DNS resolved them
+	targets := []string{domain}
+	if len(ips) > 0 {
+		targets = limit(ips, maxTLSEndpoints)
+	}
+	var conn *tls.Conn
+	var dialErr error
+	for _, target := range targets {
+		c, err := dialTLS(ctx, net.JoinHostPort(target, "443"), domain)
+		endpoint := &proto.TLSEndpoint{Host: target, Port: 443}
+		if err != nil {
+			endpoint.Error = err.Error()
+			dialErr = err
+		} else {
+			describeEndpoint(endpoint, c.ConnectionState())
+		}
+		if len(ips) > 0 {
+			result.Endpoints = append(result.Endpoints, endpoint)
+		}
+		switch {
+		case err != nil:
+		case conn == nil:
+			conn = c
+			defer conn.Close()
+		default:
+			c.Close()
+		}
 	}
 
-	// Dial TLS connection
-	conn, err := tls.DialWithDialer(&net.Dialer{Timeout: 5 * time.Second}, "tcp", domain, &tls.Config{
-		InsecureSkipVerify: false,
-	})
-	if err != nil {
-		result.Errors = append(result.Errors, fmt.Sprintf("Failed to establish TLS connection: %v", err))
+	// Probe mail exchangers
+	for _, mx := range limit(mxHosts, maxTLSEndpoints) {
+		endpoint := &proto.TLSEndpoint{Host: mx, Port: 25}
+		state, err := probeSTARTTLS(ctx, mx)
+		if err != nil {
+			endpoint.Error = err.Error()
+		} else {
+			describeEndpoint(endpoint, state)
+		}
+		result.Endpoints = append(result.Endpoints, endpoint)
+	}
+
+	if conn == nil {
+		result.Errors = append(result.Errors, fmt.Sprintf("Failed to establish TLS connection: %v", dialErr))
 		return result, nil
 	}
-	defer conn.Close()
 
 	// Get TLS version and cipher suite
 	result.TlsVersion = tlsVersionToString(conn.ConnectionState().Version)
@@ -110,23 +168,75 @@ func (p *ScanTLSPlugin) ScanTLS(domain string, dnsScanID string) (*proto.TLSSecu
 	}
 
 	// Check HSTS header
-	hstsEnabled, err := checkHSTS(domain)
+	hstsEnabled, err := checkHSTS(ctx, domain)
 	if err != nil {
 		result.Errors = append(result.Errors, fmt.Sprintf("HSTS check error: %v", err))
 	} else {
 		result.HstsHeader = hstsEnabled
 	}
 
-	// Store result
-	id, err := p.InsertTLSScanResult(strings.TrimSuffix(domain, ":443"), dnsScanID, result)
+	return result, nil
+}
+
+// dialTLS opens a verified TLS connection to addr using serverName for SNI
+func dialTLS(ctx context.Context, addr, serverName string) (*tls.Conn, error) {
+	dialer := &tls.Dialer{
+		NetDialer: &net.Dialer{Timeout: 5 * time.Second},
+		Config:    &tls.Config{ServerName: serverName, InsecureSkipVerify: false},
+	}
+	conn, err := dialer.DialContext(ctx, "tcp", addr)
 	if err != nil {
-		result.Errors = append(result.Errors, fmt.Sprintf("Database storage error: %v", err))
-		log.Printf("Failed to store TLS scan result for %s: %v", domain, err)
-	} else {
-		log.Printf("Stored TLS scan result for %s with ID: %s", domain, id)
+		return nil, err
 	}
+	return conn.(*tls.Conn), nil
+}
 
-	return result, nil
+// probeSTARTTLS upgrades an SMTP session with host to TLS and returns the
+// resulting connection state
+func probeSTARTTLS(ctx context.Context, host string) (tls.ConnectionState, error) {
+	dialer := &net.Dialer{Timeout: 5 * time.Second}
+	conn, err := dialer.DialContext(ctx, "tcp", net.JoinHostPort(host, "25"))
+	if err != nil {
+		return tls.ConnectionState{}, err
+	}
+	defer conn.Close()
+	deadline := time.Now().Add(10 * time.Second)
+	if d, ok := ctx.Deadline(); ok && d.Before(deadline) {
+		deadline = d
+	}
+	conn.SetDeadline(deadline)
+
+	client, err := smtp.NewClient(conn, host)
+	if err != nil {
+		return tls.ConnectionState{}, err
+	}
+	defer client.Close()
+	if ok, _ := client.Extension("STARTTLS"); !ok {
+		return tls.ConnectionState{}, fmt.Errorf("STARTTLS not offered")
+	}
+	if err := client.StartTLS(&tls.Config{ServerName: host}); err != nil {
+		return tls.ConnectionState{}, err
+	}
+	state, _ := client.TLSConnectionState()
+	return state, nil
+}
+
+// describeEndpoint fills in the negotiated parameters of a probed endpoint
+func describeEndpoint(endpoint *proto.TLSEndpoint, state tls.ConnectionState) {
+	endpoint.TlsVersion = tlsVersionToString(state.Version)
+	endpoint.CipherSuite = tls.CipherSuiteName(state.CipherSuite)
+	if len(state.PeerCertificates) > 0 {
+		cert := state.PeerCertificates[0]
+		endpoint.CertificateValid = time.Now().After(cert.NotBefore) && time.Now().Before(cert.NotAfter)
+	}
+}
+
+// limit returns at most n values
+func limit(values []string, n int) []string {
+	if len(values) > n {
+		return values[:n]
+	}
+	return values
 }
 
 // tlsVersionToString converts TLS version to string
@@ -146,14 +256,18 @@ func tlsVersionToString(version uint16) string {
 }
 
 // checkHSTS checks for HSTS header
-func checkHSTS(domain string) (bool, error) {
+func checkHSTS(ctx context.Context, domain string) (bool, error) {
 	client := &http.Client{
 		Timeout: 5 * time.Second,
 		Transport: &http.Transport{
 			TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
 		},
 	}
-	resp, err := client.Get("https://" + strings.TrimSuffix(domain, ":443"))
+	req, err := http.NewRequestWithContext(ctx, "GET", "https://"+domain, nil)
+	if err != nil {
+		return false, err
+	}
+	resp, err := client.Do(req)
 	if err != nil {
 		return false, err
 	}
@@ -164,7 +278,7 @@ func checkHSTS(domain string) (bool, error) {
 }
 
 // InsertTLSScanResult inserts a TLS scan result into the database
-func (p *ScanTLSPlugin) InsertTLSScanResult(domain string, dnsScanID string, result *proto.TLSSecurityResult) (string, error) {
+func (p *ScanTLSPlugin) InsertTLSScanResult(scanRunID, domain, dnsScanID string, result *proto.TLSSecurityResult) (string, error) {
 	if p.db == nil {
 		return "", fmt.Errorf("database connection not provided")
 	}
@@ -174,10 +288,10 @@ func (p *ScanTLSPlugin) InsertTLSScanResult(domain string, dnsScanID string, res
 		return "", fmt.Errorf("failed to marshal result: %w", err)
 	}
 	query := `
-		INSERT INTO tls_scan_results (id, domain, dns_scan_id, result, created_at)
-		VALUES ($1, $2, $3, $4, $5)
+		INSERT INTO tls_scan_results (id, scan_run_id, domain, dns_scan_id, result, created_at)
+		VALUES ($1, $2, $3, NULLIF($4, '')::uuid, $5, $6)
 	`
-	_, err = p.db.Exec(query, id, domain, dnsScanID, resultJSON, time.Now())
+	_, err = p.db.Exec(query, id, scanRunID, domain, dnsScanID, resultJSON, time.Now())
 	if err != nil {
 		return "", fmt.Errorf("failed to insert TLS scan result: %w", err)
 	}
@@ -190,7 +304,7 @@ func (p *ScanTLSPlugin) GetTLSScanResultsByDomain(domain string) ([]interfaces.T
 		return nil, fmt.Errorf("database connection not provided")
 	}
 	query := `
-		SELECT id, domain, dns_scan_id, result, created_at
+		SELECT id, domain, COALESCE(dns_scan_id::text, ''), result, created_at
 		FROM tls_scan_results
 		WHERE domain = $1
 		ORDER BY created_at DESC
@@ -212,22 +326,32 @@ func (p *ScanTLSPlugin) GetTLSScanResultsByDomain(domain string) ([]interfaces.T
 		if err := json.Unmarshal(resultJSON, &scanResult); err != nil {
 			return nil, fmt.Errorf("failed to unmarshal result: %w", err)
 		}
-		r.Result = scanResult
+		r.Result = &scanResult
 		results = append(results, r)
 	}
 	return results, nil
 }
 
-// Scan implements the GenericPlugin interface
-func (p *ScanTLSPlugin) Scan(ctx context.Context, domain, dnsScanID string) (interface{}, error) {
-	return p.ScanTLS(domain, dnsScanID)
-}
-
-// InsertResult implements the GenericPlugin interface
-func (p *ScanTLSPlugin) InsertResult(domain, dnsScanID string, result interface{}) (string, error) {
-	tlsResult, ok := result.(*proto.TLSSecurityResult)
-	if !ok {
-		return "", fmt.Errorf("invalid result type")
+// Scan implements the GenericPlugin interface. It runs the scan and stores the result.
+func (p *ScanTLSPlugin) Scan(ctx context.Context, req interfaces.ScanRequest) (*interfaces.ScanResult, error) {
+	res := interfaces.NewScanResult("ScanTLS")
+	ctx, cancel := req.Context(ctx)
+	defer cancel()
+	result, err := p.scanTLS(ctx, req.Domain, req.Input(interfaces.ArtifactIPs), req.Input(interfaces.ArtifactMX))
+	if err == nil {
+		err = ctx.Err()
+	}
+	if err != nil {
+		return res.Fail(err), err
+	}
+	domain := strings.TrimSuffix(strings.TrimSpace(strings.ToLower(req.Domain)), ".")
+	id, err := p.InsertTLSScanResult(req.RunID, domain, req.ParentID, result)
+	if err != nil {
+		result.Errors = append(result.Errors, fmt.Sprintf("Database storage error: %v", err))
+		res.Errors = append(res.Errors, fmt.Sprintf("failed to store result: %v", err))
+		log.Printf("Failed to store TLS scan result for %s: %v", domain, err)
+	} else {
+		log.Printf("Stored TLS scan result for %s with ID: %s", domain, id)
 	}
-	return p.InsertTLSScanResult(domain, dnsScanID, tlsResult)
+	return res.Succeed(id, result), nil
 }
@@ -14,6 +14,7 @@ import (
 	"github.com/moos3/sparta/internal/config"
 	"github.com/moos3/sparta/internal/db"
 	"github.com/moos3/sparta/internal/interfaces"
+	"github.com/moos3/sparta/internal/registry"
 	"github.com/moos3/sparta/proto"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
@@ -24,6 +25,17 @@ type ScanWhoisPlugin struct {
 	config *config.Config
 }
 
+func init() {
+	registry.Register(registry.Plugin{
+		Name:         "ScanWhois",
+		Version:      "1.0.0",
+		Description:  "Retrieves WHOIS registration data",
+		Passive:      true,
+		Dependencies: []string{"ScanDNS"},
+		New:          func() interfaces.GenericPlugin { return &ScanWhoisPlugin{} },
+	})
+}
+
 func (p *ScanWhoisPlugin) Initialize() error {
 	p.name = "ScanWhois"
 	if p.db == nil {
@@ -34,8 +46,9 @@ func (p *ScanWhoisPlugin) Initialize() error {
 	return nil
 }
 
+// Name returns the plugin name
 func (p *ScanWhoisPlugin) Name() string {
-	return p.name
+	return "ScanWhois"
 }
 
 func (p *ScanWhoisPlugin) SetDatabase(db db.Database) {
@@ -49,7 +62,7 @@ func (p *ScanWhoisPlugin) SetConfig(cfg *config.Config) error {
 	return nil
 }
 
-func (p *ScanWhoisPlugin) ScanWhois(domain, dnsScanID string) (*proto.WhoisSecurityResult, error) {
+func (p *ScanWhoisPlugin) ScanWhois(ctx context.Context, domain, dnsScanID string) (*proto.WhoisSecurityResult, error) {
 	if p.db == nil {
 		return nil, fmt.Errorf("database connection not provided")
 	}
@@ -57,8 +70,25 @@ func (p *ScanWhoisPlugin) ScanWhois(domain, dnsScanID string) (*proto.WhoisSecur
 	domain = strings.TrimSpace(strings.ToLower(domain))
 	domain = strings.TrimSuffix(domain, ".")
 
-	// Perform Whois query
-	result, err := whois.Whois(domain)
+	// Perform Whois query; the whois client has no context support, so give up
+	// on it rather than block a cancelled scan
+	type whoisReply struct {
+		raw string
+		err error
+	}
+	replies := make(chan whoisReply, 1)
+	go func() {
+		raw, err := whois.Whois(domain)
+		replies <- whoisReply{raw, err}
+	}()
+	var result string
+	var err error
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case reply := <-replies:
+		result, err = reply.raw, reply.err
+	}
 	if err != nil {
 		return &proto.WhoisSecurityResult{Errors: []string{fmt.Sprintf("Whois query failed: %v", err)}}, nil
 	}
@@ -86,18 +116,10 @@ func (p *ScanWhoisPlugin) ScanWhois(domain, dnsScanID string) (*proto.WhoisSecur
 		ExpiryDate: timestamppb.New(expirationDate),
 		Errors:     []string{},
 	}
-	// Store result
-	id, err := p.InsertWhoisScanResult(domain, dnsScanID, whoisResult)
-	if err != nil {
-		whoisResult.Errors = append(whoisResult.Errors, fmt.Sprintf("Database storage error: %v", err))
-		log.Printf("Failed to store Whois scan result for %s: %v", domain, err)
-	} else {
-		log.Printf("Stored Whois scan result for %s with ID: %s", domain, id)
-	}
 	return whoisResult, nil
 }
 
-func (p *ScanWhoisPlugin) InsertWhoisScanResult(domain, dnsScanID string, result *proto.WhoisSecurityResult) (string, error) {
+func (p *ScanWhoisPlugin) InsertWhoisScanResult(scanRunID, domain, dnsScanID string, result *proto.WhoisSecurityResult) (string, error) {
 	if p.db == nil {
 		return "", fmt.Errorf("database connection not provided")
 	}
@@ -107,10 +129,10 @@ func (p *ScanWhoisPlugin) InsertWhoisScanResult(domain, dnsScanID string, result
 		return "", fmt.Errorf("failed to marshal result: %w", err)
 	}
 	query := `
-		INSERT INTO whois_scan_results (id, domain, dns_scan_id, result, created_at)
-		VALUES ($1, $2, $3, $4, $5)
+		INSERT INTO whois_scan_results (id, scan_run_id, domain, dns_scan_id, result, created_at)
+		VALUES ($1, $2, $3, NULLIF($4, '')::uuid, $5, $6)
 	`
-	_, err = p.db.Exec(query, id, domain, dnsScanID, resultJSON, time.Now())
+	_, err = p.db.Exec(query, id, scanRunID, domain, dnsScanID, resultJSON, time.Now())
 	if err != nil {
 		return "", fmt.Errorf("failed to insert Whois scan result: %w", err)
 	}
@@ -122,7 +144,7 @@ func (p *ScanWhoisPlugin) GetWhoisScanResultsByDomain(domain string) ([]interfac
 		return nil, fmt.Errorf("database connection not provided")
 	}
 	query := `
-		SELECT id, domain, dns_scan_id, result, created_at
+		SELECT id, domain, COALESCE(dns_scan_id::text, ''), result, created_at
 		FROM whois_scan_results
 		WHERE domain = $1
 		ORDER BY created_at DESC
@@ -144,22 +166,32 @@ func (p *ScanWhoisPlugin) GetWhoisScanResultsByDomain(domain string) ([]interfac
 		if err := json.Unmarshal(resultJSON, &scanResult); err != nil {
 			return nil, fmt.Errorf("failed to unmarshal result: %w", err)
 		}
-		r.Result = scanResult
+		r.Result = &scanResult
 		results = append(results, r)
 	}
 	return results, nil
 }
 
-// Scan implements the GenericPlugin interface
-func (p *ScanWhoisPlugin) Scan(ctx context.Context, domain, dnsScanID string) (interface{}, error) {
-	return p.ScanWhois(domain, dnsScanID)
-}
-
-// InsertResult implements the GenericPlugin interface
-func (p *ScanWhoisPlugin) InsertResult(domain, dnsScanID string, result interface{}) (string, error) {
-	whoisResult, ok := result.(*proto.WhoisSecurityResult)
-	if !ok {
-		return "", fmt.Errorf("invalid result type")
+// Scan implements the GenericPlugin interface. It runs the scan and stores the result.
+func (p *ScanWhoisPlugin) Scan(ctx context.Context, req interfaces.ScanRequest) (*interfaces.ScanResult, error) {
+	res := interfaces.NewScanResult("ScanWhois")
+	ctx, cancel := req.Context(ctx)
+	defer cancel()
+	result, err := p.ScanWhois(ctx, req.Domain, req.ParentID)
+	if err == nil {
+		err = ctx.Err()
+	}
+	if err != nil {
+		return res.Fail(err), err
+	}
+	domain := strings.TrimSuffix(strings.TrimSpace(strings.ToLower(req.Domain)), ".")
+	id, err := p.InsertWhoisScanResult(req.RunID, domain, req.ParentID, result)
+	if err != nil {
+		result.Errors = append(result.Errors, fmt.Sprintf("Database storage error: %v", err))
+		res.Errors = append(res.Errors, fmt.Sprintf("failed to store result: %v", err))
+		log.Printf("Failed to store Whois scan result for %s: %v", domain, err)
+	} else {
+		log.Printf("Stored Whois scan result for %s with ID: %s", domain, id)
 	}
-	return p.InsertWhoisScanResult(domain, dnsScanID, whoisResult)
+	return res.Succeed(id, result), nil
 }
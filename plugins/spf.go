@@ -0,0 +1,411 @@
+// plugins/spf.go
+package plugins
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"strconv"
+	"strings"
+
+	"github.com/miekg/dns"
+	"github.com/moos3/sparta/internal/resolver"
+	"github.com/moos3/sparta/proto"
+)
+
+// RFC 7208 limits on the DNS queries one SPF check may cause
+const (
+	spfLookupLimit     = 10 // include, a, mx, ptr, exists and redirect
+	spfVoidLookupLimit = 2  // lookups answered with NXDOMAIN or no records
+	spfMXLimit         = 10 // hosts one mx mechanism may resolve
+)
+
+// maxSPFExpansion stops the expansion of records far past the lookup limit,
+// so the lookups are counted without following them forever.
+const maxSPFExpansion = 50
+
+// Ranges in the domain's own records wider than these are reported as
+// overly broad.
+const (
+	broadIPv4Prefix = 16
+	broadIPv6Prefix = 32
+)
+
+// spfEvaluator expands an SPF record the way a receiver checking mail from
+// the domain would, without a sender address to match.
+type spfEvaluator struct {
+	ctx    context.Context
+	rs     *resolver.Resolver
+	result *proto.SPFEvaluation
+	ips    map[string]bool
+	stack  []string // domains being expanded, to catch include loops
+}
+
+// evaluateSPF looks up domain's SPF record and expands it, returning the
+// record, its policy (the qualified all mechanism, following redirects) and
+// the evaluation. The record and policy are empty if domain publishes none.
+func evaluateSPF(ctx context.Context, rs *resolver.Resolver, domain string) (string, string, *proto.SPFEvaluation, error) {
+	e := &spfEvaluator{ctx: ctx, rs: rs, result: &proto.SPFEvaluation{}, ips: make(map[string]bool)}
+	records, _, err := e.records(domain)
+	if err != nil {
+		return "", "", nil, err
+	}
+	e.result.RecordCount = int32(len(records))
+	if len(records) == 0 {
+		return "", "", e.result, nil
+	}
+	if len(records) > 1 {
+		e.fail("%d SPF records published, receivers reject them all", len(records))
+	}
+	policy := e.expand(strings.TrimSuffix(domain, "."), records[0], true, true)
+	e.result.PermissiveAll = policy == "+all"
+	return records[0], policy, e.result, nil
+}
+
+// isSPFValid reports whether a domain's SPF record passes evaluation and
+// fails or soft-fails mail from unlisted senders.
+func isSPFValid(record, policy string, eval *proto.SPFEvaluation) bool {
+	return record != "" && len(eval.Errors) == 0 && (policy == "-all" || policy == "~all")
+}
+
+func (e *spfEvaluator) fail(format string, args ...interface{}) {
+	e.result.Errors = append(e.result.Errors, fmt.Sprintf(format, args...))
+}
+
+// count records a DNS lookup against the limit and reports whether the
+// mechanism should still be followed.
+func (e *spfEvaluator) count() bool {
+	e.result.DnsLookups++
+	if e.result.DnsLookups == spfLookupLimit+1 {
+		e.result.LookupLimitExceeded = true
+		e.fail("more than %d DNS lookups", spfLookupLimit)
+	}
+	return e.result.DnsLookups <= maxSPFExpansion
+}
+
+// void records a lookup that found nothing against the void lookup limit.
+func (e *spfEvaluator) void() {
+	e.result.VoidLookups++
+	if e.result.VoidLookups == spfVoidLookupLimit+1 {
+		e.result.VoidLookupLimitExceeded = true
+		e.fail("more than %d void DNS lookups", spfVoidLookupLimit)
+	}
+}
+
+// query returns the records of type qtype at name and whether the lookup
+// was void.
+func (e *spfEvaluator) query(name string, qtype uint16) ([]dns.RR, bool, error) {
+	m := new(dns.Msg)
+	m.SetQuestion(dns.Fqdn(name), qtype)
+	r, err := e.rs.Exchange(e.ctx, m)
+	if err != nil {
+		return nil, false, err
+	}
+	switch r.Rcode {
+	case dns.RcodeSuccess:
+	case dns.RcodeNameError:
+		return nil, true, nil
+	default:
+		return nil, false, fmt.Errorf("lookup of %s answered %s", name, dns.RcodeToString[r.Rcode])
+	}
+	var answers []dns.RR
+	for _, ans := range r.Answer {
+		if ans.Header().Rrtype == qtype {
+			answers = append(answers, ans)
+		}
+	}
+	return answers, len(answers) == 0, nil
+}
+
+// records returns the SPF records name publishes and whether the lookup was
+// void.
+func (e *spfEvaluator) records(name string) ([]string, bool, error) {
+	answers, void, err := e.query(name, dns.TypeTXT)
+	if err != nil {
+		return nil, false, err
+	}
+	var records []string
+	for _, ans := range answers {
+		// Records longer than 255 bytes are split across strings
+		record := strings.Join(ans.(*dns.TXT).Txt, "")
+		if lower := strings.ToLower(record); lower == "v=spf1" || strings.HasPrefix(lower, "v=spf1 ") {
+			records = append(records, record)
+		}
+	}
+	return records, void, nil
+}
+
+// expand walks the terms of record, published by domain, and returns its
+// policy. own is set for the domain's record and those it redirects to,
+// authorizing while the mechanisms would give a pass.
+func (e *spfEvaluator) expand(domain, record string, own, authorizing bool) string {
+	e.stack = append(e.stack, domain)
+	defer func() { e.stack = e.stack[:len(e.stack)-1] }()
+
+	var policy, redirect string
+	for _, term := range strings.Fields(record)[1:] {
+		if name, value, ok := spfModifier(term); ok {
+			if name == "redirect" {
+				redirect = value
+			}
+			continue
+		}
+		qualifier := "+"
+		if strings.ContainsRune("+-~?", rune(term[0])) {
+			qualifier, term = term[:1], term[1:]
+		}
+		pass := authorizing && qualifier == "+"
+		name, arg := term, ""
+		if i := strings.IndexAny(term, ":/"); i >= 0 {
+			name, arg = term[:i], term[i:]
+		}
+		switch strings.ToLower(name) {
+		case "all":
+			policy = qualifier + "all"
+		case "include":
+			target := strings.TrimPrefix(arg, ":")
+			if !strings.HasPrefix(arg, ":") || target == "" {
+				e.fail("%s has include without a domain", domain)
+				continue
+			}
+			e.include(target, pass)
+		case "a", "mx":
+			target, v4, v6, err := spfDomainSpec(arg, domain)
+			if err != nil {
+				e.fail("%s: invalid %s: %v", domain, term, err)
+				continue
+			}
+			e.addresses(strings.ToLower(name), target, v4, v6, pass)
+		case "ptr", "exists":
+			// Both depend on the sender, so there is nothing to list
+			e.count()
+		case "ip4", "ip6":
+			e.network(domain, strings.ToLower(name), arg, own, pass)
+		default:
+			e.fail("%s has unknown mechanism %q", domain, term)
+		}
+	}
+	// A redirect only applies to records without an all mechanism
+	if redirect != "" && policy == "" {
+		policy = e.redirect(redirect, own, authorizing)
+	}
+	return policy
+}
+
+// spfModifier splits a name=value modifier term.
+func spfModifier(term string) (string, string, bool) {
+	name, value, ok := strings.Cut(term, "=")
+	if !ok || name == "" || strings.ContainsAny(name, ":/") {
+		return "", "", false
+	}
+	return strings.ToLower(name), value, true
+}
+
+// expanding reports whether domain's record is already being expanded.
+func (e *spfEvaluator) expanding(domain string) bool {
+	for _, d := range e.stack {
+		if strings.EqualFold(d, domain) {
+			return true
+		}
+	}
+	return false
+}
+
+// target fetches the single SPF record of an include or redirect target.
+func (e *spfEvaluator) target(mechanism, domain string) (string, bool) {
+	if !e.count() || strings.Contains(domain, "%") {
+		// Past the expansion cap, or a macro that needs the sender
+		return "", false
+	}
+	if e.expanding(domain) {
+		e.fail("%s:%s loops back to a record being expanded", mechanism, domain)
+		return "", false
+	}
+	records, void, err := e.records(domain)
+	if err != nil {
+		e.fail("%s:%s: %v", mechanism, domain, err)
+		return "", false
+	}
+	if void {
+		e.void()
+	}
+	switch len(records) {
+	case 0:
+		e.fail("%s:%s has no SPF record", mechanism, domain)
+		return "", false
+	case 1:
+		e.result.Includes = append(e.result.Includes, domain)
+		return records[0], true
+	default:
+		e.fail("%s:%s publishes %d SPF records", mechanism, domain, len(records))
+		return "", false
+	}
+}
+
+// include expands an include mechanism. Its senders are authorised when it
+// has a pass qualifier.
+func (e *spfEvaluator) include(domain string, pass bool) {
+	if record, ok := e.target("include", domain); ok {
+		e.expand(domain, record, false, pass)
+	}
+}
+
+// redirect expands a redirect modifier, whose record replaces the current
+// one, and returns its policy.
+func (e *spfEvaluator) redirect(domain string, own, authorizing bool) string {
+	record, ok := e.target("redirect", domain)
+	if !ok {
+		return ""
+	}
+	return e.expand(domain, record, own, authorizing)
+}
+
+// addresses resolves an a or mx mechanism to the addresses it covers.
+func (e *spfEvaluator) addresses(mechanism, domain string, v4, v6 int, pass bool) {
+	if !e.count() || strings.Contains(domain, "%") {
+		return
+	}
+	hosts := []string{domain}
+	if mechanism == "mx" {
+		answers, void, err := e.query(domain, dns.TypeMX)
+		if err != nil {
+			e.fail("mx:%s: %v", domain, err)
+			return
+		}
+		if void {
+			e.void()
+			return
+		}
+		if len(answers) > spfMXLimit {
+			e.fail("mx:%s has %d MX hosts, more than %d", domain, len(answers), spfMXLimit)
+			answers = answers[:spfMXLimit]
+		}
+		hosts = hosts[:0]
+		for _, ans := range answers {
+			hosts = append(hosts, ans.(*dns.MX).Mx)
+		}
+	}
+
+	found := false
+	for _, host := range hosts {
+		for _, qtype := range []uint16{dns.TypeA, dns.TypeAAAA} {
+			answers, _, err := e.query(host, qtype)
+			if err != nil {
+				e.fail("%s:%s: %v", mechanism, domain, err)
+				continue
+			}
+			for _, ans := range answers {
+				found = true
+				if !pass {
+					continue
+				}
+				switch rr := ans.(type) {
+				case *dns.A:
+					if addr, ok := netip.AddrFromSlice(rr.A.To4()); ok {
+						e.authorize(netip.PrefixFrom(addr, v4))
+					}
+				case *dns.AAAA:
+					if addr, ok := netip.AddrFromSlice(rr.AAAA); ok {
+						e.authorize(netip.PrefixFrom(addr, v6))
+					}
+				}
+			}
+		}
+	}
+	if mechanism == "a" && !found {
+		e.void()
+	}
+}
+
+// network handles an ip4 or ip6 mechanism.
+func (e *spfEvaluator) network(domain, mechanism, arg string, own, pass bool) {
+	value := strings.TrimPrefix(arg, ":")
+	prefix, err := parseSPFNetwork(value, mechanism == "ip6")
+	if err != nil {
+		e.fail("%s: invalid %s%s: %v", domain, mechanism, arg, err)
+		return
+	}
+	broad := broadIPv4Prefix
+	if mechanism == "ip6" {
+		broad = broadIPv6Prefix
+	}
+	if own && prefix.Bits() < broad {
+		e.result.BroadRanges = append(e.result.BroadRanges, mechanism+":"+value)
+	}
+	if pass {
+		e.authorize(prefix)
+	}
+}
+
+// authorize adds a network to the flattened list of authorised senders.
+func (e *spfEvaluator) authorize(prefix netip.Prefix) {
+	prefix = prefix.Masked()
+	entry := prefix.String()
+	if prefix.IsSingleIP() {
+		entry = prefix.Addr().String()
+	}
+	if !e.ips[entry] {
+		e.ips[entry] = true
+		e.result.AuthorizedIps = append(e.result.AuthorizedIps, entry)
+	}
+}
+
+// parseSPFNetwork parses the address or CIDR range of an ip4 or ip6
+// mechanism.
+func parseSPFNetwork(value string, ipv6 bool) (netip.Prefix, error) {
+	var prefix netip.Prefix
+	if strings.Contains(value, "/") {
+		p, err := netip.ParsePrefix(value)
+		if err != nil {
+			return netip.Prefix{}, err
+		}
+		prefix = p
+	} else {
+		addr, err := netip.ParseAddr(value)
+		if err != nil {
+			return netip.Prefix{}, err
+		}
+		prefix = netip.PrefixFrom(addr, addr.BitLen())
+	}
+	if prefix.Addr().Is4() == ipv6 {
+		return netip.Prefix{}, fmt.Errorf("wrong address family")
+	}
+	return prefix, nil
+}
+
+// spfDomainSpec parses the [:domain][/cidr4][//cidr6] argument of an a or
+// mx mechanism, defaulting to current and single addresses.
+func spfDomainSpec(arg, current string) (string, int, int, error) {
+	domain, v4, v6 := current, 32, 128
+	spec := arg
+	if strings.HasPrefix(spec, ":") {
+		spec = spec[1:]
+		i := strings.Index(spec, "/")
+		if i < 0 {
+			i = len(spec)
+		}
+		domain, spec = spec[:i], spec[i:]
+		if domain == "" {
+			return "", 0, 0, fmt.Errorf("empty domain")
+		}
+	}
+	if spec == "" {
+		return domain, v4, v6, nil
+	}
+	cidr4, cidr6, dual := strings.Cut(spec, "//")
+	if cidr4 != "" {
+		n, err := strconv.Atoi(strings.TrimPrefix(cidr4, "/"))
+		if !strings.HasPrefix(cidr4, "/") || err != nil || n < 0 || n > 32 {
+			return "", 0, 0, fmt.Errorf("bad ip4 prefix length %q", cidr4)
+		}
+		v4 = n
+	}
+	if dual {
+		n, err := strconv.Atoi(cidr6)
+		if err != nil || n < 0 || n > 128 {
+			return "", 0, 0, fmt.Errorf("bad ip6 prefix length %q", cidr6)
+		}
+		v6 = n
+	}
+	return domain, v4, v6, nil
+}
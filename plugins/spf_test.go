@@ -0,0 +1,176 @@
+// plugins/spf_test.go
+package plugins
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/moos3/sparta/internal/config"
+	"github.com/moos3/sparta/internal/resolver"
+	"github.com/moos3/sparta/pluginstest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// zoneResolver returns a resolver answering from records.
+func zoneResolver(t *testing.T, records ...string) *resolver.Resolver {
+	t.Helper()
+	cfg := &config.Config{}
+	pluginstest.StubDNS(t, cfg, pluginstest.Zone(t, records...))
+	rs, err := resolver.New(cfg)
+	require.NoError(t, err)
+	return rs
+}
+
+func TestEvaluateSPF(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Flattens", func(t *testing.T) {
+		rs := zoneResolver(t,
+			`example.com. 300 IN TXT "v=spf1 ip4:192.0.2.0/24 a mx include:_spf.provider.test -ip4:198.51.100.1 ~all"`,
+			`example.com. 300 IN TXT "google-site-verification=abc"`,
+			"example.com. 300 IN A 192.0.2.10",
+			"example.com. 300 IN MX 10 mail.example.com.",
+			"mail.example.com. 300 IN A 192.0.2.25",
+			"mail.example.com. 300 IN AAAA 2001:db8::25",
+			`_spf.provider.test. 300 IN TXT "v=spf1 ip6:2001:db8:1::/48 " "ip4:203.0.113.0/24 -all"`,
+		)
+		record, policy, eval, err := evaluateSPF(ctx, rs, "example.com.")
+		require.NoError(t, err)
+		assert.Contains(t, record, "include:_spf.provider.test")
+		assert.Equal(t, "~all", policy)
+		assert.Equal(t, int32(1), eval.RecordCount)
+		assert.Equal(t, int32(3), eval.DnsLookups)
+		assert.Zero(t, eval.VoidLookups)
+		assert.Equal(t, []string{"_spf.provider.test"}, eval.Includes)
+		assert.Equal(t, []string{
+			"192.0.2.0/24", "192.0.2.10", "192.0.2.25", "2001:db8::25",
+			"2001:db8:1::/48", "203.0.113.0/24",
+		}, eval.AuthorizedIps)
+		assert.Empty(t, eval.Errors)
+		assert.Empty(t, eval.BroadRanges)
+		assert.True(t, isSPFValid(record, policy, eval))
+	})
+
+	t.Run("LookupLimit", func(t *testing.T) {
+		records := []string{`example.com. 300 IN TXT "v=spf1 include:inc0.example.com -all"`}
+		for i := 0; i < 11; i++ {
+			records = append(records, fmt.Sprintf(`inc%d.example.com. 300 IN TXT "v=spf1 ip4:192.0.2.%d include:inc%d.example.com ~all"`, i, i, i+1))
+		}
+		records = append(records, `inc11.example.com. 300 IN TXT "v=spf1 -all"`)
+		record, policy, eval, err := evaluateSPF(ctx, zoneResolver(t, records...), "example.com.")
+		require.NoError(t, err)
+		assert.Equal(t, int32(12), eval.DnsLookups)
+		assert.True(t, eval.LookupLimitExceeded)
+		assert.Contains(t, eval.Errors, "more than 10 DNS lookups")
+		assert.Len(t, eval.AuthorizedIps, 11)
+		assert.False(t, isSPFValid(record, policy, eval))
+	})
+
+	t.Run("VoidLookups", func(t *testing.T) {
+		rs := zoneResolver(t,
+			`example.com. 300 IN TXT "v=spf1 a:gone1.example.com a:gone2.example.com mx:gone3.example.com -all"`,
+		)
+		_, _, eval, err := evaluateSPF(ctx, rs, "example.com.")
+		require.NoError(t, err)
+		assert.Equal(t, int32(3), eval.VoidLookups)
+		assert.True(t, eval.VoidLookupLimitExceeded)
+		assert.Equal(t, []string{"more than 2 void DNS lookups"}, eval.Errors)
+	})
+
+	t.Run("DuplicateRecords", func(t *testing.T) {
+		rs := zoneResolver(t,
+			`example.com. 300 IN TXT "v=spf1 -all"`,
+			`example.com. 300 IN TXT "v=spf1 mx -all"`,
+		)
+		record, policy, eval, err := evaluateSPF(ctx, rs, "example.com.")
+		require.NoError(t, err)
+		assert.Equal(t, int32(2), eval.RecordCount)
+		assert.Equal(t, []string{"2 SPF records published, receivers reject them all"}, eval.Errors)
+		assert.False(t, isSPFValid(record, policy, eval))
+	})
+
+	t.Run("PermissiveAndBroad", func(t *testing.T) {
+		rs := zoneResolver(t,
+			`example.com. 300 IN TXT "v=spf1 ip4:10.0.0.0/8 ip6:2001:db8::/29 include:_spf.provider.test all"`,
+			`_spf.provider.test. 300 IN TXT "v=spf1 ip4:172.16.0.0/12 -all"`,
+		)
+		record, policy, eval, err := evaluateSPF(ctx, rs, "example.com.")
+		require.NoError(t, err)
+		assert.Equal(t, "+all", policy)
+		assert.True(t, eval.PermissiveAll)
+		// Ranges in included records belong to the provider
+		assert.Equal(t, []string{"ip4:10.0.0.0/8", "ip6:2001:db8::/29"}, eval.BroadRanges)
+		assert.False(t, isSPFValid(record, policy, eval))
+	})
+
+	t.Run("Redirect", func(t *testing.T) {
+		rs := zoneResolver(t,
+			`example.com. 300 IN TXT "v=spf1 redirect=_spf.example.com"`,
+			`_spf.example.com. 300 IN TXT "v=spf1 ip4:198.51.100.0/15 -all"`,
+		)
+		record, policy, eval, err := evaluateSPF(ctx, rs, "example.com.")
+		require.NoError(t, err)
+		assert.Equal(t, "-all", policy)
+		assert.Equal(t, int32(1), eval.DnsLookups)
+		assert.Equal(t, []string{"ip4:198.51.100.0/15"}, eval.BroadRanges)
+		assert.Equal(t, []string{"198.50.0.0/15"}, eval.AuthorizedIps)
+		assert.True(t, isSPFValid(record, policy, eval))
+	})
+
+	t.Run("Problems", func(t *testing.T) {
+		rs := zoneResolver(t,
+			`example.com. 300 IN TXT "v=spf1 include:loop.example.com include:nospf.example.com -include:deny.example.com ip4:300.1.1.1 bogus ~all"`,
+			`loop.example.com. 300 IN TXT "v=spf1 include:example.com -all"`,
+			`nospf.example.com. 300 IN TXT "hello"`,
+			`deny.example.com. 300 IN TXT "v=spf1 ip4:192.0.2.1 -all"`,
+		)
+		_, _, eval, err := evaluateSPF(ctx, rs, "example.com.")
+		require.NoError(t, err)
+		assert.Equal(t, []string{
+			"include:example.com loops back to a record being expanded",
+			"include:nospf.example.com has no SPF record",
+			`example.com: invalid ip4:300.1.1.1: ParseAddr("300.1.1.1"): IPv4 field has value >255`,
+			`example.com has unknown mechanism "bogus"`,
+		}, eval.Errors)
+		// A failing include does not authorise its senders
+		assert.Empty(t, eval.AuthorizedIps)
+	})
+
+	t.Run("NoRecord", func(t *testing.T) {
+		rs := zoneResolver(t, "example.com. 300 IN A 192.0.2.1")
+		record, policy, eval, err := evaluateSPF(ctx, rs, "example.com.")
+		require.NoError(t, err)
+		assert.Empty(t, record)
+		assert.Empty(t, policy)
+		assert.Zero(t, eval.RecordCount)
+		assert.False(t, isSPFValid(record, policy, eval))
+	})
+}
+
+func TestSPFDomainSpec(t *testing.T) {
+	tests := []struct {
+		arg    string
+		domain string
+		v4, v6 int
+	}{
+		{"", "example.com", 32, 128},
+		{":mail.example.com", "mail.example.com", 32, 128},
+		{"/24", "example.com", 24, 128},
+		{"//64", "example.com", 32, 64},
+		{":mail.example.com/24//64", "mail.example.com", 24, 64},
+	}
+	for _, tt := range tests {
+		domain, v4, v6, err := spfDomainSpec(tt.arg, "example.com")
+		if assert.NoError(t, err, tt.arg) {
+			assert.Equal(t, tt.domain, domain, tt.arg)
+			assert.Equal(t, tt.v4, v4, tt.arg)
+			assert.Equal(t, tt.v6, v6, tt.arg)
+		}
+	}
+	for _, bad := range []string{":", "/33", "//129", ":example.com/x"} {
+		_, _, _, err := spfDomainSpec(bad, "example.com")
+		assert.Error(t, err, bad)
+	}
+}
@@ -0,0 +1,129 @@
+// pluginsdk/sdk.go
+package pluginsdk
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net"
+	"os"
+	"os/signal"
+	"runtime/debug"
+	"syscall"
+
+	pluginpb "github.com/moos3/sparta/proto/plugin"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ProtocolVersion is the version of the plugin protocol in proto/plugin. The
+// server refuses plugins that speak another version.
+const ProtocolVersion = 1
+
+// CookieKey and CookieValue are set in the environment of every plugin the
+// server starts, so a plugin run by hand can say what it is instead of
+// waiting for a handshake that never comes.
+const (
+	CookieKey   = "SPARTA_PLUGIN_COOKIE"
+	CookieValue = "5f3c9e1a-sparta-scan-plugin"
+)
+
+// Scanner is implemented by an external scan plugin.
+type Scanner interface {
+	// Describe returns the plugin's name, version and the artifacts it
+	// consumes and produces, as registered with the server.
+	Describe() *pluginpb.DescribeResponse
+	// Configure receives the settings from the plugin's entry under
+	// external_plugins in the server's config.yaml.
+	Configure(settings map[string]string) error
+	// Scan scans one domain. The result is a JSON document the server stores
+	// as is; the context carries the plugin's timeout.
+	Scan(ctx context.Context, req *pluginpb.ScanRequest) (*pluginpb.ScanResponse, error)
+}
+
+// HealthChecker may be implemented by a Scanner to report whether it can
+// scan, for example whether its upstream API is reachable. Scanners without
+// it are healthy while their process runs.
+type HealthChecker interface {
+	Health(ctx context.Context) error
+}
+
+// Serve runs scanner as a plugin of the server that started this process:
+// it listens on a loopback port, announces it on stdout as
+// "<protocol version>|tcp|<address>" and serves the plugin protocol until the
+// server stops it. Logs should go to stderr, which the server records. Serve
+// exits the process if it was not started by the server.
+func Serve(scanner Scanner) {
+	if os.Getenv(CookieKey) != CookieValue {
+		fmt.Fprintln(os.Stderr, "This program is a sparta scan plugin. List it under external_plugins in the server's config.yaml instead of running it directly.")
+		os.Exit(1)
+	}
+	log.SetOutput(os.Stderr)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		log.Fatalf("Failed to listen: %v", err)
+	}
+	srv := grpc.NewServer()
+	pluginpb.RegisterPluginServer(srv, &server{scanner: scanner})
+
+	stop := make(chan os.Signal, 1)
+	signal.Notify(stop, os.Interrupt, syscall.SIGTERM)
+	go func() {
+		<-stop
+		srv.GracefulStop()
+	}()
+
+	fmt.Printf("%d|tcp|%s\n", ProtocolVersion, listener.Addr())
+	if err := srv.Serve(listener); err != nil {
+		log.Fatalf("Failed to serve plugin: %v", err)
+	}
+}
+
+// server adapts a Scanner to the generated PluginServer.
+type server struct {
+	pluginpb.UnimplementedPluginServer
+	scanner Scanner
+}
+
+func (s *server) Handshake(ctx context.Context, req *pluginpb.HandshakeRequest) (*pluginpb.HandshakeResponse, error) {
+	if req.GetProtocolVersion() != ProtocolVersion {
+		return nil, status.Errorf(codes.FailedPrecondition, "plugin speaks protocol version %d, server %d", ProtocolVersion, req.GetProtocolVersion())
+	}
+	settings := make(map[string]string, len(req.GetSettings()))
+	for _, s := range req.GetSettings() {
+		settings[s.GetKey()] = s.GetValue()
+	}
+	if err := s.scanner.Configure(settings); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to configure plugin: %v", err)
+	}
+	return &pluginpb.HandshakeResponse{ProtocolVersion: ProtocolVersion}, nil
+}
+
+func (s *server) Describe(ctx context.Context, req *pluginpb.DescribeRequest) (*pluginpb.DescribeResponse, error) {
+	return s.scanner.Describe(), nil
+}
+
+// Scan runs the scanner, turning a panic into an error so one bad domain
+// does not end the process.
+func (s *server) Scan(ctx context.Context, req *pluginpb.ScanRequest) (resp *pluginpb.ScanResponse, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			log.Printf("Scan of %s panicked: %v\n%s", req.GetDomain(), r, debug.Stack())
+			resp, err = nil, status.Errorf(codes.Internal, "plugin panicked: %v", r)
+		}
+	}()
+	return s.scanner.Scan(ctx, req)
+}
+
+func (s *server) Health(ctx context.Context, req *pluginpb.HealthRequest) (*pluginpb.HealthResponse, error) {
+	checker, ok := s.scanner.(HealthChecker)
+	if !ok {
+		return &pluginpb.HealthResponse{Healthy: true}, nil
+	}
+	if err := checker.Health(ctx); err != nil {
+		return &pluginpb.HealthResponse{Healthy: false, Message: err.Error()}, nil
+	}
+	return &pluginpb.HealthResponse{Healthy: true}, nil
+}
@@ -0,0 +1,215 @@
+// pluginstest/memorydb.go
+package pluginstest
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+)
+
+// Row is a stored row keyed by column name.
+type Row map[string]interface{}
+
+// MemoryDB is a db.Database that keeps the rows plugins insert in memory so
+// a test can look at what was stored. Every SELECT returns no rows, which
+// plugins see as an empty cache or history, and INSERT ... RETURNING returns
+// a single row of 1s so call counters keep working.
+type MemoryDB struct {
+	*sql.DB
+	mu     sync.Mutex
+	tables map[string][]Row
+}
+
+var (
+	memoryOnce     sync.Once
+	memorySeq      atomic.Int64
+	memoryRegistry sync.Map
+)
+
+// NewMemoryDB creates an empty MemoryDB.
+func NewMemoryDB() *MemoryDB {
+	memoryOnce.Do(func() {
+		sql.Register("sparta-pluginstest", memoryDriver{})
+	})
+	name := fmt.Sprintf("memory-%d", memorySeq.Add(1))
+	m := &MemoryDB{tables: make(map[string][]Row)}
+	memoryRegistry.Store(name, m)
+	conn, err := sql.Open("sparta-pluginstest", name)
+	if err != nil {
+		panic(err)
+	}
+	m.DB = conn
+	return m
+}
+
+// Rows returns the rows inserted into table so far.
+func (m *MemoryDB) Rows(table string) []Row {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]Row(nil), m.tables[table]...)
+}
+
+// Find returns the table and row whose id column is id.
+func (m *MemoryDB) Find(id string) (string, Row, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for table, rows := range m.tables {
+		for _, row := range rows {
+			if row["id"] == id {
+				return table, row, true
+			}
+		}
+	}
+	return "", nil, false
+}
+
+// Count returns the number of rows inserted into every table but those
+// listed.
+func (m *MemoryDB) Count(except ...string) int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	n := 0
+	for table, rows := range m.tables {
+		skip := false
+		for _, e := range except {
+			skip = skip || table == e
+		}
+		if !skip {
+			n += len(rows)
+		}
+	}
+	return n
+}
+
+var (
+	insertPattern      = regexp.MustCompile(`(?is)INSERT INTO\s+(\w+)\s*\(([^)]*)\)\s*VALUES\s*\(`)
+	placeholderPattern = regexp.MustCompile(`\$(\d+)`)
+)
+
+// record stores the row an INSERT ... VALUES statement describes. Other
+// statements are ignored.
+func (m *MemoryDB) record(query string, args []driver.NamedValue) {
+	loc := insertPattern.FindStringSubmatchIndex(query)
+	if loc == nil {
+		return
+	}
+	table, columns := query[loc[2]:loc[3]], strings.Split(query[loc[4]:loc[5]], ",")
+	values := splitTopLevel(query[loc[1]:])
+	row := make(Row, len(columns))
+	for i, column := range columns {
+		if i >= len(values) {
+			break
+		}
+		p := placeholderPattern.FindStringSubmatch(values[i])
+		if p == nil {
+			continue
+		}
+		n, _ := strconv.Atoi(p[1])
+		if n >= 1 && n <= len(args) {
+			row[strings.TrimSpace(column)] = args[n-1].Value
+		}
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.tables[table] = append(m.tables[table], row)
+}
+
+// splitTopLevel splits the VALUES list at the start of s, up to its closing
+// parenthesis, on the commas outside nested parentheses and quotes.
+func splitTopLevel(s string) []string {
+	var parts []string
+	depth, start, quoted := 0, 0, false
+	for i, r := range s {
+		switch {
+		case r == '\'':
+			quoted = !quoted
+		case quoted:
+		case r == '(':
+			depth++
+		case r == ')' && depth == 0:
+			return append(parts, s[start:i])
+		case r == ')':
+			depth--
+		case r == ',' && depth == 0:
+			parts = append(parts, s[start:i])
+			start = i + 1
+		}
+	}
+	return append(parts, s[start:])
+}
+
+var returningPattern = regexp.MustCompile(`(?is)\bRETURNING\s+(.+?)\s*$`)
+
+type memoryDriver struct{}
+
+func (memoryDriver) Open(name string) (driver.Conn, error) {
+	v, ok := memoryRegistry.Load(name)
+	if !ok {
+		return nil, fmt.Errorf("unknown memory database %q", name)
+	}
+	return &memoryConn{db: v.(*MemoryDB)}, nil
+}
+
+type memoryConn struct {
+	db *MemoryDB
+}
+
+func (c *memoryConn) Prepare(query string) (driver.Stmt, error) {
+	return nil, fmt.Errorf("prepared statements are not supported")
+}
+
+func (c *memoryConn) Close() error { return nil }
+
+func (c *memoryConn) Begin() (driver.Tx, error) { return memoryTx{}, nil }
+
+func (c *memoryConn) CheckNamedValue(*driver.NamedValue) error { return nil }
+
+func (c *memoryConn) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	c.db.record(query, args)
+	match := returningPattern.FindStringSubmatch(query)
+	if match == nil {
+		return &memoryRows{}, nil
+	}
+	columns := strings.Split(match[1], ",")
+	row := make([]driver.Value, len(columns))
+	for i := range row {
+		row[i] = int64(1)
+	}
+	return &memoryRows{columns: columns, rows: [][]driver.Value{row}}, nil
+}
+
+func (c *memoryConn) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	c.db.record(query, args)
+	return driver.RowsAffected(1), nil
+}
+
+type memoryTx struct{}
+
+func (memoryTx) Commit() error   { return nil }
+func (memoryTx) Rollback() error { return nil }
+
+type memoryRows struct {
+	columns []string
+	rows    [][]driver.Value
+	pos     int
+}
+
+func (r *memoryRows) Columns() []string { return r.columns }
+
+func (r *memoryRows) Close() error { return nil }
+
+func (r *memoryRows) Next(dest []driver.Value) error {
+	if r.pos >= len(r.rows) {
+		return io.EOF
+	}
+	copy(dest, r.rows[r.pos])
+	r.pos++
+	return nil
+}
@@ -0,0 +1,344 @@
+// pluginstest/pluginstest.go
+package pluginstest
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/miekg/dns"
+	"github.com/moos3/sparta/internal/config"
+	"github.com/moos3/sparta/internal/interfaces"
+	"github.com/moos3/sparta/internal/pluginhost"
+	"github.com/moos3/sparta/proto"
+	"google.golang.org/protobuf/encoding/protojson"
+	protobuf "google.golang.org/protobuf/proto"
+)
+
+// scanTimeout bounds every Scan the kit makes; a plugin that ignores a
+// cancelled context fails once it runs out.
+const scanTimeout = 10 * time.Second
+
+// Case describes the plugin under test.
+type Case struct {
+	Name    string                          // name the plugin is registered under
+	New     func() interfaces.GenericPlugin // returns a fresh, unconfigured plugin
+	Config  *config.Config                  // passed to SetConfig; nil is an empty config
+	Request interfaces.ScanRequest          // Domain defaults to example.com; RunID is filled in
+	// Skipped allows the successful scan to be skipped rather than stored,
+	// for plugins with nothing to look up in the stand-in data.
+	Skipped bool
+}
+
+// Run checks that the plugin in c behaves the way the server expects: the
+// SetDatabase, SetConfig, Initialize lifecycle, scanning without a database,
+// honouring a cancelled context, storing a successful result under its ID
+// and scan run, and a result whose stored JSON decodes back to the same
+// message. Point the plugin's providers at stand-ins with StubProvider first.
+func Run(t *testing.T, c Case) {
+	t.Helper()
+	if c.Config == nil {
+		c.Config = &config.Config{}
+	}
+	if c.Request.Domain == "" {
+		c.Request.Domain = "example.com"
+	}
+
+	t.Run("Lifecycle", func(t *testing.T) {
+		p := c.New()
+		if named, ok := p.(interface{ Name() string }); ok && named.Name() != c.Name {
+			t.Errorf("Name() = %q, want %q", named.Name(), c.Name)
+		}
+		start(t, p, NewMemoryDB(), c.Config)
+	})
+
+	t.Run("NilDatabase", func(t *testing.T) {
+		p := c.New()
+		p.SetDatabase(nil)
+		if err := p.SetConfig(c.Config); err != nil {
+			t.Fatalf("SetConfig: %v", err)
+		}
+		if err := p.Initialize(); err != nil {
+			t.Fatalf("Initialize without a database: %v", err)
+		}
+		res := scan(t, p, context.Background(), c.request())
+		if res.Status == interfaces.ScanStatusSucceeded && res.ID != "" {
+			t.Errorf("scan without a database reported stored result %s", res.ID)
+		}
+	})
+
+	t.Run("Cancelled", func(t *testing.T) {
+		p := c.New()
+		db := NewMemoryDB()
+		start(t, p, db, c.Config)
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		res := scan(t, p, ctx, c.request())
+		if res.Status == interfaces.ScanStatusSucceeded {
+			t.Errorf("scan with a cancelled context succeeded")
+		}
+		if n := db.Count("intel_cache"); n != 0 {
+			t.Errorf("scan with a cancelled context stored %d rows", n)
+		}
+	})
+
+	t.Run("Persistence", func(t *testing.T) {
+		p := c.New()
+		db := NewMemoryDB()
+		start(t, p, db, c.Config)
+		req := c.request()
+		res := scan(t, p, context.Background(), req)
+		if c.Skipped && res.Status == interfaces.ScanStatusSkipped {
+			return
+		}
+		if res.Status != interfaces.ScanStatusSucceeded {
+			t.Fatalf("scan %s with errors %v, want succeeded", res.Status, res.Errors)
+		}
+		if res.Plugin != c.Name {
+			t.Errorf("result is for plugin %q, want %q", res.Plugin, c.Name)
+		}
+		if res.Result == nil {
+			t.Fatalf("successful scan returned no result message")
+		}
+		if res.ID == "" {
+			t.Fatalf("successful scan returned no result ID")
+		}
+		table, row, ok := db.Find(res.ID)
+		if !ok {
+			t.Fatalf("result %s was not stored", res.ID)
+		}
+		if row["scan_run_id"] != req.RunID {
+			t.Errorf("%s row has scan_run_id %v, want %s", table, row["scan_run_id"], req.RunID)
+		}
+		if row["domain"] != req.Domain {
+			t.Errorf("%s row has domain %v, want %s", table, row["domain"], req.Domain)
+		}
+
+		t.Run("RoundTrip", func(t *testing.T) {
+			stored, err := storedJSON(row["result"])
+			if err != nil {
+				t.Fatalf("%s row: %v", table, err)
+			}
+			if external, ok := res.Result.(*proto.ExternalSecurityResult); ok {
+				// External plugins' results are stored as the plugin returned them
+				if string(stored) != external.GetResult() {
+					t.Errorf("stored result %s, want %s", stored, external.GetResult())
+				}
+				return
+			}
+			// Stored results are read back with encoding/json, as the scan
+			// run store does, and sent to clients as protobuf JSON
+			decoded := res.Result.ProtoReflect().New().Interface()
+			if err := json.Unmarshal(stored, decoded); err != nil {
+				t.Fatalf("stored result does not decode: %v", err)
+			}
+			if !protobuf.Equal(decoded, res.Result) {
+				t.Errorf("stored result decodes to %v, want %v", decoded, res.Result)
+			}
+			wire, err := protojson.Marshal(res.Result)
+			if err != nil {
+				t.Fatalf("result does not encode as protobuf JSON: %v", err)
+			}
+			again := res.Result.ProtoReflect().New().Interface()
+			if err := protojson.Unmarshal(wire, again); err != nil || !protobuf.Equal(again, res.Result) {
+				t.Errorf("result does not survive a protobuf JSON round trip: %v", err)
+			}
+		})
+	})
+}
+
+// RunExternal runs the same checks against an external plugin executable,
+// started the way the server starts the plugins under external_plugins.
+// Plugins written against pluginsdk can call it from their own tests.
+func RunExternal(t *testing.T, path string, settings map[string]string) {
+	t.Helper()
+	spec := config.ExternalPlugin{Path: path, Settings: settings}
+	p, err := pluginhost.Launch(spec)
+	if err != nil {
+		t.Fatalf("failed to start plugin %s: %v", path, err)
+	}
+	t.Cleanup(p.Close)
+	Run(t, Case{
+		Name:    p.Name(),
+		New:     func() interfaces.GenericPlugin { return p },
+		Skipped: true,
+	})
+}
+
+// StubProvider serves handler in place of provider (abuse_ch, chaos, crtsh,
+// isc, otx or shodan) for plugins configured with cfg, and returns the
+// stand-in server, which is closed when the test ends.
+func StubProvider(t testing.TB, cfg *config.Config, provider string, handler http.Handler) *httptest.Server {
+	t.Helper()
+	srv := httptest.NewServer(handler)
+	t.Cleanup(srv.Close)
+	switch provider {
+	case "abuse_ch":
+		cfg.Abuse.BaseURL = srv.URL
+	case "chaos":
+		cfg.Chaos.BaseURL = srv.URL
+	case "crtsh":
+		cfg.CrtSh.BaseURL = srv.URL
+	case "isc":
+		cfg.ISC.BaseURL = srv.URL
+	case "otx":
+		cfg.OTX.BaseURL = srv.URL
+	case "shodan":
+		cfg.Shodan.BaseURL = srv.URL
+	default:
+		t.Fatalf("unknown provider %q", provider)
+	}
+	return srv
+}
+
+// StubDNS serves handler over UDP and TCP on a local port and makes it the
+// only resolver for plugins configured with cfg. It returns the address,
+// and the servers are shut down when the test ends.
+func StubDNS(t testing.TB, cfg *config.Config, handler dns.Handler) string {
+	t.Helper()
+	var (
+		pc   net.PacketConn
+		l    net.Listener
+		addr string
+		err  error
+	)
+	// The TCP port the kernel gave UDP may be taken; try another pair
+	for attempt := 0; attempt < 10; attempt++ {
+		pc, err = net.ListenPacket("udp", "127.0.0.1:0")
+		if err != nil {
+			t.Fatalf("failed to listen for DNS over UDP: %v", err)
+		}
+		addr = pc.LocalAddr().String()
+		if l, err = net.Listen("tcp", addr); err == nil {
+			break
+		}
+		pc.Close()
+	}
+	if err != nil {
+		t.Fatalf("failed to listen for DNS over TCP: %v", err)
+	}
+	for _, srv := range []*dns.Server{{PacketConn: pc, Handler: handler}, {Listener: l, Handler: handler}} {
+		started := make(chan struct{})
+		srv.NotifyStartedFunc = func() { close(started) }
+		go srv.ActivateAndServe()
+		<-started
+		t.Cleanup(func() { srv.Shutdown() })
+	}
+	cfg.DNS.Resolvers = []config.DNSResolver{{Address: addr, Protocol: "udp"}}
+	return addr
+}
+
+// Zone returns a DNS handler answering from records, given in zone file
+// syntax, with NXDOMAIN for names it has no records for.
+func Zone(t testing.TB, records ...string) dns.Handler {
+	t.Helper()
+	zone := make(map[string][]dns.RR)
+	for _, record := range records {
+		rr, err := dns.NewRR(record)
+		if err != nil {
+			t.Fatalf("invalid record %q: %v", record, err)
+		}
+		name := strings.ToLower(rr.Header().Name)
+		zone[name] = append(zone[name], rr)
+	}
+	return dns.HandlerFunc(func(w dns.ResponseWriter, r *dns.Msg) {
+		m := new(dns.Msg)
+		m.SetReply(r)
+		q := r.Question[0]
+		rrs, ok := zone[strings.ToLower(q.Name)]
+		if !ok {
+			m.Rcode = dns.RcodeNameError
+		}
+		for _, rr := range rrs {
+			if rr.Header().Rrtype == q.Qtype || (q.Qtype != dns.TypeRRSIG && rr.Header().Rrtype == dns.TypeRRSIG && rr.(*dns.RRSIG).TypeCovered == q.Qtype) {
+				m.Answer = append(m.Answer, rr)
+			}
+		}
+		w.WriteMsg(m)
+	})
+}
+
+// JSON returns a handler answering every request with body as JSON.
+func JSON(body string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, body)
+	})
+}
+
+func (c Case) request() interfaces.ScanRequest {
+	req := c.Request
+	req.RunID = uuid.New().String()
+	return req
+}
+
+// start runs the lifecycle the server runs when it loads a plugin.
+func start(t *testing.T, p interfaces.GenericPlugin, db *MemoryDB, cfg *config.Config) {
+	t.Helper()
+	p.SetDatabase(db)
+	if err := p.SetConfig(cfg); err != nil {
+		t.Fatalf("SetConfig: %v", err)
+	}
+	if err := p.Initialize(); err != nil {
+		t.Fatalf("Initialize: %v", err)
+	}
+}
+
+// scan runs one scan, failing the test if the plugin panics, hangs or
+// returns no envelope.
+func scan(t *testing.T, p interfaces.GenericPlugin, ctx context.Context, req interfaces.ScanRequest) *interfaces.ScanResult {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(ctx, scanTimeout)
+	defer cancel()
+	type outcome struct {
+		res   *interfaces.ScanResult
+		err   error
+		panic interface{}
+	}
+	done := make(chan outcome, 1)
+	go func() {
+		defer func() {
+			if r := recover(); r != nil {
+				done <- outcome{panic: r}
+			}
+		}()
+		res, err := p.Scan(ctx, req)
+		done <- outcome{res: res, err: err}
+	}()
+
+	var o outcome
+	select {
+	case o = <-done:
+	case <-time.After(scanTimeout + 5*time.Second):
+		t.Fatalf("Scan did not return within %s of its context ending", 5*time.Second)
+	}
+	if o.panic != nil {
+		t.Fatalf("Scan panicked: %v", o.panic)
+	}
+	if o.res == nil {
+		t.Fatalf("Scan returned no result envelope (error %v)", o.err)
+	}
+	if o.err != nil && o.res.Status != interfaces.ScanStatusFailed {
+		t.Errorf("Scan returned error %v with status %s, want failed", o.err, o.res.Status)
+	}
+	return o.res
+}
+
+// storedJSON returns the bytes of a stored result column.
+func storedJSON(v interface{}) ([]byte, error) {
+	switch v := v.(type) {
+	case []byte:
+		return v, nil
+	case string:
+		return []byte(v), nil
+	}
+	return nil, fmt.Errorf("result column holds %v, want JSON", reflect.TypeOf(v))
+}
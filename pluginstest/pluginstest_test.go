@@ -0,0 +1,64 @@
+// pluginstest/pluginstest_test.go
+package pluginstest
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/moos3/sparta/pluginsdk"
+	pluginpb "github.com/moos3/sparta/proto/plugin"
+	"github.com/stretchr/testify/assert"
+)
+
+// The test binary doubles as an external plugin when started with this
+// variable set.
+const helperEnv = "PLUGINSTEST_TEST_PLUGIN"
+
+func TestMain(m *testing.M) {
+	if os.Getenv(helperEnv) == "1" {
+		pluginsdk.Serve(echoScanner{})
+		os.Exit(0)
+	}
+	os.Exit(m.Run())
+}
+
+type echoScanner struct{}
+
+func (echoScanner) Describe() *pluginpb.DescribeResponse {
+	return &pluginpb.DescribeResponse{Name: "Echo", Version: "1.0.0", Passive: true}
+}
+
+func (echoScanner) Configure(settings map[string]string) error { return nil }
+
+func (echoScanner) Scan(ctx context.Context, req *pluginpb.ScanRequest) (*pluginpb.ScanResponse, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return &pluginpb.ScanResponse{Status: "succeeded", Result: []byte(`{"domain":"` + req.GetDomain() + `"}`)}, nil
+}
+
+func TestRunExternal(t *testing.T) {
+	t.Setenv(helperEnv, "1")
+	RunExternal(t, os.Args[0], nil)
+}
+
+func TestMemoryDB(t *testing.T) {
+	db := NewMemoryDB()
+	_, err := db.Exec(`
+		INSERT INTO tls_scan_results (id, scan_run_id, domain, dns_scan_id, result, created_at)
+		VALUES ($1, $2, $3, NULLIF($4, '')::uuid, $5, $6)
+		ON CONFLICT (id) DO NOTHING
+	`, "id-1", "run-1", "example.com", "", []byte(`{}`), "now")
+	assert.NoError(t, err)
+
+	table, row, ok := db.Find("id-1")
+	assert.True(t, ok)
+	assert.Equal(t, "tls_scan_results", table)
+	assert.Equal(t, Row{"id": "id-1", "scan_run_id": "run-1", "domain": "example.com", "dns_scan_id": "", "result": []byte(`{}`), "created_at": "now"}, row)
+
+	var calls int
+	assert.NoError(t, db.QueryRow(`INSERT INTO provider_usage (provider, day, calls) SELECT $1, $2, 1 RETURNING calls`, "otx", "today").Scan(&calls))
+	assert.Equal(t, 1, calls)
+	assert.Equal(t, 1, db.Count())
+}
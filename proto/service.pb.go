@@ -26,6 +26,8 @@ const (
 type GenerateReportRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Domain        string                 `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
+	Profile       string                 `protobuf:"bytes,2,opt,name=profile,proto3" json:"profile,omitempty"`  // scan profile name; defaults to "full"
+	Refresh       bool                   `protobuf:"varint,3,opt,name=refresh,proto3" json:"refresh,omitempty"` // bypass cached intelligence lookups
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -67,6 +69,20 @@ func (x *GenerateReportRequest) GetDomain() string {
 	return ""
 }
 
+func (x *GenerateReportRequest) GetProfile() string {
+	if x != nil {
+		return x.Profile
+	}
+	return ""
+}
+
+func (x *GenerateReportRequest) GetRefresh() bool {
+	if x != nil {
+		return x.Refresh
+	}
+	return false
+}
+
 type GenerateReportResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ReportId      string                 `protobuf:"bytes,1,opt,name=report_id,json=reportId,proto3" json:"report_id,omitempty"`
@@ -74,6 +90,8 @@ type GenerateReportResponse struct {
 	Score         int32                  `protobuf:"varint,3,opt,name=score,proto3" json:"score,omitempty"`
 	RiskTier      string                 `protobuf:"bytes,4,opt,name=risk_tier,json=riskTier,proto3" json:"risk_tier,omitempty"`
 	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ScanRunId     string                 `protobuf:"bytes,6,opt,name=scan_run_id,json=scanRunId,proto3" json:"scan_run_id,omitempty"`
+	Profile       string                 `protobuf:"bytes,7,opt,name=profile,proto3" json:"profile,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -143,6 +161,235 @@ func (x *GenerateReportResponse) GetCreatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *GenerateReportResponse) GetScanRunId() string {
+	if x != nil {
+		return x.ScanRunId
+	}
+	return ""
+}
+
+func (x *GenerateReportResponse) GetProfile() string {
+	if x != nil {
+		return x.Profile
+	}
+	return ""
+}
+
+// ReportProgressEvent is streamed by GenerateReportStream as each plugin
+// changes state. The last event has done set and carries the stored report.
+type ReportProgressEvent struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Plugin    string                 `protobuf:"bytes,1,opt,name=plugin,proto3" json:"plugin,omitempty"`
+	Status    string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"` // queued, running, succeeded, failed or skipped
+	ResultId  string                 `protobuf:"bytes,3,opt,name=result_id,json=resultId,proto3" json:"result_id,omitempty"`
+	Errors    []string               `protobuf:"bytes,4,rep,name=errors,proto3" json:"errors,omitempty"`
+	TimedOut  bool                   `protobuf:"varint,5,opt,name=timed_out,json=timedOut,proto3" json:"timed_out,omitempty"`
+	Score     int32                  `protobuf:"varint,6,opt,name=score,proto3" json:"score,omitempty"` // risk score over the results received so far
+	RiskTier  string                 `protobuf:"bytes,7,opt,name=risk_tier,json=riskTier,proto3" json:"risk_tier,omitempty"`
+	Done      bool                   `protobuf:"varint,8,opt,name=done,proto3" json:"done,omitempty"`
+	ReportId  string                 `protobuf:"bytes,9,opt,name=report_id,json=reportId,proto3" json:"report_id,omitempty"`
+	DnsScanId string                 `protobuf:"bytes,10,opt,name=dns_scan_id,json=dnsScanId,proto3" json:"dns_scan_id,omitempty"`
+	// The plugin's result, set on the event that reports it succeeded
+	DnsResult      *DNSSecurityResult      `protobuf:"bytes,11,opt,name=dns_result,json=dnsResult,proto3" json:"dns_result,omitempty"`
+	TlsResult      *TLSSecurityResult      `protobuf:"bytes,12,opt,name=tls_result,json=tlsResult,proto3" json:"tls_result,omitempty"`
+	CrtshResult    *CrtShSecurityResult    `protobuf:"bytes,13,opt,name=crtsh_result,json=crtshResult,proto3" json:"crtsh_result,omitempty"`
+	ChaosResult    *ChaosSecurityResult    `protobuf:"bytes,14,opt,name=chaos_result,json=chaosResult,proto3" json:"chaos_result,omitempty"`
+	ShodanResult   *ShodanSecurityResult   `protobuf:"bytes,15,opt,name=shodan_result,json=shodanResult,proto3" json:"shodan_result,omitempty"`
+	OtxResult      *OTXSecurityResult      `protobuf:"bytes,16,opt,name=otx_result,json=otxResult,proto3" json:"otx_result,omitempty"`
+	WhoisResult    *WhoisSecurityResult    `protobuf:"bytes,17,opt,name=whois_result,json=whoisResult,proto3" json:"whois_result,omitempty"`
+	AbusechResult  *AbuseChSecurityResult  `protobuf:"bytes,18,opt,name=abusech_result,json=abusechResult,proto3" json:"abusech_result,omitempty"`
+	IscResult      *ISCSecurityResult      `protobuf:"bytes,19,opt,name=isc_result,json=iscResult,proto3" json:"isc_result,omitempty"`
+	ScanRunId      string                  `protobuf:"bytes,20,opt,name=scan_run_id,json=scanRunId,proto3" json:"scan_run_id,omitempty"`
+	Profile        string                  `protobuf:"bytes,21,opt,name=profile,proto3" json:"profile,omitempty"`
+	ExternalResult *ExternalSecurityResult `protobuf:"bytes,22,opt,name=external_result,json=externalResult,proto3" json:"external_result,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ReportProgressEvent) Reset() {
+	*x = ReportProgressEvent{}
+	mi := &file_proto_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReportProgressEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportProgressEvent) ProtoMessage() {}
+
+func (x *ReportProgressEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportProgressEvent.ProtoReflect.Descriptor instead.
+func (*ReportProgressEvent) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ReportProgressEvent) GetPlugin() string {
+	if x != nil {
+		return x.Plugin
+	}
+	return ""
+}
+
+func (x *ReportProgressEvent) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ReportProgressEvent) GetResultId() string {
+	if x != nil {
+		return x.ResultId
+	}
+	return ""
+}
+
+func (x *ReportProgressEvent) GetErrors() []string {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+func (x *ReportProgressEvent) GetTimedOut() bool {
+	if x != nil {
+		return x.TimedOut
+	}
+	return false
+}
+
+func (x *ReportProgressEvent) GetScore() int32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *ReportProgressEvent) GetRiskTier() string {
+	if x != nil {
+		return x.RiskTier
+	}
+	return ""
+}
+
+func (x *ReportProgressEvent) GetDone() bool {
+	if x != nil {
+		return x.Done
+	}
+	return false
+}
+
+func (x *ReportProgressEvent) GetReportId() string {
+	if x != nil {
+		return x.ReportId
+	}
+	return ""
+}
+
+func (x *ReportProgressEvent) GetDnsScanId() string {
+	if x != nil {
+		return x.DnsScanId
+	}
+	return ""
+}
+
+func (x *ReportProgressEvent) GetDnsResult() *DNSSecurityResult {
+	if x != nil {
+		return x.DnsResult
+	}
+	return nil
+}
+
+func (x *ReportProgressEvent) GetTlsResult() *TLSSecurityResult {
+	if x != nil {
+		return x.TlsResult
+	}
+	return nil
+}
+
+func (x *ReportProgressEvent) GetCrtshResult() *CrtShSecurityResult {
+	if x != nil {
+		return x.CrtshResult
+	}
+	return nil
+}
+
+func (x *ReportProgressEvent) GetChaosResult() *ChaosSecurityResult {
+	if x != nil {
+		return x.ChaosResult
+	}
+	return nil
+}
+
+func (x *ReportProgressEvent) GetShodanResult() *ShodanSecurityResult {
+	if x != nil {
+		return x.ShodanResult
+	}
+	return nil
+}
+
+func (x *ReportProgressEvent) GetOtxResult() *OTXSecurityResult {
+	if x != nil {
+		return x.OtxResult
+	}
+	return nil
+}
+
+func (x *ReportProgressEvent) GetWhoisResult() *WhoisSecurityResult {
+	if x != nil {
+		return x.WhoisResult
+	}
+	return nil
+}
+
+func (x *ReportProgressEvent) GetAbusechResult() *AbuseChSecurityResult {
+	if x != nil {
+		return x.AbusechResult
+	}
+	return nil
+}
+
+func (x *ReportProgressEvent) GetIscResult() *ISCSecurityResult {
+	if x != nil {
+		return x.IscResult
+	}
+	return nil
+}
+
+func (x *ReportProgressEvent) GetScanRunId() string {
+	if x != nil {
+		return x.ScanRunId
+	}
+	return ""
+}
+
+func (x *ReportProgressEvent) GetProfile() string {
+	if x != nil {
+		return x.Profile
+	}
+	return ""
+}
+
+func (x *ReportProgressEvent) GetExternalResult() *ExternalSecurityResult {
+	if x != nil {
+		return x.ExternalResult
+	}
+	return nil
+}
+
 type ListReportsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Domain        string                 `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"` // Optional filter
@@ -152,7 +399,7 @@ type ListReportsRequest struct {
 
 func (x *ListReportsRequest) Reset() {
 	*x = ListReportsRequest{}
-	mi := &file_proto_service_proto_msgTypes[2]
+	mi := &file_proto_service_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -164,7 +411,7 @@ func (x *ListReportsRequest) String() string {
 func (*ListReportsRequest) ProtoMessage() {}
 
 func (x *ListReportsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_service_proto_msgTypes[2]
+	mi := &file_proto_service_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -177,7 +424,7 @@ func (x *ListReportsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListReportsRequest.ProtoReflect.Descriptor instead.
 func (*ListReportsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_service_proto_rawDescGZIP(), []int{2}
+	return file_proto_service_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *ListReportsRequest) GetDomain() string {
@@ -195,13 +442,15 @@ type Report struct {
 	Score         int32                  `protobuf:"varint,4,opt,name=score,proto3" json:"score,omitempty"`
 	RiskTier      string                 `protobuf:"bytes,5,opt,name=risk_tier,json=riskTier,proto3" json:"risk_tier,omitempty"`
 	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ScanRunId     string                 `protobuf:"bytes,7,opt,name=scan_run_id,json=scanRunId,proto3" json:"scan_run_id,omitempty"`
+	Profile       string                 `protobuf:"bytes,8,opt,name=profile,proto3" json:"profile,omitempty"` // scan profile the report's scan ran with
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Report) Reset() {
 	*x = Report{}
-	mi := &file_proto_service_proto_msgTypes[3]
+	mi := &file_proto_service_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -213,7 +462,7 @@ func (x *Report) String() string {
 func (*Report) ProtoMessage() {}
 
 func (x *Report) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_service_proto_msgTypes[3]
+	mi := &file_proto_service_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -226,7 +475,7 @@ func (x *Report) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Report.ProtoReflect.Descriptor instead.
 func (*Report) Descriptor() ([]byte, []int) {
-	return file_proto_service_proto_rawDescGZIP(), []int{3}
+	return file_proto_service_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *Report) GetReportId() string {
@@ -271,6 +520,20 @@ func (x *Report) GetCreatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Report) GetScanRunId() string {
+	if x != nil {
+		return x.ScanRunId
+	}
+	return ""
+}
+
+func (x *Report) GetProfile() string {
+	if x != nil {
+		return x.Profile
+	}
+	return ""
+}
+
 type ListReportsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Reports       []*Report              `protobuf:"bytes,1,rep,name=reports,proto3" json:"reports,omitempty"`
@@ -280,7 +543,7 @@ type ListReportsResponse struct {
 
 func (x *ListReportsResponse) Reset() {
 	*x = ListReportsResponse{}
-	mi := &file_proto_service_proto_msgTypes[4]
+	mi := &file_proto_service_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -292,7 +555,7 @@ func (x *ListReportsResponse) String() string {
 func (*ListReportsResponse) ProtoMessage() {}
 
 func (x *ListReportsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_service_proto_msgTypes[4]
+	mi := &file_proto_service_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -305,7 +568,7 @@ func (x *ListReportsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListReportsResponse.ProtoReflect.Descriptor instead.
 func (*ListReportsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_service_proto_rawDescGZIP(), []int{4}
+	return file_proto_service_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *ListReportsResponse) GetReports() []*Report {
@@ -324,7 +587,7 @@ type GetReportByIdRequest struct {
 
 func (x *GetReportByIdRequest) Reset() {
 	*x = GetReportByIdRequest{}
-	mi := &file_proto_service_proto_msgTypes[5]
+	mi := &file_proto_service_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -336,7 +599,7 @@ func (x *GetReportByIdRequest) String() string {
 func (*GetReportByIdRequest) ProtoMessage() {}
 
 func (x *GetReportByIdRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_service_proto_msgTypes[5]
+	mi := &file_proto_service_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -349,7 +612,7 @@ func (x *GetReportByIdRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetReportByIdRequest.ProtoReflect.Descriptor instead.
 func (*GetReportByIdRequest) Descriptor() ([]byte, []int) {
-	return file_proto_service_proto_rawDescGZIP(), []int{5}
+	return file_proto_service_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *GetReportByIdRequest) GetReportId() string {
@@ -368,7 +631,7 @@ type GetReportByIdResponse struct {
 
 func (x *GetReportByIdResponse) Reset() {
 	*x = GetReportByIdResponse{}
-	mi := &file_proto_service_proto_msgTypes[6]
+	mi := &file_proto_service_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -380,7 +643,7 @@ func (x *GetReportByIdResponse) String() string {
 func (*GetReportByIdResponse) ProtoMessage() {}
 
 func (x *GetReportByIdResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_service_proto_msgTypes[6]
+	mi := &file_proto_service_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -393,7 +656,7 @@ func (x *GetReportByIdResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetReportByIdResponse.ProtoReflect.Descriptor instead.
 func (*GetReportByIdResponse) Descriptor() ([]byte, []int) {
-	return file_proto_service_proto_rawDescGZIP(), []int{6}
+	return file_proto_service_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *GetReportByIdResponse) GetReport() *Report {
@@ -412,7 +675,7 @@ type CalculateRiskScoreRequest struct {
 
 func (x *CalculateRiskScoreRequest) Reset() {
 	*x = CalculateRiskScoreRequest{}
-	mi := &file_proto_service_proto_msgTypes[7]
+	mi := &file_proto_service_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -424,7 +687,7 @@ func (x *CalculateRiskScoreRequest) String() string {
 func (*CalculateRiskScoreRequest) ProtoMessage() {}
 
 func (x *CalculateRiskScoreRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_service_proto_msgTypes[7]
+	mi := &file_proto_service_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -437,7 +700,7 @@ func (x *CalculateRiskScoreRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CalculateRiskScoreRequest.ProtoReflect.Descriptor instead.
 func (*CalculateRiskScoreRequest) Descriptor() ([]byte, []int) {
-	return file_proto_service_proto_rawDescGZIP(), []int{7}
+	return file_proto_service_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *CalculateRiskScoreRequest) GetDomain() string {
@@ -457,7 +720,7 @@ type CalculateRiskScoreResponse struct {
 
 func (x *CalculateRiskScoreResponse) Reset() {
 	*x = CalculateRiskScoreResponse{}
-	mi := &file_proto_service_proto_msgTypes[8]
+	mi := &file_proto_service_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -469,7 +732,7 @@ func (x *CalculateRiskScoreResponse) String() string {
 func (*CalculateRiskScoreResponse) ProtoMessage() {}
 
 func (x *CalculateRiskScoreResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_service_proto_msgTypes[8]
+	mi := &file_proto_service_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -482,7 +745,7 @@ func (x *CalculateRiskScoreResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CalculateRiskScoreResponse.ProtoReflect.Descriptor instead.
 func (*CalculateRiskScoreResponse) Descriptor() ([]byte, []int) {
-	return file_proto_service_proto_rawDescGZIP(), []int{8}
+	return file_proto_service_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *CalculateRiskScoreResponse) GetScore() int32 {
@@ -513,7 +776,7 @@ type CreateUserRequest struct {
 
 func (x *CreateUserRequest) Reset() {
 	*x = CreateUserRequest{}
-	mi := &file_proto_service_proto_msgTypes[9]
+	mi := &file_proto_service_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -525,7 +788,7 @@ func (x *CreateUserRequest) String() string {
 func (*CreateUserRequest) ProtoMessage() {}
 
 func (x *CreateUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_service_proto_msgTypes[9]
+	mi := &file_proto_service_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -538,7 +801,7 @@ func (x *CreateUserRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateUserRequest.ProtoReflect.Descriptor instead.
 func (*CreateUserRequest) Descriptor() ([]byte, []int) {
-	return file_proto_service_proto_rawDescGZIP(), []int{9}
+	return file_proto_service_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *CreateUserRequest) GetFirstName() string {
@@ -585,7 +848,7 @@ type CreateUserResponse struct {
 
 func (x *CreateUserResponse) Reset() {
 	*x = CreateUserResponse{}
-	mi := &file_proto_service_proto_msgTypes[10]
+	mi := &file_proto_service_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -597,7 +860,7 @@ func (x *CreateUserResponse) String() string {
 func (*CreateUserResponse) ProtoMessage() {}
 
 func (x *CreateUserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_service_proto_msgTypes[10]
+	mi := &file_proto_service_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -610,7 +873,7 @@ func (x *CreateUserResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateUserResponse.ProtoReflect.Descriptor instead.
 func (*CreateUserResponse) Descriptor() ([]byte, []int) {
-	return file_proto_service_proto_rawDescGZIP(), []int{10}
+	return file_proto_service_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *CreateUserResponse) GetUserId() string {
@@ -629,7 +892,7 @@ type GetUserRequest struct {
 
 func (x *GetUserRequest) Reset() {
 	*x = GetUserRequest{}
-	mi := &file_proto_service_proto_msgTypes[11]
+	mi := &file_proto_service_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -641,7 +904,7 @@ func (x *GetUserRequest) String() string {
 func (*GetUserRequest) ProtoMessage() {}
 
 func (x *GetUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_service_proto_msgTypes[11]
+	mi := &file_proto_service_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -654,7 +917,7 @@ func (x *GetUserRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUserRequest.ProtoReflect.Descriptor instead.
 func (*GetUserRequest) Descriptor() ([]byte, []int) {
-	return file_proto_service_proto_rawDescGZIP(), []int{11}
+	return file_proto_service_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *GetUserRequest) GetUserId() string {
@@ -678,7 +941,7 @@ type GetUserResponse struct {
 
 func (x *GetUserResponse) Reset() {
 	*x = GetUserResponse{}
-	mi := &file_proto_service_proto_msgTypes[12]
+	mi := &file_proto_service_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -690,7 +953,7 @@ func (x *GetUserResponse) String() string {
 func (*GetUserResponse) ProtoMessage() {}
 
 func (x *GetUserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_service_proto_msgTypes[12]
+	mi := &file_proto_service_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -703,7 +966,7 @@ func (x *GetUserResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUserResponse.ProtoReflect.Descriptor instead.
 func (*GetUserResponse) Descriptor() ([]byte, []int) {
-	return file_proto_service_proto_rawDescGZIP(), []int{12}
+	return file_proto_service_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *GetUserResponse) GetUserId() string {
@@ -761,7 +1024,7 @@ type UpdateUserRequest struct {
 
 func (x *UpdateUserRequest) Reset() {
 	*x = UpdateUserRequest{}
-	mi := &file_proto_service_proto_msgTypes[13]
+	mi := &file_proto_service_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -773,7 +1036,7 @@ func (x *UpdateUserRequest) String() string {
 func (*UpdateUserRequest) ProtoMessage() {}
 
 func (x *UpdateUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_service_proto_msgTypes[13]
+	mi := &file_proto_service_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -786,7 +1049,7 @@ func (x *UpdateUserRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateUserRequest.ProtoReflect.Descriptor instead.
 func (*UpdateUserRequest) Descriptor() ([]byte, []int) {
-	return file_proto_service_proto_rawDescGZIP(), []int{13}
+	return file_proto_service_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *UpdateUserRequest) GetUserId() string {
@@ -832,7 +1095,7 @@ type UpdateUserResponse struct {
 
 func (x *UpdateUserResponse) Reset() {
 	*x = UpdateUserResponse{}
-	mi := &file_proto_service_proto_msgTypes[14]
+	mi := &file_proto_service_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -844,7 +1107,7 @@ func (x *UpdateUserResponse) String() string {
 func (*UpdateUserResponse) ProtoMessage() {}
 
 func (x *UpdateUserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_service_proto_msgTypes[14]
+	mi := &file_proto_service_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -857,7 +1120,7 @@ func (x *UpdateUserResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateUserResponse.ProtoReflect.Descriptor instead.
 func (*UpdateUserResponse) Descriptor() ([]byte, []int) {
-	return file_proto_service_proto_rawDescGZIP(), []int{14}
+	return file_proto_service_proto_rawDescGZIP(), []int{15}
 }
 
 type DeleteUserRequest struct {
@@ -869,7 +1132,7 @@ type DeleteUserRequest struct {
 
 func (x *DeleteUserRequest) Reset() {
 	*x = DeleteUserRequest{}
-	mi := &file_proto_service_proto_msgTypes[15]
+	mi := &file_proto_service_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -881,7 +1144,7 @@ func (x *DeleteUserRequest) String() string {
 func (*DeleteUserRequest) ProtoMessage() {}
 
 func (x *DeleteUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_service_proto_msgTypes[15]
+	mi := &file_proto_service_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -894,7 +1157,7 @@ func (x *DeleteUserRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteUserRequest.ProtoReflect.Descriptor instead.
 func (*DeleteUserRequest) Descriptor() ([]byte, []int) {
-	return file_proto_service_proto_rawDescGZIP(), []int{15}
+	return file_proto_service_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *DeleteUserRequest) GetUserId() string {
@@ -912,7 +1175,7 @@ type DeleteUserResponse struct {
 
 func (x *DeleteUserResponse) Reset() {
 	*x = DeleteUserResponse{}
-	mi := &file_proto_service_proto_msgTypes[16]
+	mi := &file_proto_service_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -924,7 +1187,7 @@ func (x *DeleteUserResponse) String() string {
 func (*DeleteUserResponse) ProtoMessage() {}
 
 func (x *DeleteUserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_service_proto_msgTypes[16]
+	mi := &file_proto_service_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -937,7 +1200,7 @@ func (x *DeleteUserResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteUserResponse.ProtoReflect.Descriptor instead.
 func (*DeleteUserResponse) Descriptor() ([]byte, []int) {
-	return file_proto_service_proto_rawDescGZIP(), []int{16}
+	return file_proto_service_proto_rawDescGZIP(), []int{17}
 }
 
 type ListUsersRequest struct {
@@ -948,7 +1211,7 @@ type ListUsersRequest struct {
 
 func (x *ListUsersRequest) Reset() {
 	*x = ListUsersRequest{}
-	mi := &file_proto_service_proto_msgTypes[17]
+	mi := &file_proto_service_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -960,7 +1223,7 @@ func (x *ListUsersRequest) String() string {
 func (*ListUsersRequest) ProtoMessage() {}
 
 func (x *ListUsersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_service_proto_msgTypes[17]
+	mi := &file_proto_service_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -973,7 +1236,7 @@ func (x *ListUsersRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListUsersRequest.ProtoReflect.Descriptor instead.
 func (*ListUsersRequest) Descriptor() ([]byte, []int) {
-	return file_proto_service_proto_rawDescGZIP(), []int{17}
+	return file_proto_service_proto_rawDescGZIP(), []int{18}
 }
 
 type ListUsersResponse struct {
@@ -985,7 +1248,7 @@ type ListUsersResponse struct {
 
 func (x *ListUsersResponse) Reset() {
 	*x = ListUsersResponse{}
-	mi := &file_proto_service_proto_msgTypes[18]
+	mi := &file_proto_service_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -997,7 +1260,7 @@ func (x *ListUsersResponse) String() string {
 func (*ListUsersResponse) ProtoMessage() {}
 
 func (x *ListUsersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_service_proto_msgTypes[18]
+	mi := &file_proto_service_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1010,7 +1273,7 @@ func (x *ListUsersResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListUsersResponse.ProtoReflect.Descriptor instead.
 func (*ListUsersResponse) Descriptor() ([]byte, []int) {
-	return file_proto_service_proto_rawDescGZIP(), []int{18}
+	return file_proto_service_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *ListUsersResponse) GetUsers() []*User {
@@ -1034,7 +1297,7 @@ type User struct {
 
 func (x *User) Reset() {
 	*x = User{}
-	mi := &file_proto_service_proto_msgTypes[19]
+	mi := &file_proto_service_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1046,7 +1309,7 @@ func (x *User) String() string {
 func (*User) ProtoMessage() {}
 
 func (x *User) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_service_proto_msgTypes[19]
+	mi := &file_proto_service_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1059,7 +1322,7 @@ func (x *User) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use User.ProtoReflect.Descriptor instead.
 func (*User) Descriptor() ([]byte, []int) {
-	return file_proto_service_proto_rawDescGZIP(), []int{19}
+	return file_proto_service_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *User) GetId() string {
@@ -1116,7 +1379,7 @@ type CreateAPIKeyRequest struct {
 
 func (x *CreateAPIKeyRequest) Reset() {
 	*x = CreateAPIKeyRequest{}
-	mi := &file_proto_service_proto_msgTypes[20]
+	mi := &file_proto_service_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1128,7 +1391,7 @@ func (x *CreateAPIKeyRequest) String() string {
 func (*CreateAPIKeyRequest) ProtoMessage() {}
 
 func (x *CreateAPIKeyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_service_proto_msgTypes[20]
+	mi := &file_proto_service_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1141,7 +1404,7 @@ func (x *CreateAPIKeyRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateAPIKeyRequest.ProtoReflect.Descriptor instead.
 func (*CreateAPIKeyRequest) Descriptor() ([]byte, []int) {
-	return file_proto_service_proto_rawDescGZIP(), []int{20}
+	return file_proto_service_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *CreateAPIKeyRequest) GetUserId() string {
@@ -1177,7 +1440,7 @@ type CreateAPIKeyResponse struct {
 
 func (x *CreateAPIKeyResponse) Reset() {
 	*x = CreateAPIKeyResponse{}
-	mi := &file_proto_service_proto_msgTypes[21]
+	mi := &file_proto_service_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1189,7 +1452,7 @@ func (x *CreateAPIKeyResponse) String() string {
 func (*CreateAPIKeyResponse) ProtoMessage() {}
 
 func (x *CreateAPIKeyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_service_proto_msgTypes[21]
+	mi := &file_proto_service_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1202,7 +1465,7 @@ func (x *CreateAPIKeyResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateAPIKeyResponse.ProtoReflect.Descriptor instead.
 func (*CreateAPIKeyResponse) Descriptor() ([]byte, []int) {
-	return file_proto_service_proto_rawDescGZIP(), []int{21}
+	return file_proto_service_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *CreateAPIKeyResponse) GetApiKey() string {
@@ -1242,7 +1505,7 @@ type RotateAPIKeyRequest struct {
 
 func (x *RotateAPIKeyRequest) Reset() {
 	*x = RotateAPIKeyRequest{}
-	mi := &file_proto_service_proto_msgTypes[22]
+	mi := &file_proto_service_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1254,7 +1517,7 @@ func (x *RotateAPIKeyRequest) String() string {
 func (*RotateAPIKeyRequest) ProtoMessage() {}
 
 func (x *RotateAPIKeyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_service_proto_msgTypes[22]
+	mi := &file_proto_service_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1267,7 +1530,7 @@ func (x *RotateAPIKeyRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RotateAPIKeyRequest.ProtoReflect.Descriptor instead.
 func (*RotateAPIKeyRequest) Descriptor() ([]byte, []int) {
-	return file_proto_service_proto_rawDescGZIP(), []int{22}
+	return file_proto_service_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *RotateAPIKeyRequest) GetApiKey() string {
@@ -1287,7 +1550,7 @@ type RotateAPIKeyResponse struct {
 
 func (x *RotateAPIKeyResponse) Reset() {
 	*x = RotateAPIKeyResponse{}
-	mi := &file_proto_service_proto_msgTypes[23]
+	mi := &file_proto_service_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1299,7 +1562,7 @@ func (x *RotateAPIKeyResponse) String() string {
 func (*RotateAPIKeyResponse) ProtoMessage() {}
 
 func (x *RotateAPIKeyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_service_proto_msgTypes[23]
+	mi := &file_proto_service_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1312,7 +1575,7 @@ func (x *RotateAPIKeyResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RotateAPIKeyResponse.ProtoReflect.Descriptor instead.
 func (*RotateAPIKeyResponse) Descriptor() ([]byte, []int) {
-	return file_proto_service_proto_rawDescGZIP(), []int{23}
+	return file_proto_service_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *RotateAPIKeyResponse) GetNewApiKey() string {
@@ -1338,7 +1601,7 @@ type ActivateAPIKeyRequest struct {
 
 func (x *ActivateAPIKeyRequest) Reset() {
 	*x = ActivateAPIKeyRequest{}
-	mi := &file_proto_service_proto_msgTypes[24]
+	mi := &file_proto_service_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1350,7 +1613,7 @@ func (x *ActivateAPIKeyRequest) String() string {
 func (*ActivateAPIKeyRequest) ProtoMessage() {}
 
 func (x *ActivateAPIKeyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_service_proto_msgTypes[24]
+	mi := &file_proto_service_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1363,7 +1626,7 @@ func (x *ActivateAPIKeyRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ActivateAPIKeyRequest.ProtoReflect.Descriptor instead.
 func (*ActivateAPIKeyRequest) Descriptor() ([]byte, []int) {
-	return file_proto_service_proto_rawDescGZIP(), []int{24}
+	return file_proto_service_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *ActivateAPIKeyRequest) GetApiKey() string {
@@ -1381,7 +1644,7 @@ type ActivateAPIKeyResponse struct {
 
 func (x *ActivateAPIKeyResponse) Reset() {
 	*x = ActivateAPIKeyResponse{}
-	mi := &file_proto_service_proto_msgTypes[25]
+	mi := &file_proto_service_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1393,7 +1656,7 @@ func (x *ActivateAPIKeyResponse) String() string {
 func (*ActivateAPIKeyResponse) ProtoMessage() {}
 
 func (x *ActivateAPIKeyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_service_proto_msgTypes[25]
+	mi := &file_proto_service_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1406,7 +1669,7 @@ func (x *ActivateAPIKeyResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ActivateAPIKeyResponse.ProtoReflect.Descriptor instead.
 func (*ActivateAPIKeyResponse) Descriptor() ([]byte, []int) {
-	return file_proto_service_proto_rawDescGZIP(), []int{25}
+	return file_proto_service_proto_rawDescGZIP(), []int{26}
 }
 
 type DeactivateAPIKeyRequest struct {
@@ -1419,7 +1682,7 @@ type DeactivateAPIKeyRequest struct {
 
 func (x *DeactivateAPIKeyRequest) Reset() {
 	*x = DeactivateAPIKeyRequest{}
-	mi := &file_proto_service_proto_msgTypes[26]
+	mi := &file_proto_service_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1431,7 +1694,7 @@ func (x *DeactivateAPIKeyRequest) String() string {
 func (*DeactivateAPIKeyRequest) ProtoMessage() {}
 
 func (x *DeactivateAPIKeyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_service_proto_msgTypes[26]
+	mi := &file_proto_service_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1444,7 +1707,7 @@ func (x *DeactivateAPIKeyRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeactivateAPIKeyRequest.ProtoReflect.Descriptor instead.
 func (*DeactivateAPIKeyRequest) Descriptor() ([]byte, []int) {
-	return file_proto_service_proto_rawDescGZIP(), []int{26}
+	return file_proto_service_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *DeactivateAPIKeyRequest) GetApiKey() string {
@@ -1469,7 +1732,7 @@ type DeactivateAPIKeyResponse struct {
 
 func (x *DeactivateAPIKeyResponse) Reset() {
 	*x = DeactivateAPIKeyResponse{}
-	mi := &file_proto_service_proto_msgTypes[27]
+	mi := &file_proto_service_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1481,7 +1744,7 @@ func (x *DeactivateAPIKeyResponse) String() string {
 func (*DeactivateAPIKeyResponse) ProtoMessage() {}
 
 func (x *DeactivateAPIKeyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_service_proto_msgTypes[27]
+	mi := &file_proto_service_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1494,7 +1757,7 @@ func (x *DeactivateAPIKeyResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeactivateAPIKeyResponse.ProtoReflect.Descriptor instead.
 func (*DeactivateAPIKeyResponse) Descriptor() ([]byte, []int) {
-	return file_proto_service_proto_rawDescGZIP(), []int{27}
+	return file_proto_service_proto_rawDescGZIP(), []int{28}
 }
 
 type ListAPIKeysRequest struct {
@@ -1506,7 +1769,7 @@ type ListAPIKeysRequest struct {
 
 func (x *ListAPIKeysRequest) Reset() {
 	*x = ListAPIKeysRequest{}
-	mi := &file_proto_service_proto_msgTypes[28]
+	mi := &file_proto_service_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1518,7 +1781,7 @@ func (x *ListAPIKeysRequest) String() string {
 func (*ListAPIKeysRequest) ProtoMessage() {}
 
 func (x *ListAPIKeysRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_service_proto_msgTypes[28]
+	mi := &file_proto_service_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1531,7 +1794,7 @@ func (x *ListAPIKeysRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListAPIKeysRequest.ProtoReflect.Descriptor instead.
 func (*ListAPIKeysRequest) Descriptor() ([]byte, []int) {
-	return file_proto_service_proto_rawDescGZIP(), []int{28}
+	return file_proto_service_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *ListAPIKeysRequest) GetUserId() string {
@@ -1550,7 +1813,7 @@ type ListAPIKeysResponse struct {
 
 func (x *ListAPIKeysResponse) Reset() {
 	*x = ListAPIKeysResponse{}
-	mi := &file_proto_service_proto_msgTypes[29]
+	mi := &file_proto_service_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1562,7 +1825,7 @@ func (x *ListAPIKeysResponse) String() string {
 func (*ListAPIKeysResponse) ProtoMessage() {}
 
 func (x *ListAPIKeysResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_service_proto_msgTypes[29]
+	mi := &file_proto_service_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1575,7 +1838,7 @@ func (x *ListAPIKeysResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListAPIKeysResponse.ProtoReflect.Descriptor instead.
 func (*ListAPIKeysResponse) Descriptor() ([]byte, []int) {
-	return file_proto_service_proto_rawDescGZIP(), []int{29}
+	return file_proto_service_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *ListAPIKeysResponse) GetApiKeys() []*APIKey {
@@ -1601,7 +1864,7 @@ type APIKey struct {
 
 func (x *APIKey) Reset() {
 	*x = APIKey{}
-	mi := &file_proto_service_proto_msgTypes[30]
+	mi := &file_proto_service_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1613,7 +1876,7 @@ func (x *APIKey) String() string {
 func (*APIKey) ProtoMessage() {}
 
 func (x *APIKey) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_service_proto_msgTypes[30]
+	mi := &file_proto_service_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1626,7 +1889,7 @@ func (x *APIKey) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use APIKey.ProtoReflect.Descriptor instead.
 func (*APIKey) Descriptor() ([]byte, []int) {
-	return file_proto_service_proto_rawDescGZIP(), []int{30}
+	return file_proto_service_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *APIKey) GetApiKey() string {
@@ -1697,7 +1960,7 @@ type ChangePasswordRequest struct {
 
 func (x *ChangePasswordRequest) Reset() {
 	*x = ChangePasswordRequest{}
-	mi := &file_proto_service_proto_msgTypes[31]
+	mi := &file_proto_service_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1709,7 +1972,7 @@ func (x *ChangePasswordRequest) String() string {
 func (*ChangePasswordRequest) ProtoMessage() {}
 
 func (x *ChangePasswordRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_service_proto_msgTypes[31]
+	mi := &file_proto_service_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1722,7 +1985,7 @@ func (x *ChangePasswordRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ChangePasswordRequest.ProtoReflect.Descriptor instead.
 func (*ChangePasswordRequest) Descriptor() ([]byte, []int) {
-	return file_proto_service_proto_rawDescGZIP(), []int{31}
+	return file_proto_service_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *ChangePasswordRequest) GetUserId() string {
@@ -1754,7 +2017,7 @@ type ChangePasswordResponse struct {
 
 func (x *ChangePasswordResponse) Reset() {
 	*x = ChangePasswordResponse{}
-	mi := &file_proto_service_proto_msgTypes[32]
+	mi := &file_proto_service_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1766,7 +2029,7 @@ func (x *ChangePasswordResponse) String() string {
 func (*ChangePasswordResponse) ProtoMessage() {}
 
 func (x *ChangePasswordResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_service_proto_msgTypes[32]
+	mi := &file_proto_service_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1779,7 +2042,7 @@ func (x *ChangePasswordResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ChangePasswordResponse.ProtoReflect.Descriptor instead.
 func (*ChangePasswordResponse) Descriptor() ([]byte, []int) {
-	return file_proto_service_proto_rawDescGZIP(), []int{32}
+	return file_proto_service_proto_rawDescGZIP(), []int{33}
 }
 
 // Auth-related messages
@@ -1793,7 +2056,7 @@ type LoginRequest struct {
 
 func (x *LoginRequest) Reset() {
 	*x = LoginRequest{}
-	mi := &file_proto_service_proto_msgTypes[33]
+	mi := &file_proto_service_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1805,7 +2068,7 @@ func (x *LoginRequest) String() string {
 func (*LoginRequest) ProtoMessage() {}
 
 func (x *LoginRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_service_proto_msgTypes[33]
+	mi := &file_proto_service_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1818,7 +2081,7 @@ func (x *LoginRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LoginRequest.ProtoReflect.Descriptor instead.
 func (*LoginRequest) Descriptor() ([]byte, []int) {
-	return file_proto_service_proto_rawDescGZIP(), []int{33}
+	return file_proto_service_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *LoginRequest) GetEmail() string {
@@ -1848,7 +2111,7 @@ type LoginResponse struct {
 
 func (x *LoginResponse) Reset() {
 	*x = LoginResponse{}
-	mi := &file_proto_service_proto_msgTypes[34]
+	mi := &file_proto_service_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1860,7 +2123,7 @@ func (x *LoginResponse) String() string {
 func (*LoginResponse) ProtoMessage() {}
 
 func (x *LoginResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_service_proto_msgTypes[34]
+	mi := &file_proto_service_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1873,7 +2136,7 @@ func (x *LoginResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LoginResponse.ProtoReflect.Descriptor instead.
 func (*LoginResponse) Descriptor() ([]byte, []int) {
-	return file_proto_service_proto_rawDescGZIP(), []int{34}
+	return file_proto_service_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *LoginResponse) GetUserId() string {
@@ -1921,7 +2184,7 @@ type InviteUserRequest struct {
 
 func (x *InviteUserRequest) Reset() {
 	*x = InviteUserRequest{}
-	mi := &file_proto_service_proto_msgTypes[35]
+	mi := &file_proto_service_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1933,7 +2196,7 @@ func (x *InviteUserRequest) String() string {
 func (*InviteUserRequest) ProtoMessage() {}
 
 func (x *InviteUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_service_proto_msgTypes[35]
+	mi := &file_proto_service_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1946,7 +2209,7 @@ func (x *InviteUserRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InviteUserRequest.ProtoReflect.Descriptor instead.
 func (*InviteUserRequest) Descriptor() ([]byte, []int) {
-	return file_proto_service_proto_rawDescGZIP(), []int{35}
+	return file_proto_service_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *InviteUserRequest) GetEmail() string {
@@ -1974,7 +2237,7 @@ type InviteUserResponse struct {
 
 func (x *InviteUserResponse) Reset() {
 	*x = InviteUserResponse{}
-	mi := &file_proto_service_proto_msgTypes[36]
+	mi := &file_proto_service_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1986,7 +2249,7 @@ func (x *InviteUserResponse) String() string {
 func (*InviteUserResponse) ProtoMessage() {}
 
 func (x *InviteUserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_service_proto_msgTypes[36]
+	mi := &file_proto_service_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1999,7 +2262,7 @@ func (x *InviteUserResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InviteUserResponse.ProtoReflect.Descriptor instead.
 func (*InviteUserResponse) Descriptor() ([]byte, []int) {
-	return file_proto_service_proto_rawDescGZIP(), []int{36}
+	return file_proto_service_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *InviteUserResponse) GetInvitationId() string {
@@ -2032,7 +2295,7 @@ type ValidateInviteRequest struct {
 
 func (x *ValidateInviteRequest) Reset() {
 	*x = ValidateInviteRequest{}
-	mi := &file_proto_service_proto_msgTypes[37]
+	mi := &file_proto_service_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2044,7 +2307,7 @@ func (x *ValidateInviteRequest) String() string {
 func (*ValidateInviteRequest) ProtoMessage() {}
 
 func (x *ValidateInviteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_service_proto_msgTypes[37]
+	mi := &file_proto_service_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2057,7 +2320,7 @@ func (x *ValidateInviteRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ValidateInviteRequest.ProtoReflect.Descriptor instead.
 func (*ValidateInviteRequest) Descriptor() ([]byte, []int) {
-	return file_proto_service_proto_rawDescGZIP(), []int{37}
+	return file_proto_service_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *ValidateInviteRequest) GetToken() string {
@@ -2077,7 +2340,7 @@ type ValidateInviteResponse struct {
 
 func (x *ValidateInviteResponse) Reset() {
 	*x = ValidateInviteResponse{}
-	mi := &file_proto_service_proto_msgTypes[38]
+	mi := &file_proto_service_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2089,7 +2352,7 @@ func (x *ValidateInviteResponse) String() string {
 func (*ValidateInviteResponse) ProtoMessage() {}
 
 func (x *ValidateInviteResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_service_proto_msgTypes[38]
+	mi := &file_proto_service_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2102,7 +2365,7 @@ func (x *ValidateInviteResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ValidateInviteResponse.ProtoReflect.Descriptor instead.
 func (*ValidateInviteResponse) Descriptor() ([]byte, []int) {
-	return file_proto_service_proto_rawDescGZIP(), []int{38}
+	return file_proto_service_proto_rawDescGZIP(), []int{39}
 }
 
 func (x *ValidateInviteResponse) GetEmail() string {
@@ -2129,7 +2392,7 @@ type ScanDomainRequest struct {
 
 func (x *ScanDomainRequest) Reset() {
 	*x = ScanDomainRequest{}
-	mi := &file_proto_service_proto_msgTypes[39]
+	mi := &file_proto_service_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2141,7 +2404,7 @@ func (x *ScanDomainRequest) String() string {
 func (*ScanDomainRequest) ProtoMessage() {}
 
 func (x *ScanDomainRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_service_proto_msgTypes[39]
+	mi := &file_proto_service_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2154,7 +2417,7 @@ func (x *ScanDomainRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ScanDomainRequest.ProtoReflect.Descriptor instead.
 func (*ScanDomainRequest) Descriptor() ([]byte, []int) {
-	return file_proto_service_proto_rawDescGZIP(), []int{39}
+	return file_proto_service_proto_rawDescGZIP(), []int{40}
 }
 
 func (x *ScanDomainRequest) GetDomain() string {
@@ -2168,13 +2431,14 @@ type ScanDomainResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ScanId        string                 `protobuf:"bytes,1,opt,name=scan_id,json=scanId,proto3" json:"scan_id,omitempty"`
 	Result        *DNSSecurityResult     `protobuf:"bytes,2,opt,name=result,proto3" json:"result,omitempty"`
+	ScanRunId     string                 `protobuf:"bytes,3,opt,name=scan_run_id,json=scanRunId,proto3" json:"scan_run_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ScanDomainResponse) Reset() {
 	*x = ScanDomainResponse{}
-	mi := &file_proto_service_proto_msgTypes[40]
+	mi := &file_proto_service_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2186,7 +2450,7 @@ func (x *ScanDomainResponse) String() string {
 func (*ScanDomainResponse) ProtoMessage() {}
 
 func (x *ScanDomainResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_service_proto_msgTypes[40]
+	mi := &file_proto_service_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2199,7 +2463,7 @@ func (x *ScanDomainResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ScanDomainResponse.ProtoReflect.Descriptor instead.
 func (*ScanDomainResponse) Descriptor() ([]byte, []int) {
-	return file_proto_service_proto_rawDescGZIP(), []int{40}
+	return file_proto_service_proto_rawDescGZIP(), []int{41}
 }
 
 func (x *ScanDomainResponse) GetScanId() string {
@@ -2216,6 +2480,13 @@ func (x *ScanDomainResponse) GetResult() *DNSSecurityResult {
 	return nil
 }
 
+func (x *ScanDomainResponse) GetScanRunId() string {
+	if x != nil {
+		return x.ScanRunId
+	}
+	return ""
+}
+
 type GetDNSScanResultsByDomainRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Domain        string                 `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
@@ -2225,7 +2496,7 @@ type GetDNSScanResultsByDomainRequest struct {
 
 func (x *GetDNSScanResultsByDomainRequest) Reset() {
 	*x = GetDNSScanResultsByDomainRequest{}
-	mi := &file_proto_service_proto_msgTypes[41]
+	mi := &file_proto_service_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2237,7 +2508,7 @@ func (x *GetDNSScanResultsByDomainRequest) String() string {
 func (*GetDNSScanResultsByDomainRequest) ProtoMessage() {}
 
 func (x *GetDNSScanResultsByDomainRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_service_proto_msgTypes[41]
+	mi := &file_proto_service_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2250,7 +2521,7 @@ func (x *GetDNSScanResultsByDomainRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetDNSScanResultsByDomainRequest.ProtoReflect.Descriptor instead.
 func (*GetDNSScanResultsByDomainRequest) Descriptor() ([]byte, []int) {
-	return file_proto_service_proto_rawDescGZIP(), []int{41}
+	return file_proto_service_proto_rawDescGZIP(), []int{42}
 }
 
 func (x *GetDNSScanResultsByDomainRequest) GetDomain() string {
@@ -2269,7 +2540,7 @@ type GetDNSScanResultsByDomainResponse struct {
 
 func (x *GetDNSScanResultsByDomainResponse) Reset() {
 	*x = GetDNSScanResultsByDomainResponse{}
-	mi := &file_proto_service_proto_msgTypes[42]
+	mi := &file_proto_service_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2281,7 +2552,7 @@ func (x *GetDNSScanResultsByDomainResponse) String() string {
 func (*GetDNSScanResultsByDomainResponse) ProtoMessage() {}
 
 func (x *GetDNSScanResultsByDomainResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_service_proto_msgTypes[42]
+	mi := &file_proto_service_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2294,7 +2565,7 @@ func (x *GetDNSScanResultsByDomainResponse) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use GetDNSScanResultsByDomainResponse.ProtoReflect.Descriptor instead.
 func (*GetDNSScanResultsByDomainResponse) Descriptor() ([]byte, []int) {
-	return file_proto_service_proto_rawDescGZIP(), []int{42}
+	return file_proto_service_proto_rawDescGZIP(), []int{43}
 }
 
 func (x *GetDNSScanResultsByDomainResponse) GetResults() []*DNSScanResult {
@@ -2313,7 +2584,7 @@ type GetDNSScanResultByIDRequest struct {
 
 func (x *GetDNSScanResultByIDRequest) Reset() {
 	*x = GetDNSScanResultByIDRequest{}
-	mi := &file_proto_service_proto_msgTypes[43]
+	mi := &file_proto_service_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2325,7 +2596,7 @@ func (x *GetDNSScanResultByIDRequest) String() string {
 func (*GetDNSScanResultByIDRequest) ProtoMessage() {}
 
 func (x *GetDNSScanResultByIDRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_service_proto_msgTypes[43]
+	mi := &file_proto_service_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2338,7 +2609,7 @@ func (x *GetDNSScanResultByIDRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetDNSScanResultByIDRequest.ProtoReflect.Descriptor instead.
 func (*GetDNSScanResultByIDRequest) Descriptor() ([]byte, []int) {
-	return file_proto_service_proto_rawDescGZIP(), []int{43}
+	return file_proto_service_proto_rawDescGZIP(), []int{44}
 }
 
 func (x *GetDNSScanResultByIDRequest) GetDnsScanId() string {
@@ -2357,7 +2628,7 @@ type GetDNSScanResultByIDResponse struct {
 
 func (x *GetDNSScanResultByIDResponse) Reset() {
 	*x = GetDNSScanResultByIDResponse{}
-	mi := &file_proto_service_proto_msgTypes[44]
+	mi := &file_proto_service_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2369,7 +2640,7 @@ func (x *GetDNSScanResultByIDResponse) String() string {
 func (*GetDNSScanResultByIDResponse) ProtoMessage() {}
 
 func (x *GetDNSScanResultByIDResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_service_proto_msgTypes[44]
+	mi := &file_proto_service_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2382,7 +2653,7 @@ func (x *GetDNSScanResultByIDResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetDNSScanResultByIDResponse.ProtoReflect.Descriptor instead.
 func (*GetDNSScanResultByIDResponse) Descriptor() ([]byte, []int) {
-	return file_proto_service_proto_rawDescGZIP(), []int{44}
+	return file_proto_service_proto_rawDescGZIP(), []int{45}
 }
 
 func (x *GetDNSScanResultByIDResponse) GetResult() *DNSScanResult {
@@ -2405,7 +2676,7 @@ type DNSScanResult struct {
 
 func (x *DNSScanResult) Reset() {
 	*x = DNSScanResult{}
-	mi := &file_proto_service_proto_msgTypes[45]
+	mi := &file_proto_service_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2417,7 +2688,7 @@ func (x *DNSScanResult) String() string {
 func (*DNSScanResult) ProtoMessage() {}
 
 func (x *DNSScanResult) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_service_proto_msgTypes[45]
+	mi := &file_proto_service_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2430,7 +2701,7 @@ func (x *DNSScanResult) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DNSScanResult.ProtoReflect.Descriptor instead.
 func (*DNSScanResult) Descriptor() ([]byte, []int) {
-	return file_proto_service_proto_rawDescGZIP(), []int{45}
+	return file_proto_service_proto_rawDescGZIP(), []int{46}
 }
 
 func (x *DNSScanResult) GetId() string {
@@ -2478,7 +2749,7 @@ type ScanTLSRequest struct {
 
 func (x *ScanTLSRequest) Reset() {
 	*x = ScanTLSRequest{}
-	mi := &file_proto_service_proto_msgTypes[46]
+	mi := &file_proto_service_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2490,7 +2761,7 @@ func (x *ScanTLSRequest) String() string {
 func (*ScanTLSRequest) ProtoMessage() {}
 
 func (x *ScanTLSRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_service_proto_msgTypes[46]
+	mi := &file_proto_service_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2503,7 +2774,7 @@ func (x *ScanTLSRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ScanTLSRequest.ProtoReflect.Descriptor instead.
 func (*ScanTLSRequest) Descriptor() ([]byte, []int) {
-	return file_proto_service_proto_rawDescGZIP(), []int{46}
+	return file_proto_service_proto_rawDescGZIP(), []int{47}
 }
 
 func (x *ScanTLSRequest) GetDomain() string {
@@ -2524,13 +2795,14 @@ type ScanTLSResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ScanId        string                 `protobuf:"bytes,1,opt,name=scan_id,json=scanId,proto3" json:"scan_id,omitempty"`
 	Result        *TLSSecurityResult     `protobuf:"bytes,2,opt,name=result,proto3" json:"result,omitempty"`
+	ScanRunId     string                 `protobuf:"bytes,3,opt,name=scan_run_id,json=scanRunId,proto3" json:"scan_run_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ScanTLSResponse) Reset() {
 	*x = ScanTLSResponse{}
-	mi := &file_proto_service_proto_msgTypes[47]
+	mi := &file_proto_service_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2542,7 +2814,7 @@ func (x *ScanTLSResponse) String() string {
 func (*ScanTLSResponse) ProtoMessage() {}
 
 func (x *ScanTLSResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_service_proto_msgTypes[47]
+	mi := &file_proto_service_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2555,7 +2827,7 @@ func (x *ScanTLSResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ScanTLSResponse.ProtoReflect.Descriptor instead.
 func (*ScanTLSResponse) Descriptor() ([]byte, []int) {
-	return file_proto_service_proto_rawDescGZIP(), []int{47}
+	return file_proto_service_proto_rawDescGZIP(), []int{48}
 }
 
 func (x *ScanTLSResponse) GetScanId() string {
@@ -2572,6 +2844,13 @@ func (x *ScanTLSResponse) GetResult() *TLSSecurityResult {
 	return nil
 }
 
+func (x *ScanTLSResponse) GetScanRunId() string {
+	if x != nil {
+		return x.ScanRunId
+	}
+	return ""
+}
+
 type GetTLSScanResultsByDomainRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Domain        string                 `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
@@ -2581,7 +2860,7 @@ type GetTLSScanResultsByDomainRequest struct {
 
 func (x *GetTLSScanResultsByDomainRequest) Reset() {
 	*x = GetTLSScanResultsByDomainRequest{}
-	mi := &file_proto_service_proto_msgTypes[48]
+	mi := &file_proto_service_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2593,7 +2872,7 @@ func (x *GetTLSScanResultsByDomainRequest) String() string {
 func (*GetTLSScanResultsByDomainRequest) ProtoMessage() {}
 
 func (x *GetTLSScanResultsByDomainRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_service_proto_msgTypes[48]
+	mi := &file_proto_service_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2606,7 +2885,7 @@ func (x *GetTLSScanResultsByDomainRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetTLSScanResultsByDomainRequest.ProtoReflect.Descriptor instead.
 func (*GetTLSScanResultsByDomainRequest) Descriptor() ([]byte, []int) {
-	return file_proto_service_proto_rawDescGZIP(), []int{48}
+	return file_proto_service_proto_rawDescGZIP(), []int{49}
 }
 
 func (x *GetTLSScanResultsByDomainRequest) GetDomain() string {
@@ -2625,7 +2904,7 @@ type GetTLSScanResultsByDomainResponse struct {
 
 func (x *GetTLSScanResultsByDomainResponse) Reset() {
 	*x = GetTLSScanResultsByDomainResponse{}
-	mi := &file_proto_service_proto_msgTypes[49]
+	mi := &file_proto_service_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2637,7 +2916,7 @@ func (x *GetTLSScanResultsByDomainResponse) String() string {
 func (*GetTLSScanResultsByDomainResponse) ProtoMessage() {}
 
 func (x *GetTLSScanResultsByDomainResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_service_proto_msgTypes[49]
+	mi := &file_proto_service_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2650,7 +2929,7 @@ func (x *GetTLSScanResultsByDomainResponse) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use GetTLSScanResultsByDomainResponse.ProtoReflect.Descriptor instead.
 func (*GetTLSScanResultsByDomainResponse) Descriptor() ([]byte, []int) {
-	return file_proto_service_proto_rawDescGZIP(), []int{49}
+	return file_proto_service_proto_rawDescGZIP(), []int{50}
 }
 
 func (x *GetTLSScanResultsByDomainResponse) GetResults() []*TLSScanResult {
@@ -2673,7 +2952,7 @@ type TLSScanResult struct {
 
 func (x *TLSScanResult) Reset() {
 	*x = TLSScanResult{}
-	mi := &file_proto_service_proto_msgTypes[50]
+	mi := &file_proto_service_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2685,7 +2964,7 @@ func (x *TLSScanResult) String() string {
 func (*TLSScanResult) ProtoMessage() {}
 
 func (x *TLSScanResult) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_service_proto_msgTypes[50]
+	mi := &file_proto_service_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2698,7 +2977,7 @@ func (x *TLSScanResult) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TLSScanResult.ProtoReflect.Descriptor instead.
 func (*TLSScanResult) Descriptor() ([]byte, []int) {
-	return file_proto_service_proto_rawDescGZIP(), []int{50}
+	return file_proto_service_proto_rawDescGZIP(), []int{51}
 }
 
 func (x *TLSScanResult) GetId() string {
@@ -2746,7 +3025,7 @@ type ScanCrtShRequest struct {
 
 func (x *ScanCrtShRequest) Reset() {
 	*x = ScanCrtShRequest{}
-	mi := &file_proto_service_proto_msgTypes[51]
+	mi := &file_proto_service_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2758,7 +3037,7 @@ func (x *ScanCrtShRequest) String() string {
 func (*ScanCrtShRequest) ProtoMessage() {}
 
 func (x *ScanCrtShRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_service_proto_msgTypes[51]
+	mi := &file_proto_service_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2771,7 +3050,7 @@ func (x *ScanCrtShRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ScanCrtShRequest.ProtoReflect.Descriptor instead.
 func (*ScanCrtShRequest) Descriptor() ([]byte, []int) {
-	return file_proto_service_proto_rawDescGZIP(), []int{51}
+	return file_proto_service_proto_rawDescGZIP(), []int{52}
 }
 
 func (x *ScanCrtShRequest) GetDomain() string {
@@ -2792,13 +3071,14 @@ type ScanCrtShResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ScanId        string                 `protobuf:"bytes,1,opt,name=scan_id,json=scanId,proto3" json:"scan_id,omitempty"`
 	Result        *CrtShSecurityResult   `protobuf:"bytes,2,opt,name=result,proto3" json:"result,omitempty"`
+	ScanRunId     string                 `protobuf:"bytes,3,opt,name=scan_run_id,json=scanRunId,proto3" json:"scan_run_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ScanCrtShResponse) Reset() {
 	*x = ScanCrtShResponse{}
-	mi := &file_proto_service_proto_msgTypes[52]
+	mi := &file_proto_service_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2810,7 +3090,7 @@ func (x *ScanCrtShResponse) String() string {
 func (*ScanCrtShResponse) ProtoMessage() {}
 
 func (x *ScanCrtShResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_service_proto_msgTypes[52]
+	mi := &file_proto_service_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2823,7 +3103,7 @@ func (x *ScanCrtShResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ScanCrtShResponse.ProtoReflect.Descriptor instead.
 func (*ScanCrtShResponse) Descriptor() ([]byte, []int) {
-	return file_proto_service_proto_rawDescGZIP(), []int{52}
+	return file_proto_service_proto_rawDescGZIP(), []int{53}
 }
 
 func (x *ScanCrtShResponse) GetScanId() string {
@@ -2840,6 +3120,13 @@ func (x *ScanCrtShResponse) GetResult() *CrtShSecurityResult {
 	return nil
 }
 
+func (x *ScanCrtShResponse) GetScanRunId() string {
+	if x != nil {
+		return x.ScanRunId
+	}
+	return ""
+}
+
 type GetCrtShScanResultsByDomainRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Domain        string                 `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
@@ -2849,7 +3136,7 @@ type GetCrtShScanResultsByDomainRequest struct {
 
 func (x *GetCrtShScanResultsByDomainRequest) Reset() {
 	*x = GetCrtShScanResultsByDomainRequest{}
-	mi := &file_proto_service_proto_msgTypes[53]
+	mi := &file_proto_service_proto_msgTypes[54]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2861,7 +3148,7 @@ func (x *GetCrtShScanResultsByDomainRequest) String() string {
 func (*GetCrtShScanResultsByDomainRequest) ProtoMessage() {}
 
 func (x *GetCrtShScanResultsByDomainRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_service_proto_msgTypes[53]
+	mi := &file_proto_service_proto_msgTypes[54]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2874,7 +3161,7 @@ func (x *GetCrtShScanResultsByDomainRequest) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use GetCrtShScanResultsByDomainRequest.ProtoReflect.Descriptor instead.
 func (*GetCrtShScanResultsByDomainRequest) Descriptor() ([]byte, []int) {
-	return file_proto_service_proto_rawDescGZIP(), []int{53}
+	return file_proto_service_proto_rawDescGZIP(), []int{54}
 }
 
 func (x *GetCrtShScanResultsByDomainRequest) GetDomain() string {
@@ -2893,7 +3180,7 @@ type GetCrtShScanResultsByDomainResponse struct {
 
 func (x *GetCrtShScanResultsByDomainResponse) Reset() {
 	*x = GetCrtShScanResultsByDomainResponse{}
-	mi := &file_proto_service_proto_msgTypes[54]
+	mi := &file_proto_service_proto_msgTypes[55]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2905,7 +3192,7 @@ func (x *GetCrtShScanResultsByDomainResponse) String() string {
 func (*GetCrtShScanResultsByDomainResponse) ProtoMessage() {}
 
 func (x *GetCrtShScanResultsByDomainResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_service_proto_msgTypes[54]
+	mi := &file_proto_service_proto_msgTypes[55]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2918,7 +3205,7 @@ func (x *GetCrtShScanResultsByDomainResponse) ProtoReflect() protoreflect.Messag
 
 // Deprecated: Use GetCrtShScanResultsByDomainResponse.ProtoReflect.Descriptor instead.
 func (*GetCrtShScanResultsByDomainResponse) Descriptor() ([]byte, []int) {
-	return file_proto_service_proto_rawDescGZIP(), []int{54}
+	return file_proto_service_proto_rawDescGZIP(), []int{55}
 }
 
 func (x *GetCrtShScanResultsByDomainResponse) GetResults() []*CrtShScanResult {
@@ -2941,7 +3228,7 @@ type CrtShScanResult struct {
 
 func (x *CrtShScanResult) Reset() {
 	*x = CrtShScanResult{}
-	mi := &file_proto_service_proto_msgTypes[55]
+	mi := &file_proto_service_proto_msgTypes[56]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2953,7 +3240,7 @@ func (x *CrtShScanResult) String() string {
 func (*CrtShScanResult) ProtoMessage() {}
 
 func (x *CrtShScanResult) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_service_proto_msgTypes[55]
+	mi := &file_proto_service_proto_msgTypes[56]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2966,7 +3253,7 @@ func (x *CrtShScanResult) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CrtShScanResult.ProtoReflect.Descriptor instead.
 func (*CrtShScanResult) Descriptor() ([]byte, []int) {
-	return file_proto_service_proto_rawDescGZIP(), []int{55}
+	return file_proto_service_proto_rawDescGZIP(), []int{56}
 }
 
 func (x *CrtShScanResult) GetId() string {
@@ -3008,13 +3295,14 @@ type ScanChaosRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Domain        string                 `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
 	DnsScanId     string                 `protobuf:"bytes,2,opt,name=dns_scan_id,json=dnsScanId,proto3" json:"dns_scan_id,omitempty"`
+	Refresh       bool                   `protobuf:"varint,3,opt,name=refresh,proto3" json:"refresh,omitempty"` // bypass cached intelligence lookups
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ScanChaosRequest) Reset() {
 	*x = ScanChaosRequest{}
-	mi := &file_proto_service_proto_msgTypes[56]
+	mi := &file_proto_service_proto_msgTypes[57]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3026,7 +3314,7 @@ func (x *ScanChaosRequest) String() string {
 func (*ScanChaosRequest) ProtoMessage() {}
 
 func (x *ScanChaosRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_service_proto_msgTypes[56]
+	mi := &file_proto_service_proto_msgTypes[57]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3039,7 +3327,7 @@ func (x *ScanChaosRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ScanChaosRequest.ProtoReflect.Descriptor instead.
 func (*ScanChaosRequest) Descriptor() ([]byte, []int) {
-	return file_proto_service_proto_rawDescGZIP(), []int{56}
+	return file_proto_service_proto_rawDescGZIP(), []int{57}
 }
 
 func (x *ScanChaosRequest) GetDomain() string {
@@ -3056,17 +3344,25 @@ func (x *ScanChaosRequest) GetDnsScanId() string {
 	return ""
 }
 
+func (x *ScanChaosRequest) GetRefresh() bool {
+	if x != nil {
+		return x.Refresh
+	}
+	return false
+}
+
 type ScanChaosResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ScanId        string                 `protobuf:"bytes,1,opt,name=scan_id,json=scanId,proto3" json:"scan_id,omitempty"`
 	Result        *ChaosSecurityResult   `protobuf:"bytes,2,opt,name=result,proto3" json:"result,omitempty"`
+	ScanRunId     string                 `protobuf:"bytes,3,opt,name=scan_run_id,json=scanRunId,proto3" json:"scan_run_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ScanChaosResponse) Reset() {
 	*x = ScanChaosResponse{}
-	mi := &file_proto_service_proto_msgTypes[57]
+	mi := &file_proto_service_proto_msgTypes[58]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3078,7 +3374,7 @@ func (x *ScanChaosResponse) String() string {
 func (*ScanChaosResponse) ProtoMessage() {}
 
 func (x *ScanChaosResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_service_proto_msgTypes[57]
+	mi := &file_proto_service_proto_msgTypes[58]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3091,7 +3387,7 @@ func (x *ScanChaosResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ScanChaosResponse.ProtoReflect.Descriptor instead.
 func (*ScanChaosResponse) Descriptor() ([]byte, []int) {
-	return file_proto_service_proto_rawDescGZIP(), []int{57}
+	return file_proto_service_proto_rawDescGZIP(), []int{58}
 }
 
 func (x *ScanChaosResponse) GetScanId() string {
@@ -3108,6 +3404,13 @@ func (x *ScanChaosResponse) GetResult() *ChaosSecurityResult {
 	return nil
 }
 
+func (x *ScanChaosResponse) GetScanRunId() string {
+	if x != nil {
+		return x.ScanRunId
+	}
+	return ""
+}
+
 type GetChaosScanResultsByDomainRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Domain        string                 `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
@@ -3117,7 +3420,7 @@ type GetChaosScanResultsByDomainRequest struct {
 
 func (x *GetChaosScanResultsByDomainRequest) Reset() {
 	*x = GetChaosScanResultsByDomainRequest{}
-	mi := &file_proto_service_proto_msgTypes[58]
+	mi := &file_proto_service_proto_msgTypes[59]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3129,7 +3432,7 @@ func (x *GetChaosScanResultsByDomainRequest) String() string {
 func (*GetChaosScanResultsByDomainRequest) ProtoMessage() {}
 
 func (x *GetChaosScanResultsByDomainRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_service_proto_msgTypes[58]
+	mi := &file_proto_service_proto_msgTypes[59]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3142,7 +3445,7 @@ func (x *GetChaosScanResultsByDomainRequest) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use GetChaosScanResultsByDomainRequest.ProtoReflect.Descriptor instead.
 func (*GetChaosScanResultsByDomainRequest) Descriptor() ([]byte, []int) {
-	return file_proto_service_proto_rawDescGZIP(), []int{58}
+	return file_proto_service_proto_rawDescGZIP(), []int{59}
 }
 
 func (x *GetChaosScanResultsByDomainRequest) GetDomain() string {
@@ -3161,7 +3464,7 @@ type GetChaosScanResultsByDomainResponse struct {
 
 func (x *GetChaosScanResultsByDomainResponse) Reset() {
 	*x = GetChaosScanResultsByDomainResponse{}
-	mi := &file_proto_service_proto_msgTypes[59]
+	mi := &file_proto_service_proto_msgTypes[60]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3173,7 +3476,7 @@ func (x *GetChaosScanResultsByDomainResponse) String() string {
 func (*GetChaosScanResultsByDomainResponse) ProtoMessage() {}
 
 func (x *GetChaosScanResultsByDomainResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_service_proto_msgTypes[59]
+	mi := &file_proto_service_proto_msgTypes[60]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3186,7 +3489,7 @@ func (x *GetChaosScanResultsByDomainResponse) ProtoReflect() protoreflect.Messag
 
 // Deprecated: Use GetChaosScanResultsByDomainResponse.ProtoReflect.Descriptor instead.
 func (*GetChaosScanResultsByDomainResponse) Descriptor() ([]byte, []int) {
-	return file_proto_service_proto_rawDescGZIP(), []int{59}
+	return file_proto_service_proto_rawDescGZIP(), []int{60}
 }
 
 func (x *GetChaosScanResultsByDomainResponse) GetResults() []*ChaosScanResult {
@@ -3209,7 +3512,7 @@ type ChaosScanResult struct {
 
 func (x *ChaosScanResult) Reset() {
 	*x = ChaosScanResult{}
-	mi := &file_proto_service_proto_msgTypes[60]
+	mi := &file_proto_service_proto_msgTypes[61]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3221,7 +3524,7 @@ func (x *ChaosScanResult) String() string {
 func (*ChaosScanResult) ProtoMessage() {}
 
 func (x *ChaosScanResult) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_service_proto_msgTypes[60]
+	mi := &file_proto_service_proto_msgTypes[61]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3234,7 +3537,7 @@ func (x *ChaosScanResult) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ChaosScanResult.ProtoReflect.Descriptor instead.
 func (*ChaosScanResult) Descriptor() ([]byte, []int) {
-	return file_proto_service_proto_rawDescGZIP(), []int{60}
+	return file_proto_service_proto_rawDescGZIP(), []int{61}
 }
 
 func (x *ChaosScanResult) GetId() string {
@@ -3276,13 +3579,14 @@ type ScanShodanRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Domain        string                 `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
 	DnsScanId     string                 `protobuf:"bytes,2,opt,name=dns_scan_id,json=dnsScanId,proto3" json:"dns_scan_id,omitempty"`
+	Refresh       bool                   `protobuf:"varint,3,opt,name=refresh,proto3" json:"refresh,omitempty"` // bypass cached intelligence lookups
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ScanShodanRequest) Reset() {
 	*x = ScanShodanRequest{}
-	mi := &file_proto_service_proto_msgTypes[61]
+	mi := &file_proto_service_proto_msgTypes[62]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3294,7 +3598,7 @@ func (x *ScanShodanRequest) String() string {
 func (*ScanShodanRequest) ProtoMessage() {}
 
 func (x *ScanShodanRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_service_proto_msgTypes[61]
+	mi := &file_proto_service_proto_msgTypes[62]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3307,7 +3611,7 @@ func (x *ScanShodanRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ScanShodanRequest.ProtoReflect.Descriptor instead.
 func (*ScanShodanRequest) Descriptor() ([]byte, []int) {
-	return file_proto_service_proto_rawDescGZIP(), []int{61}
+	return file_proto_service_proto_rawDescGZIP(), []int{62}
 }
 
 func (x *ScanShodanRequest) GetDomain() string {
@@ -3324,17 +3628,25 @@ func (x *ScanShodanRequest) GetDnsScanId() string {
 	return ""
 }
 
+func (x *ScanShodanRequest) GetRefresh() bool {
+	if x != nil {
+		return x.Refresh
+	}
+	return false
+}
+
 type ScanShodanResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ScanId        string                 `protobuf:"bytes,1,opt,name=scan_id,json=scanId,proto3" json:"scan_id,omitempty"`
 	Result        *ShodanSecurityResult  `protobuf:"bytes,2,opt,name=result,proto3" json:"result,omitempty"`
+	ScanRunId     string                 `protobuf:"bytes,3,opt,name=scan_run_id,json=scanRunId,proto3" json:"scan_run_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ScanShodanResponse) Reset() {
 	*x = ScanShodanResponse{}
-	mi := &file_proto_service_proto_msgTypes[62]
+	mi := &file_proto_service_proto_msgTypes[63]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3346,7 +3658,7 @@ func (x *ScanShodanResponse) String() string {
 func (*ScanShodanResponse) ProtoMessage() {}
 
 func (x *ScanShodanResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_service_proto_msgTypes[62]
+	mi := &file_proto_service_proto_msgTypes[63]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3359,7 +3671,7 @@ func (x *ScanShodanResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ScanShodanResponse.ProtoReflect.Descriptor instead.
 func (*ScanShodanResponse) Descriptor() ([]byte, []int) {
-	return file_proto_service_proto_rawDescGZIP(), []int{62}
+	return file_proto_service_proto_rawDescGZIP(), []int{63}
 }
 
 func (x *ScanShodanResponse) GetScanId() string {
@@ -3376,6 +3688,13 @@ func (x *ScanShodanResponse) GetResult() *ShodanSecurityResult {
 	return nil
 }
 
+func (x *ScanShodanResponse) GetScanRunId() string {
+	if x != nil {
+		return x.ScanRunId
+	}
+	return ""
+}
+
 type GetShodanScanResultsByDomainRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Domain        string                 `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
@@ -3385,7 +3704,7 @@ type GetShodanScanResultsByDomainRequest struct {
 
 func (x *GetShodanScanResultsByDomainRequest) Reset() {
 	*x = GetShodanScanResultsByDomainRequest{}
-	mi := &file_proto_service_proto_msgTypes[63]
+	mi := &file_proto_service_proto_msgTypes[64]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3397,7 +3716,7 @@ func (x *GetShodanScanResultsByDomainRequest) String() string {
 func (*GetShodanScanResultsByDomainRequest) ProtoMessage() {}
 
 func (x *GetShodanScanResultsByDomainRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_service_proto_msgTypes[63]
+	mi := &file_proto_service_proto_msgTypes[64]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3410,7 +3729,7 @@ func (x *GetShodanScanResultsByDomainRequest) ProtoReflect() protoreflect.Messag
 
 // Deprecated: Use GetShodanScanResultsByDomainRequest.ProtoReflect.Descriptor instead.
 func (*GetShodanScanResultsByDomainRequest) Descriptor() ([]byte, []int) {
-	return file_proto_service_proto_rawDescGZIP(), []int{63}
+	return file_proto_service_proto_rawDescGZIP(), []int{64}
 }
 
 func (x *GetShodanScanResultsByDomainRequest) GetDomain() string {
@@ -3429,7 +3748,7 @@ type GetShodanScanResultsByDomainResponse struct {
 
 func (x *GetShodanScanResultsByDomainResponse) Reset() {
 	*x = GetShodanScanResultsByDomainResponse{}
-	mi := &file_proto_service_proto_msgTypes[64]
+	mi := &file_proto_service_proto_msgTypes[65]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3441,7 +3760,7 @@ func (x *GetShodanScanResultsByDomainResponse) String() string {
 func (*GetShodanScanResultsByDomainResponse) ProtoMessage() {}
 
 func (x *GetShodanScanResultsByDomainResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_service_proto_msgTypes[64]
+	mi := &file_proto_service_proto_msgTypes[65]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3454,7 +3773,7 @@ func (x *GetShodanScanResultsByDomainResponse) ProtoReflect() protoreflect.Messa
 
 // Deprecated: Use GetShodanScanResultsByDomainResponse.ProtoReflect.Descriptor instead.
 func (*GetShodanScanResultsByDomainResponse) Descriptor() ([]byte, []int) {
-	return file_proto_service_proto_rawDescGZIP(), []int{64}
+	return file_proto_service_proto_rawDescGZIP(), []int{65}
 }
 
 func (x *GetShodanScanResultsByDomainResponse) GetResults() []*ShodanScanResult {
@@ -3484,13 +3803,24 @@ type DNSSecurityResult struct {
 	MxRecords             []string               `protobuf:"bytes,15,rep,name=mx_records,json=mxRecords,proto3" json:"mx_records,omitempty"`
 	NsRecords             []string               `protobuf:"bytes,16,rep,name=ns_records,json=nsRecords,proto3" json:"ns_records,omitempty"`
 	Errors                []string               `protobuf:"bytes,17,rep,name=errors,proto3" json:"errors,omitempty"`
+	DkimSelectors         []*DKIMSelector        `protobuf:"bytes,18,rep,name=dkim_selectors,json=dkimSelectors,proto3" json:"dkim_selectors,omitempty"`
+	DkimSelectorsTried    int32                  `protobuf:"varint,19,opt,name=dkim_selectors_tried,json=dkimSelectorsTried,proto3" json:"dkim_selectors_tried,omitempty"`
+	SpfEvaluation         *SPFEvaluation         `protobuf:"bytes,20,opt,name=spf_evaluation,json=spfEvaluation,proto3" json:"spf_evaluation,omitempty"`
+	DnssecChain           []*DNSSECZone          `protobuf:"bytes,21,rep,name=dnssec_chain,json=dnssecChain,proto3" json:"dnssec_chain,omitempty"`
+	DnssecFindings        []*DNSSECFinding       `protobuf:"bytes,22,rep,name=dnssec_findings,json=dnssecFindings,proto3" json:"dnssec_findings,omitempty"`
+	MtaSts                *MTASTSResult          `protobuf:"bytes,23,opt,name=mta_sts,json=mtaSts,proto3" json:"mta_sts,omitempty"`
+	TlsRpt                *TLSRPTResult          `protobuf:"bytes,24,opt,name=tls_rpt,json=tlsRpt,proto3" json:"tls_rpt,omitempty"`
+	Bimi                  *BIMIResult            `protobuf:"bytes,25,opt,name=bimi,proto3" json:"bimi,omitempty"`
+	Dane                  []*DANEResult          `protobuf:"bytes,26,rep,name=dane,proto3" json:"dane,omitempty"`
+	DnssecSkipped         bool                   `protobuf:"varint,27,opt,name=dnssec_skipped,json=dnssecSkipped,proto3" json:"dnssec_skipped,omitempty"`                       // the scan's options left out the DNSSEC checks
+	MtaStsPolicySkipped   bool                   `protobuf:"varint,28,opt,name=mta_sts_policy_skipped,json=mtaStsPolicySkipped,proto3" json:"mta_sts_policy_skipped,omitempty"` // the scan's options left out the MTA-STS policy fetch
 	unknownFields         protoimpl.UnknownFields
 	sizeCache             protoimpl.SizeCache
 }
 
 func (x *DNSSecurityResult) Reset() {
 	*x = DNSSecurityResult{}
-	mi := &file_proto_service_proto_msgTypes[65]
+	mi := &file_proto_service_proto_msgTypes[66]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3502,7 +3832,7 @@ func (x *DNSSecurityResult) String() string {
 func (*DNSSecurityResult) ProtoMessage() {}
 
 func (x *DNSSecurityResult) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_service_proto_msgTypes[65]
+	mi := &file_proto_service_proto_msgTypes[66]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3515,7 +3845,7 @@ func (x *DNSSecurityResult) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DNSSecurityResult.ProtoReflect.Descriptor instead.
 func (*DNSSecurityResult) Descriptor() ([]byte, []int) {
-	return file_proto_service_proto_rawDescGZIP(), []int{65}
+	return file_proto_service_proto_rawDescGZIP(), []int{66}
 }
 
 func (x *DNSSecurityResult) GetSpfRecord() string {
@@ -3637,166 +3967,114 @@ func (x *DNSSecurityResult) GetErrors() []string {
 	return nil
 }
 
-type TLSSecurityResult struct {
-	state                  protoimpl.MessageState `protogen:"open.v1"`
-	TlsVersion             string                 `protobuf:"bytes,1,opt,name=tls_version,json=tlsVersion,proto3" json:"tls_version,omitempty"`
-	CipherSuite            string                 `protobuf:"bytes,2,opt,name=cipher_suite,json=cipherSuite,proto3" json:"cipher_suite,omitempty"`
-	HstsHeader             bool                   `protobuf:"varint,3,opt,name=hsts_header,json=hstsHeader,proto3" json:"hsts_header,omitempty"`
-	CertificateValid       bool                   `protobuf:"varint,4,opt,name=certificate_valid,json=certificateValid,proto3" json:"certificate_valid,omitempty"`
-	CertIssuer             string                 `protobuf:"bytes,5,opt,name=cert_issuer,json=certIssuer,proto3" json:"cert_issuer,omitempty"`
-	CertSubject            string                 `protobuf:"bytes,6,opt,name=cert_subject,json=certSubject,proto3" json:"cert_subject,omitempty"`
-	CertNotBefore          *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=cert_not_before,json=certNotBefore,proto3" json:"cert_not_before,omitempty"`
-	CertNotAfter           *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=cert_not_after,json=certNotAfter,proto3" json:"cert_not_after,omitempty"`
-	CertDnsNames           []string               `protobuf:"bytes,9,rep,name=cert_dns_names,json=certDnsNames,proto3" json:"cert_dns_names,omitempty"`
-	CertKeyStrength        int32                  `protobuf:"varint,10,opt,name=cert_key_strength,json=certKeyStrength,proto3" json:"cert_key_strength,omitempty"`
-	CertSignatureAlgorithm string                 `protobuf:"bytes,11,opt,name=cert_signature_algorithm,json=certSignatureAlgorithm,proto3" json:"cert_signature_algorithm,omitempty"`
-	Errors                 []string               `protobuf:"bytes,12,rep,name=errors,proto3" json:"errors,omitempty"`
-	unknownFields          protoimpl.UnknownFields
-	sizeCache              protoimpl.SizeCache
-}
-
-func (x *TLSSecurityResult) Reset() {
-	*x = TLSSecurityResult{}
-	mi := &file_proto_service_proto_msgTypes[66]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *TLSSecurityResult) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*TLSSecurityResult) ProtoMessage() {}
-
-func (x *TLSSecurityResult) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_service_proto_msgTypes[66]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use TLSSecurityResult.ProtoReflect.Descriptor instead.
-func (*TLSSecurityResult) Descriptor() ([]byte, []int) {
-	return file_proto_service_proto_rawDescGZIP(), []int{66}
-}
-
-func (x *TLSSecurityResult) GetTlsVersion() string {
-	if x != nil {
-		return x.TlsVersion
-	}
-	return ""
-}
-
-func (x *TLSSecurityResult) GetCipherSuite() string {
+func (x *DNSSecurityResult) GetDkimSelectors() []*DKIMSelector {
 	if x != nil {
-		return x.CipherSuite
+		return x.DkimSelectors
 	}
-	return ""
+	return nil
 }
 
-func (x *TLSSecurityResult) GetHstsHeader() bool {
+func (x *DNSSecurityResult) GetDkimSelectorsTried() int32 {
 	if x != nil {
-		return x.HstsHeader
+		return x.DkimSelectorsTried
 	}
-	return false
+	return 0
 }
 
-func (x *TLSSecurityResult) GetCertificateValid() bool {
+func (x *DNSSecurityResult) GetSpfEvaluation() *SPFEvaluation {
 	if x != nil {
-		return x.CertificateValid
+		return x.SpfEvaluation
 	}
-	return false
+	return nil
 }
 
-func (x *TLSSecurityResult) GetCertIssuer() string {
+func (x *DNSSecurityResult) GetDnssecChain() []*DNSSECZone {
 	if x != nil {
-		return x.CertIssuer
+		return x.DnssecChain
 	}
-	return ""
+	return nil
 }
 
-func (x *TLSSecurityResult) GetCertSubject() string {
+func (x *DNSSecurityResult) GetDnssecFindings() []*DNSSECFinding {
 	if x != nil {
-		return x.CertSubject
+		return x.DnssecFindings
 	}
-	return ""
+	return nil
 }
 
-func (x *TLSSecurityResult) GetCertNotBefore() *timestamppb.Timestamp {
+func (x *DNSSecurityResult) GetMtaSts() *MTASTSResult {
 	if x != nil {
-		return x.CertNotBefore
+		return x.MtaSts
 	}
 	return nil
 }
 
-func (x *TLSSecurityResult) GetCertNotAfter() *timestamppb.Timestamp {
+func (x *DNSSecurityResult) GetTlsRpt() *TLSRPTResult {
 	if x != nil {
-		return x.CertNotAfter
+		return x.TlsRpt
 	}
 	return nil
 }
 
-func (x *TLSSecurityResult) GetCertDnsNames() []string {
+func (x *DNSSecurityResult) GetBimi() *BIMIResult {
 	if x != nil {
-		return x.CertDnsNames
+		return x.Bimi
 	}
 	return nil
 }
 
-func (x *TLSSecurityResult) GetCertKeyStrength() int32 {
+func (x *DNSSecurityResult) GetDane() []*DANEResult {
 	if x != nil {
-		return x.CertKeyStrength
+		return x.Dane
 	}
-	return 0
+	return nil
 }
 
-func (x *TLSSecurityResult) GetCertSignatureAlgorithm() string {
+func (x *DNSSecurityResult) GetDnssecSkipped() bool {
 	if x != nil {
-		return x.CertSignatureAlgorithm
+		return x.DnssecSkipped
 	}
-	return ""
+	return false
 }
 
-func (x *TLSSecurityResult) GetErrors() []string {
+func (x *DNSSecurityResult) GetMtaStsPolicySkipped() bool {
 	if x != nil {
-		return x.Errors
+		return x.MtaStsPolicySkipped
 	}
-	return nil
+	return false
 }
 
-type CrtShCertificate struct {
-	state              protoimpl.MessageState `protogen:"open.v1"`
-	Id                 int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	CommonName         string                 `protobuf:"bytes,2,opt,name=common_name,json=commonName,proto3" json:"common_name,omitempty"`
-	Issuer             string                 `protobuf:"bytes,3,opt,name=issuer,proto3" json:"issuer,omitempty"`
-	NotBefore          *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=not_before,json=notBefore,proto3" json:"not_before,omitempty"`
-	NotAfter           *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=not_after,json=notAfter,proto3" json:"not_after,omitempty"`
-	SerialNumber       string                 `protobuf:"bytes,6,opt,name=serial_number,json=serialNumber,proto3" json:"serial_number,omitempty"`
-	DnsNames           []string               `protobuf:"bytes,7,rep,name=dns_names,json=dnsNames,proto3" json:"dns_names,omitempty"`
-	SignatureAlgorithm string                 `protobuf:"bytes,8,opt,name=signature_algorithm,json=signatureAlgorithm,proto3" json:"signature_algorithm,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+// MTASTSResult is the domain's MTA-STS record and policy (RFC 8461).
+type MTASTSResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Record        string                 `protobuf:"bytes,1,opt,name=record,proto3" json:"record,omitempty"` // _mta-sts TXT record
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	RecordValid   bool                   `protobuf:"varint,3,opt,name=record_valid,json=recordValid,proto3" json:"record_valid,omitempty"`
+	Policy        string                 `protobuf:"bytes,4,opt,name=policy,proto3" json:"policy,omitempty"` // https://mta-sts.<domain>/.well-known/mta-sts.txt
+	Mode          string                 `protobuf:"bytes,5,opt,name=mode,proto3" json:"mode,omitempty"`     // enforce, testing or none
+	MxPatterns    []string               `protobuf:"bytes,6,rep,name=mx_patterns,json=mxPatterns,proto3" json:"mx_patterns,omitempty"`
+	MaxAge        int64                  `protobuf:"varint,7,opt,name=max_age,json=maxAge,proto3" json:"max_age,omitempty"` // seconds
+	PolicyValid   bool                   `protobuf:"varint,8,opt,name=policy_valid,json=policyValid,proto3" json:"policy_valid,omitempty"`
+	UnmatchedMx   []string               `protobuf:"bytes,9,rep,name=unmatched_mx,json=unmatchedMx,proto3" json:"unmatched_mx,omitempty"` // MX hosts no mx pattern covers
+	Errors        []string               `protobuf:"bytes,10,rep,name=errors,proto3" json:"errors,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CrtShCertificate) Reset() {
-	*x = CrtShCertificate{}
+func (x *MTASTSResult) Reset() {
+	*x = MTASTSResult{}
 	mi := &file_proto_service_proto_msgTypes[67]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CrtShCertificate) String() string {
+func (x *MTASTSResult) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CrtShCertificate) ProtoMessage() {}
+func (*MTASTSResult) ProtoMessage() {}
 
-func (x *CrtShCertificate) ProtoReflect() protoreflect.Message {
+func (x *MTASTSResult) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_service_proto_msgTypes[67]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3808,90 +4086,106 @@ func (x *CrtShCertificate) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CrtShCertificate.ProtoReflect.Descriptor instead.
-func (*CrtShCertificate) Descriptor() ([]byte, []int) {
+// Deprecated: Use MTASTSResult.ProtoReflect.Descriptor instead.
+func (*MTASTSResult) Descriptor() ([]byte, []int) {
 	return file_proto_service_proto_rawDescGZIP(), []int{67}
 }
 
-func (x *CrtShCertificate) GetId() int64 {
+func (x *MTASTSResult) GetRecord() string {
+	if x != nil {
+		return x.Record
+	}
+	return ""
+}
+
+func (x *MTASTSResult) GetId() string {
 	if x != nil {
 		return x.Id
 	}
-	return 0
+	return ""
 }
 
-func (x *CrtShCertificate) GetCommonName() string {
+func (x *MTASTSResult) GetRecordValid() bool {
 	if x != nil {
-		return x.CommonName
+		return x.RecordValid
+	}
+	return false
+}
+
+func (x *MTASTSResult) GetPolicy() string {
+	if x != nil {
+		return x.Policy
 	}
 	return ""
 }
 
-func (x *CrtShCertificate) GetIssuer() string {
+func (x *MTASTSResult) GetMode() string {
 	if x != nil {
-		return x.Issuer
+		return x.Mode
 	}
 	return ""
 }
 
-func (x *CrtShCertificate) GetNotBefore() *timestamppb.Timestamp {
+func (x *MTASTSResult) GetMxPatterns() []string {
 	if x != nil {
-		return x.NotBefore
+		return x.MxPatterns
 	}
 	return nil
 }
 
-func (x *CrtShCertificate) GetNotAfter() *timestamppb.Timestamp {
+func (x *MTASTSResult) GetMaxAge() int64 {
 	if x != nil {
-		return x.NotAfter
+		return x.MaxAge
 	}
-	return nil
+	return 0
 }
 
-func (x *CrtShCertificate) GetSerialNumber() string {
+func (x *MTASTSResult) GetPolicyValid() bool {
 	if x != nil {
-		return x.SerialNumber
+		return x.PolicyValid
 	}
-	return ""
+	return false
 }
 
-func (x *CrtShCertificate) GetDnsNames() []string {
+func (x *MTASTSResult) GetUnmatchedMx() []string {
 	if x != nil {
-		return x.DnsNames
+		return x.UnmatchedMx
 	}
 	return nil
 }
 
-func (x *CrtShCertificate) GetSignatureAlgorithm() string {
+func (x *MTASTSResult) GetErrors() []string {
 	if x != nil {
-		return x.SignatureAlgorithm
+		return x.Errors
 	}
-	return ""
+	return nil
 }
 
-type CrtShSecurityResult struct {
+// TLSRPTResult is the domain's SMTP TLS reporting record (RFC 8460).
+type TLSRPTResult struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Certificates  []*CrtShCertificate    `protobuf:"bytes,1,rep,name=certificates,proto3" json:"certificates,omitempty"`
-	Subdomains    []string               `protobuf:"bytes,2,rep,name=subdomains,proto3" json:"subdomains,omitempty"`
-	Errors        []string               `protobuf:"bytes,3,rep,name=errors,proto3" json:"errors,omitempty"`
+	Record        string                 `protobuf:"bytes,1,opt,name=record,proto3" json:"record,omitempty"` // _smtp._tls TXT record
+	Rua           []string               `protobuf:"bytes,2,rep,name=rua,proto3" json:"rua,omitempty"`       // mailto: and https: report destinations
+	Valid         bool                   `protobuf:"varint,3,opt,name=valid,proto3" json:"valid,omitempty"`
+	Errors        []string               `protobuf:"bytes,4,rep,name=errors,proto3" json:"errors,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CrtShSecurityResult) Reset() {
-	*x = CrtShSecurityResult{}
+func (x *TLSRPTResult) Reset() {
+	*x = TLSRPTResult{}
 	mi := &file_proto_service_proto_msgTypes[68]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CrtShSecurityResult) String() string {
+func (x *TLSRPTResult) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CrtShSecurityResult) ProtoMessage() {}
+func (*TLSRPTResult) ProtoMessage() {}
 
-func (x *CrtShSecurityResult) ProtoReflect() protoreflect.Message {
+func (x *TLSRPTResult) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_service_proto_msgTypes[68]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3903,54 +4197,65 @@ func (x *CrtShSecurityResult) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CrtShSecurityResult.ProtoReflect.Descriptor instead.
-func (*CrtShSecurityResult) Descriptor() ([]byte, []int) {
+// Deprecated: Use TLSRPTResult.ProtoReflect.Descriptor instead.
+func (*TLSRPTResult) Descriptor() ([]byte, []int) {
 	return file_proto_service_proto_rawDescGZIP(), []int{68}
 }
 
-func (x *CrtShSecurityResult) GetCertificates() []*CrtShCertificate {
+func (x *TLSRPTResult) GetRecord() string {
 	if x != nil {
-		return x.Certificates
+		return x.Record
 	}
-	return nil
+	return ""
 }
 
-func (x *CrtShSecurityResult) GetSubdomains() []string {
+func (x *TLSRPTResult) GetRua() []string {
 	if x != nil {
-		return x.Subdomains
+		return x.Rua
 	}
 	return nil
 }
 
-func (x *CrtShSecurityResult) GetErrors() []string {
+func (x *TLSRPTResult) GetValid() bool {
+	if x != nil {
+		return x.Valid
+	}
+	return false
+}
+
+func (x *TLSRPTResult) GetErrors() []string {
 	if x != nil {
 		return x.Errors
 	}
 	return nil
 }
 
-type ChaosSecurityResult struct {
+// BIMIResult is the domain's default BIMI record.
+type BIMIResult struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Subdomains    []string               `protobuf:"bytes,1,rep,name=subdomains,proto3" json:"subdomains,omitempty"`
-	Errors        []string               `protobuf:"bytes,2,rep,name=errors,proto3" json:"errors,omitempty"`
+	Record        string                 `protobuf:"bytes,1,opt,name=record,proto3" json:"record,omitempty"`                                 // default._bimi TXT record
+	LogoUrl       string                 `protobuf:"bytes,2,opt,name=logo_url,json=logoUrl,proto3" json:"logo_url,omitempty"`                // l=, an SVG over HTTPS
+	AuthorityUrl  string                 `protobuf:"bytes,3,opt,name=authority_url,json=authorityUrl,proto3" json:"authority_url,omitempty"` // a=, the mark certificate over HTTPS
+	Valid         bool                   `protobuf:"varint,4,opt,name=valid,proto3" json:"valid,omitempty"`
+	Errors        []string               `protobuf:"bytes,5,rep,name=errors,proto3" json:"errors,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ChaosSecurityResult) Reset() {
-	*x = ChaosSecurityResult{}
+func (x *BIMIResult) Reset() {
+	*x = BIMIResult{}
 	mi := &file_proto_service_proto_msgTypes[69]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ChaosSecurityResult) String() string {
+func (x *BIMIResult) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChaosSecurityResult) ProtoMessage() {}
+func (*BIMIResult) ProtoMessage() {}
 
-func (x *ChaosSecurityResult) ProtoReflect() protoreflect.Message {
+func (x *BIMIResult) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_service_proto_msgTypes[69]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3962,50 +4267,72 @@ func (x *ChaosSecurityResult) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChaosSecurityResult.ProtoReflect.Descriptor instead.
-func (*ChaosSecurityResult) Descriptor() ([]byte, []int) {
+// Deprecated: Use BIMIResult.ProtoReflect.Descriptor instead.
+func (*BIMIResult) Descriptor() ([]byte, []int) {
 	return file_proto_service_proto_rawDescGZIP(), []int{69}
 }
 
-func (x *ChaosSecurityResult) GetSubdomains() []string {
+func (x *BIMIResult) GetRecord() string {
 	if x != nil {
-		return x.Subdomains
+		return x.Record
 	}
-	return nil
+	return ""
 }
 
-func (x *ChaosSecurityResult) GetErrors() []string {
+func (x *BIMIResult) GetLogoUrl() string {
+	if x != nil {
+		return x.LogoUrl
+	}
+	return ""
+}
+
+func (x *BIMIResult) GetAuthorityUrl() string {
+	if x != nil {
+		return x.AuthorityUrl
+	}
+	return ""
+}
+
+func (x *BIMIResult) GetValid() bool {
+	if x != nil {
+		return x.Valid
+	}
+	return false
+}
+
+func (x *BIMIResult) GetErrors() []string {
 	if x != nil {
 		return x.Errors
 	}
 	return nil
 }
 
-type ShodanScanResult struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Domain        string                 `protobuf:"bytes,2,opt,name=domain,proto3" json:"domain,omitempty"`
-	DnsScanId     string                 `protobuf:"bytes,3,opt,name=dns_scan_id,json=dnsScanId,proto3" json:"dns_scan_id,omitempty"`
-	Result        *ShodanSecurityResult  `protobuf:"bytes,4,opt,name=result,proto3" json:"result,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// DANEResult is the TLSA records published for one MX host (RFC 7672).
+type DANEResult struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	MxHost              string                 `protobuf:"bytes,1,opt,name=mx_host,json=mxHost,proto3" json:"mx_host,omitempty"`
+	Records             []string               `protobuf:"bytes,2,rep,name=records,proto3" json:"records,omitempty"`                                                     // _25._tcp TLSA records
+	DnssecAuthenticated bool                   `protobuf:"varint,3,opt,name=dnssec_authenticated,json=dnssecAuthenticated,proto3" json:"dnssec_authenticated,omitempty"` // the resolver validated the answer
+	Valid               bool                   `protobuf:"varint,4,opt,name=valid,proto3" json:"valid,omitempty"`
+	Errors              []string               `protobuf:"bytes,5,rep,name=errors,proto3" json:"errors,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
 }
 
-func (x *ShodanScanResult) Reset() {
-	*x = ShodanScanResult{}
+func (x *DANEResult) Reset() {
+	*x = DANEResult{}
 	mi := &file_proto_service_proto_msgTypes[70]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ShodanScanResult) String() string {
+func (x *DANEResult) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ShodanScanResult) ProtoMessage() {}
+func (*DANEResult) ProtoMessage() {}
 
-func (x *ShodanScanResult) ProtoReflect() protoreflect.Message {
+func (x *DANEResult) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_service_proto_msgTypes[70]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4017,70 +4344,74 @@ func (x *ShodanScanResult) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ShodanScanResult.ProtoReflect.Descriptor instead.
-func (*ShodanScanResult) Descriptor() ([]byte, []int) {
+// Deprecated: Use DANEResult.ProtoReflect.Descriptor instead.
+func (*DANEResult) Descriptor() ([]byte, []int) {
 	return file_proto_service_proto_rawDescGZIP(), []int{70}
 }
 
-func (x *ShodanScanResult) GetId() string {
+func (x *DANEResult) GetMxHost() string {
 	if x != nil {
-		return x.Id
+		return x.MxHost
 	}
 	return ""
 }
 
-func (x *ShodanScanResult) GetDomain() string {
+func (x *DANEResult) GetRecords() []string {
 	if x != nil {
-		return x.Domain
+		return x.Records
 	}
-	return ""
+	return nil
 }
 
-func (x *ShodanScanResult) GetDnsScanId() string {
+func (x *DANEResult) GetDnssecAuthenticated() bool {
 	if x != nil {
-		return x.DnsScanId
+		return x.DnssecAuthenticated
 	}
-	return ""
+	return false
 }
 
-func (x *ShodanScanResult) GetResult() *ShodanSecurityResult {
+func (x *DANEResult) GetValid() bool {
 	if x != nil {
-		return x.Result
+		return x.Valid
 	}
-	return nil
+	return false
 }
 
-func (x *ShodanScanResult) GetCreatedAt() *timestamppb.Timestamp {
+func (x *DANEResult) GetErrors() []string {
 	if x != nil {
-		return x.CreatedAt
+		return x.Errors
 	}
 	return nil
 }
 
-type ShodanLocation struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	City          string                 `protobuf:"bytes,1,opt,name=city,proto3" json:"city,omitempty"`
-	CountryName   string                 `protobuf:"bytes,2,opt,name=country_name,json=countryName,proto3" json:"country_name,omitempty"`
-	Latitude      float32                `protobuf:"fixed32,3,opt,name=latitude,proto3" json:"latitude,omitempty"`
-	Longitude     float32                `protobuf:"fixed32,4,opt,name=longitude,proto3" json:"longitude,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// DNSSECZone is one zone on the chain of trust from the root to the domain.
+type DNSSECZone struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Zone            string                 `protobuf:"bytes,1,opt,name=zone,proto3" json:"zone,omitempty"`
+	Status          string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"` // secure, insecure or bogus
+	KeyTags         []int32                `protobuf:"varint,3,rep,packed,name=key_tags,json=keyTags,proto3" json:"key_tags,omitempty"`
+	Algorithms      []string               `protobuf:"bytes,4,rep,name=algorithms,proto3" json:"algorithms,omitempty"`
+	Denial          string                 `protobuf:"bytes,5,opt,name=denial,proto3" json:"denial,omitempty"` // nsec or nsec3
+	Nsec3Iterations int32                  `protobuf:"varint,6,opt,name=nsec3_iterations,json=nsec3Iterations,proto3" json:"nsec3_iterations,omitempty"`
+	Nsec3Salt       string                 `protobuf:"bytes,7,opt,name=nsec3_salt,json=nsec3Salt,proto3" json:"nsec3_salt,omitempty"` // hex; empty when unsalted
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *ShodanLocation) Reset() {
-	*x = ShodanLocation{}
+func (x *DNSSECZone) Reset() {
+	*x = DNSSECZone{}
 	mi := &file_proto_service_proto_msgTypes[71]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ShodanLocation) String() string {
+func (x *DNSSECZone) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ShodanLocation) ProtoMessage() {}
+func (*DNSSECZone) ProtoMessage() {}
 
-func (x *ShodanLocation) ProtoReflect() protoreflect.Message {
+func (x *DNSSECZone) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_service_proto_msgTypes[71]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4092,63 +4423,85 @@ func (x *ShodanLocation) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ShodanLocation.ProtoReflect.Descriptor instead.
-func (*ShodanLocation) Descriptor() ([]byte, []int) {
+// Deprecated: Use DNSSECZone.ProtoReflect.Descriptor instead.
+func (*DNSSECZone) Descriptor() ([]byte, []int) {
 	return file_proto_service_proto_rawDescGZIP(), []int{71}
 }
 
-func (x *ShodanLocation) GetCity() string {
+func (x *DNSSECZone) GetZone() string {
 	if x != nil {
-		return x.City
+		return x.Zone
 	}
 	return ""
 }
 
-func (x *ShodanLocation) GetCountryName() string {
+func (x *DNSSECZone) GetStatus() string {
 	if x != nil {
-		return x.CountryName
+		return x.Status
 	}
 	return ""
 }
 
-func (x *ShodanLocation) GetLatitude() float32 {
+func (x *DNSSECZone) GetKeyTags() []int32 {
 	if x != nil {
-		return x.Latitude
+		return x.KeyTags
 	}
-	return 0
+	return nil
 }
 
-func (x *ShodanLocation) GetLongitude() float32 {
+func (x *DNSSECZone) GetAlgorithms() []string {
 	if x != nil {
-		return x.Longitude
+		return x.Algorithms
+	}
+	return nil
+}
+
+func (x *DNSSECZone) GetDenial() string {
+	if x != nil {
+		return x.Denial
+	}
+	return ""
+}
+
+func (x *DNSSECZone) GetNsec3Iterations() int32 {
+	if x != nil {
+		return x.Nsec3Iterations
 	}
 	return 0
 }
 
-type ShodanSSL struct {
+func (x *DNSSECZone) GetNsec3Salt() string {
+	if x != nil {
+		return x.Nsec3Salt
+	}
+	return ""
+}
+
+// DNSSECFinding is one problem found validating the chain of trust.
+type DNSSECFinding struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Issuer        string                 `protobuf:"bytes,1,opt,name=issuer,proto3" json:"issuer,omitempty"`
-	Subject       string                 `protobuf:"bytes,2,opt,name=subject,proto3" json:"subject,omitempty"`
-	Expires       *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=expires,proto3" json:"expires,omitempty"`
-	NotAfter      *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=not_after,json=notAfter,proto3" json:"not_after,omitempty"`
+	Zone          string                 `protobuf:"bytes,1,opt,name=zone,proto3" json:"zone,omitempty"`
+	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`         // e.g. ds_mismatch, signature_expired, weak_algorithm
+	Severity      string                 `protobuf:"bytes,3,opt,name=severity,proto3" json:"severity,omitempty"` // critical, high, medium, low or info
+	Message       string                 `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ShodanSSL) Reset() {
-	*x = ShodanSSL{}
+func (x *DNSSECFinding) Reset() {
+	*x = DNSSECFinding{}
 	mi := &file_proto_service_proto_msgTypes[72]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ShodanSSL) String() string {
+func (x *DNSSECFinding) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ShodanSSL) ProtoMessage() {}
+func (*DNSSECFinding) ProtoMessage() {}
 
-func (x *ShodanSSL) ProtoReflect() protoreflect.Message {
+func (x *DNSSECFinding) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_service_proto_msgTypes[72]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4160,60 +4513,71 @@ func (x *ShodanSSL) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ShodanSSL.ProtoReflect.Descriptor instead.
-func (*ShodanSSL) Descriptor() ([]byte, []int) {
+// Deprecated: Use DNSSECFinding.ProtoReflect.Descriptor instead.
+func (*DNSSECFinding) Descriptor() ([]byte, []int) {
 	return file_proto_service_proto_rawDescGZIP(), []int{72}
 }
 
-func (x *ShodanSSL) GetIssuer() string {
+func (x *DNSSECFinding) GetZone() string {
 	if x != nil {
-		return x.Issuer
+		return x.Zone
 	}
 	return ""
 }
 
-func (x *ShodanSSL) GetSubject() string {
+func (x *DNSSECFinding) GetCode() string {
 	if x != nil {
-		return x.Subject
+		return x.Code
 	}
 	return ""
 }
 
-func (x *ShodanSSL) GetExpires() *timestamppb.Timestamp {
+func (x *DNSSECFinding) GetSeverity() string {
 	if x != nil {
-		return x.Expires
+		return x.Severity
 	}
-	return nil
+	return ""
 }
 
-func (x *ShodanSSL) GetNotAfter() *timestamppb.Timestamp {
+func (x *DNSSECFinding) GetMessage() string {
 	if x != nil {
-		return x.NotAfter
+		return x.Message
 	}
-	return nil
+	return ""
 }
 
-type ShodanMetadata struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Module        string                 `protobuf:"bytes,1,opt,name=module,proto3" json:"module,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// SPFEvaluation is the outcome of expanding a domain's SPF record the way a
+// receiver would under RFC 7208.
+type SPFEvaluation struct {
+	state                   protoimpl.MessageState `protogen:"open.v1"`
+	RecordCount             int32                  `protobuf:"varint,1,opt,name=record_count,json=recordCount,proto3" json:"record_count,omitempty"` // more than one is a permerror
+	DnsLookups              int32                  `protobuf:"varint,2,opt,name=dns_lookups,json=dnsLookups,proto3" json:"dns_lookups,omitempty"`    // include, a, mx, ptr, exists and redirect; the limit is 10
+	VoidLookups             int32                  `protobuf:"varint,3,opt,name=void_lookups,json=voidLookups,proto3" json:"void_lookups,omitempty"` // lookups that found nothing; the limit is 2
+	LookupLimitExceeded     bool                   `protobuf:"varint,4,opt,name=lookup_limit_exceeded,json=lookupLimitExceeded,proto3" json:"lookup_limit_exceeded,omitempty"`
+	VoidLookupLimitExceeded bool                   `protobuf:"varint,5,opt,name=void_lookup_limit_exceeded,json=voidLookupLimitExceeded,proto3" json:"void_lookup_limit_exceeded,omitempty"`
+	PermissiveAll           bool                   `protobuf:"varint,6,opt,name=permissive_all,json=permissiveAll,proto3" json:"permissive_all,omitempty"` // +all lets anyone send as the domain
+	BroadRanges             []string               `protobuf:"bytes,7,rep,name=broad_ranges,json=broadRanges,proto3" json:"broad_ranges,omitempty"`        // ip4 wider than /16 or ip6 wider than /32 in the domain's own records
+	AuthorizedIps           []string               `protobuf:"bytes,8,rep,name=authorized_ips,json=authorizedIps,proto3" json:"authorized_ips,omitempty"`  // flattened addresses and ranges allowed to send
+	Includes                []string               `protobuf:"bytes,9,rep,name=includes,proto3" json:"includes,omitempty"`                                 // domains expanded through include and redirect
+	Errors                  []string               `protobuf:"bytes,10,rep,name=errors,proto3" json:"errors,omitempty"`                                    // why receivers would return permerror or temperror
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
 }
 
-func (x *ShodanMetadata) Reset() {
-	*x = ShodanMetadata{}
+func (x *SPFEvaluation) Reset() {
+	*x = SPFEvaluation{}
 	mi := &file_proto_service_proto_msgTypes[73]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ShodanMetadata) String() string {
+func (x *SPFEvaluation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ShodanMetadata) ProtoMessage() {}
+func (*SPFEvaluation) ProtoMessage() {}
 
-func (x *ShodanMetadata) ProtoReflect() protoreflect.Message {
+func (x *SPFEvaluation) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_service_proto_msgTypes[73]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4225,189 +4589,4301 @@ func (x *ShodanMetadata) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ShodanMetadata.ProtoReflect.Descriptor instead.
-func (*ShodanMetadata) Descriptor() ([]byte, []int) {
+// Deprecated: Use SPFEvaluation.ProtoReflect.Descriptor instead.
+func (*SPFEvaluation) Descriptor() ([]byte, []int) {
 	return file_proto_service_proto_rawDescGZIP(), []int{73}
 }
 
-func (x *ShodanMetadata) GetModule() string {
+func (x *SPFEvaluation) GetRecordCount() int32 {
 	if x != nil {
-		return x.Module
+		return x.RecordCount
 	}
-	return ""
-}
-
-type ShodanHost struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Ip            string                 `protobuf:"bytes,1,opt,name=ip,proto3" json:"ip,omitempty"`
-	Port          int32                  `protobuf:"varint,2,opt,name=port,proto3" json:"port,omitempty"`
-	Hostnames     []string               `protobuf:"bytes,3,rep,name=hostnames,proto3" json:"hostnames,omitempty"`
-	Os            string                 `protobuf:"bytes,4,opt,name=os,proto3" json:"os,omitempty"`
-	Banner        string                 `protobuf:"bytes,5,opt,name=banner,proto3" json:"banner,omitempty"`
-	Tags          []string               `protobuf:"bytes,6,rep,name=tags,proto3" json:"tags,omitempty"`
-	Location      *ShodanLocation        `protobuf:"bytes,7,opt,name=location,proto3" json:"location,omitempty"`
-	Ssl           *ShodanSSL             `protobuf:"bytes,8,opt,name=ssl,proto3" json:"ssl,omitempty"`
-	Domains       []string               `protobuf:"bytes,9,rep,name=domains,proto3" json:"domains,omitempty"`
-	Asn           string                 `protobuf:"bytes,10,opt,name=asn,proto3" json:"asn,omitempty"`
-	Org           string                 `protobuf:"bytes,11,opt,name=org,proto3" json:"org,omitempty"`
-	Isp           string                 `protobuf:"bytes,12,opt,name=isp,proto3" json:"isp,omitempty"`
-	Timestamp     *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	ShodanMeta    *ShodanMetadata        `protobuf:"bytes,14,opt,name=shodan_meta,json=shodanMeta,proto3" json:"shodan_meta,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *ShodanHost) Reset() {
-	*x = ShodanHost{}
-	mi := &file_proto_service_proto_msgTypes[74]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *ShodanHost) String() string {
-	return protoimpl.X.MessageStringOf(x)
+	return 0
 }
 
-func (*ShodanHost) ProtoMessage() {}
-
-func (x *ShodanHost) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_service_proto_msgTypes[74]
+func (x *SPFEvaluation) GetDnsLookups() int32 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.DnsLookups
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use ShodanHost.ProtoReflect.Descriptor instead.
-func (*ShodanHost) Descriptor() ([]byte, []int) {
-	return file_proto_service_proto_rawDescGZIP(), []int{74}
+	return 0
 }
 
-func (x *ShodanHost) GetIp() string {
+func (x *SPFEvaluation) GetVoidLookups() int32 {
 	if x != nil {
-		return x.Ip
+		return x.VoidLookups
 	}
-	return ""
+	return 0
 }
 
-func (x *ShodanHost) GetPort() int32 {
+func (x *SPFEvaluation) GetLookupLimitExceeded() bool {
 	if x != nil {
-		return x.Port
+		return x.LookupLimitExceeded
 	}
-	return 0
+	return false
 }
 
-func (x *ShodanHost) GetHostnames() []string {
+func (x *SPFEvaluation) GetVoidLookupLimitExceeded() bool {
 	if x != nil {
-		return x.Hostnames
+		return x.VoidLookupLimitExceeded
 	}
-	return nil
+	return false
 }
 
-func (x *ShodanHost) GetOs() string {
+func (x *SPFEvaluation) GetPermissiveAll() bool {
 	if x != nil {
-		return x.Os
+		return x.PermissiveAll
 	}
-	return ""
+	return false
 }
 
-func (x *ShodanHost) GetBanner() string {
+func (x *SPFEvaluation) GetBroadRanges() []string {
 	if x != nil {
-		return x.Banner
+		return x.BroadRanges
 	}
-	return ""
+	return nil
 }
 
-func (x *ShodanHost) GetTags() []string {
+func (x *SPFEvaluation) GetAuthorizedIps() []string {
 	if x != nil {
-		return x.Tags
+		return x.AuthorizedIps
 	}
 	return nil
 }
 
-func (x *ShodanHost) GetLocation() *ShodanLocation {
+func (x *SPFEvaluation) GetIncludes() []string {
 	if x != nil {
-		return x.Location
+		return x.Includes
 	}
 	return nil
 }
 
-func (x *ShodanHost) GetSsl() *ShodanSSL {
+func (x *SPFEvaluation) GetErrors() []string {
 	if x != nil {
-		return x.Ssl
+		return x.Errors
 	}
 	return nil
 }
 
-func (x *ShodanHost) GetDomains() []string {
+// DKIMSelector is a key published at <selector>._domainkey.<domain>.
+type DKIMSelector struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Selector        string                 `protobuf:"bytes,1,opt,name=selector,proto3" json:"selector,omitempty"`
+	Record          string                 `protobuf:"bytes,2,opt,name=record,proto3" json:"record,omitempty"`
+	Valid           bool                   `protobuf:"varint,3,opt,name=valid,proto3" json:"valid,omitempty"`
+	ValidationError string                 `protobuf:"bytes,4,opt,name=validation_error,json=validationError,proto3" json:"validation_error,omitempty"`
+	KeyType         string                 `protobuf:"bytes,5,opt,name=key_type,json=keyType,proto3" json:"key_type,omitempty"` // rsa or ed25519
+	KeyBits         int32                  `protobuf:"varint,6,opt,name=key_bits,json=keyBits,proto3" json:"key_bits,omitempty"`
+	Source          string                 `protobuf:"bytes,7,opt,name=source,proto3" json:"source,omitempty"` // remembered, option or dictionary
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *DKIMSelector) Reset() {
+	*x = DKIMSelector{}
+	mi := &file_proto_service_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DKIMSelector) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DKIMSelector) ProtoMessage() {}
+
+func (x *DKIMSelector) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[74]
 	if x != nil {
-		return x.Domains
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *ShodanHost) GetAsn() string {
+// Deprecated: Use DKIMSelector.ProtoReflect.Descriptor instead.
+func (*DKIMSelector) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *DKIMSelector) GetSelector() string {
 	if x != nil {
-		return x.Asn
+		return x.Selector
 	}
 	return ""
 }
 
-func (x *ShodanHost) GetOrg() string {
+func (x *DKIMSelector) GetRecord() string {
 	if x != nil {
-		return x.Org
+		return x.Record
 	}
 	return ""
 }
 
-func (x *ShodanHost) GetIsp() string {
+func (x *DKIMSelector) GetValid() bool {
 	if x != nil {
-		return x.Isp
+		return x.Valid
 	}
-	return ""
+	return false
 }
 
-func (x *ShodanHost) GetTimestamp() *timestamppb.Timestamp {
+func (x *DKIMSelector) GetValidationError() string {
 	if x != nil {
-		return x.Timestamp
+		return x.ValidationError
 	}
-	return nil
+	return ""
+}
+
+func (x *DKIMSelector) GetKeyType() string {
+	if x != nil {
+		return x.KeyType
+	}
+	return ""
+}
+
+func (x *DKIMSelector) GetKeyBits() int32 {
+	if x != nil {
+		return x.KeyBits
+	}
+	return 0
+}
+
+func (x *DKIMSelector) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+type TLSSecurityResult struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	TlsVersion             string                 `protobuf:"bytes,1,opt,name=tls_version,json=tlsVersion,proto3" json:"tls_version,omitempty"`
+	CipherSuite            string                 `protobuf:"bytes,2,opt,name=cipher_suite,json=cipherSuite,proto3" json:"cipher_suite,omitempty"`
+	HstsHeader             bool                   `protobuf:"varint,3,opt,name=hsts_header,json=hstsHeader,proto3" json:"hsts_header,omitempty"`
+	CertificateValid       bool                   `protobuf:"varint,4,opt,name=certificate_valid,json=certificateValid,proto3" json:"certificate_valid,omitempty"`
+	CertIssuer             string                 `protobuf:"bytes,5,opt,name=cert_issuer,json=certIssuer,proto3" json:"cert_issuer,omitempty"`
+	CertSubject            string                 `protobuf:"bytes,6,opt,name=cert_subject,json=certSubject,proto3" json:"cert_subject,omitempty"`
+	CertNotBefore          *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=cert_not_before,json=certNotBefore,proto3" json:"cert_not_before,omitempty"`
+	CertNotAfter           *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=cert_not_after,json=certNotAfter,proto3" json:"cert_not_after,omitempty"`
+	CertDnsNames           []string               `protobuf:"bytes,9,rep,name=cert_dns_names,json=certDnsNames,proto3" json:"cert_dns_names,omitempty"`
+	CertKeyStrength        int32                  `protobuf:"varint,10,opt,name=cert_key_strength,json=certKeyStrength,proto3" json:"cert_key_strength,omitempty"`
+	CertSignatureAlgorithm string                 `protobuf:"bytes,11,opt,name=cert_signature_algorithm,json=certSignatureAlgorithm,proto3" json:"cert_signature_algorithm,omitempty"`
+	Errors                 []string               `protobuf:"bytes,12,rep,name=errors,proto3" json:"errors,omitempty"`
+	Endpoints              []*TLSEndpoint         `protobuf:"bytes,13,rep,name=endpoints,proto3" json:"endpoints,omitempty"` // one per resolved IP and MX host probed
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *TLSSecurityResult) Reset() {
+	*x = TLSSecurityResult{}
+	mi := &file_proto_service_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TLSSecurityResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TLSSecurityResult) ProtoMessage() {}
+
+func (x *TLSSecurityResult) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TLSSecurityResult.ProtoReflect.Descriptor instead.
+func (*TLSSecurityResult) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *TLSSecurityResult) GetTlsVersion() string {
+	if x != nil {
+		return x.TlsVersion
+	}
+	return ""
+}
+
+func (x *TLSSecurityResult) GetCipherSuite() string {
+	if x != nil {
+		return x.CipherSuite
+	}
+	return ""
+}
+
+func (x *TLSSecurityResult) GetHstsHeader() bool {
+	if x != nil {
+		return x.HstsHeader
+	}
+	return false
+}
+
+func (x *TLSSecurityResult) GetCertificateValid() bool {
+	if x != nil {
+		return x.CertificateValid
+	}
+	return false
+}
+
+func (x *TLSSecurityResult) GetCertIssuer() string {
+	if x != nil {
+		return x.CertIssuer
+	}
+	return ""
+}
+
+func (x *TLSSecurityResult) GetCertSubject() string {
+	if x != nil {
+		return x.CertSubject
+	}
+	return ""
+}
+
+func (x *TLSSecurityResult) GetCertNotBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CertNotBefore
+	}
+	return nil
+}
+
+func (x *TLSSecurityResult) GetCertNotAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CertNotAfter
+	}
+	return nil
+}
+
+func (x *TLSSecurityResult) GetCertDnsNames() []string {
+	if x != nil {
+		return x.CertDnsNames
+	}
+	return nil
+}
+
+func (x *TLSSecurityResult) GetCertKeyStrength() int32 {
+	if x != nil {
+		return x.CertKeyStrength
+	}
+	return 0
+}
+
+func (x *TLSSecurityResult) GetCertSignatureAlgorithm() string {
+	if x != nil {
+		return x.CertSignatureAlgorithm
+	}
+	return ""
+}
+
+func (x *TLSSecurityResult) GetErrors() []string {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+func (x *TLSSecurityResult) GetEndpoints() []*TLSEndpoint {
+	if x != nil {
+		return x.Endpoints
+	}
+	return nil
+}
+
+type TLSEndpoint struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Host             string                 `protobuf:"bytes,1,opt,name=host,proto3" json:"host,omitempty"` // IP address or MX hostname
+	Port             int32                  `protobuf:"varint,2,opt,name=port,proto3" json:"port,omitempty"`
+	TlsVersion       string                 `protobuf:"bytes,3,opt,name=tls_version,json=tlsVersion,proto3" json:"tls_version,omitempty"`
+	CipherSuite      string                 `protobuf:"bytes,4,opt,name=cipher_suite,json=cipherSuite,proto3" json:"cipher_suite,omitempty"`
+	CertificateValid bool                   `protobuf:"varint,5,opt,name=certificate_valid,json=certificateValid,proto3" json:"certificate_valid,omitempty"`
+	Error            string                 `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *TLSEndpoint) Reset() {
+	*x = TLSEndpoint{}
+	mi := &file_proto_service_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TLSEndpoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TLSEndpoint) ProtoMessage() {}
+
+func (x *TLSEndpoint) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TLSEndpoint.ProtoReflect.Descriptor instead.
+func (*TLSEndpoint) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *TLSEndpoint) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *TLSEndpoint) GetPort() int32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+func (x *TLSEndpoint) GetTlsVersion() string {
+	if x != nil {
+		return x.TlsVersion
+	}
+	return ""
+}
+
+func (x *TLSEndpoint) GetCipherSuite() string {
+	if x != nil {
+		return x.CipherSuite
+	}
+	return ""
+}
+
+func (x *TLSEndpoint) GetCertificateValid() bool {
+	if x != nil {
+		return x.CertificateValid
+	}
+	return false
+}
+
+func (x *TLSEndpoint) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type CrtShCertificate struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Id                 int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CommonName         string                 `protobuf:"bytes,2,opt,name=common_name,json=commonName,proto3" json:"common_name,omitempty"`
+	Issuer             string                 `protobuf:"bytes,3,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	NotBefore          *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=not_before,json=notBefore,proto3" json:"not_before,omitempty"`
+	NotAfter           *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=not_after,json=notAfter,proto3" json:"not_after,omitempty"`
+	SerialNumber       string                 `protobuf:"bytes,6,opt,name=serial_number,json=serialNumber,proto3" json:"serial_number,omitempty"`
+	DnsNames           []string               `protobuf:"bytes,7,rep,name=dns_names,json=dnsNames,proto3" json:"dns_names,omitempty"`
+	SignatureAlgorithm string                 `protobuf:"bytes,8,opt,name=signature_algorithm,json=signatureAlgorithm,proto3" json:"signature_algorithm,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *CrtShCertificate) Reset() {
+	*x = CrtShCertificate{}
+	mi := &file_proto_service_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CrtShCertificate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CrtShCertificate) ProtoMessage() {}
+
+func (x *CrtShCertificate) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CrtShCertificate.ProtoReflect.Descriptor instead.
+func (*CrtShCertificate) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *CrtShCertificate) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *CrtShCertificate) GetCommonName() string {
+	if x != nil {
+		return x.CommonName
+	}
+	return ""
+}
+
+func (x *CrtShCertificate) GetIssuer() string {
+	if x != nil {
+		return x.Issuer
+	}
+	return ""
+}
+
+func (x *CrtShCertificate) GetNotBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NotBefore
+	}
+	return nil
+}
+
+func (x *CrtShCertificate) GetNotAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NotAfter
+	}
+	return nil
+}
+
+func (x *CrtShCertificate) GetSerialNumber() string {
+	if x != nil {
+		return x.SerialNumber
+	}
+	return ""
+}
+
+func (x *CrtShCertificate) GetDnsNames() []string {
+	if x != nil {
+		return x.DnsNames
+	}
+	return nil
+}
+
+func (x *CrtShCertificate) GetSignatureAlgorithm() string {
+	if x != nil {
+		return x.SignatureAlgorithm
+	}
+	return ""
+}
+
+type CrtShSecurityResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Certificates  []*CrtShCertificate    `protobuf:"bytes,1,rep,name=certificates,proto3" json:"certificates,omitempty"`
+	Subdomains    []string               `protobuf:"bytes,2,rep,name=subdomains,proto3" json:"subdomains,omitempty"`
+	Errors        []string               `protobuf:"bytes,3,rep,name=errors,proto3" json:"errors,omitempty"`
+	Unavailable   []string               `protobuf:"bytes,4,rep,name=unavailable,proto3" json:"unavailable,omitempty"` // requests the provider could not serve even after retries; not scored
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CrtShSecurityResult) Reset() {
+	*x = CrtShSecurityResult{}
+	mi := &file_proto_service_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CrtShSecurityResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CrtShSecurityResult) ProtoMessage() {}
+
+func (x *CrtShSecurityResult) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CrtShSecurityResult.ProtoReflect.Descriptor instead.
+func (*CrtShSecurityResult) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *CrtShSecurityResult) GetCertificates() []*CrtShCertificate {
+	if x != nil {
+		return x.Certificates
+	}
+	return nil
+}
+
+func (x *CrtShSecurityResult) GetSubdomains() []string {
+	if x != nil {
+		return x.Subdomains
+	}
+	return nil
+}
+
+func (x *CrtShSecurityResult) GetErrors() []string {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+func (x *CrtShSecurityResult) GetUnavailable() []string {
+	if x != nil {
+		return x.Unavailable
+	}
+	return nil
+}
+
+type ChaosSecurityResult struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Subdomains      []string               `protobuf:"bytes,1,rep,name=subdomains,proto3" json:"subdomains,omitempty"`
+	Errors          []string               `protobuf:"bytes,2,rep,name=errors,proto3" json:"errors,omitempty"`
+	Cached          bool                   `protobuf:"varint,3,opt,name=cached,proto3" json:"cached,omitempty"`                                            // served from the intelligence cache
+	CacheAgeSeconds int64                  `protobuf:"varint,4,opt,name=cache_age_seconds,json=cacheAgeSeconds,proto3" json:"cache_age_seconds,omitempty"` // age of the cached data
+	Unavailable     []string               `protobuf:"bytes,5,rep,name=unavailable,proto3" json:"unavailable,omitempty"`                                   // requests the provider could not serve, such as once its quota is spent; not scored
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ChaosSecurityResult) Reset() {
+	*x = ChaosSecurityResult{}
+	mi := &file_proto_service_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChaosSecurityResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChaosSecurityResult) ProtoMessage() {}
+
+func (x *ChaosSecurityResult) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChaosSecurityResult.ProtoReflect.Descriptor instead.
+func (*ChaosSecurityResult) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *ChaosSecurityResult) GetSubdomains() []string {
+	if x != nil {
+		return x.Subdomains
+	}
+	return nil
+}
+
+func (x *ChaosSecurityResult) GetErrors() []string {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+func (x *ChaosSecurityResult) GetCached() bool {
+	if x != nil {
+		return x.Cached
+	}
+	return false
+}
+
+func (x *ChaosSecurityResult) GetCacheAgeSeconds() int64 {
+	if x != nil {
+		return x.CacheAgeSeconds
+	}
+	return 0
+}
+
+func (x *ChaosSecurityResult) GetUnavailable() []string {
+	if x != nil {
+		return x.Unavailable
+	}
+	return nil
+}
+
+type ShodanScanResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Domain        string                 `protobuf:"bytes,2,opt,name=domain,proto3" json:"domain,omitempty"`
+	DnsScanId     string                 `protobuf:"bytes,3,opt,name=dns_scan_id,json=dnsScanId,proto3" json:"dns_scan_id,omitempty"`
+	Result        *ShodanSecurityResult  `protobuf:"bytes,4,opt,name=result,proto3" json:"result,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShodanScanResult) Reset() {
+	*x = ShodanScanResult{}
+	mi := &file_proto_service_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShodanScanResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShodanScanResult) ProtoMessage() {}
+
+func (x *ShodanScanResult) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShodanScanResult.ProtoReflect.Descriptor instead.
+func (*ShodanScanResult) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *ShodanScanResult) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ShodanScanResult) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+func (x *ShodanScanResult) GetDnsScanId() string {
+	if x != nil {
+		return x.DnsScanId
+	}
+	return ""
+}
+
+func (x *ShodanScanResult) GetResult() *ShodanSecurityResult {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+func (x *ShodanScanResult) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type ShodanLocation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	City          string                 `protobuf:"bytes,1,opt,name=city,proto3" json:"city,omitempty"`
+	CountryName   string                 `protobuf:"bytes,2,opt,name=country_name,json=countryName,proto3" json:"country_name,omitempty"`
+	Latitude      float32                `protobuf:"fixed32,3,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude     float32                `protobuf:"fixed32,4,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShodanLocation) Reset() {
+	*x = ShodanLocation{}
+	mi := &file_proto_service_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShodanLocation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShodanLocation) ProtoMessage() {}
+
+func (x *ShodanLocation) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShodanLocation.ProtoReflect.Descriptor instead.
+func (*ShodanLocation) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *ShodanLocation) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+func (x *ShodanLocation) GetCountryName() string {
+	if x != nil {
+		return x.CountryName
+	}
+	return ""
+}
+
+func (x *ShodanLocation) GetLatitude() float32 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *ShodanLocation) GetLongitude() float32 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+type ShodanSSL struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Issuer        string                 `protobuf:"bytes,1,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	Subject       string                 `protobuf:"bytes,2,opt,name=subject,proto3" json:"subject,omitempty"`
+	Expires       *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=expires,proto3" json:"expires,omitempty"`
+	NotAfter      *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=not_after,json=notAfter,proto3" json:"not_after,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShodanSSL) Reset() {
+	*x = ShodanSSL{}
+	mi := &file_proto_service_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShodanSSL) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShodanSSL) ProtoMessage() {}
+
+func (x *ShodanSSL) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShodanSSL.ProtoReflect.Descriptor instead.
+func (*ShodanSSL) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *ShodanSSL) GetIssuer() string {
+	if x != nil {
+		return x.Issuer
+	}
+	return ""
+}
+
+func (x *ShodanSSL) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *ShodanSSL) GetExpires() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Expires
+	}
+	return nil
+}
+
+func (x *ShodanSSL) GetNotAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NotAfter
+	}
+	return nil
+}
+
+type ShodanMetadata struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Module        string                 `protobuf:"bytes,1,opt,name=module,proto3" json:"module,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShodanMetadata) Reset() {
+	*x = ShodanMetadata{}
+	mi := &file_proto_service_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShodanMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShodanMetadata) ProtoMessage() {}
+
+func (x *ShodanMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShodanMetadata.ProtoReflect.Descriptor instead.
+func (*ShodanMetadata) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *ShodanMetadata) GetModule() string {
+	if x != nil {
+		return x.Module
+	}
+	return ""
+}
+
+type ShodanHost struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ip            string                 `protobuf:"bytes,1,opt,name=ip,proto3" json:"ip,omitempty"`
+	Port          int32                  `protobuf:"varint,2,opt,name=port,proto3" json:"port,omitempty"`
+	Hostnames     []string               `protobuf:"bytes,3,rep,name=hostnames,proto3" json:"hostnames,omitempty"`
+	Os            string                 `protobuf:"bytes,4,opt,name=os,proto3" json:"os,omitempty"`
+	Banner        string                 `protobuf:"bytes,5,opt,name=banner,proto3" json:"banner,omitempty"`
+	Tags          []string               `protobuf:"bytes,6,rep,name=tags,proto3" json:"tags,omitempty"`
+	Location      *ShodanLocation        `protobuf:"bytes,7,opt,name=location,proto3" json:"location,omitempty"`
+	Ssl           *ShodanSSL             `protobuf:"bytes,8,opt,name=ssl,proto3" json:"ssl,omitempty"`
+	Domains       []string               `protobuf:"bytes,9,rep,name=domains,proto3" json:"domains,omitempty"`
+	Asn           string                 `protobuf:"bytes,10,opt,name=asn,proto3" json:"asn,omitempty"`
+	Org           string                 `protobuf:"bytes,11,opt,name=org,proto3" json:"org,omitempty"`
+	Isp           string                 `protobuf:"bytes,12,opt,name=isp,proto3" json:"isp,omitempty"`
+	Timestamp     *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	ShodanMeta    *ShodanMetadata        `protobuf:"bytes,14,opt,name=shodan_meta,json=shodanMeta,proto3" json:"shodan_meta,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShodanHost) Reset() {
+	*x = ShodanHost{}
+	mi := &file_proto_service_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShodanHost) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShodanHost) ProtoMessage() {}
+
+func (x *ShodanHost) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShodanHost.ProtoReflect.Descriptor instead.
+func (*ShodanHost) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *ShodanHost) GetIp() string {
+	if x != nil {
+		return x.Ip
+	}
+	return ""
+}
+
+func (x *ShodanHost) GetPort() int32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+func (x *ShodanHost) GetHostnames() []string {
+	if x != nil {
+		return x.Hostnames
+	}
+	return nil
+}
+
+func (x *ShodanHost) GetOs() string {
+	if x != nil {
+		return x.Os
+	}
+	return ""
+}
+
+func (x *ShodanHost) GetBanner() string {
+	if x != nil {
+		return x.Banner
+	}
+	return ""
+}
+
+func (x *ShodanHost) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *ShodanHost) GetLocation() *ShodanLocation {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *ShodanHost) GetSsl() *ShodanSSL {
+	if x != nil {
+		return x.Ssl
+	}
+	return nil
+}
+
+func (x *ShodanHost) GetDomains() []string {
+	if x != nil {
+		return x.Domains
+	}
+	return nil
+}
+
+func (x *ShodanHost) GetAsn() string {
+	if x != nil {
+		return x.Asn
+	}
+	return ""
+}
+
+func (x *ShodanHost) GetOrg() string {
+	if x != nil {
+		return x.Org
+	}
+	return ""
+}
+
+func (x *ShodanHost) GetIsp() string {
+	if x != nil {
+		return x.Isp
+	}
+	return ""
+}
+
+func (x *ShodanHost) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
 }
 
 func (x *ShodanHost) GetShodanMeta() *ShodanMetadata {
 	if x != nil {
-		return x.ShodanMeta
+		return x.ShodanMeta
+	}
+	return nil
+}
+
+type ShodanSecurityResult struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Hosts           []*ShodanHost          `protobuf:"bytes,1,rep,name=hosts,proto3" json:"hosts,omitempty"`
+	Errors          []string               `protobuf:"bytes,2,rep,name=errors,proto3" json:"errors,omitempty"`
+	Unavailable     []string               `protobuf:"bytes,3,rep,name=unavailable,proto3" json:"unavailable,omitempty"`                                   // requests the provider could not serve even after retries; not scored
+	Cached          bool                   `protobuf:"varint,4,opt,name=cached,proto3" json:"cached,omitempty"`                                            // some lookups were served from the intelligence cache
+	CacheAgeSeconds int64                  `protobuf:"varint,5,opt,name=cache_age_seconds,json=cacheAgeSeconds,proto3" json:"cache_age_seconds,omitempty"` // age of the oldest cached data used
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ShodanSecurityResult) Reset() {
+	*x = ShodanSecurityResult{}
+	mi := &file_proto_service_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShodanSecurityResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShodanSecurityResult) ProtoMessage() {}
+
+func (x *ShodanSecurityResult) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShodanSecurityResult.ProtoReflect.Descriptor instead.
+func (*ShodanSecurityResult) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *ShodanSecurityResult) GetHosts() []*ShodanHost {
+	if x != nil {
+		return x.Hosts
+	}
+	return nil
+}
+
+func (x *ShodanSecurityResult) GetErrors() []string {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+func (x *ShodanSecurityResult) GetUnavailable() []string {
+	if x != nil {
+		return x.Unavailable
+	}
+	return nil
+}
+
+func (x *ShodanSecurityResult) GetCached() bool {
+	if x != nil {
+		return x.Cached
+	}
+	return false
+}
+
+func (x *ShodanSecurityResult) GetCacheAgeSeconds() int64 {
+	if x != nil {
+		return x.CacheAgeSeconds
+	}
+	return 0
+}
+
+type ScanOTXRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Domain        string                 `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
+	DnsScanId     string                 `protobuf:"bytes,2,opt,name=dns_scan_id,json=dnsScanId,proto3" json:"dns_scan_id,omitempty"`
+	Refresh       bool                   `protobuf:"varint,3,opt,name=refresh,proto3" json:"refresh,omitempty"` // bypass cached intelligence lookups
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScanOTXRequest) Reset() {
+	*x = ScanOTXRequest{}
+	mi := &file_proto_service_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScanOTXRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScanOTXRequest) ProtoMessage() {}
+
+func (x *ScanOTXRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScanOTXRequest.ProtoReflect.Descriptor instead.
+func (*ScanOTXRequest) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *ScanOTXRequest) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+func (x *ScanOTXRequest) GetDnsScanId() string {
+	if x != nil {
+		return x.DnsScanId
+	}
+	return ""
+}
+
+func (x *ScanOTXRequest) GetRefresh() bool {
+	if x != nil {
+		return x.Refresh
+	}
+	return false
+}
+
+type ScanOTXResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ScanId        string                 `protobuf:"bytes,1,opt,name=scan_id,json=scanId,proto3" json:"scan_id,omitempty"`
+	Result        *OTXSecurityResult     `protobuf:"bytes,2,opt,name=result,proto3" json:"result,omitempty"`
+	ScanRunId     string                 `protobuf:"bytes,3,opt,name=scan_run_id,json=scanRunId,proto3" json:"scan_run_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScanOTXResponse) Reset() {
+	*x = ScanOTXResponse{}
+	mi := &file_proto_service_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScanOTXResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScanOTXResponse) ProtoMessage() {}
+
+func (x *ScanOTXResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScanOTXResponse.ProtoReflect.Descriptor instead.
+func (*ScanOTXResponse) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *ScanOTXResponse) GetScanId() string {
+	if x != nil {
+		return x.ScanId
+	}
+	return ""
+}
+
+func (x *ScanOTXResponse) GetResult() *OTXSecurityResult {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+func (x *ScanOTXResponse) GetScanRunId() string {
+	if x != nil {
+		return x.ScanRunId
+	}
+	return ""
+}
+
+type GetOTXScanResultsByDomainRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Domain        string                 `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOTXScanResultsByDomainRequest) Reset() {
+	*x = GetOTXScanResultsByDomainRequest{}
+	mi := &file_proto_service_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOTXScanResultsByDomainRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOTXScanResultsByDomainRequest) ProtoMessage() {}
+
+func (x *GetOTXScanResultsByDomainRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOTXScanResultsByDomainRequest.ProtoReflect.Descriptor instead.
+func (*GetOTXScanResultsByDomainRequest) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *GetOTXScanResultsByDomainRequest) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+type GetOTXScanResultsByDomainResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Results       []*OTXScanResult       `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOTXScanResultsByDomainResponse) Reset() {
+	*x = GetOTXScanResultsByDomainResponse{}
+	mi := &file_proto_service_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOTXScanResultsByDomainResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOTXScanResultsByDomainResponse) ProtoMessage() {}
+
+func (x *GetOTXScanResultsByDomainResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOTXScanResultsByDomainResponse.ProtoReflect.Descriptor instead.
+func (*GetOTXScanResultsByDomainResponse) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *GetOTXScanResultsByDomainResponse) GetResults() []*OTXScanResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type OTXScanResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Domain        string                 `protobuf:"bytes,2,opt,name=domain,proto3" json:"domain,omitempty"`
+	DnsScanId     string                 `protobuf:"bytes,3,opt,name=dns_scan_id,json=dnsScanId,proto3" json:"dns_scan_id,omitempty"`
+	Result        *OTXSecurityResult     `protobuf:"bytes,4,opt,name=result,proto3" json:"result,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OTXScanResult) Reset() {
+	*x = OTXScanResult{}
+	mi := &file_proto_service_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OTXScanResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OTXScanResult) ProtoMessage() {}
+
+func (x *OTXScanResult) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OTXScanResult.ProtoReflect.Descriptor instead.
+func (*OTXScanResult) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *OTXScanResult) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *OTXScanResult) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+func (x *OTXScanResult) GetDnsScanId() string {
+	if x != nil {
+		return x.DnsScanId
+	}
+	return ""
+}
+
+func (x *OTXScanResult) GetResult() *OTXSecurityResult {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+func (x *OTXScanResult) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type OTXGeneralInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PulseCount    int32                  `protobuf:"varint,1,opt,name=pulse_count,json=pulseCount,proto3" json:"pulse_count,omitempty"`
+	Pulses        []string               `protobuf:"bytes,2,rep,name=pulses,proto3" json:"pulses,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OTXGeneralInfo) Reset() {
+	*x = OTXGeneralInfo{}
+	mi := &file_proto_service_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OTXGeneralInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OTXGeneralInfo) ProtoMessage() {}
+
+func (x *OTXGeneralInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OTXGeneralInfo.ProtoReflect.Descriptor instead.
+func (*OTXGeneralInfo) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *OTXGeneralInfo) GetPulseCount() int32 {
+	if x != nil {
+		return x.PulseCount
+	}
+	return 0
+}
+
+func (x *OTXGeneralInfo) GetPulses() []string {
+	if x != nil {
+		return x.Pulses
+	}
+	return nil
+}
+
+type OTXMalware struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Hash          string                 `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	Datetime      *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=datetime,proto3" json:"datetime,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OTXMalware) Reset() {
+	*x = OTXMalware{}
+	mi := &file_proto_service_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OTXMalware) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OTXMalware) ProtoMessage() {}
+
+func (x *OTXMalware) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OTXMalware.ProtoReflect.Descriptor instead.
+func (*OTXMalware) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *OTXMalware) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+func (x *OTXMalware) GetDatetime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Datetime
+	}
+	return nil
+}
+
+type OTXURL struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Datetime      *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=datetime,proto3" json:"datetime,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OTXURL) Reset() {
+	*x = OTXURL{}
+	mi := &file_proto_service_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OTXURL) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OTXURL) ProtoMessage() {}
+
+func (x *OTXURL) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OTXURL.ProtoReflect.Descriptor instead.
+func (*OTXURL) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *OTXURL) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *OTXURL) GetDatetime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Datetime
+	}
+	return nil
+}
+
+type OTXPassiveDNS struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Address       string                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Hostname      string                 `protobuf:"bytes,2,opt,name=hostname,proto3" json:"hostname,omitempty"`
+	Record        string                 `protobuf:"bytes,3,opt,name=record,proto3" json:"record,omitempty"`
+	Datetime      *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=datetime,proto3" json:"datetime,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OTXPassiveDNS) Reset() {
+	*x = OTXPassiveDNS{}
+	mi := &file_proto_service_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OTXPassiveDNS) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OTXPassiveDNS) ProtoMessage() {}
+
+func (x *OTXPassiveDNS) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OTXPassiveDNS.ProtoReflect.Descriptor instead.
+func (*OTXPassiveDNS) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *OTXPassiveDNS) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *OTXPassiveDNS) GetHostname() string {
+	if x != nil {
+		return x.Hostname
+	}
+	return ""
+}
+
+func (x *OTXPassiveDNS) GetRecord() string {
+	if x != nil {
+		return x.Record
+	}
+	return ""
+}
+
+func (x *OTXPassiveDNS) GetDatetime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Datetime
+	}
+	return nil
+}
+
+type OTXSecurityResult struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	GeneralInfo     *OTXGeneralInfo        `protobuf:"bytes,1,opt,name=general_info,json=generalInfo,proto3" json:"general_info,omitempty"`
+	Malware         []*OTXMalware          `protobuf:"bytes,2,rep,name=malware,proto3" json:"malware,omitempty"`
+	Urls            []*OTXURL              `protobuf:"bytes,3,rep,name=urls,proto3" json:"urls,omitempty"`
+	PassiveDns      []*OTXPassiveDNS       `protobuf:"bytes,4,rep,name=passive_dns,json=passiveDns,proto3" json:"passive_dns,omitempty"`
+	Errors          []string               `protobuf:"bytes,5,rep,name=errors,proto3" json:"errors,omitempty"`
+	IpReputation    []*OTXIPReputation     `protobuf:"bytes,6,rep,name=ip_reputation,json=ipReputation,proto3" json:"ip_reputation,omitempty"`             // one per resolved IP
+	Unavailable     []string               `protobuf:"bytes,7,rep,name=unavailable,proto3" json:"unavailable,omitempty"`                                   // requests the provider could not serve even after retries; not scored
+	Cached          bool                   `protobuf:"varint,8,opt,name=cached,proto3" json:"cached,omitempty"`                                            // some lookups were served from the intelligence cache
+	CacheAgeSeconds int64                  `protobuf:"varint,9,opt,name=cache_age_seconds,json=cacheAgeSeconds,proto3" json:"cache_age_seconds,omitempty"` // age of the oldest cached data used
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *OTXSecurityResult) Reset() {
+	*x = OTXSecurityResult{}
+	mi := &file_proto_service_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OTXSecurityResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OTXSecurityResult) ProtoMessage() {}
+
+func (x *OTXSecurityResult) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OTXSecurityResult.ProtoReflect.Descriptor instead.
+func (*OTXSecurityResult) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *OTXSecurityResult) GetGeneralInfo() *OTXGeneralInfo {
+	if x != nil {
+		return x.GeneralInfo
+	}
+	return nil
+}
+
+func (x *OTXSecurityResult) GetMalware() []*OTXMalware {
+	if x != nil {
+		return x.Malware
+	}
+	return nil
+}
+
+func (x *OTXSecurityResult) GetUrls() []*OTXURL {
+	if x != nil {
+		return x.Urls
+	}
+	return nil
+}
+
+func (x *OTXSecurityResult) GetPassiveDns() []*OTXPassiveDNS {
+	if x != nil {
+		return x.PassiveDns
+	}
+	return nil
+}
+
+func (x *OTXSecurityResult) GetErrors() []string {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+func (x *OTXSecurityResult) GetIpReputation() []*OTXIPReputation {
+	if x != nil {
+		return x.IpReputation
+	}
+	return nil
+}
+
+func (x *OTXSecurityResult) GetUnavailable() []string {
+	if x != nil {
+		return x.Unavailable
+	}
+	return nil
+}
+
+func (x *OTXSecurityResult) GetCached() bool {
+	if x != nil {
+		return x.Cached
+	}
+	return false
+}
+
+func (x *OTXSecurityResult) GetCacheAgeSeconds() int64 {
+	if x != nil {
+		return x.CacheAgeSeconds
+	}
+	return 0
+}
+
+type OTXIPReputation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ip            string                 `protobuf:"bytes,1,opt,name=ip,proto3" json:"ip,omitempty"`
+	PulseCount    int32                  `protobuf:"varint,2,opt,name=pulse_count,json=pulseCount,proto3" json:"pulse_count,omitempty"`
+	Reputation    int32                  `protobuf:"varint,3,opt,name=reputation,proto3" json:"reputation,omitempty"`
+	CountryName   string                 `protobuf:"bytes,4,opt,name=country_name,json=countryName,proto3" json:"country_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OTXIPReputation) Reset() {
+	*x = OTXIPReputation{}
+	mi := &file_proto_service_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OTXIPReputation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OTXIPReputation) ProtoMessage() {}
+
+func (x *OTXIPReputation) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[96]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OTXIPReputation.ProtoReflect.Descriptor instead.
+func (*OTXIPReputation) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *OTXIPReputation) GetIp() string {
+	if x != nil {
+		return x.Ip
+	}
+	return ""
+}
+
+func (x *OTXIPReputation) GetPulseCount() int32 {
+	if x != nil {
+		return x.PulseCount
+	}
+	return 0
+}
+
+func (x *OTXIPReputation) GetReputation() int32 {
+	if x != nil {
+		return x.Reputation
+	}
+	return 0
+}
+
+func (x *OTXIPReputation) GetCountryName() string {
+	if x != nil {
+		return x.CountryName
+	}
+	return ""
+}
+
+type ScanWhoisRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Domain        string                 `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
+	DnsScanId     string                 `protobuf:"bytes,2,opt,name=dns_scan_id,json=dnsScanId,proto3" json:"dns_scan_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScanWhoisRequest) Reset() {
+	*x = ScanWhoisRequest{}
+	mi := &file_proto_service_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScanWhoisRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScanWhoisRequest) ProtoMessage() {}
+
+func (x *ScanWhoisRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[97]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScanWhoisRequest.ProtoReflect.Descriptor instead.
+func (*ScanWhoisRequest) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *ScanWhoisRequest) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+func (x *ScanWhoisRequest) GetDnsScanId() string {
+	if x != nil {
+		return x.DnsScanId
+	}
+	return ""
+}
+
+type ScanWhoisResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ScanId        string                 `protobuf:"bytes,1,opt,name=scan_id,json=scanId,proto3" json:"scan_id,omitempty"`
+	Result        *WhoisSecurityResult   `protobuf:"bytes,2,opt,name=result,proto3" json:"result,omitempty"`
+	ScanRunId     string                 `protobuf:"bytes,3,opt,name=scan_run_id,json=scanRunId,proto3" json:"scan_run_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScanWhoisResponse) Reset() {
+	*x = ScanWhoisResponse{}
+	mi := &file_proto_service_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScanWhoisResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScanWhoisResponse) ProtoMessage() {}
+
+func (x *ScanWhoisResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[98]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScanWhoisResponse.ProtoReflect.Descriptor instead.
+func (*ScanWhoisResponse) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *ScanWhoisResponse) GetScanId() string {
+	if x != nil {
+		return x.ScanId
+	}
+	return ""
+}
+
+func (x *ScanWhoisResponse) GetResult() *WhoisSecurityResult {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+func (x *ScanWhoisResponse) GetScanRunId() string {
+	if x != nil {
+		return x.ScanRunId
+	}
+	return ""
+}
+
+type GetWhoisScanResultsByDomainRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Domain        string                 `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWhoisScanResultsByDomainRequest) Reset() {
+	*x = GetWhoisScanResultsByDomainRequest{}
+	mi := &file_proto_service_proto_msgTypes[99]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWhoisScanResultsByDomainRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWhoisScanResultsByDomainRequest) ProtoMessage() {}
+
+func (x *GetWhoisScanResultsByDomainRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[99]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWhoisScanResultsByDomainRequest.ProtoReflect.Descriptor instead.
+func (*GetWhoisScanResultsByDomainRequest) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *GetWhoisScanResultsByDomainRequest) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+type GetWhoisScanResultsByDomainResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Results       []*WhoisScanResult     `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWhoisScanResultsByDomainResponse) Reset() {
+	*x = GetWhoisScanResultsByDomainResponse{}
+	mi := &file_proto_service_proto_msgTypes[100]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWhoisScanResultsByDomainResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWhoisScanResultsByDomainResponse) ProtoMessage() {}
+
+func (x *GetWhoisScanResultsByDomainResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[100]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWhoisScanResultsByDomainResponse.ProtoReflect.Descriptor instead.
+func (*GetWhoisScanResultsByDomainResponse) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *GetWhoisScanResultsByDomainResponse) GetResults() []*WhoisScanResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type WhoisScanResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Domain        string                 `protobuf:"bytes,2,opt,name=domain,proto3" json:"domain,omitempty"`
+	DnsScanId     string                 `protobuf:"bytes,3,opt,name=dns_scan_id,json=dnsScanId,proto3" json:"dns_scan_id,omitempty"`
+	Result        *WhoisSecurityResult   `protobuf:"bytes,4,opt,name=result,proto3" json:"result,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WhoisScanResult) Reset() {
+	*x = WhoisScanResult{}
+	mi := &file_proto_service_proto_msgTypes[101]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WhoisScanResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WhoisScanResult) ProtoMessage() {}
+
+func (x *WhoisScanResult) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[101]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WhoisScanResult.ProtoReflect.Descriptor instead.
+func (*WhoisScanResult) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *WhoisScanResult) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *WhoisScanResult) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+func (x *WhoisScanResult) GetDnsScanId() string {
+	if x != nil {
+		return x.DnsScanId
+	}
+	return ""
+}
+
+func (x *WhoisScanResult) GetResult() *WhoisSecurityResult {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+func (x *WhoisScanResult) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type WhoisSecurityResult struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Domain         string                 `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
+	Registrar      string                 `protobuf:"bytes,2,opt,name=registrar,proto3" json:"registrar,omitempty"`
+	CreationDate   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=creation_date,json=creationDate,proto3" json:"creation_date,omitempty"`
+	ExpiryDate     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=expiry_date,json=expiryDate,proto3" json:"expiry_date,omitempty"`
+	RegistrantName string                 `protobuf:"bytes,5,opt,name=registrant_name,json=registrantName,proto3" json:"registrant_name,omitempty"`
+	Errors         []string               `protobuf:"bytes,6,rep,name=errors,proto3" json:"errors,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *WhoisSecurityResult) Reset() {
+	*x = WhoisSecurityResult{}
+	mi := &file_proto_service_proto_msgTypes[102]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WhoisSecurityResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WhoisSecurityResult) ProtoMessage() {}
+
+func (x *WhoisSecurityResult) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[102]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WhoisSecurityResult.ProtoReflect.Descriptor instead.
+func (*WhoisSecurityResult) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *WhoisSecurityResult) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+func (x *WhoisSecurityResult) GetRegistrar() string {
+	if x != nil {
+		return x.Registrar
+	}
+	return ""
+}
+
+func (x *WhoisSecurityResult) GetCreationDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreationDate
+	}
+	return nil
+}
+
+func (x *WhoisSecurityResult) GetExpiryDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiryDate
+	}
+	return nil
+}
+
+func (x *WhoisSecurityResult) GetRegistrantName() string {
+	if x != nil {
+		return x.RegistrantName
+	}
+	return ""
+}
+
+func (x *WhoisSecurityResult) GetErrors() []string {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+type AbuseChIOC struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IocType       string                 `protobuf:"bytes,1,opt,name=ioc_type,json=iocType,proto3" json:"ioc_type,omitempty"`
+	IocValue      string                 `protobuf:"bytes,2,opt,name=ioc_value,json=iocValue,proto3" json:"ioc_value,omitempty"`
+	ThreatType    string                 `protobuf:"bytes,3,opt,name=threat_type,json=threatType,proto3" json:"threat_type,omitempty"`
+	Confidence    float32                `protobuf:"fixed32,4,opt,name=confidence,proto3" json:"confidence,omitempty"`
+	FirstSeen     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=first_seen,json=firstSeen,proto3" json:"first_seen,omitempty"`
+	LastSeen      *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=last_seen,json=lastSeen,proto3" json:"last_seen,omitempty"`
+	MalwareAlias  []string               `protobuf:"bytes,7,rep,name=malware_alias,json=malwareAlias,proto3" json:"malware_alias,omitempty"`
+	Tags          []string               `protobuf:"bytes,8,rep,name=tags,proto3" json:"tags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AbuseChIOC) Reset() {
+	*x = AbuseChIOC{}
+	mi := &file_proto_service_proto_msgTypes[103]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AbuseChIOC) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AbuseChIOC) ProtoMessage() {}
+
+func (x *AbuseChIOC) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[103]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AbuseChIOC.ProtoReflect.Descriptor instead.
+func (*AbuseChIOC) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *AbuseChIOC) GetIocType() string {
+	if x != nil {
+		return x.IocType
+	}
+	return ""
+}
+
+func (x *AbuseChIOC) GetIocValue() string {
+	if x != nil {
+		return x.IocValue
+	}
+	return ""
+}
+
+func (x *AbuseChIOC) GetThreatType() string {
+	if x != nil {
+		return x.ThreatType
+	}
+	return ""
+}
+
+func (x *AbuseChIOC) GetConfidence() float32 {
+	if x != nil {
+		return x.Confidence
+	}
+	return 0
+}
+
+func (x *AbuseChIOC) GetFirstSeen() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FirstSeen
+	}
+	return nil
+}
+
+func (x *AbuseChIOC) GetLastSeen() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastSeen
+	}
+	return nil
+}
+
+func (x *AbuseChIOC) GetMalwareAlias() []string {
+	if x != nil {
+		return x.MalwareAlias
+	}
+	return nil
+}
+
+func (x *AbuseChIOC) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type AbuseChSecurityResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Iocs          []*AbuseChIOC          `protobuf:"bytes,1,rep,name=iocs,proto3" json:"iocs,omitempty"`
+	Errors        []string               `protobuf:"bytes,2,rep,name=errors,proto3" json:"errors,omitempty"`
+	Unavailable   []string               `protobuf:"bytes,3,rep,name=unavailable,proto3" json:"unavailable,omitempty"` // requests the provider could not serve even after retries; not scored
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AbuseChSecurityResult) Reset() {
+	*x = AbuseChSecurityResult{}
+	mi := &file_proto_service_proto_msgTypes[104]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AbuseChSecurityResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AbuseChSecurityResult) ProtoMessage() {}
+
+func (x *AbuseChSecurityResult) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[104]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AbuseChSecurityResult.ProtoReflect.Descriptor instead.
+func (*AbuseChSecurityResult) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *AbuseChSecurityResult) GetIocs() []*AbuseChIOC {
+	if x != nil {
+		return x.Iocs
+	}
+	return nil
+}
+
+func (x *AbuseChSecurityResult) GetErrors() []string {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+func (x *AbuseChSecurityResult) GetUnavailable() []string {
+	if x != nil {
+		return x.Unavailable
+	}
+	return nil
+}
+
+type ScanAbuseChRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Domain        string                 `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
+	DnsScanId     string                 `protobuf:"bytes,2,opt,name=dns_scan_id,json=dnsScanId,proto3" json:"dns_scan_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScanAbuseChRequest) Reset() {
+	*x = ScanAbuseChRequest{}
+	mi := &file_proto_service_proto_msgTypes[105]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScanAbuseChRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScanAbuseChRequest) ProtoMessage() {}
+
+func (x *ScanAbuseChRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[105]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScanAbuseChRequest.ProtoReflect.Descriptor instead.
+func (*ScanAbuseChRequest) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *ScanAbuseChRequest) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+func (x *ScanAbuseChRequest) GetDnsScanId() string {
+	if x != nil {
+		return x.DnsScanId
+	}
+	return ""
+}
+
+type ScanAbuseChResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ScanId        string                 `protobuf:"bytes,1,opt,name=scan_id,json=scanId,proto3" json:"scan_id,omitempty"`
+	Result        *AbuseChSecurityResult `protobuf:"bytes,2,opt,name=result,proto3" json:"result,omitempty"`
+	ScanRunId     string                 `protobuf:"bytes,3,opt,name=scan_run_id,json=scanRunId,proto3" json:"scan_run_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScanAbuseChResponse) Reset() {
+	*x = ScanAbuseChResponse{}
+	mi := &file_proto_service_proto_msgTypes[106]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScanAbuseChResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScanAbuseChResponse) ProtoMessage() {}
+
+func (x *ScanAbuseChResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[106]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScanAbuseChResponse.ProtoReflect.Descriptor instead.
+func (*ScanAbuseChResponse) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *ScanAbuseChResponse) GetScanId() string {
+	if x != nil {
+		return x.ScanId
+	}
+	return ""
+}
+
+func (x *ScanAbuseChResponse) GetResult() *AbuseChSecurityResult {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+func (x *ScanAbuseChResponse) GetScanRunId() string {
+	if x != nil {
+		return x.ScanRunId
+	}
+	return ""
+}
+
+type GetAbuseChScanResultsByDomainRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Domain        string                 `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAbuseChScanResultsByDomainRequest) Reset() {
+	*x = GetAbuseChScanResultsByDomainRequest{}
+	mi := &file_proto_service_proto_msgTypes[107]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAbuseChScanResultsByDomainRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAbuseChScanResultsByDomainRequest) ProtoMessage() {}
+
+func (x *GetAbuseChScanResultsByDomainRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[107]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAbuseChScanResultsByDomainRequest.ProtoReflect.Descriptor instead.
+func (*GetAbuseChScanResultsByDomainRequest) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *GetAbuseChScanResultsByDomainRequest) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+type GetAbuseChScanResultsByDomainResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Results       []*AbuseChScanResult   `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAbuseChScanResultsByDomainResponse) Reset() {
+	*x = GetAbuseChScanResultsByDomainResponse{}
+	mi := &file_proto_service_proto_msgTypes[108]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAbuseChScanResultsByDomainResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAbuseChScanResultsByDomainResponse) ProtoMessage() {}
+
+func (x *GetAbuseChScanResultsByDomainResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[108]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAbuseChScanResultsByDomainResponse.ProtoReflect.Descriptor instead.
+func (*GetAbuseChScanResultsByDomainResponse) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *GetAbuseChScanResultsByDomainResponse) GetResults() []*AbuseChScanResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type AbuseChScanResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Domain        string                 `protobuf:"bytes,2,opt,name=domain,proto3" json:"domain,omitempty"`
+	DnsScanId     string                 `protobuf:"bytes,3,opt,name=dns_scan_id,json=dnsScanId,proto3" json:"dns_scan_id,omitempty"`
+	Result        *AbuseChSecurityResult `protobuf:"bytes,4,opt,name=result,proto3" json:"result,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AbuseChScanResult) Reset() {
+	*x = AbuseChScanResult{}
+	mi := &file_proto_service_proto_msgTypes[109]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AbuseChScanResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AbuseChScanResult) ProtoMessage() {}
+
+func (x *AbuseChScanResult) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[109]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AbuseChScanResult.ProtoReflect.Descriptor instead.
+func (*AbuseChScanResult) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *AbuseChScanResult) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AbuseChScanResult) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+func (x *AbuseChScanResult) GetDnsScanId() string {
+	if x != nil {
+		return x.DnsScanId
+	}
+	return ""
+}
+
+func (x *AbuseChScanResult) GetResult() *AbuseChSecurityResult {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+func (x *AbuseChScanResult) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type ScanISCRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Domain        string                 `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
+	DnsScanId     string                 `protobuf:"bytes,2,opt,name=dns_scan_id,json=dnsScanId,proto3" json:"dns_scan_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScanISCRequest) Reset() {
+	*x = ScanISCRequest{}
+	mi := &file_proto_service_proto_msgTypes[110]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScanISCRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScanISCRequest) ProtoMessage() {}
+
+func (x *ScanISCRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[110]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScanISCRequest.ProtoReflect.Descriptor instead.
+func (*ScanISCRequest) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *ScanISCRequest) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+func (x *ScanISCRequest) GetDnsScanId() string {
+	if x != nil {
+		return x.DnsScanId
+	}
+	return ""
+}
+
+type ScanISCResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ScanId        string                 `protobuf:"bytes,1,opt,name=scan_id,json=scanId,proto3" json:"scan_id,omitempty"`
+	Result        *ISCSecurityResult     `protobuf:"bytes,2,opt,name=result,proto3" json:"result,omitempty"`
+	ScanRunId     string                 `protobuf:"bytes,3,opt,name=scan_run_id,json=scanRunId,proto3" json:"scan_run_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScanISCResponse) Reset() {
+	*x = ScanISCResponse{}
+	mi := &file_proto_service_proto_msgTypes[111]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScanISCResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScanISCResponse) ProtoMessage() {}
+
+func (x *ScanISCResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[111]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScanISCResponse.ProtoReflect.Descriptor instead.
+func (*ScanISCResponse) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *ScanISCResponse) GetScanId() string {
+	if x != nil {
+		return x.ScanId
+	}
+	return ""
+}
+
+func (x *ScanISCResponse) GetResult() *ISCSecurityResult {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+func (x *ScanISCResponse) GetScanRunId() string {
+	if x != nil {
+		return x.ScanRunId
+	}
+	return ""
+}
+
+type GetISCScanResultsByDomainRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Domain        string                 `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetISCScanResultsByDomainRequest) Reset() {
+	*x = GetISCScanResultsByDomainRequest{}
+	mi := &file_proto_service_proto_msgTypes[112]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetISCScanResultsByDomainRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetISCScanResultsByDomainRequest) ProtoMessage() {}
+
+func (x *GetISCScanResultsByDomainRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[112]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetISCScanResultsByDomainRequest.ProtoReflect.Descriptor instead.
+func (*GetISCScanResultsByDomainRequest) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *GetISCScanResultsByDomainRequest) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+type GetISCScanResultsByDomainResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Results       []*ISCScanResult       `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetISCScanResultsByDomainResponse) Reset() {
+	*x = GetISCScanResultsByDomainResponse{}
+	mi := &file_proto_service_proto_msgTypes[113]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetISCScanResultsByDomainResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetISCScanResultsByDomainResponse) ProtoMessage() {}
+
+func (x *GetISCScanResultsByDomainResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[113]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetISCScanResultsByDomainResponse.ProtoReflect.Descriptor instead.
+func (*GetISCScanResultsByDomainResponse) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *GetISCScanResultsByDomainResponse) GetResults() []*ISCScanResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type ISCScanResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Domain        string                 `protobuf:"bytes,2,opt,name=domain,proto3" json:"domain,omitempty"`
+	DnsScanId     string                 `protobuf:"bytes,3,opt,name=dns_scan_id,json=dnsScanId,proto3" json:"dns_scan_id,omitempty"`
+	Result        *ISCSecurityResult     `protobuf:"bytes,4,opt,name=result,proto3" json:"result,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ISCScanResult) Reset() {
+	*x = ISCScanResult{}
+	mi := &file_proto_service_proto_msgTypes[114]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ISCScanResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ISCScanResult) ProtoMessage() {}
+
+func (x *ISCScanResult) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[114]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ISCScanResult.ProtoReflect.Descriptor instead.
+func (*ISCScanResult) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *ISCScanResult) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ISCScanResult) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+func (x *ISCScanResult) GetDnsScanId() string {
+	if x != nil {
+		return x.DnsScanId
+	}
+	return ""
+}
+
+func (x *ISCScanResult) GetResult() *ISCSecurityResult {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+func (x *ISCScanResult) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type ISCIncident struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Date          *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Severity      string                 `protobuf:"bytes,4,opt,name=severity,proto3" json:"severity,omitempty"` // e.g., "Low", "Medium", "High"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ISCIncident) Reset() {
+	*x = ISCIncident{}
+	mi := &file_proto_service_proto_msgTypes[115]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ISCIncident) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ISCIncident) ProtoMessage() {}
+
+func (x *ISCIncident) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[115]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ISCIncident.ProtoReflect.Descriptor instead.
+func (*ISCIncident) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{115}
+}
+
+func (x *ISCIncident) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ISCIncident) GetDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Date
+	}
+	return nil
+}
+
+func (x *ISCIncident) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *ISCIncident) GetSeverity() string {
+	if x != nil {
+		return x.Severity
+	}
+	return ""
+}
+
+type ISCSecurityResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Incidents     []*ISCIncident         `protobuf:"bytes,1,rep,name=incidents,proto3" json:"incidents,omitempty"`
+	OverallRisk   string                 `protobuf:"bytes,2,opt,name=overall_risk,json=overallRisk,proto3" json:"overall_risk,omitempty"`
+	Errors        []string               `protobuf:"bytes,3,rep,name=errors,proto3" json:"errors,omitempty"`
+	IpReputation  []*ISCIPReputation     `protobuf:"bytes,4,rep,name=ip_reputation,json=ipReputation,proto3" json:"ip_reputation,omitempty"` // one per resolved IP
+	Unavailable   []string               `protobuf:"bytes,5,rep,name=unavailable,proto3" json:"unavailable,omitempty"`                       // requests the provider could not serve even after retries; not scored
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ISCSecurityResult) Reset() {
+	*x = ISCSecurityResult{}
+	mi := &file_proto_service_proto_msgTypes[116]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ISCSecurityResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ISCSecurityResult) ProtoMessage() {}
+
+func (x *ISCSecurityResult) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[116]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ISCSecurityResult.ProtoReflect.Descriptor instead.
+func (*ISCSecurityResult) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{116}
+}
+
+func (x *ISCSecurityResult) GetIncidents() []*ISCIncident {
+	if x != nil {
+		return x.Incidents
+	}
+	return nil
+}
+
+func (x *ISCSecurityResult) GetOverallRisk() string {
+	if x != nil {
+		return x.OverallRisk
+	}
+	return ""
+}
+
+func (x *ISCSecurityResult) GetErrors() []string {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+func (x *ISCSecurityResult) GetIpReputation() []*ISCIPReputation {
+	if x != nil {
+		return x.IpReputation
+	}
+	return nil
+}
+
+func (x *ISCSecurityResult) GetUnavailable() []string {
+	if x != nil {
+		return x.Unavailable
+	}
+	return nil
+}
+
+type ISCIPReputation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ip            string                 `protobuf:"bytes,1,opt,name=ip,proto3" json:"ip,omitempty"`
+	Count         int32                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`     // reports naming the IP as a source
+	Attacks       int32                  `protobuf:"varint,3,opt,name=attacks,proto3" json:"attacks,omitempty"` // distinct targets attacked
+	AsName        string                 `protobuf:"bytes,4,opt,name=as_name,json=asName,proto3" json:"as_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ISCIPReputation) Reset() {
+	*x = ISCIPReputation{}
+	mi := &file_proto_service_proto_msgTypes[117]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ISCIPReputation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ISCIPReputation) ProtoMessage() {}
+
+func (x *ISCIPReputation) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[117]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ISCIPReputation.ProtoReflect.Descriptor instead.
+func (*ISCIPReputation) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{117}
+}
+
+func (x *ISCIPReputation) GetIp() string {
+	if x != nil {
+		return x.Ip
+	}
+	return ""
+}
+
+func (x *ISCIPReputation) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *ISCIPReputation) GetAttacks() int32 {
+	if x != nil {
+		return x.Attacks
+	}
+	return 0
+}
+
+func (x *ISCIPReputation) GetAsName() string {
+	if x != nil {
+		return x.AsName
+	}
+	return ""
+}
+
+// Plugin registry messages
+type ListPluginsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPluginsRequest) Reset() {
+	*x = ListPluginsRequest{}
+	mi := &file_proto_service_proto_msgTypes[118]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPluginsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPluginsRequest) ProtoMessage() {}
+
+func (x *ListPluginsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[118]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPluginsRequest.ProtoReflect.Descriptor instead.
+func (*ListPluginsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{118}
+}
+
+type ListPluginsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Plugins       []*PluginInfo          `protobuf:"bytes,1,rep,name=plugins,proto3" json:"plugins,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPluginsResponse) Reset() {
+	*x = ListPluginsResponse{}
+	mi := &file_proto_service_proto_msgTypes[119]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPluginsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPluginsResponse) ProtoMessage() {}
+
+func (x *ListPluginsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[119]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPluginsResponse.ProtoReflect.Descriptor instead.
+func (*ListPluginsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{119}
+}
+
+func (x *ListPluginsResponse) GetPlugins() []*PluginInfo {
+	if x != nil {
+		return x.Plugins
+	}
+	return nil
+}
+
+type PluginInfo struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Name           string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Version        string                 `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	Description    string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	RequiredConfig []string               `protobuf:"bytes,4,rep,name=required_config,json=requiredConfig,proto3" json:"required_config,omitempty"` // config.yaml keys, e.g. "shodan.api_key"
+	Passive        bool                   `protobuf:"varint,5,opt,name=passive,proto3" json:"passive,omitempty"`                                    // true if the plugin never contacts the target directly
+	Dependencies   []string               `protobuf:"bytes,6,rep,name=dependencies,proto3" json:"dependencies,omitempty"`
+	Loaded         bool                   `protobuf:"varint,7,opt,name=loaded,proto3" json:"loaded,omitempty"`
+	Configured     bool                   `protobuf:"varint,8,opt,name=configured,proto3" json:"configured,omitempty"`
+	MissingConfig  []string               `protobuf:"bytes,9,rep,name=missing_config,json=missingConfig,proto3" json:"missing_config,omitempty"`
+	External       bool                   `protobuf:"varint,10,opt,name=external,proto3" json:"external,omitempty"`                               // runs as a separate executable under external_plugins
+	Healthy        bool                   `protobuf:"varint,11,opt,name=healthy,proto3" json:"healthy,omitempty"`                                 // loaded and, for plugins that check, able to scan
+	HealthMessage  string                 `protobuf:"bytes,12,opt,name=health_message,json=healthMessage,proto3" json:"health_message,omitempty"` // why the plugin is unhealthy or was not loaded
+	Enabled        bool                   `protobuf:"varint,13,opt,name=enabled,proto3" json:"enabled,omitempty"`                                 // false if turned off under plugins in config.yaml
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *PluginInfo) Reset() {
+	*x = PluginInfo{}
+	mi := &file_proto_service_proto_msgTypes[120]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PluginInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PluginInfo) ProtoMessage() {}
+
+func (x *PluginInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[120]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PluginInfo.ProtoReflect.Descriptor instead.
+func (*PluginInfo) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{120}
+}
+
+func (x *PluginInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PluginInfo) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *PluginInfo) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *PluginInfo) GetRequiredConfig() []string {
+	if x != nil {
+		return x.RequiredConfig
+	}
+	return nil
+}
+
+func (x *PluginInfo) GetPassive() bool {
+	if x != nil {
+		return x.Passive
+	}
+	return false
+}
+
+func (x *PluginInfo) GetDependencies() []string {
+	if x != nil {
+		return x.Dependencies
+	}
+	return nil
+}
+
+func (x *PluginInfo) GetLoaded() bool {
+	if x != nil {
+		return x.Loaded
+	}
+	return false
+}
+
+func (x *PluginInfo) GetConfigured() bool {
+	if x != nil {
+		return x.Configured
+	}
+	return false
+}
+
+func (x *PluginInfo) GetMissingConfig() []string {
+	if x != nil {
+		return x.MissingConfig
+	}
+	return nil
+}
+
+func (x *PluginInfo) GetExternal() bool {
+	if x != nil {
+		return x.External
+	}
+	return false
+}
+
+func (x *PluginInfo) GetHealthy() bool {
+	if x != nil {
+		return x.Healthy
+	}
+	return false
+}
+
+func (x *PluginInfo) GetHealthMessage() string {
+	if x != nil {
+		return x.HealthMessage
+	}
+	return ""
+}
+
+func (x *PluginInfo) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+// Scan job messages
+type SubmitScanJobRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Domain        string                 `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
+	Profile       string                 `protobuf:"bytes,2,opt,name=profile,proto3" json:"profile,omitempty"`  // scan profile name; defaults to "full"
+	Refresh       bool                   `protobuf:"varint,3,opt,name=refresh,proto3" json:"refresh,omitempty"` // bypass cached intelligence lookups
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitScanJobRequest) Reset() {
+	*x = SubmitScanJobRequest{}
+	mi := &file_proto_service_proto_msgTypes[121]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitScanJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitScanJobRequest) ProtoMessage() {}
+
+func (x *SubmitScanJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[121]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitScanJobRequest.ProtoReflect.Descriptor instead.
+func (*SubmitScanJobRequest) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{121}
+}
+
+func (x *SubmitScanJobRequest) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+func (x *SubmitScanJobRequest) GetProfile() string {
+	if x != nil {
+		return x.Profile
+	}
+	return ""
+}
+
+func (x *SubmitScanJobRequest) GetRefresh() bool {
+	if x != nil {
+		return x.Refresh
+	}
+	return false
+}
+
+type SubmitScanJobResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Job           *ScanJob               `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitScanJobResponse) Reset() {
+	*x = SubmitScanJobResponse{}
+	mi := &file_proto_service_proto_msgTypes[122]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitScanJobResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitScanJobResponse) ProtoMessage() {}
+
+func (x *SubmitScanJobResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[122]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitScanJobResponse.ProtoReflect.Descriptor instead.
+func (*SubmitScanJobResponse) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{122}
+}
+
+func (x *SubmitScanJobResponse) GetJob() *ScanJob {
+	if x != nil {
+		return x.Job
+	}
+	return nil
+}
+
+type GetScanJobRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JobId         string                 `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetScanJobRequest) Reset() {
+	*x = GetScanJobRequest{}
+	mi := &file_proto_service_proto_msgTypes[123]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetScanJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetScanJobRequest) ProtoMessage() {}
+
+func (x *GetScanJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[123]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetScanJobRequest.ProtoReflect.Descriptor instead.
+func (*GetScanJobRequest) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{123}
+}
+
+func (x *GetScanJobRequest) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+type GetScanJobResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Job           *ScanJob               `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetScanJobResponse) Reset() {
+	*x = GetScanJobResponse{}
+	mi := &file_proto_service_proto_msgTypes[124]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetScanJobResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetScanJobResponse) ProtoMessage() {}
+
+func (x *GetScanJobResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[124]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetScanJobResponse.ProtoReflect.Descriptor instead.
+func (*GetScanJobResponse) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{124}
+}
+
+func (x *GetScanJobResponse) GetJob() *ScanJob {
+	if x != nil {
+		return x.Job
+	}
+	return nil
+}
+
+type ListScanJobsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"` // defaults to 50
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListScanJobsRequest) Reset() {
+	*x = ListScanJobsRequest{}
+	mi := &file_proto_service_proto_msgTypes[125]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListScanJobsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListScanJobsRequest) ProtoMessage() {}
+
+func (x *ListScanJobsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[125]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListScanJobsRequest.ProtoReflect.Descriptor instead.
+func (*ListScanJobsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{125}
+}
+
+func (x *ListScanJobsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListScanJobsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Jobs          []*ScanJob             `protobuf:"bytes,1,rep,name=jobs,proto3" json:"jobs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListScanJobsResponse) Reset() {
+	*x = ListScanJobsResponse{}
+	mi := &file_proto_service_proto_msgTypes[126]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListScanJobsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListScanJobsResponse) ProtoMessage() {}
+
+func (x *ListScanJobsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[126]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListScanJobsResponse.ProtoReflect.Descriptor instead.
+func (*ListScanJobsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{126}
+}
+
+func (x *ListScanJobsResponse) GetJobs() []*ScanJob {
+	if x != nil {
+		return x.Jobs
+	}
+	return nil
+}
+
+type CancelScanJobRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JobId         string                 `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelScanJobRequest) Reset() {
+	*x = CancelScanJobRequest{}
+	mi := &file_proto_service_proto_msgTypes[127]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelScanJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelScanJobRequest) ProtoMessage() {}
+
+func (x *CancelScanJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[127]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelScanJobRequest.ProtoReflect.Descriptor instead.
+func (*CancelScanJobRequest) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{127}
+}
+
+func (x *CancelScanJobRequest) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+type CancelScanJobResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Job           *ScanJob               `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelScanJobResponse) Reset() {
+	*x = CancelScanJobResponse{}
+	mi := &file_proto_service_proto_msgTypes[128]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelScanJobResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelScanJobResponse) ProtoMessage() {}
+
+func (x *CancelScanJobResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[128]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelScanJobResponse.ProtoReflect.Descriptor instead.
+func (*CancelScanJobResponse) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{128}
+}
+
+func (x *CancelScanJobResponse) GetJob() *ScanJob {
+	if x != nil {
+		return x.Job
+	}
+	return nil
+}
+
+type ScanJob struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	JobId           string                 `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	Domain          string                 `protobuf:"bytes,2,opt,name=domain,proto3" json:"domain,omitempty"`
+	Status          string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"` // queued, running, succeeded, failed or cancelled
+	ReportId        string                 `protobuf:"bytes,4,opt,name=report_id,json=reportId,proto3" json:"report_id,omitempty"`
+	Error           string                 `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+	CancelRequested bool                   `protobuf:"varint,6,opt,name=cancel_requested,json=cancelRequested,proto3" json:"cancel_requested,omitempty"`
+	Plugins         []*ScanJobPlugin       `protobuf:"bytes,7,rep,name=plugins,proto3" json:"plugins,omitempty"`
+	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	StartedAt       *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	FinishedAt      *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=finished_at,json=finishedAt,proto3" json:"finished_at,omitempty"`
+	ParentJobId     string                 `protobuf:"bytes,11,opt,name=parent_job_id,json=parentJobId,proto3" json:"parent_job_id,omitempty"` // set on jobs queued for subdomains found by another job
+	ScanRunId       string                 `protobuf:"bytes,12,opt,name=scan_run_id,json=scanRunId,proto3" json:"scan_run_id,omitempty"`
+	BatchId         string                 `protobuf:"bytes,13,opt,name=batch_id,json=batchId,proto3" json:"batch_id,omitempty"` // set on jobs submitted by BulkScan
+	Tags            []string               `protobuf:"bytes,14,rep,name=tags,proto3" json:"tags,omitempty"`
+	Profile         string                 `protobuf:"bytes,15,opt,name=profile,proto3" json:"profile,omitempty"`
+	Refresh         bool                   `protobuf:"varint,16,opt,name=refresh,proto3" json:"refresh,omitempty"` // bypasses cached intelligence lookups
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ScanJob) Reset() {
+	*x = ScanJob{}
+	mi := &file_proto_service_proto_msgTypes[129]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScanJob) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScanJob) ProtoMessage() {}
+
+func (x *ScanJob) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[129]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScanJob.ProtoReflect.Descriptor instead.
+func (*ScanJob) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{129}
+}
+
+func (x *ScanJob) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+func (x *ScanJob) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+func (x *ScanJob) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ScanJob) GetReportId() string {
+	if x != nil {
+		return x.ReportId
+	}
+	return ""
+}
+
+func (x *ScanJob) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *ScanJob) GetCancelRequested() bool {
+	if x != nil {
+		return x.CancelRequested
+	}
+	return false
+}
+
+func (x *ScanJob) GetPlugins() []*ScanJobPlugin {
+	if x != nil {
+		return x.Plugins
+	}
+	return nil
+}
+
+func (x *ScanJob) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ScanJob) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+func (x *ScanJob) GetFinishedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FinishedAt
+	}
+	return nil
+}
+
+func (x *ScanJob) GetParentJobId() string {
+	if x != nil {
+		return x.ParentJobId
+	}
+	return ""
+}
+
+func (x *ScanJob) GetScanRunId() string {
+	if x != nil {
+		return x.ScanRunId
+	}
+	return ""
+}
+
+func (x *ScanJob) GetBatchId() string {
+	if x != nil {
+		return x.BatchId
+	}
+	return ""
+}
+
+func (x *ScanJob) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *ScanJob) GetProfile() string {
+	if x != nil {
+		return x.Profile
+	}
+	return ""
+}
+
+func (x *ScanJob) GetRefresh() bool {
+	if x != nil {
+		return x.Refresh
+	}
+	return false
+}
+
+type ScanJobPlugin struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Plugin        string                 `protobuf:"bytes,1,opt,name=plugin,proto3" json:"plugin,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"` // queued, running, succeeded, failed or skipped
+	ResultId      string                 `protobuf:"bytes,3,opt,name=result_id,json=resultId,proto3" json:"result_id,omitempty"`
+	Error         string                 `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	StartedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	FinishedAt    *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=finished_at,json=finishedAt,proto3" json:"finished_at,omitempty"`
+	TimedOut      bool                   `protobuf:"varint,7,opt,name=timed_out,json=timedOut,proto3" json:"timed_out,omitempty"` // the plugin was abandoned after exceeding its timeout
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScanJobPlugin) Reset() {
+	*x = ScanJobPlugin{}
+	mi := &file_proto_service_proto_msgTypes[130]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScanJobPlugin) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScanJobPlugin) ProtoMessage() {}
+
+func (x *ScanJobPlugin) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[130]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScanJobPlugin.ProtoReflect.Descriptor instead.
+func (*ScanJobPlugin) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{130}
+}
+
+func (x *ScanJobPlugin) GetPlugin() string {
+	if x != nil {
+		return x.Plugin
+	}
+	return ""
+}
+
+func (x *ScanJobPlugin) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ScanJobPlugin) GetResultId() string {
+	if x != nil {
+		return x.ResultId
+	}
+	return ""
+}
+
+func (x *ScanJobPlugin) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *ScanJobPlugin) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+func (x *ScanJobPlugin) GetFinishedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FinishedAt
+	}
+	return nil
+}
+
+func (x *ScanJobPlugin) GetTimedOut() bool {
+	if x != nil {
+		return x.TimedOut
+	}
+	return false
+}
+
+// Scan run messages
+type GetScanRunRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ScanRunId     string                 `protobuf:"bytes,1,opt,name=scan_run_id,json=scanRunId,proto3" json:"scan_run_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetScanRunRequest) Reset() {
+	*x = GetScanRunRequest{}
+	mi := &file_proto_service_proto_msgTypes[131]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetScanRunRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetScanRunRequest) ProtoMessage() {}
+
+func (x *GetScanRunRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[131]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetScanRunRequest.ProtoReflect.Descriptor instead.
+func (*GetScanRunRequest) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{131}
+}
+
+func (x *GetScanRunRequest) GetScanRunId() string {
+	if x != nil {
+		return x.ScanRunId
+	}
+	return ""
+}
+
+type GetScanRunResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Run           *ScanRun               `protobuf:"bytes,1,opt,name=run,proto3" json:"run,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetScanRunResponse) Reset() {
+	*x = GetScanRunResponse{}
+	mi := &file_proto_service_proto_msgTypes[132]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetScanRunResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetScanRunResponse) ProtoMessage() {}
+
+func (x *GetScanRunResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[132]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetScanRunResponse.ProtoReflect.Descriptor instead.
+func (*GetScanRunResponse) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{132}
+}
+
+func (x *GetScanRunResponse) GetRun() *ScanRun {
+	if x != nil {
+		return x.Run
+	}
+	return nil
+}
+
+// ScanRun groups the plugin results produced by one scan of a domain
+type ScanRun struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Domain        string                 `protobuf:"bytes,2,opt,name=domain,proto3" json:"domain,omitempty"`
+	RequestedBy   string                 `protobuf:"bytes,3,opt,name=requested_by,json=requestedBy,proto3" json:"requested_by,omitempty"` // empty for runs started by the server
+	Profile       string                 `protobuf:"bytes,4,opt,name=profile,proto3" json:"profile,omitempty"`                            // scan profile name, "subdomain", or the plugin name for single-plugin scans
+	Status        string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`                              // running, succeeded or failed
+	Error         string                 `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
+	StartedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	FinishedAt    *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=finished_at,json=finishedAt,proto3" json:"finished_at,omitempty"`
+	Results       []*ScanRunResult       `protobuf:"bytes,9,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScanRun) Reset() {
+	*x = ScanRun{}
+	mi := &file_proto_service_proto_msgTypes[133]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScanRun) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScanRun) ProtoMessage() {}
+
+func (x *ScanRun) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[133]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScanRun.ProtoReflect.Descriptor instead.
+func (*ScanRun) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{133}
+}
+
+func (x *ScanRun) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ScanRun) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+func (x *ScanRun) GetRequestedBy() string {
+	if x != nil {
+		return x.RequestedBy
+	}
+	return ""
+}
+
+func (x *ScanRun) GetProfile() string {
+	if x != nil {
+		return x.Profile
+	}
+	return ""
+}
+
+func (x *ScanRun) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ScanRun) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *ScanRun) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+func (x *ScanRun) GetFinishedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FinishedAt
+	}
+	return nil
+}
+
+func (x *ScanRun) GetResults() []*ScanRunResult {
+	if x != nil {
+		return x.Results
 	}
 	return nil
 }
 
-type ShodanSecurityResult struct {
+// ScanRunResult is one plugin result stored under a run. Only the field
+// matching the plugin is set.
+type ScanRunResult struct {
+	state          protoimpl.MessageState  `protogen:"open.v1"`
+	Plugin         string                  `protobuf:"bytes,1,opt,name=plugin,proto3" json:"plugin,omitempty"`
+	ResultId       string                  `protobuf:"bytes,2,opt,name=result_id,json=resultId,proto3" json:"result_id,omitempty"`
+	CreatedAt      *timestamppb.Timestamp  `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	DnsResult      *DNSSecurityResult      `protobuf:"bytes,4,opt,name=dns_result,json=dnsResult,proto3" json:"dns_result,omitempty"`
+	TlsResult      *TLSSecurityResult      `protobuf:"bytes,5,opt,name=tls_result,json=tlsResult,proto3" json:"tls_result,omitempty"`
+	CrtshResult    *CrtShSecurityResult    `protobuf:"bytes,6,opt,name=crtsh_result,json=crtshResult,proto3" json:"crtsh_result,omitempty"`
+	ChaosResult    *ChaosSecurityResult    `protobuf:"bytes,7,opt,name=chaos_result,json=chaosResult,proto3" json:"chaos_result,omitempty"`
+	ShodanResult   *ShodanSecurityResult   `protobuf:"bytes,8,opt,name=shodan_result,json=shodanResult,proto3" json:"shodan_result,omitempty"`
+	OtxResult      *OTXSecurityResult      `protobuf:"bytes,9,opt,name=otx_result,json=otxResult,proto3" json:"otx_result,omitempty"`
+	WhoisResult    *WhoisSecurityResult    `protobuf:"bytes,10,opt,name=whois_result,json=whoisResult,proto3" json:"whois_result,omitempty"`
+	AbusechResult  *AbuseChSecurityResult  `protobuf:"bytes,11,opt,name=abusech_result,json=abusechResult,proto3" json:"abusech_result,omitempty"`
+	IscResult      *ISCSecurityResult      `protobuf:"bytes,12,opt,name=isc_result,json=iscResult,proto3" json:"isc_result,omitempty"`
+	ExternalResult *ExternalSecurityResult `protobuf:"bytes,13,opt,name=external_result,json=externalResult,proto3" json:"external_result,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ScanRunResult) Reset() {
+	*x = ScanRunResult{}
+	mi := &file_proto_service_proto_msgTypes[134]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScanRunResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScanRunResult) ProtoMessage() {}
+
+func (x *ScanRunResult) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[134]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScanRunResult.ProtoReflect.Descriptor instead.
+func (*ScanRunResult) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{134}
+}
+
+func (x *ScanRunResult) GetPlugin() string {
+	if x != nil {
+		return x.Plugin
+	}
+	return ""
+}
+
+func (x *ScanRunResult) GetResultId() string {
+	if x != nil {
+		return x.ResultId
+	}
+	return ""
+}
+
+func (x *ScanRunResult) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ScanRunResult) GetDnsResult() *DNSSecurityResult {
+	if x != nil {
+		return x.DnsResult
+	}
+	return nil
+}
+
+func (x *ScanRunResult) GetTlsResult() *TLSSecurityResult {
+	if x != nil {
+		return x.TlsResult
+	}
+	return nil
+}
+
+func (x *ScanRunResult) GetCrtshResult() *CrtShSecurityResult {
+	if x != nil {
+		return x.CrtshResult
+	}
+	return nil
+}
+
+func (x *ScanRunResult) GetChaosResult() *ChaosSecurityResult {
+	if x != nil {
+		return x.ChaosResult
+	}
+	return nil
+}
+
+func (x *ScanRunResult) GetShodanResult() *ShodanSecurityResult {
+	if x != nil {
+		return x.ShodanResult
+	}
+	return nil
+}
+
+func (x *ScanRunResult) GetOtxResult() *OTXSecurityResult {
+	if x != nil {
+		return x.OtxResult
+	}
+	return nil
+}
+
+func (x *ScanRunResult) GetWhoisResult() *WhoisSecurityResult {
+	if x != nil {
+		return x.WhoisResult
+	}
+	return nil
+}
+
+func (x *ScanRunResult) GetAbusechResult() *AbuseChSecurityResult {
+	if x != nil {
+		return x.AbusechResult
+	}
+	return nil
+}
+
+func (x *ScanRunResult) GetIscResult() *ISCSecurityResult {
+	if x != nil {
+		return x.IscResult
+	}
+	return nil
+}
+
+func (x *ScanRunResult) GetExternalResult() *ExternalSecurityResult {
+	if x != nil {
+		return x.ExternalResult
+	}
+	return nil
+}
+
+// ExternalSecurityResult is the result of a plugin run as an external
+// executable, which the server stores without interpreting.
+type ExternalSecurityResult struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Hosts         []*ShodanHost          `protobuf:"bytes,1,rep,name=hosts,proto3" json:"hosts,omitempty"`
-	Errors        []string               `protobuf:"bytes,2,rep,name=errors,proto3" json:"errors,omitempty"`
+	Plugin        string                 `protobuf:"bytes,1,opt,name=plugin,proto3" json:"plugin,omitempty"`
+	Result        string                 `protobuf:"bytes,2,opt,name=result,proto3" json:"result,omitempty"` // JSON document returned by the plugin
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ShodanSecurityResult) Reset() {
-	*x = ShodanSecurityResult{}
-	mi := &file_proto_service_proto_msgTypes[75]
+func (x *ExternalSecurityResult) Reset() {
+	*x = ExternalSecurityResult{}
+	mi := &file_proto_service_proto_msgTypes[135]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ShodanSecurityResult) String() string {
+func (x *ExternalSecurityResult) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ShodanSecurityResult) ProtoMessage() {}
+func (*ExternalSecurityResult) ProtoMessage() {}
 
-func (x *ShodanSecurityResult) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_service_proto_msgTypes[75]
+func (x *ExternalSecurityResult) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[135]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4418,48 +8894,273 @@ func (x *ShodanSecurityResult) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ShodanSecurityResult.ProtoReflect.Descriptor instead.
-func (*ShodanSecurityResult) Descriptor() ([]byte, []int) {
-	return file_proto_service_proto_rawDescGZIP(), []int{75}
+// Deprecated: Use ExternalSecurityResult.ProtoReflect.Descriptor instead.
+func (*ExternalSecurityResult) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{135}
+}
+
+func (x *ExternalSecurityResult) GetPlugin() string {
+	if x != nil {
+		return x.Plugin
+	}
+	return ""
+}
+
+func (x *ExternalSecurityResult) GetResult() string {
+	if x != nil {
+		return x.Result
+	}
+	return ""
+}
+
+// Scan schedule messages
+type CreateScanScheduleRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Domain          string                 `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
+	Cron            string                 `protobuf:"bytes,2,opt,name=cron,proto3" json:"cron,omitempty"`                                               // five-field cron expression in UTC, e.g. "0 3 * * 1"
+	IntervalSeconds int64                  `protobuf:"varint,3,opt,name=interval_seconds,json=intervalSeconds,proto3" json:"interval_seconds,omitempty"` // alternative to cron; at least 60
+	Profile         string                 `protobuf:"bytes,4,opt,name=profile,proto3" json:"profile,omitempty"`                                         // defaults to "full"
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CreateScanScheduleRequest) Reset() {
+	*x = CreateScanScheduleRequest{}
+	mi := &file_proto_service_proto_msgTypes[136]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateScanScheduleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateScanScheduleRequest) ProtoMessage() {}
+
+func (x *CreateScanScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[136]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateScanScheduleRequest.ProtoReflect.Descriptor instead.
+func (*CreateScanScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{136}
+}
+
+func (x *CreateScanScheduleRequest) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+func (x *CreateScanScheduleRequest) GetCron() string {
+	if x != nil {
+		return x.Cron
+	}
+	return ""
+}
+
+func (x *CreateScanScheduleRequest) GetIntervalSeconds() int64 {
+	if x != nil {
+		return x.IntervalSeconds
+	}
+	return 0
+}
+
+func (x *CreateScanScheduleRequest) GetProfile() string {
+	if x != nil {
+		return x.Profile
+	}
+	return ""
+}
+
+type CreateScanScheduleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Schedule      *ScanSchedule          `protobuf:"bytes,1,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateScanScheduleResponse) Reset() {
+	*x = CreateScanScheduleResponse{}
+	mi := &file_proto_service_proto_msgTypes[137]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateScanScheduleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateScanScheduleResponse) ProtoMessage() {}
+
+func (x *CreateScanScheduleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[137]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateScanScheduleResponse.ProtoReflect.Descriptor instead.
+func (*CreateScanScheduleResponse) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{137}
+}
+
+func (x *CreateScanScheduleResponse) GetSchedule() *ScanSchedule {
+	if x != nil {
+		return x.Schedule
+	}
+	return nil
+}
+
+type UpdateScanScheduleRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ScheduleId      string                 `protobuf:"bytes,1,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+	Cron            string                 `protobuf:"bytes,2,opt,name=cron,proto3" json:"cron,omitempty"`
+	IntervalSeconds int64                  `protobuf:"varint,3,opt,name=interval_seconds,json=intervalSeconds,proto3" json:"interval_seconds,omitempty"`
+	Profile         string                 `protobuf:"bytes,4,opt,name=profile,proto3" json:"profile,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *UpdateScanScheduleRequest) Reset() {
+	*x = UpdateScanScheduleRequest{}
+	mi := &file_proto_service_proto_msgTypes[138]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateScanScheduleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateScanScheduleRequest) ProtoMessage() {}
+
+func (x *UpdateScanScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[138]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateScanScheduleRequest.ProtoReflect.Descriptor instead.
+func (*UpdateScanScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{138}
+}
+
+func (x *UpdateScanScheduleRequest) GetScheduleId() string {
+	if x != nil {
+		return x.ScheduleId
+	}
+	return ""
+}
+
+func (x *UpdateScanScheduleRequest) GetCron() string {
+	if x != nil {
+		return x.Cron
+	}
+	return ""
+}
+
+func (x *UpdateScanScheduleRequest) GetIntervalSeconds() int64 {
+	if x != nil {
+		return x.IntervalSeconds
+	}
+	return 0
+}
+
+func (x *UpdateScanScheduleRequest) GetProfile() string {
+	if x != nil {
+		return x.Profile
+	}
+	return ""
+}
+
+type UpdateScanScheduleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Schedule      *ScanSchedule          `protobuf:"bytes,1,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateScanScheduleResponse) Reset() {
+	*x = UpdateScanScheduleResponse{}
+	mi := &file_proto_service_proto_msgTypes[139]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateScanScheduleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateScanScheduleResponse) ProtoMessage() {}
+
+func (x *UpdateScanScheduleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[139]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-func (x *ShodanSecurityResult) GetHosts() []*ShodanHost {
-	if x != nil {
-		return x.Hosts
-	}
-	return nil
+// Deprecated: Use UpdateScanScheduleResponse.ProtoReflect.Descriptor instead.
+func (*UpdateScanScheduleResponse) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{139}
 }
 
-func (x *ShodanSecurityResult) GetErrors() []string {
+func (x *UpdateScanScheduleResponse) GetSchedule() *ScanSchedule {
 	if x != nil {
-		return x.Errors
+		return x.Schedule
 	}
 	return nil
 }
 
-type ScanOTXRequest struct {
+type PauseScanScheduleRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Domain        string                 `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
-	DnsScanId     string                 `protobuf:"bytes,2,opt,name=dns_scan_id,json=dnsScanId,proto3" json:"dns_scan_id,omitempty"`
+	ScheduleId    string                 `protobuf:"bytes,1,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+	Paused        bool                   `protobuf:"varint,2,opt,name=paused,proto3" json:"paused,omitempty"` // false resumes the schedule
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ScanOTXRequest) Reset() {
-	*x = ScanOTXRequest{}
-	mi := &file_proto_service_proto_msgTypes[76]
+func (x *PauseScanScheduleRequest) Reset() {
+	*x = PauseScanScheduleRequest{}
+	mi := &file_proto_service_proto_msgTypes[140]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ScanOTXRequest) String() string {
+func (x *PauseScanScheduleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ScanOTXRequest) ProtoMessage() {}
+func (*PauseScanScheduleRequest) ProtoMessage() {}
 
-func (x *ScanOTXRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_service_proto_msgTypes[76]
+func (x *PauseScanScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[140]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4470,48 +9171,47 @@ func (x *ScanOTXRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ScanOTXRequest.ProtoReflect.Descriptor instead.
-func (*ScanOTXRequest) Descriptor() ([]byte, []int) {
-	return file_proto_service_proto_rawDescGZIP(), []int{76}
+// Deprecated: Use PauseScanScheduleRequest.ProtoReflect.Descriptor instead.
+func (*PauseScanScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{140}
 }
 
-func (x *ScanOTXRequest) GetDomain() string {
+func (x *PauseScanScheduleRequest) GetScheduleId() string {
 	if x != nil {
-		return x.Domain
+		return x.ScheduleId
 	}
 	return ""
 }
 
-func (x *ScanOTXRequest) GetDnsScanId() string {
+func (x *PauseScanScheduleRequest) GetPaused() bool {
 	if x != nil {
-		return x.DnsScanId
+		return x.Paused
 	}
-	return ""
+	return false
 }
 
-type ScanOTXResponse struct {
+type PauseScanScheduleResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ScanId        string                 `protobuf:"bytes,1,opt,name=scan_id,json=scanId,proto3" json:"scan_id,omitempty"`
-	Result        *OTXSecurityResult     `protobuf:"bytes,2,opt,name=result,proto3" json:"result,omitempty"`
+	Schedule      *ScanSchedule          `protobuf:"bytes,1,opt,name=schedule,proto3" json:"schedule,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ScanOTXResponse) Reset() {
-	*x = ScanOTXResponse{}
-	mi := &file_proto_service_proto_msgTypes[77]
+func (x *PauseScanScheduleResponse) Reset() {
+	*x = PauseScanScheduleResponse{}
+	mi := &file_proto_service_proto_msgTypes[141]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ScanOTXResponse) String() string {
+func (x *PauseScanScheduleResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ScanOTXResponse) ProtoMessage() {}
+func (*PauseScanScheduleResponse) ProtoMessage() {}
 
-func (x *ScanOTXResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_service_proto_msgTypes[77]
+func (x *PauseScanScheduleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[141]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4522,47 +9222,39 @@ func (x *ScanOTXResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ScanOTXResponse.ProtoReflect.Descriptor instead.
-func (*ScanOTXResponse) Descriptor() ([]byte, []int) {
-	return file_proto_service_proto_rawDescGZIP(), []int{77}
-}
-
-func (x *ScanOTXResponse) GetScanId() string {
-	if x != nil {
-		return x.ScanId
-	}
-	return ""
+// Deprecated: Use PauseScanScheduleResponse.ProtoReflect.Descriptor instead.
+func (*PauseScanScheduleResponse) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{141}
 }
 
-func (x *ScanOTXResponse) GetResult() *OTXSecurityResult {
+func (x *PauseScanScheduleResponse) GetSchedule() *ScanSchedule {
 	if x != nil {
-		return x.Result
+		return x.Schedule
 	}
 	return nil
 }
 
-type GetOTXScanResultsByDomainRequest struct {
+type ListScanSchedulesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Domain        string                 `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetOTXScanResultsByDomainRequest) Reset() {
-	*x = GetOTXScanResultsByDomainRequest{}
-	mi := &file_proto_service_proto_msgTypes[78]
+func (x *ListScanSchedulesRequest) Reset() {
+	*x = ListScanSchedulesRequest{}
+	mi := &file_proto_service_proto_msgTypes[142]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetOTXScanResultsByDomainRequest) String() string {
+func (x *ListScanSchedulesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetOTXScanResultsByDomainRequest) ProtoMessage() {}
+func (*ListScanSchedulesRequest) ProtoMessage() {}
 
-func (x *GetOTXScanResultsByDomainRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_service_proto_msgTypes[78]
+func (x *ListScanSchedulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[142]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4573,40 +9265,33 @@ func (x *GetOTXScanResultsByDomainRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetOTXScanResultsByDomainRequest.ProtoReflect.Descriptor instead.
-func (*GetOTXScanResultsByDomainRequest) Descriptor() ([]byte, []int) {
-	return file_proto_service_proto_rawDescGZIP(), []int{78}
-}
-
-func (x *GetOTXScanResultsByDomainRequest) GetDomain() string {
-	if x != nil {
-		return x.Domain
-	}
-	return ""
+// Deprecated: Use ListScanSchedulesRequest.ProtoReflect.Descriptor instead.
+func (*ListScanSchedulesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{142}
 }
 
-type GetOTXScanResultsByDomainResponse struct {
+type ListScanSchedulesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Results       []*OTXScanResult       `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	Schedules     []*ScanSchedule        `protobuf:"bytes,1,rep,name=schedules,proto3" json:"schedules,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetOTXScanResultsByDomainResponse) Reset() {
-	*x = GetOTXScanResultsByDomainResponse{}
-	mi := &file_proto_service_proto_msgTypes[79]
+func (x *ListScanSchedulesResponse) Reset() {
+	*x = ListScanSchedulesResponse{}
+	mi := &file_proto_service_proto_msgTypes[143]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetOTXScanResultsByDomainResponse) String() string {
+func (x *ListScanSchedulesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetOTXScanResultsByDomainResponse) ProtoMessage() {}
+func (*ListScanSchedulesResponse) ProtoMessage() {}
 
-func (x *GetOTXScanResultsByDomainResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_service_proto_msgTypes[79]
+func (x *ListScanSchedulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[143]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4617,44 +9302,52 @@ func (x *GetOTXScanResultsByDomainResponse) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetOTXScanResultsByDomainResponse.ProtoReflect.Descriptor instead.
-func (*GetOTXScanResultsByDomainResponse) Descriptor() ([]byte, []int) {
-	return file_proto_service_proto_rawDescGZIP(), []int{79}
+// Deprecated: Use ListScanSchedulesResponse.ProtoReflect.Descriptor instead.
+func (*ListScanSchedulesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{143}
 }
 
-func (x *GetOTXScanResultsByDomainResponse) GetResults() []*OTXScanResult {
+func (x *ListScanSchedulesResponse) GetSchedules() []*ScanSchedule {
 	if x != nil {
-		return x.Results
+		return x.Schedules
 	}
 	return nil
 }
 
-type OTXScanResult struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Domain        string                 `protobuf:"bytes,2,opt,name=domain,proto3" json:"domain,omitempty"`
-	DnsScanId     string                 `protobuf:"bytes,3,opt,name=dns_scan_id,json=dnsScanId,proto3" json:"dns_scan_id,omitempty"`
-	Result        *OTXSecurityResult     `protobuf:"bytes,4,opt,name=result,proto3" json:"result,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *OTXScanResult) Reset() {
-	*x = OTXScanResult{}
-	mi := &file_proto_service_proto_msgTypes[80]
+type ScanSchedule struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ScheduleId      string                 `protobuf:"bytes,1,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+	Domain          string                 `protobuf:"bytes,2,opt,name=domain,proto3" json:"domain,omitempty"`
+	Cron            string                 `protobuf:"bytes,3,opt,name=cron,proto3" json:"cron,omitempty"`
+	IntervalSeconds int64                  `protobuf:"varint,4,opt,name=interval_seconds,json=intervalSeconds,proto3" json:"interval_seconds,omitempty"`
+	Profile         string                 `protobuf:"bytes,5,opt,name=profile,proto3" json:"profile,omitempty"`
+	OwnerId         string                 `protobuf:"bytes,6,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	Paused          bool                   `protobuf:"varint,7,opt,name=paused,proto3" json:"paused,omitempty"`
+	NextRunAt       *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=next_run_at,json=nextRunAt,proto3" json:"next_run_at,omitempty"`
+	LastRunAt       *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=last_run_at,json=lastRunAt,proto3" json:"last_run_at,omitempty"`
+	LastJobId       string                 `protobuf:"bytes,10,opt,name=last_job_id,json=lastJobId,proto3" json:"last_job_id,omitempty"`
+	LastError       string                 `protobuf:"bytes,11,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"` // why the last run could not be queued
+	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt       *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ScanSchedule) Reset() {
+	*x = ScanSchedule{}
+	mi := &file_proto_service_proto_msgTypes[144]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *OTXScanResult) String() string {
+func (x *ScanSchedule) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OTXScanResult) ProtoMessage() {}
+func (*ScanSchedule) ProtoMessage() {}
 
-func (x *OTXScanResult) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_service_proto_msgTypes[80]
+func (x *ScanSchedule) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[144]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4665,121 +9358,131 @@ func (x *OTXScanResult) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OTXScanResult.ProtoReflect.Descriptor instead.
-func (*OTXScanResult) Descriptor() ([]byte, []int) {
-	return file_proto_service_proto_rawDescGZIP(), []int{80}
+// Deprecated: Use ScanSchedule.ProtoReflect.Descriptor instead.
+func (*ScanSchedule) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{144}
 }
 
-func (x *OTXScanResult) GetId() string {
+func (x *ScanSchedule) GetScheduleId() string {
 	if x != nil {
-		return x.Id
+		return x.ScheduleId
 	}
 	return ""
 }
 
-func (x *OTXScanResult) GetDomain() string {
+func (x *ScanSchedule) GetDomain() string {
 	if x != nil {
 		return x.Domain
 	}
 	return ""
 }
 
-func (x *OTXScanResult) GetDnsScanId() string {
+func (x *ScanSchedule) GetCron() string {
 	if x != nil {
-		return x.DnsScanId
+		return x.Cron
 	}
 	return ""
 }
 
-func (x *OTXScanResult) GetResult() *OTXSecurityResult {
+func (x *ScanSchedule) GetIntervalSeconds() int64 {
 	if x != nil {
-		return x.Result
+		return x.IntervalSeconds
 	}
-	return nil
+	return 0
 }
 
-func (x *OTXScanResult) GetCreatedAt() *timestamppb.Timestamp {
+func (x *ScanSchedule) GetProfile() string {
 	if x != nil {
-		return x.CreatedAt
+		return x.Profile
 	}
-	return nil
+	return ""
 }
 
-type OTXGeneralInfo struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	PulseCount    int32                  `protobuf:"varint,1,opt,name=pulse_count,json=pulseCount,proto3" json:"pulse_count,omitempty"`
-	Pulses        []string               `protobuf:"bytes,2,rep,name=pulses,proto3" json:"pulses,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *ScanSchedule) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
 }
 
-func (x *OTXGeneralInfo) Reset() {
-	*x = OTXGeneralInfo{}
-	mi := &file_proto_service_proto_msgTypes[81]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *ScanSchedule) GetPaused() bool {
+	if x != nil {
+		return x.Paused
+	}
+	return false
 }
 
-func (x *OTXGeneralInfo) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *ScanSchedule) GetNextRunAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextRunAt
+	}
+	return nil
 }
 
-func (*OTXGeneralInfo) ProtoMessage() {}
+func (x *ScanSchedule) GetLastRunAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastRunAt
+	}
+	return nil
+}
 
-func (x *OTXGeneralInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_service_proto_msgTypes[81]
+func (x *ScanSchedule) GetLastJobId() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.LastJobId
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use OTXGeneralInfo.ProtoReflect.Descriptor instead.
-func (*OTXGeneralInfo) Descriptor() ([]byte, []int) {
-	return file_proto_service_proto_rawDescGZIP(), []int{81}
+func (x *ScanSchedule) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
 }
 
-func (x *OTXGeneralInfo) GetPulseCount() int32 {
+func (x *ScanSchedule) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.PulseCount
+		return x.CreatedAt
 	}
-	return 0
+	return nil
 }
 
-func (x *OTXGeneralInfo) GetPulses() []string {
+func (x *ScanSchedule) GetUpdatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Pulses
+		return x.UpdatedAt
 	}
 	return nil
 }
 
-type OTXMalware struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Hash          string                 `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
-	Datetime      *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=datetime,proto3" json:"datetime,omitempty"`
+// Bulk scan messages
+type BulkScanRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Domains []string               `protobuf:"bytes,1,rep,name=domains,proto3" json:"domains,omitempty"`
+	// CSV with a domain column and an optional tags column holding tags
+	// separated by ";". The header row is optional; without one the first
+	// column is the domain and any further columns are tags.
+	Csv           []byte   `protobuf:"bytes,2,opt,name=csv,proto3" json:"csv,omitempty"`
+	Tags          []string `protobuf:"bytes,3,rep,name=tags,proto3" json:"tags,omitempty"`       // added to every domain
+	Profile       string   `protobuf:"bytes,4,opt,name=profile,proto3" json:"profile,omitempty"` // defaults to "full"
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *OTXMalware) Reset() {
-	*x = OTXMalware{}
-	mi := &file_proto_service_proto_msgTypes[82]
+func (x *BulkScanRequest) Reset() {
+	*x = BulkScanRequest{}
+	mi := &file_proto_service_proto_msgTypes[145]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *OTXMalware) String() string {
+func (x *BulkScanRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OTXMalware) ProtoMessage() {}
+func (*BulkScanRequest) ProtoMessage() {}
 
-func (x *OTXMalware) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_service_proto_msgTypes[82]
+func (x *BulkScanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[145]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4790,48 +9493,63 @@ func (x *OTXMalware) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OTXMalware.ProtoReflect.Descriptor instead.
-func (*OTXMalware) Descriptor() ([]byte, []int) {
-	return file_proto_service_proto_rawDescGZIP(), []int{82}
+// Deprecated: Use BulkScanRequest.ProtoReflect.Descriptor instead.
+func (*BulkScanRequest) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{145}
 }
 
-func (x *OTXMalware) GetHash() string {
+func (x *BulkScanRequest) GetDomains() []string {
 	if x != nil {
-		return x.Hash
+		return x.Domains
 	}
-	return ""
+	return nil
 }
 
-func (x *OTXMalware) GetDatetime() *timestamppb.Timestamp {
+func (x *BulkScanRequest) GetCsv() []byte {
 	if x != nil {
-		return x.Datetime
+		return x.Csv
 	}
 	return nil
 }
 
-type OTXURL struct {
+func (x *BulkScanRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *BulkScanRequest) GetProfile() string {
+	if x != nil {
+		return x.Profile
+	}
+	return ""
+}
+
+type BulkScanResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
-	Datetime      *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=datetime,proto3" json:"datetime,omitempty"`
+	Batch         *ScanBatch             `protobuf:"bytes,1,opt,name=batch,proto3" json:"batch,omitempty"`
+	Rejected      []*BulkScanRejected    `protobuf:"bytes,2,rep,name=rejected,proto3" json:"rejected,omitempty"`      // inputs that are not valid domains
+	Duplicates    int32                  `protobuf:"varint,3,opt,name=duplicates,proto3" json:"duplicates,omitempty"` // inputs merged into an earlier entry for the same domain
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *OTXURL) Reset() {
-	*x = OTXURL{}
-	mi := &file_proto_service_proto_msgTypes[83]
+func (x *BulkScanResponse) Reset() {
+	*x = BulkScanResponse{}
+	mi := &file_proto_service_proto_msgTypes[146]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *OTXURL) String() string {
+func (x *BulkScanResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OTXURL) ProtoMessage() {}
+func (*BulkScanResponse) ProtoMessage() {}
 
-func (x *OTXURL) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_service_proto_msgTypes[83]
+func (x *BulkScanResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[146]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4842,119 +9560,106 @@ func (x *OTXURL) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OTXURL.ProtoReflect.Descriptor instead.
-func (*OTXURL) Descriptor() ([]byte, []int) {
-	return file_proto_service_proto_rawDescGZIP(), []int{83}
+// Deprecated: Use BulkScanResponse.ProtoReflect.Descriptor instead.
+func (*BulkScanResponse) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{146}
 }
 
-func (x *OTXURL) GetUrl() string {
+func (x *BulkScanResponse) GetBatch() *ScanBatch {
 	if x != nil {
-		return x.Url
+		return x.Batch
 	}
-	return ""
+	return nil
 }
 
-func (x *OTXURL) GetDatetime() *timestamppb.Timestamp {
+func (x *BulkScanResponse) GetRejected() []*BulkScanRejected {
 	if x != nil {
-		return x.Datetime
+		return x.Rejected
 	}
 	return nil
 }
 
-type OTXPassiveDNS struct {
+func (x *BulkScanResponse) GetDuplicates() int32 {
+	if x != nil {
+		return x.Duplicates
+	}
+	return 0
+}
+
+type BulkScanRejected struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Address       string                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	Hostname      string                 `protobuf:"bytes,2,opt,name=hostname,proto3" json:"hostname,omitempty"`
-	Record        string                 `protobuf:"bytes,3,opt,name=record,proto3" json:"record,omitempty"`
-	Datetime      *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=datetime,proto3" json:"datetime,omitempty"`
+	Input         string                 `protobuf:"bytes,1,opt,name=input,proto3" json:"input,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *OTXPassiveDNS) Reset() {
-	*x = OTXPassiveDNS{}
-	mi := &file_proto_service_proto_msgTypes[84]
+func (x *BulkScanRejected) Reset() {
+	*x = BulkScanRejected{}
+	mi := &file_proto_service_proto_msgTypes[147]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *OTXPassiveDNS) String() string {
+func (x *BulkScanRejected) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OTXPassiveDNS) ProtoMessage() {}
-
-func (x *OTXPassiveDNS) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_service_proto_msgTypes[84]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use OTXPassiveDNS.ProtoReflect.Descriptor instead.
-func (*OTXPassiveDNS) Descriptor() ([]byte, []int) {
-	return file_proto_service_proto_rawDescGZIP(), []int{84}
-}
-
-func (x *OTXPassiveDNS) GetAddress() string {
-	if x != nil {
-		return x.Address
-	}
-	return ""
-}
-
-func (x *OTXPassiveDNS) GetHostname() string {
+func (*BulkScanRejected) ProtoMessage() {}
+
+func (x *BulkScanRejected) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[147]
 	if x != nil {
-		return x.Hostname
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *OTXPassiveDNS) GetRecord() string {
+// Deprecated: Use BulkScanRejected.ProtoReflect.Descriptor instead.
+func (*BulkScanRejected) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{147}
+}
+
+func (x *BulkScanRejected) GetInput() string {
 	if x != nil {
-		return x.Record
+		return x.Input
 	}
 	return ""
 }
 
-func (x *OTXPassiveDNS) GetDatetime() *timestamppb.Timestamp {
+func (x *BulkScanRejected) GetReason() string {
 	if x != nil {
-		return x.Datetime
+		return x.Reason
 	}
-	return nil
+	return ""
 }
 
-type OTXSecurityResult struct {
+type GetScanBatchRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	GeneralInfo   *OTXGeneralInfo        `protobuf:"bytes,1,opt,name=general_info,json=generalInfo,proto3" json:"general_info,omitempty"`
-	Malware       []*OTXMalware          `protobuf:"bytes,2,rep,name=malware,proto3" json:"malware,omitempty"`
-	Urls          []*OTXURL              `protobuf:"bytes,3,rep,name=urls,proto3" json:"urls,omitempty"`
-	PassiveDns    []*OTXPassiveDNS       `protobuf:"bytes,4,rep,name=passive_dns,json=passiveDns,proto3" json:"passive_dns,omitempty"`
-	Errors        []string               `protobuf:"bytes,5,rep,name=errors,proto3" json:"errors,omitempty"`
+	BatchId       string                 `protobuf:"bytes,1,opt,name=batch_id,json=batchId,proto3" json:"batch_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *OTXSecurityResult) Reset() {
-	*x = OTXSecurityResult{}
-	mi := &file_proto_service_proto_msgTypes[85]
+func (x *GetScanBatchRequest) Reset() {
+	*x = GetScanBatchRequest{}
+	mi := &file_proto_service_proto_msgTypes[148]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *OTXSecurityResult) String() string {
+func (x *GetScanBatchRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OTXSecurityResult) ProtoMessage() {}
+func (*GetScanBatchRequest) ProtoMessage() {}
 
-func (x *OTXSecurityResult) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_service_proto_msgTypes[85]
+func (x *GetScanBatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[148]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4965,69 +9670,93 @@ func (x *OTXSecurityResult) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OTXSecurityResult.ProtoReflect.Descriptor instead.
-func (*OTXSecurityResult) Descriptor() ([]byte, []int) {
-	return file_proto_service_proto_rawDescGZIP(), []int{85}
+// Deprecated: Use GetScanBatchRequest.ProtoReflect.Descriptor instead.
+func (*GetScanBatchRequest) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{148}
 }
 
-func (x *OTXSecurityResult) GetGeneralInfo() *OTXGeneralInfo {
+func (x *GetScanBatchRequest) GetBatchId() string {
 	if x != nil {
-		return x.GeneralInfo
+		return x.BatchId
 	}
-	return nil
+	return ""
 }
 
-func (x *OTXSecurityResult) GetMalware() []*OTXMalware {
-	if x != nil {
-		return x.Malware
-	}
-	return nil
+type GetScanBatchResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Batch         *ScanBatch             `protobuf:"bytes,1,opt,name=batch,proto3" json:"batch,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *OTXSecurityResult) GetUrls() []*OTXURL {
-	if x != nil {
-		return x.Urls
-	}
-	return nil
+func (x *GetScanBatchResponse) Reset() {
+	*x = GetScanBatchResponse{}
+	mi := &file_proto_service_proto_msgTypes[149]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *OTXSecurityResult) GetPassiveDns() []*OTXPassiveDNS {
+func (x *GetScanBatchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetScanBatchResponse) ProtoMessage() {}
+
+func (x *GetScanBatchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[149]
 	if x != nil {
-		return x.PassiveDns
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *OTXSecurityResult) GetErrors() []string {
+// Deprecated: Use GetScanBatchResponse.ProtoReflect.Descriptor instead.
+func (*GetScanBatchResponse) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{149}
+}
+
+func (x *GetScanBatchResponse) GetBatch() *ScanBatch {
 	if x != nil {
-		return x.Errors
+		return x.Batch
 	}
 	return nil
 }
 
-type ScanWhoisRequest struct {
+type ScanBatch struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Domain        string                 `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
-	DnsScanId     string                 `protobuf:"bytes,2,opt,name=dns_scan_id,json=dnsScanId,proto3" json:"dns_scan_id,omitempty"`
+	BatchId       string                 `protobuf:"bytes,1,opt,name=batch_id,json=batchId,proto3" json:"batch_id,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Queued        int32                  `protobuf:"varint,3,opt,name=queued,proto3" json:"queued,omitempty"`
+	Running       int32                  `protobuf:"varint,4,opt,name=running,proto3" json:"running,omitempty"`
+	Succeeded     int32                  `protobuf:"varint,5,opt,name=succeeded,proto3" json:"succeeded,omitempty"`
+	Failed        int32                  `protobuf:"varint,6,opt,name=failed,proto3" json:"failed,omitempty"`
+	Cancelled     int32                  `protobuf:"varint,7,opt,name=cancelled,proto3" json:"cancelled,omitempty"`
+	Done          bool                   `protobuf:"varint,8,opt,name=done,proto3" json:"done,omitempty"` // every job has finished
+	Summary       *ScanBatchSummary      `protobuf:"bytes,9,opt,name=summary,proto3" json:"summary,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ScanWhoisRequest) Reset() {
-	*x = ScanWhoisRequest{}
-	mi := &file_proto_service_proto_msgTypes[86]
+func (x *ScanBatch) Reset() {
+	*x = ScanBatch{}
+	mi := &file_proto_service_proto_msgTypes[150]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ScanWhoisRequest) String() string {
+func (x *ScanBatch) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ScanWhoisRequest) ProtoMessage() {}
+func (*ScanBatch) ProtoMessage() {}
 
-func (x *ScanWhoisRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_service_proto_msgTypes[86]
+func (x *ScanBatch) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[150]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5038,99 +9767,108 @@ func (x *ScanWhoisRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ScanWhoisRequest.ProtoReflect.Descriptor instead.
-func (*ScanWhoisRequest) Descriptor() ([]byte, []int) {
-	return file_proto_service_proto_rawDescGZIP(), []int{86}
+// Deprecated: Use ScanBatch.ProtoReflect.Descriptor instead.
+func (*ScanBatch) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{150}
 }
 
-func (x *ScanWhoisRequest) GetDomain() string {
+func (x *ScanBatch) GetBatchId() string {
 	if x != nil {
-		return x.Domain
+		return x.BatchId
 	}
 	return ""
 }
 
-func (x *ScanWhoisRequest) GetDnsScanId() string {
+func (x *ScanBatch) GetTotal() int32 {
 	if x != nil {
-		return x.DnsScanId
+		return x.Total
 	}
-	return ""
+	return 0
 }
 
-type ScanWhoisResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ScanId        string                 `protobuf:"bytes,1,opt,name=scan_id,json=scanId,proto3" json:"scan_id,omitempty"`
-	Result        *WhoisSecurityResult   `protobuf:"bytes,2,opt,name=result,proto3" json:"result,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *ScanBatch) GetQueued() int32 {
+	if x != nil {
+		return x.Queued
+	}
+	return 0
 }
 
-func (x *ScanWhoisResponse) Reset() {
-	*x = ScanWhoisResponse{}
-	mi := &file_proto_service_proto_msgTypes[87]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *ScanBatch) GetRunning() int32 {
+	if x != nil {
+		return x.Running
+	}
+	return 0
 }
 
-func (x *ScanWhoisResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *ScanBatch) GetSucceeded() int32 {
+	if x != nil {
+		return x.Succeeded
+	}
+	return 0
 }
 
-func (*ScanWhoisResponse) ProtoMessage() {}
+func (x *ScanBatch) GetFailed() int32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
 
-func (x *ScanWhoisResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_service_proto_msgTypes[87]
+func (x *ScanBatch) GetCancelled() int32 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Cancelled
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use ScanWhoisResponse.ProtoReflect.Descriptor instead.
-func (*ScanWhoisResponse) Descriptor() ([]byte, []int) {
-	return file_proto_service_proto_rawDescGZIP(), []int{87}
+func (x *ScanBatch) GetDone() bool {
+	if x != nil {
+		return x.Done
+	}
+	return false
 }
 
-func (x *ScanWhoisResponse) GetScanId() string {
+func (x *ScanBatch) GetSummary() *ScanBatchSummary {
 	if x != nil {
-		return x.ScanId
+		return x.Summary
 	}
-	return ""
+	return nil
 }
 
-func (x *ScanWhoisResponse) GetResult() *WhoisSecurityResult {
+func (x *ScanBatch) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Result
+		return x.CreatedAt
 	}
 	return nil
 }
 
-type GetWhoisScanResultsByDomainRequest struct {
+type ScanBatchSummary struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Domain        string                 `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
+	Reports       int32                  `protobuf:"varint,1,opt,name=reports,proto3" json:"reports,omitempty"` // jobs that produced a report
+	AverageScore  float64                `protobuf:"fixed64,2,opt,name=average_score,json=averageScore,proto3" json:"average_score,omitempty"`
+	MinScore      int32                  `protobuf:"varint,3,opt,name=min_score,json=minScore,proto3" json:"min_score,omitempty"`
+	MaxScore      int32                  `protobuf:"varint,4,opt,name=max_score,json=maxScore,proto3" json:"max_score,omitempty"`
+	RiskTiers     []*RiskTierCount       `protobuf:"bytes,5,rep,name=risk_tiers,json=riskTiers,proto3" json:"risk_tiers,omitempty"`
+	FailedDomains []*ScanBatchFailure    `protobuf:"bytes,6,rep,name=failed_domains,json=failedDomains,proto3" json:"failed_domains,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetWhoisScanResultsByDomainRequest) Reset() {
-	*x = GetWhoisScanResultsByDomainRequest{}
-	mi := &file_proto_service_proto_msgTypes[88]
+func (x *ScanBatchSummary) Reset() {
+	*x = ScanBatchSummary{}
+	mi := &file_proto_service_proto_msgTypes[151]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetWhoisScanResultsByDomainRequest) String() string {
+func (x *ScanBatchSummary) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetWhoisScanResultsByDomainRequest) ProtoMessage() {}
+func (*ScanBatchSummary) ProtoMessage() {}
 
-func (x *GetWhoisScanResultsByDomainRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_service_proto_msgTypes[88]
+func (x *ScanBatchSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[151]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5141,40 +9879,76 @@ func (x *GetWhoisScanResultsByDomainRequest) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetWhoisScanResultsByDomainRequest.ProtoReflect.Descriptor instead.
-func (*GetWhoisScanResultsByDomainRequest) Descriptor() ([]byte, []int) {
-	return file_proto_service_proto_rawDescGZIP(), []int{88}
+// Deprecated: Use ScanBatchSummary.ProtoReflect.Descriptor instead.
+func (*ScanBatchSummary) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{151}
 }
 
-func (x *GetWhoisScanResultsByDomainRequest) GetDomain() string {
+func (x *ScanBatchSummary) GetReports() int32 {
 	if x != nil {
-		return x.Domain
+		return x.Reports
 	}
-	return ""
+	return 0
 }
 
-type GetWhoisScanResultsByDomainResponse struct {
+func (x *ScanBatchSummary) GetAverageScore() float64 {
+	if x != nil {
+		return x.AverageScore
+	}
+	return 0
+}
+
+func (x *ScanBatchSummary) GetMinScore() int32 {
+	if x != nil {
+		return x.MinScore
+	}
+	return 0
+}
+
+func (x *ScanBatchSummary) GetMaxScore() int32 {
+	if x != nil {
+		return x.MaxScore
+	}
+	return 0
+}
+
+func (x *ScanBatchSummary) GetRiskTiers() []*RiskTierCount {
+	if x != nil {
+		return x.RiskTiers
+	}
+	return nil
+}
+
+func (x *ScanBatchSummary) GetFailedDomains() []*ScanBatchFailure {
+	if x != nil {
+		return x.FailedDomains
+	}
+	return nil
+}
+
+type RiskTierCount struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Results       []*WhoisScanResult     `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	RiskTier      string                 `protobuf:"bytes,1,opt,name=risk_tier,json=riskTier,proto3" json:"risk_tier,omitempty"`
+	Count         int32                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetWhoisScanResultsByDomainResponse) Reset() {
-	*x = GetWhoisScanResultsByDomainResponse{}
-	mi := &file_proto_service_proto_msgTypes[89]
+func (x *RiskTierCount) Reset() {
+	*x = RiskTierCount{}
+	mi := &file_proto_service_proto_msgTypes[152]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetWhoisScanResultsByDomainResponse) String() string {
+func (x *RiskTierCount) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetWhoisScanResultsByDomainResponse) ProtoMessage() {}
+func (*RiskTierCount) ProtoMessage() {}
 
-func (x *GetWhoisScanResultsByDomainResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_service_proto_msgTypes[89]
+func (x *RiskTierCount) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[152]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5185,44 +9959,49 @@ func (x *GetWhoisScanResultsByDomainResponse) ProtoReflect() protoreflect.Messag
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetWhoisScanResultsByDomainResponse.ProtoReflect.Descriptor instead.
-func (*GetWhoisScanResultsByDomainResponse) Descriptor() ([]byte, []int) {
-	return file_proto_service_proto_rawDescGZIP(), []int{89}
+// Deprecated: Use RiskTierCount.ProtoReflect.Descriptor instead.
+func (*RiskTierCount) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{152}
 }
 
-func (x *GetWhoisScanResultsByDomainResponse) GetResults() []*WhoisScanResult {
+func (x *RiskTierCount) GetRiskTier() string {
 	if x != nil {
-		return x.Results
+		return x.RiskTier
 	}
-	return nil
+	return ""
 }
 
-type WhoisScanResult struct {
+func (x *RiskTierCount) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type ScanBatchFailure struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Domain        string                 `protobuf:"bytes,2,opt,name=domain,proto3" json:"domain,omitempty"`
-	DnsScanId     string                 `protobuf:"bytes,3,opt,name=dns_scan_id,json=dnsScanId,proto3" json:"dns_scan_id,omitempty"`
-	Result        *WhoisSecurityResult   `protobuf:"bytes,4,opt,name=result,proto3" json:"result,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Domain        string                 `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
+	JobId         string                 `protobuf:"bytes,2,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	Error         string                 `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *WhoisScanResult) Reset() {
-	*x = WhoisScanResult{}
-	mi := &file_proto_service_proto_msgTypes[90]
+func (x *ScanBatchFailure) Reset() {
+	*x = ScanBatchFailure{}
+	mi := &file_proto_service_proto_msgTypes[153]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *WhoisScanResult) String() string {
+func (x *ScanBatchFailure) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WhoisScanResult) ProtoMessage() {}
+func (*ScanBatchFailure) ProtoMessage() {}
 
-func (x *WhoisScanResult) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_service_proto_msgTypes[90]
+func (x *ScanBatchFailure) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[153]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5233,73 +10012,65 @@ func (x *WhoisScanResult) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WhoisScanResult.ProtoReflect.Descriptor instead.
-func (*WhoisScanResult) Descriptor() ([]byte, []int) {
-	return file_proto_service_proto_rawDescGZIP(), []int{90}
-}
-
-func (x *WhoisScanResult) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
+// Deprecated: Use ScanBatchFailure.ProtoReflect.Descriptor instead.
+func (*ScanBatchFailure) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{153}
 }
 
-func (x *WhoisScanResult) GetDomain() string {
+func (x *ScanBatchFailure) GetDomain() string {
 	if x != nil {
 		return x.Domain
 	}
 	return ""
 }
 
-func (x *WhoisScanResult) GetDnsScanId() string {
+func (x *ScanBatchFailure) GetJobId() string {
 	if x != nil {
-		return x.DnsScanId
+		return x.JobId
 	}
 	return ""
 }
 
-func (x *WhoisScanResult) GetResult() *WhoisSecurityResult {
-	if x != nil {
-		return x.Result
-	}
-	return nil
-}
-
-func (x *WhoisScanResult) GetCreatedAt() *timestamppb.Timestamp {
+func (x *ScanBatchFailure) GetError() string {
 	if x != nil {
-		return x.CreatedAt
+		return x.Error
 	}
-	return nil
+	return ""
 }
 
-type WhoisSecurityResult struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Domain         string                 `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
-	Registrar      string                 `protobuf:"bytes,2,opt,name=registrar,proto3" json:"registrar,omitempty"`
-	CreationDate   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=creation_date,json=creationDate,proto3" json:"creation_date,omitempty"`
-	ExpiryDate     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=expiry_date,json=expiryDate,proto3" json:"expiry_date,omitempty"`
-	RegistrantName string                 `protobuf:"bytes,5,opt,name=registrant_name,json=registrantName,proto3" json:"registrant_name,omitempty"`
-	Errors         []string               `protobuf:"bytes,6,rep,name=errors,proto3" json:"errors,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+// ScanProfile selects which plugins a scan runs, with which options and
+// timeouts. Built-in and config profiles are shared; users may define their
+// own with SaveScanProfile.
+type ScanProfile struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Plugins       []string               `protobuf:"bytes,3,rep,name=plugins,proto3" json:"plugins,omitempty"`                             // empty means every loaded plugin
+	PassiveOnly   bool                   `protobuf:"varint,4,opt,name=passive_only,json=passiveOnly,proto3" json:"passive_only,omitempty"` // drop plugins that contact the target directly
+	Options       []*ScanProfileOption   `protobuf:"bytes,5,rep,name=options,proto3" json:"options,omitempty"`
+	Timeouts      []*ScanProfileTimeout  `protobuf:"bytes,6,rep,name=timeouts,proto3" json:"timeouts,omitempty"`
+	Source        string                 `protobuf:"bytes,7,opt,name=source,proto3" json:"source,omitempty"` // builtin, config or user
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *WhoisSecurityResult) Reset() {
-	*x = WhoisSecurityResult{}
-	mi := &file_proto_service_proto_msgTypes[91]
+func (x *ScanProfile) Reset() {
+	*x = ScanProfile{}
+	mi := &file_proto_service_proto_msgTypes[154]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *WhoisSecurityResult) String() string {
+func (x *ScanProfile) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WhoisSecurityResult) ProtoMessage() {}
+func (*ScanProfile) ProtoMessage() {}
 
-func (x *WhoisSecurityResult) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_service_proto_msgTypes[91]
+func (x *ScanProfile) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[154]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5310,82 +10081,98 @@ func (x *WhoisSecurityResult) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WhoisSecurityResult.ProtoReflect.Descriptor instead.
-func (*WhoisSecurityResult) Descriptor() ([]byte, []int) {
-	return file_proto_service_proto_rawDescGZIP(), []int{91}
+// Deprecated: Use ScanProfile.ProtoReflect.Descriptor instead.
+func (*ScanProfile) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{154}
 }
 
-func (x *WhoisSecurityResult) GetDomain() string {
+func (x *ScanProfile) GetName() string {
 	if x != nil {
-		return x.Domain
+		return x.Name
 	}
 	return ""
 }
 
-func (x *WhoisSecurityResult) GetRegistrar() string {
+func (x *ScanProfile) GetDescription() string {
 	if x != nil {
-		return x.Registrar
+		return x.Description
 	}
 	return ""
 }
 
-func (x *WhoisSecurityResult) GetCreationDate() *timestamppb.Timestamp {
+func (x *ScanProfile) GetPlugins() []string {
 	if x != nil {
-		return x.CreationDate
+		return x.Plugins
 	}
 	return nil
 }
 
-func (x *WhoisSecurityResult) GetExpiryDate() *timestamppb.Timestamp {
+func (x *ScanProfile) GetPassiveOnly() bool {
 	if x != nil {
-		return x.ExpiryDate
+		return x.PassiveOnly
+	}
+	return false
+}
+
+func (x *ScanProfile) GetOptions() []*ScanProfileOption {
+	if x != nil {
+		return x.Options
 	}
 	return nil
 }
 
-func (x *WhoisSecurityResult) GetRegistrantName() string {
+func (x *ScanProfile) GetTimeouts() []*ScanProfileTimeout {
 	if x != nil {
-		return x.RegistrantName
+		return x.Timeouts
+	}
+	return nil
+}
+
+func (x *ScanProfile) GetSource() string {
+	if x != nil {
+		return x.Source
 	}
 	return ""
 }
 
-func (x *WhoisSecurityResult) GetErrors() []string {
+func (x *ScanProfile) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Errors
+		return x.CreatedAt
 	}
 	return nil
 }
 
-type AbuseChIOC struct {
+func (x *ScanProfile) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type ScanProfileOption struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	IocType       string                 `protobuf:"bytes,1,opt,name=ioc_type,json=iocType,proto3" json:"ioc_type,omitempty"`
-	IocValue      string                 `protobuf:"bytes,2,opt,name=ioc_value,json=iocValue,proto3" json:"ioc_value,omitempty"`
-	ThreatType    string                 `protobuf:"bytes,3,opt,name=threat_type,json=threatType,proto3" json:"threat_type,omitempty"`
-	Confidence    float32                `protobuf:"fixed32,4,opt,name=confidence,proto3" json:"confidence,omitempty"`
-	FirstSeen     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=first_seen,json=firstSeen,proto3" json:"first_seen,omitempty"`
-	LastSeen      *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=last_seen,json=lastSeen,proto3" json:"last_seen,omitempty"`
-	MalwareAlias  []string               `protobuf:"bytes,7,rep,name=malware_alias,json=malwareAlias,proto3" json:"malware_alias,omitempty"`
-	Tags          []string               `protobuf:"bytes,8,rep,name=tags,proto3" json:"tags,omitempty"`
+	Plugin        string                 `protobuf:"bytes,1,opt,name=plugin,proto3" json:"plugin,omitempty"`
+	Key           string                 `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	Value         string                 `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AbuseChIOC) Reset() {
-	*x = AbuseChIOC{}
-	mi := &file_proto_service_proto_msgTypes[92]
+func (x *ScanProfileOption) Reset() {
+	*x = ScanProfileOption{}
+	mi := &file_proto_service_proto_msgTypes[155]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AbuseChIOC) String() string {
+func (x *ScanProfileOption) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AbuseChIOC) ProtoMessage() {}
+func (*ScanProfileOption) ProtoMessage() {}
 
-func (x *AbuseChIOC) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_service_proto_msgTypes[92]
+func (x *ScanProfileOption) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[155]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5396,90 +10183,106 @@ func (x *AbuseChIOC) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AbuseChIOC.ProtoReflect.Descriptor instead.
-func (*AbuseChIOC) Descriptor() ([]byte, []int) {
-	return file_proto_service_proto_rawDescGZIP(), []int{92}
+// Deprecated: Use ScanProfileOption.ProtoReflect.Descriptor instead.
+func (*ScanProfileOption) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{155}
 }
 
-func (x *AbuseChIOC) GetIocType() string {
+func (x *ScanProfileOption) GetPlugin() string {
 	if x != nil {
-		return x.IocType
+		return x.Plugin
 	}
 	return ""
 }
 
-func (x *AbuseChIOC) GetIocValue() string {
+func (x *ScanProfileOption) GetKey() string {
 	if x != nil {
-		return x.IocValue
+		return x.Key
 	}
 	return ""
 }
 
-func (x *AbuseChIOC) GetThreatType() string {
+func (x *ScanProfileOption) GetValue() string {
 	if x != nil {
-		return x.ThreatType
+		return x.Value
 	}
 	return ""
 }
 
-func (x *AbuseChIOC) GetConfidence() float32 {
-	if x != nil {
-		return x.Confidence
-	}
-	return 0
+type ScanProfileTimeout struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Plugin        string                 `protobuf:"bytes,1,opt,name=plugin,proto3" json:"plugin,omitempty"`
+	Seconds       int32                  `protobuf:"varint,2,opt,name=seconds,proto3" json:"seconds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AbuseChIOC) GetFirstSeen() *timestamppb.Timestamp {
-	if x != nil {
-		return x.FirstSeen
-	}
-	return nil
+func (x *ScanProfileTimeout) Reset() {
+	*x = ScanProfileTimeout{}
+	mi := &file_proto_service_proto_msgTypes[156]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *AbuseChIOC) GetLastSeen() *timestamppb.Timestamp {
+func (x *ScanProfileTimeout) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScanProfileTimeout) ProtoMessage() {}
+
+func (x *ScanProfileTimeout) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[156]
 	if x != nil {
-		return x.LastSeen
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *AbuseChIOC) GetMalwareAlias() []string {
+// Deprecated: Use ScanProfileTimeout.ProtoReflect.Descriptor instead.
+func (*ScanProfileTimeout) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{156}
+}
+
+func (x *ScanProfileTimeout) GetPlugin() string {
 	if x != nil {
-		return x.MalwareAlias
+		return x.Plugin
 	}
-	return nil
+	return ""
 }
 
-func (x *AbuseChIOC) GetTags() []string {
+func (x *ScanProfileTimeout) GetSeconds() int32 {
 	if x != nil {
-		return x.Tags
+		return x.Seconds
 	}
-	return nil
+	return 0
 }
 
-type AbuseChSecurityResult struct {
+type SaveScanProfileRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Iocs          []*AbuseChIOC          `protobuf:"bytes,1,rep,name=iocs,proto3" json:"iocs,omitempty"`
-	Errors        []string               `protobuf:"bytes,2,rep,name=errors,proto3" json:"errors,omitempty"`
+	Profile       *ScanProfile           `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"` // created, or replaced if the caller already has one by this name
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AbuseChSecurityResult) Reset() {
-	*x = AbuseChSecurityResult{}
-	mi := &file_proto_service_proto_msgTypes[93]
+func (x *SaveScanProfileRequest) Reset() {
+	*x = SaveScanProfileRequest{}
+	mi := &file_proto_service_proto_msgTypes[157]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AbuseChSecurityResult) String() string {
+func (x *SaveScanProfileRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AbuseChSecurityResult) ProtoMessage() {}
+func (*SaveScanProfileRequest) ProtoMessage() {}
 
-func (x *AbuseChSecurityResult) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_service_proto_msgTypes[93]
+func (x *SaveScanProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[157]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5490,48 +10293,40 @@ func (x *AbuseChSecurityResult) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AbuseChSecurityResult.ProtoReflect.Descriptor instead.
-func (*AbuseChSecurityResult) Descriptor() ([]byte, []int) {
-	return file_proto_service_proto_rawDescGZIP(), []int{93}
-}
-
-func (x *AbuseChSecurityResult) GetIocs() []*AbuseChIOC {
-	if x != nil {
-		return x.Iocs
-	}
-	return nil
+// Deprecated: Use SaveScanProfileRequest.ProtoReflect.Descriptor instead.
+func (*SaveScanProfileRequest) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{157}
 }
 
-func (x *AbuseChSecurityResult) GetErrors() []string {
+func (x *SaveScanProfileRequest) GetProfile() *ScanProfile {
 	if x != nil {
-		return x.Errors
+		return x.Profile
 	}
 	return nil
 }
 
-type ScanAbuseChRequest struct {
+type SaveScanProfileResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Domain        string                 `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
-	DnsScanId     string                 `protobuf:"bytes,2,opt,name=dns_scan_id,json=dnsScanId,proto3" json:"dns_scan_id,omitempty"`
+	Profile       *ScanProfile           `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ScanAbuseChRequest) Reset() {
-	*x = ScanAbuseChRequest{}
-	mi := &file_proto_service_proto_msgTypes[94]
+func (x *SaveScanProfileResponse) Reset() {
+	*x = SaveScanProfileResponse{}
+	mi := &file_proto_service_proto_msgTypes[158]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ScanAbuseChRequest) String() string {
+func (x *SaveScanProfileResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ScanAbuseChRequest) ProtoMessage() {}
+func (*SaveScanProfileResponse) ProtoMessage() {}
 
-func (x *ScanAbuseChRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_service_proto_msgTypes[94]
+func (x *SaveScanProfileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[158]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5542,48 +10337,39 @@ func (x *ScanAbuseChRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ScanAbuseChRequest.ProtoReflect.Descriptor instead.
-func (*ScanAbuseChRequest) Descriptor() ([]byte, []int) {
-	return file_proto_service_proto_rawDescGZIP(), []int{94}
-}
-
-func (x *ScanAbuseChRequest) GetDomain() string {
-	if x != nil {
-		return x.Domain
-	}
-	return ""
+// Deprecated: Use SaveScanProfileResponse.ProtoReflect.Descriptor instead.
+func (*SaveScanProfileResponse) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{158}
 }
 
-func (x *ScanAbuseChRequest) GetDnsScanId() string {
+func (x *SaveScanProfileResponse) GetProfile() *ScanProfile {
 	if x != nil {
-		return x.DnsScanId
+		return x.Profile
 	}
-	return ""
+	return nil
 }
 
-type ScanAbuseChResponse struct {
+type ListScanProfilesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ScanId        string                 `protobuf:"bytes,1,opt,name=scan_id,json=scanId,proto3" json:"scan_id,omitempty"`
-	Result        *AbuseChSecurityResult `protobuf:"bytes,2,opt,name=result,proto3" json:"result,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ScanAbuseChResponse) Reset() {
-	*x = ScanAbuseChResponse{}
-	mi := &file_proto_service_proto_msgTypes[95]
+func (x *ListScanProfilesRequest) Reset() {
+	*x = ListScanProfilesRequest{}
+	mi := &file_proto_service_proto_msgTypes[159]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ScanAbuseChResponse) String() string {
+func (x *ListScanProfilesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ScanAbuseChResponse) ProtoMessage() {}
+func (*ListScanProfilesRequest) ProtoMessage() {}
 
-func (x *ScanAbuseChResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_service_proto_msgTypes[95]
+func (x *ListScanProfilesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[159]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5594,47 +10380,33 @@ func (x *ScanAbuseChResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ScanAbuseChResponse.ProtoReflect.Descriptor instead.
-func (*ScanAbuseChResponse) Descriptor() ([]byte, []int) {
-	return file_proto_service_proto_rawDescGZIP(), []int{95}
-}
-
-func (x *ScanAbuseChResponse) GetScanId() string {
-	if x != nil {
-		return x.ScanId
-	}
-	return ""
-}
-
-func (x *ScanAbuseChResponse) GetResult() *AbuseChSecurityResult {
-	if x != nil {
-		return x.Result
-	}
-	return nil
+// Deprecated: Use ListScanProfilesRequest.ProtoReflect.Descriptor instead.
+func (*ListScanProfilesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{159}
 }
 
-type GetAbuseChScanResultsByDomainRequest struct {
+type ListScanProfilesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Domain        string                 `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
+	Profiles      []*ScanProfile         `protobuf:"bytes,1,rep,name=profiles,proto3" json:"profiles,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetAbuseChScanResultsByDomainRequest) Reset() {
-	*x = GetAbuseChScanResultsByDomainRequest{}
-	mi := &file_proto_service_proto_msgTypes[96]
+func (x *ListScanProfilesResponse) Reset() {
+	*x = ListScanProfilesResponse{}
+	mi := &file_proto_service_proto_msgTypes[160]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAbuseChScanResultsByDomainRequest) String() string {
+func (x *ListScanProfilesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAbuseChScanResultsByDomainRequest) ProtoMessage() {}
+func (*ListScanProfilesResponse) ProtoMessage() {}
 
-func (x *GetAbuseChScanResultsByDomainRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_service_proto_msgTypes[96]
+func (x *ListScanProfilesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[160]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5645,40 +10417,40 @@ func (x *GetAbuseChScanResultsByDomainRequest) ProtoReflect() protoreflect.Messa
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAbuseChScanResultsByDomainRequest.ProtoReflect.Descriptor instead.
-func (*GetAbuseChScanResultsByDomainRequest) Descriptor() ([]byte, []int) {
-	return file_proto_service_proto_rawDescGZIP(), []int{96}
+// Deprecated: Use ListScanProfilesResponse.ProtoReflect.Descriptor instead.
+func (*ListScanProfilesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{160}
 }
 
-func (x *GetAbuseChScanResultsByDomainRequest) GetDomain() string {
+func (x *ListScanProfilesResponse) GetProfiles() []*ScanProfile {
 	if x != nil {
-		return x.Domain
+		return x.Profiles
 	}
-	return ""
+	return nil
 }
 
-type GetAbuseChScanResultsByDomainResponse struct {
+type DeleteScanProfileRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Results       []*AbuseChScanResult   `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetAbuseChScanResultsByDomainResponse) Reset() {
-	*x = GetAbuseChScanResultsByDomainResponse{}
-	mi := &file_proto_service_proto_msgTypes[97]
+func (x *DeleteScanProfileRequest) Reset() {
+	*x = DeleteScanProfileRequest{}
+	mi := &file_proto_service_proto_msgTypes[161]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAbuseChScanResultsByDomainResponse) String() string {
+func (x *DeleteScanProfileRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAbuseChScanResultsByDomainResponse) ProtoMessage() {}
+func (*DeleteScanProfileRequest) ProtoMessage() {}
 
-func (x *GetAbuseChScanResultsByDomainResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_service_proto_msgTypes[97]
+func (x *DeleteScanProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[161]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5689,44 +10461,39 @@ func (x *GetAbuseChScanResultsByDomainResponse) ProtoReflect() protoreflect.Mess
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAbuseChScanResultsByDomainResponse.ProtoReflect.Descriptor instead.
-func (*GetAbuseChScanResultsByDomainResponse) Descriptor() ([]byte, []int) {
-	return file_proto_service_proto_rawDescGZIP(), []int{97}
+// Deprecated: Use DeleteScanProfileRequest.ProtoReflect.Descriptor instead.
+func (*DeleteScanProfileRequest) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{161}
 }
 
-func (x *GetAbuseChScanResultsByDomainResponse) GetResults() []*AbuseChScanResult {
+func (x *DeleteScanProfileRequest) GetName() string {
 	if x != nil {
-		return x.Results
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-type AbuseChScanResult struct {
+type DeleteScanProfileResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Domain        string                 `protobuf:"bytes,2,opt,name=domain,proto3" json:"domain,omitempty"`
-	DnsScanId     string                 `protobuf:"bytes,3,opt,name=dns_scan_id,json=dnsScanId,proto3" json:"dns_scan_id,omitempty"`
-	Result        *AbuseChSecurityResult `protobuf:"bytes,4,opt,name=result,proto3" json:"result,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AbuseChScanResult) Reset() {
-	*x = AbuseChScanResult{}
-	mi := &file_proto_service_proto_msgTypes[98]
+func (x *DeleteScanProfileResponse) Reset() {
+	*x = DeleteScanProfileResponse{}
+	mi := &file_proto_service_proto_msgTypes[162]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AbuseChScanResult) String() string {
+func (x *DeleteScanProfileResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AbuseChScanResult) ProtoMessage() {}
+func (*DeleteScanProfileResponse) ProtoMessage() {}
 
-func (x *AbuseChScanResult) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_service_proto_msgTypes[98]
+func (x *DeleteScanProfileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[162]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5737,69 +10504,77 @@ func (x *AbuseChScanResult) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AbuseChScanResult.ProtoReflect.Descriptor instead.
-func (*AbuseChScanResult) Descriptor() ([]byte, []int) {
-	return file_proto_service_proto_rawDescGZIP(), []int{98}
+// Deprecated: Use DeleteScanProfileResponse.ProtoReflect.Descriptor instead.
+func (*DeleteScanProfileResponse) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{162}
 }
 
-func (x *AbuseChScanResult) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
+type GetProviderUsageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Provider      string                 `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"` // optional; every provider with a budget or calls this month when empty
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AbuseChScanResult) GetDomain() string {
-	if x != nil {
-		return x.Domain
-	}
-	return ""
+func (x *GetProviderUsageRequest) Reset() {
+	*x = GetProviderUsageRequest{}
+	mi := &file_proto_service_proto_msgTypes[163]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *AbuseChScanResult) GetDnsScanId() string {
-	if x != nil {
-		return x.DnsScanId
-	}
-	return ""
+func (x *GetProviderUsageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *AbuseChScanResult) GetResult() *AbuseChSecurityResult {
+func (*GetProviderUsageRequest) ProtoMessage() {}
+
+func (x *GetProviderUsageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[163]
 	if x != nil {
-		return x.Result
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *AbuseChScanResult) GetCreatedAt() *timestamppb.Timestamp {
+// Deprecated: Use GetProviderUsageRequest.ProtoReflect.Descriptor instead.
+func (*GetProviderUsageRequest) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{163}
+}
+
+func (x *GetProviderUsageRequest) GetProvider() string {
 	if x != nil {
-		return x.CreatedAt
+		return x.Provider
 	}
-	return nil
+	return ""
 }
 
-type ScanISCRequest struct {
+type GetProviderUsageResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Domain        string                 `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
-	DnsScanId     string                 `protobuf:"bytes,2,opt,name=dns_scan_id,json=dnsScanId,proto3" json:"dns_scan_id,omitempty"`
+	Providers     []*ProviderUsage       `protobuf:"bytes,1,rep,name=providers,proto3" json:"providers,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ScanISCRequest) Reset() {
-	*x = ScanISCRequest{}
-	mi := &file_proto_service_proto_msgTypes[99]
+func (x *GetProviderUsageResponse) Reset() {
+	*x = GetProviderUsageResponse{}
+	mi := &file_proto_service_proto_msgTypes[164]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ScanISCRequest) String() string {
+func (x *GetProviderUsageResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ScanISCRequest) ProtoMessage() {}
+func (*GetProviderUsageResponse) ProtoMessage() {}
 
-func (x *ScanISCRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_service_proto_msgTypes[99]
+func (x *GetProviderUsageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[164]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5810,48 +10585,50 @@ func (x *ScanISCRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ScanISCRequest.ProtoReflect.Descriptor instead.
-func (*ScanISCRequest) Descriptor() ([]byte, []int) {
-	return file_proto_service_proto_rawDescGZIP(), []int{99}
-}
-
-func (x *ScanISCRequest) GetDomain() string {
-	if x != nil {
-		return x.Domain
-	}
-	return ""
+// Deprecated: Use GetProviderUsageResponse.ProtoReflect.Descriptor instead.
+func (*GetProviderUsageResponse) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{164}
 }
 
-func (x *ScanISCRequest) GetDnsScanId() string {
+func (x *GetProviderUsageResponse) GetProviders() []*ProviderUsage {
 	if x != nil {
-		return x.DnsScanId
+		return x.Providers
 	}
-	return ""
-}
-
-type ScanISCResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ScanId        string                 `protobuf:"bytes,1,opt,name=scan_id,json=scanId,proto3" json:"scan_id,omitempty"`
-	Result        *ISCSecurityResult     `protobuf:"bytes,2,opt,name=result,proto3" json:"result,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	return nil
 }
 
-func (x *ScanISCResponse) Reset() {
-	*x = ScanISCResponse{}
-	mi := &file_proto_service_proto_msgTypes[100]
+// ProviderUsage is an intelligence provider's call count against its budget.
+// Days and months are UTC; limits of 0 are unlimited and their remaining
+// count is -1.
+type ProviderUsage struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Provider         string                 `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	DailyCalls       int32                  `protobuf:"varint,2,opt,name=daily_calls,json=dailyCalls,proto3" json:"daily_calls,omitempty"`
+	DailyLimit       int32                  `protobuf:"varint,3,opt,name=daily_limit,json=dailyLimit,proto3" json:"daily_limit,omitempty"`
+	DailyRemaining   int32                  `protobuf:"varint,4,opt,name=daily_remaining,json=dailyRemaining,proto3" json:"daily_remaining,omitempty"`
+	MonthlyCalls     int32                  `protobuf:"varint,5,opt,name=monthly_calls,json=monthlyCalls,proto3" json:"monthly_calls,omitempty"`
+	MonthlyLimit     int32                  `protobuf:"varint,6,opt,name=monthly_limit,json=monthlyLimit,proto3" json:"monthly_limit,omitempty"`
+	MonthlyRemaining int32                  `protobuf:"varint,7,opt,name=monthly_remaining,json=monthlyRemaining,proto3" json:"monthly_remaining,omitempty"`
+	Exhausted        bool                   `protobuf:"varint,8,opt,name=exhausted,proto3" json:"exhausted,omitempty"` // scans using the provider are refused until the budget resets
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ProviderUsage) Reset() {
+	*x = ProviderUsage{}
+	mi := &file_proto_service_proto_msgTypes[165]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ScanISCResponse) String() string {
+func (x *ProviderUsage) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ScanISCResponse) ProtoMessage() {}
+func (*ProviderUsage) ProtoMessage() {}
 
-func (x *ScanISCResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_service_proto_msgTypes[100]
+func (x *ProviderUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[165]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5862,47 +10639,89 @@ func (x *ScanISCResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ScanISCResponse.ProtoReflect.Descriptor instead.
-func (*ScanISCResponse) Descriptor() ([]byte, []int) {
-	return file_proto_service_proto_rawDescGZIP(), []int{100}
+// Deprecated: Use ProviderUsage.ProtoReflect.Descriptor instead.
+func (*ProviderUsage) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{165}
 }
 
-func (x *ScanISCResponse) GetScanId() string {
+func (x *ProviderUsage) GetProvider() string {
 	if x != nil {
-		return x.ScanId
+		return x.Provider
 	}
 	return ""
 }
 
-func (x *ScanISCResponse) GetResult() *ISCSecurityResult {
+func (x *ProviderUsage) GetDailyCalls() int32 {
 	if x != nil {
-		return x.Result
+		return x.DailyCalls
 	}
-	return nil
+	return 0
 }
 
-type GetISCScanResultsByDomainRequest struct {
+func (x *ProviderUsage) GetDailyLimit() int32 {
+	if x != nil {
+		return x.DailyLimit
+	}
+	return 0
+}
+
+func (x *ProviderUsage) GetDailyRemaining() int32 {
+	if x != nil {
+		return x.DailyRemaining
+	}
+	return 0
+}
+
+func (x *ProviderUsage) GetMonthlyCalls() int32 {
+	if x != nil {
+		return x.MonthlyCalls
+	}
+	return 0
+}
+
+func (x *ProviderUsage) GetMonthlyLimit() int32 {
+	if x != nil {
+		return x.MonthlyLimit
+	}
+	return 0
+}
+
+func (x *ProviderUsage) GetMonthlyRemaining() int32 {
+	if x != nil {
+		return x.MonthlyRemaining
+	}
+	return 0
+}
+
+func (x *ProviderUsage) GetExhausted() bool {
+	if x != nil {
+		return x.Exhausted
+	}
+	return false
+}
+
+type GetProviderHealthRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Domain        string                 `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
+	Provider      string                 `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"` // optional; every provider called since the server started when empty
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetISCScanResultsByDomainRequest) Reset() {
-	*x = GetISCScanResultsByDomainRequest{}
-	mi := &file_proto_service_proto_msgTypes[101]
+func (x *GetProviderHealthRequest) Reset() {
+	*x = GetProviderHealthRequest{}
+	mi := &file_proto_service_proto_msgTypes[166]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetISCScanResultsByDomainRequest) String() string {
+func (x *GetProviderHealthRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetISCScanResultsByDomainRequest) ProtoMessage() {}
+func (*GetProviderHealthRequest) ProtoMessage() {}
 
-func (x *GetISCScanResultsByDomainRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_service_proto_msgTypes[101]
+func (x *GetProviderHealthRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[166]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5913,40 +10732,40 @@ func (x *GetISCScanResultsByDomainRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetISCScanResultsByDomainRequest.ProtoReflect.Descriptor instead.
-func (*GetISCScanResultsByDomainRequest) Descriptor() ([]byte, []int) {
-	return file_proto_service_proto_rawDescGZIP(), []int{101}
+// Deprecated: Use GetProviderHealthRequest.ProtoReflect.Descriptor instead.
+func (*GetProviderHealthRequest) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{166}
 }
 
-func (x *GetISCScanResultsByDomainRequest) GetDomain() string {
+func (x *GetProviderHealthRequest) GetProvider() string {
 	if x != nil {
-		return x.Domain
+		return x.Provider
 	}
 	return ""
 }
 
-type GetISCScanResultsByDomainResponse struct {
+type GetProviderHealthResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Results       []*ISCScanResult       `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	Providers     []*ProviderHealth      `protobuf:"bytes,1,rep,name=providers,proto3" json:"providers,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetISCScanResultsByDomainResponse) Reset() {
-	*x = GetISCScanResultsByDomainResponse{}
-	mi := &file_proto_service_proto_msgTypes[102]
+func (x *GetProviderHealthResponse) Reset() {
+	*x = GetProviderHealthResponse{}
+	mi := &file_proto_service_proto_msgTypes[167]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetISCScanResultsByDomainResponse) String() string {
+func (x *GetProviderHealthResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetISCScanResultsByDomainResponse) ProtoMessage() {}
+func (*GetProviderHealthResponse) ProtoMessage() {}
 
-func (x *GetISCScanResultsByDomainResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_service_proto_msgTypes[102]
+func (x *GetProviderHealthResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[167]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5957,44 +10776,47 @@ func (x *GetISCScanResultsByDomainResponse) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetISCScanResultsByDomainResponse.ProtoReflect.Descriptor instead.
-func (*GetISCScanResultsByDomainResponse) Descriptor() ([]byte, []int) {
-	return file_proto_service_proto_rawDescGZIP(), []int{102}
+// Deprecated: Use GetProviderHealthResponse.ProtoReflect.Descriptor instead.
+func (*GetProviderHealthResponse) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{167}
 }
 
-func (x *GetISCScanResultsByDomainResponse) GetResults() []*ISCScanResult {
+func (x *GetProviderHealthResponse) GetProviders() []*ProviderHealth {
 	if x != nil {
-		return x.Results
+		return x.Providers
 	}
 	return nil
 }
 
-type ISCScanResult struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Domain        string                 `protobuf:"bytes,2,opt,name=domain,proto3" json:"domain,omitempty"`
-	DnsScanId     string                 `protobuf:"bytes,3,opt,name=dns_scan_id,json=dnsScanId,proto3" json:"dns_scan_id,omitempty"`
-	Result        *ISCSecurityResult     `protobuf:"bytes,4,opt,name=result,proto3" json:"result,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// ProviderHealth is the state of an intelligence provider's circuit breaker.
+// Scans skip a provider whose breaker is open.
+type ProviderHealth struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Provider            string                 `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	State               string                 `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"` // closed, open or half_open
+	ConsecutiveFailures int32                  `protobuf:"varint,3,opt,name=consecutive_failures,json=consecutiveFailures,proto3" json:"consecutive_failures,omitempty"`
+	OpenedAt            *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=opened_at,json=openedAt,proto3" json:"opened_at,omitempty"` // unset while closed
+	RetryAt             *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=retry_at,json=retryAt,proto3" json:"retry_at,omitempty"`    // when a trial request is let through; unset while closed
+	LastError           string                 `protobuf:"bytes,6,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
 }
 
-func (x *ISCScanResult) Reset() {
-	*x = ISCScanResult{}
-	mi := &file_proto_service_proto_msgTypes[103]
+func (x *ProviderHealth) Reset() {
+	*x = ProviderHealth{}
+	mi := &file_proto_service_proto_msgTypes[168]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ISCScanResult) String() string {
+func (x *ProviderHealth) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ISCScanResult) ProtoMessage() {}
+func (*ProviderHealth) ProtoMessage() {}
 
-func (x *ISCScanResult) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_service_proto_msgTypes[103]
+func (x *ProviderHealth) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[168]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6005,71 +10827,74 @@ func (x *ISCScanResult) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ISCScanResult.ProtoReflect.Descriptor instead.
-func (*ISCScanResult) Descriptor() ([]byte, []int) {
-	return file_proto_service_proto_rawDescGZIP(), []int{103}
+// Deprecated: Use ProviderHealth.ProtoReflect.Descriptor instead.
+func (*ProviderHealth) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{168}
 }
 
-func (x *ISCScanResult) GetId() string {
+func (x *ProviderHealth) GetProvider() string {
 	if x != nil {
-		return x.Id
+		return x.Provider
 	}
 	return ""
 }
 
-func (x *ISCScanResult) GetDomain() string {
+func (x *ProviderHealth) GetState() string {
 	if x != nil {
-		return x.Domain
+		return x.State
 	}
 	return ""
 }
 
-func (x *ISCScanResult) GetDnsScanId() string {
+func (x *ProviderHealth) GetConsecutiveFailures() int32 {
 	if x != nil {
-		return x.DnsScanId
+		return x.ConsecutiveFailures
 	}
-	return ""
+	return 0
 }
 
-func (x *ISCScanResult) GetResult() *ISCSecurityResult {
+func (x *ProviderHealth) GetOpenedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Result
+		return x.OpenedAt
 	}
 	return nil
 }
 
-func (x *ISCScanResult) GetCreatedAt() *timestamppb.Timestamp {
+func (x *ProviderHealth) GetRetryAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.CreatedAt
+		return x.RetryAt
 	}
 	return nil
 }
 
-type ISCIncident struct {
+func (x *ProviderHealth) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+type DescribeConfigRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Date          *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`
-	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	Severity      string                 `protobuf:"bytes,4,opt,name=severity,proto3" json:"severity,omitempty"` // e.g., "Low", "Medium", "High"
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ISCIncident) Reset() {
-	*x = ISCIncident{}
-	mi := &file_proto_service_proto_msgTypes[104]
+func (x *DescribeConfigRequest) Reset() {
+	*x = DescribeConfigRequest{}
+	mi := &file_proto_service_proto_msgTypes[169]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ISCIncident) String() string {
+func (x *DescribeConfigRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ISCIncident) ProtoMessage() {}
+func (*DescribeConfigRequest) ProtoMessage() {}
 
-func (x *ISCIncident) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_service_proto_msgTypes[104]
+func (x *DescribeConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[169]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6080,63 +10905,40 @@ func (x *ISCIncident) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ISCIncident.ProtoReflect.Descriptor instead.
-func (*ISCIncident) Descriptor() ([]byte, []int) {
-	return file_proto_service_proto_rawDescGZIP(), []int{104}
-}
-
-func (x *ISCIncident) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (x *ISCIncident) GetDate() *timestamppb.Timestamp {
-	if x != nil {
-		return x.Date
-	}
-	return nil
-}
-
-func (x *ISCIncident) GetDescription() string {
-	if x != nil {
-		return x.Description
-	}
-	return ""
-}
-
-func (x *ISCIncident) GetSeverity() string {
-	if x != nil {
-		return x.Severity
-	}
-	return ""
+// Deprecated: Use DescribeConfigRequest.ProtoReflect.Descriptor instead.
+func (*DescribeConfigRequest) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{169}
 }
 
-type ISCSecurityResult struct {
+// DescribeConfigResponse identifies the config the server is running with.
+// The server reloads config.yaml when it changes or on SIGHUP.
+type DescribeConfigResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Incidents     []*ISCIncident         `protobuf:"bytes,1,rep,name=incidents,proto3" json:"incidents,omitempty"`
-	OverallRisk   string                 `protobuf:"bytes,2,opt,name=overall_risk,json=overallRisk,proto3" json:"overall_risk,omitempty"`
-	Errors        []string               `protobuf:"bytes,3,rep,name=errors,proto3" json:"errors,omitempty"`
+	Version       int32                  `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`  // 1 for the config loaded at startup, incremented by each reload
+	Checksum      string                 `protobuf:"bytes,2,opt,name=checksum,proto3" json:"checksum,omitempty"` // SHA-256 of config.yaml as loaded
+	LoadedAt      *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=loaded_at,json=loadedAt,proto3" json:"loaded_at,omitempty"`
+	Path          string                 `protobuf:"bytes,4,opt,name=path,proto3" json:"path,omitempty"`
+	LastError     string                 `protobuf:"bytes,5,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"` // why the most recent reload was refused, if one was
+	FailedAt      *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=failed_at,json=failedAt,proto3" json:"failed_at,omitempty"`    // unset unless a reload was refused
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ISCSecurityResult) Reset() {
-	*x = ISCSecurityResult{}
-	mi := &file_proto_service_proto_msgTypes[105]
+func (x *DescribeConfigResponse) Reset() {
+	*x = DescribeConfigResponse{}
+	mi := &file_proto_service_proto_msgTypes[170]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ISCSecurityResult) String() string {
+func (x *DescribeConfigResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ISCSecurityResult) ProtoMessage() {}
+func (*DescribeConfigResponse) ProtoMessage() {}
 
-func (x *ISCSecurityResult) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_service_proto_msgTypes[105]
+func (x *DescribeConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_service_proto_msgTypes[170]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6147,28 +10949,49 @@ func (x *ISCSecurityResult) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ISCSecurityResult.ProtoReflect.Descriptor instead.
-func (*ISCSecurityResult) Descriptor() ([]byte, []int) {
-	return file_proto_service_proto_rawDescGZIP(), []int{105}
+// Deprecated: Use DescribeConfigResponse.ProtoReflect.Descriptor instead.
+func (*DescribeConfigResponse) Descriptor() ([]byte, []int) {
+	return file_proto_service_proto_rawDescGZIP(), []int{170}
 }
 
-func (x *ISCSecurityResult) GetIncidents() []*ISCIncident {
+func (x *DescribeConfigResponse) GetVersion() int32 {
 	if x != nil {
-		return x.Incidents
+		return x.Version
+	}
+	return 0
+}
+
+func (x *DescribeConfigResponse) GetChecksum() string {
+	if x != nil {
+		return x.Checksum
+	}
+	return ""
+}
+
+func (x *DescribeConfigResponse) GetLoadedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LoadedAt
 	}
 	return nil
 }
 
-func (x *ISCSecurityResult) GetOverallRisk() string {
+func (x *DescribeConfigResponse) GetPath() string {
 	if x != nil {
-		return x.OverallRisk
+		return x.Path
 	}
 	return ""
 }
 
-func (x *ISCSecurityResult) GetErrors() []string {
+func (x *DescribeConfigResponse) GetLastError() string {
 	if x != nil {
-		return x.Errors
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *DescribeConfigResponse) GetFailedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FailedAt
 	}
 	return nil
 }
@@ -6177,18 +11000,50 @@ var File_proto_service_proto protoreflect.FileDescriptor
 
 const file_proto_service_proto_rawDesc = "" +
 	"\n" +
-	"\x13proto/service.proto\x12\aservice\x1a\x1fgoogle/protobuf/timestamp.proto\"/\n" +
+	"\x13proto/service.proto\x12\aservice\x1a\x1fgoogle/protobuf/timestamp.proto\"c\n" +
 	"\x15GenerateReportRequest\x12\x16\n" +
-	"\x06domain\x18\x01 \x01(\tR\x06domain\"\xc3\x01\n" +
+	"\x06domain\x18\x01 \x01(\tR\x06domain\x12\x18\n" +
+	"\aprofile\x18\x02 \x01(\tR\aprofile\x12\x18\n" +
+	"\arefresh\x18\x03 \x01(\bR\arefresh\"\xfd\x01\n" +
 	"\x16GenerateReportResponse\x12\x1b\n" +
 	"\treport_id\x18\x01 \x01(\tR\breportId\x12\x1e\n" +
 	"\vdns_scan_id\x18\x02 \x01(\tR\tdnsScanId\x12\x14\n" +
 	"\x05score\x18\x03 \x01(\x05R\x05score\x12\x1b\n" +
 	"\trisk_tier\x18\x04 \x01(\tR\briskTier\x129\n" +
 	"\n" +
-	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\",\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x1e\n" +
+	"\vscan_run_id\x18\x06 \x01(\tR\tscanRunId\x12\x18\n" +
+	"\aprofile\x18\a \x01(\tR\aprofile\"\xd9\a\n" +
+	"\x13ReportProgressEvent\x12\x16\n" +
+	"\x06plugin\x18\x01 \x01(\tR\x06plugin\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x1b\n" +
+	"\tresult_id\x18\x03 \x01(\tR\bresultId\x12\x16\n" +
+	"\x06errors\x18\x04 \x03(\tR\x06errors\x12\x1b\n" +
+	"\ttimed_out\x18\x05 \x01(\bR\btimedOut\x12\x14\n" +
+	"\x05score\x18\x06 \x01(\x05R\x05score\x12\x1b\n" +
+	"\trisk_tier\x18\a \x01(\tR\briskTier\x12\x12\n" +
+	"\x04done\x18\b \x01(\bR\x04done\x12\x1b\n" +
+	"\treport_id\x18\t \x01(\tR\breportId\x12\x1e\n" +
+	"\vdns_scan_id\x18\n" +
+	" \x01(\tR\tdnsScanId\x129\n" +
+	"\n" +
+	"dns_result\x18\v \x01(\v2\x1a.service.DNSSecurityResultR\tdnsResult\x129\n" +
+	"\n" +
+	"tls_result\x18\f \x01(\v2\x1a.service.TLSSecurityResultR\ttlsResult\x12?\n" +
+	"\fcrtsh_result\x18\r \x01(\v2\x1c.service.CrtShSecurityResultR\vcrtshResult\x12?\n" +
+	"\fchaos_result\x18\x0e \x01(\v2\x1c.service.ChaosSecurityResultR\vchaosResult\x12B\n" +
+	"\rshodan_result\x18\x0f \x01(\v2\x1d.service.ShodanSecurityResultR\fshodanResult\x129\n" +
+	"\n" +
+	"otx_result\x18\x10 \x01(\v2\x1a.service.OTXSecurityResultR\totxResult\x12?\n" +
+	"\fwhois_result\x18\x11 \x01(\v2\x1c.service.WhoisSecurityResultR\vwhoisResult\x12E\n" +
+	"\x0eabusech_result\x18\x12 \x01(\v2\x1e.service.AbuseChSecurityResultR\rabusechResult\x129\n" +
+	"\n" +
+	"isc_result\x18\x13 \x01(\v2\x1a.service.ISCSecurityResultR\tiscResult\x12\x1e\n" +
+	"\vscan_run_id\x18\x14 \x01(\tR\tscanRunId\x12\x18\n" +
+	"\aprofile\x18\x15 \x01(\tR\aprofile\x12H\n" +
+	"\x0fexternal_result\x18\x16 \x01(\v2\x1f.service.ExternalSecurityResultR\x0eexternalResult\",\n" +
 	"\x12ListReportsRequest\x12\x16\n" +
-	"\x06domain\x18\x01 \x01(\tR\x06domain\"\xcb\x01\n" +
+	"\x06domain\x18\x01 \x01(\tR\x06domain\"\x85\x02\n" +
 	"\x06Report\x12\x1b\n" +
 	"\treport_id\x18\x01 \x01(\tR\breportId\x12\x16\n" +
 	"\x06domain\x18\x02 \x01(\tR\x06domain\x12\x1e\n" +
@@ -6196,7 +11051,9 @@ const file_proto_service_proto_rawDesc = "" +
 	"\x05score\x18\x04 \x01(\x05R\x05score\x12\x1b\n" +
 	"\trisk_tier\x18\x05 \x01(\tR\briskTier\x129\n" +
 	"\n" +
-	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"@\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x1e\n" +
+	"\vscan_run_id\x18\a \x01(\tR\tscanRunId\x12\x18\n" +
+	"\aprofile\x18\b \x01(\tR\aprofile\"@\n" +
 	"\x13ListReportsResponse\x12)\n" +
 	"\areports\x18\x01 \x03(\v2\x0f.service.ReportR\areports\"3\n" +
 	"\x14GetReportByIdRequest\x12\x1b\n" +
@@ -6318,10 +11175,11 @@ const file_proto_service_proto_rawDesc = "" +
 	"\x05email\x18\x01 \x01(\tR\x05email\x12\x19\n" +
 	"\bis_admin\x18\x02 \x01(\bR\aisAdmin\"+\n" +
 	"\x11ScanDomainRequest\x12\x16\n" +
-	"\x06domain\x18\x01 \x01(\tR\x06domain\"a\n" +
+	"\x06domain\x18\x01 \x01(\tR\x06domain\"\x81\x01\n" +
 	"\x12ScanDomainResponse\x12\x17\n" +
 	"\ascan_id\x18\x01 \x01(\tR\x06scanId\x122\n" +
-	"\x06result\x18\x02 \x01(\v2\x1a.service.DNSSecurityResultR\x06result\":\n" +
+	"\x06result\x18\x02 \x01(\v2\x1a.service.DNSSecurityResultR\x06result\x12\x1e\n" +
+	"\vscan_run_id\x18\x03 \x01(\tR\tscanRunId\":\n" +
 	" GetDNSScanResultsByDomainRequest\x12\x16\n" +
 	"\x06domain\x18\x01 \x01(\tR\x06domain\"U\n" +
 	"!GetDNSScanResultsByDomainResponse\x120\n" +
@@ -6339,10 +11197,11 @@ const file_proto_service_proto_rawDesc = "" +
 	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"H\n" +
 	"\x0eScanTLSRequest\x12\x16\n" +
 	"\x06domain\x18\x01 \x01(\tR\x06domain\x12\x1e\n" +
-	"\vdns_scan_id\x18\x02 \x01(\tR\tdnsScanId\"^\n" +
+	"\vdns_scan_id\x18\x02 \x01(\tR\tdnsScanId\"~\n" +
 	"\x0fScanTLSResponse\x12\x17\n" +
 	"\ascan_id\x18\x01 \x01(\tR\x06scanId\x122\n" +
-	"\x06result\x18\x02 \x01(\v2\x1a.service.TLSSecurityResultR\x06result\":\n" +
+	"\x06result\x18\x02 \x01(\v2\x1a.service.TLSSecurityResultR\x06result\x12\x1e\n" +
+	"\vscan_run_id\x18\x03 \x01(\tR\tscanRunId\":\n" +
 	" GetTLSScanResultsByDomainRequest\x12\x16\n" +
 	"\x06domain\x18\x01 \x01(\tR\x06domain\"U\n" +
 	"!GetTLSScanResultsByDomainResponse\x120\n" +
@@ -6356,10 +11215,11 @@ const file_proto_service_proto_rawDesc = "" +
 	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"J\n" +
 	"\x10ScanCrtShRequest\x12\x16\n" +
 	"\x06domain\x18\x01 \x01(\tR\x06domain\x12\x1e\n" +
-	"\vdns_scan_id\x18\x02 \x01(\tR\tdnsScanId\"b\n" +
+	"\vdns_scan_id\x18\x02 \x01(\tR\tdnsScanId\"\x82\x01\n" +
 	"\x11ScanCrtShResponse\x12\x17\n" +
 	"\ascan_id\x18\x01 \x01(\tR\x06scanId\x124\n" +
-	"\x06result\x18\x02 \x01(\v2\x1c.service.CrtShSecurityResultR\x06result\"<\n" +
+	"\x06result\x18\x02 \x01(\v2\x1c.service.CrtShSecurityResultR\x06result\x12\x1e\n" +
+	"\vscan_run_id\x18\x03 \x01(\tR\tscanRunId\"<\n" +
 	"\"GetCrtShScanResultsByDomainRequest\x12\x16\n" +
 	"\x06domain\x18\x01 \x01(\tR\x06domain\"Y\n" +
 	"#GetCrtShScanResultsByDomainResponse\x122\n" +
@@ -6370,13 +11230,15 @@ const file_proto_service_proto_rawDesc = "" +
 	"\vdns_scan_id\x18\x03 \x01(\tR\tdnsScanId\x124\n" +
 	"\x06result\x18\x04 \x01(\v2\x1c.service.CrtShSecurityResultR\x06result\x129\n" +
 	"\n" +
-	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"J\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"d\n" +
 	"\x10ScanChaosRequest\x12\x16\n" +
 	"\x06domain\x18\x01 \x01(\tR\x06domain\x12\x1e\n" +
-	"\vdns_scan_id\x18\x02 \x01(\tR\tdnsScanId\"b\n" +
+	"\vdns_scan_id\x18\x02 \x01(\tR\tdnsScanId\x12\x18\n" +
+	"\arefresh\x18\x03 \x01(\bR\arefresh\"\x82\x01\n" +
 	"\x11ScanChaosResponse\x12\x17\n" +
 	"\ascan_id\x18\x01 \x01(\tR\x06scanId\x124\n" +
-	"\x06result\x18\x02 \x01(\v2\x1c.service.ChaosSecurityResultR\x06result\"<\n" +
+	"\x06result\x18\x02 \x01(\v2\x1c.service.ChaosSecurityResultR\x06result\x12\x1e\n" +
+	"\vscan_run_id\x18\x03 \x01(\tR\tscanRunId\"<\n" +
 	"\"GetChaosScanResultsByDomainRequest\x12\x16\n" +
 	"\x06domain\x18\x01 \x01(\tR\x06domain\"Y\n" +
 	"#GetChaosScanResultsByDomainResponse\x122\n" +
@@ -6387,17 +11249,19 @@ const file_proto_service_proto_rawDesc = "" +
 	"\vdns_scan_id\x18\x03 \x01(\tR\tdnsScanId\x124\n" +
 	"\x06result\x18\x04 \x01(\v2\x1c.service.ChaosSecurityResultR\x06result\x129\n" +
 	"\n" +
-	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"K\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"e\n" +
 	"\x11ScanShodanRequest\x12\x16\n" +
 	"\x06domain\x18\x01 \x01(\tR\x06domain\x12\x1e\n" +
-	"\vdns_scan_id\x18\x02 \x01(\tR\tdnsScanId\"d\n" +
+	"\vdns_scan_id\x18\x02 \x01(\tR\tdnsScanId\x12\x18\n" +
+	"\arefresh\x18\x03 \x01(\bR\arefresh\"\x84\x01\n" +
 	"\x12ScanShodanResponse\x12\x17\n" +
 	"\ascan_id\x18\x01 \x01(\tR\x06scanId\x125\n" +
-	"\x06result\x18\x02 \x01(\v2\x1d.service.ShodanSecurityResultR\x06result\"=\n" +
+	"\x06result\x18\x02 \x01(\v2\x1d.service.ShodanSecurityResultR\x06result\x12\x1e\n" +
+	"\vscan_run_id\x18\x03 \x01(\tR\tscanRunId\"=\n" +
 	"#GetShodanScanResultsByDomainRequest\x12\x16\n" +
 	"\x06domain\x18\x01 \x01(\tR\x06domain\"[\n" +
 	"$GetShodanScanResultsByDomainResponse\x123\n" +
-	"\aresults\x18\x01 \x03(\v2\x19.service.ShodanScanResultR\aresults\"\xfa\x04\n" +
+	"\aresults\x18\x01 \x03(\v2\x19.service.ShodanScanResultR\aresults\"\xb0\t\n" +
 	"\x11DNSSecurityResult\x12\x1d\n" +
 	"\n" +
 	"spf_record\x18\x01 \x01(\tR\tspfRecord\x12\x1b\n" +
@@ -6423,7 +11287,88 @@ const file_proto_service_proto_rawDesc = "" +
 	"mx_records\x18\x0f \x03(\tR\tmxRecords\x12\x1d\n" +
 	"\n" +
 	"ns_records\x18\x10 \x03(\tR\tnsRecords\x12\x16\n" +
-	"\x06errors\x18\x11 \x03(\tR\x06errors\"\x93\x04\n" +
+	"\x06errors\x18\x11 \x03(\tR\x06errors\x12<\n" +
+	"\x0edkim_selectors\x18\x12 \x03(\v2\x15.service.DKIMSelectorR\rdkimSelectors\x120\n" +
+	"\x14dkim_selectors_tried\x18\x13 \x01(\x05R\x12dkimSelectorsTried\x12=\n" +
+	"\x0espf_evaluation\x18\x14 \x01(\v2\x16.service.SPFEvaluationR\rspfEvaluation\x126\n" +
+	"\fdnssec_chain\x18\x15 \x03(\v2\x13.service.DNSSECZoneR\vdnssecChain\x12?\n" +
+	"\x0fdnssec_findings\x18\x16 \x03(\v2\x16.service.DNSSECFindingR\x0ednssecFindings\x12.\n" +
+	"\amta_sts\x18\x17 \x01(\v2\x15.service.MTASTSResultR\x06mtaSts\x12.\n" +
+	"\atls_rpt\x18\x18 \x01(\v2\x15.service.TLSRPTResultR\x06tlsRpt\x12'\n" +
+	"\x04bimi\x18\x19 \x01(\v2\x13.service.BIMIResultR\x04bimi\x12'\n" +
+	"\x04dane\x18\x1a \x03(\v2\x13.service.DANEResultR\x04dane\x12%\n" +
+	"\x0ednssec_skipped\x18\x1b \x01(\bR\rdnssecSkipped\x123\n" +
+	"\x16mta_sts_policy_skipped\x18\x1c \x01(\bR\x13mtaStsPolicySkipped\"\x9d\x02\n" +
+	"\fMTASTSResult\x12\x16\n" +
+	"\x06record\x18\x01 \x01(\tR\x06record\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\x12!\n" +
+	"\frecord_valid\x18\x03 \x01(\bR\vrecordValid\x12\x16\n" +
+	"\x06policy\x18\x04 \x01(\tR\x06policy\x12\x12\n" +
+	"\x04mode\x18\x05 \x01(\tR\x04mode\x12\x1f\n" +
+	"\vmx_patterns\x18\x06 \x03(\tR\n" +
+	"mxPatterns\x12\x17\n" +
+	"\amax_age\x18\a \x01(\x03R\x06maxAge\x12!\n" +
+	"\fpolicy_valid\x18\b \x01(\bR\vpolicyValid\x12!\n" +
+	"\funmatched_mx\x18\t \x03(\tR\vunmatchedMx\x12\x16\n" +
+	"\x06errors\x18\n" +
+	" \x03(\tR\x06errors\"f\n" +
+	"\fTLSRPTResult\x12\x16\n" +
+	"\x06record\x18\x01 \x01(\tR\x06record\x12\x10\n" +
+	"\x03rua\x18\x02 \x03(\tR\x03rua\x12\x14\n" +
+	"\x05valid\x18\x03 \x01(\bR\x05valid\x12\x16\n" +
+	"\x06errors\x18\x04 \x03(\tR\x06errors\"\x92\x01\n" +
+	"\n" +
+	"BIMIResult\x12\x16\n" +
+	"\x06record\x18\x01 \x01(\tR\x06record\x12\x19\n" +
+	"\blogo_url\x18\x02 \x01(\tR\alogoUrl\x12#\n" +
+	"\rauthority_url\x18\x03 \x01(\tR\fauthorityUrl\x12\x14\n" +
+	"\x05valid\x18\x04 \x01(\bR\x05valid\x12\x16\n" +
+	"\x06errors\x18\x05 \x03(\tR\x06errors\"\xa0\x01\n" +
+	"\n" +
+	"DANEResult\x12\x17\n" +
+	"\amx_host\x18\x01 \x01(\tR\x06mxHost\x12\x18\n" +
+	"\arecords\x18\x02 \x03(\tR\arecords\x121\n" +
+	"\x14dnssec_authenticated\x18\x03 \x01(\bR\x13dnssecAuthenticated\x12\x14\n" +
+	"\x05valid\x18\x04 \x01(\bR\x05valid\x12\x16\n" +
+	"\x06errors\x18\x05 \x03(\tR\x06errors\"\xd5\x01\n" +
+	"\n" +
+	"DNSSECZone\x12\x12\n" +
+	"\x04zone\x18\x01 \x01(\tR\x04zone\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x19\n" +
+	"\bkey_tags\x18\x03 \x03(\x05R\akeyTags\x12\x1e\n" +
+	"\n" +
+	"algorithms\x18\x04 \x03(\tR\n" +
+	"algorithms\x12\x16\n" +
+	"\x06denial\x18\x05 \x01(\tR\x06denial\x12)\n" +
+	"\x10nsec3_iterations\x18\x06 \x01(\x05R\x0fnsec3Iterations\x12\x1d\n" +
+	"\n" +
+	"nsec3_salt\x18\a \x01(\tR\tnsec3Salt\"m\n" +
+	"\rDNSSECFinding\x12\x12\n" +
+	"\x04zone\x18\x01 \x01(\tR\x04zone\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\x12\x1a\n" +
+	"\bseverity\x18\x03 \x01(\tR\bseverity\x12\x18\n" +
+	"\amessage\x18\x04 \x01(\tR\amessage\"\x8c\x03\n" +
+	"\rSPFEvaluation\x12!\n" +
+	"\frecord_count\x18\x01 \x01(\x05R\vrecordCount\x12\x1f\n" +
+	"\vdns_lookups\x18\x02 \x01(\x05R\n" +
+	"dnsLookups\x12!\n" +
+	"\fvoid_lookups\x18\x03 \x01(\x05R\vvoidLookups\x122\n" +
+	"\x15lookup_limit_exceeded\x18\x04 \x01(\bR\x13lookupLimitExceeded\x12;\n" +
+	"\x1avoid_lookup_limit_exceeded\x18\x05 \x01(\bR\x17voidLookupLimitExceeded\x12%\n" +
+	"\x0epermissive_all\x18\x06 \x01(\bR\rpermissiveAll\x12!\n" +
+	"\fbroad_ranges\x18\a \x03(\tR\vbroadRanges\x12%\n" +
+	"\x0eauthorized_ips\x18\b \x03(\tR\rauthorizedIps\x12\x1a\n" +
+	"\bincludes\x18\t \x03(\tR\bincludes\x12\x16\n" +
+	"\x06errors\x18\n" +
+	" \x03(\tR\x06errors\"\xd1\x01\n" +
+	"\fDKIMSelector\x12\x1a\n" +
+	"\bselector\x18\x01 \x01(\tR\bselector\x12\x16\n" +
+	"\x06record\x18\x02 \x01(\tR\x06record\x12\x14\n" +
+	"\x05valid\x18\x03 \x01(\bR\x05valid\x12)\n" +
+	"\x10validation_error\x18\x04 \x01(\tR\x0fvalidationError\x12\x19\n" +
+	"\bkey_type\x18\x05 \x01(\tR\akeyType\x12\x19\n" +
+	"\bkey_bits\x18\x06 \x01(\x05R\akeyBits\x12\x16\n" +
+	"\x06source\x18\a \x01(\tR\x06source\"\xc7\x04\n" +
 	"\x11TLSSecurityResult\x12\x1f\n" +
 	"\vtls_version\x18\x01 \x01(\tR\n" +
 	"tlsVersion\x12!\n" +
@@ -6440,7 +11385,16 @@ const file_proto_service_proto_rawDesc = "" +
 	"\x11cert_key_strength\x18\n" +
 	" \x01(\x05R\x0fcertKeyStrength\x128\n" +
 	"\x18cert_signature_algorithm\x18\v \x01(\tR\x16certSignatureAlgorithm\x12\x16\n" +
-	"\x06errors\x18\f \x03(\tR\x06errors\"\xc2\x02\n" +
+	"\x06errors\x18\f \x03(\tR\x06errors\x122\n" +
+	"\tendpoints\x18\r \x03(\v2\x14.service.TLSEndpointR\tendpoints\"\xbc\x01\n" +
+	"\vTLSEndpoint\x12\x12\n" +
+	"\x04host\x18\x01 \x01(\tR\x04host\x12\x12\n" +
+	"\x04port\x18\x02 \x01(\x05R\x04port\x12\x1f\n" +
+	"\vtls_version\x18\x03 \x01(\tR\n" +
+	"tlsVersion\x12!\n" +
+	"\fcipher_suite\x18\x04 \x01(\tR\vcipherSuite\x12+\n" +
+	"\x11certificate_valid\x18\x05 \x01(\bR\x10certificateValid\x12\x14\n" +
+	"\x05error\x18\x06 \x01(\tR\x05error\"\xc2\x02\n" +
 	"\x10CrtShCertificate\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1f\n" +
 	"\vcommon_name\x18\x02 \x01(\tR\n" +
@@ -6451,18 +11405,22 @@ const file_proto_service_proto_rawDesc = "" +
 	"\tnot_after\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\bnotAfter\x12#\n" +
 	"\rserial_number\x18\x06 \x01(\tR\fserialNumber\x12\x1b\n" +
 	"\tdns_names\x18\a \x03(\tR\bdnsNames\x12/\n" +
-	"\x13signature_algorithm\x18\b \x01(\tR\x12signatureAlgorithm\"\x8c\x01\n" +
+	"\x13signature_algorithm\x18\b \x01(\tR\x12signatureAlgorithm\"\xae\x01\n" +
 	"\x13CrtShSecurityResult\x12=\n" +
 	"\fcertificates\x18\x01 \x03(\v2\x19.service.CrtShCertificateR\fcertificates\x12\x1e\n" +
 	"\n" +
 	"subdomains\x18\x02 \x03(\tR\n" +
 	"subdomains\x12\x16\n" +
-	"\x06errors\x18\x03 \x03(\tR\x06errors\"M\n" +
+	"\x06errors\x18\x03 \x03(\tR\x06errors\x12 \n" +
+	"\vunavailable\x18\x04 \x03(\tR\vunavailable\"\xb3\x01\n" +
 	"\x13ChaosSecurityResult\x12\x1e\n" +
 	"\n" +
 	"subdomains\x18\x01 \x03(\tR\n" +
 	"subdomains\x12\x16\n" +
-	"\x06errors\x18\x02 \x03(\tR\x06errors\"\xcc\x01\n" +
+	"\x06errors\x18\x02 \x03(\tR\x06errors\x12\x16\n" +
+	"\x06cached\x18\x03 \x01(\bR\x06cached\x12*\n" +
+	"\x11cache_age_seconds\x18\x04 \x01(\x03R\x0fcacheAgeSeconds\x12 \n" +
+	"\vunavailable\x18\x05 \x03(\tR\vunavailable\"\xcc\x01\n" +
 	"\x10ShodanScanResult\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
 	"\x06domain\x18\x02 \x01(\tR\x06domain\x12\x1e\n" +
@@ -6499,16 +11457,21 @@ const file_proto_service_proto_rawDesc = "" +
 	"\x03isp\x18\f \x01(\tR\x03isp\x128\n" +
 	"\ttimestamp\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\x128\n" +
 	"\vshodan_meta\x18\x0e \x01(\v2\x17.service.ShodanMetadataR\n" +
-	"shodanMeta\"Y\n" +
+	"shodanMeta\"\xbf\x01\n" +
 	"\x14ShodanSecurityResult\x12)\n" +
 	"\x05hosts\x18\x01 \x03(\v2\x13.service.ShodanHostR\x05hosts\x12\x16\n" +
-	"\x06errors\x18\x02 \x03(\tR\x06errors\"H\n" +
+	"\x06errors\x18\x02 \x03(\tR\x06errors\x12 \n" +
+	"\vunavailable\x18\x03 \x03(\tR\vunavailable\x12\x16\n" +
+	"\x06cached\x18\x04 \x01(\bR\x06cached\x12*\n" +
+	"\x11cache_age_seconds\x18\x05 \x01(\x03R\x0fcacheAgeSeconds\"b\n" +
 	"\x0eScanOTXRequest\x12\x16\n" +
 	"\x06domain\x18\x01 \x01(\tR\x06domain\x12\x1e\n" +
-	"\vdns_scan_id\x18\x02 \x01(\tR\tdnsScanId\"^\n" +
+	"\vdns_scan_id\x18\x02 \x01(\tR\tdnsScanId\x12\x18\n" +
+	"\arefresh\x18\x03 \x01(\bR\arefresh\"~\n" +
 	"\x0fScanOTXResponse\x12\x17\n" +
 	"\ascan_id\x18\x01 \x01(\tR\x06scanId\x122\n" +
-	"\x06result\x18\x02 \x01(\v2\x1a.service.OTXSecurityResultR\x06result\":\n" +
+	"\x06result\x18\x02 \x01(\v2\x1a.service.OTXSecurityResultR\x06result\x12\x1e\n" +
+	"\vscan_run_id\x18\x03 \x01(\tR\tscanRunId\":\n" +
 	" GetOTXScanResultsByDomainRequest\x12\x16\n" +
 	"\x06domain\x18\x01 \x01(\tR\x06domain\"U\n" +
 	"!GetOTXScanResultsByDomainResponse\x120\n" +
@@ -6535,20 +11498,33 @@ const file_proto_service_proto_rawDesc = "" +
 	"\aaddress\x18\x01 \x01(\tR\aaddress\x12\x1a\n" +
 	"\bhostname\x18\x02 \x01(\tR\bhostname\x12\x16\n" +
 	"\x06record\x18\x03 \x01(\tR\x06record\x126\n" +
-	"\bdatetime\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\bdatetime\"\xf4\x01\n" +
+	"\bdatetime\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\bdatetime\"\x99\x03\n" +
 	"\x11OTXSecurityResult\x12:\n" +
 	"\fgeneral_info\x18\x01 \x01(\v2\x17.service.OTXGeneralInfoR\vgeneralInfo\x12-\n" +
 	"\amalware\x18\x02 \x03(\v2\x13.service.OTXMalwareR\amalware\x12#\n" +
 	"\x04urls\x18\x03 \x03(\v2\x0f.service.OTXURLR\x04urls\x127\n" +
 	"\vpassive_dns\x18\x04 \x03(\v2\x16.service.OTXPassiveDNSR\n" +
 	"passiveDns\x12\x16\n" +
-	"\x06errors\x18\x05 \x03(\tR\x06errors\"J\n" +
+	"\x06errors\x18\x05 \x03(\tR\x06errors\x12=\n" +
+	"\rip_reputation\x18\x06 \x03(\v2\x18.service.OTXIPReputationR\fipReputation\x12 \n" +
+	"\vunavailable\x18\a \x03(\tR\vunavailable\x12\x16\n" +
+	"\x06cached\x18\b \x01(\bR\x06cached\x12*\n" +
+	"\x11cache_age_seconds\x18\t \x01(\x03R\x0fcacheAgeSeconds\"\x85\x01\n" +
+	"\x0fOTXIPReputation\x12\x0e\n" +
+	"\x02ip\x18\x01 \x01(\tR\x02ip\x12\x1f\n" +
+	"\vpulse_count\x18\x02 \x01(\x05R\n" +
+	"pulseCount\x12\x1e\n" +
+	"\n" +
+	"reputation\x18\x03 \x01(\x05R\n" +
+	"reputation\x12!\n" +
+	"\fcountry_name\x18\x04 \x01(\tR\vcountryName\"J\n" +
 	"\x10ScanWhoisRequest\x12\x16\n" +
 	"\x06domain\x18\x01 \x01(\tR\x06domain\x12\x1e\n" +
-	"\vdns_scan_id\x18\x02 \x01(\tR\tdnsScanId\"b\n" +
+	"\vdns_scan_id\x18\x02 \x01(\tR\tdnsScanId\"\x82\x01\n" +
 	"\x11ScanWhoisResponse\x12\x17\n" +
 	"\ascan_id\x18\x01 \x01(\tR\x06scanId\x124\n" +
-	"\x06result\x18\x02 \x01(\v2\x1c.service.WhoisSecurityResultR\x06result\"<\n" +
+	"\x06result\x18\x02 \x01(\v2\x1c.service.WhoisSecurityResultR\x06result\x12\x1e\n" +
+	"\vscan_run_id\x18\x03 \x01(\tR\tscanRunId\"<\n" +
 	"\"GetWhoisScanResultsByDomainRequest\x12\x16\n" +
 	"\x06domain\x18\x01 \x01(\tR\x06domain\"Y\n" +
 	"#GetWhoisScanResultsByDomainResponse\x122\n" +
@@ -6581,16 +11557,18 @@ const file_proto_service_proto_rawDesc = "" +
 	"first_seen\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tfirstSeen\x127\n" +
 	"\tlast_seen\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\blastSeen\x12#\n" +
 	"\rmalware_alias\x18\a \x03(\tR\fmalwareAlias\x12\x12\n" +
-	"\x04tags\x18\b \x03(\tR\x04tags\"X\n" +
+	"\x04tags\x18\b \x03(\tR\x04tags\"z\n" +
 	"\x15AbuseChSecurityResult\x12'\n" +
 	"\x04iocs\x18\x01 \x03(\v2\x13.service.AbuseChIOCR\x04iocs\x12\x16\n" +
-	"\x06errors\x18\x02 \x03(\tR\x06errors\"L\n" +
+	"\x06errors\x18\x02 \x03(\tR\x06errors\x12 \n" +
+	"\vunavailable\x18\x03 \x03(\tR\vunavailable\"L\n" +
 	"\x12ScanAbuseChRequest\x12\x16\n" +
 	"\x06domain\x18\x01 \x01(\tR\x06domain\x12\x1e\n" +
-	"\vdns_scan_id\x18\x02 \x01(\tR\tdnsScanId\"f\n" +
+	"\vdns_scan_id\x18\x02 \x01(\tR\tdnsScanId\"\x86\x01\n" +
 	"\x13ScanAbuseChResponse\x12\x17\n" +
 	"\ascan_id\x18\x01 \x01(\tR\x06scanId\x126\n" +
-	"\x06result\x18\x02 \x01(\v2\x1e.service.AbuseChSecurityResultR\x06result\">\n" +
+	"\x06result\x18\x02 \x01(\v2\x1e.service.AbuseChSecurityResultR\x06result\x12\x1e\n" +
+	"\vscan_run_id\x18\x03 \x01(\tR\tscanRunId\">\n" +
 	"$GetAbuseChScanResultsByDomainRequest\x12\x16\n" +
 	"\x06domain\x18\x01 \x01(\tR\x06domain\"]\n" +
 	"%GetAbuseChScanResultsByDomainResponse\x124\n" +
@@ -6604,10 +11582,11 @@ const file_proto_service_proto_rawDesc = "" +
 	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"H\n" +
 	"\x0eScanISCRequest\x12\x16\n" +
 	"\x06domain\x18\x01 \x01(\tR\x06domain\x12\x1e\n" +
-	"\vdns_scan_id\x18\x02 \x01(\tR\tdnsScanId\"^\n" +
+	"\vdns_scan_id\x18\x02 \x01(\tR\tdnsScanId\"~\n" +
 	"\x0fScanISCResponse\x12\x17\n" +
 	"\ascan_id\x18\x01 \x01(\tR\x06scanId\x122\n" +
-	"\x06result\x18\x02 \x01(\v2\x1a.service.ISCSecurityResultR\x06result\":\n" +
+	"\x06result\x18\x02 \x01(\v2\x1a.service.ISCSecurityResultR\x06result\x12\x1e\n" +
+	"\vscan_run_id\x18\x03 \x01(\tR\tscanRunId\":\n" +
 	" GetISCScanResultsByDomainRequest\x12\x16\n" +
 	"\x06domain\x18\x01 \x01(\tR\x06domain\"U\n" +
 	"!GetISCScanResultsByDomainResponse\x120\n" +
@@ -6623,11 +11602,281 @@ const file_proto_service_proto_rawDesc = "" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12.\n" +
 	"\x04date\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x04date\x12 \n" +
 	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x1a\n" +
-	"\bseverity\x18\x04 \x01(\tR\bseverity\"\x82\x01\n" +
+	"\bseverity\x18\x04 \x01(\tR\bseverity\"\xe3\x01\n" +
 	"\x11ISCSecurityResult\x122\n" +
 	"\tincidents\x18\x01 \x03(\v2\x14.service.ISCIncidentR\tincidents\x12!\n" +
 	"\foverall_risk\x18\x02 \x01(\tR\voverallRisk\x12\x16\n" +
-	"\x06errors\x18\x03 \x03(\tR\x06errors2\xb6\x04\n" +
+	"\x06errors\x18\x03 \x03(\tR\x06errors\x12=\n" +
+	"\rip_reputation\x18\x04 \x03(\v2\x18.service.ISCIPReputationR\fipReputation\x12 \n" +
+	"\vunavailable\x18\x05 \x03(\tR\vunavailable\"j\n" +
+	"\x0fISCIPReputation\x12\x0e\n" +
+	"\x02ip\x18\x01 \x01(\tR\x02ip\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x05R\x05count\x12\x18\n" +
+	"\aattacks\x18\x03 \x01(\x05R\aattacks\x12\x17\n" +
+	"\aas_name\x18\x04 \x01(\tR\x06asName\"\x14\n" +
+	"\x12ListPluginsRequest\"D\n" +
+	"\x13ListPluginsResponse\x12-\n" +
+	"\aplugins\x18\x01 \x03(\v2\x13.service.PluginInfoR\aplugins\"\x99\x03\n" +
+	"\n" +
+	"PluginInfo\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\tR\aversion\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12'\n" +
+	"\x0frequired_config\x18\x04 \x03(\tR\x0erequiredConfig\x12\x18\n" +
+	"\apassive\x18\x05 \x01(\bR\apassive\x12\"\n" +
+	"\fdependencies\x18\x06 \x03(\tR\fdependencies\x12\x16\n" +
+	"\x06loaded\x18\a \x01(\bR\x06loaded\x12\x1e\n" +
+	"\n" +
+	"configured\x18\b \x01(\bR\n" +
+	"configured\x12%\n" +
+	"\x0emissing_config\x18\t \x03(\tR\rmissingConfig\x12\x1a\n" +
+	"\bexternal\x18\n" +
+	" \x01(\bR\bexternal\x12\x18\n" +
+	"\ahealthy\x18\v \x01(\bR\ahealthy\x12%\n" +
+	"\x0ehealth_message\x18\f \x01(\tR\rhealthMessage\x12\x18\n" +
+	"\aenabled\x18\r \x01(\bR\aenabled\"b\n" +
+	"\x14SubmitScanJobRequest\x12\x16\n" +
+	"\x06domain\x18\x01 \x01(\tR\x06domain\x12\x18\n" +
+	"\aprofile\x18\x02 \x01(\tR\aprofile\x12\x18\n" +
+	"\arefresh\x18\x03 \x01(\bR\arefresh\";\n" +
+	"\x15SubmitScanJobResponse\x12\"\n" +
+	"\x03job\x18\x01 \x01(\v2\x10.service.ScanJobR\x03job\"*\n" +
+	"\x11GetScanJobRequest\x12\x15\n" +
+	"\x06job_id\x18\x01 \x01(\tR\x05jobId\"8\n" +
+	"\x12GetScanJobResponse\x12\"\n" +
+	"\x03job\x18\x01 \x01(\v2\x10.service.ScanJobR\x03job\"+\n" +
+	"\x13ListScanJobsRequest\x12\x14\n" +
+	"\x05limit\x18\x01 \x01(\x05R\x05limit\"<\n" +
+	"\x14ListScanJobsResponse\x12$\n" +
+	"\x04jobs\x18\x01 \x03(\v2\x10.service.ScanJobR\x04jobs\"-\n" +
+	"\x14CancelScanJobRequest\x12\x15\n" +
+	"\x06job_id\x18\x01 \x01(\tR\x05jobId\";\n" +
+	"\x15CancelScanJobResponse\x12\"\n" +
+	"\x03job\x18\x01 \x01(\v2\x10.service.ScanJobR\x03job\"\xba\x04\n" +
+	"\aScanJob\x12\x15\n" +
+	"\x06job_id\x18\x01 \x01(\tR\x05jobId\x12\x16\n" +
+	"\x06domain\x18\x02 \x01(\tR\x06domain\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12\x1b\n" +
+	"\treport_id\x18\x04 \x01(\tR\breportId\x12\x14\n" +
+	"\x05error\x18\x05 \x01(\tR\x05error\x12)\n" +
+	"\x10cancel_requested\x18\x06 \x01(\bR\x0fcancelRequested\x120\n" +
+	"\aplugins\x18\a \x03(\v2\x16.service.ScanJobPluginR\aplugins\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"started_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tstartedAt\x12;\n" +
+	"\vfinished_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"finishedAt\x12\"\n" +
+	"\rparent_job_id\x18\v \x01(\tR\vparentJobId\x12\x1e\n" +
+	"\vscan_run_id\x18\f \x01(\tR\tscanRunId\x12\x19\n" +
+	"\bbatch_id\x18\r \x01(\tR\abatchId\x12\x12\n" +
+	"\x04tags\x18\x0e \x03(\tR\x04tags\x12\x18\n" +
+	"\aprofile\x18\x0f \x01(\tR\aprofile\x12\x18\n" +
+	"\arefresh\x18\x10 \x01(\bR\arefresh\"\x87\x02\n" +
+	"\rScanJobPlugin\x12\x16\n" +
+	"\x06plugin\x18\x01 \x01(\tR\x06plugin\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x1b\n" +
+	"\tresult_id\x18\x03 \x01(\tR\bresultId\x12\x14\n" +
+	"\x05error\x18\x04 \x01(\tR\x05error\x129\n" +
+	"\n" +
+	"started_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tstartedAt\x12;\n" +
+	"\vfinished_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"finishedAt\x12\x1b\n" +
+	"\ttimed_out\x18\a \x01(\bR\btimedOut\"3\n" +
+	"\x11GetScanRunRequest\x12\x1e\n" +
+	"\vscan_run_id\x18\x01 \x01(\tR\tscanRunId\"8\n" +
+	"\x12GetScanRunResponse\x12\"\n" +
+	"\x03run\x18\x01 \x01(\v2\x10.service.ScanRunR\x03run\"\xc6\x02\n" +
+	"\aScanRun\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06domain\x18\x02 \x01(\tR\x06domain\x12!\n" +
+	"\frequested_by\x18\x03 \x01(\tR\vrequestedBy\x12\x18\n" +
+	"\aprofile\x18\x04 \x01(\tR\aprofile\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\x12\x14\n" +
+	"\x05error\x18\x06 \x01(\tR\x05error\x129\n" +
+	"\n" +
+	"started_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tstartedAt\x12;\n" +
+	"\vfinished_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"finishedAt\x120\n" +
+	"\aresults\x18\t \x03(\v2\x16.service.ScanRunResultR\aresults\"\x83\x06\n" +
+	"\rScanRunResult\x12\x16\n" +
+	"\x06plugin\x18\x01 \x01(\tR\x06plugin\x12\x1b\n" +
+	"\tresult_id\x18\x02 \x01(\tR\bresultId\x129\n" +
+	"\n" +
+	"created_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"dns_result\x18\x04 \x01(\v2\x1a.service.DNSSecurityResultR\tdnsResult\x129\n" +
+	"\n" +
+	"tls_result\x18\x05 \x01(\v2\x1a.service.TLSSecurityResultR\ttlsResult\x12?\n" +
+	"\fcrtsh_result\x18\x06 \x01(\v2\x1c.service.CrtShSecurityResultR\vcrtshResult\x12?\n" +
+	"\fchaos_result\x18\a \x01(\v2\x1c.service.ChaosSecurityResultR\vchaosResult\x12B\n" +
+	"\rshodan_result\x18\b \x01(\v2\x1d.service.ShodanSecurityResultR\fshodanResult\x129\n" +
+	"\n" +
+	"otx_result\x18\t \x01(\v2\x1a.service.OTXSecurityResultR\totxResult\x12?\n" +
+	"\fwhois_result\x18\n" +
+	" \x01(\v2\x1c.service.WhoisSecurityResultR\vwhoisResult\x12E\n" +
+	"\x0eabusech_result\x18\v \x01(\v2\x1e.service.AbuseChSecurityResultR\rabusechResult\x129\n" +
+	"\n" +
+	"isc_result\x18\f \x01(\v2\x1a.service.ISCSecurityResultR\tiscResult\x12H\n" +
+	"\x0fexternal_result\x18\r \x01(\v2\x1f.service.ExternalSecurityResultR\x0eexternalResult\"H\n" +
+	"\x16ExternalSecurityResult\x12\x16\n" +
+	"\x06plugin\x18\x01 \x01(\tR\x06plugin\x12\x16\n" +
+	"\x06result\x18\x02 \x01(\tR\x06result\"\x8c\x01\n" +
+	"\x19CreateScanScheduleRequest\x12\x16\n" +
+	"\x06domain\x18\x01 \x01(\tR\x06domain\x12\x12\n" +
+	"\x04cron\x18\x02 \x01(\tR\x04cron\x12)\n" +
+	"\x10interval_seconds\x18\x03 \x01(\x03R\x0fintervalSeconds\x12\x18\n" +
+	"\aprofile\x18\x04 \x01(\tR\aprofile\"O\n" +
+	"\x1aCreateScanScheduleResponse\x121\n" +
+	"\bschedule\x18\x01 \x01(\v2\x15.service.ScanScheduleR\bschedule\"\x95\x01\n" +
+	"\x19UpdateScanScheduleRequest\x12\x1f\n" +
+	"\vschedule_id\x18\x01 \x01(\tR\n" +
+	"scheduleId\x12\x12\n" +
+	"\x04cron\x18\x02 \x01(\tR\x04cron\x12)\n" +
+	"\x10interval_seconds\x18\x03 \x01(\x03R\x0fintervalSeconds\x12\x18\n" +
+	"\aprofile\x18\x04 \x01(\tR\aprofile\"O\n" +
+	"\x1aUpdateScanScheduleResponse\x121\n" +
+	"\bschedule\x18\x01 \x01(\v2\x15.service.ScanScheduleR\bschedule\"S\n" +
+	"\x18PauseScanScheduleRequest\x12\x1f\n" +
+	"\vschedule_id\x18\x01 \x01(\tR\n" +
+	"scheduleId\x12\x16\n" +
+	"\x06paused\x18\x02 \x01(\bR\x06paused\"N\n" +
+	"\x19PauseScanScheduleResponse\x121\n" +
+	"\bschedule\x18\x01 \x01(\v2\x15.service.ScanScheduleR\bschedule\"\x1a\n" +
+	"\x18ListScanSchedulesRequest\"P\n" +
+	"\x19ListScanSchedulesResponse\x123\n" +
+	"\tschedules\x18\x01 \x03(\v2\x15.service.ScanScheduleR\tschedules\"\x80\x04\n" +
+	"\fScanSchedule\x12\x1f\n" +
+	"\vschedule_id\x18\x01 \x01(\tR\n" +
+	"scheduleId\x12\x16\n" +
+	"\x06domain\x18\x02 \x01(\tR\x06domain\x12\x12\n" +
+	"\x04cron\x18\x03 \x01(\tR\x04cron\x12)\n" +
+	"\x10interval_seconds\x18\x04 \x01(\x03R\x0fintervalSeconds\x12\x18\n" +
+	"\aprofile\x18\x05 \x01(\tR\aprofile\x12\x19\n" +
+	"\bowner_id\x18\x06 \x01(\tR\aownerId\x12\x16\n" +
+	"\x06paused\x18\a \x01(\bR\x06paused\x12:\n" +
+	"\vnext_run_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tnextRunAt\x12:\n" +
+	"\vlast_run_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tlastRunAt\x12\x1e\n" +
+	"\vlast_job_id\x18\n" +
+	" \x01(\tR\tlastJobId\x12\x1d\n" +
+	"\n" +
+	"last_error\x18\v \x01(\tR\tlastError\x129\n" +
+	"\n" +
+	"created_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"k\n" +
+	"\x0fBulkScanRequest\x12\x18\n" +
+	"\adomains\x18\x01 \x03(\tR\adomains\x12\x10\n" +
+	"\x03csv\x18\x02 \x01(\fR\x03csv\x12\x12\n" +
+	"\x04tags\x18\x03 \x03(\tR\x04tags\x12\x18\n" +
+	"\aprofile\x18\x04 \x01(\tR\aprofile\"\x93\x01\n" +
+	"\x10BulkScanResponse\x12(\n" +
+	"\x05batch\x18\x01 \x01(\v2\x12.service.ScanBatchR\x05batch\x125\n" +
+	"\brejected\x18\x02 \x03(\v2\x19.service.BulkScanRejectedR\brejected\x12\x1e\n" +
+	"\n" +
+	"duplicates\x18\x03 \x01(\x05R\n" +
+	"duplicates\"@\n" +
+	"\x10BulkScanRejected\x12\x14\n" +
+	"\x05input\x18\x01 \x01(\tR\x05input\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"0\n" +
+	"\x13GetScanBatchRequest\x12\x19\n" +
+	"\bbatch_id\x18\x01 \x01(\tR\abatchId\"@\n" +
+	"\x14GetScanBatchResponse\x12(\n" +
+	"\x05batch\x18\x01 \x01(\v2\x12.service.ScanBatchR\x05batch\"\xc6\x02\n" +
+	"\tScanBatch\x12\x19\n" +
+	"\bbatch_id\x18\x01 \x01(\tR\abatchId\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\x12\x16\n" +
+	"\x06queued\x18\x03 \x01(\x05R\x06queued\x12\x18\n" +
+	"\arunning\x18\x04 \x01(\x05R\arunning\x12\x1c\n" +
+	"\tsucceeded\x18\x05 \x01(\x05R\tsucceeded\x12\x16\n" +
+	"\x06failed\x18\x06 \x01(\x05R\x06failed\x12\x1c\n" +
+	"\tcancelled\x18\a \x01(\x05R\tcancelled\x12\x12\n" +
+	"\x04done\x18\b \x01(\bR\x04done\x123\n" +
+	"\asummary\x18\t \x01(\v2\x19.service.ScanBatchSummaryR\asummary\x129\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\x84\x02\n" +
+	"\x10ScanBatchSummary\x12\x18\n" +
+	"\areports\x18\x01 \x01(\x05R\areports\x12#\n" +
+	"\raverage_score\x18\x02 \x01(\x01R\faverageScore\x12\x1b\n" +
+	"\tmin_score\x18\x03 \x01(\x05R\bminScore\x12\x1b\n" +
+	"\tmax_score\x18\x04 \x01(\x05R\bmaxScore\x125\n" +
+	"\n" +
+	"risk_tiers\x18\x05 \x03(\v2\x16.service.RiskTierCountR\triskTiers\x12@\n" +
+	"\x0efailed_domains\x18\x06 \x03(\v2\x19.service.ScanBatchFailureR\rfailedDomains\"B\n" +
+	"\rRiskTierCount\x12\x1b\n" +
+	"\trisk_tier\x18\x01 \x01(\tR\briskTier\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x05R\x05count\"W\n" +
+	"\x10ScanBatchFailure\x12\x16\n" +
+	"\x06domain\x18\x01 \x01(\tR\x06domain\x12\x15\n" +
+	"\x06job_id\x18\x02 \x01(\tR\x05jobId\x12\x14\n" +
+	"\x05error\x18\x03 \x01(\tR\x05error\"\xfd\x02\n" +
+	"\vScanProfile\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x18\n" +
+	"\aplugins\x18\x03 \x03(\tR\aplugins\x12!\n" +
+	"\fpassive_only\x18\x04 \x01(\bR\vpassiveOnly\x124\n" +
+	"\aoptions\x18\x05 \x03(\v2\x1a.service.ScanProfileOptionR\aoptions\x127\n" +
+	"\btimeouts\x18\x06 \x03(\v2\x1b.service.ScanProfileTimeoutR\btimeouts\x12\x16\n" +
+	"\x06source\x18\a \x01(\tR\x06source\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"S\n" +
+	"\x11ScanProfileOption\x12\x16\n" +
+	"\x06plugin\x18\x01 \x01(\tR\x06plugin\x12\x10\n" +
+	"\x03key\x18\x02 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x03 \x01(\tR\x05value\"F\n" +
+	"\x12ScanProfileTimeout\x12\x16\n" +
+	"\x06plugin\x18\x01 \x01(\tR\x06plugin\x12\x18\n" +
+	"\aseconds\x18\x02 \x01(\x05R\aseconds\"H\n" +
+	"\x16SaveScanProfileRequest\x12.\n" +
+	"\aprofile\x18\x01 \x01(\v2\x14.service.ScanProfileR\aprofile\"I\n" +
+	"\x17SaveScanProfileResponse\x12.\n" +
+	"\aprofile\x18\x01 \x01(\v2\x14.service.ScanProfileR\aprofile\"\x19\n" +
+	"\x17ListScanProfilesRequest\"L\n" +
+	"\x18ListScanProfilesResponse\x120\n" +
+	"\bprofiles\x18\x01 \x03(\v2\x14.service.ScanProfileR\bprofiles\".\n" +
+	"\x18DeleteScanProfileRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"\x1b\n" +
+	"\x19DeleteScanProfileResponse\"5\n" +
+	"\x17GetProviderUsageRequest\x12\x1a\n" +
+	"\bprovider\x18\x01 \x01(\tR\bprovider\"P\n" +
+	"\x18GetProviderUsageResponse\x124\n" +
+	"\tproviders\x18\x01 \x03(\v2\x16.service.ProviderUsageR\tproviders\"\xab\x02\n" +
+	"\rProviderUsage\x12\x1a\n" +
+	"\bprovider\x18\x01 \x01(\tR\bprovider\x12\x1f\n" +
+	"\vdaily_calls\x18\x02 \x01(\x05R\n" +
+	"dailyCalls\x12\x1f\n" +
+	"\vdaily_limit\x18\x03 \x01(\x05R\n" +
+	"dailyLimit\x12'\n" +
+	"\x0fdaily_remaining\x18\x04 \x01(\x05R\x0edailyRemaining\x12#\n" +
+	"\rmonthly_calls\x18\x05 \x01(\x05R\fmonthlyCalls\x12#\n" +
+	"\rmonthly_limit\x18\x06 \x01(\x05R\fmonthlyLimit\x12+\n" +
+	"\x11monthly_remaining\x18\a \x01(\x05R\x10monthlyRemaining\x12\x1c\n" +
+	"\texhausted\x18\b \x01(\bR\texhausted\"6\n" +
+	"\x18GetProviderHealthRequest\x12\x1a\n" +
+	"\bprovider\x18\x01 \x01(\tR\bprovider\"R\n" +
+	"\x19GetProviderHealthResponse\x125\n" +
+	"\tproviders\x18\x01 \x03(\v2\x17.service.ProviderHealthR\tproviders\"\x84\x02\n" +
+	"\x0eProviderHealth\x12\x1a\n" +
+	"\bprovider\x18\x01 \x01(\tR\bprovider\x12\x14\n" +
+	"\x05state\x18\x02 \x01(\tR\x05state\x121\n" +
+	"\x14consecutive_failures\x18\x03 \x01(\x05R\x13consecutiveFailures\x127\n" +
+	"\topened_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\bopenedAt\x125\n" +
+	"\bretry_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\aretryAt\x12\x1d\n" +
+	"\n" +
+	"last_error\x18\x06 \x01(\tR\tlastError\"\x17\n" +
+	"\x15DescribeConfigRequest\"\xf3\x01\n" +
+	"\x16DescribeConfigResponse\x12\x18\n" +
+	"\aversion\x18\x01 \x01(\x05R\aversion\x12\x1a\n" +
+	"\bchecksum\x18\x02 \x01(\tR\bchecksum\x127\n" +
+	"\tloaded_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\bloadedAt\x12\x12\n" +
+	"\x04path\x18\x04 \x01(\tR\x04path\x12\x1d\n" +
+	"\n" +
+	"last_error\x18\x05 \x01(\tR\tlastError\x127\n" +
+	"\tfailed_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\bfailedAt2\xb6\x04\n" +
 	"\vAuthService\x12E\n" +
 	"\n" +
 	"CreateUser\x12\x1a.service.CreateUserRequest\x1a\x1b.service.CreateUserResponse\x12<\n" +
@@ -6647,7 +11896,7 @@ const file_proto_service_proto_rawDesc = "" +
 	"\x0eActivateAPIKey\x12\x1e.service.ActivateAPIKeyRequest\x1a\x1f.service.ActivateAPIKeyResponse\x12W\n" +
 	"\x10DeactivateAPIKey\x12 .service.DeactivateAPIKeyRequest\x1a!.service.DeactivateAPIKeyResponse\x12H\n" +
 	"\vListAPIKeys\x12\x1b.service.ListAPIKeysRequest\x1a\x1c.service.ListAPIKeysResponse\x12Q\n" +
-	"\x0eChangePassword\x12\x1e.service.ChangePasswordRequest\x1a\x1f.service.ChangePasswordResponse2\x8b\x0e\n" +
+	"\x0eChangePassword\x12\x1e.service.ChangePasswordRequest\x1a\x1f.service.ChangePasswordResponse2\xe7\x19\n" +
 	"\vScanService\x12E\n" +
 	"\n" +
 	"ScanDomain\x12\x1a.service.ScanDomainRequest\x1a\x1b.service.ScanDomainResponse\x12<\n" +
@@ -6669,9 +11918,30 @@ const file_proto_service_proto_rawDesc = "" +
 	"\x1bGetWhoisScanResultsByDomain\x12+.service.GetWhoisScanResultsByDomainRequest\x1a,.service.GetWhoisScanResultsByDomainResponse\x12~\n" +
 	"\x1dGetAbuseChScanResultsByDomain\x12-.service.GetAbuseChScanResultsByDomainRequest\x1a..service.GetAbuseChScanResultsByDomainResponse\x12r\n" +
 	"\x19GetISCScanResultsByDomain\x12).service.GetISCScanResultsByDomainRequest\x1a*.service.GetISCScanResultsByDomainResponse\x12c\n" +
-	"\x14GetDNSScanResultByID\x12$.service.GetDNSScanResultByIDRequest\x1a%.service.GetDNSScanResultByIDResponse2\xdb\x02\n" +
+	"\x14GetDNSScanResultByID\x12$.service.GetDNSScanResultByIDRequest\x1a%.service.GetDNSScanResultByIDResponse\x12H\n" +
+	"\vListPlugins\x12\x1b.service.ListPluginsRequest\x1a\x1c.service.ListPluginsResponse\x12N\n" +
+	"\rSubmitScanJob\x12\x1d.service.SubmitScanJobRequest\x1a\x1e.service.SubmitScanJobResponse\x12E\n" +
+	"\n" +
+	"GetScanJob\x12\x1a.service.GetScanJobRequest\x1a\x1b.service.GetScanJobResponse\x12K\n" +
+	"\fListScanJobs\x12\x1c.service.ListScanJobsRequest\x1a\x1d.service.ListScanJobsResponse\x12N\n" +
+	"\rCancelScanJob\x12\x1d.service.CancelScanJobRequest\x1a\x1e.service.CancelScanJobResponse\x12E\n" +
+	"\n" +
+	"GetScanRun\x12\x1a.service.GetScanRunRequest\x1a\x1b.service.GetScanRunResponse\x12]\n" +
+	"\x12CreateScanSchedule\x12\".service.CreateScanScheduleRequest\x1a#.service.CreateScanScheduleResponse\x12]\n" +
+	"\x12UpdateScanSchedule\x12\".service.UpdateScanScheduleRequest\x1a#.service.UpdateScanScheduleResponse\x12Z\n" +
+	"\x11PauseScanSchedule\x12!.service.PauseScanScheduleRequest\x1a\".service.PauseScanScheduleResponse\x12Z\n" +
+	"\x11ListScanSchedules\x12!.service.ListScanSchedulesRequest\x1a\".service.ListScanSchedulesResponse\x12?\n" +
+	"\bBulkScan\x12\x18.service.BulkScanRequest\x1a\x19.service.BulkScanResponse\x12K\n" +
+	"\fGetScanBatch\x12\x1c.service.GetScanBatchRequest\x1a\x1d.service.GetScanBatchResponse\x12T\n" +
+	"\x0fSaveScanProfile\x12\x1f.service.SaveScanProfileRequest\x1a .service.SaveScanProfileResponse\x12W\n" +
+	"\x10ListScanProfiles\x12 .service.ListScanProfilesRequest\x1a!.service.ListScanProfilesResponse\x12Z\n" +
+	"\x11DeleteScanProfile\x12!.service.DeleteScanProfileRequest\x1a\".service.DeleteScanProfileResponse\x12W\n" +
+	"\x10GetProviderUsage\x12 .service.GetProviderUsageRequest\x1a!.service.GetProviderUsageResponse\x12Z\n" +
+	"\x11GetProviderHealth\x12!.service.GetProviderHealthRequest\x1a\".service.GetProviderHealthResponse\x12Q\n" +
+	"\x0eDescribeConfig\x12\x1e.service.DescribeConfigRequest\x1a\x1f.service.DescribeConfigResponse2\xb3\x03\n" +
 	"\rReportService\x12Q\n" +
-	"\x0eGenerateReport\x12\x1e.service.GenerateReportRequest\x1a\x1f.service.GenerateReportResponse\x12H\n" +
+	"\x0eGenerateReport\x12\x1e.service.GenerateReportRequest\x1a\x1f.service.GenerateReportResponse\x12V\n" +
+	"\x14GenerateReportStream\x12\x1e.service.GenerateReportRequest\x1a\x1c.service.ReportProgressEvent0\x01\x12H\n" +
 	"\vListReports\x12\x1b.service.ListReportsRequest\x1a\x1c.service.ListReportsResponse\x12N\n" +
 	"\rGetReportById\x12\x1d.service.GetReportByIdRequest\x1a\x1e.service.GetReportByIdResponse\x12]\n" +
 	"\x12CalculateRiskScore\x12\".service.CalculateRiskScoreRequest\x1a#.service.CalculateRiskScoreResponseB\x1fZ\x1dgithub.com/moos3/sparta/protob\x06proto3"
@@ -6688,272 +11958,450 @@ func file_proto_service_proto_rawDescGZIP() []byte {
 	return file_proto_service_proto_rawDescData
 }
 
-var file_proto_service_proto_msgTypes = make([]protoimpl.MessageInfo, 106)
+var file_proto_service_proto_msgTypes = make([]protoimpl.MessageInfo, 171)
 var file_proto_service_proto_goTypes = []any{
 	(*GenerateReportRequest)(nil),                 // 0: service.GenerateReportRequest
 	(*GenerateReportResponse)(nil),                // 1: service.GenerateReportResponse
-	(*ListReportsRequest)(nil),                    // 2: service.ListReportsRequest
-	(*Report)(nil),                                // 3: service.Report
-	(*ListReportsResponse)(nil),                   // 4: service.ListReportsResponse
-	(*GetReportByIdRequest)(nil),                  // 5: service.GetReportByIdRequest
-	(*GetReportByIdResponse)(nil),                 // 6: service.GetReportByIdResponse
-	(*CalculateRiskScoreRequest)(nil),             // 7: service.CalculateRiskScoreRequest
-	(*CalculateRiskScoreResponse)(nil),            // 8: service.CalculateRiskScoreResponse
-	(*CreateUserRequest)(nil),                     // 9: service.CreateUserRequest
-	(*CreateUserResponse)(nil),                    // 10: service.CreateUserResponse
-	(*GetUserRequest)(nil),                        // 11: service.GetUserRequest
-	(*GetUserResponse)(nil),                       // 12: service.GetUserResponse
-	(*UpdateUserRequest)(nil),                     // 13: service.UpdateUserRequest
-	(*UpdateUserResponse)(nil),                    // 14: service.UpdateUserResponse
-	(*DeleteUserRequest)(nil),                     // 15: service.DeleteUserRequest
-	(*DeleteUserResponse)(nil),                    // 16: service.DeleteUserResponse
-	(*ListUsersRequest)(nil),                      // 17: service.ListUsersRequest
-	(*ListUsersResponse)(nil),                     // 18: service.ListUsersResponse
-	(*User)(nil),                                  // 19: service.User
-	(*CreateAPIKeyRequest)(nil),                   // 20: service.CreateAPIKeyRequest
-	(*CreateAPIKeyResponse)(nil),                  // 21: service.CreateAPIKeyResponse
-	(*RotateAPIKeyRequest)(nil),                   // 22: service.RotateAPIKeyRequest
-	(*RotateAPIKeyResponse)(nil),                  // 23: service.RotateAPIKeyResponse
-	(*ActivateAPIKeyRequest)(nil),                 // 24: service.ActivateAPIKeyRequest
-	(*ActivateAPIKeyResponse)(nil),                // 25: service.ActivateAPIKeyResponse
-	(*DeactivateAPIKeyRequest)(nil),               // 26: service.DeactivateAPIKeyRequest
-	(*DeactivateAPIKeyResponse)(nil),              // 27: service.DeactivateAPIKeyResponse
-	(*ListAPIKeysRequest)(nil),                    // 28: service.ListAPIKeysRequest
-	(*ListAPIKeysResponse)(nil),                   // 29: service.ListAPIKeysResponse
-	(*APIKey)(nil),                                // 30: service.APIKey
-	(*ChangePasswordRequest)(nil),                 // 31: service.ChangePasswordRequest
-	(*ChangePasswordResponse)(nil),                // 32: service.ChangePasswordResponse
-	(*LoginRequest)(nil),                          // 33: service.LoginRequest
-	(*LoginResponse)(nil),                         // 34: service.LoginResponse
-	(*InviteUserRequest)(nil),                     // 35: service.InviteUserRequest
-	(*InviteUserResponse)(nil),                    // 36: service.InviteUserResponse
-	(*ValidateInviteRequest)(nil),                 // 37: service.ValidateInviteRequest
-	(*ValidateInviteResponse)(nil),                // 38: service.ValidateInviteResponse
-	(*ScanDomainRequest)(nil),                     // 39: service.ScanDomainRequest
-	(*ScanDomainResponse)(nil),                    // 40: service.ScanDomainResponse
-	(*GetDNSScanResultsByDomainRequest)(nil),      // 41: service.GetDNSScanResultsByDomainRequest
-	(*GetDNSScanResultsByDomainResponse)(nil),     // 42: service.GetDNSScanResultsByDomainResponse
-	(*GetDNSScanResultByIDRequest)(nil),           // 43: service.GetDNSScanResultByIDRequest
-	(*GetDNSScanResultByIDResponse)(nil),          // 44: service.GetDNSScanResultByIDResponse
-	(*DNSScanResult)(nil),                         // 45: service.DNSScanResult
-	(*ScanTLSRequest)(nil),                        // 46: service.ScanTLSRequest
-	(*ScanTLSResponse)(nil),                       // 47: service.ScanTLSResponse
-	(*GetTLSScanResultsByDomainRequest)(nil),      // 48: service.GetTLSScanResultsByDomainRequest
-	(*GetTLSScanResultsByDomainResponse)(nil),     // 49: service.GetTLSScanResultsByDomainResponse
-	(*TLSScanResult)(nil),                         // 50: service.TLSScanResult
-	(*ScanCrtShRequest)(nil),                      // 51: service.ScanCrtShRequest
-	(*ScanCrtShResponse)(nil),                     // 52: service.ScanCrtShResponse
-	(*GetCrtShScanResultsByDomainRequest)(nil),    // 53: service.GetCrtShScanResultsByDomainRequest
-	(*GetCrtShScanResultsByDomainResponse)(nil),   // 54: service.GetCrtShScanResultsByDomainResponse
-	(*CrtShScanResult)(nil),                       // 55: service.CrtShScanResult
-	(*ScanChaosRequest)(nil),                      // 56: service.ScanChaosRequest
-	(*ScanChaosResponse)(nil),                     // 57: service.ScanChaosResponse
-	(*GetChaosScanResultsByDomainRequest)(nil),    // 58: service.GetChaosScanResultsByDomainRequest
-	(*GetChaosScanResultsByDomainResponse)(nil),   // 59: service.GetChaosScanResultsByDomainResponse
-	(*ChaosScanResult)(nil),                       // 60: service.ChaosScanResult
-	(*ScanShodanRequest)(nil),                     // 61: service.ScanShodanRequest
-	(*ScanShodanResponse)(nil),                    // 62: service.ScanShodanResponse
-	(*GetShodanScanResultsByDomainRequest)(nil),   // 63: service.GetShodanScanResultsByDomainRequest
-	(*GetShodanScanResultsByDomainResponse)(nil),  // 64: service.GetShodanScanResultsByDomainResponse
-	(*DNSSecurityResult)(nil),                     // 65: service.DNSSecurityResult
-	(*TLSSecurityResult)(nil),                     // 66: service.TLSSecurityResult
-	(*CrtShCertificate)(nil),                      // 67: service.CrtShCertificate
-	(*CrtShSecurityResult)(nil),                   // 68: service.CrtShSecurityResult
-	(*ChaosSecurityResult)(nil),                   // 69: service.ChaosSecurityResult
-	(*ShodanScanResult)(nil),                      // 70: service.ShodanScanResult
-	(*ShodanLocation)(nil),                        // 71: service.ShodanLocation
-	(*ShodanSSL)(nil),                             // 72: service.ShodanSSL
-	(*ShodanMetadata)(nil),                        // 73: service.ShodanMetadata
-	(*ShodanHost)(nil),                            // 74: service.ShodanHost
-	(*ShodanSecurityResult)(nil),                  // 75: service.ShodanSecurityResult
-	(*ScanOTXRequest)(nil),                        // 76: service.ScanOTXRequest
-	(*ScanOTXResponse)(nil),                       // 77: service.ScanOTXResponse
-	(*GetOTXScanResultsByDomainRequest)(nil),      // 78: service.GetOTXScanResultsByDomainRequest
-	(*GetOTXScanResultsByDomainResponse)(nil),     // 79: service.GetOTXScanResultsByDomainResponse
-	(*OTXScanResult)(nil),                         // 80: service.OTXScanResult
-	(*OTXGeneralInfo)(nil),                        // 81: service.OTXGeneralInfo
-	(*OTXMalware)(nil),                            // 82: service.OTXMalware
-	(*OTXURL)(nil),                                // 83: service.OTXURL
-	(*OTXPassiveDNS)(nil),                         // 84: service.OTXPassiveDNS
-	(*OTXSecurityResult)(nil),                     // 85: service.OTXSecurityResult
-	(*ScanWhoisRequest)(nil),                      // 86: service.ScanWhoisRequest
-	(*ScanWhoisResponse)(nil),                     // 87: service.ScanWhoisResponse
-	(*GetWhoisScanResultsByDomainRequest)(nil),    // 88: service.GetWhoisScanResultsByDomainRequest
-	(*GetWhoisScanResultsByDomainResponse)(nil),   // 89: service.GetWhoisScanResultsByDomainResponse
-	(*WhoisScanResult)(nil),                       // 90: service.WhoisScanResult
-	(*WhoisSecurityResult)(nil),                   // 91: service.WhoisSecurityResult
-	(*AbuseChIOC)(nil),                            // 92: service.AbuseChIOC
-	(*AbuseChSecurityResult)(nil),                 // 93: service.AbuseChSecurityResult
-	(*ScanAbuseChRequest)(nil),                    // 94: service.ScanAbuseChRequest
-	(*ScanAbuseChResponse)(nil),                   // 95: service.ScanAbuseChResponse
-	(*GetAbuseChScanResultsByDomainRequest)(nil),  // 96: service.GetAbuseChScanResultsByDomainRequest
-	(*GetAbuseChScanResultsByDomainResponse)(nil), // 97: service.GetAbuseChScanResultsByDomainResponse
-	(*AbuseChScanResult)(nil),                     // 98: service.AbuseChScanResult
-	(*ScanISCRequest)(nil),                        // 99: service.ScanISCRequest
-	(*ScanISCResponse)(nil),                       // 100: service.ScanISCResponse
-	(*GetISCScanResultsByDomainRequest)(nil),      // 101: service.GetISCScanResultsByDomainRequest
-	(*GetISCScanResultsByDomainResponse)(nil),     // 102: service.GetISCScanResultsByDomainResponse
-	(*ISCScanResult)(nil),                         // 103: service.ISCScanResult
-	(*ISCIncident)(nil),                           // 104: service.ISCIncident
-	(*ISCSecurityResult)(nil),                     // 105: service.ISCSecurityResult
-	(*timestamppb.Timestamp)(nil),                 // 106: google.protobuf.Timestamp
+	(*ReportProgressEvent)(nil),                   // 2: service.ReportProgressEvent
+	(*ListReportsRequest)(nil),                    // 3: service.ListReportsRequest
+	(*Report)(nil),                                // 4: service.Report
+	(*ListReportsResponse)(nil),                   // 5: service.ListReportsResponse
+	(*GetReportByIdRequest)(nil),                  // 6: service.GetReportByIdRequest
+	(*GetReportByIdResponse)(nil),                 // 7: service.GetReportByIdResponse
+	(*CalculateRiskScoreRequest)(nil),             // 8: service.CalculateRiskScoreRequest
+	(*CalculateRiskScoreResponse)(nil),            // 9: service.CalculateRiskScoreResponse
+	(*CreateUserRequest)(nil),                     // 10: service.CreateUserRequest
+	(*CreateUserResponse)(nil),                    // 11: service.CreateUserResponse
+	(*GetUserRequest)(nil),                        // 12: service.GetUserRequest
+	(*GetUserResponse)(nil),                       // 13: service.GetUserResponse
+	(*UpdateUserRequest)(nil),                     // 14: service.UpdateUserRequest
+	(*UpdateUserResponse)(nil),                    // 15: service.UpdateUserResponse
+	(*DeleteUserRequest)(nil),                     // 16: service.DeleteUserRequest
+	(*DeleteUserResponse)(nil),                    // 17: service.DeleteUserResponse
+	(*ListUsersRequest)(nil),                      // 18: service.ListUsersRequest
+	(*ListUsersResponse)(nil),                     // 19: service.ListUsersResponse
+	(*User)(nil),                                  // 20: service.User
+	(*CreateAPIKeyRequest)(nil),                   // 21: service.CreateAPIKeyRequest
+	(*CreateAPIKeyResponse)(nil),                  // 22: service.CreateAPIKeyResponse
+	(*RotateAPIKeyRequest)(nil),                   // 23: service.RotateAPIKeyRequest
+	(*RotateAPIKeyResponse)(nil),                  // 24: service.RotateAPIKeyResponse
+	(*ActivateAPIKeyRequest)(nil),                 // 25: service.ActivateAPIKeyRequest
+	(*ActivateAPIKeyResponse)(nil),                // 26: service.ActivateAPIKeyResponse
+	(*DeactivateAPIKeyRequest)(nil),               // 27: service.DeactivateAPIKeyRequest
+	(*DeactivateAPIKeyResponse)(nil),              // 28: service.DeactivateAPIKeyResponse
+	(*ListAPIKeysRequest)(nil),                    // 29: service.ListAPIKeysRequest
+	(*ListAPIKeysResponse)(nil),                   // 30: service.ListAPIKeysResponse
+	(*APIKey)(nil),                                // 31: service.APIKey
+	(*ChangePasswordRequest)(nil),                 // 32: service.ChangePasswordRequest
+	(*ChangePasswordResponse)(nil),                // 33: service.ChangePasswordResponse
+	(*LoginRequest)(nil),                          // 34: service.LoginRequest
+	(*LoginResponse)(nil),                         // 35: service.LoginResponse
+	(*InviteUserRequest)(nil),                     // 36: service.InviteUserRequest
+	(*InviteUserResponse)(nil),                    // 37: service.InviteUserResponse
+	(*ValidateInviteRequest)(nil),                 // 38: service.ValidateInviteRequest
+	(*ValidateInviteResponse)(nil),                // 39: service.ValidateInviteResponse
+	(*ScanDomainRequest)(nil),                     // 40: service.ScanDomainRequest
+	(*ScanDomainResponse)(nil),                    // 41: service.ScanDomainResponse
+	(*GetDNSScanResultsByDomainRequest)(nil),      // 42: service.GetDNSScanResultsByDomainRequest
+	(*GetDNSScanResultsByDomainResponse)(nil),     // 43: service.GetDNSScanResultsByDomainResponse
+	(*GetDNSScanResultByIDRequest)(nil),           // 44: service.GetDNSScanResultByIDRequest
+	(*GetDNSScanResultByIDResponse)(nil),          // 45: service.GetDNSScanResultByIDResponse
+	(*DNSScanResult)(nil),                         // 46: service.DNSScanResult
+	(*ScanTLSRequest)(nil),                        // 47: service.ScanTLSRequest
+	(*ScanTLSResponse)(nil),                       // 48: service.ScanTLSResponse
+	(*GetTLSScanResultsByDomainRequest)(nil),      // 49: service.GetTLSScanResultsByDomainRequest
+	(*GetTLSScanResultsByDomainResponse)(nil),     // 50: service.GetTLSScanResultsByDomainResponse
+	(*TLSScanResult)(nil),                         // 51: service.TLSScanResult
+	(*ScanCrtShRequest)(nil),                      // 52: service.ScanCrtShRequest
+	(*ScanCrtShResponse)(nil),                     // 53: service.ScanCrtShResponse
+	(*GetCrtShScanResultsByDomainRequest)(nil),    // 54: service.GetCrtShScanResultsByDomainRequest
+	(*GetCrtShScanResultsByDomainResponse)(nil),   // 55: service.GetCrtShScanResultsByDomainResponse
+	(*CrtShScanResult)(nil),                       // 56: service.CrtShScanResult
+	(*ScanChaosRequest)(nil),                      // 57: service.ScanChaosRequest
+	(*ScanChaosResponse)(nil),                     // 58: service.ScanChaosResponse
+	(*GetChaosScanResultsByDomainRequest)(nil),    // 59: service.GetChaosScanResultsByDomainRequest
+	(*GetChaosScanResultsByDomainResponse)(nil),   // 60: service.GetChaosScanResultsByDomainResponse
+	(*ChaosScanResult)(nil),                       // 61: service.ChaosScanResult
+	(*ScanShodanRequest)(nil),                     // 62: service.ScanShodanRequest
+	(*ScanShodanResponse)(nil),                    // 63: service.ScanShodanResponse
+	(*GetShodanScanResultsByDomainRequest)(nil),   // 64: service.GetShodanScanResultsByDomainRequest
+	(*GetShodanScanResultsByDomainResponse)(nil),  // 65: service.GetShodanScanResultsByDomainResponse
+	(*DNSSecurityResult)(nil),                     // 66: service.DNSSecurityResult
+	(*MTASTSResult)(nil),                          // 67: service.MTASTSResult
+	(*TLSRPTResult)(nil),                          // 68: service.TLSRPTResult
+	(*BIMIResult)(nil),                            // 69: service.BIMIResult
+	(*DANEResult)(nil),                            // 70: service.DANEResult
+	(*DNSSECZone)(nil),                            // 71: service.DNSSECZone
+	(*DNSSECFinding)(nil),                         // 72: service.DNSSECFinding
+	(*SPFEvaluation)(nil),                         // 73: service.SPFEvaluation
+	(*DKIMSelector)(nil),                          // 74: service.DKIMSelector
+	(*TLSSecurityResult)(nil),                     // 75: service.TLSSecurityResult
+	(*TLSEndpoint)(nil),                           // 76: service.TLSEndpoint
+	(*CrtShCertificate)(nil),                      // 77: service.CrtShCertificate
+	(*CrtShSecurityResult)(nil),                   // 78: service.CrtShSecurityResult
+	(*ChaosSecurityResult)(nil),                   // 79: service.ChaosSecurityResult
+	(*ShodanScanResult)(nil),                      // 80: service.ShodanScanResult
+	(*ShodanLocation)(nil),                        // 81: service.ShodanLocation
+	(*ShodanSSL)(nil),                             // 82: service.ShodanSSL
+	(*ShodanMetadata)(nil),                        // 83: service.ShodanMetadata
+	(*ShodanHost)(nil),                            // 84: service.ShodanHost
+	(*ShodanSecurityResult)(nil),                  // 85: service.ShodanSecurityResult
+	(*ScanOTXRequest)(nil),                        // 86: service.ScanOTXRequest
+	(*ScanOTXResponse)(nil),                       // 87: service.ScanOTXResponse
+	(*GetOTXScanResultsByDomainRequest)(nil),      // 88: service.GetOTXScanResultsByDomainRequest
+	(*GetOTXScanResultsByDomainResponse)(nil),     // 89: service.GetOTXScanResultsByDomainResponse
+	(*OTXScanResult)(nil),                         // 90: service.OTXScanResult
+	(*OTXGeneralInfo)(nil),                        // 91: service.OTXGeneralInfo
+	(*OTXMalware)(nil),                            // 92: service.OTXMalware
+	(*OTXURL)(nil),                                // 93: service.OTXURL
+	(*OTXPassiveDNS)(nil),                         // 94: service.OTXPassiveDNS
+	(*OTXSecurityResult)(nil),                     // 95: service.OTXSecurityResult
+	(*OTXIPReputation)(nil),                       // 96: service.OTXIPReputation
+	(*ScanWhoisRequest)(nil),                      // 97: service.ScanWhoisRequest
+	(*ScanWhoisResponse)(nil),                     // 98: service.ScanWhoisResponse
+	(*GetWhoisScanResultsByDomainRequest)(nil),    // 99: service.GetWhoisScanResultsByDomainRequest
+	(*GetWhoisScanResultsByDomainResponse)(nil),   // 100: service.GetWhoisScanResultsByDomainResponse
+	(*WhoisScanResult)(nil),                       // 101: service.WhoisScanResult
+	(*WhoisSecurityResult)(nil),                   // 102: service.WhoisSecurityResult
+	(*AbuseChIOC)(nil),                            // 103: service.AbuseChIOC
+	(*AbuseChSecurityResult)(nil),                 // 104: service.AbuseChSecurityResult
+	(*ScanAbuseChRequest)(nil),                    // 105: service.ScanAbuseChRequest
+	(*ScanAbuseChResponse)(nil),                   // 106: service.ScanAbuseChResponse
+	(*GetAbuseChScanResultsByDomainRequest)(nil),  // 107: service.GetAbuseChScanResultsByDomainRequest
+	(*GetAbuseChScanResultsByDomainResponse)(nil), // 108: service.GetAbuseChScanResultsByDomainResponse
+	(*AbuseChScanResult)(nil),                     // 109: service.AbuseChScanResult
+	(*ScanISCRequest)(nil),                        // 110: service.ScanISCRequest
+	(*ScanISCResponse)(nil),                       // 111: service.ScanISCResponse
+	(*GetISCScanResultsByDomainRequest)(nil),      // 112: service.GetISCScanResultsByDomainRequest
+	(*GetISCScanResultsByDomainResponse)(nil),     // 113: service.GetISCScanResultsByDomainResponse
+	(*ISCScanResult)(nil),                         // 114: service.ISCScanResult
+	(*ISCIncident)(nil),                           // 115: service.ISCIncident
+	(*ISCSecurityResult)(nil),                     // 116: service.ISCSecurityResult
+	(*ISCIPReputation)(nil),                       // 117: service.ISCIPReputation
+	(*ListPluginsRequest)(nil),                    // 118: service.ListPluginsRequest
+	(*ListPluginsResponse)(nil),                   // 119: service.ListPluginsResponse
+	(*PluginInfo)(nil),                            // 120: service.PluginInfo
+	(*SubmitScanJobRequest)(nil),                  // 121: service.SubmitScanJobRequest
+	(*SubmitScanJobResponse)(nil),                 // 122: service.SubmitScanJobResponse
+	(*GetScanJobRequest)(nil),                     // 123: service.GetScanJobRequest
+	(*GetScanJobResponse)(nil),                    // 124: service.GetScanJobResponse
+	(*ListScanJobsRequest)(nil),                   // 125: service.ListScanJobsRequest
+	(*ListScanJobsResponse)(nil),                  // 126: service.ListScanJobsResponse
+	(*CancelScanJobRequest)(nil),                  // 127: service.CancelScanJobRequest
+	(*CancelScanJobResponse)(nil),                 // 128: service.CancelScanJobResponse
+	(*ScanJob)(nil),                               // 129: service.ScanJob
+	(*ScanJobPlugin)(nil),                         // 130: service.ScanJobPlugin
+	(*GetScanRunRequest)(nil),                     // 131: service.GetScanRunRequest
+	(*GetScanRunResponse)(nil),                    // 132: service.GetScanRunResponse
+	(*ScanRun)(nil),                               // 133: service.ScanRun
+	(*ScanRunResult)(nil),                         // 134: service.ScanRunResult
+	(*ExternalSecurityResult)(nil),                // 135: service.ExternalSecurityResult
+	(*CreateScanScheduleRequest)(nil),             // 136: service.CreateScanScheduleRequest
+	(*CreateScanScheduleResponse)(nil),            // 137: service.CreateScanScheduleResponse
+	(*UpdateScanScheduleRequest)(nil),             // 138: service.UpdateScanScheduleRequest
+	(*UpdateScanScheduleResponse)(nil),            // 139: service.UpdateScanScheduleResponse
+	(*PauseScanScheduleRequest)(nil),              // 140: service.PauseScanScheduleRequest
+	(*PauseScanScheduleResponse)(nil),             // 141: service.PauseScanScheduleResponse
+	(*ListScanSchedulesRequest)(nil),              // 142: service.ListScanSchedulesRequest
+	(*ListScanSchedulesResponse)(nil),             // 143: service.ListScanSchedulesResponse
+	(*ScanSchedule)(nil),                          // 144: service.ScanSchedule
+	(*BulkScanRequest)(nil),                       // 145: service.BulkScanRequest
+	(*BulkScanResponse)(nil),                      // 146: service.BulkScanResponse
+	(*BulkScanRejected)(nil),                      // 147: service.BulkScanRejected
+	(*GetScanBatchRequest)(nil),                   // 148: service.GetScanBatchRequest
+	(*GetScanBatchResponse)(nil),                  // 149: service.GetScanBatchResponse
+	(*ScanBatch)(nil),                             // 150: service.ScanBatch
+	(*ScanBatchSummary)(nil),                      // 151: service.ScanBatchSummary
+	(*RiskTierCount)(nil),                         // 152: service.RiskTierCount
+	(*ScanBatchFailure)(nil),                      // 153: service.ScanBatchFailure
+	(*ScanProfile)(nil),                           // 154: service.ScanProfile
+	(*ScanProfileOption)(nil),                     // 155: service.ScanProfileOption
+	(*ScanProfileTimeout)(nil),                    // 156: service.ScanProfileTimeout
+	(*SaveScanProfileRequest)(nil),                // 157: service.SaveScanProfileRequest
+	(*SaveScanProfileResponse)(nil),               // 158: service.SaveScanProfileResponse
+	(*ListScanProfilesRequest)(nil),               // 159: service.ListScanProfilesRequest
+	(*ListScanProfilesResponse)(nil),              // 160: service.ListScanProfilesResponse
+	(*DeleteScanProfileRequest)(nil),              // 161: service.DeleteScanProfileRequest
+	(*DeleteScanProfileResponse)(nil),             // 162: service.DeleteScanProfileResponse
+	(*GetProviderUsageRequest)(nil),               // 163: service.GetProviderUsageRequest
+	(*GetProviderUsageResponse)(nil),              // 164: service.GetProviderUsageResponse
+	(*ProviderUsage)(nil),                         // 165: service.ProviderUsage
+	(*GetProviderHealthRequest)(nil),              // 166: service.GetProviderHealthRequest
+	(*GetProviderHealthResponse)(nil),             // 167: service.GetProviderHealthResponse
+	(*ProviderHealth)(nil),                        // 168: service.ProviderHealth
+	(*DescribeConfigRequest)(nil),                 // 169: service.DescribeConfigRequest
+	(*DescribeConfigResponse)(nil),                // 170: service.DescribeConfigResponse
+	(*timestamppb.Timestamp)(nil),                 // 171: google.protobuf.Timestamp
 }
 var file_proto_service_proto_depIdxs = []int32{
-	106, // 0: service.GenerateReportResponse.created_at:type_name -> google.protobuf.Timestamp
-	106, // 1: service.Report.created_at:type_name -> google.protobuf.Timestamp
-	3,   // 2: service.ListReportsResponse.reports:type_name -> service.Report
-	3,   // 3: service.GetReportByIdResponse.report:type_name -> service.Report
-	106, // 4: service.GetUserResponse.created_at:type_name -> google.protobuf.Timestamp
-	19,  // 5: service.ListUsersResponse.users:type_name -> service.User
-	106, // 6: service.User.created_at:type_name -> google.protobuf.Timestamp
-	106, // 7: service.CreateAPIKeyResponse.expires_at:type_name -> google.protobuf.Timestamp
-	106, // 8: service.RotateAPIKeyResponse.expires_at:type_name -> google.protobuf.Timestamp
-	30,  // 9: service.ListAPIKeysResponse.api_keys:type_name -> service.APIKey
-	106, // 10: service.APIKey.created_at:type_name -> google.protobuf.Timestamp
-	106, // 11: service.APIKey.expires_at:type_name -> google.protobuf.Timestamp
-	106, // 12: service.InviteUserResponse.expires_at:type_name -> google.protobuf.Timestamp
-	65,  // 13: service.ScanDomainResponse.result:type_name -> service.DNSSecurityResult
-	45,  // 14: service.GetDNSScanResultsByDomainResponse.results:type_name -> service.DNSScanResult
-	45,  // 15: service.GetDNSScanResultByIDResponse.result:type_name -> service.DNSScanResult
-	65,  // 16: service.DNSScanResult.result:type_name -> service.DNSSecurityResult
-	106, // 17: service.DNSScanResult.created_at:type_name -> google.protobuf.Timestamp
-	66,  // 18: service.ScanTLSResponse.result:type_name -> service.TLSSecurityResult
-	50,  // 19: service.GetTLSScanResultsByDomainResponse.results:type_name -> service.TLSScanResult
-	66,  // 20: service.TLSScanResult.result:type_name -> service.TLSSecurityResult
-	106, // 21: service.TLSScanResult.created_at:type_name -> google.protobuf.Timestamp
-	68,  // 22: service.ScanCrtShResponse.result:type_name -> service.CrtShSecurityResult
-	55,  // 23: service.GetCrtShScanResultsByDomainResponse.results:type_name -> service.CrtShScanResult
-	68,  // 24: service.CrtShScanResult.result:type_name -> service.CrtShSecurityResult
-	106, // 25: service.CrtShScanResult.created_at:type_name -> google.protobuf.Timestamp
-	69,  // 26: service.ScanChaosResponse.result:type_name -> service.ChaosSecurityResult
-	60,  // 27: service.GetChaosScanResultsByDomainResponse.results:type_name -> service.ChaosScanResult
-	69,  // 28: service.ChaosScanResult.result:type_name -> service.ChaosSecurityResult
-	106, // 29: service.ChaosScanResult.created_at:type_name -> google.protobuf.Timestamp
-	75,  // 30: service.ScanShodanResponse.result:type_name -> service.ShodanSecurityResult
-	70,  // 31: service.GetShodanScanResultsByDomainResponse.results:type_name -> service.ShodanScanResult
-	106, // 32: service.TLSSecurityResult.cert_not_before:type_name -> google.protobuf.Timestamp
-	106, // 33: service.TLSSecurityResult.cert_not_after:type_name -> google.protobuf.Timestamp
-	106, // 34: service.CrtShCertificate.not_before:type_name -> google.protobuf.Timestamp
-	106, // 35: service.CrtShCertificate.not_after:type_name -> google.protobuf.Timestamp
-	67,  // 36: service.CrtShSecurityResult.certificates:type_name -> service.CrtShCertificate
-	75,  // 37: service.ShodanScanResult.result:type_name -> service.ShodanSecurityResult
-	106, // 38: service.ShodanScanResult.created_at:type_name -> google.protobuf.Timestamp
-	106, // 39: service.ShodanSSL.expires:type_name -> google.protobuf.Timestamp
-	106, // 40: service.ShodanSSL.not_after:type_name -> google.protobuf.Timestamp
-	71,  // 41: service.ShodanHost.location:type_name -> service.ShodanLocation
-	72,  // 42: service.ShodanHost.ssl:type_name -> service.ShodanSSL
-	106, // 43: service.ShodanHost.timestamp:type_name -> google.protobuf.Timestamp
-	73,  // 44: service.ShodanHost.shodan_meta:type_name -> service.ShodanMetadata
-	74,  // 45: service.ShodanSecurityResult.hosts:type_name -> service.ShodanHost
-	85,  // 46: service.ScanOTXResponse.result:type_name -> service.OTXSecurityResult
-	80,  // 47: service.GetOTXScanResultsByDomainResponse.results:type_name -> service.OTXScanResult
-	85,  // 48: service.OTXScanResult.result:type_name -> service.OTXSecurityResult
-	106, // 49: service.OTXScanResult.created_at:type_name -> google.protobuf.Timestamp
-	106, // 50: service.OTXMalware.datetime:type_name -> google.protobuf.Timestamp
-	106, // 51: service.OTXURL.datetime:type_name -> google.protobuf.Timestamp
-	106, // 52: service.OTXPassiveDNS.datetime:type_name -> google.protobuf.Timestamp
-	81,  // 53: service.OTXSecurityResult.general_info:type_name -> service.OTXGeneralInfo
-	82,  // 54: service.OTXSecurityResult.malware:type_name -> service.OTXMalware
-	83,  // 55: service.OTXSecurityResult.urls:type_name -> service.OTXURL
-	84,  // 56: service.OTXSecurityResult.passive_dns:type_name -> service.OTXPassiveDNS
-	91,  // 57: service.ScanWhoisResponse.result:type_name -> service.WhoisSecurityResult
-	90,  // 58: service.GetWhoisScanResultsByDomainResponse.results:type_name -> service.WhoisScanResult
-	91,  // 59: service.WhoisScanResult.result:type_name -> service.WhoisSecurityResult
-	106, // 60: service.WhoisScanResult.created_at:type_name -> google.protobuf.Timestamp
-	106, // 61: service.WhoisSecurityResult.creation_date:type_name -> google.protobuf.Timestamp
-	106, // 62: service.WhoisSecurityResult.expiry_date:type_name -> google.protobuf.Timestamp
-	106, // 63: service.AbuseChIOC.first_seen:type_name -> google.protobuf.Timestamp
-	106, // 64: service.AbuseChIOC.last_seen:type_name -> google.protobuf.Timestamp
-	92,  // 65: service.AbuseChSecurityResult.iocs:type_name -> service.AbuseChIOC
-	93,  // 66: service.ScanAbuseChResponse.result:type_name -> service.AbuseChSecurityResult
-	98,  // 67: service.GetAbuseChScanResultsByDomainResponse.results:type_name -> service.AbuseChScanResult
-	93,  // 68: service.AbuseChScanResult.result:type_name -> service.AbuseChSecurityResult
-	106, // 69: service.AbuseChScanResult.created_at:type_name -> google.protobuf.Timestamp
-	105, // 70: service.ScanISCResponse.result:type_name -> service.ISCSecurityResult
-	103, // 71: service.GetISCScanResultsByDomainResponse.results:type_name -> service.ISCScanResult
-	105, // 72: service.ISCScanResult.result:type_name -> service.ISCSecurityResult
-	106, // 73: service.ISCScanResult.created_at:type_name -> google.protobuf.Timestamp
-	106, // 74: service.ISCIncident.date:type_name -> google.protobuf.Timestamp
-	104, // 75: service.ISCSecurityResult.incidents:type_name -> service.ISCIncident
-	9,   // 76: service.AuthService.CreateUser:input_type -> service.CreateUserRequest
-	11,  // 77: service.AuthService.GetUser:input_type -> service.GetUserRequest
-	13,  // 78: service.AuthService.UpdateUser:input_type -> service.UpdateUserRequest
-	15,  // 79: service.AuthService.DeleteUser:input_type -> service.DeleteUserRequest
-	17,  // 80: service.AuthService.ListUsers:input_type -> service.ListUsersRequest
-	33,  // 81: service.AuthService.Login:input_type -> service.LoginRequest
-	35,  // 82: service.AuthService.InviteUser:input_type -> service.InviteUserRequest
-	37,  // 83: service.AuthService.ValidateInvite:input_type -> service.ValidateInviteRequest
-	20,  // 84: service.UserService.CreateAPIKey:input_type -> service.CreateAPIKeyRequest
-	22,  // 85: service.UserService.RotateAPIKey:input_type -> service.RotateAPIKeyRequest
-	24,  // 86: service.UserService.ActivateAPIKey:input_type -> service.ActivateAPIKeyRequest
-	26,  // 87: service.UserService.DeactivateAPIKey:input_type -> service.DeactivateAPIKeyRequest
-	28,  // 88: service.UserService.ListAPIKeys:input_type -> service.ListAPIKeysRequest
-	31,  // 89: service.UserService.ChangePassword:input_type -> service.ChangePasswordRequest
-	39,  // 90: service.ScanService.ScanDomain:input_type -> service.ScanDomainRequest
-	46,  // 91: service.ScanService.ScanTLS:input_type -> service.ScanTLSRequest
-	51,  // 92: service.ScanService.ScanCrtSh:input_type -> service.ScanCrtShRequest
-	56,  // 93: service.ScanService.ScanChaos:input_type -> service.ScanChaosRequest
-	61,  // 94: service.ScanService.ScanShodan:input_type -> service.ScanShodanRequest
-	76,  // 95: service.ScanService.ScanOTX:input_type -> service.ScanOTXRequest
-	86,  // 96: service.ScanService.ScanWhois:input_type -> service.ScanWhoisRequest
-	94,  // 97: service.ScanService.ScanAbuseCh:input_type -> service.ScanAbuseChRequest
-	99,  // 98: service.ScanService.ScanISC:input_type -> service.ScanISCRequest
-	41,  // 99: service.ScanService.GetDNSScanResultsByDomain:input_type -> service.GetDNSScanResultsByDomainRequest
-	48,  // 100: service.ScanService.GetTLSScanResultsByDomain:input_type -> service.GetTLSScanResultsByDomainRequest
-	53,  // 101: service.ScanService.GetCrtShScanResultsByDomain:input_type -> service.GetCrtShScanResultsByDomainRequest
-	58,  // 102: service.ScanService.GetChaosScanResultsByDomain:input_type -> service.GetChaosScanResultsByDomainRequest
-	63,  // 103: service.ScanService.GetShodanScanResultsByDomain:input_type -> service.GetShodanScanResultsByDomainRequest
-	78,  // 104: service.ScanService.GetOTXScanResultsByDomain:input_type -> service.GetOTXScanResultsByDomainRequest
-	88,  // 105: service.ScanService.GetWhoisScanResultsByDomain:input_type -> service.GetWhoisScanResultsByDomainRequest
-	96,  // 106: service.ScanService.GetAbuseChScanResultsByDomain:input_type -> service.GetAbuseChScanResultsByDomainRequest
-	101, // 107: service.ScanService.GetISCScanResultsByDomain:input_type -> service.GetISCScanResultsByDomainRequest
-	43,  // 108: service.ScanService.GetDNSScanResultByID:input_type -> service.GetDNSScanResultByIDRequest
-	0,   // 109: service.ReportService.GenerateReport:input_type -> service.GenerateReportRequest
-	2,   // 110: service.ReportService.ListReports:input_type -> service.ListReportsRequest
-	5,   // 111: service.ReportService.GetReportById:input_type -> service.GetReportByIdRequest
-	7,   // 112: service.ReportService.CalculateRiskScore:input_type -> service.CalculateRiskScoreRequest
-	10,  // 113: service.AuthService.CreateUser:output_type -> service.CreateUserResponse
-	12,  // 114: service.AuthService.GetUser:output_type -> service.GetUserResponse
-	14,  // 115: service.AuthService.UpdateUser:output_type -> service.UpdateUserResponse
-	16,  // 116: service.AuthService.DeleteUser:output_type -> service.DeleteUserResponse
-	18,  // 117: service.AuthService.ListUsers:output_type -> service.ListUsersResponse
-	34,  // 118: service.AuthService.Login:output_type -> service.LoginResponse
-	36,  // 119: service.AuthService.InviteUser:output_type -> service.InviteUserResponse
-	38,  // 120: service.AuthService.ValidateInvite:output_type -> service.ValidateInviteResponse
-	21,  // 121: service.UserService.CreateAPIKey:output_type -> service.CreateAPIKeyResponse
-	23,  // 122: service.UserService.RotateAPIKey:output_type -> service.RotateAPIKeyResponse
-	25,  // 123: service.UserService.ActivateAPIKey:output_type -> service.ActivateAPIKeyResponse
-	27,  // 124: service.UserService.DeactivateAPIKey:output_type -> service.DeactivateAPIKeyResponse
-	29,  // 125: service.UserService.ListAPIKeys:output_type -> service.ListAPIKeysResponse
-	32,  // 126: service.UserService.ChangePassword:output_type -> service.ChangePasswordResponse
-	40,  // 127: service.ScanService.ScanDomain:output_type -> service.ScanDomainResponse
-	47,  // 128: service.ScanService.ScanTLS:output_type -> service.ScanTLSResponse
-	52,  // 129: service.ScanService.ScanCrtSh:output_type -> service.ScanCrtShResponse
-	57,  // 130: service.ScanService.ScanChaos:output_type -> service.ScanChaosResponse
-	62,  // 131: service.ScanService.ScanShodan:output_type -> service.ScanShodanResponse
-	77,  // 132: service.ScanService.ScanOTX:output_type -> service.ScanOTXResponse
-	87,  // 133: service.ScanService.ScanWhois:output_type -> service.ScanWhoisResponse
-	95,  // 134: service.ScanService.ScanAbuseCh:output_type -> service.ScanAbuseChResponse
-	100, // 135: service.ScanService.ScanISC:output_type -> service.ScanISCResponse
-	42,  // 136: service.ScanService.GetDNSScanResultsByDomain:output_type -> service.GetDNSScanResultsByDomainResponse
-	49,  // 137: service.ScanService.GetTLSScanResultsByDomain:output_type -> service.GetTLSScanResultsByDomainResponse
-	54,  // 138: service.ScanService.GetCrtShScanResultsByDomain:output_type -> service.GetCrtShScanResultsByDomainResponse
-	59,  // 139: service.ScanService.GetChaosScanResultsByDomain:output_type -> service.GetChaosScanResultsByDomainResponse
-	64,  // 140: service.ScanService.GetShodanScanResultsByDomain:output_type -> service.GetShodanScanResultsByDomainResponse
-	79,  // 141: service.ScanService.GetOTXScanResultsByDomain:output_type -> service.GetOTXScanResultsByDomainResponse
-	89,  // 142: service.ScanService.GetWhoisScanResultsByDomain:output_type -> service.GetWhoisScanResultsByDomainResponse
-	97,  // 143: service.ScanService.GetAbuseChScanResultsByDomain:output_type -> service.GetAbuseChScanResultsByDomainResponse
-	102, // 144: service.ScanService.GetISCScanResultsByDomain:output_type -> service.GetISCScanResultsByDomainResponse
-	44,  // 145: service.ScanService.GetDNSScanResultByID:output_type -> service.GetDNSScanResultByIDResponse
-	1,   // 146: service.ReportService.GenerateReport:output_type -> service.GenerateReportResponse
-	4,   // 147: service.ReportService.ListReports:output_type -> service.ListReportsResponse
-	6,   // 148: service.ReportService.GetReportById:output_type -> service.GetReportByIdResponse
-	8,   // 149: service.ReportService.CalculateRiskScore:output_type -> service.CalculateRiskScoreResponse
-	113, // [113:150] is the sub-list for method output_type
-	76,  // [76:113] is the sub-list for method input_type
-	76,  // [76:76] is the sub-list for extension type_name
-	76,  // [76:76] is the sub-list for extension extendee
-	0,   // [0:76] is the sub-list for field type_name
+	171, // 0: service.GenerateReportResponse.created_at:type_name -> google.protobuf.Timestamp
+	66,  // 1: service.ReportProgressEvent.dns_result:type_name -> service.DNSSecurityResult
+	75,  // 2: service.ReportProgressEvent.tls_result:type_name -> service.TLSSecurityResult
+	78,  // 3: service.ReportProgressEvent.crtsh_result:type_name -> service.CrtShSecurityResult
+	79,  // 4: service.ReportProgressEvent.chaos_result:type_name -> service.ChaosSecurityResult
+	85,  // 5: service.ReportProgressEvent.shodan_result:type_name -> service.ShodanSecurityResult
+	95,  // 6: service.ReportProgressEvent.otx_result:type_name -> service.OTXSecurityResult
+	102, // 7: service.ReportProgressEvent.whois_result:type_name -> service.WhoisSecurityResult
+	104, // 8: service.ReportProgressEvent.abusech_result:type_name -> service.AbuseChSecurityResult
+	116, // 9: service.ReportProgressEvent.isc_result:type_name -> service.ISCSecurityResult
+	135, // 10: service.ReportProgressEvent.external_result:type_name -> service.ExternalSecurityResult
+	171, // 11: service.Report.created_at:type_name -> google.protobuf.Timestamp
+	4,   // 12: service.ListReportsResponse.reports:type_name -> service.Report
+	4,   // 13: service.GetReportByIdResponse.report:type_name -> service.Report
+	171, // 14: service.GetUserResponse.created_at:type_name -> google.protobuf.Timestamp
+	20,  // 15: service.ListUsersResponse.users:type_name -> service.User
+	171, // 16: service.User.created_at:type_name -> google.protobuf.Timestamp
+	171, // 17: service.CreateAPIKeyResponse.expires_at:type_name -> google.protobuf.Timestamp
+	171, // 18: service.RotateAPIKeyResponse.expires_at:type_name -> google.protobuf.Timestamp
+	31,  // 19: service.ListAPIKeysResponse.api_keys:type_name -> service.APIKey
+	171, // 20: service.APIKey.created_at:type_name -> google.protobuf.Timestamp
+	171, // 21: service.APIKey.expires_at:type_name -> google.protobuf.Timestamp
+	171, // 22: service.InviteUserResponse.expires_at:type_name -> google.protobuf.Timestamp
+	66,  // 23: service.ScanDomainResponse.result:type_name -> service.DNSSecurityResult
+	46,  // 24: service.GetDNSScanResultsByDomainResponse.results:type_name -> service.DNSScanResult
+	46,  // 25: service.GetDNSScanResultByIDResponse.result:type_name -> service.DNSScanResult
+	66,  // 26: service.DNSScanResult.result:type_name -> service.DNSSecurityResult
+	171, // 27: service.DNSScanResult.created_at:type_name -> google.protobuf.Timestamp
+	75,  // 28: service.ScanTLSResponse.result:type_name -> service.TLSSecurityResult
+	51,  // 29: service.GetTLSScanResultsByDomainResponse.results:type_name -> service.TLSScanResult
+	75,  // 30: service.TLSScanResult.result:type_name -> service.TLSSecurityResult
+	171, // 31: service.TLSScanResult.created_at:type_name -> google.protobuf.Timestamp
+	78,  // 32: service.ScanCrtShResponse.result:type_name -> service.CrtShSecurityResult
+	56,  // 33: service.GetCrtShScanResultsByDomainResponse.results:type_name -> service.CrtShScanResult
+	78,  // 34: service.CrtShScanResult.result:type_name -> service.CrtShSecurityResult
+	171, // 35: service.CrtShScanResult.created_at:type_name -> google.protobuf.Timestamp
+	79,  // 36: service.ScanChaosResponse.result:type_name -> service.ChaosSecurityResult
+	61,  // 37: service.GetChaosScanResultsByDomainResponse.results:type_name -> service.ChaosScanResult
+	79,  // 38: service.ChaosScanResult.result:type_name -> service.ChaosSecurityResult
+	171, // 39: service.ChaosScanResult.created_at:type_name -> google.protobuf.Timestamp
+	85,  // 40: service.ScanShodanResponse.result:type_name -> service.ShodanSecurityResult
+	80,  // 41: service.GetShodanScanResultsByDomainResponse.results:type_name -> service.ShodanScanResult
+	74,  // 42: service.DNSSecurityResult.dkim_selectors:type_name -> service.DKIMSelector
+	73,  // 43: service.DNSSecurityResult.spf_evaluation:type_name -> service.SPFEvaluation
+	71,  // 44: service.DNSSecurityResult.dnssec_chain:type_name -> service.DNSSECZone
+	72,  // 45: service.DNSSecurityResult.dnssec_findings:type_name -> service.DNSSECFinding
+	67,  // 46: service.DNSSecurityResult.mta_sts:type_name -> service.MTASTSResult
+	68,  // 47: service.DNSSecurityResult.tls_rpt:type_name -> service.TLSRPTResult
+	69,  // 48: service.DNSSecurityResult.bimi:type_name -> service.BIMIResult
+	70,  // 49: service.DNSSecurityResult.dane:type_name -> service.DANEResult
+	171, // 50: service.TLSSecurityResult.cert_not_before:type_name -> google.protobuf.Timestamp
+	171, // 51: service.TLSSecurityResult.cert_not_after:type_name -> google.protobuf.Timestamp
+	76,  // 52: service.TLSSecurityResult.endpoints:type_name -> service.TLSEndpoint
+	171, // 53: service.CrtShCertificate.not_before:type_name -> google.protobuf.Timestamp
+	171, // 54: service.CrtShCertificate.not_after:type_name -> google.protobuf.Timestamp
+	77,  // 55: service.CrtShSecurityResult.certificates:type_name -> service.CrtShCertificate
+	85,  // 56: service.ShodanScanResult.result:type_name -> service.ShodanSecurityResult
+	171, // 57: service.ShodanScanResult.created_at:type_name -> google.protobuf.Timestamp
+	171, // 58: service.ShodanSSL.expires:type_name -> google.protobuf.Timestamp
+	171, // 59: service.ShodanSSL.not_after:type_name -> google.protobuf.Timestamp
+	81,  // 60: service.ShodanHost.location:type_name -> service.ShodanLocation
+	82,  // 61: service.ShodanHost.ssl:type_name -> service.ShodanSSL
+	171, // 62: service.ShodanHost.timestamp:type_name -> google.protobuf.Timestamp
+	83,  // 63: service.ShodanHost.shodan_meta:type_name -> service.ShodanMetadata
+	84,  // 64: service.ShodanSecurityResult.hosts:type_name -> service.ShodanHost
+	95,  // 65: service.ScanOTXResponse.result:type_name -> service.OTXSecurityResult
+	90,  // 66: service.GetOTXScanResultsByDomainResponse.results:type_name -> service.OTXScanResult
+	95,  // 67: service.OTXScanResult.result:type_name -> service.OTXSecurityResult
+	171, // 68: service.OTXScanResult.created_at:type_name -> google.protobuf.Timestamp
+	171, // 69: service.OTXMalware.datetime:type_name -> google.protobuf.Timestamp
+	171, // 70: service.OTXURL.datetime:type_name -> google.protobuf.Timestamp
+	171, // 71: service.OTXPassiveDNS.datetime:type_name -> google.protobuf.Timestamp
+	91,  // 72: service.OTXSecurityResult.general_info:type_name -> service.OTXGeneralInfo
+	92,  // 73: service.OTXSecurityResult.malware:type_name -> service.OTXMalware
+	93,  // 74: service.OTXSecurityResult.urls:type_name -> service.OTXURL
+	94,  // 75: service.OTXSecurityResult.passive_dns:type_name -> service.OTXPassiveDNS
+	96,  // 76: service.OTXSecurityResult.ip_reputation:type_name -> service.OTXIPReputation
+	102, // 77: service.ScanWhoisResponse.result:type_name -> service.WhoisSecurityResult
+	101, // 78: service.GetWhoisScanResultsByDomainResponse.results:type_name -> service.WhoisScanResult
+	102, // 79: service.WhoisScanResult.result:type_name -> service.WhoisSecurityResult
+	171, // 80: service.WhoisScanResult.created_at:type_name -> google.protobuf.Timestamp
+	171, // 81: service.WhoisSecurityResult.creation_date:type_name -> google.protobuf.Timestamp
+	171, // 82: service.WhoisSecurityResult.expiry_date:type_name -> google.protobuf.Timestamp
+	171, // 83: service.AbuseChIOC.first_seen:type_name -> google.protobuf.Timestamp
+	171, // 84: service.AbuseChIOC.last_seen:type_name -> google.protobuf.Timestamp
+	103, // 85: service.AbuseChSecurityResult.iocs:type_name -> service.AbuseChIOC
+	104, // 86: service.ScanAbuseChResponse.result:type_name -> service.AbuseChSecurityResult
+	109, // 87: service.GetAbuseChScanResultsByDomainResponse.results:type_name -> service.AbuseChScanResult
+	104, // 88: service.AbuseChScanResult.result:type_name -> service.AbuseChSecurityResult
+	171, // 89: service.AbuseChScanResult.created_at:type_name -> google.protobuf.Timestamp
+	116, // 90: service.ScanISCResponse.result:type_name -> service.ISCSecurityResult
+	114, // 91: service.GetISCScanResultsByDomainResponse.results:type_name -> service.ISCScanResult
+	116, // 92: service.ISCScanResult.result:type_name -> service.ISCSecurityResult
+	171, // 93: service.ISCScanResult.created_at:type_name -> google.protobuf.Timestamp
+	171, // 94: service.ISCIncident.date:type_name -> google.protobuf.Timestamp
+	115, // 95: service.ISCSecurityResult.incidents:type_name -> service.ISCIncident
+	117, // 96: service.ISCSecurityResult.ip_reputation:type_name -> service.ISCIPReputation
+	120, // 97: service.ListPluginsResponse.plugins:type_name -> service.PluginInfo
+	129, // 98: service.SubmitScanJobResponse.job:type_name -> service.ScanJob
+	129, // 99: service.GetScanJobResponse.job:type_name -> service.ScanJob
+	129, // 100: service.ListScanJobsResponse.jobs:type_name -> service.ScanJob
+	129, // 101: service.CancelScanJobResponse.job:type_name -> service.ScanJob
+	130, // 102: service.ScanJob.plugins:type_name -> service.ScanJobPlugin
+	171, // 103: service.ScanJob.created_at:type_name -> google.protobuf.Timestamp
+	171, // 104: service.ScanJob.started_at:type_name -> google.protobuf.Timestamp
+	171, // 105: service.ScanJob.finished_at:type_name -> google.protobuf.Timestamp
+	171, // 106: service.ScanJobPlugin.started_at:type_name -> google.protobuf.Timestamp
+	171, // 107: service.ScanJobPlugin.finished_at:type_name -> google.protobuf.Timestamp
+	133, // 108: service.GetScanRunResponse.run:type_name -> service.ScanRun
+	171, // 109: service.ScanRun.started_at:type_name -> google.protobuf.Timestamp
+	171, // 110: service.ScanRun.finished_at:type_name -> google.protobuf.Timestamp
+	134, // 111: service.ScanRun.results:type_name -> service.ScanRunResult
+	171, // 112: service.ScanRunResult.created_at:type_name -> google.protobuf.Timestamp
+	66,  // 113: service.ScanRunResult.dns_result:type_name -> service.DNSSecurityResult
+	75,  // 114: service.ScanRunResult.tls_result:type_name -> service.TLSSecurityResult
+	78,  // 115: service.ScanRunResult.crtsh_result:type_name -> service.CrtShSecurityResult
+	79,  // 116: service.ScanRunResult.chaos_result:type_name -> service.ChaosSecurityResult
+	85,  // 117: service.ScanRunResult.shodan_result:type_name -> service.ShodanSecurityResult
+	95,  // 118: service.ScanRunResult.otx_result:type_name -> service.OTXSecurityResult
+	102, // 119: service.ScanRunResult.whois_result:type_name -> service.WhoisSecurityResult
+	104, // 120: service.ScanRunResult.abusech_result:type_name -> service.AbuseChSecurityResult
+	116, // 121: service.ScanRunResult.isc_result:type_name -> service.ISCSecurityResult
+	135, // 122: service.ScanRunResult.external_result:type_name -> service.ExternalSecurityResult
+	144, // 123: service.CreateScanScheduleResponse.schedule:type_name -> service.ScanSchedule
+	144, // 124: service.UpdateScanScheduleResponse.schedule:type_name -> service.ScanSchedule
+	144, // 125: service.PauseScanScheduleResponse.schedule:type_name -> service.ScanSchedule
+	144, // 126: service.ListScanSchedulesResponse.schedules:type_name -> service.ScanSchedule
+	171, // 127: service.ScanSchedule.next_run_at:type_name -> google.protobuf.Timestamp
+	171, // 128: service.ScanSchedule.last_run_at:type_name -> google.protobuf.Timestamp
+	171, // 129: service.ScanSchedule.created_at:type_name -> google.protobuf.Timestamp
+	171, // 130: service.ScanSchedule.updated_at:type_name -> google.protobuf.Timestamp
+	150, // 131: service.BulkScanResponse.batch:type_name -> service.ScanBatch
+	147, // 132: service.BulkScanResponse.rejected:type_name -> service.BulkScanRejected
+	150, // 133: service.GetScanBatchResponse.batch:type_name -> service.ScanBatch
+	151, // 134: service.ScanBatch.summary:type_name -> service.ScanBatchSummary
+	171, // 135: service.ScanBatch.created_at:type_name -> google.protobuf.Timestamp
+	152, // 136: service.ScanBatchSummary.risk_tiers:type_name -> service.RiskTierCount
+	153, // 137: service.ScanBatchSummary.failed_domains:type_name -> service.ScanBatchFailure
+	155, // 138: service.ScanProfile.options:type_name -> service.ScanProfileOption
+	156, // 139: service.ScanProfile.timeouts:type_name -> service.ScanProfileTimeout
+	171, // 140: service.ScanProfile.created_at:type_name -> google.protobuf.Timestamp
+	171, // 141: service.ScanProfile.updated_at:type_name -> google.protobuf.Timestamp
+	154, // 142: service.SaveScanProfileRequest.profile:type_name -> service.ScanProfile
+	154, // 143: service.SaveScanProfileResponse.profile:type_name -> service.ScanProfile
+	154, // 144: service.ListScanProfilesResponse.profiles:type_name -> service.ScanProfile
+	165, // 145: service.GetProviderUsageResponse.providers:type_name -> service.ProviderUsage
+	168, // 146: service.GetProviderHealthResponse.providers:type_name -> service.ProviderHealth
+	171, // 147: service.ProviderHealth.opened_at:type_name -> google.protobuf.Timestamp
+	171, // 148: service.ProviderHealth.retry_at:type_name -> google.protobuf.Timestamp
+	171, // 149: service.DescribeConfigResponse.loaded_at:type_name -> google.protobuf.Timestamp
+	171, // 150: service.DescribeConfigResponse.failed_at:type_name -> google.protobuf.Timestamp
+	10,  // 151: service.AuthService.CreateUser:input_type -> service.CreateUserRequest
+	12,  // 152: service.AuthService.GetUser:input_type -> service.GetUserRequest
+	14,  // 153: service.AuthService.UpdateUser:input_type -> service.UpdateUserRequest
+	16,  // 154: service.AuthService.DeleteUser:input_type -> service.DeleteUserRequest
+	18,  // 155: service.AuthService.ListUsers:input_type -> service.ListUsersRequest
+	34,  // 156: service.AuthService.Login:input_type -> service.LoginRequest
+	36,  // 157: service.AuthService.InviteUser:input_type -> service.InviteUserRequest
+	38,  // 158: service.AuthService.ValidateInvite:input_type -> service.ValidateInviteRequest
+	21,  // 159: service.UserService.CreateAPIKey:input_type -> service.CreateAPIKeyRequest
+	23,  // 160: service.UserService.RotateAPIKey:input_type -> service.RotateAPIKeyRequest
+	25,  // 161: service.UserService.ActivateAPIKey:input_type -> service.ActivateAPIKeyRequest
+	27,  // 162: service.UserService.DeactivateAPIKey:input_type -> service.DeactivateAPIKeyRequest
+	29,  // 163: service.UserService.ListAPIKeys:input_type -> service.ListAPIKeysRequest
+	32,  // 164: service.UserService.ChangePassword:input_type -> service.ChangePasswordRequest
+	40,  // 165: service.ScanService.ScanDomain:input_type -> service.ScanDomainRequest
+	47,  // 166: service.ScanService.ScanTLS:input_type -> service.ScanTLSRequest
+	52,  // 167: service.ScanService.ScanCrtSh:input_type -> service.ScanCrtShRequest
+	57,  // 168: service.ScanService.ScanChaos:input_type -> service.ScanChaosRequest
+	62,  // 169: service.ScanService.ScanShodan:input_type -> service.ScanShodanRequest
+	86,  // 170: service.ScanService.ScanOTX:input_type -> service.ScanOTXRequest
+	97,  // 171: service.ScanService.ScanWhois:input_type -> service.ScanWhoisRequest
+	105, // 172: service.ScanService.ScanAbuseCh:input_type -> service.ScanAbuseChRequest
+	110, // 173: service.ScanService.ScanISC:input_type -> service.ScanISCRequest
+	42,  // 174: service.ScanService.GetDNSScanResultsByDomain:input_type -> service.GetDNSScanResultsByDomainRequest
+	49,  // 175: service.ScanService.GetTLSScanResultsByDomain:input_type -> service.GetTLSScanResultsByDomainRequest
+	54,  // 176: service.ScanService.GetCrtShScanResultsByDomain:input_type -> service.GetCrtShScanResultsByDomainRequest
+	59,  // 177: service.ScanService.GetChaosScanResultsByDomain:input_type -> service.GetChaosScanResultsByDomainRequest
+	64,  // 178: service.ScanService.GetShodanScanResultsByDomain:input_type -> service.GetShodanScanResultsByDomainRequest
+	88,  // 179: service.ScanService.GetOTXScanResultsByDomain:input_type -> service.GetOTXScanResultsByDomainRequest
+	99,  // 180: service.ScanService.GetWhoisScanResultsByDomain:input_type -> service.GetWhoisScanResultsByDomainRequest
+	107, // 181: service.ScanService.GetAbuseChScanResultsByDomain:input_type -> service.GetAbuseChScanResultsByDomainRequest
+	112, // 182: service.ScanService.GetISCScanResultsByDomain:input_type -> service.GetISCScanResultsByDomainRequest
+	44,  // 183: service.ScanService.GetDNSScanResultByID:input_type -> service.GetDNSScanResultByIDRequest
+	118, // 184: service.ScanService.ListPlugins:input_type -> service.ListPluginsRequest
+	121, // 185: service.ScanService.SubmitScanJob:input_type -> service.SubmitScanJobRequest
+	123, // 186: service.ScanService.GetScanJob:input_type -> service.GetScanJobRequest
+	125, // 187: service.ScanService.ListScanJobs:input_type -> service.ListScanJobsRequest
+	127, // 188: service.ScanService.CancelScanJob:input_type -> service.CancelScanJobRequest
+	131, // 189: service.ScanService.GetScanRun:input_type -> service.GetScanRunRequest
+	136, // 190: service.ScanService.CreateScanSchedule:input_type -> service.CreateScanScheduleRequest
+	138, // 191: service.ScanService.UpdateScanSchedule:input_type -> service.UpdateScanScheduleRequest
+	140, // 192: service.ScanService.PauseScanSchedule:input_type -> service.PauseScanScheduleRequest
+	142, // 193: service.ScanService.ListScanSchedules:input_type -> service.ListScanSchedulesRequest
+	145, // 194: service.ScanService.BulkScan:input_type -> service.BulkScanRequest
+	148, // 195: service.ScanService.GetScanBatch:input_type -> service.GetScanBatchRequest
+	157, // 196: service.ScanService.SaveScanProfile:input_type -> service.SaveScanProfileRequest
+	159, // 197: service.ScanService.ListScanProfiles:input_type -> service.ListScanProfilesRequest
+	161, // 198: service.ScanService.DeleteScanProfile:input_type -> service.DeleteScanProfileRequest
+	163, // 199: service.ScanService.GetProviderUsage:input_type -> service.GetProviderUsageRequest
+	166, // 200: service.ScanService.GetProviderHealth:input_type -> service.GetProviderHealthRequest
+	169, // 201: service.ScanService.DescribeConfig:input_type -> service.DescribeConfigRequest
+	0,   // 202: service.ReportService.GenerateReport:input_type -> service.GenerateReportRequest
+	0,   // 203: service.ReportService.GenerateReportStream:input_type -> service.GenerateReportRequest
+	3,   // 204: service.ReportService.ListReports:input_type -> service.ListReportsRequest
+	6,   // 205: service.ReportService.GetReportById:input_type -> service.GetReportByIdRequest
+	8,   // 206: service.ReportService.CalculateRiskScore:input_type -> service.CalculateRiskScoreRequest
+	11,  // 207: service.AuthService.CreateUser:output_type -> service.CreateUserResponse
+	13,  // 208: service.AuthService.GetUser:output_type -> service.GetUserResponse
+	15,  // 209: service.AuthService.UpdateUser:output_type -> service.UpdateUserResponse
+	17,  // 210: service.AuthService.DeleteUser:output_type -> service.DeleteUserResponse
+	19,  // 211: service.AuthService.ListUsers:output_type -> service.ListUsersResponse
+	35,  // 212: service.AuthService.Login:output_type -> service.LoginResponse
+	37,  // 213: service.AuthService.InviteUser:output_type -> service.InviteUserResponse
+	39,  // 214: service.AuthService.ValidateInvite:output_type -> service.ValidateInviteResponse
+	22,  // 215: service.UserService.CreateAPIKey:output_type -> service.CreateAPIKeyResponse
+	24,  // 216: service.UserService.RotateAPIKey:output_type -> service.RotateAPIKeyResponse
+	26,  // 217: service.UserService.ActivateAPIKey:output_type -> service.ActivateAPIKeyResponse
+	28,  // 218: service.UserService.DeactivateAPIKey:output_type -> service.DeactivateAPIKeyResponse
+	30,  // 219: service.UserService.ListAPIKeys:output_type -> service.ListAPIKeysResponse
+	33,  // 220: service.UserService.ChangePassword:output_type -> service.ChangePasswordResponse
+	41,  // 221: service.ScanService.ScanDomain:output_type -> service.ScanDomainResponse
+	48,  // 222: service.ScanService.ScanTLS:output_type -> service.ScanTLSResponse
+	53,  // 223: service.ScanService.ScanCrtSh:output_type -> service.ScanCrtShResponse
+	58,  // 224: service.ScanService.ScanChaos:output_type -> service.ScanChaosResponse
+	63,  // 225: service.ScanService.ScanShodan:output_type -> service.ScanShodanResponse
+	87,  // 226: service.ScanService.ScanOTX:output_type -> service.ScanOTXResponse
+	98,  // 227: service.ScanService.ScanWhois:output_type -> service.ScanWhoisResponse
+	106, // 228: service.ScanService.ScanAbuseCh:output_type -> service.ScanAbuseChResponse
+	111, // 229: service.ScanService.ScanISC:output_type -> service.ScanISCResponse
+	43,  // 230: service.ScanService.GetDNSScanResultsByDomain:output_type -> service.GetDNSScanResultsByDomainResponse
+	50,  // 231: service.ScanService.GetTLSScanResultsByDomain:output_type -> service.GetTLSScanResultsByDomainResponse
+	55,  // 232: service.ScanService.GetCrtShScanResultsByDomain:output_type -> service.GetCrtShScanResultsByDomainResponse
+	60,  // 233: service.ScanService.GetChaosScanResultsByDomain:output_type -> service.GetChaosScanResultsByDomainResponse
+	65,  // 234: service.ScanService.GetShodanScanResultsByDomain:output_type -> service.GetShodanScanResultsByDomainResponse
+	89,  // 235: service.ScanService.GetOTXScanResultsByDomain:output_type -> service.GetOTXScanResultsByDomainResponse
+	100, // 236: service.ScanService.GetWhoisScanResultsByDomain:output_type -> service.GetWhoisScanResultsByDomainResponse
+	108, // 237: service.ScanService.GetAbuseChScanResultsByDomain:output_type -> service.GetAbuseChScanResultsByDomainResponse
+	113, // 238: service.ScanService.GetISCScanResultsByDomain:output_type -> service.GetISCScanResultsByDomainResponse
+	45,  // 239: service.ScanService.GetDNSScanResultByID:output_type -> service.GetDNSScanResultByIDResponse
+	119, // 240: service.ScanService.ListPlugins:output_type -> service.ListPluginsResponse
+	122, // 241: service.ScanService.SubmitScanJob:output_type -> service.SubmitScanJobResponse
+	124, // 242: service.ScanService.GetScanJob:output_type -> service.GetScanJobResponse
+	126, // 243: service.ScanService.ListScanJobs:output_type -> service.ListScanJobsResponse
+	128, // 244: service.ScanService.CancelScanJob:output_type -> service.CancelScanJobResponse
+	132, // 245: service.ScanService.GetScanRun:output_type -> service.GetScanRunResponse
+	137, // 246: service.ScanService.CreateScanSchedule:output_type -> service.CreateScanScheduleResponse
+	139, // 247: service.ScanService.UpdateScanSchedule:output_type -> service.UpdateScanScheduleResponse
+	141, // 248: service.ScanService.PauseScanSchedule:output_type -> service.PauseScanScheduleResponse
+	143, // 249: service.ScanService.ListScanSchedules:output_type -> service.ListScanSchedulesResponse
+	146, // 250: service.ScanService.BulkScan:output_type -> service.BulkScanResponse
+	149, // 251: service.ScanService.GetScanBatch:output_type -> service.GetScanBatchResponse
+	158, // 252: service.ScanService.SaveScanProfile:output_type -> service.SaveScanProfileResponse
+	160, // 253: service.ScanService.ListScanProfiles:output_type -> service.ListScanProfilesResponse
+	162, // 254: service.ScanService.DeleteScanProfile:output_type -> service.DeleteScanProfileResponse
+	164, // 255: service.ScanService.GetProviderUsage:output_type -> service.GetProviderUsageResponse
+	167, // 256: service.ScanService.GetProviderHealth:output_type -> service.GetProviderHealthResponse
+	170, // 257: service.ScanService.DescribeConfig:output_type -> service.DescribeConfigResponse
+	1,   // 258: service.ReportService.GenerateReport:output_type -> service.GenerateReportResponse
+	2,   // 259: service.ReportService.GenerateReportStream:output_type -> service.ReportProgressEvent
+	5,   // 260: service.ReportService.ListReports:output_type -> service.ListReportsResponse
+	7,   // 261: service.ReportService.GetReportById:output_type -> service.GetReportByIdResponse
+	9,   // 262: service.ReportService.CalculateRiskScore:output_type -> service.CalculateRiskScoreResponse
+	207, // [207:263] is the sub-list for method output_type
+	151, // [151:207] is the sub-list for method input_type
+	151, // [151:151] is the sub-list for extension type_name
+	151, // [151:151] is the sub-list for extension extendee
+	0,   // [0:151] is the sub-list for field type_name
 }
 
 func init() { file_proto_service_proto_init() }
@@ -6967,7 +12415,7 @@ func file_proto_service_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_service_proto_rawDesc), len(file_proto_service_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   106,
+			NumMessages:   171,
 			NumExtensions: 0,
 			NumServices:   4,
 		},
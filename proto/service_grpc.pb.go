@@ -702,6 +702,24 @@ const (
 	ScanService_GetAbuseChScanResultsByDomain_FullMethodName = "/service.ScanService/GetAbuseChScanResultsByDomain"
 	ScanService_GetISCScanResultsByDomain_FullMethodName     = "/service.ScanService/GetISCScanResultsByDomain"
 	ScanService_GetDNSScanResultByID_FullMethodName          = "/service.ScanService/GetDNSScanResultByID"
+	ScanService_ListPlugins_FullMethodName                   = "/service.ScanService/ListPlugins"
+	ScanService_SubmitScanJob_FullMethodName                 = "/service.ScanService/SubmitScanJob"
+	ScanService_GetScanJob_FullMethodName                    = "/service.ScanService/GetScanJob"
+	ScanService_ListScanJobs_FullMethodName                  = "/service.ScanService/ListScanJobs"
+	ScanService_CancelScanJob_FullMethodName                 = "/service.ScanService/CancelScanJob"
+	ScanService_GetScanRun_FullMethodName                    = "/service.ScanService/GetScanRun"
+	ScanService_CreateScanSchedule_FullMethodName            = "/service.ScanService/CreateScanSchedule"
+	ScanService_UpdateScanSchedule_FullMethodName            = "/service.ScanService/UpdateScanSchedule"
+	ScanService_PauseScanSchedule_FullMethodName             = "/service.ScanService/PauseScanSchedule"
+	ScanService_ListScanSchedules_FullMethodName             = "/service.ScanService/ListScanSchedules"
+	ScanService_BulkScan_FullMethodName                      = "/service.ScanService/BulkScan"
+	ScanService_GetScanBatch_FullMethodName                  = "/service.ScanService/GetScanBatch"
+	ScanService_SaveScanProfile_FullMethodName               = "/service.ScanService/SaveScanProfile"
+	ScanService_ListScanProfiles_FullMethodName              = "/service.ScanService/ListScanProfiles"
+	ScanService_DeleteScanProfile_FullMethodName             = "/service.ScanService/DeleteScanProfile"
+	ScanService_GetProviderUsage_FullMethodName              = "/service.ScanService/GetProviderUsage"
+	ScanService_GetProviderHealth_FullMethodName             = "/service.ScanService/GetProviderHealth"
+	ScanService_DescribeConfig_FullMethodName                = "/service.ScanService/DescribeConfig"
 )
 
 // ScanServiceClient is the client API for ScanService service.
@@ -730,6 +748,33 @@ type ScanServiceClient interface {
 	GetISCScanResultsByDomain(ctx context.Context, in *GetISCScanResultsByDomainRequest, opts ...grpc.CallOption) (*GetISCScanResultsByDomainResponse, error)
 	// Method to retrieve a specific DNS scan result by ID
 	GetDNSScanResultByID(ctx context.Context, in *GetDNSScanResultByIDRequest, opts ...grpc.CallOption) (*GetDNSScanResultByIDResponse, error)
+	// Method to list the scan plugins built into the server
+	ListPlugins(ctx context.Context, in *ListPluginsRequest, opts ...grpc.CallOption) (*ListPluginsResponse, error)
+	// Asynchronous scan jobs: submit returns immediately, the job runs in the background
+	SubmitScanJob(ctx context.Context, in *SubmitScanJobRequest, opts ...grpc.CallOption) (*SubmitScanJobResponse, error)
+	GetScanJob(ctx context.Context, in *GetScanJobRequest, opts ...grpc.CallOption) (*GetScanJobResponse, error)
+	ListScanJobs(ctx context.Context, in *ListScanJobsRequest, opts ...grpc.CallOption) (*ListScanJobsResponse, error)
+	CancelScanJob(ctx context.Context, in *CancelScanJobRequest, opts ...grpc.CallOption) (*CancelScanJobResponse, error)
+	// Method to retrieve a scan run along with every result stored under it
+	GetScanRun(ctx context.Context, in *GetScanRunRequest, opts ...grpc.CallOption) (*GetScanRunResponse, error)
+	// Recurring scans: the server queues a scan job each time a schedule is due
+	CreateScanSchedule(ctx context.Context, in *CreateScanScheduleRequest, opts ...grpc.CallOption) (*CreateScanScheduleResponse, error)
+	UpdateScanSchedule(ctx context.Context, in *UpdateScanScheduleRequest, opts ...grpc.CallOption) (*UpdateScanScheduleResponse, error)
+	PauseScanSchedule(ctx context.Context, in *PauseScanScheduleRequest, opts ...grpc.CallOption) (*PauseScanScheduleResponse, error)
+	ListScanSchedules(ctx context.Context, in *ListScanSchedulesRequest, opts ...grpc.CallOption) (*ListScanSchedulesResponse, error)
+	// Bulk scans: one scan job per domain, grouped into a batch
+	BulkScan(ctx context.Context, in *BulkScanRequest, opts ...grpc.CallOption) (*BulkScanResponse, error)
+	GetScanBatch(ctx context.Context, in *GetScanBatchRequest, opts ...grpc.CallOption) (*GetScanBatchResponse, error)
+	// Scan profiles: named plugin sets that reports, jobs, schedules and bulk scans run with
+	SaveScanProfile(ctx context.Context, in *SaveScanProfileRequest, opts ...grpc.CallOption) (*SaveScanProfileResponse, error)
+	ListScanProfiles(ctx context.Context, in *ListScanProfilesRequest, opts ...grpc.CallOption) (*ListScanProfilesResponse, error)
+	DeleteScanProfile(ctx context.Context, in *DeleteScanProfileRequest, opts ...grpc.CallOption) (*DeleteScanProfileResponse, error)
+	// Provider quotas
+	GetProviderUsage(ctx context.Context, in *GetProviderUsageRequest, opts ...grpc.CallOption) (*GetProviderUsageResponse, error)
+	// Provider health
+	GetProviderHealth(ctx context.Context, in *GetProviderHealthRequest, opts ...grpc.CallOption) (*GetProviderHealthResponse, error)
+	// Active config version; admin only
+	DescribeConfig(ctx context.Context, in *DescribeConfigRequest, opts ...grpc.CallOption) (*DescribeConfigResponse, error)
 }
 
 type scanServiceClient struct {
@@ -930,6 +975,186 @@ func (c *scanServiceClient) GetDNSScanResultByID(ctx context.Context, in *GetDNS
 	return out, nil
 }
 
+func (c *scanServiceClient) ListPlugins(ctx context.Context, in *ListPluginsRequest, opts ...grpc.CallOption) (*ListPluginsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPluginsResponse)
+	err := c.cc.Invoke(ctx, ScanService_ListPlugins_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scanServiceClient) SubmitScanJob(ctx context.Context, in *SubmitScanJobRequest, opts ...grpc.CallOption) (*SubmitScanJobResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SubmitScanJobResponse)
+	err := c.cc.Invoke(ctx, ScanService_SubmitScanJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scanServiceClient) GetScanJob(ctx context.Context, in *GetScanJobRequest, opts ...grpc.CallOption) (*GetScanJobResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetScanJobResponse)
+	err := c.cc.Invoke(ctx, ScanService_GetScanJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scanServiceClient) ListScanJobs(ctx context.Context, in *ListScanJobsRequest, opts ...grpc.CallOption) (*ListScanJobsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListScanJobsResponse)
+	err := c.cc.Invoke(ctx, ScanService_ListScanJobs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scanServiceClient) CancelScanJob(ctx context.Context, in *CancelScanJobRequest, opts ...grpc.CallOption) (*CancelScanJobResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelScanJobResponse)
+	err := c.cc.Invoke(ctx, ScanService_CancelScanJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scanServiceClient) GetScanRun(ctx context.Context, in *GetScanRunRequest, opts ...grpc.CallOption) (*GetScanRunResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetScanRunResponse)
+	err := c.cc.Invoke(ctx, ScanService_GetScanRun_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scanServiceClient) CreateScanSchedule(ctx context.Context, in *CreateScanScheduleRequest, opts ...grpc.CallOption) (*CreateScanScheduleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateScanScheduleResponse)
+	err := c.cc.Invoke(ctx, ScanService_CreateScanSchedule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scanServiceClient) UpdateScanSchedule(ctx context.Context, in *UpdateScanScheduleRequest, opts ...grpc.CallOption) (*UpdateScanScheduleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateScanScheduleResponse)
+	err := c.cc.Invoke(ctx, ScanService_UpdateScanSchedule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scanServiceClient) PauseScanSchedule(ctx context.Context, in *PauseScanScheduleRequest, opts ...grpc.CallOption) (*PauseScanScheduleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PauseScanScheduleResponse)
+	err := c.cc.Invoke(ctx, ScanService_PauseScanSchedule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scanServiceClient) ListScanSchedules(ctx context.Context, in *ListScanSchedulesRequest, opts ...grpc.CallOption) (*ListScanSchedulesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListScanSchedulesResponse)
+	err := c.cc.Invoke(ctx, ScanService_ListScanSchedules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scanServiceClient) BulkScan(ctx context.Context, in *BulkScanRequest, opts ...grpc.CallOption) (*BulkScanResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BulkScanResponse)
+	err := c.cc.Invoke(ctx, ScanService_BulkScan_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scanServiceClient) GetScanBatch(ctx context.Context, in *GetScanBatchRequest, opts ...grpc.CallOption) (*GetScanBatchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetScanBatchResponse)
+	err := c.cc.Invoke(ctx, ScanService_GetScanBatch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scanServiceClient) SaveScanProfile(ctx context.Context, in *SaveScanProfileRequest, opts ...grpc.CallOption) (*SaveScanProfileResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SaveScanProfileResponse)
+	err := c.cc.Invoke(ctx, ScanService_SaveScanProfile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scanServiceClient) ListScanProfiles(ctx context.Context, in *ListScanProfilesRequest, opts ...grpc.CallOption) (*ListScanProfilesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListScanProfilesResponse)
+	err := c.cc.Invoke(ctx, ScanService_ListScanProfiles_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scanServiceClient) DeleteScanProfile(ctx context.Context, in *DeleteScanProfileRequest, opts ...grpc.CallOption) (*DeleteScanProfileResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteScanProfileResponse)
+	err := c.cc.Invoke(ctx, ScanService_DeleteScanProfile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scanServiceClient) GetProviderUsage(ctx context.Context, in *GetProviderUsageRequest, opts ...grpc.CallOption) (*GetProviderUsageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetProviderUsageResponse)
+	err := c.cc.Invoke(ctx, ScanService_GetProviderUsage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scanServiceClient) GetProviderHealth(ctx context.Context, in *GetProviderHealthRequest, opts ...grpc.CallOption) (*GetProviderHealthResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetProviderHealthResponse)
+	err := c.cc.Invoke(ctx, ScanService_GetProviderHealth_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scanServiceClient) DescribeConfig(ctx context.Context, in *DescribeConfigRequest, opts ...grpc.CallOption) (*DescribeConfigResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DescribeConfigResponse)
+	err := c.cc.Invoke(ctx, ScanService_DescribeConfig_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ScanServiceServer is the server API for ScanService service.
 // All implementations must embed UnimplementedScanServiceServer
 // for forward compatibility.
@@ -956,6 +1181,33 @@ type ScanServiceServer interface {
 	GetISCScanResultsByDomain(context.Context, *GetISCScanResultsByDomainRequest) (*GetISCScanResultsByDomainResponse, error)
 	// Method to retrieve a specific DNS scan result by ID
 	GetDNSScanResultByID(context.Context, *GetDNSScanResultByIDRequest) (*GetDNSScanResultByIDResponse, error)
+	// Method to list the scan plugins built into the server
+	ListPlugins(context.Context, *ListPluginsRequest) (*ListPluginsResponse, error)
+	// Asynchronous scan jobs: submit returns immediately, the job runs in the background
+	SubmitScanJob(context.Context, *SubmitScanJobRequest) (*SubmitScanJobResponse, error)
+	GetScanJob(context.Context, *GetScanJobRequest) (*GetScanJobResponse, error)
+	ListScanJobs(context.Context, *ListScanJobsRequest) (*ListScanJobsResponse, error)
+	CancelScanJob(context.Context, *CancelScanJobRequest) (*CancelScanJobResponse, error)
+	// Method to retrieve a scan run along with every result stored under it
+	GetScanRun(context.Context, *GetScanRunRequest) (*GetScanRunResponse, error)
+	// Recurring scans: the server queues a scan job each time a schedule is due
+	CreateScanSchedule(context.Context, *CreateScanScheduleRequest) (*CreateScanScheduleResponse, error)
+	UpdateScanSchedule(context.Context, *UpdateScanScheduleRequest) (*UpdateScanScheduleResponse, error)
+	PauseScanSchedule(context.Context, *PauseScanScheduleRequest) (*PauseScanScheduleResponse, error)
+	ListScanSchedules(context.Context, *ListScanSchedulesRequest) (*ListScanSchedulesResponse, error)
+	// Bulk scans: one scan job per domain, grouped into a batch
+	BulkScan(context.Context, *BulkScanRequest) (*BulkScanResponse, error)
+	GetScanBatch(context.Context, *GetScanBatchRequest) (*GetScanBatchResponse, error)
+	// Scan profiles: named plugin sets that reports, jobs, schedules and bulk scans run with
+	SaveScanProfile(context.Context, *SaveScanProfileRequest) (*SaveScanProfileResponse, error)
+	ListScanProfiles(context.Context, *ListScanProfilesRequest) (*ListScanProfilesResponse, error)
+	DeleteScanProfile(context.Context, *DeleteScanProfileRequest) (*DeleteScanProfileResponse, error)
+	// Provider quotas
+	GetProviderUsage(context.Context, *GetProviderUsageRequest) (*GetProviderUsageResponse, error)
+	// Provider health
+	GetProviderHealth(context.Context, *GetProviderHealthRequest) (*GetProviderHealthResponse, error)
+	// Active config version; admin only
+	DescribeConfig(context.Context, *DescribeConfigRequest) (*DescribeConfigResponse, error)
 	mustEmbedUnimplementedScanServiceServer()
 }
 
@@ -1023,6 +1275,60 @@ func (UnimplementedScanServiceServer) GetISCScanResultsByDomain(context.Context,
 func (UnimplementedScanServiceServer) GetDNSScanResultByID(context.Context, *GetDNSScanResultByIDRequest) (*GetDNSScanResultByIDResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetDNSScanResultByID not implemented")
 }
+func (UnimplementedScanServiceServer) ListPlugins(context.Context, *ListPluginsRequest) (*ListPluginsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPlugins not implemented")
+}
+func (UnimplementedScanServiceServer) SubmitScanJob(context.Context, *SubmitScanJobRequest) (*SubmitScanJobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitScanJob not implemented")
+}
+func (UnimplementedScanServiceServer) GetScanJob(context.Context, *GetScanJobRequest) (*GetScanJobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetScanJob not implemented")
+}
+func (UnimplementedScanServiceServer) ListScanJobs(context.Context, *ListScanJobsRequest) (*ListScanJobsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListScanJobs not implemented")
+}
+func (UnimplementedScanServiceServer) CancelScanJob(context.Context, *CancelScanJobRequest) (*CancelScanJobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelScanJob not implemented")
+}
+func (UnimplementedScanServiceServer) GetScanRun(context.Context, *GetScanRunRequest) (*GetScanRunResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetScanRun not implemented")
+}
+func (UnimplementedScanServiceServer) CreateScanSchedule(context.Context, *CreateScanScheduleRequest) (*CreateScanScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateScanSchedule not implemented")
+}
+func (UnimplementedScanServiceServer) UpdateScanSchedule(context.Context, *UpdateScanScheduleRequest) (*UpdateScanScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateScanSchedule not implemented")
+}
+func (UnimplementedScanServiceServer) PauseScanSchedule(context.Context, *PauseScanScheduleRequest) (*PauseScanScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseScanSchedule not implemented")
+}
+func (UnimplementedScanServiceServer) ListScanSchedules(context.Context, *ListScanSchedulesRequest) (*ListScanSchedulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListScanSchedules not implemented")
+}
+func (UnimplementedScanServiceServer) BulkScan(context.Context, *BulkScanRequest) (*BulkScanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BulkScan not implemented")
+}
+func (UnimplementedScanServiceServer) GetScanBatch(context.Context, *GetScanBatchRequest) (*GetScanBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetScanBatch not implemented")
+}
+func (UnimplementedScanServiceServer) SaveScanProfile(context.Context, *SaveScanProfileRequest) (*SaveScanProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveScanProfile not implemented")
+}
+func (UnimplementedScanServiceServer) ListScanProfiles(context.Context, *ListScanProfilesRequest) (*ListScanProfilesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListScanProfiles not implemented")
+}
+func (UnimplementedScanServiceServer) DeleteScanProfile(context.Context, *DeleteScanProfileRequest) (*DeleteScanProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteScanProfile not implemented")
+}
+func (UnimplementedScanServiceServer) GetProviderUsage(context.Context, *GetProviderUsageRequest) (*GetProviderUsageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProviderUsage not implemented")
+}
+func (UnimplementedScanServiceServer) GetProviderHealth(context.Context, *GetProviderHealthRequest) (*GetProviderHealthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProviderHealth not implemented")
+}
+func (UnimplementedScanServiceServer) DescribeConfig(context.Context, *DescribeConfigRequest) (*DescribeConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeConfig not implemented")
+}
 func (UnimplementedScanServiceServer) mustEmbedUnimplementedScanServiceServer() {}
 func (UnimplementedScanServiceServer) testEmbeddedByValue()                     {}
 
@@ -1386,6 +1692,330 @@ func _ScanService_GetDNSScanResultByID_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ScanService_ListPlugins_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPluginsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScanServiceServer).ListPlugins(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScanService_ListPlugins_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScanServiceServer).ListPlugins(ctx, req.(*ListPluginsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScanService_SubmitScanJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitScanJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScanServiceServer).SubmitScanJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScanService_SubmitScanJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScanServiceServer).SubmitScanJob(ctx, req.(*SubmitScanJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScanService_GetScanJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetScanJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScanServiceServer).GetScanJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScanService_GetScanJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScanServiceServer).GetScanJob(ctx, req.(*GetScanJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScanService_ListScanJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListScanJobsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScanServiceServer).ListScanJobs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScanService_ListScanJobs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScanServiceServer).ListScanJobs(ctx, req.(*ListScanJobsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScanService_CancelScanJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelScanJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScanServiceServer).CancelScanJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScanService_CancelScanJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScanServiceServer).CancelScanJob(ctx, req.(*CancelScanJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScanService_GetScanRun_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetScanRunRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScanServiceServer).GetScanRun(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScanService_GetScanRun_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScanServiceServer).GetScanRun(ctx, req.(*GetScanRunRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScanService_CreateScanSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateScanScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScanServiceServer).CreateScanSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScanService_CreateScanSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScanServiceServer).CreateScanSchedule(ctx, req.(*CreateScanScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScanService_UpdateScanSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateScanScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScanServiceServer).UpdateScanSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScanService_UpdateScanSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScanServiceServer).UpdateScanSchedule(ctx, req.(*UpdateScanScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScanService_PauseScanSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PauseScanScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScanServiceServer).PauseScanSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScanService_PauseScanSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScanServiceServer).PauseScanSchedule(ctx, req.(*PauseScanScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScanService_ListScanSchedules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListScanSchedulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScanServiceServer).ListScanSchedules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScanService_ListScanSchedules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScanServiceServer).ListScanSchedules(ctx, req.(*ListScanSchedulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScanService_BulkScan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkScanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScanServiceServer).BulkScan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScanService_BulkScan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScanServiceServer).BulkScan(ctx, req.(*BulkScanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScanService_GetScanBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetScanBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScanServiceServer).GetScanBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScanService_GetScanBatch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScanServiceServer).GetScanBatch(ctx, req.(*GetScanBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScanService_SaveScanProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveScanProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScanServiceServer).SaveScanProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScanService_SaveScanProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScanServiceServer).SaveScanProfile(ctx, req.(*SaveScanProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScanService_ListScanProfiles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListScanProfilesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScanServiceServer).ListScanProfiles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScanService_ListScanProfiles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScanServiceServer).ListScanProfiles(ctx, req.(*ListScanProfilesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScanService_DeleteScanProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteScanProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScanServiceServer).DeleteScanProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScanService_DeleteScanProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScanServiceServer).DeleteScanProfile(ctx, req.(*DeleteScanProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScanService_GetProviderUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProviderUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScanServiceServer).GetProviderUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScanService_GetProviderUsage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScanServiceServer).GetProviderUsage(ctx, req.(*GetProviderUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScanService_GetProviderHealth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProviderHealthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScanServiceServer).GetProviderHealth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScanService_GetProviderHealth_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScanServiceServer).GetProviderHealth(ctx, req.(*GetProviderHealthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScanService_DescribeConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScanServiceServer).DescribeConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScanService_DescribeConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScanServiceServer).DescribeConfig(ctx, req.(*DescribeConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ScanService_ServiceDesc is the grpc.ServiceDesc for ScanService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1469,16 +2099,89 @@ var ScanService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetDNSScanResultByID",
 			Handler:    _ScanService_GetDNSScanResultByID_Handler,
 		},
+		{
+			MethodName: "ListPlugins",
+			Handler:    _ScanService_ListPlugins_Handler,
+		},
+		{
+			MethodName: "SubmitScanJob",
+			Handler:    _ScanService_SubmitScanJob_Handler,
+		},
+		{
+			MethodName: "GetScanJob",
+			Handler:    _ScanService_GetScanJob_Handler,
+		},
+		{
+			MethodName: "ListScanJobs",
+			Handler:    _ScanService_ListScanJobs_Handler,
+		},
+		{
+			MethodName: "CancelScanJob",
+			Handler:    _ScanService_CancelScanJob_Handler,
+		},
+		{
+			MethodName: "GetScanRun",
+			Handler:    _ScanService_GetScanRun_Handler,
+		},
+		{
+			MethodName: "CreateScanSchedule",
+			Handler:    _ScanService_CreateScanSchedule_Handler,
+		},
+		{
+			MethodName: "UpdateScanSchedule",
+			Handler:    _ScanService_UpdateScanSchedule_Handler,
+		},
+		{
+			MethodName: "PauseScanSchedule",
+			Handler:    _ScanService_PauseScanSchedule_Handler,
+		},
+		{
+			MethodName: "ListScanSchedules",
+			Handler:    _ScanService_ListScanSchedules_Handler,
+		},
+		{
+			MethodName: "BulkScan",
+			Handler:    _ScanService_BulkScan_Handler,
+		},
+		{
+			MethodName: "GetScanBatch",
+			Handler:    _ScanService_GetScanBatch_Handler,
+		},
+		{
+			MethodName: "SaveScanProfile",
+			Handler:    _ScanService_SaveScanProfile_Handler,
+		},
+		{
+			MethodName: "ListScanProfiles",
+			Handler:    _ScanService_ListScanProfiles_Handler,
+		},
+		{
+			MethodName: "DeleteScanProfile",
+			Handler:    _ScanService_DeleteScanProfile_Handler,
+		},
+		{
+			MethodName: "GetProviderUsage",
+			Handler:    _ScanService_GetProviderUsage_Handler,
+		},
+		{
+			MethodName: "GetProviderHealth",
+			Handler:    _ScanService_GetProviderHealth_Handler,
+		},
+		{
+			MethodName: "DescribeConfig",
+			Handler:    _ScanService_DescribeConfig_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/service.proto",
 }
 
 const (
-	ReportService_GenerateReport_FullMethodName     = "/service.ReportService/GenerateReport"
-	ReportService_ListReports_FullMethodName        = "/service.ReportService/ListReports"
-	ReportService_GetReportById_FullMethodName      = "/service.ReportService/GetReportById"
-	ReportService_CalculateRiskScore_FullMethodName = "/service.ReportService/CalculateRiskScore"
+	ReportService_GenerateReport_FullMethodName       = "/service.ReportService/GenerateReport"
+	ReportService_GenerateReportStream_FullMethodName = "/service.ReportService/GenerateReportStream"
+	ReportService_ListReports_FullMethodName          = "/service.ReportService/ListReports"
+	ReportService_GetReportById_FullMethodName        = "/service.ReportService/GetReportById"
+	ReportService_CalculateRiskScore_FullMethodName   = "/service.ReportService/CalculateRiskScore"
 )
 
 // ReportServiceClient is the client API for ReportService service.
@@ -1486,6 +2189,7 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type ReportServiceClient interface {
 	GenerateReport(ctx context.Context, in *GenerateReportRequest, opts ...grpc.CallOption) (*GenerateReportResponse, error)
+	GenerateReportStream(ctx context.Context, in *GenerateReportRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ReportProgressEvent], error)
 	ListReports(ctx context.Context, in *ListReportsRequest, opts ...grpc.CallOption) (*ListReportsResponse, error)
 	GetReportById(ctx context.Context, in *GetReportByIdRequest, opts ...grpc.CallOption) (*GetReportByIdResponse, error)
 	CalculateRiskScore(ctx context.Context, in *CalculateRiskScoreRequest, opts ...grpc.CallOption) (*CalculateRiskScoreResponse, error)
@@ -1509,6 +2213,25 @@ func (c *reportServiceClient) GenerateReport(ctx context.Context, in *GenerateRe
 	return out, nil
 }
 
+func (c *reportServiceClient) GenerateReportStream(ctx context.Context, in *GenerateReportRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ReportProgressEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ReportService_ServiceDesc.Streams[0], ReportService_GenerateReportStream_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[GenerateReportRequest, ReportProgressEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ReportService_GenerateReportStreamClient = grpc.ServerStreamingClient[ReportProgressEvent]
+
 func (c *reportServiceClient) ListReports(ctx context.Context, in *ListReportsRequest, opts ...grpc.CallOption) (*ListReportsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListReportsResponse)
@@ -1544,6 +2267,7 @@ func (c *reportServiceClient) CalculateRiskScore(ctx context.Context, in *Calcul
 // for forward compatibility.
 type ReportServiceServer interface {
 	GenerateReport(context.Context, *GenerateReportRequest) (*GenerateReportResponse, error)
+	GenerateReportStream(*GenerateReportRequest, grpc.ServerStreamingServer[ReportProgressEvent]) error
 	ListReports(context.Context, *ListReportsRequest) (*ListReportsResponse, error)
 	GetReportById(context.Context, *GetReportByIdRequest) (*GetReportByIdResponse, error)
 	CalculateRiskScore(context.Context, *CalculateRiskScoreRequest) (*CalculateRiskScoreResponse, error)
@@ -1560,6 +2284,9 @@ type UnimplementedReportServiceServer struct{}
 func (UnimplementedReportServiceServer) GenerateReport(context.Context, *GenerateReportRequest) (*GenerateReportResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GenerateReport not implemented")
 }
+func (UnimplementedReportServiceServer) GenerateReportStream(*GenerateReportRequest, grpc.ServerStreamingServer[ReportProgressEvent]) error {
+	return status.Errorf(codes.Unimplemented, "method GenerateReportStream not implemented")
+}
 func (UnimplementedReportServiceServer) ListReports(context.Context, *ListReportsRequest) (*ListReportsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListReports not implemented")
 }
@@ -1608,6 +2335,17 @@ func _ReportService_GenerateReport_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ReportService_GenerateReportStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GenerateReportRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ReportServiceServer).GenerateReportStream(m, &grpc.GenericServerStream[GenerateReportRequest, ReportProgressEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ReportService_GenerateReportStreamServer = grpc.ServerStreamingServer[ReportProgressEvent]
+
 func _ReportService_ListReports_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListReportsRequest)
 	if err := dec(in); err != nil {
@@ -1686,6 +2424,12 @@ var ReportService_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _ReportService_CalculateRiskScore_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "GenerateReportStream",
+			Handler:       _ReportService_GenerateReportStream_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "proto/service.proto",
 }
@@ -91,15 +91,30 @@ CREATE TABLE IF NOT EXISTS invite_tokens (
     created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
 );
 
+-- One scan of a domain; every plugin result references the run it belongs to
+CREATE TABLE IF NOT EXISTS scan_runs (
+    id UUID PRIMARY KEY,
+    domain TEXT NOT NULL,
+    requested_by TEXT REFERENCES users(id),
+    profile TEXT NOT NULL, -- 'full', or the plugin name for single-plugin runs
+    status TEXT NOT NULL DEFAULT 'running', -- running, succeeded, failed
+    error TEXT NOT NULL DEFAULT '',
+    started_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    finished_at TIMESTAMP WITH TIME ZONE
+);
+CREATE INDEX IF NOT EXISTS idx_scan_runs_domain ON scan_runs (domain, started_at DESC);
+
 -- Plugin data storage
 CREATE TABLE IF NOT EXISTS dns_scan_results (
     id UUID PRIMARY KEY,
+    scan_run_id UUID NOT NULL REFERENCES scan_runs(id),
     domain VARCHAR(255) NOT NULL,
     result JSONB NOT NULL,
     created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
 );
 
 CREATE INDEX IF NOT EXISTS idx_domain ON dns_scan_results (domain);
+CREATE INDEX IF NOT EXISTS idx_dns_scan_results_scan_run_id ON dns_scan_results (scan_run_id);
 
 CREATE TABLE risk_scores (
     id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
@@ -113,100 +128,236 @@ CREATE INDEX IF NOT EXISTS idx_risk_scores_domain ON risk_scores (domain);
 
 CREATE TABLE IF NOT EXISTS tls_scan_results (
     id UUID PRIMARY KEY,
+    scan_run_id UUID NOT NULL REFERENCES scan_runs(id),
     domain VARCHAR(255) NOT NULL,
-    dns_scan_id UUID NOT NULL,
+    dns_scan_id UUID REFERENCES dns_scan_results(id), -- DNS scan the result was attached to, if any
     result JSONB NOT NULL,
-    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
-    FOREIGN KEY (dns_scan_id) REFERENCES dns_scan_results(id),
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
 );
 CREATE INDEX IF NOT EXISTS idx_tls_scan_results_domain ON tls_scan_results (domain);
 CREATE INDEX IF NOT EXISTS idx_tls_scan_results_dns_scan_id ON tls_scan_results (dns_scan_id);
+CREATE INDEX IF NOT EXISTS idx_tls_scan_results_scan_run_id ON tls_scan_results (scan_run_id);
 
 -- crt.sh data sets
 CREATE TABLE IF NOT EXISTS crtsh_scan_results (
     id UUID PRIMARY KEY,
+    scan_run_id UUID NOT NULL REFERENCES scan_runs(id),
     domain VARCHAR(255) NOT NULL,
-    dns_scan_id UUID NOT NULL,
+    dns_scan_id UUID REFERENCES dns_scan_results(id), -- DNS scan the result was attached to, if any
     result JSONB NOT NULL,
-    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
-    FOREIGN KEY (dns_scan_id) REFERENCES dns_scan_results(id)
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
 );
 CREATE INDEX IF NOT EXISTS idx_crtsh_scan_results_domain ON crtsh_scan_results (domain);
 CREATE INDEX IF NOT EXISTS idx_crtsh_scan_results_dns_scan_id ON crtsh_scan_results(dns_scan_id);
+CREATE INDEX IF NOT EXISTS idx_crtsh_scan_results_scan_run_id ON crtsh_scan_results (scan_run_id);
 
 -- chaos.projectdiscovery.org data
 CREATE TABLE IF NOT EXISTS chaos_scan_results (
     id UUID PRIMARY KEY,
+    scan_run_id UUID NOT NULL REFERENCES scan_runs(id),
     domain VARCHAR(255) NOT NULL,
-    dns_scan_id UUID NOT NULL,
+    dns_scan_id UUID REFERENCES dns_scan_results(id), -- DNS scan the result was attached to, if any
     result JSONB NOT NULL,
-    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
-    FOREIGN KEY (dns_scan_id) REFERENCES dns_scan_results(id)
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
 );
 CREATE INDEX IF NOT EXISTS idx_chaos_scan_results_domain ON chaos_scan_results (domain);
 CREATE INDEX IF NOT EXISTS idx_chaos_scan_results_dns_scan_id ON chaos_scan_results (dns_scan_id);
+CREATE INDEX IF NOT EXISTS idx_chaos_scan_results_scan_run_id ON chaos_scan_results (scan_run_id);
 
 -- shodan.io datasets
 CREATE TABLE IF NOT EXISTS shodan_scan_results (
     id UUID PRIMARY KEY,
+    scan_run_id UUID NOT NULL REFERENCES scan_runs(id),
     domain VARCHAR(255) NOT NULL,
-    dns_scan_id UUID NOT NULL,
+    dns_scan_id UUID REFERENCES dns_scan_results(id), -- DNS scan the result was attached to, if any
     result JSONB NOT NULL,
-    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
-    FOREIGN KEY (dns_scan_id) REFERENCES dns_scan_results(id)
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
 );
 CREATE INDEX IF NOT EXISTS idx_shodan_scan_results_domain ON shodan_scan_results (domain);
 CREATE INDEX IF NOT EXISTS idx_shodan_scan_results_dns_scan_id ON shodan_scan_results (dns_scan_id);
+CREATE INDEX IF NOT EXISTS idx_shodan_scan_results_scan_run_id ON shodan_scan_results (scan_run_id);
 
 -- whois data
 CREATE TABLE whois_scan_results (
     id UUID PRIMARY KEY,
+    scan_run_id UUID NOT NULL REFERENCES scan_runs(id),
     domain TEXT NOT NULL,
-    dns_scan_id UUID NOT NULL,
+    dns_scan_id UUID REFERENCES dns_scan_results(id), -- DNS scan the result was attached to, if any
     result JSONB NOT NULL,
-    created_at TIMESTAMP NOT NULL,
-    FOREIGN KEY (dns_scan_id) REFERENCES dns_scan_results(id)
+    created_at TIMESTAMP NOT NULL
 );
 CREATE INDEX IF NOT EXISTS idx_whois_scan_results_domain ON whois_scan_results (domain);
 CREATE INDEX IF NOT EXISTS idx_whois_scan_results_dns_scan_id ON whois_scan_results (dns_scan_id);
+CREATE INDEX IF NOT EXISTS idx_whois_scan_results_scan_run_id ON whois_scan_results (scan_run_id);
 
 -- otx data
 CREATE TABLE otx_scan_results (
-    id TEXT PRIMARY KEY,
-    domain TEXT,
-    dns_scan_id TEXT,
-    result JSONB,
-    created_at TIMESTAMP
+    id UUID PRIMARY KEY,
+    scan_run_id UUID NOT NULL REFERENCES scan_runs(id),
+    domain TEXT NOT NULL,
+    dns_scan_id UUID REFERENCES dns_scan_results(id), -- DNS scan the result was attached to, if any
+    result JSONB NOT NULL,
+    created_at TIMESTAMP NOT NULL
 );
 CREATE INDEX IF NOT EXISTS idx_otx_scan_results_domain ON otx_scan_results (domain);
 CREATE INDEX IF NOT EXISTS idx_otx_scan_results_dns_scan_id ON otx_scan_results (dns_scan_id);
+CREATE INDEX IF NOT EXISTS idx_otx_scan_results_scan_run_id ON otx_scan_results (scan_run_id);
 
 -- abusech_scan_results
 CREATE TABLE abusech_scan_results (
-    id TEXT PRIMARY KEY,
-    domain TEXT,
-    dns_scan_id TEXT,
-    result JSONB,
-    created_at TIMESTAMP
+    id UUID PRIMARY KEY,
+    scan_run_id UUID NOT NULL REFERENCES scan_runs(id),
+    domain TEXT NOT NULL,
+    dns_scan_id UUID REFERENCES dns_scan_results(id), -- DNS scan the result was attached to, if any
+    result JSONB NOT NULL,
+    created_at TIMESTAMP NOT NULL
 );
 CREATE INDEX IF NOT EXISTS idx_abusech_scan_results_domain ON abusech_scan_results (domain);
 CREATE INDEX IF NOT EXISTS idx_abusech_scan_results_dns_scan_id ON abusech_scan_results (dns_scan_id);
+CREATE INDEX IF NOT EXISTS idx_abusech_scan_results_scan_run_id ON abusech_scan_results (scan_run_id);
+
+-- results of plugins run as external executables, stored by the server on
+-- their behalf
+CREATE TABLE external_scan_results (
+    id UUID PRIMARY KEY,
+    scan_run_id UUID NOT NULL REFERENCES scan_runs(id),
+    plugin TEXT NOT NULL,
+    domain TEXT NOT NULL,
+    dns_scan_id UUID REFERENCES dns_scan_results(id), -- DNS scan the result was attached to, if any
+    result JSONB NOT NULL,
+    created_at TIMESTAMP NOT NULL
+);
+CREATE INDEX IF NOT EXISTS idx_external_scan_results_domain ON external_scan_results (plugin, domain);
+CREATE INDEX IF NOT EXISTS idx_external_scan_results_scan_run_id ON external_scan_results (scan_run_id);
 
 CREATE TABLE reports (
     id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id TEXT NOT NULL REFERENCES users(id),
     domain TEXT NOT NULL,
     dns_scan_id UUID NOT NULL REFERENCES dns_scan_results(id),
+    scan_run_id UUID REFERENCES scan_runs(id),
+    profile TEXT NOT NULL DEFAULT 'full', -- scan profile the report's scan ran with
     score INTEGER NOT NULL,
     risk_tier TEXT NOT NULL,
     created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
 );
 
 CREATE TABLE isc_scan_results (
-    id TEXT PRIMARY KEY,
-    domain TEXT,
-    dns_scan_id TEXT,
-    result JSONB,
-    created_at TIMESTAMP
+    id UUID PRIMARY KEY,
+    scan_run_id UUID NOT NULL REFERENCES scan_runs(id),
+    domain TEXT NOT NULL,
+    dns_scan_id UUID REFERENCES dns_scan_results(id), -- DNS scan the result was attached to, if any
+    result JSONB NOT NULL,
+    created_at TIMESTAMP NOT NULL
 );
 CREATE INDEX IF NOT EXISTS idx_isc_scan_results_domain ON isc_scan_results (domain);
-CREATE INDEX IF NOT EXISTS idx_isc_scan_results_dns_scan_id ON isc_scan_results (dns_scan_id)
\ No newline at end of file
+CREATE INDEX IF NOT EXISTS idx_isc_scan_results_dns_scan_id ON isc_scan_results (dns_scan_id);
+CREATE INDEX IF NOT EXISTS idx_isc_scan_results_scan_run_id ON isc_scan_results (scan_run_id);
+
+-- bulk scans; each domain of a batch is queued as its own scan job
+CREATE TABLE scan_batches (
+    id UUID PRIMARY KEY,
+    user_id TEXT NOT NULL REFERENCES users(id),
+    total INTEGER NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE INDEX IF NOT EXISTS idx_scan_batches_user_id ON scan_batches (user_id, created_at DESC);
+
+-- asynchronous scan jobs, claimed by workers with FOR UPDATE SKIP LOCKED
+CREATE TABLE scan_jobs (
+    id UUID PRIMARY KEY,
+    user_id TEXT NOT NULL REFERENCES users(id),
+    domain TEXT NOT NULL,
+    status TEXT NOT NULL DEFAULT 'queued', -- queued, running, succeeded, failed, cancelled
+    report_id UUID REFERENCES reports(id),
+    error TEXT NOT NULL DEFAULT '',
+    cancel_requested BOOLEAN NOT NULL DEFAULT FALSE,
+    heartbeat_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    started_at TIMESTAMP WITH TIME ZONE,
+    finished_at TIMESTAMP WITH TIME ZONE,
+    parent_id UUID REFERENCES scan_jobs(id) ON DELETE SET NULL, -- job whose scan found this subdomain
+    scan_run_id UUID REFERENCES scan_runs(id), -- set once a worker starts the scan
+    batch_id UUID REFERENCES scan_batches(id) ON DELETE CASCADE,
+    tags TEXT[] NOT NULL DEFAULT '{}',
+    profile TEXT NOT NULL DEFAULT 'full',
+    refresh BOOLEAN NOT NULL DEFAULT FALSE -- bypass cached intelligence lookups
+);
+CREATE INDEX IF NOT EXISTS idx_scan_jobs_user_id ON scan_jobs (user_id, created_at DESC);
+CREATE INDEX IF NOT EXISTS idx_scan_jobs_status ON scan_jobs (status, created_at);
+CREATE INDEX IF NOT EXISTS idx_scan_jobs_batch_id ON scan_jobs (batch_id, status) WHERE batch_id IS NOT NULL;
+
+CREATE TABLE scan_job_plugins (
+    job_id UUID NOT NULL REFERENCES scan_jobs(id) ON DELETE CASCADE,
+    plugin TEXT NOT NULL,
+    status TEXT NOT NULL DEFAULT 'queued', -- queued, running, succeeded, failed, skipped
+    result_id TEXT NOT NULL DEFAULT '',
+    error TEXT NOT NULL DEFAULT '',
+    timed_out BOOLEAN NOT NULL DEFAULT FALSE,
+    started_at TIMESTAMP WITH TIME ZONE,
+    finished_at TIMESTAMP WITH TIME ZONE,
+    PRIMARY KEY (job_id, plugin)
+);
+
+-- recurring scans; servers fire due schedules under an advisory lock and
+-- claim each run by advancing next_run_at conditionally
+CREATE TABLE scan_schedules (
+    id UUID PRIMARY KEY,
+    owner_id TEXT NOT NULL REFERENCES users(id),
+    domain TEXT NOT NULL,
+    cron_expr TEXT NOT NULL DEFAULT '', -- five-field cron in UTC; empty for interval schedules
+    interval_seconds BIGINT NOT NULL DEFAULT 0,
+    profile TEXT NOT NULL DEFAULT 'full',
+    paused BOOLEAN NOT NULL DEFAULT FALSE,
+    next_run_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    last_run_at TIMESTAMP WITH TIME ZONE,
+    last_job_id UUID REFERENCES scan_jobs(id) ON DELETE SET NULL,
+    last_error TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE INDEX IF NOT EXISTS idx_scan_schedules_owner_id ON scan_schedules (owner_id, domain);
+CREATE INDEX IF NOT EXISTS idx_scan_schedules_due ON scan_schedules (next_run_at) WHERE NOT paused;
+
+-- user-defined scan profiles; the built-in profiles and those in config.yaml
+-- are not stored
+CREATE TABLE scan_profiles (
+    owner_id TEXT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    name TEXT NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    plugins TEXT[] NOT NULL DEFAULT '{}', -- empty means every loaded plugin
+    passive_only BOOLEAN NOT NULL DEFAULT FALSE,
+    options JSONB NOT NULL DEFAULT '{}', -- plugin name -> option -> value
+    timeouts JSONB NOT NULL DEFAULT '{}', -- plugin name -> seconds
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (owner_id, name)
+);
+
+-- responses from intelligence providers, reused until the provider's TTL
+-- under intel_cache in config.yaml runs out
+CREATE TABLE intel_cache (
+    provider TEXT NOT NULL,
+    endpoint TEXT NOT NULL,
+    query TEXT NOT NULL,
+    response JSONB NOT NULL,
+    fetched_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    PRIMARY KEY (provider, endpoint, query)
+);
+
+-- calls made to each intelligence provider per UTC day, checked against the
+-- budgets under quotas in config.yaml
+CREATE TABLE provider_usage (
+    provider TEXT NOT NULL,
+    day DATE NOT NULL,
+    calls INTEGER NOT NULL DEFAULT 0,
+    PRIMARY KEY (provider, day)
+);
+
+-- DKIM selectors found on each domain, tried first on its next DNS scan
+CREATE TABLE dkim_selectors (
+    domain TEXT NOT NULL,
+    selector TEXT NOT NULL,
+    last_seen TIMESTAMP WITH TIME ZONE NOT NULL,
+    PRIMARY KEY (domain, selector)
+);
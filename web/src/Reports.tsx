@@ -1,7 +1,7 @@
 // web/src/Reports.tsx
 import React, { useState, useEffect, useContext, useMemo } from 'react'; // Added useMemo
 import { Box, Typography, TextField, Button, List, ListItem, ListItemText, Paper, Alert, CircularProgress, Grid } from '@mui/material';
-import { GenerateReportRequest, GenerateReportResponse, ListReportsRequest, ListReportsResponse, GetReportByIdRequest, GetReportByIdResponse, Report } from './proto/service';
+import { GenerateReportRequest, ReportProgressEvent, ListReportsRequest, ListReportsResponse, GetReportByIdRequest, GetReportByIdResponse, Report } from './proto/service';
 import { ReportServiceClient } from './proto/service.client';
 import { GrpcWebFetchTransport } from '@protobuf-ts/grpcweb-transport';
 import { AuthContext } from './AuthContext';
@@ -42,6 +42,8 @@ const Reports: React.FC = () => {
     const [loading, setLoading] = useState<boolean>(false);
     const [error, setError] = useState<string>('');
     const [success, setSuccess] = useState<string>('');
+    const [progress, setProgress] = useState<ReportProgressEvent[]>([]);
+    const [runningScore, setRunningScore] = useState<{ score: number; riskTier: string } | null>(null);
 
     const fetchReports = (filterDomain: string = '') => {
         setError('');
@@ -90,11 +92,25 @@ const Reports: React.FC = () => {
         }
 
         const request: GenerateReportRequest = { domain: domain };
+        setProgress([]);
+        setRunningScore(null);
+
+        // Stream per-plugin progress; the last event carries the stored report
+        const streamReport = async () => {
+            const call = reportClient.generateReportStream(request);
+            for await (const event of call.responses) {
+                setRunningScore({ score: event.score, riskTier: event.riskTier });
+                if (event.done) {
+                    setSuccess(`Report generated for ${domain} with ID: ${event.reportId}`);
+                    continue;
+                }
+                setProgress((prev) => [...prev.filter((p) => p.plugin !== event.plugin), event]);
+            }
+            await call.status;
+        };
 
-        reportClient.generateReport(request).then((callResponse) => {
-            const response: GenerateReportResponse = callResponse.response;
+        streamReport().then(() => {
             setLoading(false);
-            setSuccess(`Report generated for ${domain} with ID: ${response.reportId}`);
             setDomain('');
             fetchReports();
         }).catch((err: any) => {
@@ -180,6 +196,24 @@ const Reports: React.FC = () => {
                 </Grid>
             </Grid>
 
+            {progress.length > 0 && (
+                <Paper sx={{ p: 3, mb: 4 }}>
+                    <Typography variant="h6" gutterBottom>
+                        Scan Progress{runningScore ? ` - Score: ${runningScore.score} - Risk: ${runningScore.riskTier}` : ''}
+                    </Typography>
+                    <List dense>
+                        {progress.map((event) => (
+                            <ListItem key={event.plugin}>
+                                <ListItemText
+                                    primary={`${event.plugin}: ${event.timedOut ? 'timed out' : event.status}`}
+                                    secondary={event.errors.length > 0 ? event.errors.join('; ') : undefined}
+                                />
+                            </ListItem>
+                        ))}
+                    </List>
+                </Paper>
+            )}
+
             {selectedReport && (
                 <Paper sx={{ p: 3, mb: 4 }}>
                     <Typography variant="h6" gutterBottom>Selected Report Details</Typography>
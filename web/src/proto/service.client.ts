@@ -8,9 +8,47 @@ import type { GetReportByIdResponse } from "./service";
 import type { GetReportByIdRequest } from "./service";
 import type { ListReportsResponse } from "./service";
 import type { ListReportsRequest } from "./service";
+import type { ReportProgressEvent } from "./service";
+import type { ServerStreamingCall } from "@protobuf-ts/runtime-rpc";
 import type { GenerateReportResponse } from "./service";
 import type { GenerateReportRequest } from "./service";
 import { ScanService } from "./service";
+import type { DescribeConfigResponse } from "./service";
+import type { DescribeConfigRequest } from "./service";
+import type { GetProviderHealthResponse } from "./service";
+import type { GetProviderHealthRequest } from "./service";
+import type { GetProviderUsageResponse } from "./service";
+import type { GetProviderUsageRequest } from "./service";
+import type { DeleteScanProfileResponse } from "./service";
+import type { DeleteScanProfileRequest } from "./service";
+import type { ListScanProfilesResponse } from "./service";
+import type { ListScanProfilesRequest } from "./service";
+import type { SaveScanProfileResponse } from "./service";
+import type { SaveScanProfileRequest } from "./service";
+import type { GetScanBatchResponse } from "./service";
+import type { GetScanBatchRequest } from "./service";
+import type { BulkScanResponse } from "./service";
+import type { BulkScanRequest } from "./service";
+import type { ListScanSchedulesResponse } from "./service";
+import type { ListScanSchedulesRequest } from "./service";
+import type { PauseScanScheduleResponse } from "./service";
+import type { PauseScanScheduleRequest } from "./service";
+import type { UpdateScanScheduleResponse } from "./service";
+import type { UpdateScanScheduleRequest } from "./service";
+import type { CreateScanScheduleResponse } from "./service";
+import type { CreateScanScheduleRequest } from "./service";
+import type { GetScanRunResponse } from "./service";
+import type { GetScanRunRequest } from "./service";
+import type { CancelScanJobResponse } from "./service";
+import type { CancelScanJobRequest } from "./service";
+import type { ListScanJobsResponse } from "./service";
+import type { ListScanJobsRequest } from "./service";
+import type { GetScanJobResponse } from "./service";
+import type { GetScanJobRequest } from "./service";
+import type { SubmitScanJobResponse } from "./service";
+import type { SubmitScanJobRequest } from "./service";
+import type { ListPluginsResponse } from "./service";
+import type { ListPluginsRequest } from "./service";
 import type { GetDNSScanResultByIDResponse } from "./service";
 import type { GetDNSScanResultByIDRequest } from "./service";
 import type { GetISCScanResultsByDomainResponse } from "./service";
@@ -84,8 +122,6 @@ import type { CreateUserResponse } from "./service";
 import type { CreateUserRequest } from "./service";
 import type { UnaryCall } from "@protobuf-ts/runtime-rpc";
 import type { RpcOptions } from "@protobuf-ts/runtime-rpc";
-// Services definitions
-
 /**
  * @generated from protobuf service service.AuthService
  */
@@ -123,8 +159,6 @@ export interface IAuthServiceClient {
      */
     validateInvite(input: ValidateInviteRequest, options?: RpcOptions): UnaryCall<ValidateInviteRequest, ValidateInviteResponse>;
 }
-// Services definitions
-
 /**
  * @generated from protobuf service service.AuthService
  */
@@ -376,6 +410,96 @@ export interface IScanServiceClient {
      * @generated from protobuf rpc: GetDNSScanResultByID
      */
     getDNSScanResultByID(input: GetDNSScanResultByIDRequest, options?: RpcOptions): UnaryCall<GetDNSScanResultByIDRequest, GetDNSScanResultByIDResponse>;
+    /**
+     * Method to list the scan plugins built into the server
+     *
+     * @generated from protobuf rpc: ListPlugins
+     */
+    listPlugins(input: ListPluginsRequest, options?: RpcOptions): UnaryCall<ListPluginsRequest, ListPluginsResponse>;
+    /**
+     * Asynchronous scan jobs: submit returns immediately, the job runs in the background
+     *
+     * @generated from protobuf rpc: SubmitScanJob
+     */
+    submitScanJob(input: SubmitScanJobRequest, options?: RpcOptions): UnaryCall<SubmitScanJobRequest, SubmitScanJobResponse>;
+    /**
+     * @generated from protobuf rpc: GetScanJob
+     */
+    getScanJob(input: GetScanJobRequest, options?: RpcOptions): UnaryCall<GetScanJobRequest, GetScanJobResponse>;
+    /**
+     * @generated from protobuf rpc: ListScanJobs
+     */
+    listScanJobs(input: ListScanJobsRequest, options?: RpcOptions): UnaryCall<ListScanJobsRequest, ListScanJobsResponse>;
+    /**
+     * @generated from protobuf rpc: CancelScanJob
+     */
+    cancelScanJob(input: CancelScanJobRequest, options?: RpcOptions): UnaryCall<CancelScanJobRequest, CancelScanJobResponse>;
+    /**
+     * Method to retrieve a scan run along with every result stored under it
+     *
+     * @generated from protobuf rpc: GetScanRun
+     */
+    getScanRun(input: GetScanRunRequest, options?: RpcOptions): UnaryCall<GetScanRunRequest, GetScanRunResponse>;
+    /**
+     * Recurring scans: the server queues a scan job each time a schedule is due
+     *
+     * @generated from protobuf rpc: CreateScanSchedule
+     */
+    createScanSchedule(input: CreateScanScheduleRequest, options?: RpcOptions): UnaryCall<CreateScanScheduleRequest, CreateScanScheduleResponse>;
+    /**
+     * @generated from protobuf rpc: UpdateScanSchedule
+     */
+    updateScanSchedule(input: UpdateScanScheduleRequest, options?: RpcOptions): UnaryCall<UpdateScanScheduleRequest, UpdateScanScheduleResponse>;
+    /**
+     * @generated from protobuf rpc: PauseScanSchedule
+     */
+    pauseScanSchedule(input: PauseScanScheduleRequest, options?: RpcOptions): UnaryCall<PauseScanScheduleRequest, PauseScanScheduleResponse>;
+    /**
+     * @generated from protobuf rpc: ListScanSchedules
+     */
+    listScanSchedules(input: ListScanSchedulesRequest, options?: RpcOptions): UnaryCall<ListScanSchedulesRequest, ListScanSchedulesResponse>;
+    /**
+     * Bulk scans: one scan job per domain, grouped into a batch
+     *
+     * @generated from protobuf rpc: BulkScan
+     */
+    bulkScan(input: BulkScanRequest, options?: RpcOptions): UnaryCall<BulkScanRequest, BulkScanResponse>;
+    /**
+     * @generated from protobuf rpc: GetScanBatch
+     */
+    getScanBatch(input: GetScanBatchRequest, options?: RpcOptions): UnaryCall<GetScanBatchRequest, GetScanBatchResponse>;
+    /**
+     * Scan profiles: named plugin sets that reports, jobs, schedules and bulk scans run with
+     *
+     * @generated from protobuf rpc: SaveScanProfile
+     */
+    saveScanProfile(input: SaveScanProfileRequest, options?: RpcOptions): UnaryCall<SaveScanProfileRequest, SaveScanProfileResponse>;
+    /**
+     * @generated from protobuf rpc: ListScanProfiles
+     */
+    listScanProfiles(input: ListScanProfilesRequest, options?: RpcOptions): UnaryCall<ListScanProfilesRequest, ListScanProfilesResponse>;
+    /**
+     * @generated from protobuf rpc: DeleteScanProfile
+     */
+    deleteScanProfile(input: DeleteScanProfileRequest, options?: RpcOptions): UnaryCall<DeleteScanProfileRequest, DeleteScanProfileResponse>;
+    /**
+     * Provider quotas
+     *
+     * @generated from protobuf rpc: GetProviderUsage
+     */
+    getProviderUsage(input: GetProviderUsageRequest, options?: RpcOptions): UnaryCall<GetProviderUsageRequest, GetProviderUsageResponse>;
+    /**
+     * Provider health
+     *
+     * @generated from protobuf rpc: GetProviderHealth
+     */
+    getProviderHealth(input: GetProviderHealthRequest, options?: RpcOptions): UnaryCall<GetProviderHealthRequest, GetProviderHealthResponse>;
+    /**
+     * Active config version; admin only
+     *
+     * @generated from protobuf rpc: DescribeConfig
+     */
+    describeConfig(input: DescribeConfigRequest, options?: RpcOptions): UnaryCall<DescribeConfigRequest, DescribeConfigResponse>;
 }
 /**
  * @generated from protobuf service service.ScanService
@@ -525,6 +649,150 @@ export class ScanServiceClient implements IScanServiceClient, ServiceInfo {
         const method = this.methods[18], opt = this._transport.mergeOptions(options);
         return stackIntercept<GetDNSScanResultByIDRequest, GetDNSScanResultByIDResponse>("unary", this._transport, method, opt, input);
     }
+    /**
+     * Method to list the scan plugins built into the server
+     *
+     * @generated from protobuf rpc: ListPlugins
+     */
+    listPlugins(input: ListPluginsRequest, options?: RpcOptions): UnaryCall<ListPluginsRequest, ListPluginsResponse> {
+        const method = this.methods[19], opt = this._transport.mergeOptions(options);
+        return stackIntercept<ListPluginsRequest, ListPluginsResponse>("unary", this._transport, method, opt, input);
+    }
+    /**
+     * Asynchronous scan jobs: submit returns immediately, the job runs in the background
+     *
+     * @generated from protobuf rpc: SubmitScanJob
+     */
+    submitScanJob(input: SubmitScanJobRequest, options?: RpcOptions): UnaryCall<SubmitScanJobRequest, SubmitScanJobResponse> {
+        const method = this.methods[20], opt = this._transport.mergeOptions(options);
+        return stackIntercept<SubmitScanJobRequest, SubmitScanJobResponse>("unary", this._transport, method, opt, input);
+    }
+    /**
+     * @generated from protobuf rpc: GetScanJob
+     */
+    getScanJob(input: GetScanJobRequest, options?: RpcOptions): UnaryCall<GetScanJobRequest, GetScanJobResponse> {
+        const method = this.methods[21], opt = this._transport.mergeOptions(options);
+        return stackIntercept<GetScanJobRequest, GetScanJobResponse>("unary", this._transport, method, opt, input);
+    }
+    /**
+     * @generated from protobuf rpc: ListScanJobs
+     */
+    listScanJobs(input: ListScanJobsRequest, options?: RpcOptions): UnaryCall<ListScanJobsRequest, ListScanJobsResponse> {
+        const method = this.methods[22], opt = this._transport.mergeOptions(options);
+        return stackIntercept<ListScanJobsRequest, ListScanJobsResponse>("unary", this._transport, method, opt, input);
+    }
+    /**
+     * @generated from protobuf rpc: CancelScanJob
+     */
+    cancelScanJob(input: CancelScanJobRequest, options?: RpcOptions): UnaryCall<CancelScanJobRequest, CancelScanJobResponse> {
+        const method = this.methods[23], opt = this._transport.mergeOptions(options);
+        return stackIntercept<CancelScanJobRequest, CancelScanJobResponse>("unary", this._transport, method, opt, input);
+    }
+    /**
+     * Method to retrieve a scan run along with every result stored under it
+     *
+     * @generated from protobuf rpc: GetScanRun
+     */
+    getScanRun(input: GetScanRunRequest, options?: RpcOptions): UnaryCall<GetScanRunRequest, GetScanRunResponse> {
+        const method = this.methods[24], opt = this._transport.mergeOptions(options);
+        return stackIntercept<GetScanRunRequest, GetScanRunResponse>("unary", this._transport, method, opt, input);
+    }
+    /**
+     * Recurring scans: the server queues a scan job each time a schedule is due
+     *
+     * @generated from protobuf rpc: CreateScanSchedule
+     */
+    createScanSchedule(input: CreateScanScheduleRequest, options?: RpcOptions): UnaryCall<CreateScanScheduleRequest, CreateScanScheduleResponse> {
+        const method = this.methods[25], opt = this._transport.mergeOptions(options);
+        return stackIntercept<CreateScanScheduleRequest, CreateScanScheduleResponse>("unary", this._transport, method, opt, input);
+    }
+    /**
+     * @generated from protobuf rpc: UpdateScanSchedule
+     */
+    updateScanSchedule(input: UpdateScanScheduleRequest, options?: RpcOptions): UnaryCall<UpdateScanScheduleRequest, UpdateScanScheduleResponse> {
+        const method = this.methods[26], opt = this._transport.mergeOptions(options);
+        return stackIntercept<UpdateScanScheduleRequest, UpdateScanScheduleResponse>("unary", this._transport, method, opt, input);
+    }
+    /**
+     * @generated from protobuf rpc: PauseScanSchedule
+     */
+    pauseScanSchedule(input: PauseScanScheduleRequest, options?: RpcOptions): UnaryCall<PauseScanScheduleRequest, PauseScanScheduleResponse> {
+        const method = this.methods[27], opt = this._transport.mergeOptions(options);
+        return stackIntercept<PauseScanScheduleRequest, PauseScanScheduleResponse>("unary", this._transport, method, opt, input);
+    }
+    /**
+     * @generated from protobuf rpc: ListScanSchedules
+     */
+    listScanSchedules(input: ListScanSchedulesRequest, options?: RpcOptions): UnaryCall<ListScanSchedulesRequest, ListScanSchedulesResponse> {
+        const method = this.methods[28], opt = this._transport.mergeOptions(options);
+        return stackIntercept<ListScanSchedulesRequest, ListScanSchedulesResponse>("unary", this._transport, method, opt, input);
+    }
+    /**
+     * Bulk scans: one scan job per domain, grouped into a batch
+     *
+     * @generated from protobuf rpc: BulkScan
+     */
+    bulkScan(input: BulkScanRequest, options?: RpcOptions): UnaryCall<BulkScanRequest, BulkScanResponse> {
+        const method = this.methods[29], opt = this._transport.mergeOptions(options);
+        return stackIntercept<BulkScanRequest, BulkScanResponse>("unary", this._transport, method, opt, input);
+    }
+    /**
+     * @generated from protobuf rpc: GetScanBatch
+     */
+    getScanBatch(input: GetScanBatchRequest, options?: RpcOptions): UnaryCall<GetScanBatchRequest, GetScanBatchResponse> {
+        const method = this.methods[30], opt = this._transport.mergeOptions(options);
+        return stackIntercept<GetScanBatchRequest, GetScanBatchResponse>("unary", this._transport, method, opt, input);
+    }
+    /**
+     * Scan profiles: named plugin sets that reports, jobs, schedules and bulk scans run with
+     *
+     * @generated from protobuf rpc: SaveScanProfile
+     */
+    saveScanProfile(input: SaveScanProfileRequest, options?: RpcOptions): UnaryCall<SaveScanProfileRequest, SaveScanProfileResponse> {
+        const method = this.methods[31], opt = this._transport.mergeOptions(options);
+        return stackIntercept<SaveScanProfileRequest, SaveScanProfileResponse>("unary", this._transport, method, opt, input);
+    }
+    /**
+     * @generated from protobuf rpc: ListScanProfiles
+     */
+    listScanProfiles(input: ListScanProfilesRequest, options?: RpcOptions): UnaryCall<ListScanProfilesRequest, ListScanProfilesResponse> {
+        const method = this.methods[32], opt = this._transport.mergeOptions(options);
+        return stackIntercept<ListScanProfilesRequest, ListScanProfilesResponse>("unary", this._transport, method, opt, input);
+    }
+    /**
+     * @generated from protobuf rpc: DeleteScanProfile
+     */
+    deleteScanProfile(input: DeleteScanProfileRequest, options?: RpcOptions): UnaryCall<DeleteScanProfileRequest, DeleteScanProfileResponse> {
+        const method = this.methods[33], opt = this._transport.mergeOptions(options);
+        return stackIntercept<DeleteScanProfileRequest, DeleteScanProfileResponse>("unary", this._transport, method, opt, input);
+    }
+    /**
+     * Provider quotas
+     *
+     * @generated from protobuf rpc: GetProviderUsage
+     */
+    getProviderUsage(input: GetProviderUsageRequest, options?: RpcOptions): UnaryCall<GetProviderUsageRequest, GetProviderUsageResponse> {
+        const method = this.methods[34], opt = this._transport.mergeOptions(options);
+        return stackIntercept<GetProviderUsageRequest, GetProviderUsageResponse>("unary", this._transport, method, opt, input);
+    }
+    /**
+     * Provider health
+     *
+     * @generated from protobuf rpc: GetProviderHealth
+     */
+    getProviderHealth(input: GetProviderHealthRequest, options?: RpcOptions): UnaryCall<GetProviderHealthRequest, GetProviderHealthResponse> {
+        const method = this.methods[35], opt = this._transport.mergeOptions(options);
+        return stackIntercept<GetProviderHealthRequest, GetProviderHealthResponse>("unary", this._transport, method, opt, input);
+    }
+    /**
+     * Active config version; admin only
+     *
+     * @generated from protobuf rpc: DescribeConfig
+     */
+    describeConfig(input: DescribeConfigRequest, options?: RpcOptions): UnaryCall<DescribeConfigRequest, DescribeConfigResponse> {
+        const method = this.methods[36], opt = this._transport.mergeOptions(options);
+        return stackIntercept<DescribeConfigRequest, DescribeConfigResponse>("unary", this._transport, method, opt, input);
+    }
 }
 /**
  * @generated from protobuf service service.ReportService
@@ -534,6 +802,10 @@ export interface IReportServiceClient {
      * @generated from protobuf rpc: GenerateReport
      */
     generateReport(input: GenerateReportRequest, options?: RpcOptions): UnaryCall<GenerateReportRequest, GenerateReportResponse>;
+    /**
+     * @generated from protobuf rpc: GenerateReportStream
+     */
+    generateReportStream(input: GenerateReportRequest, options?: RpcOptions): ServerStreamingCall<GenerateReportRequest, ReportProgressEvent>;
     /**
      * @generated from protobuf rpc: ListReports
      */
@@ -563,25 +835,32 @@ export class ReportServiceClient implements IReportServiceClient, ServiceInfo {
         const method = this.methods[0], opt = this._transport.mergeOptions(options);
         return stackIntercept<GenerateReportRequest, GenerateReportResponse>("unary", this._transport, method, opt, input);
     }
+    /**
+     * @generated from protobuf rpc: GenerateReportStream
+     */
+    generateReportStream(input: GenerateReportRequest, options?: RpcOptions): ServerStreamingCall<GenerateReportRequest, ReportProgressEvent> {
+        const method = this.methods[1], opt = this._transport.mergeOptions(options);
+        return stackIntercept<GenerateReportRequest, ReportProgressEvent>("serverStreaming", this._transport, method, opt, input);
+    }
     /**
      * @generated from protobuf rpc: ListReports
      */
     listReports(input: ListReportsRequest, options?: RpcOptions): UnaryCall<ListReportsRequest, ListReportsResponse> {
-        const method = this.methods[1], opt = this._transport.mergeOptions(options);
+        const method = this.methods[2], opt = this._transport.mergeOptions(options);
         return stackIntercept<ListReportsRequest, ListReportsResponse>("unary", this._transport, method, opt, input);
     }
     /**
      * @generated from protobuf rpc: GetReportById
      */
     getReportById(input: GetReportByIdRequest, options?: RpcOptions): UnaryCall<GetReportByIdRequest, GetReportByIdResponse> {
-        const method = this.methods[2], opt = this._transport.mergeOptions(options);
+        const method = this.methods[3], opt = this._transport.mergeOptions(options);
         return stackIntercept<GetReportByIdRequest, GetReportByIdResponse>("unary", this._transport, method, opt, input);
     }
     /**
      * @generated from protobuf rpc: CalculateRiskScore
      */
     calculateRiskScore(input: CalculateRiskScoreRequest, options?: RpcOptions): UnaryCall<CalculateRiskScoreRequest, CalculateRiskScoreResponse> {
-        const method = this.methods[3], opt = this._transport.mergeOptions(options);
+        const method = this.methods[4], opt = this._transport.mergeOptions(options);
         return stackIntercept<CalculateRiskScoreRequest, CalculateRiskScoreResponse>("unary", this._transport, method, opt, input);
     }
 }
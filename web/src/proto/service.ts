@@ -22,6 +22,14 @@ export interface GenerateReportRequest {
      * @generated from protobuf field: string domain = 1
      */
     domain: string;
+    /**
+     * @generated from protobuf field: string profile = 2
+     */
+    profile: string; // scan profile name; defaults to "full"
+    /**
+     * @generated from protobuf field: bool refresh = 3
+     */
+    refresh: boolean; // bypass cached intelligence lookups
 }
 /**
  * @generated from protobuf message service.GenerateReportResponse
@@ -47,6 +55,112 @@ export interface GenerateReportResponse {
      * @generated from protobuf field: google.protobuf.Timestamp created_at = 5
      */
     createdAt?: Timestamp;
+    /**
+     * @generated from protobuf field: string scan_run_id = 6
+     */
+    scanRunId: string;
+    /**
+     * @generated from protobuf field: string profile = 7
+     */
+    profile: string;
+}
+/**
+ * ReportProgressEvent is streamed by GenerateReportStream as each plugin
+ * changes state. The last event has done set and carries the stored report.
+ *
+ * @generated from protobuf message service.ReportProgressEvent
+ */
+export interface ReportProgressEvent {
+    /**
+     * @generated from protobuf field: string plugin = 1
+     */
+    plugin: string;
+    /**
+     * @generated from protobuf field: string status = 2
+     */
+    status: string; // queued, running, succeeded, failed or skipped
+    /**
+     * @generated from protobuf field: string result_id = 3
+     */
+    resultId: string;
+    /**
+     * @generated from protobuf field: repeated string errors = 4
+     */
+    errors: string[];
+    /**
+     * @generated from protobuf field: bool timed_out = 5
+     */
+    timedOut: boolean;
+    /**
+     * @generated from protobuf field: int32 score = 6
+     */
+    score: number; // risk score over the results received so far
+    /**
+     * @generated from protobuf field: string risk_tier = 7
+     */
+    riskTier: string;
+    /**
+     * @generated from protobuf field: bool done = 8
+     */
+    done: boolean;
+    /**
+     * @generated from protobuf field: string report_id = 9
+     */
+    reportId: string;
+    /**
+     * @generated from protobuf field: string dns_scan_id = 10
+     */
+    dnsScanId: string;
+    /**
+     * The plugin's result, set on the event that reports it succeeded
+     *
+     * @generated from protobuf field: service.DNSSecurityResult dns_result = 11
+     */
+    dnsResult?: DNSSecurityResult;
+    /**
+     * @generated from protobuf field: service.TLSSecurityResult tls_result = 12
+     */
+    tlsResult?: TLSSecurityResult;
+    /**
+     * @generated from protobuf field: service.CrtShSecurityResult crtsh_result = 13
+     */
+    crtshResult?: CrtShSecurityResult;
+    /**
+     * @generated from protobuf field: service.ChaosSecurityResult chaos_result = 14
+     */
+    chaosResult?: ChaosSecurityResult;
+    /**
+     * @generated from protobuf field: service.ShodanSecurityResult shodan_result = 15
+     */
+    shodanResult?: ShodanSecurityResult;
+    /**
+     * @generated from protobuf field: service.OTXSecurityResult otx_result = 16
+     */
+    otxResult?: OTXSecurityResult;
+    /**
+     * @generated from protobuf field: service.WhoisSecurityResult whois_result = 17
+     */
+    whoisResult?: WhoisSecurityResult;
+    /**
+     * @generated from protobuf field: service.AbuseChSecurityResult abusech_result = 18
+     */
+    abusechResult?: AbuseChSecurityResult;
+    /**
+     * @generated from protobuf field: service.ISCSecurityResult isc_result = 19
+     */
+    iscResult?: ISCSecurityResult;
+    /**
+     * @generated from protobuf field: string scan_run_id = 20
+     */
+    scanRunId: string;
+    /**
+     * @generated from protobuf field: string profile = 21
+     */
+    profile: string;
+    /**
+     * @generated from protobuf field: service.ExternalSecurityResult external_result = 22
+     */
+    externalResult?: ExternalSecurityResult;
 }
 /**
  * @generated from protobuf message service.ListReportsRequest
@@ -85,6 +199,14 @@ export interface Report {
      * @generated from protobuf field: google.protobuf.Timestamp created_at = 6
      */
     createdAt?: Timestamp;
+    /**
+     * @generated from protobuf field: string scan_run_id = 7
+     */
+    scanRunId: string;
+    /**
+     * @generated from protobuf field: string profile = 8
+     */
+    profile: string; // scan profile the report's scan ran with
 }
 /**
  * @generated from protobuf message service.ListReportsResponse
@@ -584,6 +706,10 @@ export interface ScanDomainResponse {
      * @generated from protobuf field: service.DNSSecurityResult result = 2
      */
     result?: DNSSecurityResult;
+    /**
+     * @generated from protobuf field: string scan_run_id = 3
+     */
+    scanRunId: string;
 }
 /**
  * @generated from protobuf message service.GetDNSScanResultsByDomainRequest
@@ -671,6 +797,10 @@ export interface ScanTLSResponse {
      * @generated from protobuf field: service.TLSSecurityResult result = 2
      */
     result?: TLSSecurityResult;
+    /**
+     * @generated from protobuf field: string scan_run_id = 3
+     */
+    scanRunId: string;
 }
 /**
  * @generated from protobuf message service.GetTLSScanResultsByDomainRequest
@@ -740,6 +870,10 @@ export interface ScanCrtShResponse {
      * @generated from protobuf field: service.CrtShSecurityResult result = 2
      */
     result?: CrtShSecurityResult;
+    /**
+     * @generated from protobuf field: string scan_run_id = 3
+     */
+    scanRunId: string;
 }
 /**
  * @generated from protobuf message service.GetCrtShScanResultsByDomainRequest
@@ -796,6 +930,10 @@ export interface ScanChaosRequest {
      * @generated from protobuf field: string dns_scan_id = 2
      */
     dnsScanId: string;
+    /**
+     * @generated from protobuf field: bool refresh = 3
+     */
+    refresh: boolean; // bypass cached intelligence lookups
 }
 /**
  * @generated from protobuf message service.ScanChaosResponse
@@ -809,6 +947,10 @@ export interface ScanChaosResponse {
      * @generated from protobuf field: service.ChaosSecurityResult result = 2
      */
     result?: ChaosSecurityResult;
+    /**
+     * @generated from protobuf field: string scan_run_id = 3
+     */
+    scanRunId: string;
 }
 /**
  * @generated from protobuf message service.GetChaosScanResultsByDomainRequest
@@ -865,6 +1007,10 @@ export interface ScanShodanRequest {
      * @generated from protobuf field: string dns_scan_id = 2
      */
     dnsScanId: string;
+    /**
+     * @generated from protobuf field: bool refresh = 3
+     */
+    refresh: boolean; // bypass cached intelligence lookups
 }
 /**
  * @generated from protobuf message service.ScanShodanResponse
@@ -878,6 +1024,10 @@ export interface ScanShodanResponse {
      * @generated from protobuf field: service.ShodanSecurityResult result = 2
      */
     result?: ShodanSecurityResult;
+    /**
+     * @generated from protobuf field: string scan_run_id = 3
+     */
+    scanRunId: string;
 }
 /**
  * @generated from protobuf message service.GetShodanScanResultsByDomainRequest
@@ -971,479 +1121,489 @@ export interface DNSSecurityResult {
      * @generated from protobuf field: repeated string errors = 17
      */
     errors: string[];
-}
-/**
- * @generated from protobuf message service.TLSSecurityResult
- */
-export interface TLSSecurityResult {
-    /**
-     * @generated from protobuf field: string tls_version = 1
-     */
-    tlsVersion: string;
     /**
-     * @generated from protobuf field: string cipher_suite = 2
+     * @generated from protobuf field: repeated service.DKIMSelector dkim_selectors = 18
      */
-    cipherSuite: string;
+    dkimSelectors: DKIMSelector[];
     /**
-     * @generated from protobuf field: bool hsts_header = 3
+     * @generated from protobuf field: int32 dkim_selectors_tried = 19
      */
-    hstsHeader: boolean;
+    dkimSelectorsTried: number;
     /**
-     * @generated from protobuf field: bool certificate_valid = 4
+     * @generated from protobuf field: service.SPFEvaluation spf_evaluation = 20
      */
-    certificateValid: boolean;
+    spfEvaluation?: SPFEvaluation;
     /**
-     * @generated from protobuf field: string cert_issuer = 5
+     * @generated from protobuf field: repeated service.DNSSECZone dnssec_chain = 21
      */
-    certIssuer: string;
+    dnssecChain: DNSSECZone[];
     /**
-     * @generated from protobuf field: string cert_subject = 6
+     * @generated from protobuf field: repeated service.DNSSECFinding dnssec_findings = 22
      */
-    certSubject: string;
+    dnssecFindings: DNSSECFinding[];
     /**
-     * @generated from protobuf field: google.protobuf.Timestamp cert_not_before = 7
+     * @generated from protobuf field: service.MTASTSResult mta_sts = 23
      */
-    certNotBefore?: Timestamp;
+    mtaSts?: MTASTSResult;
     /**
-     * @generated from protobuf field: google.protobuf.Timestamp cert_not_after = 8
+     * @generated from protobuf field: service.TLSRPTResult tls_rpt = 24
      */
-    certNotAfter?: Timestamp;
+    tlsRpt?: TLSRPTResult;
     /**
-     * @generated from protobuf field: repeated string cert_dns_names = 9
+     * @generated from protobuf field: service.BIMIResult bimi = 25
      */
-    certDnsNames: string[];
+    bimi?: BIMIResult;
     /**
-     * @generated from protobuf field: int32 cert_key_strength = 10
+     * @generated from protobuf field: repeated service.DANEResult dane = 26
      */
-    certKeyStrength: number;
+    dane: DANEResult[];
     /**
-     * @generated from protobuf field: string cert_signature_algorithm = 11
+     * @generated from protobuf field: bool dnssec_skipped = 27
      */
-    certSignatureAlgorithm: string;
+    dnssecSkipped: boolean; // the scan's options left out the DNSSEC checks
     /**
-     * @generated from protobuf field: repeated string errors = 12
+     * @generated from protobuf field: bool mta_sts_policy_skipped = 28
      */
-    errors: string[];
+    mtaStsPolicySkipped: boolean; // the scan's options left out the MTA-STS policy fetch
 }
 /**
- * @generated from protobuf message service.CrtShCertificate
+ * MTASTSResult is the domain's MTA-STS record and policy (RFC 8461).
+ *
+ * @generated from protobuf message service.MTASTSResult
  */
-export interface CrtShCertificate {
+export interface MTASTSResult {
     /**
-     * @generated from protobuf field: int64 id = 1
+     * @generated from protobuf field: string record = 1
      */
-    id: bigint;
+    record: string; // _mta-sts TXT record
     /**
-     * @generated from protobuf field: string common_name = 2
+     * @generated from protobuf field: string id = 2
      */
-    commonName: string;
+    id: string;
     /**
-     * @generated from protobuf field: string issuer = 3
+     * @generated from protobuf field: bool record_valid = 3
      */
-    issuer: string;
+    recordValid: boolean;
     /**
-     * @generated from protobuf field: google.protobuf.Timestamp not_before = 4
+     * @generated from protobuf field: string policy = 4
      */
-    notBefore?: Timestamp;
+    policy: string; // https://mta-sts.<domain>/.well-known/mta-sts.txt
     /**
-     * @generated from protobuf field: google.protobuf.Timestamp not_after = 5
+     * @generated from protobuf field: string mode = 5
      */
-    notAfter?: Timestamp;
+    mode: string; // enforce, testing or none
     /**
-     * @generated from protobuf field: string serial_number = 6
+     * @generated from protobuf field: repeated string mx_patterns = 6
      */
-    serialNumber: string;
+    mxPatterns: string[];
     /**
-     * @generated from protobuf field: repeated string dns_names = 7
+     * @generated from protobuf field: int64 max_age = 7
      */
-    dnsNames: string[];
+    maxAge: bigint; // seconds
     /**
-     * @generated from protobuf field: string signature_algorithm = 8
+     * @generated from protobuf field: bool policy_valid = 8
      */
-    signatureAlgorithm: string;
+    policyValid: boolean;
+    /**
+     * @generated from protobuf field: repeated string unmatched_mx = 9
+     */
+    unmatchedMx: string[]; // MX hosts no mx pattern covers
+    /**
+     * @generated from protobuf field: repeated string errors = 10
+     */
+    errors: string[];
 }
 /**
- * @generated from protobuf message service.CrtShSecurityResult
+ * TLSRPTResult is the domain's SMTP TLS reporting record (RFC 8460).
+ *
+ * @generated from protobuf message service.TLSRPTResult
  */
-export interface CrtShSecurityResult {
+export interface TLSRPTResult {
     /**
-     * @generated from protobuf field: repeated service.CrtShCertificate certificates = 1
+     * @generated from protobuf field: string record = 1
      */
-    certificates: CrtShCertificate[];
+    record: string; // _smtp._tls TXT record
     /**
-     * @generated from protobuf field: repeated string subdomains = 2
+     * @generated from protobuf field: repeated string rua = 2
      */
-    subdomains: string[];
+    rua: string[]; // mailto: and https: report destinations
     /**
-     * @generated from protobuf field: repeated string errors = 3
+     * @generated from protobuf field: bool valid = 3
+     */
+    valid: boolean;
+    /**
+     * @generated from protobuf field: repeated string errors = 4
      */
     errors: string[];
 }
 /**
- * @generated from protobuf message service.ChaosSecurityResult
+ * BIMIResult is the domain's default BIMI record.
+ *
+ * @generated from protobuf message service.BIMIResult
  */
-export interface ChaosSecurityResult {
+export interface BIMIResult {
     /**
-     * @generated from protobuf field: repeated string subdomains = 1
+     * @generated from protobuf field: string record = 1
      */
-    subdomains: string[];
+    record: string; // default._bimi TXT record
     /**
-     * @generated from protobuf field: repeated string errors = 2
+     * @generated from protobuf field: string logo_url = 2
+     */
+    logoUrl: string; // l=, an SVG over HTTPS
+    /**
+     * @generated from protobuf field: string authority_url = 3
+     */
+    authorityUrl: string; // a=, the mark certificate over HTTPS
+    /**
+     * @generated from protobuf field: bool valid = 4
+     */
+    valid: boolean;
+    /**
+     * @generated from protobuf field: repeated string errors = 5
      */
     errors: string[];
 }
 /**
- * @generated from protobuf message service.ShodanScanResult
+ * DANEResult is the TLSA records published for one MX host (RFC 7672).
+ *
+ * @generated from protobuf message service.DANEResult
  */
-export interface ShodanScanResult {
+export interface DANEResult {
     /**
-     * @generated from protobuf field: string id = 1
+     * @generated from protobuf field: string mx_host = 1
      */
-    id: string;
+    mxHost: string;
     /**
-     * @generated from protobuf field: string domain = 2
+     * @generated from protobuf field: repeated string records = 2
      */
-    domain: string;
+    records: string[]; // _25._tcp TLSA records
     /**
-     * @generated from protobuf field: string dns_scan_id = 3
+     * @generated from protobuf field: bool dnssec_authenticated = 3
      */
-    dnsScanId: string;
+    dnssecAuthenticated: boolean; // the resolver validated the answer
     /**
-     * @generated from protobuf field: service.ShodanSecurityResult result = 4
+     * @generated from protobuf field: bool valid = 4
      */
-    result?: ShodanSecurityResult;
+    valid: boolean;
     /**
-     * @generated from protobuf field: google.protobuf.Timestamp created_at = 5
+     * @generated from protobuf field: repeated string errors = 5
      */
-    createdAt?: Timestamp;
+    errors: string[];
 }
 /**
- * @generated from protobuf message service.ShodanLocation
+ * DNSSECZone is one zone on the chain of trust from the root to the domain.
+ *
+ * @generated from protobuf message service.DNSSECZone
  */
-export interface ShodanLocation {
+export interface DNSSECZone {
     /**
-     * @generated from protobuf field: string city = 1
+     * @generated from protobuf field: string zone = 1
      */
-    city: string;
+    zone: string;
     /**
-     * @generated from protobuf field: string country_name = 2
+     * @generated from protobuf field: string status = 2
      */
-    countryName: string;
+    status: string; // secure, insecure or bogus
     /**
-     * @generated from protobuf field: float latitude = 3
+     * @generated from protobuf field: repeated int32 key_tags = 3
      */
-    latitude: number;
+    keyTags: number[];
     /**
-     * @generated from protobuf field: float longitude = 4
+     * @generated from protobuf field: repeated string algorithms = 4
      */
-    longitude: number;
+    algorithms: string[];
+    /**
+     * @generated from protobuf field: string denial = 5
+     */
+    denial: string; // nsec or nsec3
+    /**
+     * @generated from protobuf field: int32 nsec3_iterations = 6
+     */
+    nsec3Iterations: number;
+    /**
+     * @generated from protobuf field: string nsec3_salt = 7
+     */
+    nsec3Salt: string; // hex; empty when unsalted
 }
 /**
- * @generated from protobuf message service.ShodanSSL
+ * DNSSECFinding is one problem found validating the chain of trust.
+ *
+ * @generated from protobuf message service.DNSSECFinding
  */
-export interface ShodanSSL {
+export interface DNSSECFinding {
     /**
-     * @generated from protobuf field: string issuer = 1
+     * @generated from protobuf field: string zone = 1
      */
-    issuer: string;
+    zone: string;
     /**
-     * @generated from protobuf field: string subject = 2
+     * @generated from protobuf field: string code = 2
      */
-    subject: string;
+    code: string; // e.g. ds_mismatch, signature_expired, weak_algorithm
     /**
-     * @generated from protobuf field: google.protobuf.Timestamp expires = 3
+     * @generated from protobuf field: string severity = 3
      */
-    expires?: Timestamp;
+    severity: string; // critical, high, medium, low or info
     /**
-     * @generated from protobuf field: google.protobuf.Timestamp not_after = 4
+     * @generated from protobuf field: string message = 4
      */
-    notAfter?: Timestamp;
+    message: string;
 }
 /**
- * @generated from protobuf message service.ShodanMetadata
+ * SPFEvaluation is the outcome of expanding a domain's SPF record the way a
+ * receiver would under RFC 7208.
+ *
+ * @generated from protobuf message service.SPFEvaluation
  */
-export interface ShodanMetadata {
+export interface SPFEvaluation {
     /**
-     * @generated from protobuf field: string module = 1
+     * @generated from protobuf field: int32 record_count = 1
      */
-    module: string;
-}
-/**
- * @generated from protobuf message service.ShodanHost
- */
-export interface ShodanHost {
+    recordCount: number; // more than one is a permerror
     /**
-     * @generated from protobuf field: string ip = 1
+     * @generated from protobuf field: int32 dns_lookups = 2
      */
-    ip: string;
+    dnsLookups: number; // include, a, mx, ptr, exists and redirect; the limit is 10
     /**
-     * @generated from protobuf field: int32 port = 2
+     * @generated from protobuf field: int32 void_lookups = 3
      */
-    port: number;
+    voidLookups: number; // lookups that found nothing; the limit is 2
     /**
-     * @generated from protobuf field: repeated string hostnames = 3
+     * @generated from protobuf field: bool lookup_limit_exceeded = 4
      */
-    hostnames: string[];
+    lookupLimitExceeded: boolean;
     /**
-     * @generated from protobuf field: string os = 4
+     * @generated from protobuf field: bool void_lookup_limit_exceeded = 5
      */
-    os: string;
+    voidLookupLimitExceeded: boolean;
     /**
-     * @generated from protobuf field: string banner = 5
+     * @generated from protobuf field: bool permissive_all = 6
      */
-    banner: string;
+    permissiveAll: boolean; // +all lets anyone send as the domain
     /**
-     * @generated from protobuf field: repeated string tags = 6
+     * @generated from protobuf field: repeated string broad_ranges = 7
      */
-    tags: string[];
+    broadRanges: string[]; // ip4 wider than /16 or ip6 wider than /32 in the domain's own records
     /**
-     * @generated from protobuf field: service.ShodanLocation location = 7
+     * @generated from protobuf field: repeated string authorized_ips = 8
      */
-    location?: ShodanLocation;
+    authorizedIps: string[]; // flattened addresses and ranges allowed to send
     /**
-     * @generated from protobuf field: service.ShodanSSL ssl = 8
+     * @generated from protobuf field: repeated string includes = 9
      */
-    ssl?: ShodanSSL;
+    includes: string[]; // domains expanded through include and redirect
     /**
-     * @generated from protobuf field: repeated string domains = 9
+     * @generated from protobuf field: repeated string errors = 10
      */
-    domains: string[];
+    errors: string[]; // why receivers would return permerror or temperror
+}
+/**
+ * DKIMSelector is a key published at <selector>._domainkey.<domain>.
+ *
+ * @generated from protobuf message service.DKIMSelector
+ */
+export interface DKIMSelector {
     /**
-     * @generated from protobuf field: string asn = 10
+     * @generated from protobuf field: string selector = 1
      */
-    asn: string;
+    selector: string;
     /**
-     * @generated from protobuf field: string org = 11
+     * @generated from protobuf field: string record = 2
      */
-    org: string;
+    record: string;
     /**
-     * @generated from protobuf field: string isp = 12
+     * @generated from protobuf field: bool valid = 3
      */
-    isp: string;
+    valid: boolean;
     /**
-     * @generated from protobuf field: google.protobuf.Timestamp timestamp = 13
+     * @generated from protobuf field: string validation_error = 4
      */
-    timestamp?: Timestamp;
+    validationError: string;
     /**
-     * @generated from protobuf field: service.ShodanMetadata shodan_meta = 14
+     * @generated from protobuf field: string key_type = 5
      */
-    shodanMeta?: ShodanMetadata;
-}
-/**
- * @generated from protobuf message service.ShodanSecurityResult
- */
-export interface ShodanSecurityResult {
+    keyType: string; // rsa or ed25519
     /**
-     * @generated from protobuf field: repeated service.ShodanHost hosts = 1
+     * @generated from protobuf field: int32 key_bits = 6
      */
-    hosts: ShodanHost[];
+    keyBits: number;
     /**
-     * @generated from protobuf field: repeated string errors = 2
+     * @generated from protobuf field: string source = 7
      */
-    errors: string[];
+    source: string; // remembered, option or dictionary
 }
 /**
- * @generated from protobuf message service.ScanOTXRequest
+ * @generated from protobuf message service.TLSSecurityResult
  */
-export interface ScanOTXRequest {
+export interface TLSSecurityResult {
     /**
-     * @generated from protobuf field: string domain = 1
+     * @generated from protobuf field: string tls_version = 1
      */
-    domain: string;
+    tlsVersion: string;
     /**
-     * @generated from protobuf field: string dns_scan_id = 2
+     * @generated from protobuf field: string cipher_suite = 2
      */
-    dnsScanId: string;
-}
-/**
- * @generated from protobuf message service.ScanOTXResponse
- */
-export interface ScanOTXResponse {
+    cipherSuite: string;
     /**
-     * @generated from protobuf field: string scan_id = 1
+     * @generated from protobuf field: bool hsts_header = 3
      */
-    scanId: string;
+    hstsHeader: boolean;
     /**
-     * @generated from protobuf field: service.OTXSecurityResult result = 2
+     * @generated from protobuf field: bool certificate_valid = 4
      */
-    result?: OTXSecurityResult;
-}
-/**
- * @generated from protobuf message service.GetOTXScanResultsByDomainRequest
- */
-export interface GetOTXScanResultsByDomainRequest {
+    certificateValid: boolean;
     /**
-     * @generated from protobuf field: string domain = 1
+     * @generated from protobuf field: string cert_issuer = 5
      */
-    domain: string;
-}
-/**
- * @generated from protobuf message service.GetOTXScanResultsByDomainResponse
- */
-export interface GetOTXScanResultsByDomainResponse {
+    certIssuer: string;
     /**
-     * @generated from protobuf field: repeated service.OTXScanResult results = 1
+     * @generated from protobuf field: string cert_subject = 6
      */
-    results: OTXScanResult[];
-}
-/**
- * @generated from protobuf message service.OTXScanResult
- */
-export interface OTXScanResult {
+    certSubject: string;
     /**
-     * @generated from protobuf field: string id = 1
+     * @generated from protobuf field: google.protobuf.Timestamp cert_not_before = 7
      */
-    id: string;
+    certNotBefore?: Timestamp;
     /**
-     * @generated from protobuf field: string domain = 2
+     * @generated from protobuf field: google.protobuf.Timestamp cert_not_after = 8
      */
-    domain: string;
+    certNotAfter?: Timestamp;
     /**
-     * @generated from protobuf field: string dns_scan_id = 3
+     * @generated from protobuf field: repeated string cert_dns_names = 9
      */
-    dnsScanId: string;
+    certDnsNames: string[];
     /**
-     * @generated from protobuf field: service.OTXSecurityResult result = 4
+     * @generated from protobuf field: int32 cert_key_strength = 10
      */
-    result?: OTXSecurityResult;
+    certKeyStrength: number;
     /**
-     * @generated from protobuf field: google.protobuf.Timestamp created_at = 5
+     * @generated from protobuf field: string cert_signature_algorithm = 11
      */
-    createdAt?: Timestamp;
-}
-/**
- * @generated from protobuf message service.OTXGeneralInfo
- */
-export interface OTXGeneralInfo {
+    certSignatureAlgorithm: string;
     /**
-     * @generated from protobuf field: int32 pulse_count = 1
+     * @generated from protobuf field: repeated string errors = 12
      */
-    pulseCount: number;
+    errors: string[];
     /**
-     * @generated from protobuf field: repeated string pulses = 2
+     * @generated from protobuf field: repeated service.TLSEndpoint endpoints = 13
      */
-    pulses: string[];
+    endpoints: TLSEndpoint[]; // one per resolved IP and MX host probed
 }
 /**
- * @generated from protobuf message service.OTXMalware
+ * @generated from protobuf message service.TLSEndpoint
  */
-export interface OTXMalware {
+export interface TLSEndpoint {
     /**
-     * @generated from protobuf field: string hash = 1
+     * @generated from protobuf field: string host = 1
      */
-    hash: string;
+    host: string; // IP address or MX hostname
     /**
-     * @generated from protobuf field: google.protobuf.Timestamp datetime = 2
+     * @generated from protobuf field: int32 port = 2
      */
-    datetime?: Timestamp;
-}
-/**
- * @generated from protobuf message service.OTXURL
- */
-export interface OTXURL {
+    port: number;
     /**
-     * @generated from protobuf field: string url = 1
+     * @generated from protobuf field: string tls_version = 3
      */
-    url: string;
+    tlsVersion: string;
     /**
-     * @generated from protobuf field: google.protobuf.Timestamp datetime = 2
+     * @generated from protobuf field: string cipher_suite = 4
      */
-    datetime?: Timestamp;
+    cipherSuite: string;
+    /**
+     * @generated from protobuf field: bool certificate_valid = 5
+     */
+    certificateValid: boolean;
+    /**
+     * @generated from protobuf field: string error = 6
+     */
+    error: string;
 }
 /**
- * @generated from protobuf message service.OTXPassiveDNS
+ * @generated from protobuf message service.CrtShCertificate
  */
-export interface OTXPassiveDNS {
-    /**
-     * @generated from protobuf field: string address = 1
-     */
-    address: string;
+export interface CrtShCertificate {
     /**
-     * @generated from protobuf field: string hostname = 2
+     * @generated from protobuf field: int64 id = 1
      */
-    hostname: string;
+    id: bigint;
     /**
-     * @generated from protobuf field: string record = 3
+     * @generated from protobuf field: string common_name = 2
      */
-    record: string;
+    commonName: string;
     /**
-     * @generated from protobuf field: google.protobuf.Timestamp datetime = 4
+     * @generated from protobuf field: string issuer = 3
      */
-    datetime?: Timestamp;
-}
-/**
- * @generated from protobuf message service.OTXSecurityResult
- */
-export interface OTXSecurityResult {
+    issuer: string;
     /**
-     * @generated from protobuf field: service.OTXGeneralInfo general_info = 1
+     * @generated from protobuf field: google.protobuf.Timestamp not_before = 4
      */
-    generalInfo?: OTXGeneralInfo;
+    notBefore?: Timestamp;
     /**
-     * @generated from protobuf field: repeated service.OTXMalware malware = 2
+     * @generated from protobuf field: google.protobuf.Timestamp not_after = 5
      */
-    malware: OTXMalware[];
+    notAfter?: Timestamp;
     /**
-     * @generated from protobuf field: repeated service.OTXURL urls = 3
+     * @generated from protobuf field: string serial_number = 6
      */
-    urls: OTXURL[];
+    serialNumber: string;
     /**
-     * @generated from protobuf field: repeated service.OTXPassiveDNS passive_dns = 4
+     * @generated from protobuf field: repeated string dns_names = 7
      */
-    passiveDns: OTXPassiveDNS[];
+    dnsNames: string[];
     /**
-     * @generated from protobuf field: repeated string errors = 5
+     * @generated from protobuf field: string signature_algorithm = 8
      */
-    errors: string[];
+    signatureAlgorithm: string;
 }
 /**
- * @generated from protobuf message service.ScanWhoisRequest
+ * @generated from protobuf message service.CrtShSecurityResult
  */
-export interface ScanWhoisRequest {
+export interface CrtShSecurityResult {
     /**
-     * @generated from protobuf field: string domain = 1
+     * @generated from protobuf field: repeated service.CrtShCertificate certificates = 1
      */
-    domain: string;
+    certificates: CrtShCertificate[];
     /**
-     * @generated from protobuf field: string dns_scan_id = 2
+     * @generated from protobuf field: repeated string subdomains = 2
      */
-    dnsScanId: string;
-}
-/**
- * @generated from protobuf message service.ScanWhoisResponse
- */
-export interface ScanWhoisResponse {
+    subdomains: string[];
     /**
-     * @generated from protobuf field: string scan_id = 1
+     * @generated from protobuf field: repeated string errors = 3
      */
-    scanId: string;
+    errors: string[];
     /**
-     * @generated from protobuf field: service.WhoisSecurityResult result = 2
+     * @generated from protobuf field: repeated string unavailable = 4
      */
-    result?: WhoisSecurityResult;
+    unavailable: string[]; // requests the provider could not serve even after retries; not scored
 }
 /**
- * @generated from protobuf message service.GetWhoisScanResultsByDomainRequest
+ * @generated from protobuf message service.ChaosSecurityResult
  */
-export interface GetWhoisScanResultsByDomainRequest {
+export interface ChaosSecurityResult {
     /**
-     * @generated from protobuf field: string domain = 1
+     * @generated from protobuf field: repeated string subdomains = 1
      */
-    domain: string;
-}
-/**
- * @generated from protobuf message service.GetWhoisScanResultsByDomainResponse
- */
-export interface GetWhoisScanResultsByDomainResponse {
+    subdomains: string[];
     /**
-     * @generated from protobuf field: repeated service.WhoisScanResult results = 1
+     * @generated from protobuf field: repeated string errors = 2
      */
-    results: WhoisScanResult[];
+    errors: string[];
+    /**
+     * @generated from protobuf field: bool cached = 3
+     */
+    cached: boolean; // served from the intelligence cache
+    /**
+     * @generated from protobuf field: int64 cache_age_seconds = 4
+     */
+    cacheAgeSeconds: bigint; // age of the cached data
+    /**
+     * @generated from protobuf field: repeated string unavailable = 5
+     */
+    unavailable: string[]; // requests the provider could not serve, such as once its quota is spent; not scored
 }
 /**
- * @generated from protobuf message service.WhoisScanResult
+ * @generated from protobuf message service.ShodanScanResult
  */
-export interface WhoisScanResult {
+export interface ShodanScanResult {
     /**
      * @generated from protobuf field: string id = 1
      */
@@ -1457,97 +1617,155 @@ export interface WhoisScanResult {
      */
     dnsScanId: string;
     /**
-     * @generated from protobuf field: service.WhoisSecurityResult result = 4
+     * @generated from protobuf field: service.ShodanSecurityResult result = 4
      */
-    result?: WhoisSecurityResult;
+    result?: ShodanSecurityResult;
     /**
      * @generated from protobuf field: google.protobuf.Timestamp created_at = 5
      */
     createdAt?: Timestamp;
 }
 /**
- * @generated from protobuf message service.WhoisSecurityResult
+ * @generated from protobuf message service.ShodanLocation
  */
-export interface WhoisSecurityResult {
-    /**
-     * @generated from protobuf field: string domain = 1
-     */
-    domain: string;
-    /**
-     * @generated from protobuf field: string registrar = 2
-     */
-    registrar: string;
+export interface ShodanLocation {
     /**
-     * @generated from protobuf field: google.protobuf.Timestamp creation_date = 3
+     * @generated from protobuf field: string city = 1
      */
-    creationDate?: Timestamp;
+    city: string;
     /**
-     * @generated from protobuf field: google.protobuf.Timestamp expiry_date = 4
+     * @generated from protobuf field: string country_name = 2
      */
-    expiryDate?: Timestamp;
+    countryName: string;
     /**
-     * @generated from protobuf field: string registrant_name = 5
+     * @generated from protobuf field: float latitude = 3
      */
-    registrantName: string;
+    latitude: number;
     /**
-     * @generated from protobuf field: repeated string errors = 6
+     * @generated from protobuf field: float longitude = 4
      */
-    errors: string[];
+    longitude: number;
 }
 /**
- * @generated from protobuf message service.AbuseChIOC
+ * @generated from protobuf message service.ShodanSSL
  */
-export interface AbuseChIOC {
+export interface ShodanSSL {
     /**
-     * @generated from protobuf field: string ioc_type = 1
+     * @generated from protobuf field: string issuer = 1
      */
-    iocType: string;
+    issuer: string;
     /**
-     * @generated from protobuf field: string ioc_value = 2
+     * @generated from protobuf field: string subject = 2
      */
-    iocValue: string;
+    subject: string;
     /**
-     * @generated from protobuf field: string threat_type = 3
+     * @generated from protobuf field: google.protobuf.Timestamp expires = 3
      */
-    threatType: string;
+    expires?: Timestamp;
     /**
-     * @generated from protobuf field: float confidence = 4
+     * @generated from protobuf field: google.protobuf.Timestamp not_after = 4
      */
-    confidence: number;
+    notAfter?: Timestamp;
+}
+/**
+ * @generated from protobuf message service.ShodanMetadata
+ */
+export interface ShodanMetadata {
     /**
-     * @generated from protobuf field: google.protobuf.Timestamp first_seen = 5
+     * @generated from protobuf field: string module = 1
      */
-    firstSeen?: Timestamp;
-    /**
-     * @generated from protobuf field: google.protobuf.Timestamp last_seen = 6
+    module: string;
+}
+/**
+ * @generated from protobuf message service.ShodanHost
+ */
+export interface ShodanHost {
+    /**
+     * @generated from protobuf field: string ip = 1
      */
-    lastSeen?: Timestamp;
+    ip: string;
     /**
-     * @generated from protobuf field: repeated string malware_alias = 7
+     * @generated from protobuf field: int32 port = 2
      */
-    malwareAlias: string[];
+    port: number;
     /**
-     * @generated from protobuf field: repeated string tags = 8
+     * @generated from protobuf field: repeated string hostnames = 3
+     */
+    hostnames: string[];
+    /**
+     * @generated from protobuf field: string os = 4
+     */
+    os: string;
+    /**
+     * @generated from protobuf field: string banner = 5
+     */
+    banner: string;
+    /**
+     * @generated from protobuf field: repeated string tags = 6
      */
     tags: string[];
+    /**
+     * @generated from protobuf field: service.ShodanLocation location = 7
+     */
+    location?: ShodanLocation;
+    /**
+     * @generated from protobuf field: service.ShodanSSL ssl = 8
+     */
+    ssl?: ShodanSSL;
+    /**
+     * @generated from protobuf field: repeated string domains = 9
+     */
+    domains: string[];
+    /**
+     * @generated from protobuf field: string asn = 10
+     */
+    asn: string;
+    /**
+     * @generated from protobuf field: string org = 11
+     */
+    org: string;
+    /**
+     * @generated from protobuf field: string isp = 12
+     */
+    isp: string;
+    /**
+     * @generated from protobuf field: google.protobuf.Timestamp timestamp = 13
+     */
+    timestamp?: Timestamp;
+    /**
+     * @generated from protobuf field: service.ShodanMetadata shodan_meta = 14
+     */
+    shodanMeta?: ShodanMetadata;
 }
 /**
- * @generated from protobuf message service.AbuseChSecurityResult
+ * @generated from protobuf message service.ShodanSecurityResult
  */
-export interface AbuseChSecurityResult {
+export interface ShodanSecurityResult {
     /**
-     * @generated from protobuf field: repeated service.AbuseChIOC iocs = 1
+     * @generated from protobuf field: repeated service.ShodanHost hosts = 1
      */
-    iocs: AbuseChIOC[];
+    hosts: ShodanHost[];
     /**
      * @generated from protobuf field: repeated string errors = 2
      */
     errors: string[];
+    /**
+     * @generated from protobuf field: repeated string unavailable = 3
+     */
+    unavailable: string[]; // requests the provider could not serve even after retries; not scored
+    /**
+     * @generated from protobuf field: bool cached = 4
+     */
+    cached: boolean; // some lookups were served from the intelligence cache
+    /**
+     * @generated from protobuf field: int64 cache_age_seconds = 5
+     */
+    cacheAgeSeconds: bigint; // age of the oldest cached data used
 }
 /**
- * @generated from protobuf message service.ScanAbuseChRequest
+ * @generated from protobuf message service.ScanOTXRequest
  */
-export interface ScanAbuseChRequest {
+export interface ScanOTXRequest {
     /**
      * @generated from protobuf field: string domain = 1
      */
@@ -1556,42 +1774,50 @@ export interface ScanAbuseChRequest {
      * @generated from protobuf field: string dns_scan_id = 2
      */
     dnsScanId: string;
+    /**
+     * @generated from protobuf field: bool refresh = 3
+     */
+    refresh: boolean; // bypass cached intelligence lookups
 }
 /**
- * @generated from protobuf message service.ScanAbuseChResponse
+ * @generated from protobuf message service.ScanOTXResponse
  */
-export interface ScanAbuseChResponse {
+export interface ScanOTXResponse {
     /**
      * @generated from protobuf field: string scan_id = 1
      */
     scanId: string;
     /**
-     * @generated from protobuf field: service.AbuseChSecurityResult result = 2
+     * @generated from protobuf field: service.OTXSecurityResult result = 2
      */
-    result?: AbuseChSecurityResult;
+    result?: OTXSecurityResult;
+    /**
+     * @generated from protobuf field: string scan_run_id = 3
+     */
+    scanRunId: string;
 }
 /**
- * @generated from protobuf message service.GetAbuseChScanResultsByDomainRequest
+ * @generated from protobuf message service.GetOTXScanResultsByDomainRequest
  */
-export interface GetAbuseChScanResultsByDomainRequest {
+export interface GetOTXScanResultsByDomainRequest {
     /**
      * @generated from protobuf field: string domain = 1
      */
     domain: string;
 }
 /**
- * @generated from protobuf message service.GetAbuseChScanResultsByDomainResponse
+ * @generated from protobuf message service.GetOTXScanResultsByDomainResponse
  */
-export interface GetAbuseChScanResultsByDomainResponse {
+export interface GetOTXScanResultsByDomainResponse {
     /**
-     * @generated from protobuf field: repeated service.AbuseChScanResult results = 1
+     * @generated from protobuf field: repeated service.OTXScanResult results = 1
      */
-    results: AbuseChScanResult[];
+    results: OTXScanResult[];
 }
 /**
- * @generated from protobuf message service.AbuseChScanResult
+ * @generated from protobuf message service.OTXScanResult
  */
-export interface AbuseChScanResult {
+export interface OTXScanResult {
     /**
      * @generated from protobuf field: string id = 1
      */
@@ -1605,143 +1831,5158 @@ export interface AbuseChScanResult {
      */
     dnsScanId: string;
     /**
-     * @generated from protobuf field: service.AbuseChSecurityResult result = 4
+     * @generated from protobuf field: service.OTXSecurityResult result = 4
      */
-    result?: AbuseChSecurityResult;
+    result?: OTXSecurityResult;
     /**
      * @generated from protobuf field: google.protobuf.Timestamp created_at = 5
      */
     createdAt?: Timestamp;
 }
 /**
- * @generated from protobuf message service.ScanISCRequest
+ * @generated from protobuf message service.OTXGeneralInfo
  */
-export interface ScanISCRequest {
+export interface OTXGeneralInfo {
     /**
-     * @generated from protobuf field: string domain = 1
+     * @generated from protobuf field: int32 pulse_count = 1
      */
-    domain: string;
+    pulseCount: number;
     /**
-     * @generated from protobuf field: string dns_scan_id = 2
+     * @generated from protobuf field: repeated string pulses = 2
      */
-    dnsScanId: string;
+    pulses: string[];
 }
 /**
- * @generated from protobuf message service.ScanISCResponse
+ * @generated from protobuf message service.OTXMalware
  */
-export interface ScanISCResponse {
+export interface OTXMalware {
     /**
-     * @generated from protobuf field: string scan_id = 1
+     * @generated from protobuf field: string hash = 1
      */
-    scanId: string;
+    hash: string;
     /**
-     * @generated from protobuf field: service.ISCSecurityResult result = 2
+     * @generated from protobuf field: google.protobuf.Timestamp datetime = 2
      */
-    result?: ISCSecurityResult;
+    datetime?: Timestamp;
 }
 /**
- * @generated from protobuf message service.GetISCScanResultsByDomainRequest
+ * @generated from protobuf message service.OTXURL
  */
-export interface GetISCScanResultsByDomainRequest {
+export interface OTXURL {
     /**
-     * @generated from protobuf field: string domain = 1
+     * @generated from protobuf field: string url = 1
      */
-    domain: string;
+    url: string;
+    /**
+     * @generated from protobuf field: google.protobuf.Timestamp datetime = 2
+     */
+    datetime?: Timestamp;
 }
 /**
- * @generated from protobuf message service.GetISCScanResultsByDomainResponse
+ * @generated from protobuf message service.OTXPassiveDNS
  */
-export interface GetISCScanResultsByDomainResponse {
+export interface OTXPassiveDNS {
     /**
-     * @generated from protobuf field: repeated service.ISCScanResult results = 1
+     * @generated from protobuf field: string address = 1
      */
-    results: ISCScanResult[];
+    address: string;
+    /**
+     * @generated from protobuf field: string hostname = 2
+     */
+    hostname: string;
+    /**
+     * @generated from protobuf field: string record = 3
+     */
+    record: string;
+    /**
+     * @generated from protobuf field: google.protobuf.Timestamp datetime = 4
+     */
+    datetime?: Timestamp;
 }
 /**
- * @generated from protobuf message service.ISCScanResult
+ * @generated from protobuf message service.OTXSecurityResult
  */
-export interface ISCScanResult {
+export interface OTXSecurityResult {
     /**
-     * @generated from protobuf field: string id = 1
+     * @generated from protobuf field: service.OTXGeneralInfo general_info = 1
      */
-    id: string;
+    generalInfo?: OTXGeneralInfo;
     /**
-     * @generated from protobuf field: string domain = 2
+     * @generated from protobuf field: repeated service.OTXMalware malware = 2
      */
-    domain: string;
+    malware: OTXMalware[];
     /**
-     * @generated from protobuf field: string dns_scan_id = 3
+     * @generated from protobuf field: repeated service.OTXURL urls = 3
      */
-    dnsScanId: string;
+    urls: OTXURL[];
     /**
-     * @generated from protobuf field: service.ISCSecurityResult result = 4
+     * @generated from protobuf field: repeated service.OTXPassiveDNS passive_dns = 4
      */
-    result?: ISCSecurityResult;
+    passiveDns: OTXPassiveDNS[];
     /**
-     * @generated from protobuf field: google.protobuf.Timestamp created_at = 5
+     * @generated from protobuf field: repeated string errors = 5
      */
-    createdAt?: Timestamp;
+    errors: string[];
+    /**
+     * @generated from protobuf field: repeated service.OTXIPReputation ip_reputation = 6
+     */
+    ipReputation: OTXIPReputation[]; // one per resolved IP
+    /**
+     * @generated from protobuf field: repeated string unavailable = 7
+     */
+    unavailable: string[]; // requests the provider could not serve even after retries; not scored
+    /**
+     * @generated from protobuf field: bool cached = 8
+     */
+    cached: boolean; // some lookups were served from the intelligence cache
+    /**
+     * @generated from protobuf field: int64 cache_age_seconds = 9
+     */
+    cacheAgeSeconds: bigint; // age of the oldest cached data used
 }
 /**
- * @generated from protobuf message service.ISCIncident
+ * @generated from protobuf message service.OTXIPReputation
  */
-export interface ISCIncident {
+export interface OTXIPReputation {
     /**
-     * @generated from protobuf field: string id = 1
+     * @generated from protobuf field: string ip = 1
      */
-    id: string;
+    ip: string;
     /**
-     * @generated from protobuf field: google.protobuf.Timestamp date = 2
+     * @generated from protobuf field: int32 pulse_count = 2
      */
-    date?: Timestamp;
+    pulseCount: number;
     /**
-     * @generated from protobuf field: string description = 3
+     * @generated from protobuf field: int32 reputation = 3
      */
-    description: string;
+    reputation: number;
     /**
-     * @generated from protobuf field: string severity = 4
+     * @generated from protobuf field: string country_name = 4
      */
-    severity: string; // e.g., "Low", "Medium", "High"
+    countryName: string;
 }
 /**
- * @generated from protobuf message service.ISCSecurityResult
+ * @generated from protobuf message service.ScanWhoisRequest
  */
-export interface ISCSecurityResult {
+export interface ScanWhoisRequest {
     /**
-     * @generated from protobuf field: repeated service.ISCIncident incidents = 1
+     * @generated from protobuf field: string domain = 1
      */
-    incidents: ISCIncident[];
+    domain: string;
     /**
-     * @generated from protobuf field: string overall_risk = 2
+     * @generated from protobuf field: string dns_scan_id = 2
      */
-    overallRisk: string;
+    dnsScanId: string;
+}
+/**
+ * @generated from protobuf message service.ScanWhoisResponse
+ */
+export interface ScanWhoisResponse {
     /**
-     * @generated from protobuf field: repeated string errors = 3
+     * @generated from protobuf field: string scan_id = 1
      */
-    errors: string[];
+    scanId: string;
+    /**
+     * @generated from protobuf field: service.WhoisSecurityResult result = 2
+     */
+    result?: WhoisSecurityResult;
+    /**
+     * @generated from protobuf field: string scan_run_id = 3
+     */
+    scanRunId: string;
 }
-// @generated message type with reflection information, may provide speed optimized methods
-class GenerateReportRequest$Type extends MessageType<GenerateReportRequest> {
-    constructor() {
-        super("service.GenerateReportRequest", [
-            { no: 1, name: "domain", kind: "scalar", T: 9 /*ScalarType.STRING*/ }
-        ]);
-    }
-    create(value?: PartialMessage<GenerateReportRequest>): GenerateReportRequest {
-        const message = globalThis.Object.create((this.messagePrototype!));
-        message.domain = "";
-        if (value !== undefined)
-            reflectionMergePartial<GenerateReportRequest>(this, message, value);
-        return message;
-    }
-    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: GenerateReportRequest): GenerateReportRequest {
-        let message = target ?? this.create(), end = reader.pos + length;
-        while (reader.pos < end) {
+/**
+ * @generated from protobuf message service.GetWhoisScanResultsByDomainRequest
+ */
+export interface GetWhoisScanResultsByDomainRequest {
+    /**
+     * @generated from protobuf field: string domain = 1
+     */
+    domain: string;
+}
+/**
+ * @generated from protobuf message service.GetWhoisScanResultsByDomainResponse
+ */
+export interface GetWhoisScanResultsByDomainResponse {
+    /**
+     * @generated from protobuf field: repeated service.WhoisScanResult results = 1
+     */
+    results: WhoisScanResult[];
+}
+/**
+ * @generated from protobuf message service.WhoisScanResult
+ */
+export interface WhoisScanResult {
+    /**
+     * @generated from protobuf field: string id = 1
+     */
+    id: string;
+    /**
+     * @generated from protobuf field: string domain = 2
+     */
+    domain: string;
+    /**
+     * @generated from protobuf field: string dns_scan_id = 3
+     */
+    dnsScanId: string;
+    /**
+     * @generated from protobuf field: service.WhoisSecurityResult result = 4
+     */
+    result?: WhoisSecurityResult;
+    /**
+     * @generated from protobuf field: google.protobuf.Timestamp created_at = 5
+     */
+    createdAt?: Timestamp;
+}
+/**
+ * @generated from protobuf message service.WhoisSecurityResult
+ */
+export interface WhoisSecurityResult {
+    /**
+     * @generated from protobuf field: string domain = 1
+     */
+    domain: string;
+    /**
+     * @generated from protobuf field: string registrar = 2
+     */
+    registrar: string;
+    /**
+     * @generated from protobuf field: google.protobuf.Timestamp creation_date = 3
+     */
+    creationDate?: Timestamp;
+    /**
+     * @generated from protobuf field: google.protobuf.Timestamp expiry_date = 4
+     */
+    expiryDate?: Timestamp;
+    /**
+     * @generated from protobuf field: string registrant_name = 5
+     */
+    registrantName: string;
+    /**
+     * @generated from protobuf field: repeated string errors = 6
+     */
+    errors: string[];
+}
+/**
+ * @generated from protobuf message service.AbuseChIOC
+ */
+export interface AbuseChIOC {
+    /**
+     * @generated from protobuf field: string ioc_type = 1
+     */
+    iocType: string;
+    /**
+     * @generated from protobuf field: string ioc_value = 2
+     */
+    iocValue: string;
+    /**
+     * @generated from protobuf field: string threat_type = 3
+     */
+    threatType: string;
+    /**
+     * @generated from protobuf field: float confidence = 4
+     */
+    confidence: number;
+    /**
+     * @generated from protobuf field: google.protobuf.Timestamp first_seen = 5
+     */
+    firstSeen?: Timestamp;
+    /**
+     * @generated from protobuf field: google.protobuf.Timestamp last_seen = 6
+     */
+    lastSeen?: Timestamp;
+    /**
+     * @generated from protobuf field: repeated string malware_alias = 7
+     */
+    malwareAlias: string[];
+    /**
+     * @generated from protobuf field: repeated string tags = 8
+     */
+    tags: string[];
+}
+/**
+ * @generated from protobuf message service.AbuseChSecurityResult
+ */
+export interface AbuseChSecurityResult {
+    /**
+     * @generated from protobuf field: repeated service.AbuseChIOC iocs = 1
+     */
+    iocs: AbuseChIOC[];
+    /**
+     * @generated from protobuf field: repeated string errors = 2
+     */
+    errors: string[];
+    /**
+     * @generated from protobuf field: repeated string unavailable = 3
+     */
+    unavailable: string[]; // requests the provider could not serve even after retries; not scored
+}
+/**
+ * @generated from protobuf message service.ScanAbuseChRequest
+ */
+export interface ScanAbuseChRequest {
+    /**
+     * @generated from protobuf field: string domain = 1
+     */
+    domain: string;
+    /**
+     * @generated from protobuf field: string dns_scan_id = 2
+     */
+    dnsScanId: string;
+}
+/**
+ * @generated from protobuf message service.ScanAbuseChResponse
+ */
+export interface ScanAbuseChResponse {
+    /**
+     * @generated from protobuf field: string scan_id = 1
+     */
+    scanId: string;
+    /**
+     * @generated from protobuf field: service.AbuseChSecurityResult result = 2
+     */
+    result?: AbuseChSecurityResult;
+    /**
+     * @generated from protobuf field: string scan_run_id = 3
+     */
+    scanRunId: string;
+}
+/**
+ * @generated from protobuf message service.GetAbuseChScanResultsByDomainRequest
+ */
+export interface GetAbuseChScanResultsByDomainRequest {
+    /**
+     * @generated from protobuf field: string domain = 1
+     */
+    domain: string;
+}
+/**
+ * @generated from protobuf message service.GetAbuseChScanResultsByDomainResponse
+ */
+export interface GetAbuseChScanResultsByDomainResponse {
+    /**
+     * @generated from protobuf field: repeated service.AbuseChScanResult results = 1
+     */
+    results: AbuseChScanResult[];
+}
+/**
+ * @generated from protobuf message service.AbuseChScanResult
+ */
+export interface AbuseChScanResult {
+    /**
+     * @generated from protobuf field: string id = 1
+     */
+    id: string;
+    /**
+     * @generated from protobuf field: string domain = 2
+     */
+    domain: string;
+    /**
+     * @generated from protobuf field: string dns_scan_id = 3
+     */
+    dnsScanId: string;
+    /**
+     * @generated from protobuf field: service.AbuseChSecurityResult result = 4
+     */
+    result?: AbuseChSecurityResult;
+    /**
+     * @generated from protobuf field: google.protobuf.Timestamp created_at = 5
+     */
+    createdAt?: Timestamp;
+}
+/**
+ * @generated from protobuf message service.ScanISCRequest
+ */
+export interface ScanISCRequest {
+    /**
+     * @generated from protobuf field: string domain = 1
+     */
+    domain: string;
+    /**
+     * @generated from protobuf field: string dns_scan_id = 2
+     */
+    dnsScanId: string;
+}
+/**
+ * @generated from protobuf message service.ScanISCResponse
+ */
+export interface ScanISCResponse {
+    /**
+     * @generated from protobuf field: string scan_id = 1
+     */
+    scanId: string;
+    /**
+     * @generated from protobuf field: service.ISCSecurityResult result = 2
+     */
+    result?: ISCSecurityResult;
+    /**
+     * @generated from protobuf field: string scan_run_id = 3
+     */
+    scanRunId: string;
+}
+/**
+ * @generated from protobuf message service.GetISCScanResultsByDomainRequest
+ */
+export interface GetISCScanResultsByDomainRequest {
+    /**
+     * @generated from protobuf field: string domain = 1
+     */
+    domain: string;
+}
+/**
+ * @generated from protobuf message service.GetISCScanResultsByDomainResponse
+ */
+export interface GetISCScanResultsByDomainResponse {
+    /**
+     * @generated from protobuf field: repeated service.ISCScanResult results = 1
+     */
+    results: ISCScanResult[];
+}
+/**
+ * @generated from protobuf message service.ISCScanResult
+ */
+export interface ISCScanResult {
+    /**
+     * @generated from protobuf field: string id = 1
+     */
+    id: string;
+    /**
+     * @generated from protobuf field: string domain = 2
+     */
+    domain: string;
+    /**
+     * @generated from protobuf field: string dns_scan_id = 3
+     */
+    dnsScanId: string;
+    /**
+     * @generated from protobuf field: service.ISCSecurityResult result = 4
+     */
+    result?: ISCSecurityResult;
+    /**
+     * @generated from protobuf field: google.protobuf.Timestamp created_at = 5
+     */
+    createdAt?: Timestamp;
+}
+/**
+ * @generated from protobuf message service.ISCIncident
+ */
+export interface ISCIncident {
+    /**
+     * @generated from protobuf field: string id = 1
+     */
+    id: string;
+    /**
+     * @generated from protobuf field: google.protobuf.Timestamp date = 2
+     */
+    date?: Timestamp;
+    /**
+     * @generated from protobuf field: string description = 3
+     */
+    description: string;
+    /**
+     * @generated from protobuf field: string severity = 4
+     */
+    severity: string; // e.g., "Low", "Medium", "High"
+}
+/**
+ * @generated from protobuf message service.ISCSecurityResult
+ */
+export interface ISCSecurityResult {
+    /**
+     * @generated from protobuf field: repeated service.ISCIncident incidents = 1
+     */
+    incidents: ISCIncident[];
+    /**
+     * @generated from protobuf field: string overall_risk = 2
+     */
+    overallRisk: string;
+    /**
+     * @generated from protobuf field: repeated string errors = 3
+     */
+    errors: string[];
+    /**
+     * @generated from protobuf field: repeated service.ISCIPReputation ip_reputation = 4
+     */
+    ipReputation: ISCIPReputation[]; // one per resolved IP
+    /**
+     * @generated from protobuf field: repeated string unavailable = 5
+     */
+    unavailable: string[]; // requests the provider could not serve even after retries; not scored
+}
+/**
+ * @generated from protobuf message service.ISCIPReputation
+ */
+export interface ISCIPReputation {
+    /**
+     * @generated from protobuf field: string ip = 1
+     */
+    ip: string;
+    /**
+     * @generated from protobuf field: int32 count = 2
+     */
+    count: number; // reports naming the IP as a source
+    /**
+     * @generated from protobuf field: int32 attacks = 3
+     */
+    attacks: number; // distinct targets attacked
+    /**
+     * @generated from protobuf field: string as_name = 4
+     */
+    asName: string;
+}
+/**
+ * Plugin registry messages
+ *
+ * @generated from protobuf message service.ListPluginsRequest
+ */
+export interface ListPluginsRequest {
+}
+/**
+ * @generated from protobuf message service.ListPluginsResponse
+ */
+export interface ListPluginsResponse {
+    /**
+     * @generated from protobuf field: repeated service.PluginInfo plugins = 1
+     */
+    plugins: PluginInfo[];
+}
+/**
+ * @generated from protobuf message service.PluginInfo
+ */
+export interface PluginInfo {
+    /**
+     * @generated from protobuf field: string name = 1
+     */
+    name: string;
+    /**
+     * @generated from protobuf field: string version = 2
+     */
+    version: string;
+    /**
+     * @generated from protobuf field: string description = 3
+     */
+    description: string;
+    /**
+     * @generated from protobuf field: repeated string required_config = 4
+     */
+    requiredConfig: string[]; // config.yaml keys, e.g. "shodan.api_key"
+    /**
+     * @generated from protobuf field: bool passive = 5
+     */
+    passive: boolean; // true if the plugin never contacts the target directly
+    /**
+     * @generated from protobuf field: repeated string dependencies = 6
+     */
+    dependencies: string[];
+    /**
+     * @generated from protobuf field: bool loaded = 7
+     */
+    loaded: boolean;
+    /**
+     * @generated from protobuf field: bool configured = 8
+     */
+    configured: boolean;
+    /**
+     * @generated from protobuf field: repeated string missing_config = 9
+     */
+    missingConfig: string[];
+    /**
+     * @generated from protobuf field: bool external = 10
+     */
+    external: boolean; // runs as a separate executable under external_plugins
+    /**
+     * @generated from protobuf field: bool healthy = 11
+     */
+    healthy: boolean; // loaded and, for plugins that check, able to scan
+    /**
+     * @generated from protobuf field: string health_message = 12
+     */
+    healthMessage: string; // why the plugin is unhealthy or was not loaded
+    /**
+     * @generated from protobuf field: bool enabled = 13
+     */
+    enabled: boolean; // false if turned off under plugins in config.yaml
+}
+/**
+ * Scan job messages
+ *
+ * @generated from protobuf message service.SubmitScanJobRequest
+ */
+export interface SubmitScanJobRequest {
+    /**
+     * @generated from protobuf field: string domain = 1
+     */
+    domain: string;
+    /**
+     * @generated from protobuf field: string profile = 2
+     */
+    profile: string; // scan profile name; defaults to "full"
+    /**
+     * @generated from protobuf field: bool refresh = 3
+     */
+    refresh: boolean; // bypass cached intelligence lookups
+}
+/**
+ * @generated from protobuf message service.SubmitScanJobResponse
+ */
+export interface SubmitScanJobResponse {
+    /**
+     * @generated from protobuf field: service.ScanJob job = 1
+     */
+    job?: ScanJob;
+}
+/**
+ * @generated from protobuf message service.GetScanJobRequest
+ */
+export interface GetScanJobRequest {
+    /**
+     * @generated from protobuf field: string job_id = 1
+     */
+    jobId: string;
+}
+/**
+ * @generated from protobuf message service.GetScanJobResponse
+ */
+export interface GetScanJobResponse {
+    /**
+     * @generated from protobuf field: service.ScanJob job = 1
+     */
+    job?: ScanJob;
+}
+/**
+ * @generated from protobuf message service.ListScanJobsRequest
+ */
+export interface ListScanJobsRequest {
+    /**
+     * @generated from protobuf field: int32 limit = 1
+     */
+    limit: number; // defaults to 50
+}
+/**
+ * @generated from protobuf message service.ListScanJobsResponse
+ */
+export interface ListScanJobsResponse {
+    /**
+     * @generated from protobuf field: repeated service.ScanJob jobs = 1
+     */
+    jobs: ScanJob[];
+}
+/**
+ * @generated from protobuf message service.CancelScanJobRequest
+ */
+export interface CancelScanJobRequest {
+    /**
+     * @generated from protobuf field: string job_id = 1
+     */
+    jobId: string;
+}
+/**
+ * @generated from protobuf message service.CancelScanJobResponse
+ */
+export interface CancelScanJobResponse {
+    /**
+     * @generated from protobuf field: service.ScanJob job = 1
+     */
+    job?: ScanJob;
+}
+/**
+ * @generated from protobuf message service.ScanJob
+ */
+export interface ScanJob {
+    /**
+     * @generated from protobuf field: string job_id = 1
+     */
+    jobId: string;
+    /**
+     * @generated from protobuf field: string domain = 2
+     */
+    domain: string;
+    /**
+     * @generated from protobuf field: string status = 3
+     */
+    status: string; // queued, running, succeeded, failed or cancelled
+    /**
+     * @generated from protobuf field: string report_id = 4
+     */
+    reportId: string;
+    /**
+     * @generated from protobuf field: string error = 5
+     */
+    error: string;
+    /**
+     * @generated from protobuf field: bool cancel_requested = 6
+     */
+    cancelRequested: boolean;
+    /**
+     * @generated from protobuf field: repeated service.ScanJobPlugin plugins = 7
+     */
+    plugins: ScanJobPlugin[];
+    /**
+     * @generated from protobuf field: google.protobuf.Timestamp created_at = 8
+     */
+    createdAt?: Timestamp;
+    /**
+     * @generated from protobuf field: google.protobuf.Timestamp started_at = 9
+     */
+    startedAt?: Timestamp;
+    /**
+     * @generated from protobuf field: google.protobuf.Timestamp finished_at = 10
+     */
+    finishedAt?: Timestamp;
+    /**
+     * @generated from protobuf field: string parent_job_id = 11
+     */
+    parentJobId: string; // set on jobs queued for subdomains found by another job
+    /**
+     * @generated from protobuf field: string scan_run_id = 12
+     */
+    scanRunId: string;
+    /**
+     * @generated from protobuf field: string batch_id = 13
+     */
+    batchId: string; // set on jobs submitted by BulkScan
+    /**
+     * @generated from protobuf field: repeated string tags = 14
+     */
+    tags: string[];
+    /**
+     * @generated from protobuf field: string profile = 15
+     */
+    profile: string;
+    /**
+     * @generated from protobuf field: bool refresh = 16
+     */
+    refresh: boolean; // bypasses cached intelligence lookups
+}
+/**
+ * @generated from protobuf message service.ScanJobPlugin
+ */
+export interface ScanJobPlugin {
+    /**
+     * @generated from protobuf field: string plugin = 1
+     */
+    plugin: string;
+    /**
+     * @generated from protobuf field: string status = 2
+     */
+    status: string; // queued, running, succeeded, failed or skipped
+    /**
+     * @generated from protobuf field: string result_id = 3
+     */
+    resultId: string;
+    /**
+     * @generated from protobuf field: string error = 4
+     */
+    error: string;
+    /**
+     * @generated from protobuf field: google.protobuf.Timestamp started_at = 5
+     */
+    startedAt?: Timestamp;
+    /**
+     * @generated from protobuf field: google.protobuf.Timestamp finished_at = 6
+     */
+    finishedAt?: Timestamp;
+    /**
+     * @generated from protobuf field: bool timed_out = 7
+     */
+    timedOut: boolean; // the plugin was abandoned after exceeding its timeout
+}
+/**
+ * Scan run messages
+ *
+ * @generated from protobuf message service.GetScanRunRequest
+ */
+export interface GetScanRunRequest {
+    /**
+     * @generated from protobuf field: string scan_run_id = 1
+     */
+    scanRunId: string;
+}
+/**
+ * @generated from protobuf message service.GetScanRunResponse
+ */
+export interface GetScanRunResponse {
+    /**
+     * @generated from protobuf field: service.ScanRun run = 1
+     */
+    run?: ScanRun;
+}
+/**
+ * ScanRun groups the plugin results produced by one scan of a domain
+ *
+ * @generated from protobuf message service.ScanRun
+ */
+export interface ScanRun {
+    /**
+     * @generated from protobuf field: string id = 1
+     */
+    id: string;
+    /**
+     * @generated from protobuf field: string domain = 2
+     */
+    domain: string;
+    /**
+     * @generated from protobuf field: string requested_by = 3
+     */
+    requestedBy: string; // empty for runs started by the server
+    /**
+     * @generated from protobuf field: string profile = 4
+     */
+    profile: string; // scan profile name, "subdomain", or the plugin name for single-plugin scans
+    /**
+     * @generated from protobuf field: string status = 5
+     */
+    status: string; // running, succeeded or failed
+    /**
+     * @generated from protobuf field: string error = 6
+     */
+    error: string;
+    /**
+     * @generated from protobuf field: google.protobuf.Timestamp started_at = 7
+     */
+    startedAt?: Timestamp;
+    /**
+     * @generated from protobuf field: google.protobuf.Timestamp finished_at = 8
+     */
+    finishedAt?: Timestamp;
+    /**
+     * @generated from protobuf field: repeated service.ScanRunResult results = 9
+     */
+    results: ScanRunResult[];
+}
+/**
+ * ScanRunResult is one plugin result stored under a run. Only the field
+ * matching the plugin is set.
+ *
+ * @generated from protobuf message service.ScanRunResult
+ */
+export interface ScanRunResult {
+    /**
+     * @generated from protobuf field: string plugin = 1
+     */
+    plugin: string;
+    /**
+     * @generated from protobuf field: string result_id = 2
+     */
+    resultId: string;
+    /**
+     * @generated from protobuf field: google.protobuf.Timestamp created_at = 3
+     */
+    createdAt?: Timestamp;
+    /**
+     * @generated from protobuf field: service.DNSSecurityResult dns_result = 4
+     */
+    dnsResult?: DNSSecurityResult;
+    /**
+     * @generated from protobuf field: service.TLSSecurityResult tls_result = 5
+     */
+    tlsResult?: TLSSecurityResult;
+    /**
+     * @generated from protobuf field: service.CrtShSecurityResult crtsh_result = 6
+     */
+    crtshResult?: CrtShSecurityResult;
+    /**
+     * @generated from protobuf field: service.ChaosSecurityResult chaos_result = 7
+     */
+    chaosResult?: ChaosSecurityResult;
+    /**
+     * @generated from protobuf field: service.ShodanSecurityResult shodan_result = 8
+     */
+    shodanResult?: ShodanSecurityResult;
+    /**
+     * @generated from protobuf field: service.OTXSecurityResult otx_result = 9
+     */
+    otxResult?: OTXSecurityResult;
+    /**
+     * @generated from protobuf field: service.WhoisSecurityResult whois_result = 10
+     */
+    whoisResult?: WhoisSecurityResult;
+    /**
+     * @generated from protobuf field: service.AbuseChSecurityResult abusech_result = 11
+     */
+    abusechResult?: AbuseChSecurityResult;
+    /**
+     * @generated from protobuf field: service.ISCSecurityResult isc_result = 12
+     */
+    iscResult?: ISCSecurityResult;
+    /**
+     * @generated from protobuf field: service.ExternalSecurityResult external_result = 13
+     */
+    externalResult?: ExternalSecurityResult;
+}
+/**
+ * ExternalSecurityResult is the result of a plugin run as an external
+ * executable, which the server stores without interpreting.
+ *
+ * @generated from protobuf message service.ExternalSecurityResult
+ */
+export interface ExternalSecurityResult {
+    /**
+     * @generated from protobuf field: string plugin = 1
+     */
+    plugin: string;
+    /**
+     * @generated from protobuf field: string result = 2
+     */
+    result: string; // JSON document returned by the plugin
+}
+/**
+ * Scan schedule messages
+ *
+ * @generated from protobuf message service.CreateScanScheduleRequest
+ */
+export interface CreateScanScheduleRequest {
+    /**
+     * @generated from protobuf field: string domain = 1
+     */
+    domain: string;
+    /**
+     * @generated from protobuf field: string cron = 2
+     */
+    cron: string; // five-field cron expression in UTC, e.g. "0 3 * * 1"
+    /**
+     * @generated from protobuf field: int64 interval_seconds = 3
+     */
+    intervalSeconds: bigint; // alternative to cron; at least 60
+    /**
+     * @generated from protobuf field: string profile = 4
+     */
+    profile: string; // defaults to "full"
+}
+/**
+ * @generated from protobuf message service.CreateScanScheduleResponse
+ */
+export interface CreateScanScheduleResponse {
+    /**
+     * @generated from protobuf field: service.ScanSchedule schedule = 1
+     */
+    schedule?: ScanSchedule;
+}
+/**
+ * @generated from protobuf message service.UpdateScanScheduleRequest
+ */
+export interface UpdateScanScheduleRequest {
+    /**
+     * @generated from protobuf field: string schedule_id = 1
+     */
+    scheduleId: string;
+    /**
+     * @generated from protobuf field: string cron = 2
+     */
+    cron: string;
+    /**
+     * @generated from protobuf field: int64 interval_seconds = 3
+     */
+    intervalSeconds: bigint;
+    /**
+     * @generated from protobuf field: string profile = 4
+     */
+    profile: string;
+}
+/**
+ * @generated from protobuf message service.UpdateScanScheduleResponse
+ */
+export interface UpdateScanScheduleResponse {
+    /**
+     * @generated from protobuf field: service.ScanSchedule schedule = 1
+     */
+    schedule?: ScanSchedule;
+}
+/**
+ * @generated from protobuf message service.PauseScanScheduleRequest
+ */
+export interface PauseScanScheduleRequest {
+    /**
+     * @generated from protobuf field: string schedule_id = 1
+     */
+    scheduleId: string;
+    /**
+     * @generated from protobuf field: bool paused = 2
+     */
+    paused: boolean; // false resumes the schedule
+}
+/**
+ * @generated from protobuf message service.PauseScanScheduleResponse
+ */
+export interface PauseScanScheduleResponse {
+    /**
+     * @generated from protobuf field: service.ScanSchedule schedule = 1
+     */
+    schedule?: ScanSchedule;
+}
+/**
+ * @generated from protobuf message service.ListScanSchedulesRequest
+ */
+export interface ListScanSchedulesRequest {
+}
+/**
+ * @generated from protobuf message service.ListScanSchedulesResponse
+ */
+export interface ListScanSchedulesResponse {
+    /**
+     * @generated from protobuf field: repeated service.ScanSchedule schedules = 1
+     */
+    schedules: ScanSchedule[];
+}
+/**
+ * @generated from protobuf message service.ScanSchedule
+ */
+export interface ScanSchedule {
+    /**
+     * @generated from protobuf field: string schedule_id = 1
+     */
+    scheduleId: string;
+    /**
+     * @generated from protobuf field: string domain = 2
+     */
+    domain: string;
+    /**
+     * @generated from protobuf field: string cron = 3
+     */
+    cron: string;
+    /**
+     * @generated from protobuf field: int64 interval_seconds = 4
+     */
+    intervalSeconds: bigint;
+    /**
+     * @generated from protobuf field: string profile = 5
+     */
+    profile: string;
+    /**
+     * @generated from protobuf field: string owner_id = 6
+     */
+    ownerId: string;
+    /**
+     * @generated from protobuf field: bool paused = 7
+     */
+    paused: boolean;
+    /**
+     * @generated from protobuf field: google.protobuf.Timestamp next_run_at = 8
+     */
+    nextRunAt?: Timestamp;
+    /**
+     * @generated from protobuf field: google.protobuf.Timestamp last_run_at = 9
+     */
+    lastRunAt?: Timestamp;
+    /**
+     * @generated from protobuf field: string last_job_id = 10
+     */
+    lastJobId: string;
+    /**
+     * @generated from protobuf field: string last_error = 11
+     */
+    lastError: string; // why the last run could not be queued
+    /**
+     * @generated from protobuf field: google.protobuf.Timestamp created_at = 12
+     */
+    createdAt?: Timestamp;
+    /**
+     * @generated from protobuf field: google.protobuf.Timestamp updated_at = 13
+     */
+    updatedAt?: Timestamp;
+}
+/**
+ * Bulk scan messages
+ *
+ * @generated from protobuf message service.BulkScanRequest
+ */
+export interface BulkScanRequest {
+    /**
+     * @generated from protobuf field: repeated string domains = 1
+     */
+    domains: string[];
+    /**
+     * CSV with a domain column and an optional tags column holding tags
+     * separated by ";". The header row is optional; without one the first
+     * column is the domain and any further columns are tags.
+     *
+     * @generated from protobuf field: bytes csv = 2
+     */
+    csv: Uint8Array;
+    /**
+     * @generated from protobuf field: repeated string tags = 3
+     */
+    tags: string[]; // added to every domain
+    /**
+     * @generated from protobuf field: string profile = 4
+     */
+    profile: string; // defaults to "full"
+}
+/**
+ * @generated from protobuf message service.BulkScanResponse
+ */
+export interface BulkScanResponse {
+    /**
+     * @generated from protobuf field: service.ScanBatch batch = 1
+     */
+    batch?: ScanBatch;
+    /**
+     * @generated from protobuf field: repeated service.BulkScanRejected rejected = 2
+     */
+    rejected: BulkScanRejected[]; // inputs that are not valid domains
+    /**
+     * @generated from protobuf field: int32 duplicates = 3
+     */
+    duplicates: number; // inputs merged into an earlier entry for the same domain
+}
+/**
+ * @generated from protobuf message service.BulkScanRejected
+ */
+export interface BulkScanRejected {
+    /**
+     * @generated from protobuf field: string input = 1
+     */
+    input: string;
+    /**
+     * @generated from protobuf field: string reason = 2
+     */
+    reason: string;
+}
+/**
+ * @generated from protobuf message service.GetScanBatchRequest
+ */
+export interface GetScanBatchRequest {
+    /**
+     * @generated from protobuf field: string batch_id = 1
+     */
+    batchId: string;
+}
+/**
+ * @generated from protobuf message service.GetScanBatchResponse
+ */
+export interface GetScanBatchResponse {
+    /**
+     * @generated from protobuf field: service.ScanBatch batch = 1
+     */
+    batch?: ScanBatch;
+}
+/**
+ * @generated from protobuf message service.ScanBatch
+ */
+export interface ScanBatch {
+    /**
+     * @generated from protobuf field: string batch_id = 1
+     */
+    batchId: string;
+    /**
+     * @generated from protobuf field: int32 total = 2
+     */
+    total: number;
+    /**
+     * @generated from protobuf field: int32 queued = 3
+     */
+    queued: number;
+    /**
+     * @generated from protobuf field: int32 running = 4
+     */
+    running: number;
+    /**
+     * @generated from protobuf field: int32 succeeded = 5
+     */
+    succeeded: number;
+    /**
+     * @generated from protobuf field: int32 failed = 6
+     */
+    failed: number;
+    /**
+     * @generated from protobuf field: int32 cancelled = 7
+     */
+    cancelled: number;
+    /**
+     * @generated from protobuf field: bool done = 8
+     */
+    done: boolean; // every job has finished
+    /**
+     * @generated from protobuf field: service.ScanBatchSummary summary = 9
+     */
+    summary?: ScanBatchSummary;
+    /**
+     * @generated from protobuf field: google.protobuf.Timestamp created_at = 10
+     */
+    createdAt?: Timestamp;
+}
+/**
+ * @generated from protobuf message service.ScanBatchSummary
+ */
+export interface ScanBatchSummary {
+    /**
+     * @generated from protobuf field: int32 reports = 1
+     */
+    reports: number; // jobs that produced a report
+    /**
+     * @generated from protobuf field: double average_score = 2
+     */
+    averageScore: number;
+    /**
+     * @generated from protobuf field: int32 min_score = 3
+     */
+    minScore: number;
+    /**
+     * @generated from protobuf field: int32 max_score = 4
+     */
+    maxScore: number;
+    /**
+     * @generated from protobuf field: repeated service.RiskTierCount risk_tiers = 5
+     */
+    riskTiers: RiskTierCount[];
+    /**
+     * @generated from protobuf field: repeated service.ScanBatchFailure failed_domains = 6
+     */
+    failedDomains: ScanBatchFailure[];
+}
+/**
+ * @generated from protobuf message service.RiskTierCount
+ */
+export interface RiskTierCount {
+    /**
+     * @generated from protobuf field: string risk_tier = 1
+     */
+    riskTier: string;
+    /**
+     * @generated from protobuf field: int32 count = 2
+     */
+    count: number;
+}
+/**
+ * @generated from protobuf message service.ScanBatchFailure
+ */
+export interface ScanBatchFailure {
+    /**
+     * @generated from protobuf field: string domain = 1
+     */
+    domain: string;
+    /**
+     * @generated from protobuf field: string job_id = 2
+     */
+    jobId: string;
+    /**
+     * @generated from protobuf field: string error = 3
+     */
+    error: string;
+}
+// Services definitions
+
+/**
+ * ScanProfile selects which plugins a scan runs, with which options and
+ * timeouts. Built-in and config profiles are shared; users may define their
+ * own with SaveScanProfile.
+ *
+ * @generated from protobuf message service.ScanProfile
+ */
+export interface ScanProfile {
+    /**
+     * @generated from protobuf field: string name = 1
+     */
+    name: string;
+    /**
+     * @generated from protobuf field: string description = 2
+     */
+    description: string;
+    /**
+     * @generated from protobuf field: repeated string plugins = 3
+     */
+    plugins: string[]; // empty means every loaded plugin
+    /**
+     * @generated from protobuf field: bool passive_only = 4
+     */
+    passiveOnly: boolean; // drop plugins that contact the target directly
+    /**
+     * @generated from protobuf field: repeated service.ScanProfileOption options = 5
+     */
+    options: ScanProfileOption[];
+    /**
+     * @generated from protobuf field: repeated service.ScanProfileTimeout timeouts = 6
+     */
+    timeouts: ScanProfileTimeout[];
+    /**
+     * @generated from protobuf field: string source = 7
+     */
+    source: string; // builtin, config or user
+    /**
+     * @generated from protobuf field: google.protobuf.Timestamp created_at = 8
+     */
+    createdAt?: Timestamp;
+    /**
+     * @generated from protobuf field: google.protobuf.Timestamp updated_at = 9
+     */
+    updatedAt?: Timestamp;
+}
+/**
+ * @generated from protobuf message service.ScanProfileOption
+ */
+export interface ScanProfileOption {
+    /**
+     * @generated from protobuf field: string plugin = 1
+     */
+    plugin: string;
+    /**
+     * @generated from protobuf field: string key = 2
+     */
+    key: string;
+    /**
+     * @generated from protobuf field: string value = 3
+     */
+    value: string;
+}
+/**
+ * @generated from protobuf message service.ScanProfileTimeout
+ */
+export interface ScanProfileTimeout {
+    /**
+     * @generated from protobuf field: string plugin = 1
+     */
+    plugin: string;
+    /**
+     * @generated from protobuf field: int32 seconds = 2
+     */
+    seconds: number;
+}
+/**
+ * @generated from protobuf message service.SaveScanProfileRequest
+ */
+export interface SaveScanProfileRequest {
+    /**
+     * @generated from protobuf field: service.ScanProfile profile = 1
+     */
+    profile?: ScanProfile; // created, or replaced if the caller already has one by this name
+}
+/**
+ * @generated from protobuf message service.SaveScanProfileResponse
+ */
+export interface SaveScanProfileResponse {
+    /**
+     * @generated from protobuf field: service.ScanProfile profile = 1
+     */
+    profile?: ScanProfile;
+}
+/**
+ * @generated from protobuf message service.ListScanProfilesRequest
+ */
+export interface ListScanProfilesRequest {
+}
+/**
+ * @generated from protobuf message service.ListScanProfilesResponse
+ */
+export interface ListScanProfilesResponse {
+    /**
+     * @generated from protobuf field: repeated service.ScanProfile profiles = 1
+     */
+    profiles: ScanProfile[];
+}
+/**
+ * @generated from protobuf message service.DeleteScanProfileRequest
+ */
+export interface DeleteScanProfileRequest {
+    /**
+     * @generated from protobuf field: string name = 1
+     */
+    name: string;
+}
+/**
+ * @generated from protobuf message service.DeleteScanProfileResponse
+ */
+export interface DeleteScanProfileResponse {
+}
+/**
+ * @generated from protobuf message service.GetProviderUsageRequest
+ */
+export interface GetProviderUsageRequest {
+    /**
+     * @generated from protobuf field: string provider = 1
+     */
+    provider: string; // optional; every provider with a budget or calls this month when empty
+}
+/**
+ * @generated from protobuf message service.GetProviderUsageResponse
+ */
+export interface GetProviderUsageResponse {
+    /**
+     * @generated from protobuf field: repeated service.ProviderUsage providers = 1
+     */
+    providers: ProviderUsage[];
+}
+/**
+ * ProviderUsage is an intelligence provider's call count against its budget.
+ * Days and months are UTC; limits of 0 are unlimited and their remaining
+ * count is -1.
+ *
+ * @generated from protobuf message service.ProviderUsage
+ */
+export interface ProviderUsage {
+    /**
+     * @generated from protobuf field: string provider = 1
+     */
+    provider: string;
+    /**
+     * @generated from protobuf field: int32 daily_calls = 2
+     */
+    dailyCalls: number;
+    /**
+     * @generated from protobuf field: int32 daily_limit = 3
+     */
+    dailyLimit: number;
+    /**
+     * @generated from protobuf field: int32 daily_remaining = 4
+     */
+    dailyRemaining: number;
+    /**
+     * @generated from protobuf field: int32 monthly_calls = 5
+     */
+    monthlyCalls: number;
+    /**
+     * @generated from protobuf field: int32 monthly_limit = 6
+     */
+    monthlyLimit: number;
+    /**
+     * @generated from protobuf field: int32 monthly_remaining = 7
+     */
+    monthlyRemaining: number;
+    /**
+     * @generated from protobuf field: bool exhausted = 8
+     */
+    exhausted: boolean; // scans using the provider are refused until the budget resets
+}
+/**
+ * @generated from protobuf message service.GetProviderHealthRequest
+ */
+export interface GetProviderHealthRequest {
+    /**
+     * @generated from protobuf field: string provider = 1
+     */
+    provider: string; // optional; every provider called since the server started when empty
+}
+/**
+ * @generated from protobuf message service.GetProviderHealthResponse
+ */
+export interface GetProviderHealthResponse {
+    /**
+     * @generated from protobuf field: repeated service.ProviderHealth providers = 1
+     */
+    providers: ProviderHealth[];
+}
+/**
+ * ProviderHealth is the state of an intelligence provider's circuit breaker.
+ * Scans skip a provider whose breaker is open.
+ *
+ * @generated from protobuf message service.ProviderHealth
+ */
+export interface ProviderHealth {
+    /**
+     * @generated from protobuf field: string provider = 1
+     */
+    provider: string;
+    /**
+     * @generated from protobuf field: string state = 2
+     */
+    state: string; // closed, open or half_open
+    /**
+     * @generated from protobuf field: int32 consecutive_failures = 3
+     */
+    consecutiveFailures: number;
+    /**
+     * @generated from protobuf field: google.protobuf.Timestamp opened_at = 4
+     */
+    openedAt?: Timestamp; // unset while closed
+    /**
+     * @generated from protobuf field: google.protobuf.Timestamp retry_at = 5
+     */
+    retryAt?: Timestamp; // when a trial request is let through; unset while closed
+    /**
+     * @generated from protobuf field: string last_error = 6
+     */
+    lastError: string;
+}
+/**
+ * @generated from protobuf message service.DescribeConfigRequest
+ */
+export interface DescribeConfigRequest {
+}
+/**
+ * DescribeConfigResponse identifies the config the server is running with.
+ * The server reloads config.yaml when it changes or on SIGHUP.
+ *
+ * @generated from protobuf message service.DescribeConfigResponse
+ */
+export interface DescribeConfigResponse {
+    /**
+     * @generated from protobuf field: int32 version = 1
+     */
+    version: number; // 1 for the config loaded at startup, incremented by each reload
+    /**
+     * @generated from protobuf field: string checksum = 2
+     */
+    checksum: string; // SHA-256 of config.yaml as loaded
+    /**
+     * @generated from protobuf field: google.protobuf.Timestamp loaded_at = 3
+     */
+    loadedAt?: Timestamp;
+    /**
+     * @generated from protobuf field: string path = 4
+     */
+    path: string;
+    /**
+     * @generated from protobuf field: string last_error = 5
+     */
+    lastError: string; // why the most recent reload was refused, if one was
+    /**
+     * @generated from protobuf field: google.protobuf.Timestamp failed_at = 6
+     */
+    failedAt?: Timestamp; // unset unless a reload was refused
+}
+// @generated message type with reflection information, may provide speed optimized methods
+class GenerateReportRequest$Type extends MessageType<GenerateReportRequest> {
+    constructor() {
+        super("service.GenerateReportRequest", [
+            { no: 1, name: "domain", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 2, name: "profile", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 3, name: "refresh", kind: "scalar", T: 8 /*ScalarType.BOOL*/ }
+        ]);
+    }
+    create(value?: PartialMessage<GenerateReportRequest>): GenerateReportRequest {
+        const message = globalThis.Object.create((this.messagePrototype!));
+        message.domain = "";
+        message.profile = "";
+        message.refresh = false;
+        if (value !== undefined)
+            reflectionMergePartial<GenerateReportRequest>(this, message, value);
+        return message;
+    }
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: GenerateReportRequest): GenerateReportRequest {
+        let message = target ?? this.create(), end = reader.pos + length;
+        while (reader.pos < end) {
+            let [fieldNo, wireType] = reader.tag();
+            switch (fieldNo) {
+                case /* string domain */ 1:
+                    message.domain = reader.string();
+                    break;
+                case /* string profile */ 2:
+                    message.profile = reader.string();
+                    break;
+                case /* bool refresh */ 3:
+                    message.refresh = reader.bool();
+                    break;
+                default:
+                    let u = options.readUnknownField;
+                    if (u === "throw")
+                        throw new globalThis.Error(`Unknown field ${fieldNo} (wire type ${wireType}) for ${this.typeName}`);
+                    let d = reader.skip(wireType);
+                    if (u !== false)
+                        (u === true ? UnknownFieldHandler.onRead : u)(this.typeName, message, fieldNo, wireType, d);
+            }
+        }
+        return message;
+    }
+    internalBinaryWrite(message: GenerateReportRequest, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* string domain = 1; */
+        if (message.domain !== "")
+            writer.tag(1, WireType.LengthDelimited).string(message.domain);
+        /* string profile = 2; */
+        if (message.profile !== "")
+            writer.tag(2, WireType.LengthDelimited).string(message.profile);
+        /* bool refresh = 3; */
+        if (message.refresh !== false)
+            writer.tag(3, WireType.Varint).bool(message.refresh);
+        let u = options.writeUnknownFields;
+        if (u !== false)
+            (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
+        return writer;
+    }
+}
+/**
+ * @generated MessageType for protobuf message service.GenerateReportRequest
+ */
+export const GenerateReportRequest = new GenerateReportRequest$Type();
+// @generated message type with reflection information, may provide speed optimized methods
+class GenerateReportResponse$Type extends MessageType<GenerateReportResponse> {
+    constructor() {
+        super("service.GenerateReportResponse", [
+            { no: 1, name: "report_id", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 2, name: "dns_scan_id", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 3, name: "score", kind: "scalar", T: 5 /*ScalarType.INT32*/ },
+            { no: 4, name: "risk_tier", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 5, name: "created_at", kind: "message", T: () => Timestamp },
+            { no: 6, name: "scan_run_id", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 7, name: "profile", kind: "scalar", T: 9 /*ScalarType.STRING*/ }
+        ]);
+    }
+    create(value?: PartialMessage<GenerateReportResponse>): GenerateReportResponse {
+        const message = globalThis.Object.create((this.messagePrototype!));
+        message.reportId = "";
+        message.dnsScanId = "";
+        message.score = 0;
+        message.riskTier = "";
+        message.scanRunId = "";
+        message.profile = "";
+        if (value !== undefined)
+            reflectionMergePartial<GenerateReportResponse>(this, message, value);
+        return message;
+    }
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: GenerateReportResponse): GenerateReportResponse {
+        let message = target ?? this.create(), end = reader.pos + length;
+        while (reader.pos < end) {
+            let [fieldNo, wireType] = reader.tag();
+            switch (fieldNo) {
+                case /* string report_id */ 1:
+                    message.reportId = reader.string();
+                    break;
+                case /* string dns_scan_id */ 2:
+                    message.dnsScanId = reader.string();
+                    break;
+                case /* int32 score */ 3:
+                    message.score = reader.int32();
+                    break;
+                case /* string risk_tier */ 4:
+                    message.riskTier = reader.string();
+                    break;
+                case /* google.protobuf.Timestamp created_at */ 5:
+                    message.createdAt = Timestamp.internalBinaryRead(reader, reader.uint32(), options, message.createdAt);
+                    break;
+                case /* string scan_run_id */ 6:
+                    message.scanRunId = reader.string();
+                    break;
+                case /* string profile */ 7:
+                    message.profile = reader.string();
+                    break;
+                default:
+                    let u = options.readUnknownField;
+                    if (u === "throw")
+                        throw new globalThis.Error(`Unknown field ${fieldNo} (wire type ${wireType}) for ${this.typeName}`);
+                    let d = reader.skip(wireType);
+                    if (u !== false)
+                        (u === true ? UnknownFieldHandler.onRead : u)(this.typeName, message, fieldNo, wireType, d);
+            }
+        }
+        return message;
+    }
+    internalBinaryWrite(message: GenerateReportResponse, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* string report_id = 1; */
+        if (message.reportId !== "")
+            writer.tag(1, WireType.LengthDelimited).string(message.reportId);
+        /* string dns_scan_id = 2; */
+        if (message.dnsScanId !== "")
+            writer.tag(2, WireType.LengthDelimited).string(message.dnsScanId);
+        /* int32 score = 3; */
+        if (message.score !== 0)
+            writer.tag(3, WireType.Varint).int32(message.score);
+        /* string risk_tier = 4; */
+        if (message.riskTier !== "")
+            writer.tag(4, WireType.LengthDelimited).string(message.riskTier);
+        /* google.protobuf.Timestamp created_at = 5; */
+        if (message.createdAt)
+            Timestamp.internalBinaryWrite(message.createdAt, writer.tag(5, WireType.LengthDelimited).fork(), options).join();
+        /* string scan_run_id = 6; */
+        if (message.scanRunId !== "")
+            writer.tag(6, WireType.LengthDelimited).string(message.scanRunId);
+        /* string profile = 7; */
+        if (message.profile !== "")
+            writer.tag(7, WireType.LengthDelimited).string(message.profile);
+        let u = options.writeUnknownFields;
+        if (u !== false)
+            (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
+        return writer;
+    }
+}
+/**
+ * @generated MessageType for protobuf message service.GenerateReportResponse
+ */
+export const GenerateReportResponse = new GenerateReportResponse$Type();
+// @generated message type with reflection information, may provide speed optimized methods
+class ReportProgressEvent$Type extends MessageType<ReportProgressEvent> {
+    constructor() {
+        super("service.ReportProgressEvent", [
+            { no: 1, name: "plugin", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 2, name: "status", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 3, name: "result_id", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 4, name: "errors", kind: "scalar", repeat: 2 /*RepeatType.UNPACKED*/, T: 9 /*ScalarType.STRING*/ },
+            { no: 5, name: "timed_out", kind: "scalar", T: 8 /*ScalarType.BOOL*/ },
+            { no: 6, name: "score", kind: "scalar", T: 5 /*ScalarType.INT32*/ },
+            { no: 7, name: "risk_tier", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 8, name: "done", kind: "scalar", T: 8 /*ScalarType.BOOL*/ },
+            { no: 9, name: "report_id", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 10, name: "dns_scan_id", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 11, name: "dns_result", kind: "message", T: () => DNSSecurityResult },
+            { no: 12, name: "tls_result", kind: "message", T: () => TLSSecurityResult },
+            { no: 13, name: "crtsh_result", kind: "message", T: () => CrtShSecurityResult },
+            { no: 14, name: "chaos_result", kind: "message", T: () => ChaosSecurityResult },
+            { no: 15, name: "shodan_result", kind: "message", T: () => ShodanSecurityResult },
+            { no: 16, name: "otx_result", kind: "message", T: () => OTXSecurityResult },
+            { no: 17, name: "whois_result", kind: "message", T: () => WhoisSecurityResult },
+            { no: 18, name: "abusech_result", kind: "message", T: () => AbuseChSecurityResult },
+            { no: 19, name: "isc_result", kind: "message", T: () => ISCSecurityResult },
+            { no: 20, name: "scan_run_id", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 21, name: "profile", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 22, name: "external_result", kind: "message", T: () => ExternalSecurityResult }
+        ]);
+    }
+    create(value?: PartialMessage<ReportProgressEvent>): ReportProgressEvent {
+        const message = globalThis.Object.create((this.messagePrototype!));
+        message.plugin = "";
+        message.status = "";
+        message.resultId = "";
+        message.errors = [];
+        message.timedOut = false;
+        message.score = 0;
+        message.riskTier = "";
+        message.done = false;
+        message.reportId = "";
+        message.dnsScanId = "";
+        message.scanRunId = "";
+        message.profile = "";
+        if (value !== undefined)
+            reflectionMergePartial<ReportProgressEvent>(this, message, value);
+        return message;
+    }
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: ReportProgressEvent): ReportProgressEvent {
+        let message = target ?? this.create(), end = reader.pos + length;
+        while (reader.pos < end) {
+            let [fieldNo, wireType] = reader.tag();
+            switch (fieldNo) {
+                case /* string plugin */ 1:
+                    message.plugin = reader.string();
+                    break;
+                case /* string status */ 2:
+                    message.status = reader.string();
+                    break;
+                case /* string result_id */ 3:
+                    message.resultId = reader.string();
+                    break;
+                case /* repeated string errors */ 4:
+                    message.errors.push(reader.string());
+                    break;
+                case /* bool timed_out */ 5:
+                    message.timedOut = reader.bool();
+                    break;
+                case /* int32 score */ 6:
+                    message.score = reader.int32();
+                    break;
+                case /* string risk_tier */ 7:
+                    message.riskTier = reader.string();
+                    break;
+                case /* bool done */ 8:
+                    message.done = reader.bool();
+                    break;
+                case /* string report_id */ 9:
+                    message.reportId = reader.string();
+                    break;
+                case /* string dns_scan_id */ 10:
+                    message.dnsScanId = reader.string();
+                    break;
+                case /* service.DNSSecurityResult dns_result */ 11:
+                    message.dnsResult = DNSSecurityResult.internalBinaryRead(reader, reader.uint32(), options, message.dnsResult);
+                    break;
+                case /* service.TLSSecurityResult tls_result */ 12:
+                    message.tlsResult = TLSSecurityResult.internalBinaryRead(reader, reader.uint32(), options, message.tlsResult);
+                    break;
+                case /* service.CrtShSecurityResult crtsh_result */ 13:
+                    message.crtshResult = CrtShSecurityResult.internalBinaryRead(reader, reader.uint32(), options, message.crtshResult);
+                    break;
+                case /* service.ChaosSecurityResult chaos_result */ 14:
+                    message.chaosResult = ChaosSecurityResult.internalBinaryRead(reader, reader.uint32(), options, message.chaosResult);
+                    break;
+                case /* service.ShodanSecurityResult shodan_result */ 15:
+                    message.shodanResult = ShodanSecurityResult.internalBinaryRead(reader, reader.uint32(), options, message.shodanResult);
+                    break;
+                case /* service.OTXSecurityResult otx_result */ 16:
+                    message.otxResult = OTXSecurityResult.internalBinaryRead(reader, reader.uint32(), options, message.otxResult);
+                    break;
+                case /* service.WhoisSecurityResult whois_result */ 17:
+                    message.whoisResult = WhoisSecurityResult.internalBinaryRead(reader, reader.uint32(), options, message.whoisResult);
+                    break;
+                case /* service.AbuseChSecurityResult abusech_result */ 18:
+                    message.abusechResult = AbuseChSecurityResult.internalBinaryRead(reader, reader.uint32(), options, message.abusechResult);
+                    break;
+                case /* service.ISCSecurityResult isc_result */ 19:
+                    message.iscResult = ISCSecurityResult.internalBinaryRead(reader, reader.uint32(), options, message.iscResult);
+                    break;
+                case /* string scan_run_id */ 20:
+                    message.scanRunId = reader.string();
+                    break;
+                case /* string profile */ 21:
+                    message.profile = reader.string();
+                    break;
+                case /* service.ExternalSecurityResult external_result */ 22:
+                    message.externalResult = ExternalSecurityResult.internalBinaryRead(reader, reader.uint32(), options, message.externalResult);
+                    break;
+                default:
+                    let u = options.readUnknownField;
+                    if (u === "throw")
+                        throw new globalThis.Error(`Unknown field ${fieldNo} (wire type ${wireType}) for ${this.typeName}`);
+                    let d = reader.skip(wireType);
+                    if (u !== false)
+                        (u === true ? UnknownFieldHandler.onRead : u)(this.typeName, message, fieldNo, wireType, d);
+            }
+        }
+        return message;
+    }
+    internalBinaryWrite(message: ReportProgressEvent, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* string plugin = 1; */
+        if (message.plugin !== "")
+            writer.tag(1, WireType.LengthDelimited).string(message.plugin);
+        /* string status = 2; */
+        if (message.status !== "")
+            writer.tag(2, WireType.LengthDelimited).string(message.status);
+        /* string result_id = 3; */
+        if (message.resultId !== "")
+            writer.tag(3, WireType.LengthDelimited).string(message.resultId);
+        /* repeated string errors = 4; */
+        for (let i = 0; i < message.errors.length; i++)
+            writer.tag(4, WireType.LengthDelimited).string(message.errors[i]);
+        /* bool timed_out = 5; */
+        if (message.timedOut !== false)
+            writer.tag(5, WireType.Varint).bool(message.timedOut);
+        /* int32 score = 6; */
+        if (message.score !== 0)
+            writer.tag(6, WireType.Varint).int32(message.score);
+        /* string risk_tier = 7; */
+        if (message.riskTier !== "")
+            writer.tag(7, WireType.LengthDelimited).string(message.riskTier);
+        /* bool done = 8; */
+        if (message.done !== false)
+            writer.tag(8, WireType.Varint).bool(message.done);
+        /* string report_id = 9; */
+        if (message.reportId !== "")
+            writer.tag(9, WireType.LengthDelimited).string(message.reportId);
+        /* string dns_scan_id = 10; */
+        if (message.dnsScanId !== "")
+            writer.tag(10, WireType.LengthDelimited).string(message.dnsScanId);
+        /* service.DNSSecurityResult dns_result = 11; */
+        if (message.dnsResult)
+            DNSSecurityResult.internalBinaryWrite(message.dnsResult, writer.tag(11, WireType.LengthDelimited).fork(), options).join();
+        /* service.TLSSecurityResult tls_result = 12; */
+        if (message.tlsResult)
+            TLSSecurityResult.internalBinaryWrite(message.tlsResult, writer.tag(12, WireType.LengthDelimited).fork(), options).join();
+        /* service.CrtShSecurityResult crtsh_result = 13; */
+        if (message.crtshResult)
+            CrtShSecurityResult.internalBinaryWrite(message.crtshResult, writer.tag(13, WireType.LengthDelimited).fork(), options).join();
+        /* service.ChaosSecurityResult chaos_result = 14; */
+        if (message.chaosResult)
+            ChaosSecurityResult.internalBinaryWrite(message.chaosResult, writer.tag(14, WireType.LengthDelimited).fork(), options).join();
+        /* service.ShodanSecurityResult shodan_result = 15; */
+        if (message.shodanResult)
+            ShodanSecurityResult.internalBinaryWrite(message.shodanResult, writer.tag(15, WireType.LengthDelimited).fork(), options).join();
+        /* service.OTXSecurityResult otx_result = 16; */
+        if (message.otxResult)
+            OTXSecurityResult.internalBinaryWrite(message.otxResult, writer.tag(16, WireType.LengthDelimited).fork(), options).join();
+        /* service.WhoisSecurityResult whois_result = 17; */
+        if (message.whoisResult)
+            WhoisSecurityResult.internalBinaryWrite(message.whoisResult, writer.tag(17, WireType.LengthDelimited).fork(), options).join();
+        /* service.AbuseChSecurityResult abusech_result = 18; */
+        if (message.abusechResult)
+            AbuseChSecurityResult.internalBinaryWrite(message.abusechResult, writer.tag(18, WireType.LengthDelimited).fork(), options).join();
+        /* service.ISCSecurityResult isc_result = 19; */
+        if (message.iscResult)
+            ISCSecurityResult.internalBinaryWrite(message.iscResult, writer.tag(19, WireType.LengthDelimited).fork(), options).join();
+        /* string scan_run_id = 20; */
+        if (message.scanRunId !== "")
+            writer.tag(20, WireType.LengthDelimited).string(message.scanRunId);
+        /* string profile = 21; */
+        if (message.profile !== "")
+            writer.tag(21, WireType.LengthDelimited).string(message.profile);
+        /* service.ExternalSecurityResult external_result = 22; */
+        if (message.externalResult)
+            ExternalSecurityResult.internalBinaryWrite(message.externalResult, writer.tag(22, WireType.LengthDelimited).fork(), options).join();
+        let u = options.writeUnknownFields;
+        if (u !== false)
+            (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
+        return writer;
+    }
+}
+/**
+ * @generated MessageType for protobuf message service.ReportProgressEvent
+ */
+export const ReportProgressEvent = new ReportProgressEvent$Type();
+// @generated message type with reflection information, may provide speed optimized methods
+class ListReportsRequest$Type extends MessageType<ListReportsRequest> {
+    constructor() {
+        super("service.ListReportsRequest", [
+            { no: 1, name: "domain", kind: "scalar", T: 9 /*ScalarType.STRING*/ }
+        ]);
+    }
+    create(value?: PartialMessage<ListReportsRequest>): ListReportsRequest {
+        const message = globalThis.Object.create((this.messagePrototype!));
+        message.domain = "";
+        if (value !== undefined)
+            reflectionMergePartial<ListReportsRequest>(this, message, value);
+        return message;
+    }
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: ListReportsRequest): ListReportsRequest {
+        let message = target ?? this.create(), end = reader.pos + length;
+        while (reader.pos < end) {
+            let [fieldNo, wireType] = reader.tag();
+            switch (fieldNo) {
+                case /* string domain */ 1:
+                    message.domain = reader.string();
+                    break;
+                default:
+                    let u = options.readUnknownField;
+                    if (u === "throw")
+                        throw new globalThis.Error(`Unknown field ${fieldNo} (wire type ${wireType}) for ${this.typeName}`);
+                    let d = reader.skip(wireType);
+                    if (u !== false)
+                        (u === true ? UnknownFieldHandler.onRead : u)(this.typeName, message, fieldNo, wireType, d);
+            }
+        }
+        return message;
+    }
+    internalBinaryWrite(message: ListReportsRequest, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* string domain = 1; */
+        if (message.domain !== "")
+            writer.tag(1, WireType.LengthDelimited).string(message.domain);
+        let u = options.writeUnknownFields;
+        if (u !== false)
+            (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
+        return writer;
+    }
+}
+/**
+ * @generated MessageType for protobuf message service.ListReportsRequest
+ */
+export const ListReportsRequest = new ListReportsRequest$Type();
+// @generated message type with reflection information, may provide speed optimized methods
+class Report$Type extends MessageType<Report> {
+    constructor() {
+        super("service.Report", [
+            { no: 1, name: "report_id", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 2, name: "domain", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 3, name: "dns_scan_id", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 4, name: "score", kind: "scalar", T: 5 /*ScalarType.INT32*/ },
+            { no: 5, name: "risk_tier", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 6, name: "created_at", kind: "message", T: () => Timestamp },
+            { no: 7, name: "scan_run_id", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 8, name: "profile", kind: "scalar", T: 9 /*ScalarType.STRING*/ }
+        ]);
+    }
+    create(value?: PartialMessage<Report>): Report {
+        const message = globalThis.Object.create((this.messagePrototype!));
+        message.reportId = "";
+        message.domain = "";
+        message.dnsScanId = "";
+        message.score = 0;
+        message.riskTier = "";
+        message.scanRunId = "";
+        message.profile = "";
+        if (value !== undefined)
+            reflectionMergePartial<Report>(this, message, value);
+        return message;
+    }
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: Report): Report {
+        let message = target ?? this.create(), end = reader.pos + length;
+        while (reader.pos < end) {
+            let [fieldNo, wireType] = reader.tag();
+            switch (fieldNo) {
+                case /* string report_id */ 1:
+                    message.reportId = reader.string();
+                    break;
+                case /* string domain */ 2:
+                    message.domain = reader.string();
+                    break;
+                case /* string dns_scan_id */ 3:
+                    message.dnsScanId = reader.string();
+                    break;
+                case /* int32 score */ 4:
+                    message.score = reader.int32();
+                    break;
+                case /* string risk_tier */ 5:
+                    message.riskTier = reader.string();
+                    break;
+                case /* google.protobuf.Timestamp created_at */ 6:
+                    message.createdAt = Timestamp.internalBinaryRead(reader, reader.uint32(), options, message.createdAt);
+                    break;
+                case /* string scan_run_id */ 7:
+                    message.scanRunId = reader.string();
+                    break;
+                case /* string profile */ 8:
+                    message.profile = reader.string();
+                    break;
+                default:
+                    let u = options.readUnknownField;
+                    if (u === "throw")
+                        throw new globalThis.Error(`Unknown field ${fieldNo} (wire type ${wireType}) for ${this.typeName}`);
+                    let d = reader.skip(wireType);
+                    if (u !== false)
+                        (u === true ? UnknownFieldHandler.onRead : u)(this.typeName, message, fieldNo, wireType, d);
+            }
+        }
+        return message;
+    }
+    internalBinaryWrite(message: Report, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* string report_id = 1; */
+        if (message.reportId !== "")
+            writer.tag(1, WireType.LengthDelimited).string(message.reportId);
+        /* string domain = 2; */
+        if (message.domain !== "")
+            writer.tag(2, WireType.LengthDelimited).string(message.domain);
+        /* string dns_scan_id = 3; */
+        if (message.dnsScanId !== "")
+            writer.tag(3, WireType.LengthDelimited).string(message.dnsScanId);
+        /* int32 score = 4; */
+        if (message.score !== 0)
+            writer.tag(4, WireType.Varint).int32(message.score);
+        /* string risk_tier = 5; */
+        if (message.riskTier !== "")
+            writer.tag(5, WireType.LengthDelimited).string(message.riskTier);
+        /* google.protobuf.Timestamp created_at = 6; */
+        if (message.createdAt)
+            Timestamp.internalBinaryWrite(message.createdAt, writer.tag(6, WireType.LengthDelimited).fork(), options).join();
+        /* string scan_run_id = 7; */
+        if (message.scanRunId !== "")
+            writer.tag(7, WireType.LengthDelimited).string(message.scanRunId);
+        /* string profile = 8; */
+        if (message.profile !== "")
+            writer.tag(8, WireType.LengthDelimited).string(message.profile);
+        let u = options.writeUnknownFields;
+        if (u !== false)
+            (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
+        return writer;
+    }
+}
+/**
+ * @generated MessageType for protobuf message service.Report
+ */
+export const Report = new Report$Type();
+// @generated message type with reflection information, may provide speed optimized methods
+class ListReportsResponse$Type extends MessageType<ListReportsResponse> {
+    constructor() {
+        super("service.ListReportsResponse", [
+            { no: 1, name: "reports", kind: "message", repeat: 2 /*RepeatType.UNPACKED*/, T: () => Report }
+        ]);
+    }
+    create(value?: PartialMessage<ListReportsResponse>): ListReportsResponse {
+        const message = globalThis.Object.create((this.messagePrototype!));
+        message.reports = [];
+        if (value !== undefined)
+            reflectionMergePartial<ListReportsResponse>(this, message, value);
+        return message;
+    }
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: ListReportsResponse): ListReportsResponse {
+        let message = target ?? this.create(), end = reader.pos + length;
+        while (reader.pos < end) {
+            let [fieldNo, wireType] = reader.tag();
+            switch (fieldNo) {
+                case /* repeated service.Report reports */ 1:
+                    message.reports.push(Report.internalBinaryRead(reader, reader.uint32(), options));
+                    break;
+                default:
+                    let u = options.readUnknownField;
+                    if (u === "throw")
+                        throw new globalThis.Error(`Unknown field ${fieldNo} (wire type ${wireType}) for ${this.typeName}`);
+                    let d = reader.skip(wireType);
+                    if (u !== false)
+                        (u === true ? UnknownFieldHandler.onRead : u)(this.typeName, message, fieldNo, wireType, d);
+            }
+        }
+        return message;
+    }
+    internalBinaryWrite(message: ListReportsResponse, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* repeated service.Report reports = 1; */
+        for (let i = 0; i < message.reports.length; i++)
+            Report.internalBinaryWrite(message.reports[i], writer.tag(1, WireType.LengthDelimited).fork(), options).join();
+        let u = options.writeUnknownFields;
+        if (u !== false)
+            (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
+        return writer;
+    }
+}
+/**
+ * @generated MessageType for protobuf message service.ListReportsResponse
+ */
+export const ListReportsResponse = new ListReportsResponse$Type();
+// @generated message type with reflection information, may provide speed optimized methods
+class GetReportByIdRequest$Type extends MessageType<GetReportByIdRequest> {
+    constructor() {
+        super("service.GetReportByIdRequest", [
+            { no: 1, name: "report_id", kind: "scalar", T: 9 /*ScalarType.STRING*/ }
+        ]);
+    }
+    create(value?: PartialMessage<GetReportByIdRequest>): GetReportByIdRequest {
+        const message = globalThis.Object.create((this.messagePrototype!));
+        message.reportId = "";
+        if (value !== undefined)
+            reflectionMergePartial<GetReportByIdRequest>(this, message, value);
+        return message;
+    }
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: GetReportByIdRequest): GetReportByIdRequest {
+        let message = target ?? this.create(), end = reader.pos + length;
+        while (reader.pos < end) {
+            let [fieldNo, wireType] = reader.tag();
+            switch (fieldNo) {
+                case /* string report_id */ 1:
+                    message.reportId = reader.string();
+                    break;
+                default:
+                    let u = options.readUnknownField;
+                    if (u === "throw")
+                        throw new globalThis.Error(`Unknown field ${fieldNo} (wire type ${wireType}) for ${this.typeName}`);
+                    let d = reader.skip(wireType);
+                    if (u !== false)
+                        (u === true ? UnknownFieldHandler.onRead : u)(this.typeName, message, fieldNo, wireType, d);
+            }
+        }
+        return message;
+    }
+    internalBinaryWrite(message: GetReportByIdRequest, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* string report_id = 1; */
+        if (message.reportId !== "")
+            writer.tag(1, WireType.LengthDelimited).string(message.reportId);
+        let u = options.writeUnknownFields;
+        if (u !== false)
+            (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
+        return writer;
+    }
+}
+/**
+ * @generated MessageType for protobuf message service.GetReportByIdRequest
+ */
+export const GetReportByIdRequest = new GetReportByIdRequest$Type();
+// @generated message type with reflection information, may provide speed optimized methods
+class GetReportByIdResponse$Type extends MessageType<GetReportByIdResponse> {
+    constructor() {
+        super("service.GetReportByIdResponse", [
+            { no: 1, name: "report", kind: "message", T: () => Report }
+        ]);
+    }
+    create(value?: PartialMessage<GetReportByIdResponse>): GetReportByIdResponse {
+        const message = globalThis.Object.create((this.messagePrototype!));
+        if (value !== undefined)
+            reflectionMergePartial<GetReportByIdResponse>(this, message, value);
+        return message;
+    }
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: GetReportByIdResponse): GetReportByIdResponse {
+        let message = target ?? this.create(), end = reader.pos + length;
+        while (reader.pos < end) {
+            let [fieldNo, wireType] = reader.tag();
+            switch (fieldNo) {
+                case /* service.Report report */ 1:
+                    message.report = Report.internalBinaryRead(reader, reader.uint32(), options, message.report);
+                    break;
+                default:
+                    let u = options.readUnknownField;
+                    if (u === "throw")
+                        throw new globalThis.Error(`Unknown field ${fieldNo} (wire type ${wireType}) for ${this.typeName}`);
+                    let d = reader.skip(wireType);
+                    if (u !== false)
+                        (u === true ? UnknownFieldHandler.onRead : u)(this.typeName, message, fieldNo, wireType, d);
+            }
+        }
+        return message;
+    }
+    internalBinaryWrite(message: GetReportByIdResponse, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* service.Report report = 1; */
+        if (message.report)
+            Report.internalBinaryWrite(message.report, writer.tag(1, WireType.LengthDelimited).fork(), options).join();
+        let u = options.writeUnknownFields;
+        if (u !== false)
+            (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
+        return writer;
+    }
+}
+/**
+ * @generated MessageType for protobuf message service.GetReportByIdResponse
+ */
+export const GetReportByIdResponse = new GetReportByIdResponse$Type();
+// @generated message type with reflection information, may provide speed optimized methods
+class CalculateRiskScoreRequest$Type extends MessageType<CalculateRiskScoreRequest> {
+    constructor() {
+        super("service.CalculateRiskScoreRequest", [
+            { no: 1, name: "domain", kind: "scalar", T: 9 /*ScalarType.STRING*/ }
+        ]);
+    }
+    create(value?: PartialMessage<CalculateRiskScoreRequest>): CalculateRiskScoreRequest {
+        const message = globalThis.Object.create((this.messagePrototype!));
+        message.domain = "";
+        if (value !== undefined)
+            reflectionMergePartial<CalculateRiskScoreRequest>(this, message, value);
+        return message;
+    }
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: CalculateRiskScoreRequest): CalculateRiskScoreRequest {
+        let message = target ?? this.create(), end = reader.pos + length;
+        while (reader.pos < end) {
+            let [fieldNo, wireType] = reader.tag();
+            switch (fieldNo) {
+                case /* string domain */ 1:
+                    message.domain = reader.string();
+                    break;
+                default:
+                    let u = options.readUnknownField;
+                    if (u === "throw")
+                        throw new globalThis.Error(`Unknown field ${fieldNo} (wire type ${wireType}) for ${this.typeName}`);
+                    let d = reader.skip(wireType);
+                    if (u !== false)
+                        (u === true ? UnknownFieldHandler.onRead : u)(this.typeName, message, fieldNo, wireType, d);
+            }
+        }
+        return message;
+    }
+    internalBinaryWrite(message: CalculateRiskScoreRequest, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* string domain = 1; */
+        if (message.domain !== "")
+            writer.tag(1, WireType.LengthDelimited).string(message.domain);
+        let u = options.writeUnknownFields;
+        if (u !== false)
+            (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
+        return writer;
+    }
+}
+/**
+ * @generated MessageType for protobuf message service.CalculateRiskScoreRequest
+ */
+export const CalculateRiskScoreRequest = new CalculateRiskScoreRequest$Type();
+// @generated message type with reflection information, may provide speed optimized methods
+class CalculateRiskScoreResponse$Type extends MessageType<CalculateRiskScoreResponse> {
+    constructor() {
+        super("service.CalculateRiskScoreResponse", [
+            { no: 1, name: "score", kind: "scalar", T: 5 /*ScalarType.INT32*/ },
+            { no: 2, name: "risk_tier", kind: "scalar", T: 9 /*ScalarType.STRING*/ }
+        ]);
+    }
+    create(value?: PartialMessage<CalculateRiskScoreResponse>): CalculateRiskScoreResponse {
+        const message = globalThis.Object.create((this.messagePrototype!));
+        message.score = 0;
+        message.riskTier = "";
+        if (value !== undefined)
+            reflectionMergePartial<CalculateRiskScoreResponse>(this, message, value);
+        return message;
+    }
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: CalculateRiskScoreResponse): CalculateRiskScoreResponse {
+        let message = target ?? this.create(), end = reader.pos + length;
+        while (reader.pos < end) {
+            let [fieldNo, wireType] = reader.tag();
+            switch (fieldNo) {
+                case /* int32 score */ 1:
+                    message.score = reader.int32();
+                    break;
+                case /* string risk_tier */ 2:
+                    message.riskTier = reader.string();
+                    break;
+                default:
+                    let u = options.readUnknownField;
+                    if (u === "throw")
+                        throw new globalThis.Error(`Unknown field ${fieldNo} (wire type ${wireType}) for ${this.typeName}`);
+                    let d = reader.skip(wireType);
+                    if (u !== false)
+                        (u === true ? UnknownFieldHandler.onRead : u)(this.typeName, message, fieldNo, wireType, d);
+            }
+        }
+        return message;
+    }
+    internalBinaryWrite(message: CalculateRiskScoreResponse, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* int32 score = 1; */
+        if (message.score !== 0)
+            writer.tag(1, WireType.Varint).int32(message.score);
+        /* string risk_tier = 2; */
+        if (message.riskTier !== "")
+            writer.tag(2, WireType.LengthDelimited).string(message.riskTier);
+        let u = options.writeUnknownFields;
+        if (u !== false)
+            (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
+        return writer;
+    }
+}
+/**
+ * @generated MessageType for protobuf message service.CalculateRiskScoreResponse
+ */
+export const CalculateRiskScoreResponse = new CalculateRiskScoreResponse$Type();
+// @generated message type with reflection information, may provide speed optimized methods
+class CreateUserRequest$Type extends MessageType<CreateUserRequest> {
+    constructor() {
+        super("service.CreateUserRequest", [
+            { no: 1, name: "first_name", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 2, name: "last_name", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 3, name: "email", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 4, name: "password", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 5, name: "is_admin", kind: "scalar", T: 8 /*ScalarType.BOOL*/ }
+        ]);
+    }
+    create(value?: PartialMessage<CreateUserRequest>): CreateUserRequest {
+        const message = globalThis.Object.create((this.messagePrototype!));
+        message.firstName = "";
+        message.lastName = "";
+        message.email = "";
+        message.password = "";
+        message.isAdmin = false;
+        if (value !== undefined)
+            reflectionMergePartial<CreateUserRequest>(this, message, value);
+        return message;
+    }
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: CreateUserRequest): CreateUserRequest {
+        let message = target ?? this.create(), end = reader.pos + length;
+        while (reader.pos < end) {
+            let [fieldNo, wireType] = reader.tag();
+            switch (fieldNo) {
+                case /* string first_name */ 1:
+                    message.firstName = reader.string();
+                    break;
+                case /* string last_name */ 2:
+                    message.lastName = reader.string();
+                    break;
+                case /* string email */ 3:
+                    message.email = reader.string();
+                    break;
+                case /* string password */ 4:
+                    message.password = reader.string();
+                    break;
+                case /* bool is_admin */ 5:
+                    message.isAdmin = reader.bool();
+                    break;
+                default:
+                    let u = options.readUnknownField;
+                    if (u === "throw")
+                        throw new globalThis.Error(`Unknown field ${fieldNo} (wire type ${wireType}) for ${this.typeName}`);
+                    let d = reader.skip(wireType);
+                    if (u !== false)
+                        (u === true ? UnknownFieldHandler.onRead : u)(this.typeName, message, fieldNo, wireType, d);
+            }
+        }
+        return message;
+    }
+    internalBinaryWrite(message: CreateUserRequest, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* string first_name = 1; */
+        if (message.firstName !== "")
+            writer.tag(1, WireType.LengthDelimited).string(message.firstName);
+        /* string last_name = 2; */
+        if (message.lastName !== "")
+            writer.tag(2, WireType.LengthDelimited).string(message.lastName);
+        /* string email = 3; */
+        if (message.email !== "")
+            writer.tag(3, WireType.LengthDelimited).string(message.email);
+        /* string password = 4; */
+        if (message.password !== "")
+            writer.tag(4, WireType.LengthDelimited).string(message.password);
+        /* bool is_admin = 5; */
+        if (message.isAdmin !== false)
+            writer.tag(5, WireType.Varint).bool(message.isAdmin);
+        let u = options.writeUnknownFields;
+        if (u !== false)
+            (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
+        return writer;
+    }
+}
+/**
+ * @generated MessageType for protobuf message service.CreateUserRequest
+ */
+export const CreateUserRequest = new CreateUserRequest$Type();
+// @generated message type with reflection information, may provide speed optimized methods
+class CreateUserResponse$Type extends MessageType<CreateUserResponse> {
+    constructor() {
+        super("service.CreateUserResponse", [
+            { no: 1, name: "user_id", kind: "scalar", T: 9 /*ScalarType.STRING*/ }
+        ]);
+    }
+    create(value?: PartialMessage<CreateUserResponse>): CreateUserResponse {
+        const message = globalThis.Object.create((this.messagePrototype!));
+        message.userId = "";
+        if (value !== undefined)
+            reflectionMergePartial<CreateUserResponse>(this, message, value);
+        return message;
+    }
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: CreateUserResponse): CreateUserResponse {
+        let message = target ?? this.create(), end = reader.pos + length;
+        while (reader.pos < end) {
+            let [fieldNo, wireType] = reader.tag();
+            switch (fieldNo) {
+                case /* string user_id */ 1:
+                    message.userId = reader.string();
+                    break;
+                default:
+                    let u = options.readUnknownField;
+                    if (u === "throw")
+                        throw new globalThis.Error(`Unknown field ${fieldNo} (wire type ${wireType}) for ${this.typeName}`);
+                    let d = reader.skip(wireType);
+                    if (u !== false)
+                        (u === true ? UnknownFieldHandler.onRead : u)(this.typeName, message, fieldNo, wireType, d);
+            }
+        }
+        return message;
+    }
+    internalBinaryWrite(message: CreateUserResponse, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* string user_id = 1; */
+        if (message.userId !== "")
+            writer.tag(1, WireType.LengthDelimited).string(message.userId);
+        let u = options.writeUnknownFields;
+        if (u !== false)
+            (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
+        return writer;
+    }
+}
+/**
+ * @generated MessageType for protobuf message service.CreateUserResponse
+ */
+export const CreateUserResponse = new CreateUserResponse$Type();
+// @generated message type with reflection information, may provide speed optimized methods
+class GetUserRequest$Type extends MessageType<GetUserRequest> {
+    constructor() {
+        super("service.GetUserRequest", [
+            { no: 1, name: "user_id", kind: "scalar", T: 9 /*ScalarType.STRING*/ }
+        ]);
+    }
+    create(value?: PartialMessage<GetUserRequest>): GetUserRequest {
+        const message = globalThis.Object.create((this.messagePrototype!));
+        message.userId = "";
+        if (value !== undefined)
+            reflectionMergePartial<GetUserRequest>(this, message, value);
+        return message;
+    }
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: GetUserRequest): GetUserRequest {
+        let message = target ?? this.create(), end = reader.pos + length;
+        while (reader.pos < end) {
+            let [fieldNo, wireType] = reader.tag();
+            switch (fieldNo) {
+                case /* string user_id */ 1:
+                    message.userId = reader.string();
+                    break;
+                default:
+                    let u = options.readUnknownField;
+                    if (u === "throw")
+                        throw new globalThis.Error(`Unknown field ${fieldNo} (wire type ${wireType}) for ${this.typeName}`);
+                    let d = reader.skip(wireType);
+                    if (u !== false)
+                        (u === true ? UnknownFieldHandler.onRead : u)(this.typeName, message, fieldNo, wireType, d);
+            }
+        }
+        return message;
+    }
+    internalBinaryWrite(message: GetUserRequest, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* string user_id = 1; */
+        if (message.userId !== "")
+            writer.tag(1, WireType.LengthDelimited).string(message.userId);
+        let u = options.writeUnknownFields;
+        if (u !== false)
+            (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
+        return writer;
+    }
+}
+/**
+ * @generated MessageType for protobuf message service.GetUserRequest
+ */
+export const GetUserRequest = new GetUserRequest$Type();
+// @generated message type with reflection information, may provide speed optimized methods
+class GetUserResponse$Type extends MessageType<GetUserResponse> {
+    constructor() {
+        super("service.GetUserResponse", [
+            { no: 1, name: "user_id", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 2, name: "first_name", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 3, name: "last_name", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 4, name: "email", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 5, name: "is_admin", kind: "scalar", T: 8 /*ScalarType.BOOL*/ },
+            { no: 6, name: "created_at", kind: "message", T: () => Timestamp }
+        ]);
+    }
+    create(value?: PartialMessage<GetUserResponse>): GetUserResponse {
+        const message = globalThis.Object.create((this.messagePrototype!));
+        message.userId = "";
+        message.firstName = "";
+        message.lastName = "";
+        message.email = "";
+        message.isAdmin = false;
+        if (value !== undefined)
+            reflectionMergePartial<GetUserResponse>(this, message, value);
+        return message;
+    }
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: GetUserResponse): GetUserResponse {
+        let message = target ?? this.create(), end = reader.pos + length;
+        while (reader.pos < end) {
+            let [fieldNo, wireType] = reader.tag();
+            switch (fieldNo) {
+                case /* string user_id */ 1:
+                    message.userId = reader.string();
+                    break;
+                case /* string first_name */ 2:
+                    message.firstName = reader.string();
+                    break;
+                case /* string last_name */ 3:
+                    message.lastName = reader.string();
+                    break;
+                case /* string email */ 4:
+                    message.email = reader.string();
+                    break;
+                case /* bool is_admin */ 5:
+                    message.isAdmin = reader.bool();
+                    break;
+                case /* google.protobuf.Timestamp created_at */ 6:
+                    message.createdAt = Timestamp.internalBinaryRead(reader, reader.uint32(), options, message.createdAt);
+                    break;
+                default:
+                    let u = options.readUnknownField;
+                    if (u === "throw")
+                        throw new globalThis.Error(`Unknown field ${fieldNo} (wire type ${wireType}) for ${this.typeName}`);
+                    let d = reader.skip(wireType);
+                    if (u !== false)
+                        (u === true ? UnknownFieldHandler.onRead : u)(this.typeName, message, fieldNo, wireType, d);
+            }
+        }
+        return message;
+    }
+    internalBinaryWrite(message: GetUserResponse, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* string user_id = 1; */
+        if (message.userId !== "")
+            writer.tag(1, WireType.LengthDelimited).string(message.userId);
+        /* string first_name = 2; */
+        if (message.firstName !== "")
+            writer.tag(2, WireType.LengthDelimited).string(message.firstName);
+        /* string last_name = 3; */
+        if (message.lastName !== "")
+            writer.tag(3, WireType.LengthDelimited).string(message.lastName);
+        /* string email = 4; */
+        if (message.email !== "")
+            writer.tag(4, WireType.LengthDelimited).string(message.email);
+        /* bool is_admin = 5; */
+        if (message.isAdmin !== false)
+            writer.tag(5, WireType.Varint).bool(message.isAdmin);
+        /* google.protobuf.Timestamp created_at = 6; */
+        if (message.createdAt)
+            Timestamp.internalBinaryWrite(message.createdAt, writer.tag(6, WireType.LengthDelimited).fork(), options).join();
+        let u = options.writeUnknownFields;
+        if (u !== false)
+            (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
+        return writer;
+    }
+}
+/**
+ * @generated MessageType for protobuf message service.GetUserResponse
+ */
+export const GetUserResponse = new GetUserResponse$Type();
+// @generated message type with reflection information, may provide speed optimized methods
+class UpdateUserRequest$Type extends MessageType<UpdateUserRequest> {
+    constructor() {
+        super("service.UpdateUserRequest", [
+            { no: 1, name: "user_id", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 2, name: "first_name", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 3, name: "last_name", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 4, name: "email", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 5, name: "password", kind: "scalar", T: 9 /*ScalarType.STRING*/ }
+        ]);
+    }
+    create(value?: PartialMessage<UpdateUserRequest>): UpdateUserRequest {
+        const message = globalThis.Object.create((this.messagePrototype!));
+        message.userId = "";
+        message.firstName = "";
+        message.lastName = "";
+        message.email = "";
+        message.password = "";
+        if (value !== undefined)
+            reflectionMergePartial<UpdateUserRequest>(this, message, value);
+        return message;
+    }
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: UpdateUserRequest): UpdateUserRequest {
+        let message = target ?? this.create(), end = reader.pos + length;
+        while (reader.pos < end) {
+            let [fieldNo, wireType] = reader.tag();
+            switch (fieldNo) {
+                case /* string user_id */ 1:
+                    message.userId = reader.string();
+                    break;
+                case /* string first_name */ 2:
+                    message.firstName = reader.string();
+                    break;
+                case /* string last_name */ 3:
+                    message.lastName = reader.string();
+                    break;
+                case /* string email */ 4:
+                    message.email = reader.string();
+                    break;
+                case /* string password */ 5:
+                    message.password = reader.string();
+                    break;
+                default:
+                    let u = options.readUnknownField;
+                    if (u === "throw")
+                        throw new globalThis.Error(`Unknown field ${fieldNo} (wire type ${wireType}) for ${this.typeName}`);
+                    let d = reader.skip(wireType);
+                    if (u !== false)
+                        (u === true ? UnknownFieldHandler.onRead : u)(this.typeName, message, fieldNo, wireType, d);
+            }
+        }
+        return message;
+    }
+    internalBinaryWrite(message: UpdateUserRequest, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* string user_id = 1; */
+        if (message.userId !== "")
+            writer.tag(1, WireType.LengthDelimited).string(message.userId);
+        /* string first_name = 2; */
+        if (message.firstName !== "")
+            writer.tag(2, WireType.LengthDelimited).string(message.firstName);
+        /* string last_name = 3; */
+        if (message.lastName !== "")
+            writer.tag(3, WireType.LengthDelimited).string(message.lastName);
+        /* string email = 4; */
+        if (message.email !== "")
+            writer.tag(4, WireType.LengthDelimited).string(message.email);
+        /* string password = 5; */
+        if (message.password !== "")
+            writer.tag(5, WireType.LengthDelimited).string(message.password);
+        let u = options.writeUnknownFields;
+        if (u !== false)
+            (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
+        return writer;
+    }
+}
+/**
+ * @generated MessageType for protobuf message service.UpdateUserRequest
+ */
+export const UpdateUserRequest = new UpdateUserRequest$Type();
+// @generated message type with reflection information, may provide speed optimized methods
+class UpdateUserResponse$Type extends MessageType<UpdateUserResponse> {
+    constructor() {
+        super("service.UpdateUserResponse", []);
+    }
+    create(value?: PartialMessage<UpdateUserResponse>): UpdateUserResponse {
+        const message = globalThis.Object.create((this.messagePrototype!));
+        if (value !== undefined)
+            reflectionMergePartial<UpdateUserResponse>(this, message, value);
+        return message;
+    }
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: UpdateUserResponse): UpdateUserResponse {
+        let message = target ?? this.create(), end = reader.pos + length;
+        while (reader.pos < end) {
+            let [fieldNo, wireType] = reader.tag();
+            switch (fieldNo) {
+                default:
+                    let u = options.readUnknownField;
+                    if (u === "throw")
+                        throw new globalThis.Error(`Unknown field ${fieldNo} (wire type ${wireType}) for ${this.typeName}`);
+                    let d = reader.skip(wireType);
+                    if (u !== false)
+                        (u === true ? UnknownFieldHandler.onRead : u)(this.typeName, message, fieldNo, wireType, d);
+            }
+        }
+        return message;
+    }
+    internalBinaryWrite(message: UpdateUserResponse, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        let u = options.writeUnknownFields;
+        if (u !== false)
+            (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
+        return writer;
+    }
+}
+/**
+ * @generated MessageType for protobuf message service.UpdateUserResponse
+ */
+export const UpdateUserResponse = new UpdateUserResponse$Type();
+// @generated message type with reflection information, may provide speed optimized methods
+class DeleteUserRequest$Type extends MessageType<DeleteUserRequest> {
+    constructor() {
+        super("service.DeleteUserRequest", [
+            { no: 1, name: "user_id", kind: "scalar", T: 9 /*ScalarType.STRING*/ }
+        ]);
+    }
+    create(value?: PartialMessage<DeleteUserRequest>): DeleteUserRequest {
+        const message = globalThis.Object.create((this.messagePrototype!));
+        message.userId = "";
+        if (value !== undefined)
+            reflectionMergePartial<DeleteUserRequest>(this, message, value);
+        return message;
+    }
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: DeleteUserRequest): DeleteUserRequest {
+        let message = target ?? this.create(), end = reader.pos + length;
+        while (reader.pos < end) {
+            let [fieldNo, wireType] = reader.tag();
+            switch (fieldNo) {
+                case /* string user_id */ 1:
+                    message.userId = reader.string();
+                    break;
+                default:
+                    let u = options.readUnknownField;
+                    if (u === "throw")
+                        throw new globalThis.Error(`Unknown field ${fieldNo} (wire type ${wireType}) for ${this.typeName}`);
+                    let d = reader.skip(wireType);
+                    if (u !== false)
+                        (u === true ? UnknownFieldHandler.onRead : u)(this.typeName, message, fieldNo, wireType, d);
+            }
+        }
+        return message;
+    }
+    internalBinaryWrite(message: DeleteUserRequest, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* string user_id = 1; */
+        if (message.userId !== "")
+            writer.tag(1, WireType.LengthDelimited).string(message.userId);
+        let u = options.writeUnknownFields;
+        if (u !== false)
+            (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
+        return writer;
+    }
+}
+/**
+ * @generated MessageType for protobuf message service.DeleteUserRequest
+ */
+export const DeleteUserRequest = new DeleteUserRequest$Type();
+// @generated message type with reflection information, may provide speed optimized methods
+class DeleteUserResponse$Type extends MessageType<DeleteUserResponse> {
+    constructor() {
+        super("service.DeleteUserResponse", []);
+    }
+    create(value?: PartialMessage<DeleteUserResponse>): DeleteUserResponse {
+        const message = globalThis.Object.create((this.messagePrototype!));
+        if (value !== undefined)
+            reflectionMergePartial<DeleteUserResponse>(this, message, value);
+        return message;
+    }
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: DeleteUserResponse): DeleteUserResponse {
+        let message = target ?? this.create(), end = reader.pos + length;
+        while (reader.pos < end) {
+            let [fieldNo, wireType] = reader.tag();
+            switch (fieldNo) {
+                default:
+                    let u = options.readUnknownField;
+                    if (u === "throw")
+                        throw new globalThis.Error(`Unknown field ${fieldNo} (wire type ${wireType}) for ${this.typeName}`);
+                    let d = reader.skip(wireType);
+                    if (u !== false)
+                        (u === true ? UnknownFieldHandler.onRead : u)(this.typeName, message, fieldNo, wireType, d);
+            }
+        }
+        return message;
+    }
+    internalBinaryWrite(message: DeleteUserResponse, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        let u = options.writeUnknownFields;
+        if (u !== false)
+            (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
+        return writer;
+    }
+}
+/**
+ * @generated MessageType for protobuf message service.DeleteUserResponse
+ */
+export const DeleteUserResponse = new DeleteUserResponse$Type();
+// @generated message type with reflection information, may provide speed optimized methods
+class ListUsersRequest$Type extends MessageType<ListUsersRequest> {
+    constructor() {
+        super("service.ListUsersRequest", []);
+    }
+    create(value?: PartialMessage<ListUsersRequest>): ListUsersRequest {
+        const message = globalThis.Object.create((this.messagePrototype!));
+        if (value !== undefined)
+            reflectionMergePartial<ListUsersRequest>(this, message, value);
+        return message;
+    }
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: ListUsersRequest): ListUsersRequest {
+        let message = target ?? this.create(), end = reader.pos + length;
+        while (reader.pos < end) {
+            let [fieldNo, wireType] = reader.tag();
+            switch (fieldNo) {
+                default:
+                    let u = options.readUnknownField;
+                    if (u === "throw")
+                        throw new globalThis.Error(`Unknown field ${fieldNo} (wire type ${wireType}) for ${this.typeName}`);
+                    let d = reader.skip(wireType);
+                    if (u !== false)
+                        (u === true ? UnknownFieldHandler.onRead : u)(this.typeName, message, fieldNo, wireType, d);
+            }
+        }
+        return message;
+    }
+    internalBinaryWrite(message: ListUsersRequest, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        let u = options.writeUnknownFields;
+        if (u !== false)
+            (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
+        return writer;
+    }
+}
+/**
+ * @generated MessageType for protobuf message service.ListUsersRequest
+ */
+export const ListUsersRequest = new ListUsersRequest$Type();
+// @generated message type with reflection information, may provide speed optimized methods
+class ListUsersResponse$Type extends MessageType<ListUsersResponse> {
+    constructor() {
+        super("service.ListUsersResponse", [
+            { no: 1, name: "users", kind: "message", repeat: 2 /*RepeatType.UNPACKED*/, T: () => User }
+        ]);
+    }
+    create(value?: PartialMessage<ListUsersResponse>): ListUsersResponse {
+        const message = globalThis.Object.create((this.messagePrototype!));
+        message.users = [];
+        if (value !== undefined)
+            reflectionMergePartial<ListUsersResponse>(this, message, value);
+        return message;
+    }
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: ListUsersResponse): ListUsersResponse {
+        let message = target ?? this.create(), end = reader.pos + length;
+        while (reader.pos < end) {
+            let [fieldNo, wireType] = reader.tag();
+            switch (fieldNo) {
+                case /* repeated service.User users */ 1:
+                    message.users.push(User.internalBinaryRead(reader, reader.uint32(), options));
+                    break;
+                default:
+                    let u = options.readUnknownField;
+                    if (u === "throw")
+                        throw new globalThis.Error(`Unknown field ${fieldNo} (wire type ${wireType}) for ${this.typeName}`);
+                    let d = reader.skip(wireType);
+                    if (u !== false)
+                        (u === true ? UnknownFieldHandler.onRead : u)(this.typeName, message, fieldNo, wireType, d);
+            }
+        }
+        return message;
+    }
+    internalBinaryWrite(message: ListUsersResponse, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* repeated service.User users = 1; */
+        for (let i = 0; i < message.users.length; i++)
+            User.internalBinaryWrite(message.users[i], writer.tag(1, WireType.LengthDelimited).fork(), options).join();
+        let u = options.writeUnknownFields;
+        if (u !== false)
+            (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
+        return writer;
+    }
+}
+/**
+ * @generated MessageType for protobuf message service.ListUsersResponse
+ */
+export const ListUsersResponse = new ListUsersResponse$Type();
+// @generated message type with reflection information, may provide speed optimized methods
+class User$Type extends MessageType<User> {
+    constructor() {
+        super("service.User", [
+            { no: 1, name: "id", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 2, name: "first_name", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 3, name: "last_name", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 4, name: "email", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 5, name: "is_admin", kind: "scalar", T: 8 /*ScalarType.BOOL*/ },
+            { no: 6, name: "created_at", kind: "message", T: () => Timestamp }
+        ]);
+    }
+    create(value?: PartialMessage<User>): User {
+        const message = globalThis.Object.create((this.messagePrototype!));
+        message.id = "";
+        message.firstName = "";
+        message.lastName = "";
+        message.email = "";
+        message.isAdmin = false;
+        if (value !== undefined)
+            reflectionMergePartial<User>(this, message, value);
+        return message;
+    }
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: User): User {
+        let message = target ?? this.create(), end = reader.pos + length;
+        while (reader.pos < end) {
+            let [fieldNo, wireType] = reader.tag();
+            switch (fieldNo) {
+                case /* string id */ 1:
+                    message.id = reader.string();
+                    break;
+                case /* string first_name */ 2:
+                    message.firstName = reader.string();
+                    break;
+                case /* string last_name */ 3:
+                    message.lastName = reader.string();
+                    break;
+                case /* string email */ 4:
+                    message.email = reader.string();
+                    break;
+                case /* bool is_admin */ 5:
+                    message.isAdmin = reader.bool();
+                    break;
+                case /* google.protobuf.Timestamp created_at */ 6:
+                    message.createdAt = Timestamp.internalBinaryRead(reader, reader.uint32(), options, message.createdAt);
+                    break;
+                default:
+                    let u = options.readUnknownField;
+                    if (u === "throw")
+                        throw new globalThis.Error(`Unknown field ${fieldNo} (wire type ${wireType}) for ${this.typeName}`);
+                    let d = reader.skip(wireType);
+                    if (u !== false)
+                        (u === true ? UnknownFieldHandler.onRead : u)(this.typeName, message, fieldNo, wireType, d);
+            }
+        }
+        return message;
+    }
+    internalBinaryWrite(message: User, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* string id = 1; */
+        if (message.id !== "")
+            writer.tag(1, WireType.LengthDelimited).string(message.id);
+        /* string first_name = 2; */
+        if (message.firstName !== "")
+            writer.tag(2, WireType.LengthDelimited).string(message.firstName);
+        /* string last_name = 3; */
+        if (message.lastName !== "")
+            writer.tag(3, WireType.LengthDelimited).string(message.lastName);
+        /* string email = 4; */
+        if (message.email !== "")
+            writer.tag(4, WireType.LengthDelimited).string(message.email);
+        /* bool is_admin = 5; */
+        if (message.isAdmin !== false)
+            writer.tag(5, WireType.Varint).bool(message.isAdmin);
+        /* google.protobuf.Timestamp created_at = 6; */
+        if (message.createdAt)
+            Timestamp.internalBinaryWrite(message.createdAt, writer.tag(6, WireType.LengthDelimited).fork(), options).join();
+        let u = options.writeUnknownFields;
+        if (u !== false)
+            (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
+        return writer;
+    }
+}
+/**
+ * @generated MessageType for protobuf message service.User
+ */
+export const User = new User$Type();
+// @generated message type with reflection information, may provide speed optimized methods
+class CreateAPIKeyRequest$Type extends MessageType<CreateAPIKeyRequest> {
+    constructor() {
+        super("service.CreateAPIKeyRequest", [
+            { no: 1, name: "user_id", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 2, name: "role", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 3, name: "is_service_key", kind: "scalar", T: 8 /*ScalarType.BOOL*/ }
+        ]);
+    }
+    create(value?: PartialMessage<CreateAPIKeyRequest>): CreateAPIKeyRequest {
+        const message = globalThis.Object.create((this.messagePrototype!));
+        message.userId = "";
+        message.role = "";
+        message.isServiceKey = false;
+        if (value !== undefined)
+            reflectionMergePartial<CreateAPIKeyRequest>(this, message, value);
+        return message;
+    }
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: CreateAPIKeyRequest): CreateAPIKeyRequest {
+        let message = target ?? this.create(), end = reader.pos + length;
+        while (reader.pos < end) {
+            let [fieldNo, wireType] = reader.tag();
+            switch (fieldNo) {
+                case /* string user_id */ 1:
+                    message.userId = reader.string();
+                    break;
+                case /* string role */ 2:
+                    message.role = reader.string();
+                    break;
+                case /* bool is_service_key */ 3:
+                    message.isServiceKey = reader.bool();
+                    break;
+                default:
+                    let u = options.readUnknownField;
+                    if (u === "throw")
+                        throw new globalThis.Error(`Unknown field ${fieldNo} (wire type ${wireType}) for ${this.typeName}`);
+                    let d = reader.skip(wireType);
+                    if (u !== false)
+                        (u === true ? UnknownFieldHandler.onRead : u)(this.typeName, message, fieldNo, wireType, d);
+            }
+        }
+        return message;
+    }
+    internalBinaryWrite(message: CreateAPIKeyRequest, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* string user_id = 1; */
+        if (message.userId !== "")
+            writer.tag(1, WireType.LengthDelimited).string(message.userId);
+        /* string role = 2; */
+        if (message.role !== "")
+            writer.tag(2, WireType.LengthDelimited).string(message.role);
+        /* bool is_service_key = 3; */
+        if (message.isServiceKey !== false)
+            writer.tag(3, WireType.Varint).bool(message.isServiceKey);
+        let u = options.writeUnknownFields;
+        if (u !== false)
+            (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
+        return writer;
+    }
+}
+/**
+ * @generated MessageType for protobuf message service.CreateAPIKeyRequest
+ */
+export const CreateAPIKeyRequest = new CreateAPIKeyRequest$Type();
+// @generated message type with reflection information, may provide speed optimized methods
+class CreateAPIKeyResponse$Type extends MessageType<CreateAPIKeyResponse> {
+    constructor() {
+        super("service.CreateAPIKeyResponse", [
+            { no: 1, name: "api_key", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 2, name: "role", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 3, name: "is_service_key", kind: "scalar", T: 8 /*ScalarType.BOOL*/ },
+            { no: 4, name: "expires_at", kind: "message", T: () => Timestamp }
+        ]);
+    }
+    create(value?: PartialMessage<CreateAPIKeyResponse>): CreateAPIKeyResponse {
+        const message = globalThis.Object.create((this.messagePrototype!));
+        message.apiKey = "";
+        message.role = "";
+        message.isServiceKey = false;
+        if (value !== undefined)
+            reflectionMergePartial<CreateAPIKeyResponse>(this, message, value);
+        return message;
+    }
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: CreateAPIKeyResponse): CreateAPIKeyResponse {
+        let message = target ?? this.create(), end = reader.pos + length;
+        while (reader.pos < end) {
+            let [fieldNo, wireType] = reader.tag();
+            switch (fieldNo) {
+                case /* string api_key */ 1:
+                    message.apiKey = reader.string();
+                    break;
+                case /* string role */ 2:
+                    message.role = reader.string();
+                    break;
+                case /* bool is_service_key */ 3:
+                    message.isServiceKey = reader.bool();
+                    break;
+                case /* google.protobuf.Timestamp expires_at */ 4:
+                    message.expiresAt = Timestamp.internalBinaryRead(reader, reader.uint32(), options, message.expiresAt);
+                    break;
+                default:
+                    let u = options.readUnknownField;
+                    if (u === "throw")
+                        throw new globalThis.Error(`Unknown field ${fieldNo} (wire type ${wireType}) for ${this.typeName}`);
+                    let d = reader.skip(wireType);
+                    if (u !== false)
+                        (u === true ? UnknownFieldHandler.onRead : u)(this.typeName, message, fieldNo, wireType, d);
+            }
+        }
+        return message;
+    }
+    internalBinaryWrite(message: CreateAPIKeyResponse, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* string api_key = 1; */
+        if (message.apiKey !== "")
+            writer.tag(1, WireType.LengthDelimited).string(message.apiKey);
+        /* string role = 2; */
+        if (message.role !== "")
+            writer.tag(2, WireType.LengthDelimited).string(message.role);
+        /* bool is_service_key = 3; */
+        if (message.isServiceKey !== false)
+            writer.tag(3, WireType.Varint).bool(message.isServiceKey);
+        /* google.protobuf.Timestamp expires_at = 4; */
+        if (message.expiresAt)
+            Timestamp.internalBinaryWrite(message.expiresAt, writer.tag(4, WireType.LengthDelimited).fork(), options).join();
+        let u = options.writeUnknownFields;
+        if (u !== false)
+            (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
+        return writer;
+    }
+}
+/**
+ * @generated MessageType for protobuf message service.CreateAPIKeyResponse
+ */
+export const CreateAPIKeyResponse = new CreateAPIKeyResponse$Type();
+// @generated message type with reflection information, may provide speed optimized methods
+class RotateAPIKeyRequest$Type extends MessageType<RotateAPIKeyRequest> {
+    constructor() {
+        super("service.RotateAPIKeyRequest", [
+            { no: 1, name: "api_key", kind: "scalar", T: 9 /*ScalarType.STRING*/ }
+        ]);
+    }
+    create(value?: PartialMessage<RotateAPIKeyRequest>): RotateAPIKeyRequest {
+        const message = globalThis.Object.create((this.messagePrototype!));
+        message.apiKey = "";
+        if (value !== undefined)
+            reflectionMergePartial<RotateAPIKeyRequest>(this, message, value);
+        return message;
+    }
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: RotateAPIKeyRequest): RotateAPIKeyRequest {
+        let message = target ?? this.create(), end = reader.pos + length;
+        while (reader.pos < end) {
+            let [fieldNo, wireType] = reader.tag();
+            switch (fieldNo) {
+                case /* string api_key */ 1:
+                    message.apiKey = reader.string();
+                    break;
+                default:
+                    let u = options.readUnknownField;
+                    if (u === "throw")
+                        throw new globalThis.Error(`Unknown field ${fieldNo} (wire type ${wireType}) for ${this.typeName}`);
+                    let d = reader.skip(wireType);
+                    if (u !== false)
+                        (u === true ? UnknownFieldHandler.onRead : u)(this.typeName, message, fieldNo, wireType, d);
+            }
+        }
+        return message;
+    }
+    internalBinaryWrite(message: RotateAPIKeyRequest, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* string api_key = 1; */
+        if (message.apiKey !== "")
+            writer.tag(1, WireType.LengthDelimited).string(message.apiKey);
+        let u = options.writeUnknownFields;
+        if (u !== false)
+            (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
+        return writer;
+    }
+}
+/**
+ * @generated MessageType for protobuf message service.RotateAPIKeyRequest
+ */
+export const RotateAPIKeyRequest = new RotateAPIKeyRequest$Type();
+// @generated message type with reflection information, may provide speed optimized methods
+class RotateAPIKeyResponse$Type extends MessageType<RotateAPIKeyResponse> {
+    constructor() {
+        super("service.RotateAPIKeyResponse", [
+            { no: 1, name: "new_api_key", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 2, name: "expires_at", kind: "message", T: () => Timestamp }
+        ]);
+    }
+    create(value?: PartialMessage<RotateAPIKeyResponse>): RotateAPIKeyResponse {
+        const message = globalThis.Object.create((this.messagePrototype!));
+        message.newApiKey = "";
+        if (value !== undefined)
+            reflectionMergePartial<RotateAPIKeyResponse>(this, message, value);
+        return message;
+    }
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: RotateAPIKeyResponse): RotateAPIKeyResponse {
+        let message = target ?? this.create(), end = reader.pos + length;
+        while (reader.pos < end) {
+            let [fieldNo, wireType] = reader.tag();
+            switch (fieldNo) {
+                case /* string new_api_key */ 1:
+                    message.newApiKey = reader.string();
+                    break;
+                case /* google.protobuf.Timestamp expires_at */ 2:
+                    message.expiresAt = Timestamp.internalBinaryRead(reader, reader.uint32(), options, message.expiresAt);
+                    break;
+                default:
+                    let u = options.readUnknownField;
+                    if (u === "throw")
+                        throw new globalThis.Error(`Unknown field ${fieldNo} (wire type ${wireType}) for ${this.typeName}`);
+                    let d = reader.skip(wireType);
+                    if (u !== false)
+                        (u === true ? UnknownFieldHandler.onRead : u)(this.typeName, message, fieldNo, wireType, d);
+            }
+        }
+        return message;
+    }
+    internalBinaryWrite(message: RotateAPIKeyResponse, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* string new_api_key = 1; */
+        if (message.newApiKey !== "")
+            writer.tag(1, WireType.LengthDelimited).string(message.newApiKey);
+        /* google.protobuf.Timestamp expires_at = 2; */
+        if (message.expiresAt)
+            Timestamp.internalBinaryWrite(message.expiresAt, writer.tag(2, WireType.LengthDelimited).fork(), options).join();
+        let u = options.writeUnknownFields;
+        if (u !== false)
+            (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
+        return writer;
+    }
+}
+/**
+ * @generated MessageType for protobuf message service.RotateAPIKeyResponse
+ */
+export const RotateAPIKeyResponse = new RotateAPIKeyResponse$Type();
+// @generated message type with reflection information, may provide speed optimized methods
+class ActivateAPIKeyRequest$Type extends MessageType<ActivateAPIKeyRequest> {
+    constructor() {
+        super("service.ActivateAPIKeyRequest", [
+            { no: 1, name: "api_key", kind: "scalar", T: 9 /*ScalarType.STRING*/ }
+        ]);
+    }
+    create(value?: PartialMessage<ActivateAPIKeyRequest>): ActivateAPIKeyRequest {
+        const message = globalThis.Object.create((this.messagePrototype!));
+        message.apiKey = "";
+        if (value !== undefined)
+            reflectionMergePartial<ActivateAPIKeyRequest>(this, message, value);
+        return message;
+    }
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: ActivateAPIKeyRequest): ActivateAPIKeyRequest {
+        let message = target ?? this.create(), end = reader.pos + length;
+        while (reader.pos < end) {
+            let [fieldNo, wireType] = reader.tag();
+            switch (fieldNo) {
+                case /* string api_key */ 1:
+                    message.apiKey = reader.string();
+                    break;
+                default:
+                    let u = options.readUnknownField;
+                    if (u === "throw")
+                        throw new globalThis.Error(`Unknown field ${fieldNo} (wire type ${wireType}) for ${this.typeName}`);
+                    let d = reader.skip(wireType);
+                    if (u !== false)
+                        (u === true ? UnknownFieldHandler.onRead : u)(this.typeName, message, fieldNo, wireType, d);
+            }
+        }
+        return message;
+    }
+    internalBinaryWrite(message: ActivateAPIKeyRequest, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* string api_key = 1; */
+        if (message.apiKey !== "")
+            writer.tag(1, WireType.LengthDelimited).string(message.apiKey);
+        let u = options.writeUnknownFields;
+        if (u !== false)
+            (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
+        return writer;
+    }
+}
+/**
+ * @generated MessageType for protobuf message service.ActivateAPIKeyRequest
+ */
+export const ActivateAPIKeyRequest = new ActivateAPIKeyRequest$Type();
+// @generated message type with reflection information, may provide speed optimized methods
+class ActivateAPIKeyResponse$Type extends MessageType<ActivateAPIKeyResponse> {
+    constructor() {
+        super("service.ActivateAPIKeyResponse", []);
+    }
+    create(value?: PartialMessage<ActivateAPIKeyResponse>): ActivateAPIKeyResponse {
+        const message = globalThis.Object.create((this.messagePrototype!));
+        if (value !== undefined)
+            reflectionMergePartial<ActivateAPIKeyResponse>(this, message, value);
+        return message;
+    }
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: ActivateAPIKeyResponse): ActivateAPIKeyResponse {
+        let message = target ?? this.create(), end = reader.pos + length;
+        while (reader.pos < end) {
+            let [fieldNo, wireType] = reader.tag();
+            switch (fieldNo) {
+                default:
+                    let u = options.readUnknownField;
+                    if (u === "throw")
+                        throw new globalThis.Error(`Unknown field ${fieldNo} (wire type ${wireType}) for ${this.typeName}`);
+                    let d = reader.skip(wireType);
+                    if (u !== false)
+                        (u === true ? UnknownFieldHandler.onRead : u)(this.typeName, message, fieldNo, wireType, d);
+            }
+        }
+        return message;
+    }
+    internalBinaryWrite(message: ActivateAPIKeyResponse, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        let u = options.writeUnknownFields;
+        if (u !== false)
+            (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
+        return writer;
+    }
+}
+/**
+ * @generated MessageType for protobuf message service.ActivateAPIKeyResponse
+ */
+export const ActivateAPIKeyResponse = new ActivateAPIKeyResponse$Type();
+// @generated message type with reflection information, may provide speed optimized methods
+class DeactivateAPIKeyRequest$Type extends MessageType<DeactivateAPIKeyRequest> {
+    constructor() {
+        super("service.DeactivateAPIKeyRequest", [
+            { no: 1, name: "api_key", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 2, name: "deactivation_message", kind: "scalar", T: 9 /*ScalarType.STRING*/ }
+        ]);
+    }
+    create(value?: PartialMessage<DeactivateAPIKeyRequest>): DeactivateAPIKeyRequest {
+        const message = globalThis.Object.create((this.messagePrototype!));
+        message.apiKey = "";
+        message.deactivationMessage = "";
+        if (value !== undefined)
+            reflectionMergePartial<DeactivateAPIKeyRequest>(this, message, value);
+        return message;
+    }
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: DeactivateAPIKeyRequest): DeactivateAPIKeyRequest {
+        let message = target ?? this.create(), end = reader.pos + length;
+        while (reader.pos < end) {
+            let [fieldNo, wireType] = reader.tag();
+            switch (fieldNo) {
+                case /* string api_key */ 1:
+                    message.apiKey = reader.string();
+                    break;
+                case /* string deactivation_message */ 2:
+                    message.deactivationMessage = reader.string();
+                    break;
+                default:
+                    let u = options.readUnknownField;
+                    if (u === "throw")
+                        throw new globalThis.Error(`Unknown field ${fieldNo} (wire type ${wireType}) for ${this.typeName}`);
+                    let d = reader.skip(wireType);
+                    if (u !== false)
+                        (u === true ? UnknownFieldHandler.onRead : u)(this.typeName, message, fieldNo, wireType, d);
+            }
+        }
+        return message;
+    }
+    internalBinaryWrite(message: DeactivateAPIKeyRequest, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* string api_key = 1; */
+        if (message.apiKey !== "")
+            writer.tag(1, WireType.LengthDelimited).string(message.apiKey);
+        /* string deactivation_message = 2; */
+        if (message.deactivationMessage !== "")
+            writer.tag(2, WireType.LengthDelimited).string(message.deactivationMessage);
+        let u = options.writeUnknownFields;
+        if (u !== false)
+            (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
+        return writer;
+    }
+}
+/**
+ * @generated MessageType for protobuf message service.DeactivateAPIKeyRequest
+ */
+export const DeactivateAPIKeyRequest = new DeactivateAPIKeyRequest$Type();
+// @generated message type with reflection information, may provide speed optimized methods
+class DeactivateAPIKeyResponse$Type extends MessageType<DeactivateAPIKeyResponse> {
+    constructor() {
+        super("service.DeactivateAPIKeyResponse", []);
+    }
+    create(value?: PartialMessage<DeactivateAPIKeyResponse>): DeactivateAPIKeyResponse {
+        const message = globalThis.Object.create((this.messagePrototype!));
+        if (value !== undefined)
+            reflectionMergePartial<DeactivateAPIKeyResponse>(this, message, value);
+        return message;
+    }
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: DeactivateAPIKeyResponse): DeactivateAPIKeyResponse {
+        let message = target ?? this.create(), end = reader.pos + length;
+        while (reader.pos < end) {
+            let [fieldNo, wireType] = reader.tag();
+            switch (fieldNo) {
+                default:
+                    let u = options.readUnknownField;
+                    if (u === "throw")
+                        throw new globalThis.Error(`Unknown field ${fieldNo} (wire type ${wireType}) for ${this.typeName}`);
+                    let d = reader.skip(wireType);
+                    if (u !== false)
+                        (u === true ? UnknownFieldHandler.onRead : u)(this.typeName, message, fieldNo, wireType, d);
+            }
+        }
+        return message;
+    }
+    internalBinaryWrite(message: DeactivateAPIKeyResponse, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        let u = options.writeUnknownFields;
+        if (u !== false)
+            (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
+        return writer;
+    }
+}
+/**
+ * @generated MessageType for protobuf message service.DeactivateAPIKeyResponse
+ */
+export const DeactivateAPIKeyResponse = new DeactivateAPIKeyResponse$Type();
+// @generated message type with reflection information, may provide speed optimized methods
+class ListAPIKeysRequest$Type extends MessageType<ListAPIKeysRequest> {
+    constructor() {
+        super("service.ListAPIKeysRequest", [
+            { no: 1, name: "user_id", kind: "scalar", T: 9 /*ScalarType.STRING*/ }
+        ]);
+    }
+    create(value?: PartialMessage<ListAPIKeysRequest>): ListAPIKeysRequest {
+        const message = globalThis.Object.create((this.messagePrototype!));
+        message.userId = "";
+        if (value !== undefined)
+            reflectionMergePartial<ListAPIKeysRequest>(this, message, value);
+        return message;
+    }
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: ListAPIKeysRequest): ListAPIKeysRequest {
+        let message = target ?? this.create(), end = reader.pos + length;
+        while (reader.pos < end) {
+            let [fieldNo, wireType] = reader.tag();
+            switch (fieldNo) {
+                case /* string user_id */ 1:
+                    message.userId = reader.string();
+                    break;
+                default:
+                    let u = options.readUnknownField;
+                    if (u === "throw")
+                        throw new globalThis.Error(`Unknown field ${fieldNo} (wire type ${wireType}) for ${this.typeName}`);
+                    let d = reader.skip(wireType);
+                    if (u !== false)
+                        (u === true ? UnknownFieldHandler.onRead : u)(this.typeName, message, fieldNo, wireType, d);
+            }
+        }
+        return message;
+    }
+    internalBinaryWrite(message: ListAPIKeysRequest, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* string user_id = 1; */
+        if (message.userId !== "")
+            writer.tag(1, WireType.LengthDelimited).string(message.userId);
+        let u = options.writeUnknownFields;
+        if (u !== false)
+            (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
+        return writer;
+    }
+}
+/**
+ * @generated MessageType for protobuf message service.ListAPIKeysRequest
+ */
+export const ListAPIKeysRequest = new ListAPIKeysRequest$Type();
+// @generated message type with reflection information, may provide speed optimized methods
+class ListAPIKeysResponse$Type extends MessageType<ListAPIKeysResponse> {
+    constructor() {
+        super("service.ListAPIKeysResponse", [
+            { no: 1, name: "api_keys", kind: "message", repeat: 2 /*RepeatType.UNPACKED*/, T: () => APIKey }
+        ]);
+    }
+    create(value?: PartialMessage<ListAPIKeysResponse>): ListAPIKeysResponse {
+        const message = globalThis.Object.create((this.messagePrototype!));
+        message.apiKeys = [];
+        if (value !== undefined)
+            reflectionMergePartial<ListAPIKeysResponse>(this, message, value);
+        return message;
+    }
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: ListAPIKeysResponse): ListAPIKeysResponse {
+        let message = target ?? this.create(), end = reader.pos + length;
+        while (reader.pos < end) {
+            let [fieldNo, wireType] = reader.tag();
+            switch (fieldNo) {
+                case /* repeated service.APIKey api_keys */ 1:
+                    message.apiKeys.push(APIKey.internalBinaryRead(reader, reader.uint32(), options));
+                    break;
+                default:
+                    let u = options.readUnknownField;
+                    if (u === "throw")
+                        throw new globalThis.Error(`Unknown field ${fieldNo} (wire type ${wireType}) for ${this.typeName}`);
+                    let d = reader.skip(wireType);
+                    if (u !== false)
+                        (u === true ? UnknownFieldHandler.onRead : u)(this.typeName, message, fieldNo, wireType, d);
+            }
+        }
+        return message;
+    }
+    internalBinaryWrite(message: ListAPIKeysResponse, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* repeated service.APIKey api_keys = 1; */
+        for (let i = 0; i < message.apiKeys.length; i++)
+            APIKey.internalBinaryWrite(message.apiKeys[i], writer.tag(1, WireType.LengthDelimited).fork(), options).join();
+        let u = options.writeUnknownFields;
+        if (u !== false)
+            (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
+        return writer;
+    }
+}
+/**
+ * @generated MessageType for protobuf message service.ListAPIKeysResponse
+ */
+export const ListAPIKeysResponse = new ListAPIKeysResponse$Type();
+// @generated message type with reflection information, may provide speed optimized methods
+class APIKey$Type extends MessageType<APIKey> {
+    constructor() {
+        super("service.APIKey", [
+            { no: 1, name: "api_key", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 2, name: "user_id", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 3, name: "role", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 4, name: "is_service_key", kind: "scalar", T: 8 /*ScalarType.BOOL*/ },
+            { no: 5, name: "is_active", kind: "scalar", T: 8 /*ScalarType.BOOL*/ },
+            { no: 6, name: "deactivation_message", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 7, name: "created_at", kind: "message", T: () => Timestamp },
+            { no: 8, name: "expires_at", kind: "message", T: () => Timestamp }
+        ]);
+    }
+    create(value?: PartialMessage<APIKey>): APIKey {
+        const message = globalThis.Object.create((this.messagePrototype!));
+        message.apiKey = "";
+        message.userId = "";
+        message.role = "";
+        message.isServiceKey = false;
+        message.isActive = false;
+        message.deactivationMessage = "";
+        if (value !== undefined)
+            reflectionMergePartial<APIKey>(this, message, value);
+        return message;
+    }
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: APIKey): APIKey {
+        let message = target ?? this.create(), end = reader.pos + length;
+        while (reader.pos < end) {
+            let [fieldNo, wireType] = reader.tag();
+            switch (fieldNo) {
+                case /* string api_key */ 1:
+                    message.apiKey = reader.string();
+                    break;
+                case /* string user_id */ 2:
+                    message.userId = reader.string();
+                    break;
+                case /* string role */ 3:
+                    message.role = reader.string();
+                    break;
+                case /* bool is_service_key */ 4:
+                    message.isServiceKey = reader.bool();
+                    break;
+                case /* bool is_active */ 5:
+                    message.isActive = reader.bool();
+                    break;
+                case /* string deactivation_message */ 6:
+                    message.deactivationMessage = reader.string();
+                    break;
+                case /* google.protobuf.Timestamp created_at */ 7:
+                    message.createdAt = Timestamp.internalBinaryRead(reader, reader.uint32(), options, message.createdAt);
+                    break;
+                case /* google.protobuf.Timestamp expires_at */ 8:
+                    message.expiresAt = Timestamp.internalBinaryRead(reader, reader.uint32(), options, message.expiresAt);
+                    break;
+                default:
+                    let u = options.readUnknownField;
+                    if (u === "throw")
+                        throw new globalThis.Error(`Unknown field ${fieldNo} (wire type ${wireType}) for ${this.typeName}`);
+                    let d = reader.skip(wireType);
+                    if (u !== false)
+                        (u === true ? UnknownFieldHandler.onRead : u)(this.typeName, message, fieldNo, wireType, d);
+            }
+        }
+        return message;
+    }
+    internalBinaryWrite(message: APIKey, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* string api_key = 1; */
+        if (message.apiKey !== "")
+            writer.tag(1, WireType.LengthDelimited).string(message.apiKey);
+        /* string user_id = 2; */
+        if (message.userId !== "")
+            writer.tag(2, WireType.LengthDelimited).string(message.userId);
+        /* string role = 3; */
+        if (message.role !== "")
+            writer.tag(3, WireType.LengthDelimited).string(message.role);
+        /* bool is_service_key = 4; */
+        if (message.isServiceKey !== false)
+            writer.tag(4, WireType.Varint).bool(message.isServiceKey);
+        /* bool is_active = 5; */
+        if (message.isActive !== false)
+            writer.tag(5, WireType.Varint).bool(message.isActive);
+        /* string deactivation_message = 6; */
+        if (message.deactivationMessage !== "")
+            writer.tag(6, WireType.LengthDelimited).string(message.deactivationMessage);
+        /* google.protobuf.Timestamp created_at = 7; */
+        if (message.createdAt)
+            Timestamp.internalBinaryWrite(message.createdAt, writer.tag(7, WireType.LengthDelimited).fork(), options).join();
+        /* google.protobuf.Timestamp expires_at = 8; */
+        if (message.expiresAt)
+            Timestamp.internalBinaryWrite(message.expiresAt, writer.tag(8, WireType.LengthDelimited).fork(), options).join();
+        let u = options.writeUnknownFields;
+        if (u !== false)
+            (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
+        return writer;
+    }
+}
+/**
+ * @generated MessageType for protobuf message service.APIKey
+ */
+export const APIKey = new APIKey$Type();
+// @generated message type with reflection information, may provide speed optimized methods
+class ChangePasswordRequest$Type extends MessageType<ChangePasswordRequest> {
+    constructor() {
+        super("service.ChangePasswordRequest", [
+            { no: 1, name: "user_id", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 2, name: "old_password", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 3, name: "new_password", kind: "scalar", T: 9 /*ScalarType.STRING*/ }
+        ]);
+    }
+    create(value?: PartialMessage<ChangePasswordRequest>): ChangePasswordRequest {
+        const message = globalThis.Object.create((this.messagePrototype!));
+        message.userId = "";
+        message.oldPassword = "";
+        message.newPassword = "";
+        if (value !== undefined)
+            reflectionMergePartial<ChangePasswordRequest>(this, message, value);
+        return message;
+    }
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: ChangePasswordRequest): ChangePasswordRequest {
+        let message = target ?? this.create(), end = reader.pos + length;
+        while (reader.pos < end) {
+            let [fieldNo, wireType] = reader.tag();
+            switch (fieldNo) {
+                case /* string user_id */ 1:
+                    message.userId = reader.string();
+                    break;
+                case /* string old_password */ 2:
+                    message.oldPassword = reader.string();
+                    break;
+                case /* string new_password */ 3:
+                    message.newPassword = reader.string();
+                    break;
+                default:
+                    let u = options.readUnknownField;
+                    if (u === "throw")
+                        throw new globalThis.Error(`Unknown field ${fieldNo} (wire type ${wireType}) for ${this.typeName}`);
+                    let d = reader.skip(wireType);
+                    if (u !== false)
+                        (u === true ? UnknownFieldHandler.onRead : u)(this.typeName, message, fieldNo, wireType, d);
+            }
+        }
+        return message;
+    }
+    internalBinaryWrite(message: ChangePasswordRequest, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* string user_id = 1; */
+        if (message.userId !== "")
+            writer.tag(1, WireType.LengthDelimited).string(message.userId);
+        /* string old_password = 2; */
+        if (message.oldPassword !== "")
+            writer.tag(2, WireType.LengthDelimited).string(message.oldPassword);
+        /* string new_password = 3; */
+        if (message.newPassword !== "")
+            writer.tag(3, WireType.LengthDelimited).string(message.newPassword);
+        let u = options.writeUnknownFields;
+        if (u !== false)
+            (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
+        return writer;
+    }
+}
+/**
+ * @generated MessageType for protobuf message service.ChangePasswordRequest
+ */
+export const ChangePasswordRequest = new ChangePasswordRequest$Type();
+// @generated message type with reflection information, may provide speed optimized methods
+class ChangePasswordResponse$Type extends MessageType<ChangePasswordResponse> {
+    constructor() {
+        super("service.ChangePasswordResponse", []);
+    }
+    create(value?: PartialMessage<ChangePasswordResponse>): ChangePasswordResponse {
+        const message = globalThis.Object.create((this.messagePrototype!));
+        if (value !== undefined)
+            reflectionMergePartial<ChangePasswordResponse>(this, message, value);
+        return message;
+    }
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: ChangePasswordResponse): ChangePasswordResponse {
+        let message = target ?? this.create(), end = reader.pos + length;
+        while (reader.pos < end) {
+            let [fieldNo, wireType] = reader.tag();
+            switch (fieldNo) {
+                default:
+                    let u = options.readUnknownField;
+                    if (u === "throw")
+                        throw new globalThis.Error(`Unknown field ${fieldNo} (wire type ${wireType}) for ${this.typeName}`);
+                    let d = reader.skip(wireType);
+                    if (u !== false)
+                        (u === true ? UnknownFieldHandler.onRead : u)(this.typeName, message, fieldNo, wireType, d);
+            }
+        }
+        return message;
+    }
+    internalBinaryWrite(message: ChangePasswordResponse, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        let u = options.writeUnknownFields;
+        if (u !== false)
+            (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
+        return writer;
+    }
+}
+/**
+ * @generated MessageType for protobuf message service.ChangePasswordResponse
+ */
+export const ChangePasswordResponse = new ChangePasswordResponse$Type();
+// @generated message type with reflection information, may provide speed optimized methods
+class LoginRequest$Type extends MessageType<LoginRequest> {
+    constructor() {
+        super("service.LoginRequest", [
+            { no: 1, name: "email", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 2, name: "password", kind: "scalar", T: 9 /*ScalarType.STRING*/ }
+        ]);
+    }
+    create(value?: PartialMessage<LoginRequest>): LoginRequest {
+        const message = globalThis.Object.create((this.messagePrototype!));
+        message.email = "";
+        message.password = "";
+        if (value !== undefined)
+            reflectionMergePartial<LoginRequest>(this, message, value);
+        return message;
+    }
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: LoginRequest): LoginRequest {
+        let message = target ?? this.create(), end = reader.pos + length;
+        while (reader.pos < end) {
+            let [fieldNo, wireType] = reader.tag();
+            switch (fieldNo) {
+                case /* string email */ 1:
+                    message.email = reader.string();
+                    break;
+                case /* string password */ 2:
+                    message.password = reader.string();
+                    break;
+                default:
+                    let u = options.readUnknownField;
+                    if (u === "throw")
+                        throw new globalThis.Error(`Unknown field ${fieldNo} (wire type ${wireType}) for ${this.typeName}`);
+                    let d = reader.skip(wireType);
+                    if (u !== false)
+                        (u === true ? UnknownFieldHandler.onRead : u)(this.typeName, message, fieldNo, wireType, d);
+            }
+        }
+        return message;
+    }
+    internalBinaryWrite(message: LoginRequest, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* string email = 1; */
+        if (message.email !== "")
+            writer.tag(1, WireType.LengthDelimited).string(message.email);
+        /* string password = 2; */
+        if (message.password !== "")
+            writer.tag(2, WireType.LengthDelimited).string(message.password);
+        let u = options.writeUnknownFields;
+        if (u !== false)
+            (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
+        return writer;
+    }
+}
+/**
+ * @generated MessageType for protobuf message service.LoginRequest
+ */
+export const LoginRequest = new LoginRequest$Type();
+// @generated message type with reflection information, may provide speed optimized methods
+class LoginResponse$Type extends MessageType<LoginResponse> {
+    constructor() {
+        super("service.LoginResponse", [
+            { no: 1, name: "user_id", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 2, name: "first_name", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 3, name: "last_name", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 4, name: "is_admin", kind: "scalar", T: 8 /*ScalarType.BOOL*/ },
+            { no: 5, name: "token", kind: "scalar", T: 9 /*ScalarType.STRING*/ }
+        ]);
+    }
+    create(value?: PartialMessage<LoginResponse>): LoginResponse {
+        const message = globalThis.Object.create((this.messagePrototype!));
+        message.userId = "";
+        message.firstName = "";
+        message.lastName = "";
+        message.isAdmin = false;
+        message.token = "";
+        if (value !== undefined)
+            reflectionMergePartial<LoginResponse>(this, message, value);
+        return message;
+    }
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: LoginResponse): LoginResponse {
+        let message = target ?? this.create(), end = reader.pos + length;
+        while (reader.pos < end) {
+            let [fieldNo, wireType] = reader.tag();
+            switch (fieldNo) {
+                case /* string user_id */ 1:
+                    message.userId = reader.string();
+                    break;
+                case /* string first_name */ 2:
+                    message.firstName = reader.string();
+                    break;
+                case /* string last_name */ 3:
+                    message.lastName = reader.string();
+                    break;
+                case /* bool is_admin */ 4:
+                    message.isAdmin = reader.bool();
+                    break;
+                case /* string token */ 5:
+                    message.token = reader.string();
+                    break;
+                default:
+                    let u = options.readUnknownField;
+                    if (u === "throw")
+                        throw new globalThis.Error(`Unknown field ${fieldNo} (wire type ${wireType}) for ${this.typeName}`);
+                    let d = reader.skip(wireType);
+                    if (u !== false)
+                        (u === true ? UnknownFieldHandler.onRead : u)(this.typeName, message, fieldNo, wireType, d);
+            }
+        }
+        return message;
+    }
+    internalBinaryWrite(message: LoginResponse, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* string user_id = 1; */
+        if (message.userId !== "")
+            writer.tag(1, WireType.LengthDelimited).string(message.userId);
+        /* string first_name = 2; */
+        if (message.firstName !== "")
+            writer.tag(2, WireType.LengthDelimited).string(message.firstName);
+        /* string last_name = 3; */
+        if (message.lastName !== "")
+            writer.tag(3, WireType.LengthDelimited).string(message.lastName);
+        /* bool is_admin = 4; */
+        if (message.isAdmin !== false)
+            writer.tag(4, WireType.Varint).bool(message.isAdmin);
+        /* string token = 5; */
+        if (message.token !== "")
+            writer.tag(5, WireType.LengthDelimited).string(message.token);
+        let u = options.writeUnknownFields;
+        if (u !== false)
+            (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
+        return writer;
+    }
+}
+/**
+ * @generated MessageType for protobuf message service.LoginResponse
+ */
+export const LoginResponse = new LoginResponse$Type();
+// @generated message type with reflection information, may provide speed optimized methods
+class InviteUserRequest$Type extends MessageType<InviteUserRequest> {
+    constructor() {
+        super("service.InviteUserRequest", [
+            { no: 1, name: "email", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 2, name: "is_admin", kind: "scalar", T: 8 /*ScalarType.BOOL*/ }
+        ]);
+    }
+    create(value?: PartialMessage<InviteUserRequest>): InviteUserRequest {
+        const message = globalThis.Object.create((this.messagePrototype!));
+        message.email = "";
+        message.isAdmin = false;
+        if (value !== undefined)
+            reflectionMergePartial<InviteUserRequest>(this, message, value);
+        return message;
+    }
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: InviteUserRequest): InviteUserRequest {
+        let message = target ?? this.create(), end = reader.pos + length;
+        while (reader.pos < end) {
+            let [fieldNo, wireType] = reader.tag();
+            switch (fieldNo) {
+                case /* string email */ 1:
+                    message.email = reader.string();
+                    break;
+                case /* bool is_admin */ 2:
+                    message.isAdmin = reader.bool();
+                    break;
+                default:
+                    let u = options.readUnknownField;
+                    if (u === "throw")
+                        throw new globalThis.Error(`Unknown field ${fieldNo} (wire type ${wireType}) for ${this.typeName}`);
+                    let d = reader.skip(wireType);
+                    if (u !== false)
+                        (u === true ? UnknownFieldHandler.onRead : u)(this.typeName, message, fieldNo, wireType, d);
+            }
+        }
+        return message;
+    }
+    internalBinaryWrite(message: InviteUserRequest, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* string email = 1; */
+        if (message.email !== "")
+            writer.tag(1, WireType.LengthDelimited).string(message.email);
+        /* bool is_admin = 2; */
+        if (message.isAdmin !== false)
+            writer.tag(2, WireType.Varint).bool(message.isAdmin);
+        let u = options.writeUnknownFields;
+        if (u !== false)
+            (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
+        return writer;
+    }
+}
+/**
+ * @generated MessageType for protobuf message service.InviteUserRequest
+ */
+export const InviteUserRequest = new InviteUserRequest$Type();
+// @generated message type with reflection information, may provide speed optimized methods
+class InviteUserResponse$Type extends MessageType<InviteUserResponse> {
+    constructor() {
+        super("service.InviteUserResponse", [
+            { no: 1, name: "invitation_id", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 2, name: "token", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 3, name: "expires_at", kind: "message", T: () => Timestamp }
+        ]);
+    }
+    create(value?: PartialMessage<InviteUserResponse>): InviteUserResponse {
+        const message = globalThis.Object.create((this.messagePrototype!));
+        message.invitationId = "";
+        message.token = "";
+        if (value !== undefined)
+            reflectionMergePartial<InviteUserResponse>(this, message, value);
+        return message;
+    }
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: InviteUserResponse): InviteUserResponse {
+        let message = target ?? this.create(), end = reader.pos + length;
+        while (reader.pos < end) {
+            let [fieldNo, wireType] = reader.tag();
+            switch (fieldNo) {
+                case /* string invitation_id */ 1:
+                    message.invitationId = reader.string();
+                    break;
+                case /* string token */ 2:
+                    message.token = reader.string();
+                    break;
+                case /* google.protobuf.Timestamp expires_at */ 3:
+                    message.expiresAt = Timestamp.internalBinaryRead(reader, reader.uint32(), options, message.expiresAt);
+                    break;
+                default:
+                    let u = options.readUnknownField;
+                    if (u === "throw")
+                        throw new globalThis.Error(`Unknown field ${fieldNo} (wire type ${wireType}) for ${this.typeName}`);
+                    let d = reader.skip(wireType);
+                    if (u !== false)
+                        (u === true ? UnknownFieldHandler.onRead : u)(this.typeName, message, fieldNo, wireType, d);
+            }
+        }
+        return message;
+    }
+    internalBinaryWrite(message: InviteUserResponse, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* string invitation_id = 1; */
+        if (message.invitationId !== "")
+            writer.tag(1, WireType.LengthDelimited).string(message.invitationId);
+        /* string token = 2; */
+        if (message.token !== "")
+            writer.tag(2, WireType.LengthDelimited).string(message.token);
+        /* google.protobuf.Timestamp expires_at = 3; */
+        if (message.expiresAt)
+            Timestamp.internalBinaryWrite(message.expiresAt, writer.tag(3, WireType.LengthDelimited).fork(), options).join();
+        let u = options.writeUnknownFields;
+        if (u !== false)
+            (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
+        return writer;
+    }
+}
+/**
+ * @generated MessageType for protobuf message service.InviteUserResponse
+ */
+export const InviteUserResponse = new InviteUserResponse$Type();
+// @generated message type with reflection information, may provide speed optimized methods
+class ValidateInviteRequest$Type extends MessageType<ValidateInviteRequest> {
+    constructor() {
+        super("service.ValidateInviteRequest", [
+            { no: 1, name: "token", kind: "scalar", T: 9 /*ScalarType.STRING*/ }
+        ]);
+    }
+    create(value?: PartialMessage<ValidateInviteRequest>): ValidateInviteRequest {
+        const message = globalThis.Object.create((this.messagePrototype!));
+        message.token = "";
+        if (value !== undefined)
+            reflectionMergePartial<ValidateInviteRequest>(this, message, value);
+        return message;
+    }
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: ValidateInviteRequest): ValidateInviteRequest {
+        let message = target ?? this.create(), end = reader.pos + length;
+        while (reader.pos < end) {
+            let [fieldNo, wireType] = reader.tag();
+            switch (fieldNo) {
+                case /* string token */ 1:
+                    message.token = reader.string();
+                    break;
+                default:
+                    let u = options.readUnknownField;
+                    if (u === "throw")
+                        throw new globalThis.Error(`Unknown field ${fieldNo} (wire type ${wireType}) for ${this.typeName}`);
+                    let d = reader.skip(wireType);
+                    if (u !== false)
+                        (u === true ? UnknownFieldHandler.onRead : u)(this.typeName, message, fieldNo, wireType, d);
+            }
+        }
+        return message;
+    }
+    internalBinaryWrite(message: ValidateInviteRequest, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* string token = 1; */
+        if (message.token !== "")
+            writer.tag(1, WireType.LengthDelimited).string(message.token);
+        let u = options.writeUnknownFields;
+        if (u !== false)
+            (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
+        return writer;
+    }
+}
+/**
+ * @generated MessageType for protobuf message service.ValidateInviteRequest
+ */
+export const ValidateInviteRequest = new ValidateInviteRequest$Type();
+// @generated message type with reflection information, may provide speed optimized methods
+class ValidateInviteResponse$Type extends MessageType<ValidateInviteResponse> {
+    constructor() {
+        super("service.ValidateInviteResponse", [
+            { no: 1, name: "email", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 2, name: "is_admin", kind: "scalar", T: 8 /*ScalarType.BOOL*/ }
+        ]);
+    }
+    create(value?: PartialMessage<ValidateInviteResponse>): ValidateInviteResponse {
+        const message = globalThis.Object.create((this.messagePrototype!));
+        message.email = "";
+        message.isAdmin = false;
+        if (value !== undefined)
+            reflectionMergePartial<ValidateInviteResponse>(this, message, value);
+        return message;
+    }
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: ValidateInviteResponse): ValidateInviteResponse {
+        let message = target ?? this.create(), end = reader.pos + length;
+        while (reader.pos < end) {
+            let [fieldNo, wireType] = reader.tag();
+            switch (fieldNo) {
+                case /* string email */ 1:
+                    message.email = reader.string();
+                    break;
+                case /* bool is_admin */ 2:
+                    message.isAdmin = reader.bool();
+                    break;
+                default:
+                    let u = options.readUnknownField;
+                    if (u === "throw")
+                        throw new globalThis.Error(`Unknown field ${fieldNo} (wire type ${wireType}) for ${this.typeName}`);
+                    let d = reader.skip(wireType);
+                    if (u !== false)
+                        (u === true ? UnknownFieldHandler.onRead : u)(this.typeName, message, fieldNo, wireType, d);
+            }
+        }
+        return message;
+    }
+    internalBinaryWrite(message: ValidateInviteResponse, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* string email = 1; */
+        if (message.email !== "")
+            writer.tag(1, WireType.LengthDelimited).string(message.email);
+        /* bool is_admin = 2; */
+        if (message.isAdmin !== false)
+            writer.tag(2, WireType.Varint).bool(message.isAdmin);
+        let u = options.writeUnknownFields;
+        if (u !== false)
+            (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
+        return writer;
+    }
+}
+/**
+ * @generated MessageType for protobuf message service.ValidateInviteResponse
+ */
+export const ValidateInviteResponse = new ValidateInviteResponse$Type();
+// @generated message type with reflection information, may provide speed optimized methods
+class ScanDomainRequest$Type extends MessageType<ScanDomainRequest> {
+    constructor() {
+        super("service.ScanDomainRequest", [
+            { no: 1, name: "domain", kind: "scalar", T: 9 /*ScalarType.STRING*/ }
+        ]);
+    }
+    create(value?: PartialMessage<ScanDomainRequest>): ScanDomainRequest {
+        const message = globalThis.Object.create((this.messagePrototype!));
+        message.domain = "";
+        if (value !== undefined)
+            reflectionMergePartial<ScanDomainRequest>(this, message, value);
+        return message;
+    }
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: ScanDomainRequest): ScanDomainRequest {
+        let message = target ?? this.create(), end = reader.pos + length;
+        while (reader.pos < end) {
+            let [fieldNo, wireType] = reader.tag();
+            switch (fieldNo) {
+                case /* string domain */ 1:
+                    message.domain = reader.string();
+                    break;
+                default:
+                    let u = options.readUnknownField;
+                    if (u === "throw")
+                        throw new globalThis.Error(`Unknown field ${fieldNo} (wire type ${wireType}) for ${this.typeName}`);
+                    let d = reader.skip(wireType);
+                    if (u !== false)
+                        (u === true ? UnknownFieldHandler.onRead : u)(this.typeName, message, fieldNo, wireType, d);
+            }
+        }
+        return message;
+    }
+    internalBinaryWrite(message: ScanDomainRequest, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* string domain = 1; */
+        if (message.domain !== "")
+            writer.tag(1, WireType.LengthDelimited).string(message.domain);
+        let u = options.writeUnknownFields;
+        if (u !== false)
+            (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
+        return writer;
+    }
+}
+/**
+ * @generated MessageType for protobuf message service.ScanDomainRequest
+ */
+export const ScanDomainRequest = new ScanDomainRequest$Type();
+// @generated message type with reflection information, may provide speed optimized methods
+class ScanDomainResponse$Type extends MessageType<ScanDomainResponse> {
+    constructor() {
+        super("service.ScanDomainResponse", [
+            { no: 1, name: "scan_id", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 2, name: "result", kind: "message", T: () => DNSSecurityResult },
+            { no: 3, name: "scan_run_id", kind: "scalar", T: 9 /*ScalarType.STRING*/ }
+        ]);
+    }
+    create(value?: PartialMessage<ScanDomainResponse>): ScanDomainResponse {
+        const message = globalThis.Object.create((this.messagePrototype!));
+        message.scanId = "";
+        message.scanRunId = "";
+        if (value !== undefined)
+            reflectionMergePartial<ScanDomainResponse>(this, message, value);
+        return message;
+    }
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: ScanDomainResponse): ScanDomainResponse {
+        let message = target ?? this.create(), end = reader.pos + length;
+        while (reader.pos < end) {
+            let [fieldNo, wireType] = reader.tag();
+            switch (fieldNo) {
+                case /* string scan_id */ 1:
+                    message.scanId = reader.string();
+                    break;
+                case /* service.DNSSecurityResult result */ 2:
+                    message.result = DNSSecurityResult.internalBinaryRead(reader, reader.uint32(), options, message.result);
+                    break;
+                case /* string scan_run_id */ 3:
+                    message.scanRunId = reader.string();
+                    break;
+                default:
+                    let u = options.readUnknownField;
+                    if (u === "throw")
+                        throw new globalThis.Error(`Unknown field ${fieldNo} (wire type ${wireType}) for ${this.typeName}`);
+                    let d = reader.skip(wireType);
+                    if (u !== false)
+                        (u === true ? UnknownFieldHandler.onRead : u)(this.typeName, message, fieldNo, wireType, d);
+            }
+        }
+        return message;
+    }
+    internalBinaryWrite(message: ScanDomainResponse, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* string scan_id = 1; */
+        if (message.scanId !== "")
+            writer.tag(1, WireType.LengthDelimited).string(message.scanId);
+        /* service.DNSSecurityResult result = 2; */
+        if (message.result)
+            DNSSecurityResult.internalBinaryWrite(message.result, writer.tag(2, WireType.LengthDelimited).fork(), options).join();
+        /* string scan_run_id = 3; */
+        if (message.scanRunId !== "")
+            writer.tag(3, WireType.LengthDelimited).string(message.scanRunId);
+        let u = options.writeUnknownFields;
+        if (u !== false)
+            (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
+        return writer;
+    }
+}
+/**
+ * @generated MessageType for protobuf message service.ScanDomainResponse
+ */
+export const ScanDomainResponse = new ScanDomainResponse$Type();
+// @generated message type with reflection information, may provide speed optimized methods
+class GetDNSScanResultsByDomainRequest$Type extends MessageType<GetDNSScanResultsByDomainRequest> {
+    constructor() {
+        super("service.GetDNSScanResultsByDomainRequest", [
+            { no: 1, name: "domain", kind: "scalar", T: 9 /*ScalarType.STRING*/ }
+        ]);
+    }
+    create(value?: PartialMessage<GetDNSScanResultsByDomainRequest>): GetDNSScanResultsByDomainRequest {
+        const message = globalThis.Object.create((this.messagePrototype!));
+        message.domain = "";
+        if (value !== undefined)
+            reflectionMergePartial<GetDNSScanResultsByDomainRequest>(this, message, value);
+        return message;
+    }
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: GetDNSScanResultsByDomainRequest): GetDNSScanResultsByDomainRequest {
+        let message = target ?? this.create(), end = reader.pos + length;
+        while (reader.pos < end) {
+            let [fieldNo, wireType] = reader.tag();
+            switch (fieldNo) {
+                case /* string domain */ 1:
+                    message.domain = reader.string();
+                    break;
+                default:
+                    let u = options.readUnknownField;
+                    if (u === "throw")
+                        throw new globalThis.Error(`Unknown field ${fieldNo} (wire type ${wireType}) for ${this.typeName}`);
+                    let d = reader.skip(wireType);
+                    if (u !== false)
+                        (u === true ? UnknownFieldHandler.onRead : u)(this.typeName, message, fieldNo, wireType, d);
+            }
+        }
+        return message;
+    }
+    internalBinaryWrite(message: GetDNSScanResultsByDomainRequest, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* string domain = 1; */
+        if (message.domain !== "")
+            writer.tag(1, WireType.LengthDelimited).string(message.domain);
+        let u = options.writeUnknownFields;
+        if (u !== false)
+            (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
+        return writer;
+    }
+}
+/**
+ * @generated MessageType for protobuf message service.GetDNSScanResultsByDomainRequest
+ */
+export const GetDNSScanResultsByDomainRequest = new GetDNSScanResultsByDomainRequest$Type();
+// @generated message type with reflection information, may provide speed optimized methods
+class GetDNSScanResultsByDomainResponse$Type extends MessageType<GetDNSScanResultsByDomainResponse> {
+    constructor() {
+        super("service.GetDNSScanResultsByDomainResponse", [
+            { no: 1, name: "results", kind: "message", repeat: 2 /*RepeatType.UNPACKED*/, T: () => DNSScanResult }
+        ]);
+    }
+    create(value?: PartialMessage<GetDNSScanResultsByDomainResponse>): GetDNSScanResultsByDomainResponse {
+        const message = globalThis.Object.create((this.messagePrototype!));
+        message.results = [];
+        if (value !== undefined)
+            reflectionMergePartial<GetDNSScanResultsByDomainResponse>(this, message, value);
+        return message;
+    }
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: GetDNSScanResultsByDomainResponse): GetDNSScanResultsByDomainResponse {
+        let message = target ?? this.create(), end = reader.pos + length;
+        while (reader.pos < end) {
+            let [fieldNo, wireType] = reader.tag();
+            switch (fieldNo) {
+                case /* repeated service.DNSScanResult results */ 1:
+                    message.results.push(DNSScanResult.internalBinaryRead(reader, reader.uint32(), options));
+                    break;
+                default:
+                    let u = options.readUnknownField;
+                    if (u === "throw")
+                        throw new globalThis.Error(`Unknown field ${fieldNo} (wire type ${wireType}) for ${this.typeName}`);
+                    let d = reader.skip(wireType);
+                    if (u !== false)
+                        (u === true ? UnknownFieldHandler.onRead : u)(this.typeName, message, fieldNo, wireType, d);
+            }
+        }
+        return message;
+    }
+    internalBinaryWrite(message: GetDNSScanResultsByDomainResponse, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* repeated service.DNSScanResult results = 1; */
+        for (let i = 0; i < message.results.length; i++)
+            DNSScanResult.internalBinaryWrite(message.results[i], writer.tag(1, WireType.LengthDelimited).fork(), options).join();
+        let u = options.writeUnknownFields;
+        if (u !== false)
+            (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
+        return writer;
+    }
+}
+/**
+ * @generated MessageType for protobuf message service.GetDNSScanResultsByDomainResponse
+ */
+export const GetDNSScanResultsByDomainResponse = new GetDNSScanResultsByDomainResponse$Type();
+// @generated message type with reflection information, may provide speed optimized methods
+class GetDNSScanResultByIDRequest$Type extends MessageType<GetDNSScanResultByIDRequest> {
+    constructor() {
+        super("service.GetDNSScanResultByIDRequest", [
+            { no: 1, name: "dns_scan_id", kind: "scalar", T: 9 /*ScalarType.STRING*/ }
+        ]);
+    }
+    create(value?: PartialMessage<GetDNSScanResultByIDRequest>): GetDNSScanResultByIDRequest {
+        const message = globalThis.Object.create((this.messagePrototype!));
+        message.dnsScanId = "";
+        if (value !== undefined)
+            reflectionMergePartial<GetDNSScanResultByIDRequest>(this, message, value);
+        return message;
+    }
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: GetDNSScanResultByIDRequest): GetDNSScanResultByIDRequest {
+        let message = target ?? this.create(), end = reader.pos + length;
+        while (reader.pos < end) {
+            let [fieldNo, wireType] = reader.tag();
+            switch (fieldNo) {
+                case /* string dns_scan_id */ 1:
+                    message.dnsScanId = reader.string();
+                    break;
+                default:
+                    let u = options.readUnknownField;
+                    if (u === "throw")
+                        throw new globalThis.Error(`Unknown field ${fieldNo} (wire type ${wireType}) for ${this.typeName}`);
+                    let d = reader.skip(wireType);
+                    if (u !== false)
+                        (u === true ? UnknownFieldHandler.onRead : u)(this.typeName, message, fieldNo, wireType, d);
+            }
+        }
+        return message;
+    }
+    internalBinaryWrite(message: GetDNSScanResultByIDRequest, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* string dns_scan_id = 1; */
+        if (message.dnsScanId !== "")
+            writer.tag(1, WireType.LengthDelimited).string(message.dnsScanId);
+        let u = options.writeUnknownFields;
+        if (u !== false)
+            (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
+        return writer;
+    }
+}
+/**
+ * @generated MessageType for protobuf message service.GetDNSScanResultByIDRequest
+ */
+export const GetDNSScanResultByIDRequest = new GetDNSScanResultByIDRequest$Type();
+// @generated message type with reflection information, may provide speed optimized methods
+class GetDNSScanResultByIDResponse$Type extends MessageType<GetDNSScanResultByIDResponse> {
+    constructor() {
+        super("service.GetDNSScanResultByIDResponse", [
+            { no: 1, name: "result", kind: "message", T: () => DNSScanResult }
+        ]);
+    }
+    create(value?: PartialMessage<GetDNSScanResultByIDResponse>): GetDNSScanResultByIDResponse {
+        const message = globalThis.Object.create((this.messagePrototype!));
+        if (value !== undefined)
+            reflectionMergePartial<GetDNSScanResultByIDResponse>(this, message, value);
+        return message;
+    }
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: GetDNSScanResultByIDResponse): GetDNSScanResultByIDResponse {
+        let message = target ?? this.create(), end = reader.pos + length;
+        while (reader.pos < end) {
+            let [fieldNo, wireType] = reader.tag();
+            switch (fieldNo) {
+                case /* service.DNSScanResult result */ 1:
+                    message.result = DNSScanResult.internalBinaryRead(reader, reader.uint32(), options, message.result);
+                    break;
+                default:
+                    let u = options.readUnknownField;
+                    if (u === "throw")
+                        throw new globalThis.Error(`Unknown field ${fieldNo} (wire type ${wireType}) for ${this.typeName}`);
+                    let d = reader.skip(wireType);
+                    if (u !== false)
+                        (u === true ? UnknownFieldHandler.onRead : u)(this.typeName, message, fieldNo, wireType, d);
+            }
+        }
+        return message;
+    }
+    internalBinaryWrite(message: GetDNSScanResultByIDResponse, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* service.DNSScanResult result = 1; */
+        if (message.result)
+            DNSScanResult.internalBinaryWrite(message.result, writer.tag(1, WireType.LengthDelimited).fork(), options).join();
+        let u = options.writeUnknownFields;
+        if (u !== false)
+            (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
+        return writer;
+    }
+}
+/**
+ * @generated MessageType for protobuf message service.GetDNSScanResultByIDResponse
+ */
+export const GetDNSScanResultByIDResponse = new GetDNSScanResultByIDResponse$Type();
+// @generated message type with reflection information, may provide speed optimized methods
+class DNSScanResult$Type extends MessageType<DNSScanResult> {
+    constructor() {
+        super("service.DNSScanResult", [
+            { no: 1, name: "id", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 2, name: "domain", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 3, name: "dns_scan_id", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 4, name: "result", kind: "message", T: () => DNSSecurityResult },
+            { no: 5, name: "created_at", kind: "message", T: () => Timestamp }
+        ]);
+    }
+    create(value?: PartialMessage<DNSScanResult>): DNSScanResult {
+        const message = globalThis.Object.create((this.messagePrototype!));
+        message.id = "";
+        message.domain = "";
+        message.dnsScanId = "";
+        if (value !== undefined)
+            reflectionMergePartial<DNSScanResult>(this, message, value);
+        return message;
+    }
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: DNSScanResult): DNSScanResult {
+        let message = target ?? this.create(), end = reader.pos + length;
+        while (reader.pos < end) {
+            let [fieldNo, wireType] = reader.tag();
+            switch (fieldNo) {
+                case /* string id */ 1:
+                    message.id = reader.string();
+                    break;
+                case /* string domain */ 2:
+                    message.domain = reader.string();
+                    break;
+                case /* string dns_scan_id */ 3:
+                    message.dnsScanId = reader.string();
+                    break;
+                case /* service.DNSSecurityResult result */ 4:
+                    message.result = DNSSecurityResult.internalBinaryRead(reader, reader.uint32(), options, message.result);
+                    break;
+                case /* google.protobuf.Timestamp created_at */ 5:
+                    message.createdAt = Timestamp.internalBinaryRead(reader, reader.uint32(), options, message.createdAt);
+                    break;
+                default:
+                    let u = options.readUnknownField;
+                    if (u === "throw")
+                        throw new globalThis.Error(`Unknown field ${fieldNo} (wire type ${wireType}) for ${this.typeName}`);
+                    let d = reader.skip(wireType);
+                    if (u !== false)
+                        (u === true ? UnknownFieldHandler.onRead : u)(this.typeName, message, fieldNo, wireType, d);
+            }
+        }
+        return message;
+    }
+    internalBinaryWrite(message: DNSScanResult, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* string id = 1; */
+        if (message.id !== "")
+            writer.tag(1, WireType.LengthDelimited).string(message.id);
+        /* string domain = 2; */
+        if (message.domain !== "")
+            writer.tag(2, WireType.LengthDelimited).string(message.domain);
+        /* string dns_scan_id = 3; */
+        if (message.dnsScanId !== "")
+            writer.tag(3, WireType.LengthDelimited).string(message.dnsScanId);
+        /* service.DNSSecurityResult result = 4; */
+        if (message.result)
+            DNSSecurityResult.internalBinaryWrite(message.result, writer.tag(4, WireType.LengthDelimited).fork(), options).join();
+        /* google.protobuf.Timestamp created_at = 5; */
+        if (message.createdAt)
+            Timestamp.internalBinaryWrite(message.createdAt, writer.tag(5, WireType.LengthDelimited).fork(), options).join();
+        let u = options.writeUnknownFields;
+        if (u !== false)
+            (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
+        return writer;
+    }
+}
+/**
+ * @generated MessageType for protobuf message service.DNSScanResult
+ */
+export const DNSScanResult = new DNSScanResult$Type();
+// @generated message type with reflection information, may provide speed optimized methods
+class ScanTLSRequest$Type extends MessageType<ScanTLSRequest> {
+    constructor() {
+        super("service.ScanTLSRequest", [
+            { no: 1, name: "domain", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 2, name: "dns_scan_id", kind: "scalar", T: 9 /*ScalarType.STRING*/ }
+        ]);
+    }
+    create(value?: PartialMessage<ScanTLSRequest>): ScanTLSRequest {
+        const message = globalThis.Object.create((this.messagePrototype!));
+        message.domain = "";
+        message.dnsScanId = "";
+        if (value !== undefined)
+            reflectionMergePartial<ScanTLSRequest>(this, message, value);
+        return message;
+    }
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: ScanTLSRequest): ScanTLSRequest {
+        let message = target ?? this.create(), end = reader.pos + length;
+        while (reader.pos < end) {
+            let [fieldNo, wireType] = reader.tag();
+            switch (fieldNo) {
+                case /* string domain */ 1:
+                    message.domain = reader.string();
+                    break;
+                case /* string dns_scan_id */ 2:
+                    message.dnsScanId = reader.string();
+                    break;
+                default:
+                    let u = options.readUnknownField;
+                    if (u === "throw")
+                        throw new globalThis.Error(`Unknown field ${fieldNo} (wire type ${wireType}) for ${this.typeName}`);
+                    let d = reader.skip(wireType);
+                    if (u !== false)
+                        (u === true ? UnknownFieldHandler.onRead : u)(this.typeName, message, fieldNo, wireType, d);
+            }
+        }
+        return message;
+    }
+    internalBinaryWrite(message: ScanTLSRequest, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* string domain = 1; */
+        if (message.domain !== "")
+            writer.tag(1, WireType.LengthDelimited).string(message.domain);
+        /* string dns_scan_id = 2; */
+        if (message.dnsScanId !== "")
+            writer.tag(2, WireType.LengthDelimited).string(message.dnsScanId);
+        let u = options.writeUnknownFields;
+        if (u !== false)
+            (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
+        return writer;
+    }
+}
+/**
+ * @generated MessageType for protobuf message service.ScanTLSRequest
+ */
+export const ScanTLSRequest = new ScanTLSRequest$Type();
+// @generated message type with reflection information, may provide speed optimized methods
+class ScanTLSResponse$Type extends MessageType<ScanTLSResponse> {
+    constructor() {
+        super("service.ScanTLSResponse", [
+            { no: 1, name: "scan_id", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 2, name: "result", kind: "message", T: () => TLSSecurityResult },
+            { no: 3, name: "scan_run_id", kind: "scalar", T: 9 /*ScalarType.STRING*/ }
+        ]);
+    }
+    create(value?: PartialMessage<ScanTLSResponse>): ScanTLSResponse {
+        const message = globalThis.Object.create((this.messagePrototype!));
+        message.scanId = "";
+        message.scanRunId = "";
+        if (value !== undefined)
+            reflectionMergePartial<ScanTLSResponse>(this, message, value);
+        return message;
+    }
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: ScanTLSResponse): ScanTLSResponse {
+        let message = target ?? this.create(), end = reader.pos + length;
+        while (reader.pos < end) {
+            let [fieldNo, wireType] = reader.tag();
+            switch (fieldNo) {
+                case /* string scan_id */ 1:
+                    message.scanId = reader.string();
+                    break;
+                case /* service.TLSSecurityResult result */ 2:
+                    message.result = TLSSecurityResult.internalBinaryRead(reader, reader.uint32(), options, message.result);
+                    break;
+                case /* string scan_run_id */ 3:
+                    message.scanRunId = reader.string();
+                    break;
+                default:
+                    let u = options.readUnknownField;
+                    if (u === "throw")
+                        throw new globalThis.Error(`Unknown field ${fieldNo} (wire type ${wireType}) for ${this.typeName}`);
+                    let d = reader.skip(wireType);
+                    if (u !== false)
+                        (u === true ? UnknownFieldHandler.onRead : u)(this.typeName, message, fieldNo, wireType, d);
+            }
+        }
+        return message;
+    }
+    internalBinaryWrite(message: ScanTLSResponse, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* string scan_id = 1; */
+        if (message.scanId !== "")
+            writer.tag(1, WireType.LengthDelimited).string(message.scanId);
+        /* service.TLSSecurityResult result = 2; */
+        if (message.result)
+            TLSSecurityResult.internalBinaryWrite(message.result, writer.tag(2, WireType.LengthDelimited).fork(), options).join();
+        /* string scan_run_id = 3; */
+        if (message.scanRunId !== "")
+            writer.tag(3, WireType.LengthDelimited).string(message.scanRunId);
+        let u = options.writeUnknownFields;
+        if (u !== false)
+            (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
+        return writer;
+    }
+}
+/**
+ * @generated MessageType for protobuf message service.ScanTLSResponse
+ */
+export const ScanTLSResponse = new ScanTLSResponse$Type();
+// @generated message type with reflection information, may provide speed optimized methods
+class GetTLSScanResultsByDomainRequest$Type extends MessageType<GetTLSScanResultsByDomainRequest> {
+    constructor() {
+        super("service.GetTLSScanResultsByDomainRequest", [
+            { no: 1, name: "domain", kind: "scalar", T: 9 /*ScalarType.STRING*/ }
+        ]);
+    }
+    create(value?: PartialMessage<GetTLSScanResultsByDomainRequest>): GetTLSScanResultsByDomainRequest {
+        const message = globalThis.Object.create((this.messagePrototype!));
+        message.domain = "";
+        if (value !== undefined)
+            reflectionMergePartial<GetTLSScanResultsByDomainRequest>(this, message, value);
+        return message;
+    }
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: GetTLSScanResultsByDomainRequest): GetTLSScanResultsByDomainRequest {
+        let message = target ?? this.create(), end = reader.pos + length;
+        while (reader.pos < end) {
+            let [fieldNo, wireType] = reader.tag();
+            switch (fieldNo) {
+                case /* string domain */ 1:
+                    message.domain = reader.string();
+                    break;
+                default:
+                    let u = options.readUnknownField;
+                    if (u === "throw")
+                        throw new globalThis.Error(`Unknown field ${fieldNo} (wire type ${wireType}) for ${this.typeName}`);
+                    let d = reader.skip(wireType);
+                    if (u !== false)
+                        (u === true ? UnknownFieldHandler.onRead : u)(this.typeName, message, fieldNo, wireType, d);
+            }
+        }
+        return message;
+    }
+    internalBinaryWrite(message: GetTLSScanResultsByDomainRequest, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* string domain = 1; */
+        if (message.domain !== "")
+            writer.tag(1, WireType.LengthDelimited).string(message.domain);
+        let u = options.writeUnknownFields;
+        if (u !== false)
+            (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
+        return writer;
+    }
+}
+/**
+ * @generated MessageType for protobuf message service.GetTLSScanResultsByDomainRequest
+ */
+export const GetTLSScanResultsByDomainRequest = new GetTLSScanResultsByDomainRequest$Type();
+// @generated message type with reflection information, may provide speed optimized methods
+class GetTLSScanResultsByDomainResponse$Type extends MessageType<GetTLSScanResultsByDomainResponse> {
+    constructor() {
+        super("service.GetTLSScanResultsByDomainResponse", [
+            { no: 1, name: "results", kind: "message", repeat: 2 /*RepeatType.UNPACKED*/, T: () => TLSScanResult }
+        ]);
+    }
+    create(value?: PartialMessage<GetTLSScanResultsByDomainResponse>): GetTLSScanResultsByDomainResponse {
+        const message = globalThis.Object.create((this.messagePrototype!));
+        message.results = [];
+        if (value !== undefined)
+            reflectionMergePartial<GetTLSScanResultsByDomainResponse>(this, message, value);
+        return message;
+    }
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: GetTLSScanResultsByDomainResponse): GetTLSScanResultsByDomainResponse {
+        let message = target ?? this.create(), end = reader.pos + length;
+        while (reader.pos < end) {
+            let [fieldNo, wireType] = reader.tag();
+            switch (fieldNo) {
+                case /* repeated service.TLSScanResult results */ 1:
+                    message.results.push(TLSScanResult.internalBinaryRead(reader, reader.uint32(), options));
+                    break;
+                default:
+                    let u = options.readUnknownField;
+                    if (u === "throw")
+                        throw new globalThis.Error(`Unknown field ${fieldNo} (wire type ${wireType}) for ${this.typeName}`);
+                    let d = reader.skip(wireType);
+                    if (u !== false)
+                        (u === true ? UnknownFieldHandler.onRead : u)(this.typeName, message, fieldNo, wireType, d);
+            }
+        }
+        return message;
+    }
+    internalBinaryWrite(message: GetTLSScanResultsByDomainResponse, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* repeated service.TLSScanResult results = 1; */
+        for (let i = 0; i < message.results.length; i++)
+            TLSScanResult.internalBinaryWrite(message.results[i], writer.tag(1, WireType.LengthDelimited).fork(), options).join();
+        let u = options.writeUnknownFields;
+        if (u !== false)
+            (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
+        return writer;
+    }
+}
+/**
+ * @generated MessageType for protobuf message service.GetTLSScanResultsByDomainResponse
+ */
+export const GetTLSScanResultsByDomainResponse = new GetTLSScanResultsByDomainResponse$Type();
+// @generated message type with reflection information, may provide speed optimized methods
+class TLSScanResult$Type extends MessageType<TLSScanResult> {
+    constructor() {
+        super("service.TLSScanResult", [
+            { no: 1, name: "id", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 2, name: "domain", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 3, name: "dns_scan_id", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 4, name: "result", kind: "message", T: () => TLSSecurityResult },
+            { no: 5, name: "created_at", kind: "message", T: () => Timestamp }
+        ]);
+    }
+    create(value?: PartialMessage<TLSScanResult>): TLSScanResult {
+        const message = globalThis.Object.create((this.messagePrototype!));
+        message.id = "";
+        message.domain = "";
+        message.dnsScanId = "";
+        if (value !== undefined)
+            reflectionMergePartial<TLSScanResult>(this, message, value);
+        return message;
+    }
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: TLSScanResult): TLSScanResult {
+        let message = target ?? this.create(), end = reader.pos + length;
+        while (reader.pos < end) {
+            let [fieldNo, wireType] = reader.tag();
+            switch (fieldNo) {
+                case /* string id */ 1:
+                    message.id = reader.string();
+                    break;
+                case /* string domain */ 2:
+                    message.domain = reader.string();
+                    break;
+                case /* string dns_scan_id */ 3:
+                    message.dnsScanId = reader.string();
+                    break;
+                case /* service.TLSSecurityResult result */ 4:
+                    message.result = TLSSecurityResult.internalBinaryRead(reader, reader.uint32(), options, message.result);
+                    break;
+                case /* google.protobuf.Timestamp created_at */ 5:
+                    message.createdAt = Timestamp.internalBinaryRead(reader, reader.uint32(), options, message.createdAt);
+                    break;
+                default:
+                    let u = options.readUnknownField;
+                    if (u === "throw")
+                        throw new globalThis.Error(`Unknown field ${fieldNo} (wire type ${wireType}) for ${this.typeName}`);
+                    let d = reader.skip(wireType);
+                    if (u !== false)
+                        (u === true ? UnknownFieldHandler.onRead : u)(this.typeName, message, fieldNo, wireType, d);
+            }
+        }
+        return message;
+    }
+    internalBinaryWrite(message: TLSScanResult, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* string id = 1; */
+        if (message.id !== "")
+            writer.tag(1, WireType.LengthDelimited).string(message.id);
+        /* string domain = 2; */
+        if (message.domain !== "")
+            writer.tag(2, WireType.LengthDelimited).string(message.domain);
+        /* string dns_scan_id = 3; */
+        if (message.dnsScanId !== "")
+            writer.tag(3, WireType.LengthDelimited).string(message.dnsScanId);
+        /* service.TLSSecurityResult result = 4; */
+        if (message.result)
+            TLSSecurityResult.internalBinaryWrite(message.result, writer.tag(4, WireType.LengthDelimited).fork(), options).join();
+        /* google.protobuf.Timestamp created_at = 5; */
+        if (message.createdAt)
+            Timestamp.internalBinaryWrite(message.createdAt, writer.tag(5, WireType.LengthDelimited).fork(), options).join();
+        let u = options.writeUnknownFields;
+        if (u !== false)
+            (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
+        return writer;
+    }
+}
+/**
+ * @generated MessageType for protobuf message service.TLSScanResult
+ */
+export const TLSScanResult = new TLSScanResult$Type();
+// @generated message type with reflection information, may provide speed optimized methods
+class ScanCrtShRequest$Type extends MessageType<ScanCrtShRequest> {
+    constructor() {
+        super("service.ScanCrtShRequest", [
+            { no: 1, name: "domain", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 2, name: "dns_scan_id", kind: "scalar", T: 9 /*ScalarType.STRING*/ }
+        ]);
+    }
+    create(value?: PartialMessage<ScanCrtShRequest>): ScanCrtShRequest {
+        const message = globalThis.Object.create((this.messagePrototype!));
+        message.domain = "";
+        message.dnsScanId = "";
+        if (value !== undefined)
+            reflectionMergePartial<ScanCrtShRequest>(this, message, value);
+        return message;
+    }
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: ScanCrtShRequest): ScanCrtShRequest {
+        let message = target ?? this.create(), end = reader.pos + length;
+        while (reader.pos < end) {
+            let [fieldNo, wireType] = reader.tag();
+            switch (fieldNo) {
+                case /* string domain */ 1:
+                    message.domain = reader.string();
+                    break;
+                case /* string dns_scan_id */ 2:
+                    message.dnsScanId = reader.string();
+                    break;
+                default:
+                    let u = options.readUnknownField;
+                    if (u === "throw")
+                        throw new globalThis.Error(`Unknown field ${fieldNo} (wire type ${wireType}) for ${this.typeName}`);
+                    let d = reader.skip(wireType);
+                    if (u !== false)
+                        (u === true ? UnknownFieldHandler.onRead : u)(this.typeName, message, fieldNo, wireType, d);
+            }
+        }
+        return message;
+    }
+    internalBinaryWrite(message: ScanCrtShRequest, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* string domain = 1; */
+        if (message.domain !== "")
+            writer.tag(1, WireType.LengthDelimited).string(message.domain);
+        /* string dns_scan_id = 2; */
+        if (message.dnsScanId !== "")
+            writer.tag(2, WireType.LengthDelimited).string(message.dnsScanId);
+        let u = options.writeUnknownFields;
+        if (u !== false)
+            (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
+        return writer;
+    }
+}
+/**
+ * @generated MessageType for protobuf message service.ScanCrtShRequest
+ */
+export const ScanCrtShRequest = new ScanCrtShRequest$Type();
+// @generated message type with reflection information, may provide speed optimized methods
+class ScanCrtShResponse$Type extends MessageType<ScanCrtShResponse> {
+    constructor() {
+        super("service.ScanCrtShResponse", [
+            { no: 1, name: "scan_id", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 2, name: "result", kind: "message", T: () => CrtShSecurityResult },
+            { no: 3, name: "scan_run_id", kind: "scalar", T: 9 /*ScalarType.STRING*/ }
+        ]);
+    }
+    create(value?: PartialMessage<ScanCrtShResponse>): ScanCrtShResponse {
+        const message = globalThis.Object.create((this.messagePrototype!));
+        message.scanId = "";
+        message.scanRunId = "";
+        if (value !== undefined)
+            reflectionMergePartial<ScanCrtShResponse>(this, message, value);
+        return message;
+    }
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: ScanCrtShResponse): ScanCrtShResponse {
+        let message = target ?? this.create(), end = reader.pos + length;
+        while (reader.pos < end) {
+            let [fieldNo, wireType] = reader.tag();
+            switch (fieldNo) {
+                case /* string scan_id */ 1:
+                    message.scanId = reader.string();
+                    break;
+                case /* service.CrtShSecurityResult result */ 2:
+                    message.result = CrtShSecurityResult.internalBinaryRead(reader, reader.uint32(), options, message.result);
+                    break;
+                case /* string scan_run_id */ 3:
+                    message.scanRunId = reader.string();
+                    break;
+                default:
+                    let u = options.readUnknownField;
+                    if (u === "throw")
+                        throw new globalThis.Error(`Unknown field ${fieldNo} (wire type ${wireType}) for ${this.typeName}`);
+                    let d = reader.skip(wireType);
+                    if (u !== false)
+                        (u === true ? UnknownFieldHandler.onRead : u)(this.typeName, message, fieldNo, wireType, d);
+            }
+        }
+        return message;
+    }
+    internalBinaryWrite(message: ScanCrtShResponse, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* string scan_id = 1; */
+        if (message.scanId !== "")
+            writer.tag(1, WireType.LengthDelimited).string(message.scanId);
+        /* service.CrtShSecurityResult result = 2; */
+        if (message.result)
+            CrtShSecurityResult.internalBinaryWrite(message.result, writer.tag(2, WireType.LengthDelimited).fork(), options).join();
+        /* string scan_run_id = 3; */
+        if (message.scanRunId !== "")
+            writer.tag(3, WireType.LengthDelimited).string(message.scanRunId);
+        let u = options.writeUnknownFields;
+        if (u !== false)
+            (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
+        return writer;
+    }
+}
+/**
+ * @generated MessageType for protobuf message service.ScanCrtShResponse
+ */
+export const ScanCrtShResponse = new ScanCrtShResponse$Type();
+// @generated message type with reflection information, may provide speed optimized methods
+class GetCrtShScanResultsByDomainRequest$Type extends MessageType<GetCrtShScanResultsByDomainRequest> {
+    constructor() {
+        super("service.GetCrtShScanResultsByDomainRequest", [
+            { no: 1, name: "domain", kind: "scalar", T: 9 /*ScalarType.STRING*/ }
+        ]);
+    }
+    create(value?: PartialMessage<GetCrtShScanResultsByDomainRequest>): GetCrtShScanResultsByDomainRequest {
+        const message = globalThis.Object.create((this.messagePrototype!));
+        message.domain = "";
+        if (value !== undefined)
+            reflectionMergePartial<GetCrtShScanResultsByDomainRequest>(this, message, value);
+        return message;
+    }
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: GetCrtShScanResultsByDomainRequest): GetCrtShScanResultsByDomainRequest {
+        let message = target ?? this.create(), end = reader.pos + length;
+        while (reader.pos < end) {
+            let [fieldNo, wireType] = reader.tag();
+            switch (fieldNo) {
+                case /* string domain */ 1:
+                    message.domain = reader.string();
+                    break;
+                default:
+                    let u = options.readUnknownField;
+                    if (u === "throw")
+                        throw new globalThis.Error(`Unknown field ${fieldNo} (wire type ${wireType}) for ${this.typeName}`);
+                    let d = reader.skip(wireType);
+                    if (u !== false)
+                        (u === true ? UnknownFieldHandler.onRead : u)(this.typeName, message, fieldNo, wireType, d);
+            }
+        }
+        return message;
+    }
+    internalBinaryWrite(message: GetCrtShScanResultsByDomainRequest, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* string domain = 1; */
+        if (message.domain !== "")
+            writer.tag(1, WireType.LengthDelimited).string(message.domain);
+        let u = options.writeUnknownFields;
+        if (u !== false)
+            (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
+        return writer;
+    }
+}
+/**
+ * @generated MessageType for protobuf message service.GetCrtShScanResultsByDomainRequest
+ */
+export const GetCrtShScanResultsByDomainRequest = new GetCrtShScanResultsByDomainRequest$Type();
+// @generated message type with reflection information, may provide speed optimized methods
+class GetCrtShScanResultsByDomainResponse$Type extends MessageType<GetCrtShScanResultsByDomainResponse> {
+    constructor() {
+        super("service.GetCrtShScanResultsByDomainResponse", [
+            { no: 1, name: "results", kind: "message", repeat: 2 /*RepeatType.UNPACKED*/, T: () => CrtShScanResult }
+        ]);
+    }
+    create(value?: PartialMessage<GetCrtShScanResultsByDomainResponse>): GetCrtShScanResultsByDomainResponse {
+        const message = globalThis.Object.create((this.messagePrototype!));
+        message.results = [];
+        if (value !== undefined)
+            reflectionMergePartial<GetCrtShScanResultsByDomainResponse>(this, message, value);
+        return message;
+    }
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: GetCrtShScanResultsByDomainResponse): GetCrtShScanResultsByDomainResponse {
+        let message = target ?? this.create(), end = reader.pos + length;
+        while (reader.pos < end) {
+            let [fieldNo, wireType] = reader.tag();
+            switch (fieldNo) {
+                case /* repeated service.CrtShScanResult results */ 1:
+                    message.results.push(CrtShScanResult.internalBinaryRead(reader, reader.uint32(), options));
+                    break;
+                default:
+                    let u = options.readUnknownField;
+                    if (u === "throw")
+                        throw new globalThis.Error(`Unknown field ${fieldNo} (wire type ${wireType}) for ${this.typeName}`);
+                    let d = reader.skip(wireType);
+                    if (u !== false)
+                        (u === true ? UnknownFieldHandler.onRead : u)(this.typeName, message, fieldNo, wireType, d);
+            }
+        }
+        return message;
+    }
+    internalBinaryWrite(message: GetCrtShScanResultsByDomainResponse, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* repeated service.CrtShScanResult results = 1; */
+        for (let i = 0; i < message.results.length; i++)
+            CrtShScanResult.internalBinaryWrite(message.results[i], writer.tag(1, WireType.LengthDelimited).fork(), options).join();
+        let u = options.writeUnknownFields;
+        if (u !== false)
+            (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
+        return writer;
+    }
+}
+/**
+ * @generated MessageType for protobuf message service.GetCrtShScanResultsByDomainResponse
+ */
+export const GetCrtShScanResultsByDomainResponse = new GetCrtShScanResultsByDomainResponse$Type();
+// @generated message type with reflection information, may provide speed optimized methods
+class CrtShScanResult$Type extends MessageType<CrtShScanResult> {
+    constructor() {
+        super("service.CrtShScanResult", [
+            { no: 1, name: "id", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 2, name: "domain", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 3, name: "dns_scan_id", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 4, name: "result", kind: "message", T: () => CrtShSecurityResult },
+            { no: 5, name: "created_at", kind: "message", T: () => Timestamp }
+        ]);
+    }
+    create(value?: PartialMessage<CrtShScanResult>): CrtShScanResult {
+        const message = globalThis.Object.create((this.messagePrototype!));
+        message.id = "";
+        message.domain = "";
+        message.dnsScanId = "";
+        if (value !== undefined)
+            reflectionMergePartial<CrtShScanResult>(this, message, value);
+        return message;
+    }
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: CrtShScanResult): CrtShScanResult {
+        let message = target ?? this.create(), end = reader.pos + length;
+        while (reader.pos < end) {
+            let [fieldNo, wireType] = reader.tag();
+            switch (fieldNo) {
+                case /* string id */ 1:
+                    message.id = reader.string();
+                    break;
+                case /* string domain */ 2:
+                    message.domain = reader.string();
+                    break;
+                case /* string dns_scan_id */ 3:
+                    message.dnsScanId = reader.string();
+                    break;
+                case /* service.CrtShSecurityResult result */ 4:
+                    message.result = CrtShSecurityResult.internalBinaryRead(reader, reader.uint32(), options, message.result);
+                    break;
+                case /* google.protobuf.Timestamp created_at */ 5:
+                    message.createdAt = Timestamp.internalBinaryRead(reader, reader.uint32(), options, message.createdAt);
+                    break;
+                default:
+                    let u = options.readUnknownField;
+                    if (u === "throw")
+                        throw new globalThis.Error(`Unknown field ${fieldNo} (wire type ${wireType}) for ${this.typeName}`);
+                    let d = reader.skip(wireType);
+                    if (u !== false)
+                        (u === true ? UnknownFieldHandler.onRead : u)(this.typeName, message, fieldNo, wireType, d);
+            }
+        }
+        return message;
+    }
+    internalBinaryWrite(message: CrtShScanResult, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* string id = 1; */
+        if (message.id !== "")
+            writer.tag(1, WireType.LengthDelimited).string(message.id);
+        /* string domain = 2; */
+        if (message.domain !== "")
+            writer.tag(2, WireType.LengthDelimited).string(message.domain);
+        /* string dns_scan_id = 3; */
+        if (message.dnsScanId !== "")
+            writer.tag(3, WireType.LengthDelimited).string(message.dnsScanId);
+        /* service.CrtShSecurityResult result = 4; */
+        if (message.result)
+            CrtShSecurityResult.internalBinaryWrite(message.result, writer.tag(4, WireType.LengthDelimited).fork(), options).join();
+        /* google.protobuf.Timestamp created_at = 5; */
+        if (message.createdAt)
+            Timestamp.internalBinaryWrite(message.createdAt, writer.tag(5, WireType.LengthDelimited).fork(), options).join();
+        let u = options.writeUnknownFields;
+        if (u !== false)
+            (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
+        return writer;
+    }
+}
+/**
+ * @generated MessageType for protobuf message service.CrtShScanResult
+ */
+export const CrtShScanResult = new CrtShScanResult$Type();
+// @generated message type with reflection information, may provide speed optimized methods
+class ScanChaosRequest$Type extends MessageType<ScanChaosRequest> {
+    constructor() {
+        super("service.ScanChaosRequest", [
+            { no: 1, name: "domain", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 2, name: "dns_scan_id", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 3, name: "refresh", kind: "scalar", T: 8 /*ScalarType.BOOL*/ }
+        ]);
+    }
+    create(value?: PartialMessage<ScanChaosRequest>): ScanChaosRequest {
+        const message = globalThis.Object.create((this.messagePrototype!));
+        message.domain = "";
+        message.dnsScanId = "";
+        message.refresh = false;
+        if (value !== undefined)
+            reflectionMergePartial<ScanChaosRequest>(this, message, value);
+        return message;
+    }
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: ScanChaosRequest): ScanChaosRequest {
+        let message = target ?? this.create(), end = reader.pos + length;
+        while (reader.pos < end) {
+            let [fieldNo, wireType] = reader.tag();
+            switch (fieldNo) {
+                case /* string domain */ 1:
+                    message.domain = reader.string();
+                    break;
+                case /* string dns_scan_id */ 2:
+                    message.dnsScanId = reader.string();
+                    break;
+                case /* bool refresh */ 3:
+                    message.refresh = reader.bool();
+                    break;
+                default:
+                    let u = options.readUnknownField;
+                    if (u === "throw")
+                        throw new globalThis.Error(`Unknown field ${fieldNo} (wire type ${wireType}) for ${this.typeName}`);
+                    let d = reader.skip(wireType);
+                    if (u !== false)
+                        (u === true ? UnknownFieldHandler.onRead : u)(this.typeName, message, fieldNo, wireType, d);
+            }
+        }
+        return message;
+    }
+    internalBinaryWrite(message: ScanChaosRequest, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* string domain = 1; */
+        if (message.domain !== "")
+            writer.tag(1, WireType.LengthDelimited).string(message.domain);
+        /* string dns_scan_id = 2; */
+        if (message.dnsScanId !== "")
+            writer.tag(2, WireType.LengthDelimited).string(message.dnsScanId);
+        /* bool refresh = 3; */
+        if (message.refresh !== false)
+            writer.tag(3, WireType.Varint).bool(message.refresh);
+        let u = options.writeUnknownFields;
+        if (u !== false)
+            (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
+        return writer;
+    }
+}
+/**
+ * @generated MessageType for protobuf message service.ScanChaosRequest
+ */
+export const ScanChaosRequest = new ScanChaosRequest$Type();
+// @generated message type with reflection information, may provide speed optimized methods
+class ScanChaosResponse$Type extends MessageType<ScanChaosResponse> {
+    constructor() {
+        super("service.ScanChaosResponse", [
+            { no: 1, name: "scan_id", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 2, name: "result", kind: "message", T: () => ChaosSecurityResult },
+            { no: 3, name: "scan_run_id", kind: "scalar", T: 9 /*ScalarType.STRING*/ }
+        ]);
+    }
+    create(value?: PartialMessage<ScanChaosResponse>): ScanChaosResponse {
+        const message = globalThis.Object.create((this.messagePrototype!));
+        message.scanId = "";
+        message.scanRunId = "";
+        if (value !== undefined)
+            reflectionMergePartial<ScanChaosResponse>(this, message, value);
+        return message;
+    }
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: ScanChaosResponse): ScanChaosResponse {
+        let message = target ?? this.create(), end = reader.pos + length;
+        while (reader.pos < end) {
+            let [fieldNo, wireType] = reader.tag();
+            switch (fieldNo) {
+                case /* string scan_id */ 1:
+                    message.scanId = reader.string();
+                    break;
+                case /* service.ChaosSecurityResult result */ 2:
+                    message.result = ChaosSecurityResult.internalBinaryRead(reader, reader.uint32(), options, message.result);
+                    break;
+                case /* string scan_run_id */ 3:
+                    message.scanRunId = reader.string();
+                    break;
+                default:
+                    let u = options.readUnknownField;
+                    if (u === "throw")
+                        throw new globalThis.Error(`Unknown field ${fieldNo} (wire type ${wireType}) for ${this.typeName}`);
+                    let d = reader.skip(wireType);
+                    if (u !== false)
+                        (u === true ? UnknownFieldHandler.onRead : u)(this.typeName, message, fieldNo, wireType, d);
+            }
+        }
+        return message;
+    }
+    internalBinaryWrite(message: ScanChaosResponse, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* string scan_id = 1; */
+        if (message.scanId !== "")
+            writer.tag(1, WireType.LengthDelimited).string(message.scanId);
+        /* service.ChaosSecurityResult result = 2; */
+        if (message.result)
+            ChaosSecurityResult.internalBinaryWrite(message.result, writer.tag(2, WireType.LengthDelimited).fork(), options).join();
+        /* string scan_run_id = 3; */
+        if (message.scanRunId !== "")
+            writer.tag(3, WireType.LengthDelimited).string(message.scanRunId);
+        let u = options.writeUnknownFields;
+        if (u !== false)
+            (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
+        return writer;
+    }
+}
+/**
+ * @generated MessageType for protobuf message service.ScanChaosResponse
+ */
+export const ScanChaosResponse = new ScanChaosResponse$Type();
+// @generated message type with reflection information, may provide speed optimized methods
+class GetChaosScanResultsByDomainRequest$Type extends MessageType<GetChaosScanResultsByDomainRequest> {
+    constructor() {
+        super("service.GetChaosScanResultsByDomainRequest", [
+            { no: 1, name: "domain", kind: "scalar", T: 9 /*ScalarType.STRING*/ }
+        ]);
+    }
+    create(value?: PartialMessage<GetChaosScanResultsByDomainRequest>): GetChaosScanResultsByDomainRequest {
+        const message = globalThis.Object.create((this.messagePrototype!));
+        message.domain = "";
+        if (value !== undefined)
+            reflectionMergePartial<GetChaosScanResultsByDomainRequest>(this, message, value);
+        return message;
+    }
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: GetChaosScanResultsByDomainRequest): GetChaosScanResultsByDomainRequest {
+        let message = target ?? this.create(), end = reader.pos + length;
+        while (reader.pos < end) {
+            let [fieldNo, wireType] = reader.tag();
+            switch (fieldNo) {
+                case /* string domain */ 1:
+                    message.domain = reader.string();
+                    break;
+                default:
+                    let u = options.readUnknownField;
+                    if (u === "throw")
+                        throw new globalThis.Error(`Unknown field ${fieldNo} (wire type ${wireType}) for ${this.typeName}`);
+                    let d = reader.skip(wireType);
+                    if (u !== false)
+                        (u === true ? UnknownFieldHandler.onRead : u)(this.typeName, message, fieldNo, wireType, d);
+            }
+        }
+        return message;
+    }
+    internalBinaryWrite(message: GetChaosScanResultsByDomainRequest, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* string domain = 1; */
+        if (message.domain !== "")
+            writer.tag(1, WireType.LengthDelimited).string(message.domain);
+        let u = options.writeUnknownFields;
+        if (u !== false)
+            (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
+        return writer;
+    }
+}
+/**
+ * @generated MessageType for protobuf message service.GetChaosScanResultsByDomainRequest
+ */
+export const GetChaosScanResultsByDomainRequest = new GetChaosScanResultsByDomainRequest$Type();
+// @generated message type with reflection information, may provide speed optimized methods
+class GetChaosScanResultsByDomainResponse$Type extends MessageType<GetChaosScanResultsByDomainResponse> {
+    constructor() {
+        super("service.GetChaosScanResultsByDomainResponse", [
+            { no: 1, name: "results", kind: "message", repeat: 2 /*RepeatType.UNPACKED*/, T: () => ChaosScanResult }
+        ]);
+    }
+    create(value?: PartialMessage<GetChaosScanResultsByDomainResponse>): GetChaosScanResultsByDomainResponse {
+        const message = globalThis.Object.create((this.messagePrototype!));
+        message.results = [];
+        if (value !== undefined)
+            reflectionMergePartial<GetChaosScanResultsByDomainResponse>(this, message, value);
+        return message;
+    }
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: GetChaosScanResultsByDomainResponse): GetChaosScanResultsByDomainResponse {
+        let message = target ?? this.create(), end = reader.pos + length;
+        while (reader.pos < end) {
+            let [fieldNo, wireType] = reader.tag();
+            switch (fieldNo) {
+                case /* repeated service.ChaosScanResult results */ 1:
+                    message.results.push(ChaosScanResult.internalBinaryRead(reader, reader.uint32(), options));
+                    break;
+                default:
+                    let u = options.readUnknownField;
+                    if (u === "throw")
+                        throw new globalThis.Error(`Unknown field ${fieldNo} (wire type ${wireType}) for ${this.typeName}`);
+                    let d = reader.skip(wireType);
+                    if (u !== false)
+                        (u === true ? UnknownFieldHandler.onRead : u)(this.typeName, message, fieldNo, wireType, d);
+            }
+        }
+        return message;
+    }
+    internalBinaryWrite(message: GetChaosScanResultsByDomainResponse, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* repeated service.ChaosScanResult results = 1; */
+        for (let i = 0; i < message.results.length; i++)
+            ChaosScanResult.internalBinaryWrite(message.results[i], writer.tag(1, WireType.LengthDelimited).fork(), options).join();
+        let u = options.writeUnknownFields;
+        if (u !== false)
+            (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
+        return writer;
+    }
+}
+/**
+ * @generated MessageType for protobuf message service.GetChaosScanResultsByDomainResponse
+ */
+export const GetChaosScanResultsByDomainResponse = new GetChaosScanResultsByDomainResponse$Type();
+// @generated message type with reflection information, may provide speed optimized methods
+class ChaosScanResult$Type extends MessageType<ChaosScanResult> {
+    constructor() {
+        super("service.ChaosScanResult", [
+            { no: 1, name: "id", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 2, name: "domain", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 3, name: "dns_scan_id", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 4, name: "result", kind: "message", T: () => ChaosSecurityResult },
+            { no: 5, name: "created_at", kind: "message", T: () => Timestamp }
+        ]);
+    }
+    create(value?: PartialMessage<ChaosScanResult>): ChaosScanResult {
+        const message = globalThis.Object.create((this.messagePrototype!));
+        message.id = "";
+        message.domain = "";
+        message.dnsScanId = "";
+        if (value !== undefined)
+            reflectionMergePartial<ChaosScanResult>(this, message, value);
+        return message;
+    }
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: ChaosScanResult): ChaosScanResult {
+        let message = target ?? this.create(), end = reader.pos + length;
+        while (reader.pos < end) {
             let [fieldNo, wireType] = reader.tag();
             switch (fieldNo) {
-                case /* string domain */ 1:
+                case /* string id */ 1:
+                    message.id = reader.string();
+                    break;
+                case /* string domain */ 2:
                     message.domain = reader.string();
                     break;
+                case /* string dns_scan_id */ 3:
+                    message.dnsScanId = reader.string();
+                    break;
+                case /* service.ChaosSecurityResult result */ 4:
+                    message.result = ChaosSecurityResult.internalBinaryRead(reader, reader.uint32(), options, message.result);
+                    break;
+                case /* google.protobuf.Timestamp created_at */ 5:
+                    message.createdAt = Timestamp.internalBinaryRead(reader, reader.uint32(), options, message.createdAt);
+                    break;
                 default:
                     let u = options.readUnknownField;
                     if (u === "throw")
@@ -1753,10 +6994,22 @@ class GenerateReportRequest$Type extends MessageType<GenerateReportRequest> {
         }
         return message;
     }
-    internalBinaryWrite(message: GenerateReportRequest, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
-        /* string domain = 1; */
+    internalBinaryWrite(message: ChaosScanResult, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* string id = 1; */
+        if (message.id !== "")
+            writer.tag(1, WireType.LengthDelimited).string(message.id);
+        /* string domain = 2; */
         if (message.domain !== "")
-            writer.tag(1, WireType.LengthDelimited).string(message.domain);
+            writer.tag(2, WireType.LengthDelimited).string(message.domain);
+        /* string dns_scan_id = 3; */
+        if (message.dnsScanId !== "")
+            writer.tag(3, WireType.LengthDelimited).string(message.dnsScanId);
+        /* service.ChaosSecurityResult result = 4; */
+        if (message.result)
+            ChaosSecurityResult.internalBinaryWrite(message.result, writer.tag(4, WireType.LengthDelimited).fork(), options).join();
+        /* google.protobuf.Timestamp created_at = 5; */
+        if (message.createdAt)
+            Timestamp.internalBinaryWrite(message.createdAt, writer.tag(5, WireType.LengthDelimited).fork(), options).join();
         let u = options.writeUnknownFields;
         if (u !== false)
             (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
@@ -1764,49 +7017,40 @@ class GenerateReportRequest$Type extends MessageType<GenerateReportRequest> {
     }
 }
 /**
- * @generated MessageType for protobuf message service.GenerateReportRequest
+ * @generated MessageType for protobuf message service.ChaosScanResult
  */
-export const GenerateReportRequest = new GenerateReportRequest$Type();
+export const ChaosScanResult = new ChaosScanResult$Type();
 // @generated message type with reflection information, may provide speed optimized methods
-class GenerateReportResponse$Type extends MessageType<GenerateReportResponse> {
+class ScanShodanRequest$Type extends MessageType<ScanShodanRequest> {
     constructor() {
-        super("service.GenerateReportResponse", [
-            { no: 1, name: "report_id", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+        super("service.ScanShodanRequest", [
+            { no: 1, name: "domain", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
             { no: 2, name: "dns_scan_id", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
-            { no: 3, name: "score", kind: "scalar", T: 5 /*ScalarType.INT32*/ },
-            { no: 4, name: "risk_tier", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
-            { no: 5, name: "created_at", kind: "message", T: () => Timestamp }
+            { no: 3, name: "refresh", kind: "scalar", T: 8 /*ScalarType.BOOL*/ }
         ]);
     }
-    create(value?: PartialMessage<GenerateReportResponse>): GenerateReportResponse {
+    create(value?: PartialMessage<ScanShodanRequest>): ScanShodanRequest {
         const message = globalThis.Object.create((this.messagePrototype!));
-        message.reportId = "";
+        message.domain = "";
         message.dnsScanId = "";
-        message.score = 0;
-        message.riskTier = "";
+        message.refresh = false;
         if (value !== undefined)
-            reflectionMergePartial<GenerateReportResponse>(this, message, value);
+            reflectionMergePartial<ScanShodanRequest>(this, message, value);
         return message;
     }
-    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: GenerateReportResponse): GenerateReportResponse {
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: ScanShodanRequest): ScanShodanRequest {
         let message = target ?? this.create(), end = reader.pos + length;
         while (reader.pos < end) {
             let [fieldNo, wireType] = reader.tag();
             switch (fieldNo) {
-                case /* string report_id */ 1:
-                    message.reportId = reader.string();
+                case /* string domain */ 1:
+                    message.domain = reader.string();
                     break;
                 case /* string dns_scan_id */ 2:
                     message.dnsScanId = reader.string();
                     break;
-                case /* int32 score */ 3:
-                    message.score = reader.int32();
-                    break;
-                case /* string risk_tier */ 4:
-                    message.riskTier = reader.string();
-                    break;
-                case /* google.protobuf.Timestamp created_at */ 5:
-                    message.createdAt = Timestamp.internalBinaryRead(reader, reader.uint32(), options, message.createdAt);
+                case /* bool refresh */ 3:
+                    message.refresh = reader.bool();
                     break;
                 default:
                     let u = options.readUnknownField;
@@ -1819,22 +7063,16 @@ class GenerateReportResponse$Type extends MessageType<GenerateReportResponse> {
         }
         return message;
     }
-    internalBinaryWrite(message: GenerateReportResponse, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
-        /* string report_id = 1; */
-        if (message.reportId !== "")
-            writer.tag(1, WireType.LengthDelimited).string(message.reportId);
+    internalBinaryWrite(message: ScanShodanRequest, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* string domain = 1; */
+        if (message.domain !== "")
+            writer.tag(1, WireType.LengthDelimited).string(message.domain);
         /* string dns_scan_id = 2; */
         if (message.dnsScanId !== "")
             writer.tag(2, WireType.LengthDelimited).string(message.dnsScanId);
-        /* int32 score = 3; */
-        if (message.score !== 0)
-            writer.tag(3, WireType.Varint).int32(message.score);
-        /* string risk_tier = 4; */
-        if (message.riskTier !== "")
-            writer.tag(4, WireType.LengthDelimited).string(message.riskTier);
-        /* google.protobuf.Timestamp created_at = 5; */
-        if (message.createdAt)
-            Timestamp.internalBinaryWrite(message.createdAt, writer.tag(5, WireType.LengthDelimited).fork(), options).join();
+        /* bool refresh = 3; */
+        if (message.refresh !== false)
+            writer.tag(3, WireType.Varint).bool(message.refresh);
         let u = options.writeUnknownFields;
         if (u !== false)
             (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
@@ -1842,30 +7080,317 @@ class GenerateReportResponse$Type extends MessageType<GenerateReportResponse> {
     }
 }
 /**
- * @generated MessageType for protobuf message service.GenerateReportResponse
+ * @generated MessageType for protobuf message service.ScanShodanRequest
+ */
+export const ScanShodanRequest = new ScanShodanRequest$Type();
+// @generated message type with reflection information, may provide speed optimized methods
+class ScanShodanResponse$Type extends MessageType<ScanShodanResponse> {
+    constructor() {
+        super("service.ScanShodanResponse", [
+            { no: 1, name: "scan_id", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 2, name: "result", kind: "message", T: () => ShodanSecurityResult },
+            { no: 3, name: "scan_run_id", kind: "scalar", T: 9 /*ScalarType.STRING*/ }
+        ]);
+    }
+    create(value?: PartialMessage<ScanShodanResponse>): ScanShodanResponse {
+        const message = globalThis.Object.create((this.messagePrototype!));
+        message.scanId = "";
+        message.scanRunId = "";
+        if (value !== undefined)
+            reflectionMergePartial<ScanShodanResponse>(this, message, value);
+        return message;
+    }
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: ScanShodanResponse): ScanShodanResponse {
+        let message = target ?? this.create(), end = reader.pos + length;
+        while (reader.pos < end) {
+            let [fieldNo, wireType] = reader.tag();
+            switch (fieldNo) {
+                case /* string scan_id */ 1:
+                    message.scanId = reader.string();
+                    break;
+                case /* service.ShodanSecurityResult result */ 2:
+                    message.result = ShodanSecurityResult.internalBinaryRead(reader, reader.uint32(), options, message.result);
+                    break;
+                case /* string scan_run_id */ 3:
+                    message.scanRunId = reader.string();
+                    break;
+                default:
+                    let u = options.readUnknownField;
+                    if (u === "throw")
+                        throw new globalThis.Error(`Unknown field ${fieldNo} (wire type ${wireType}) for ${this.typeName}`);
+                    let d = reader.skip(wireType);
+                    if (u !== false)
+                        (u === true ? UnknownFieldHandler.onRead : u)(this.typeName, message, fieldNo, wireType, d);
+            }
+        }
+        return message;
+    }
+    internalBinaryWrite(message: ScanShodanResponse, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* string scan_id = 1; */
+        if (message.scanId !== "")
+            writer.tag(1, WireType.LengthDelimited).string(message.scanId);
+        /* service.ShodanSecurityResult result = 2; */
+        if (message.result)
+            ShodanSecurityResult.internalBinaryWrite(message.result, writer.tag(2, WireType.LengthDelimited).fork(), options).join();
+        /* string scan_run_id = 3; */
+        if (message.scanRunId !== "")
+            writer.tag(3, WireType.LengthDelimited).string(message.scanRunId);
+        let u = options.writeUnknownFields;
+        if (u !== false)
+            (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
+        return writer;
+    }
+}
+/**
+ * @generated MessageType for protobuf message service.ScanShodanResponse
+ */
+export const ScanShodanResponse = new ScanShodanResponse$Type();
+// @generated message type with reflection information, may provide speed optimized methods
+class GetShodanScanResultsByDomainRequest$Type extends MessageType<GetShodanScanResultsByDomainRequest> {
+    constructor() {
+        super("service.GetShodanScanResultsByDomainRequest", [
+            { no: 1, name: "domain", kind: "scalar", T: 9 /*ScalarType.STRING*/ }
+        ]);
+    }
+    create(value?: PartialMessage<GetShodanScanResultsByDomainRequest>): GetShodanScanResultsByDomainRequest {
+        const message = globalThis.Object.create((this.messagePrototype!));
+        message.domain = "";
+        if (value !== undefined)
+            reflectionMergePartial<GetShodanScanResultsByDomainRequest>(this, message, value);
+        return message;
+    }
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: GetShodanScanResultsByDomainRequest): GetShodanScanResultsByDomainRequest {
+        let message = target ?? this.create(), end = reader.pos + length;
+        while (reader.pos < end) {
+            let [fieldNo, wireType] = reader.tag();
+            switch (fieldNo) {
+                case /* string domain */ 1:
+                    message.domain = reader.string();
+                    break;
+                default:
+                    let u = options.readUnknownField;
+                    if (u === "throw")
+                        throw new globalThis.Error(`Unknown field ${fieldNo} (wire type ${wireType}) for ${this.typeName}`);
+                    let d = reader.skip(wireType);
+                    if (u !== false)
+                        (u === true ? UnknownFieldHandler.onRead : u)(this.typeName, message, fieldNo, wireType, d);
+            }
+        }
+        return message;
+    }
+    internalBinaryWrite(message: GetShodanScanResultsByDomainRequest, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* string domain = 1; */
+        if (message.domain !== "")
+            writer.tag(1, WireType.LengthDelimited).string(message.domain);
+        let u = options.writeUnknownFields;
+        if (u !== false)
+            (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
+        return writer;
+    }
+}
+/**
+ * @generated MessageType for protobuf message service.GetShodanScanResultsByDomainRequest
+ */
+export const GetShodanScanResultsByDomainRequest = new GetShodanScanResultsByDomainRequest$Type();
+// @generated message type with reflection information, may provide speed optimized methods
+class GetShodanScanResultsByDomainResponse$Type extends MessageType<GetShodanScanResultsByDomainResponse> {
+    constructor() {
+        super("service.GetShodanScanResultsByDomainResponse", [
+            { no: 1, name: "results", kind: "message", repeat: 2 /*RepeatType.UNPACKED*/, T: () => ShodanScanResult }
+        ]);
+    }
+    create(value?: PartialMessage<GetShodanScanResultsByDomainResponse>): GetShodanScanResultsByDomainResponse {
+        const message = globalThis.Object.create((this.messagePrototype!));
+        message.results = [];
+        if (value !== undefined)
+            reflectionMergePartial<GetShodanScanResultsByDomainResponse>(this, message, value);
+        return message;
+    }
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: GetShodanScanResultsByDomainResponse): GetShodanScanResultsByDomainResponse {
+        let message = target ?? this.create(), end = reader.pos + length;
+        while (reader.pos < end) {
+            let [fieldNo, wireType] = reader.tag();
+            switch (fieldNo) {
+                case /* repeated service.ShodanScanResult results */ 1:
+                    message.results.push(ShodanScanResult.internalBinaryRead(reader, reader.uint32(), options));
+                    break;
+                default:
+                    let u = options.readUnknownField;
+                    if (u === "throw")
+                        throw new globalThis.Error(`Unknown field ${fieldNo} (wire type ${wireType}) for ${this.typeName}`);
+                    let d = reader.skip(wireType);
+                    if (u !== false)
+                        (u === true ? UnknownFieldHandler.onRead : u)(this.typeName, message, fieldNo, wireType, d);
+            }
+        }
+        return message;
+    }
+    internalBinaryWrite(message: GetShodanScanResultsByDomainResponse, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* repeated service.ShodanScanResult results = 1; */
+        for (let i = 0; i < message.results.length; i++)
+            ShodanScanResult.internalBinaryWrite(message.results[i], writer.tag(1, WireType.LengthDelimited).fork(), options).join();
+        let u = options.writeUnknownFields;
+        if (u !== false)
+            (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
+        return writer;
+    }
+}
+/**
+ * @generated MessageType for protobuf message service.GetShodanScanResultsByDomainResponse
  */
-export const GenerateReportResponse = new GenerateReportResponse$Type();
+export const GetShodanScanResultsByDomainResponse = new GetShodanScanResultsByDomainResponse$Type();
 // @generated message type with reflection information, may provide speed optimized methods
-class ListReportsRequest$Type extends MessageType<ListReportsRequest> {
+class DNSSecurityResult$Type extends MessageType<DNSSecurityResult> {
     constructor() {
-        super("service.ListReportsRequest", [
-            { no: 1, name: "domain", kind: "scalar", T: 9 /*ScalarType.STRING*/ }
+        super("service.DNSSecurityResult", [
+            { no: 1, name: "spf_record", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 2, name: "spf_valid", kind: "scalar", T: 8 /*ScalarType.BOOL*/ },
+            { no: 3, name: "spf_policy", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 4, name: "dkim_record", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 5, name: "dkim_valid", kind: "scalar", T: 8 /*ScalarType.BOOL*/ },
+            { no: 6, name: "dkim_validation_error", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 7, name: "dmarc_record", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 8, name: "dmarc_policy", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 9, name: "dmarc_valid", kind: "scalar", T: 8 /*ScalarType.BOOL*/ },
+            { no: 10, name: "dmarc_validation_error", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 11, name: "dnssec_enabled", kind: "scalar", T: 8 /*ScalarType.BOOL*/ },
+            { no: 12, name: "dnssec_valid", kind: "scalar", T: 8 /*ScalarType.BOOL*/ },
+            { no: 13, name: "dnssec_validation_error", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 14, name: "ip_addresses", kind: "scalar", repeat: 2 /*RepeatType.UNPACKED*/, T: 9 /*ScalarType.STRING*/ },
+            { no: 15, name: "mx_records", kind: "scalar", repeat: 2 /*RepeatType.UNPACKED*/, T: 9 /*ScalarType.STRING*/ },
+            { no: 16, name: "ns_records", kind: "scalar", repeat: 2 /*RepeatType.UNPACKED*/, T: 9 /*ScalarType.STRING*/ },
+            { no: 17, name: "errors", kind: "scalar", repeat: 2 /*RepeatType.UNPACKED*/, T: 9 /*ScalarType.STRING*/ },
+            { no: 18, name: "dkim_selectors", kind: "message", repeat: 2 /*RepeatType.UNPACKED*/, T: () => DKIMSelector },
+            { no: 19, name: "dkim_selectors_tried", kind: "scalar", T: 5 /*ScalarType.INT32*/ },
+            { no: 20, name: "spf_evaluation", kind: "message", T: () => SPFEvaluation },
+            { no: 21, name: "dnssec_chain", kind: "message", repeat: 2 /*RepeatType.UNPACKED*/, T: () => DNSSECZone },
+            { no: 22, name: "dnssec_findings", kind: "message", repeat: 2 /*RepeatType.UNPACKED*/, T: () => DNSSECFinding },
+            { no: 23, name: "mta_sts", kind: "message", T: () => MTASTSResult },
+            { no: 24, name: "tls_rpt", kind: "message", T: () => TLSRPTResult },
+            { no: 25, name: "bimi", kind: "message", T: () => BIMIResult },
+            { no: 26, name: "dane", kind: "message", repeat: 2 /*RepeatType.UNPACKED*/, T: () => DANEResult },
+            { no: 27, name: "dnssec_skipped", kind: "scalar", T: 8 /*ScalarType.BOOL*/ },
+            { no: 28, name: "mta_sts_policy_skipped", kind: "scalar", T: 8 /*ScalarType.BOOL*/ }
         ]);
     }
-    create(value?: PartialMessage<ListReportsRequest>): ListReportsRequest {
+    create(value?: PartialMessage<DNSSecurityResult>): DNSSecurityResult {
         const message = globalThis.Object.create((this.messagePrototype!));
-        message.domain = "";
+        message.spfRecord = "";
+        message.spfValid = false;
+        message.spfPolicy = "";
+        message.dkimRecord = "";
+        message.dkimValid = false;
+        message.dkimValidationError = "";
+        message.dmarcRecord = "";
+        message.dmarcPolicy = "";
+        message.dmarcValid = false;
+        message.dmarcValidationError = "";
+        message.dnssecEnabled = false;
+        message.dnssecValid = false;
+        message.dnssecValidationError = "";
+        message.ipAddresses = [];
+        message.mxRecords = [];
+        message.nsRecords = [];
+        message.errors = [];
+        message.dkimSelectors = [];
+        message.dkimSelectorsTried = 0;
+        message.dnssecChain = [];
+        message.dnssecFindings = [];
+        message.dane = [];
+        message.dnssecSkipped = false;
+        message.mtaStsPolicySkipped = false;
         if (value !== undefined)
-            reflectionMergePartial<ListReportsRequest>(this, message, value);
+            reflectionMergePartial<DNSSecurityResult>(this, message, value);
         return message;
     }
-    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: ListReportsRequest): ListReportsRequest {
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: DNSSecurityResult): DNSSecurityResult {
         let message = target ?? this.create(), end = reader.pos + length;
         while (reader.pos < end) {
             let [fieldNo, wireType] = reader.tag();
             switch (fieldNo) {
-                case /* string domain */ 1:
-                    message.domain = reader.string();
+                case /* string spf_record */ 1:
+                    message.spfRecord = reader.string();
+                    break;
+                case /* bool spf_valid */ 2:
+                    message.spfValid = reader.bool();
+                    break;
+                case /* string spf_policy */ 3:
+                    message.spfPolicy = reader.string();
+                    break;
+                case /* string dkim_record */ 4:
+                    message.dkimRecord = reader.string();
+                    break;
+                case /* bool dkim_valid */ 5:
+                    message.dkimValid = reader.bool();
+                    break;
+                case /* string dkim_validation_error */ 6:
+                    message.dkimValidationError = reader.string();
+                    break;
+                case /* string dmarc_record */ 7:
+                    message.dmarcRecord = reader.string();
+                    break;
+                case /* string dmarc_policy */ 8:
+                    message.dmarcPolicy = reader.string();
+                    break;
+                case /* bool dmarc_valid */ 9:
+                    message.dmarcValid = reader.bool();
+                    break;
+                case /* string dmarc_validation_error */ 10:
+                    message.dmarcValidationError = reader.string();
+                    break;
+                case /* bool dnssec_enabled */ 11:
+                    message.dnssecEnabled = reader.bool();
+                    break;
+                case /* bool dnssec_valid */ 12:
+                    message.dnssecValid = reader.bool();
+                    break;
+                case /* string dnssec_validation_error */ 13:
+                    message.dnssecValidationError = reader.string();
+                    break;
+                case /* repeated string ip_addresses */ 14:
+                    message.ipAddresses.push(reader.string());
+                    break;
+                case /* repeated string mx_records */ 15:
+                    message.mxRecords.push(reader.string());
+                    break;
+                case /* repeated string ns_records */ 16:
+                    message.nsRecords.push(reader.string());
+                    break;
+                case /* repeated string errors */ 17:
+                    message.errors.push(reader.string());
+                    break;
+                case /* repeated service.DKIMSelector dkim_selectors */ 18:
+                    message.dkimSelectors.push(DKIMSelector.internalBinaryRead(reader, reader.uint32(), options));
+                    break;
+                case /* int32 dkim_selectors_tried */ 19:
+                    message.dkimSelectorsTried = reader.int32();
+                    break;
+                case /* service.SPFEvaluation spf_evaluation */ 20:
+                    message.spfEvaluation = SPFEvaluation.internalBinaryRead(reader, reader.uint32(), options, message.spfEvaluation);
+                    break;
+                case /* repeated service.DNSSECZone dnssec_chain */ 21:
+                    message.dnssecChain.push(DNSSECZone.internalBinaryRead(reader, reader.uint32(), options));
+                    break;
+                case /* repeated service.DNSSECFinding dnssec_findings */ 22:
+                    message.dnssecFindings.push(DNSSECFinding.internalBinaryRead(reader, reader.uint32(), options));
+                    break;
+                case /* service.MTASTSResult mta_sts */ 23:
+                    message.mtaSts = MTASTSResult.internalBinaryRead(reader, reader.uint32(), options, message.mtaSts);
+                    break;
+                case /* service.TLSRPTResult tls_rpt */ 24:
+                    message.tlsRpt = TLSRPTResult.internalBinaryRead(reader, reader.uint32(), options, message.tlsRpt);
+                    break;
+                case /* service.BIMIResult bimi */ 25:
+                    message.bimi = BIMIResult.internalBinaryRead(reader, reader.uint32(), options, message.bimi);
+                    break;
+                case /* repeated service.DANEResult dane */ 26:
+                    message.dane.push(DANEResult.internalBinaryRead(reader, reader.uint32(), options));
+                    break;
+                case /* bool dnssec_skipped */ 27:
+                    message.dnssecSkipped = reader.bool();
+                    break;
+                case /* bool mta_sts_policy_skipped */ 28:
+                    message.mtaStsPolicySkipped = reader.bool();
                     break;
                 default:
                     let u = options.readUnknownField;
@@ -1878,10 +7403,91 @@ class ListReportsRequest$Type extends MessageType<ListReportsRequest> {
         }
         return message;
     }
-    internalBinaryWrite(message: ListReportsRequest, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
-        /* string domain = 1; */
-        if (message.domain !== "")
-            writer.tag(1, WireType.LengthDelimited).string(message.domain);
+    internalBinaryWrite(message: DNSSecurityResult, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* string spf_record = 1; */
+        if (message.spfRecord !== "")
+            writer.tag(1, WireType.LengthDelimited).string(message.spfRecord);
+        /* bool spf_valid = 2; */
+        if (message.spfValid !== false)
+            writer.tag(2, WireType.Varint).bool(message.spfValid);
+        /* string spf_policy = 3; */
+        if (message.spfPolicy !== "")
+            writer.tag(3, WireType.LengthDelimited).string(message.spfPolicy);
+        /* string dkim_record = 4; */
+        if (message.dkimRecord !== "")
+            writer.tag(4, WireType.LengthDelimited).string(message.dkimRecord);
+        /* bool dkim_valid = 5; */
+        if (message.dkimValid !== false)
+            writer.tag(5, WireType.Varint).bool(message.dkimValid);
+        /* string dkim_validation_error = 6; */
+        if (message.dkimValidationError !== "")
+            writer.tag(6, WireType.LengthDelimited).string(message.dkimValidationError);
+        /* string dmarc_record = 7; */
+        if (message.dmarcRecord !== "")
+            writer.tag(7, WireType.LengthDelimited).string(message.dmarcRecord);
+        /* string dmarc_policy = 8; */
+        if (message.dmarcPolicy !== "")
+            writer.tag(8, WireType.LengthDelimited).string(message.dmarcPolicy);
+        /* bool dmarc_valid = 9; */
+        if (message.dmarcValid !== false)
+            writer.tag(9, WireType.Varint).bool(message.dmarcValid);
+        /* string dmarc_validation_error = 10; */
+        if (message.dmarcValidationError !== "")
+            writer.tag(10, WireType.LengthDelimited).string(message.dmarcValidationError);
+        /* bool dnssec_enabled = 11; */
+        if (message.dnssecEnabled !== false)
+            writer.tag(11, WireType.Varint).bool(message.dnssecEnabled);
+        /* bool dnssec_valid = 12; */
+        if (message.dnssecValid !== false)
+            writer.tag(12, WireType.Varint).bool(message.dnssecValid);
+        /* string dnssec_validation_error = 13; */
+        if (message.dnssecValidationError !== "")
+            writer.tag(13, WireType.LengthDelimited).string(message.dnssecValidationError);
+        /* repeated string ip_addresses = 14; */
+        for (let i = 0; i < message.ipAddresses.length; i++)
+            writer.tag(14, WireType.LengthDelimited).string(message.ipAddresses[i]);
+        /* repeated string mx_records = 15; */
+        for (let i = 0; i < message.mxRecords.length; i++)
+            writer.tag(15, WireType.LengthDelimited).string(message.mxRecords[i]);
+        /* repeated string ns_records = 16; */
+        for (let i = 0; i < message.nsRecords.length; i++)
+            writer.tag(16, WireType.LengthDelimited).string(message.nsRecords[i]);
+        /* repeated string errors = 17; */
+        for (let i = 0; i < message.errors.length; i++)
+            writer.tag(17, WireType.LengthDelimited).string(message.errors[i]);
+        /* repeated service.DKIMSelector dkim_selectors = 18; */
+        for (let i = 0; i < message.dkimSelectors.length; i++)
+            DKIMSelector.internalBinaryWrite(message.dkimSelectors[i], writer.tag(18, WireType.LengthDelimited).fork(), options).join();
+        /* int32 dkim_selectors_tried = 19; */
+        if (message.dkimSelectorsTried !== 0)
+            writer.tag(19, WireType.Varint).int32(message.dkimSelectorsTried);
+        /* service.SPFEvaluation spf_evaluation = 20; */
+        if (message.spfEvaluation)
+            SPFEvaluation.internalBinaryWrite(message.spfEvaluation, writer.tag(20, WireType.LengthDelimited).fork(), options).join();
+        /* repeated service.DNSSECZone dnssec_chain = 21; */
+        for (let i = 0; i < message.dnssecChain.length; i++)
+            DNSSECZone.internalBinaryWrite(message.dnssecChain[i], writer.tag(21, WireType.LengthDelimited).fork(), options).join();
+        /* repeated service.DNSSECFinding dnssec_findings = 22; */
+        for (let i = 0; i < message.dnssecFindings.length; i++)
+            DNSSECFinding.internalBinaryWrite(message.dnssecFindings[i], writer.tag(22, WireType.LengthDelimited).fork(), options).join();
+        /* service.MTASTSResult mta_sts = 23; */
+        if (message.mtaSts)
+            MTASTSResult.internalBinaryWrite(message.mtaSts, writer.tag(23, WireType.LengthDelimited).fork(), options).join();
+        /* service.TLSRPTResult tls_rpt = 24; */
+        if (message.tlsRpt)
+            TLSRPTResult.internalBinaryWrite(message.tlsRpt, writer.tag(24, WireType.LengthDelimited).fork(), options).join();
+        /* service.BIMIResult bimi = 25; */
+        if (message.bimi)
+            BIMIResult.internalBinaryWrite(message.bimi, writer.tag(25, WireType.LengthDelimited).fork(), options).join();
+        /* repeated service.DANEResult dane = 26; */
+        for (let i = 0; i < message.dane.length; i++)
+            DANEResult.internalBinaryWrite(message.dane[i], writer.tag(26, WireType.LengthDelimited).fork(), options).join();
+        /* bool dnssec_skipped = 27; */
+        if (message.dnssecSkipped !== false)
+            writer.tag(27, WireType.Varint).bool(message.dnssecSkipped);
+        /* bool mta_sts_policy_skipped = 28; */
+        if (message.mtaStsPolicySkipped !== false)
+            writer.tag(28, WireType.Varint).bool(message.mtaStsPolicySkipped);
         let u = options.writeUnknownFields;
         if (u !== false)
             (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
@@ -1889,54 +7495,75 @@ class ListReportsRequest$Type extends MessageType<ListReportsRequest> {
     }
 }
 /**
- * @generated MessageType for protobuf message service.ListReportsRequest
+ * @generated MessageType for protobuf message service.DNSSecurityResult
  */
-export const ListReportsRequest = new ListReportsRequest$Type();
+export const DNSSecurityResult = new DNSSecurityResult$Type();
 // @generated message type with reflection information, may provide speed optimized methods
-class Report$Type extends MessageType<Report> {
+class MTASTSResult$Type extends MessageType<MTASTSResult> {
     constructor() {
-        super("service.Report", [
-            { no: 1, name: "report_id", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
-            { no: 2, name: "domain", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
-            { no: 3, name: "dns_scan_id", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
-            { no: 4, name: "score", kind: "scalar", T: 5 /*ScalarType.INT32*/ },
-            { no: 5, name: "risk_tier", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
-            { no: 6, name: "created_at", kind: "message", T: () => Timestamp }
+        super("service.MTASTSResult", [
+            { no: 1, name: "record", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 2, name: "id", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 3, name: "record_valid", kind: "scalar", T: 8 /*ScalarType.BOOL*/ },
+            { no: 4, name: "policy", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 5, name: "mode", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 6, name: "mx_patterns", kind: "scalar", repeat: 2 /*RepeatType.UNPACKED*/, T: 9 /*ScalarType.STRING*/ },
+            { no: 7, name: "max_age", kind: "scalar", T: 3 /*ScalarType.INT64*/, L: 0 /*LongType.BIGINT*/ },
+            { no: 8, name: "policy_valid", kind: "scalar", T: 8 /*ScalarType.BOOL*/ },
+            { no: 9, name: "unmatched_mx", kind: "scalar", repeat: 2 /*RepeatType.UNPACKED*/, T: 9 /*ScalarType.STRING*/ },
+            { no: 10, name: "errors", kind: "scalar", repeat: 2 /*RepeatType.UNPACKED*/, T: 9 /*ScalarType.STRING*/ }
         ]);
     }
-    create(value?: PartialMessage<Report>): Report {
+    create(value?: PartialMessage<MTASTSResult>): MTASTSResult {
         const message = globalThis.Object.create((this.messagePrototype!));
-        message.reportId = "";
-        message.domain = "";
-        message.dnsScanId = "";
-        message.score = 0;
-        message.riskTier = "";
+        message.record = "";
+        message.id = "";
+        message.recordValid = false;
+        message.policy = "";
+        message.mode = "";
+        message.mxPatterns = [];
+        message.maxAge = 0n;
+        message.policyValid = false;
+        message.unmatchedMx = [];
+        message.errors = [];
         if (value !== undefined)
-            reflectionMergePartial<Report>(this, message, value);
+            reflectionMergePartial<MTASTSResult>(this, message, value);
         return message;
     }
-    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: Report): Report {
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: MTASTSResult): MTASTSResult {
         let message = target ?? this.create(), end = reader.pos + length;
         while (reader.pos < end) {
             let [fieldNo, wireType] = reader.tag();
             switch (fieldNo) {
-                case /* string report_id */ 1:
-                    message.reportId = reader.string();
+                case /* string record */ 1:
+                    message.record = reader.string();
                     break;
-                case /* string domain */ 2:
-                    message.domain = reader.string();
+                case /* string id */ 2:
+                    message.id = reader.string();
                     break;
-                case /* string dns_scan_id */ 3:
-                    message.dnsScanId = reader.string();
+                case /* bool record_valid */ 3:
+                    message.recordValid = reader.bool();
                     break;
-                case /* int32 score */ 4:
-                    message.score = reader.int32();
+                case /* string policy */ 4:
+                    message.policy = reader.string();
                     break;
-                case /* string risk_tier */ 5:
-                    message.riskTier = reader.string();
+                case /* string mode */ 5:
+                    message.mode = reader.string();
                     break;
-                case /* google.protobuf.Timestamp created_at */ 6:
-                    message.createdAt = Timestamp.internalBinaryRead(reader, reader.uint32(), options, message.createdAt);
+                case /* repeated string mx_patterns */ 6:
+                    message.mxPatterns.push(reader.string());
+                    break;
+                case /* int64 max_age */ 7:
+                    message.maxAge = reader.int64().toBigInt();
+                    break;
+                case /* bool policy_valid */ 8:
+                    message.policyValid = reader.bool();
+                    break;
+                case /* repeated string unmatched_mx */ 9:
+                    message.unmatchedMx.push(reader.string());
+                    break;
+                case /* repeated string errors */ 10:
+                    message.errors.push(reader.string());
                     break;
                 default:
                     let u = options.readUnknownField;
@@ -1949,25 +7576,37 @@ class Report$Type extends MessageType<Report> {
         }
         return message;
     }
-    internalBinaryWrite(message: Report, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
-        /* string report_id = 1; */
-        if (message.reportId !== "")
-            writer.tag(1, WireType.LengthDelimited).string(message.reportId);
-        /* string domain = 2; */
-        if (message.domain !== "")
-            writer.tag(2, WireType.LengthDelimited).string(message.domain);
-        /* string dns_scan_id = 3; */
-        if (message.dnsScanId !== "")
-            writer.tag(3, WireType.LengthDelimited).string(message.dnsScanId);
-        /* int32 score = 4; */
-        if (message.score !== 0)
-            writer.tag(4, WireType.Varint).int32(message.score);
-        /* string risk_tier = 5; */
-        if (message.riskTier !== "")
-            writer.tag(5, WireType.LengthDelimited).string(message.riskTier);
-        /* google.protobuf.Timestamp created_at = 6; */
-        if (message.createdAt)
-            Timestamp.internalBinaryWrite(message.createdAt, writer.tag(6, WireType.LengthDelimited).fork(), options).join();
+    internalBinaryWrite(message: MTASTSResult, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* string record = 1; */
+        if (message.record !== "")
+            writer.tag(1, WireType.LengthDelimited).string(message.record);
+        /* string id = 2; */
+        if (message.id !== "")
+            writer.tag(2, WireType.LengthDelimited).string(message.id);
+        /* bool record_valid = 3; */
+        if (message.recordValid !== false)
+            writer.tag(3, WireType.Varint).bool(message.recordValid);
+        /* string policy = 4; */
+        if (message.policy !== "")
+            writer.tag(4, WireType.LengthDelimited).string(message.policy);
+        /* string mode = 5; */
+        if (message.mode !== "")
+            writer.tag(5, WireType.LengthDelimited).string(message.mode);
+        /* repeated string mx_patterns = 6; */
+        for (let i = 0; i < message.mxPatterns.length; i++)
+            writer.tag(6, WireType.LengthDelimited).string(message.mxPatterns[i]);
+        /* int64 max_age = 7; */
+        if (message.maxAge !== 0n)
+            writer.tag(7, WireType.Varint).int64(message.maxAge);
+        /* bool policy_valid = 8; */
+        if (message.policyValid !== false)
+            writer.tag(8, WireType.Varint).bool(message.policyValid);
+        /* repeated string unmatched_mx = 9; */
+        for (let i = 0; i < message.unmatchedMx.length; i++)
+            writer.tag(9, WireType.LengthDelimited).string(message.unmatchedMx[i]);
+        /* repeated string errors = 10; */
+        for (let i = 0; i < message.errors.length; i++)
+            writer.tag(10, WireType.LengthDelimited).string(message.errors[i]);
         let u = options.writeUnknownFields;
         if (u !== false)
             (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
@@ -1975,30 +7614,45 @@ class Report$Type extends MessageType<Report> {
     }
 }
 /**
- * @generated MessageType for protobuf message service.Report
+ * @generated MessageType for protobuf message service.MTASTSResult
  */
-export const Report = new Report$Type();
+export const MTASTSResult = new MTASTSResult$Type();
 // @generated message type with reflection information, may provide speed optimized methods
-class ListReportsResponse$Type extends MessageType<ListReportsResponse> {
+class TLSRPTResult$Type extends MessageType<TLSRPTResult> {
     constructor() {
-        super("service.ListReportsResponse", [
-            { no: 1, name: "reports", kind: "message", repeat: 2 /*RepeatType.UNPACKED*/, T: () => Report }
+        super("service.TLSRPTResult", [
+            { no: 1, name: "record", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 2, name: "rua", kind: "scalar", repeat: 2 /*RepeatType.UNPACKED*/, T: 9 /*ScalarType.STRING*/ },
+            { no: 3, name: "valid", kind: "scalar", T: 8 /*ScalarType.BOOL*/ },
+            { no: 4, name: "errors", kind: "scalar", repeat: 2 /*RepeatType.UNPACKED*/, T: 9 /*ScalarType.STRING*/ }
         ]);
     }
-    create(value?: PartialMessage<ListReportsResponse>): ListReportsResponse {
+    create(value?: PartialMessage<TLSRPTResult>): TLSRPTResult {
         const message = globalThis.Object.create((this.messagePrototype!));
-        message.reports = [];
+        message.record = "";
+        message.rua = [];
+        message.valid = false;
+        message.errors = [];
         if (value !== undefined)
-            reflectionMergePartial<ListReportsResponse>(this, message, value);
+            reflectionMergePartial<TLSRPTResult>(this, message, value);
         return message;
     }
-    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: ListReportsResponse): ListReportsResponse {
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: TLSRPTResult): TLSRPTResult {
         let message = target ?? this.create(), end = reader.pos + length;
         while (reader.pos < end) {
             let [fieldNo, wireType] = reader.tag();
             switch (fieldNo) {
-                case /* repeated service.Report reports */ 1:
-                    message.reports.push(Report.internalBinaryRead(reader, reader.uint32(), options));
+                case /* string record */ 1:
+                    message.record = reader.string();
+                    break;
+                case /* repeated string rua */ 2:
+                    message.rua.push(reader.string());
+                    break;
+                case /* bool valid */ 3:
+                    message.valid = reader.bool();
+                    break;
+                case /* repeated string errors */ 4:
+                    message.errors.push(reader.string());
                     break;
                 default:
                     let u = options.readUnknownField;
@@ -2011,10 +7665,19 @@ class ListReportsResponse$Type extends MessageType<ListReportsResponse> {
         }
         return message;
     }
-    internalBinaryWrite(message: ListReportsResponse, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
-        /* repeated service.Report reports = 1; */
-        for (let i = 0; i < message.reports.length; i++)
-            Report.internalBinaryWrite(message.reports[i], writer.tag(1, WireType.LengthDelimited).fork(), options).join();
+    internalBinaryWrite(message: TLSRPTResult, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* string record = 1; */
+        if (message.record !== "")
+            writer.tag(1, WireType.LengthDelimited).string(message.record);
+        /* repeated string rua = 2; */
+        for (let i = 0; i < message.rua.length; i++)
+            writer.tag(2, WireType.LengthDelimited).string(message.rua[i]);
+        /* bool valid = 3; */
+        if (message.valid !== false)
+            writer.tag(3, WireType.Varint).bool(message.valid);
+        /* repeated string errors = 4; */
+        for (let i = 0; i < message.errors.length; i++)
+            writer.tag(4, WireType.LengthDelimited).string(message.errors[i]);
         let u = options.writeUnknownFields;
         if (u !== false)
             (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
@@ -2022,30 +7685,50 @@ class ListReportsResponse$Type extends MessageType<ListReportsResponse> {
     }
 }
 /**
- * @generated MessageType for protobuf message service.ListReportsResponse
+ * @generated MessageType for protobuf message service.TLSRPTResult
  */
-export const ListReportsResponse = new ListReportsResponse$Type();
+export const TLSRPTResult = new TLSRPTResult$Type();
 // @generated message type with reflection information, may provide speed optimized methods
-class GetReportByIdRequest$Type extends MessageType<GetReportByIdRequest> {
+class BIMIResult$Type extends MessageType<BIMIResult> {
     constructor() {
-        super("service.GetReportByIdRequest", [
-            { no: 1, name: "report_id", kind: "scalar", T: 9 /*ScalarType.STRING*/ }
+        super("service.BIMIResult", [
+            { no: 1, name: "record", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 2, name: "logo_url", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 3, name: "authority_url", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 4, name: "valid", kind: "scalar", T: 8 /*ScalarType.BOOL*/ },
+            { no: 5, name: "errors", kind: "scalar", repeat: 2 /*RepeatType.UNPACKED*/, T: 9 /*ScalarType.STRING*/ }
         ]);
     }
-    create(value?: PartialMessage<GetReportByIdRequest>): GetReportByIdRequest {
+    create(value?: PartialMessage<BIMIResult>): BIMIResult {
         const message = globalThis.Object.create((this.messagePrototype!));
-        message.reportId = "";
+        message.record = "";
+        message.logoUrl = "";
+        message.authorityUrl = "";
+        message.valid = false;
+        message.errors = [];
         if (value !== undefined)
-            reflectionMergePartial<GetReportByIdRequest>(this, message, value);
+            reflectionMergePartial<BIMIResult>(this, message, value);
         return message;
     }
-    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: GetReportByIdRequest): GetReportByIdRequest {
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: BIMIResult): BIMIResult {
         let message = target ?? this.create(), end = reader.pos + length;
         while (reader.pos < end) {
             let [fieldNo, wireType] = reader.tag();
             switch (fieldNo) {
-                case /* string report_id */ 1:
-                    message.reportId = reader.string();
+                case /* string record */ 1:
+                    message.record = reader.string();
+                    break;
+                case /* string logo_url */ 2:
+                    message.logoUrl = reader.string();
+                    break;
+                case /* string authority_url */ 3:
+                    message.authorityUrl = reader.string();
+                    break;
+                case /* bool valid */ 4:
+                    message.valid = reader.bool();
+                    break;
+                case /* repeated string errors */ 5:
+                    message.errors.push(reader.string());
                     break;
                 default:
                     let u = options.readUnknownField;
@@ -2058,10 +7741,22 @@ class GetReportByIdRequest$Type extends MessageType<GetReportByIdRequest> {
         }
         return message;
     }
-    internalBinaryWrite(message: GetReportByIdRequest, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
-        /* string report_id = 1; */
-        if (message.reportId !== "")
-            writer.tag(1, WireType.LengthDelimited).string(message.reportId);
+    internalBinaryWrite(message: BIMIResult, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* string record = 1; */
+        if (message.record !== "")
+            writer.tag(1, WireType.LengthDelimited).string(message.record);
+        /* string logo_url = 2; */
+        if (message.logoUrl !== "")
+            writer.tag(2, WireType.LengthDelimited).string(message.logoUrl);
+        /* string authority_url = 3; */
+        if (message.authorityUrl !== "")
+            writer.tag(3, WireType.LengthDelimited).string(message.authorityUrl);
+        /* bool valid = 4; */
+        if (message.valid !== false)
+            writer.tag(4, WireType.Varint).bool(message.valid);
+        /* repeated string errors = 5; */
+        for (let i = 0; i < message.errors.length; i++)
+            writer.tag(5, WireType.LengthDelimited).string(message.errors[i]);
         let u = options.writeUnknownFields;
         if (u !== false)
             (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
@@ -2069,29 +7764,50 @@ class GetReportByIdRequest$Type extends MessageType<GetReportByIdRequest> {
     }
 }
 /**
- * @generated MessageType for protobuf message service.GetReportByIdRequest
+ * @generated MessageType for protobuf message service.BIMIResult
  */
-export const GetReportByIdRequest = new GetReportByIdRequest$Type();
+export const BIMIResult = new BIMIResult$Type();
 // @generated message type with reflection information, may provide speed optimized methods
-class GetReportByIdResponse$Type extends MessageType<GetReportByIdResponse> {
+class DANEResult$Type extends MessageType<DANEResult> {
     constructor() {
-        super("service.GetReportByIdResponse", [
-            { no: 1, name: "report", kind: "message", T: () => Report }
+        super("service.DANEResult", [
+            { no: 1, name: "mx_host", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 2, name: "records", kind: "scalar", repeat: 2 /*RepeatType.UNPACKED*/, T: 9 /*ScalarType.STRING*/ },
+            { no: 3, name: "dnssec_authenticated", kind: "scalar", T: 8 /*ScalarType.BOOL*/ },
+            { no: 4, name: "valid", kind: "scalar", T: 8 /*ScalarType.BOOL*/ },
+            { no: 5, name: "errors", kind: "scalar", repeat: 2 /*RepeatType.UNPACKED*/, T: 9 /*ScalarType.STRING*/ }
         ]);
     }
-    create(value?: PartialMessage<GetReportByIdResponse>): GetReportByIdResponse {
+    create(value?: PartialMessage<DANEResult>): DANEResult {
         const message = globalThis.Object.create((this.messagePrototype!));
+        message.mxHost = "";
+        message.records = [];
+        message.dnssecAuthenticated = false;
+        message.valid = false;
+        message.errors = [];
         if (value !== undefined)
-            reflectionMergePartial<GetReportByIdResponse>(this, message, value);
+            reflectionMergePartial<DANEResult>(this, message, value);
         return message;
     }
-    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: GetReportByIdResponse): GetReportByIdResponse {
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: DANEResult): DANEResult {
         let message = target ?? this.create(), end = reader.pos + length;
         while (reader.pos < end) {
             let [fieldNo, wireType] = reader.tag();
             switch (fieldNo) {
-                case /* service.Report report */ 1:
-                    message.report = Report.internalBinaryRead(reader, reader.uint32(), options, message.report);
+                case /* string mx_host */ 1:
+                    message.mxHost = reader.string();
+                    break;
+                case /* repeated string records */ 2:
+                    message.records.push(reader.string());
+                    break;
+                case /* bool dnssec_authenticated */ 3:
+                    message.dnssecAuthenticated = reader.bool();
+                    break;
+                case /* bool valid */ 4:
+                    message.valid = reader.bool();
+                    break;
+                case /* repeated string errors */ 5:
+                    message.errors.push(reader.string());
                     break;
                 default:
                     let u = options.readUnknownField;
@@ -2104,10 +7820,22 @@ class GetReportByIdResponse$Type extends MessageType<GetReportByIdResponse> {
         }
         return message;
     }
-    internalBinaryWrite(message: GetReportByIdResponse, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
-        /* service.Report report = 1; */
-        if (message.report)
-            Report.internalBinaryWrite(message.report, writer.tag(1, WireType.LengthDelimited).fork(), options).join();
+    internalBinaryWrite(message: DANEResult, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* string mx_host = 1; */
+        if (message.mxHost !== "")
+            writer.tag(1, WireType.LengthDelimited).string(message.mxHost);
+        /* repeated string records = 2; */
+        for (let i = 0; i < message.records.length; i++)
+            writer.tag(2, WireType.LengthDelimited).string(message.records[i]);
+        /* bool dnssec_authenticated = 3; */
+        if (message.dnssecAuthenticated !== false)
+            writer.tag(3, WireType.Varint).bool(message.dnssecAuthenticated);
+        /* bool valid = 4; */
+        if (message.valid !== false)
+            writer.tag(4, WireType.Varint).bool(message.valid);
+        /* repeated string errors = 5; */
+        for (let i = 0; i < message.errors.length; i++)
+            writer.tag(5, WireType.LengthDelimited).string(message.errors[i]);
         let u = options.writeUnknownFields;
         if (u !== false)
             (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
@@ -2115,30 +7843,64 @@ class GetReportByIdResponse$Type extends MessageType<GetReportByIdResponse> {
     }
 }
 /**
- * @generated MessageType for protobuf message service.GetReportByIdResponse
+ * @generated MessageType for protobuf message service.DANEResult
  */
-export const GetReportByIdResponse = new GetReportByIdResponse$Type();
+export const DANEResult = new DANEResult$Type();
 // @generated message type with reflection information, may provide speed optimized methods
-class CalculateRiskScoreRequest$Type extends MessageType<CalculateRiskScoreRequest> {
+class DNSSECZone$Type extends MessageType<DNSSECZone> {
     constructor() {
-        super("service.CalculateRiskScoreRequest", [
-            { no: 1, name: "domain", kind: "scalar", T: 9 /*ScalarType.STRING*/ }
+        super("service.DNSSECZone", [
+            { no: 1, name: "zone", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 2, name: "status", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 3, name: "key_tags", kind: "scalar", repeat: 1 /*RepeatType.PACKED*/, T: 5 /*ScalarType.INT32*/ },
+            { no: 4, name: "algorithms", kind: "scalar", repeat: 2 /*RepeatType.UNPACKED*/, T: 9 /*ScalarType.STRING*/ },
+            { no: 5, name: "denial", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 6, name: "nsec3_iterations", kind: "scalar", T: 5 /*ScalarType.INT32*/ },
+            { no: 7, name: "nsec3_salt", kind: "scalar", T: 9 /*ScalarType.STRING*/ }
         ]);
     }
-    create(value?: PartialMessage<CalculateRiskScoreRequest>): CalculateRiskScoreRequest {
+    create(value?: PartialMessage<DNSSECZone>): DNSSECZone {
         const message = globalThis.Object.create((this.messagePrototype!));
-        message.domain = "";
+        message.zone = "";
+        message.status = "";
+        message.keyTags = [];
+        message.algorithms = [];
+        message.denial = "";
+        message.nsec3Iterations = 0;
+        message.nsec3Salt = "";
         if (value !== undefined)
-            reflectionMergePartial<CalculateRiskScoreRequest>(this, message, value);
+            reflectionMergePartial<DNSSECZone>(this, message, value);
         return message;
     }
-    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: CalculateRiskScoreRequest): CalculateRiskScoreRequest {
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: DNSSECZone): DNSSECZone {
         let message = target ?? this.create(), end = reader.pos + length;
         while (reader.pos < end) {
             let [fieldNo, wireType] = reader.tag();
             switch (fieldNo) {
-                case /* string domain */ 1:
-                    message.domain = reader.string();
+                case /* string zone */ 1:
+                    message.zone = reader.string();
+                    break;
+                case /* string status */ 2:
+                    message.status = reader.string();
+                    break;
+                case /* repeated int32 key_tags */ 3:
+                    if (wireType === WireType.LengthDelimited)
+                        for (let e = reader.int32() + reader.pos; reader.pos < e;)
+                            message.keyTags.push(reader.int32());
+                    else
+                        message.keyTags.push(reader.int32());
+                    break;
+                case /* repeated string algorithms */ 4:
+                    message.algorithms.push(reader.string());
+                    break;
+                case /* string denial */ 5:
+                    message.denial = reader.string();
+                    break;
+                case /* int32 nsec3_iterations */ 6:
+                    message.nsec3Iterations = reader.int32();
+                    break;
+                case /* string nsec3_salt */ 7:
+                    message.nsec3Salt = reader.string();
                     break;
                 default:
                     let u = options.readUnknownField;
@@ -2151,10 +7913,32 @@ class CalculateRiskScoreRequest$Type extends MessageType<CalculateRiskScoreReque
         }
         return message;
     }
-    internalBinaryWrite(message: CalculateRiskScoreRequest, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
-        /* string domain = 1; */
-        if (message.domain !== "")
-            writer.tag(1, WireType.LengthDelimited).string(message.domain);
+    internalBinaryWrite(message: DNSSECZone, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* string zone = 1; */
+        if (message.zone !== "")
+            writer.tag(1, WireType.LengthDelimited).string(message.zone);
+        /* string status = 2; */
+        if (message.status !== "")
+            writer.tag(2, WireType.LengthDelimited).string(message.status);
+        /* repeated int32 key_tags = 3; */
+        if (message.keyTags.length) {
+            writer.tag(3, WireType.LengthDelimited).fork();
+            for (let i = 0; i < message.keyTags.length; i++)
+                writer.int32(message.keyTags[i]);
+            writer.join();
+        }
+        /* repeated string algorithms = 4; */
+        for (let i = 0; i < message.algorithms.length; i++)
+            writer.tag(4, WireType.LengthDelimited).string(message.algorithms[i]);
+        /* string denial = 5; */
+        if (message.denial !== "")
+            writer.tag(5, WireType.LengthDelimited).string(message.denial);
+        /* int32 nsec3_iterations = 6; */
+        if (message.nsec3Iterations !== 0)
+            writer.tag(6, WireType.Varint).int32(message.nsec3Iterations);
+        /* string nsec3_salt = 7; */
+        if (message.nsec3Salt !== "")
+            writer.tag(7, WireType.LengthDelimited).string(message.nsec3Salt);
         let u = options.writeUnknownFields;
         if (u !== false)
             (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
@@ -2162,35 +7946,45 @@ class CalculateRiskScoreRequest$Type extends MessageType<CalculateRiskScoreReque
     }
 }
 /**
- * @generated MessageType for protobuf message service.CalculateRiskScoreRequest
+ * @generated MessageType for protobuf message service.DNSSECZone
  */
-export const CalculateRiskScoreRequest = new CalculateRiskScoreRequest$Type();
+export const DNSSECZone = new DNSSECZone$Type();
 // @generated message type with reflection information, may provide speed optimized methods
-class CalculateRiskScoreResponse$Type extends MessageType<CalculateRiskScoreResponse> {
+class DNSSECFinding$Type extends MessageType<DNSSECFinding> {
     constructor() {
-        super("service.CalculateRiskScoreResponse", [
-            { no: 1, name: "score", kind: "scalar", T: 5 /*ScalarType.INT32*/ },
-            { no: 2, name: "risk_tier", kind: "scalar", T: 9 /*ScalarType.STRING*/ }
+        super("service.DNSSECFinding", [
+            { no: 1, name: "zone", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 2, name: "code", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 3, name: "severity", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 4, name: "message", kind: "scalar", T: 9 /*ScalarType.STRING*/ }
         ]);
     }
-    create(value?: PartialMessage<CalculateRiskScoreResponse>): CalculateRiskScoreResponse {
+    create(value?: PartialMessage<DNSSECFinding>): DNSSECFinding {
         const message = globalThis.Object.create((this.messagePrototype!));
-        message.score = 0;
-        message.riskTier = "";
+        message.zone = "";
+        message.code = "";
+        message.severity = "";
+        message.message = "";
         if (value !== undefined)
-            reflectionMergePartial<CalculateRiskScoreResponse>(this, message, value);
+            reflectionMergePartial<DNSSECFinding>(this, message, value);
         return message;
     }
-    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: CalculateRiskScoreResponse): CalculateRiskScoreResponse {
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: DNSSECFinding): DNSSECFinding {
         let message = target ?? this.create(), end = reader.pos + length;
         while (reader.pos < end) {
             let [fieldNo, wireType] = reader.tag();
             switch (fieldNo) {
-                case /* int32 score */ 1:
-                    message.score = reader.int32();
+                case /* string zone */ 1:
+                    message.zone = reader.string();
                     break;
-                case /* string risk_tier */ 2:
-                    message.riskTier = reader.string();
+                case /* string code */ 2:
+                    message.code = reader.string();
+                    break;
+                case /* string severity */ 3:
+                    message.severity = reader.string();
+                    break;
+                case /* string message */ 4:
+                    message.message = reader.string();
                     break;
                 default:
                     let u = options.readUnknownField;
@@ -2203,13 +7997,19 @@ class CalculateRiskScoreResponse$Type extends MessageType<CalculateRiskScoreResp
         }
         return message;
     }
-    internalBinaryWrite(message: CalculateRiskScoreResponse, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
-        /* int32 score = 1; */
-        if (message.score !== 0)
-            writer.tag(1, WireType.Varint).int32(message.score);
-        /* string risk_tier = 2; */
-        if (message.riskTier !== "")
-            writer.tag(2, WireType.LengthDelimited).string(message.riskTier);
+    internalBinaryWrite(message: DNSSECFinding, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* string zone = 1; */
+        if (message.zone !== "")
+            writer.tag(1, WireType.LengthDelimited).string(message.zone);
+        /* string code = 2; */
+        if (message.code !== "")
+            writer.tag(2, WireType.LengthDelimited).string(message.code);
+        /* string severity = 3; */
+        if (message.severity !== "")
+            writer.tag(3, WireType.LengthDelimited).string(message.severity);
+        /* string message = 4; */
+        if (message.message !== "")
+            writer.tag(4, WireType.LengthDelimited).string(message.message);
         let u = options.writeUnknownFields;
         if (u !== false)
             (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
@@ -2217,50 +8017,75 @@ class CalculateRiskScoreResponse$Type extends MessageType<CalculateRiskScoreResp
     }
 }
 /**
- * @generated MessageType for protobuf message service.CalculateRiskScoreResponse
+ * @generated MessageType for protobuf message service.DNSSECFinding
  */
-export const CalculateRiskScoreResponse = new CalculateRiskScoreResponse$Type();
+export const DNSSECFinding = new DNSSECFinding$Type();
 // @generated message type with reflection information, may provide speed optimized methods
-class CreateUserRequest$Type extends MessageType<CreateUserRequest> {
+class SPFEvaluation$Type extends MessageType<SPFEvaluation> {
     constructor() {
-        super("service.CreateUserRequest", [
-            { no: 1, name: "first_name", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
-            { no: 2, name: "last_name", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
-            { no: 3, name: "email", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
-            { no: 4, name: "password", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
-            { no: 5, name: "is_admin", kind: "scalar", T: 8 /*ScalarType.BOOL*/ }
+        super("service.SPFEvaluation", [
+            { no: 1, name: "record_count", kind: "scalar", T: 5 /*ScalarType.INT32*/ },
+            { no: 2, name: "dns_lookups", kind: "scalar", T: 5 /*ScalarType.INT32*/ },
+            { no: 3, name: "void_lookups", kind: "scalar", T: 5 /*ScalarType.INT32*/ },
+            { no: 4, name: "lookup_limit_exceeded", kind: "scalar", T: 8 /*ScalarType.BOOL*/ },
+            { no: 5, name: "void_lookup_limit_exceeded", kind: "scalar", T: 8 /*ScalarType.BOOL*/ },
+            { no: 6, name: "permissive_all", kind: "scalar", T: 8 /*ScalarType.BOOL*/ },
+            { no: 7, name: "broad_ranges", kind: "scalar", repeat: 2 /*RepeatType.UNPACKED*/, T: 9 /*ScalarType.STRING*/ },
+            { no: 8, name: "authorized_ips", kind: "scalar", repeat: 2 /*RepeatType.UNPACKED*/, T: 9 /*ScalarType.STRING*/ },
+            { no: 9, name: "includes", kind: "scalar", repeat: 2 /*RepeatType.UNPACKED*/, T: 9 /*ScalarType.STRING*/ },
+            { no: 10, name: "errors", kind: "scalar", repeat: 2 /*RepeatType.UNPACKED*/, T: 9 /*ScalarType.STRING*/ }
         ]);
     }
-    create(value?: PartialMessage<CreateUserRequest>): CreateUserRequest {
+    create(value?: PartialMessage<SPFEvaluation>): SPFEvaluation {
         const message = globalThis.Object.create((this.messagePrototype!));
-        message.firstName = "";
-        message.lastName = "";
-        message.email = "";
-        message.password = "";
-        message.isAdmin = false;
+        message.recordCount = 0;
+        message.dnsLookups = 0;
+        message.voidLookups = 0;
+        message.lookupLimitExceeded = false;
+        message.voidLookupLimitExceeded = false;
+        message.permissiveAll = false;
+        message.broadRanges = [];
+        message.authorizedIps = [];
+        message.includes = [];
+        message.errors = [];
         if (value !== undefined)
-            reflectionMergePartial<CreateUserRequest>(this, message, value);
+            reflectionMergePartial<SPFEvaluation>(this, message, value);
         return message;
     }
-    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: CreateUserRequest): CreateUserRequest {
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: SPFEvaluation): SPFEvaluation {
         let message = target ?? this.create(), end = reader.pos + length;
         while (reader.pos < end) {
             let [fieldNo, wireType] = reader.tag();
             switch (fieldNo) {
-                case /* string first_name */ 1:
-                    message.firstName = reader.string();
+                case /* int32 record_count */ 1:
+                    message.recordCount = reader.int32();
                     break;
-                case /* string last_name */ 2:
-                    message.lastName = reader.string();
+                case /* int32 dns_lookups */ 2:
+                    message.dnsLookups = reader.int32();
                     break;
-                case /* string email */ 3:
-                    message.email = reader.string();
+                case /* int32 void_lookups */ 3:
+                    message.voidLookups = reader.int32();
                     break;
-                case /* string password */ 4:
-                    message.password = reader.string();
+                case /* bool lookup_limit_exceeded */ 4:
+                    message.lookupLimitExceeded = reader.bool();
                     break;
-                case /* bool is_admin */ 5:
-                    message.isAdmin = reader.bool();
+                case /* bool void_lookup_limit_exceeded */ 5:
+                    message.voidLookupLimitExceeded = reader.bool();
+                    break;
+                case /* bool permissive_all */ 6:
+                    message.permissiveAll = reader.bool();
+                    break;
+                case /* repeated string broad_ranges */ 7:
+                    message.broadRanges.push(reader.string());
+                    break;
+                case /* repeated string authorized_ips */ 8:
+                    message.authorizedIps.push(reader.string());
+                    break;
+                case /* repeated string includes */ 9:
+                    message.includes.push(reader.string());
+                    break;
+                case /* repeated string errors */ 10:
+                    message.errors.push(reader.string());
                     break;
                 default:
                     let u = options.readUnknownField;
@@ -2273,22 +8098,37 @@ class CreateUserRequest$Type extends MessageType<CreateUserRequest> {
         }
         return message;
     }
-    internalBinaryWrite(message: CreateUserRequest, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
-        /* string first_name = 1; */
-        if (message.firstName !== "")
-            writer.tag(1, WireType.LengthDelimited).string(message.firstName);
-        /* string last_name = 2; */
-        if (message.lastName !== "")
-            writer.tag(2, WireType.LengthDelimited).string(message.lastName);
-        /* string email = 3; */
-        if (message.email !== "")
-            writer.tag(3, WireType.LengthDelimited).string(message.email);
-        /* string password = 4; */
-        if (message.password !== "")
-            writer.tag(4, WireType.LengthDelimited).string(message.password);
-        /* bool is_admin = 5; */
-        if (message.isAdmin !== false)
-            writer.tag(5, WireType.Varint).bool(message.isAdmin);
+    internalBinaryWrite(message: SPFEvaluation, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* int32 record_count = 1; */
+        if (message.recordCount !== 0)
+            writer.tag(1, WireType.Varint).int32(message.recordCount);
+        /* int32 dns_lookups = 2; */
+        if (message.dnsLookups !== 0)
+            writer.tag(2, WireType.Varint).int32(message.dnsLookups);
+        /* int32 void_lookups = 3; */
+        if (message.voidLookups !== 0)
+            writer.tag(3, WireType.Varint).int32(message.voidLookups);
+        /* bool lookup_limit_exceeded = 4; */
+        if (message.lookupLimitExceeded !== false)
+            writer.tag(4, WireType.Varint).bool(message.lookupLimitExceeded);
+        /* bool void_lookup_limit_exceeded = 5; */
+        if (message.voidLookupLimitExceeded !== false)
+            writer.tag(5, WireType.Varint).bool(message.voidLookupLimitExceeded);
+        /* bool permissive_all = 6; */
+        if (message.permissiveAll !== false)
+            writer.tag(6, WireType.Varint).bool(message.permissiveAll);
+        /* repeated string broad_ranges = 7; */
+        for (let i = 0; i < message.broadRanges.length; i++)
+            writer.tag(7, WireType.LengthDelimited).string(message.broadRanges[i]);
+        /* repeated string authorized_ips = 8; */
+        for (let i = 0; i < message.authorizedIps.length; i++)
+            writer.tag(8, WireType.LengthDelimited).string(message.authorizedIps[i]);
+        /* repeated string includes = 9; */
+        for (let i = 0; i < message.includes.length; i++)
+            writer.tag(9, WireType.LengthDelimited).string(message.includes[i]);
+        /* repeated string errors = 10; */
+        for (let i = 0; i < message.errors.length; i++)
+            writer.tag(10, WireType.LengthDelimited).string(message.errors[i]);
         let u = options.writeUnknownFields;
         if (u !== false)
             (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
@@ -2296,30 +8136,60 @@ class CreateUserRequest$Type extends MessageType<CreateUserRequest> {
     }
 }
 /**
- * @generated MessageType for protobuf message service.CreateUserRequest
+ * @generated MessageType for protobuf message service.SPFEvaluation
  */
-export const CreateUserRequest = new CreateUserRequest$Type();
+export const SPFEvaluation = new SPFEvaluation$Type();
 // @generated message type with reflection information, may provide speed optimized methods
-class CreateUserResponse$Type extends MessageType<CreateUserResponse> {
+class DKIMSelector$Type extends MessageType<DKIMSelector> {
     constructor() {
-        super("service.CreateUserResponse", [
-            { no: 1, name: "user_id", kind: "scalar", T: 9 /*ScalarType.STRING*/ }
+        super("service.DKIMSelector", [
+            { no: 1, name: "selector", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 2, name: "record", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 3, name: "valid", kind: "scalar", T: 8 /*ScalarType.BOOL*/ },
+            { no: 4, name: "validation_error", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 5, name: "key_type", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 6, name: "key_bits", kind: "scalar", T: 5 /*ScalarType.INT32*/ },
+            { no: 7, name: "source", kind: "scalar", T: 9 /*ScalarType.STRING*/ }
         ]);
     }
-    create(value?: PartialMessage<CreateUserResponse>): CreateUserResponse {
+    create(value?: PartialMessage<DKIMSelector>): DKIMSelector {
         const message = globalThis.Object.create((this.messagePrototype!));
-        message.userId = "";
+        message.selector = "";
+        message.record = "";
+        message.valid = false;
+        message.validationError = "";
+        message.keyType = "";
+        message.keyBits = 0;
+        message.source = "";
         if (value !== undefined)
-            reflectionMergePartial<CreateUserResponse>(this, message, value);
+            reflectionMergePartial<DKIMSelector>(this, message, value);
         return message;
     }
-    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: CreateUserResponse): CreateUserResponse {
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: DKIMSelector): DKIMSelector {
         let message = target ?? this.create(), end = reader.pos + length;
         while (reader.pos < end) {
             let [fieldNo, wireType] = reader.tag();
             switch (fieldNo) {
-                case /* string user_id */ 1:
-                    message.userId = reader.string();
+                case /* string selector */ 1:
+                    message.selector = reader.string();
+                    break;
+                case /* string record */ 2:
+                    message.record = reader.string();
+                    break;
+                case /* bool valid */ 3:
+                    message.valid = reader.bool();
+                    break;
+                case /* string validation_error */ 4:
+                    message.validationError = reader.string();
+                    break;
+                case /* string key_type */ 5:
+                    message.keyType = reader.string();
+                    break;
+                case /* int32 key_bits */ 6:
+                    message.keyBits = reader.int32();
+                    break;
+                case /* string source */ 7:
+                    message.source = reader.string();
                     break;
                 default:
                     let u = options.readUnknownField;
@@ -2332,10 +8202,28 @@ class CreateUserResponse$Type extends MessageType<CreateUserResponse> {
         }
         return message;
     }
-    internalBinaryWrite(message: CreateUserResponse, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
-        /* string user_id = 1; */
-        if (message.userId !== "")
-            writer.tag(1, WireType.LengthDelimited).string(message.userId);
+    internalBinaryWrite(message: DKIMSelector, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* string selector = 1; */
+        if (message.selector !== "")
+            writer.tag(1, WireType.LengthDelimited).string(message.selector);
+        /* string record = 2; */
+        if (message.record !== "")
+            writer.tag(2, WireType.LengthDelimited).string(message.record);
+        /* bool valid = 3; */
+        if (message.valid !== false)
+            writer.tag(3, WireType.Varint).bool(message.valid);
+        /* string validation_error = 4; */
+        if (message.validationError !== "")
+            writer.tag(4, WireType.LengthDelimited).string(message.validationError);
+        /* string key_type = 5; */
+        if (message.keyType !== "")
+            writer.tag(5, WireType.LengthDelimited).string(message.keyType);
+        /* int32 key_bits = 6; */
+        if (message.keyBits !== 0)
+            writer.tag(6, WireType.Varint).int32(message.keyBits);
+        /* string source = 7; */
+        if (message.source !== "")
+            writer.tag(7, WireType.LengthDelimited).string(message.source);
         let u = options.writeUnknownFields;
         if (u !== false)
             (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
@@ -2343,30 +8231,88 @@ class CreateUserResponse$Type extends MessageType<CreateUserResponse> {
     }
 }
 /**
- * @generated MessageType for protobuf message service.CreateUserResponse
+ * @generated MessageType for protobuf message service.DKIMSelector
  */
-export const CreateUserResponse = new CreateUserResponse$Type();
+export const DKIMSelector = new DKIMSelector$Type();
 // @generated message type with reflection information, may provide speed optimized methods
-class GetUserRequest$Type extends MessageType<GetUserRequest> {
+class TLSSecurityResult$Type extends MessageType<TLSSecurityResult> {
     constructor() {
-        super("service.GetUserRequest", [
-            { no: 1, name: "user_id", kind: "scalar", T: 9 /*ScalarType.STRING*/ }
+        super("service.TLSSecurityResult", [
+            { no: 1, name: "tls_version", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 2, name: "cipher_suite", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 3, name: "hsts_header", kind: "scalar", T: 8 /*ScalarType.BOOL*/ },
+            { no: 4, name: "certificate_valid", kind: "scalar", T: 8 /*ScalarType.BOOL*/ },
+            { no: 5, name: "cert_issuer", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 6, name: "cert_subject", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 7, name: "cert_not_before", kind: "message", T: () => Timestamp },
+            { no: 8, name: "cert_not_after", kind: "message", T: () => Timestamp },
+            { no: 9, name: "cert_dns_names", kind: "scalar", repeat: 2 /*RepeatType.UNPACKED*/, T: 9 /*ScalarType.STRING*/ },
+            { no: 10, name: "cert_key_strength", kind: "scalar", T: 5 /*ScalarType.INT32*/ },
+            { no: 11, name: "cert_signature_algorithm", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 12, name: "errors", kind: "scalar", repeat: 2 /*RepeatType.UNPACKED*/, T: 9 /*ScalarType.STRING*/ },
+            { no: 13, name: "endpoints", kind: "message", repeat: 2 /*RepeatType.UNPACKED*/, T: () => TLSEndpoint }
         ]);
     }
-    create(value?: PartialMessage<GetUserRequest>): GetUserRequest {
+    create(value?: PartialMessage<TLSSecurityResult>): TLSSecurityResult {
         const message = globalThis.Object.create((this.messagePrototype!));
-        message.userId = "";
+        message.tlsVersion = "";
+        message.cipherSuite = "";
+        message.hstsHeader = false;
+        message.certificateValid = false;
+        message.certIssuer = "";
+        message.certSubject = "";
+        message.certDnsNames = [];
+        message.certKeyStrength = 0;
+        message.certSignatureAlgorithm = "";
+        message.errors = [];
+        message.endpoints = [];
         if (value !== undefined)
-            reflectionMergePartial<GetUserRequest>(this, message, value);
+            reflectionMergePartial<TLSSecurityResult>(this, message, value);
         return message;
     }
-    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: GetUserRequest): GetUserRequest {
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: TLSSecurityResult): TLSSecurityResult {
         let message = target ?? this.create(), end = reader.pos + length;
         while (reader.pos < end) {
             let [fieldNo, wireType] = reader.tag();
             switch (fieldNo) {
-                case /* string user_id */ 1:
-                    message.userId = reader.string();
+                case /* string tls_version */ 1:
+                    message.tlsVersion = reader.string();
+                    break;
+                case /* string cipher_suite */ 2:
+                    message.cipherSuite = reader.string();
+                    break;
+                case /* bool hsts_header */ 3:
+                    message.hstsHeader = reader.bool();
+                    break;
+                case /* bool certificate_valid */ 4:
+                    message.certificateValid = reader.bool();
+                    break;
+                case /* string cert_issuer */ 5:
+                    message.certIssuer = reader.string();
+                    break;
+                case /* string cert_subject */ 6:
+                    message.certSubject = reader.string();
+                    break;
+                case /* google.protobuf.Timestamp cert_not_before */ 7:
+                    message.certNotBefore = Timestamp.internalBinaryRead(reader, reader.uint32(), options, message.certNotBefore);
+                    break;
+                case /* google.protobuf.Timestamp cert_not_after */ 8:
+                    message.certNotAfter = Timestamp.internalBinaryRead(reader, reader.uint32(), options, message.certNotAfter);
+                    break;
+                case /* repeated string cert_dns_names */ 9:
+                    message.certDnsNames.push(reader.string());
+                    break;
+                case /* int32 cert_key_strength */ 10:
+                    message.certKeyStrength = reader.int32();
+                    break;
+                case /* string cert_signature_algorithm */ 11:
+                    message.certSignatureAlgorithm = reader.string();
+                    break;
+                case /* repeated string errors */ 12:
+                    message.errors.push(reader.string());
+                    break;
+                case /* repeated service.TLSEndpoint endpoints */ 13:
+                    message.endpoints.push(TLSEndpoint.internalBinaryRead(reader, reader.uint32(), options));
                     break;
                 default:
                     let u = options.readUnknownField;
@@ -2379,10 +8325,46 @@ class GetUserRequest$Type extends MessageType<GetUserRequest> {
         }
         return message;
     }
-    internalBinaryWrite(message: GetUserRequest, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
-        /* string user_id = 1; */
-        if (message.userId !== "")
-            writer.tag(1, WireType.LengthDelimited).string(message.userId);
+    internalBinaryWrite(message: TLSSecurityResult, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* string tls_version = 1; */
+        if (message.tlsVersion !== "")
+            writer.tag(1, WireType.LengthDelimited).string(message.tlsVersion);
+        /* string cipher_suite = 2; */
+        if (message.cipherSuite !== "")
+            writer.tag(2, WireType.LengthDelimited).string(message.cipherSuite);
+        /* bool hsts_header = 3; */
+        if (message.hstsHeader !== false)
+            writer.tag(3, WireType.Varint).bool(message.hstsHeader);
+        /* bool certificate_valid = 4; */
+        if (message.certificateValid !== false)
+            writer.tag(4, WireType.Varint).bool(message.certificateValid);
+        /* string cert_issuer = 5; */
+        if (message.certIssuer !== "")
+            writer.tag(5, WireType.LengthDelimited).string(message.certIssuer);
+        /* string cert_subject = 6; */
+        if (message.certSubject !== "")
+            writer.tag(6, WireType.LengthDelimited).string(message.certSubject);
+        /* google.protobuf.Timestamp cert_not_before = 7; */
+        if (message.certNotBefore)
+            Timestamp.internalBinaryWrite(message.certNotBefore, writer.tag(7, WireType.LengthDelimited).fork(), options).join();
+        /* google.protobuf.Timestamp cert_not_after = 8; */
+        if (message.certNotAfter)
+            Timestamp.internalBinaryWrite(message.certNotAfter, writer.tag(8, WireType.LengthDelimited).fork(), options).join();
+        /* repeated string cert_dns_names = 9; */
+        for (let i = 0; i < message.certDnsNames.length; i++)
+            writer.tag(9, WireType.LengthDelimited).string(message.certDnsNames[i]);
+        /* int32 cert_key_strength = 10; */
+        if (message.certKeyStrength !== 0)
+            writer.tag(10, WireType.Varint).int32(message.certKeyStrength);
+        /* string cert_signature_algorithm = 11; */
+        if (message.certSignatureAlgorithm !== "")
+            writer.tag(11, WireType.LengthDelimited).string(message.certSignatureAlgorithm);
+        /* repeated string errors = 12; */
+        for (let i = 0; i < message.errors.length; i++)
+            writer.tag(12, WireType.LengthDelimited).string(message.errors[i]);
+        /* repeated service.TLSEndpoint endpoints = 13; */
+        for (let i = 0; i < message.endpoints.length; i++)
+            TLSEndpoint.internalBinaryWrite(message.endpoints[i], writer.tag(13, WireType.LengthDelimited).fork(), options).join();
         let u = options.writeUnknownFields;
         if (u !== false)
             (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
@@ -2390,54 +8372,55 @@ class GetUserRequest$Type extends MessageType<GetUserRequest> {
     }
 }
 /**
- * @generated MessageType for protobuf message service.GetUserRequest
+ * @generated MessageType for protobuf message service.TLSSecurityResult
  */
-export const GetUserRequest = new GetUserRequest$Type();
+export const TLSSecurityResult = new TLSSecurityResult$Type();
 // @generated message type with reflection information, may provide speed optimized methods
-class GetUserResponse$Type extends MessageType<GetUserResponse> {
+class TLSEndpoint$Type extends MessageType<TLSEndpoint> {
     constructor() {
-        super("service.GetUserResponse", [
-            { no: 1, name: "user_id", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
-            { no: 2, name: "first_name", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
-            { no: 3, name: "last_name", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
-            { no: 4, name: "email", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
-            { no: 5, name: "is_admin", kind: "scalar", T: 8 /*ScalarType.BOOL*/ },
-            { no: 6, name: "created_at", kind: "message", T: () => Timestamp }
+        super("service.TLSEndpoint", [
+            { no: 1, name: "host", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 2, name: "port", kind: "scalar", T: 5 /*ScalarType.INT32*/ },
+            { no: 3, name: "tls_version", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 4, name: "cipher_suite", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 5, name: "certificate_valid", kind: "scalar", T: 8 /*ScalarType.BOOL*/ },
+            { no: 6, name: "error", kind: "scalar", T: 9 /*ScalarType.STRING*/ }
         ]);
     }
-    create(value?: PartialMessage<GetUserResponse>): GetUserResponse {
+    create(value?: PartialMessage<TLSEndpoint>): TLSEndpoint {
         const message = globalThis.Object.create((this.messagePrototype!));
-        message.userId = "";
-        message.firstName = "";
-        message.lastName = "";
-        message.email = "";
-        message.isAdmin = false;
+        message.host = "";
+        message.port = 0;
+        message.tlsVersion = "";
+        message.cipherSuite = "";
+        message.certificateValid = false;
+        message.error = "";
         if (value !== undefined)
-            reflectionMergePartial<GetUserResponse>(this, message, value);
+            reflectionMergePartial<TLSEndpoint>(this, message, value);
         return message;
     }
-    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: GetUserResponse): GetUserResponse {
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: TLSEndpoint): TLSEndpoint {
         let message = target ?? this.create(), end = reader.pos + length;
         while (reader.pos < end) {
             let [fieldNo, wireType] = reader.tag();
             switch (fieldNo) {
-                case /* string user_id */ 1:
-                    message.userId = reader.string();
+                case /* string host */ 1:
+                    message.host = reader.string();
                     break;
-                case /* string first_name */ 2:
-                    message.firstName = reader.string();
+                case /* int32 port */ 2:
+                    message.port = reader.int32();
                     break;
-                case /* string last_name */ 3:
-                    message.lastName = reader.string();
+                case /* string tls_version */ 3:
+                    message.tlsVersion = reader.string();
                     break;
-                case /* string email */ 4:
-                    message.email = reader.string();
+                case /* string cipher_suite */ 4:
+                    message.cipherSuite = reader.string();
                     break;
-                case /* bool is_admin */ 5:
-                    message.isAdmin = reader.bool();
+                case /* bool certificate_valid */ 5:
+                    message.certificateValid = reader.bool();
                     break;
-                case /* google.protobuf.Timestamp created_at */ 6:
-                    message.createdAt = Timestamp.internalBinaryRead(reader, reader.uint32(), options, message.createdAt);
+                case /* string error */ 6:
+                    message.error = reader.string();
                     break;
                 default:
                     let u = options.readUnknownField;
@@ -2450,25 +8433,25 @@ class GetUserResponse$Type extends MessageType<GetUserResponse> {
         }
         return message;
     }
-    internalBinaryWrite(message: GetUserResponse, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
-        /* string user_id = 1; */
-        if (message.userId !== "")
-            writer.tag(1, WireType.LengthDelimited).string(message.userId);
-        /* string first_name = 2; */
-        if (message.firstName !== "")
-            writer.tag(2, WireType.LengthDelimited).string(message.firstName);
-        /* string last_name = 3; */
-        if (message.lastName !== "")
-            writer.tag(3, WireType.LengthDelimited).string(message.lastName);
-        /* string email = 4; */
-        if (message.email !== "")
-            writer.tag(4, WireType.LengthDelimited).string(message.email);
-        /* bool is_admin = 5; */
-        if (message.isAdmin !== false)
-            writer.tag(5, WireType.Varint).bool(message.isAdmin);
-        /* google.protobuf.Timestamp created_at = 6; */
-        if (message.createdAt)
-            Timestamp.internalBinaryWrite(message.createdAt, writer.tag(6, WireType.LengthDelimited).fork(), options).join();
+    internalBinaryWrite(message: TLSEndpoint, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* string host = 1; */
+        if (message.host !== "")
+            writer.tag(1, WireType.LengthDelimited).string(message.host);
+        /* int32 port = 2; */
+        if (message.port !== 0)
+            writer.tag(2, WireType.Varint).int32(message.port);
+        /* string tls_version = 3; */
+        if (message.tlsVersion !== "")
+            writer.tag(3, WireType.LengthDelimited).string(message.tlsVersion);
+        /* string cipher_suite = 4; */
+        if (message.cipherSuite !== "")
+            writer.tag(4, WireType.LengthDelimited).string(message.cipherSuite);
+        /* bool certificate_valid = 5; */
+        if (message.certificateValid !== false)
+            writer.tag(5, WireType.Varint).bool(message.certificateValid);
+        /* string error = 6; */
+        if (message.error !== "")
+            writer.tag(6, WireType.LengthDelimited).string(message.error);
         let u = options.writeUnknownFields;
         if (u !== false)
             (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
@@ -2476,50 +8459,63 @@ class GetUserResponse$Type extends MessageType<GetUserResponse> {
     }
 }
 /**
- * @generated MessageType for protobuf message service.GetUserResponse
+ * @generated MessageType for protobuf message service.TLSEndpoint
  */
-export const GetUserResponse = new GetUserResponse$Type();
+export const TLSEndpoint = new TLSEndpoint$Type();
 // @generated message type with reflection information, may provide speed optimized methods
-class UpdateUserRequest$Type extends MessageType<UpdateUserRequest> {
+class CrtShCertificate$Type extends MessageType<CrtShCertificate> {
     constructor() {
-        super("service.UpdateUserRequest", [
-            { no: 1, name: "user_id", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
-            { no: 2, name: "first_name", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
-            { no: 3, name: "last_name", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
-            { no: 4, name: "email", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
-            { no: 5, name: "password", kind: "scalar", T: 9 /*ScalarType.STRING*/ }
+        super("service.CrtShCertificate", [
+            { no: 1, name: "id", kind: "scalar", T: 3 /*ScalarType.INT64*/, L: 0 /*LongType.BIGINT*/ },
+            { no: 2, name: "common_name", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 3, name: "issuer", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 4, name: "not_before", kind: "message", T: () => Timestamp },
+            { no: 5, name: "not_after", kind: "message", T: () => Timestamp },
+            { no: 6, name: "serial_number", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 7, name: "dns_names", kind: "scalar", repeat: 2 /*RepeatType.UNPACKED*/, T: 9 /*ScalarType.STRING*/ },
+            { no: 8, name: "signature_algorithm", kind: "scalar", T: 9 /*ScalarType.STRING*/ }
         ]);
     }
-    create(value?: PartialMessage<UpdateUserRequest>): UpdateUserRequest {
+    create(value?: PartialMessage<CrtShCertificate>): CrtShCertificate {
         const message = globalThis.Object.create((this.messagePrototype!));
-        message.userId = "";
-        message.firstName = "";
-        message.lastName = "";
-        message.email = "";
-        message.password = "";
+        message.id = 0n;
+        message.commonName = "";
+        message.issuer = "";
+        message.serialNumber = "";
+        message.dnsNames = [];
+        message.signatureAlgorithm = "";
         if (value !== undefined)
-            reflectionMergePartial<UpdateUserRequest>(this, message, value);
+            reflectionMergePartial<CrtShCertificate>(this, message, value);
         return message;
     }
-    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: UpdateUserRequest): UpdateUserRequest {
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: CrtShCertificate): CrtShCertificate {
         let message = target ?? this.create(), end = reader.pos + length;
         while (reader.pos < end) {
             let [fieldNo, wireType] = reader.tag();
             switch (fieldNo) {
-                case /* string user_id */ 1:
-                    message.userId = reader.string();
+                case /* int64 id */ 1:
+                    message.id = reader.int64().toBigInt();
                     break;
-                case /* string first_name */ 2:
-                    message.firstName = reader.string();
+                case /* string common_name */ 2:
+                    message.commonName = reader.string();
                     break;
-                case /* string last_name */ 3:
-                    message.lastName = reader.string();
+                case /* string issuer */ 3:
+                    message.issuer = reader.string();
                     break;
-                case /* string email */ 4:
-                    message.email = reader.string();
+                case /* google.protobuf.Timestamp not_before */ 4:
+                    message.notBefore = Timestamp.internalBinaryRead(reader, reader.uint32(), options, message.notBefore);
                     break;
-                case /* string password */ 5:
-                    message.password = reader.string();
+                case /* google.protobuf.Timestamp not_after */ 5:
+                    message.notAfter = Timestamp.internalBinaryRead(reader, reader.uint32(), options, message.notAfter);
+                    break;
+                case /* string serial_number */ 6:
+                    message.serialNumber = reader.string();
+                    break;
+                case /* repeated string dns_names */ 7:
+                    message.dnsNames.push(reader.string());
+                    break;
+                case /* string signature_algorithm */ 8:
+                    message.signatureAlgorithm = reader.string();
                     break;
                 default:
                     let u = options.readUnknownField;
@@ -2532,22 +8528,31 @@ class UpdateUserRequest$Type extends MessageType<UpdateUserRequest> {
         }
         return message;
     }
-    internalBinaryWrite(message: UpdateUserRequest, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
-        /* string user_id = 1; */
-        if (message.userId !== "")
-            writer.tag(1, WireType.LengthDelimited).string(message.userId);
-        /* string first_name = 2; */
-        if (message.firstName !== "")
-            writer.tag(2, WireType.LengthDelimited).string(message.firstName);
-        /* string last_name = 3; */
-        if (message.lastName !== "")
-            writer.tag(3, WireType.LengthDelimited).string(message.lastName);
-        /* string email = 4; */
-        if (message.email !== "")
-            writer.tag(4, WireType.LengthDelimited).string(message.email);
-        /* string password = 5; */
-        if (message.password !== "")
-            writer.tag(5, WireType.LengthDelimited).string(message.password);
+    internalBinaryWrite(message: CrtShCertificate, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* int64 id = 1; */
+        if (message.id !== 0n)
+            writer.tag(1, WireType.Varint).int64(message.id);
+        /* string common_name = 2; */
+        if (message.commonName !== "")
+            writer.tag(2, WireType.LengthDelimited).string(message.commonName);
+        /* string issuer = 3; */
+        if (message.issuer !== "")
+            writer.tag(3, WireType.LengthDelimited).string(message.issuer);
+        /* google.protobuf.Timestamp not_before = 4; */
+        if (message.notBefore)
+            Timestamp.internalBinaryWrite(message.notBefore, writer.tag(4, WireType.LengthDelimited).fork(), options).join();
+        /* google.protobuf.Timestamp not_after = 5; */
+        if (message.notAfter)
+            Timestamp.internalBinaryWrite(message.notAfter, writer.tag(5, WireType.LengthDelimited).fork(), options).join();
+        /* string serial_number = 6; */
+        if (message.serialNumber !== "")
+            writer.tag(6, WireType.LengthDelimited).string(message.serialNumber);
+        /* repeated string dns_names = 7; */
+        for (let i = 0; i < message.dnsNames.length; i++)
+            writer.tag(7, WireType.LengthDelimited).string(message.dnsNames[i]);
+        /* string signature_algorithm = 8; */
+        if (message.signatureAlgorithm !== "")
+            writer.tag(8, WireType.LengthDelimited).string(message.signatureAlgorithm);
         let u = options.writeUnknownFields;
         if (u !== false)
             (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
@@ -2555,25 +8560,46 @@ class UpdateUserRequest$Type extends MessageType<UpdateUserRequest> {
     }
 }
 /**
- * @generated MessageType for protobuf message service.UpdateUserRequest
+ * @generated MessageType for protobuf message service.CrtShCertificate
  */
-export const UpdateUserRequest = new UpdateUserRequest$Type();
+export const CrtShCertificate = new CrtShCertificate$Type();
 // @generated message type with reflection information, may provide speed optimized methods
-class UpdateUserResponse$Type extends MessageType<UpdateUserResponse> {
+class CrtShSecurityResult$Type extends MessageType<CrtShSecurityResult> {
     constructor() {
-        super("service.UpdateUserResponse", []);
+        super("service.CrtShSecurityResult", [
+            { no: 1, name: "certificates", kind: "message", repeat: 2 /*RepeatType.UNPACKED*/, T: () => CrtShCertificate },
+            { no: 2, name: "subdomains", kind: "scalar", repeat: 2 /*RepeatType.UNPACKED*/, T: 9 /*ScalarType.STRING*/ },
+            { no: 3, name: "errors", kind: "scalar", repeat: 2 /*RepeatType.UNPACKED*/, T: 9 /*ScalarType.STRING*/ },
+            { no: 4, name: "unavailable", kind: "scalar", repeat: 2 /*RepeatType.UNPACKED*/, T: 9 /*ScalarType.STRING*/ }
+        ]);
     }
-    create(value?: PartialMessage<UpdateUserResponse>): UpdateUserResponse {
+    create(value?: PartialMessage<CrtShSecurityResult>): CrtShSecurityResult {
         const message = globalThis.Object.create((this.messagePrototype!));
+        message.certificates = [];
+        message.subdomains = [];
+        message.errors = [];
+        message.unavailable = [];
         if (value !== undefined)
-            reflectionMergePartial<UpdateUserResponse>(this, message, value);
+            reflectionMergePartial<CrtShSecurityResult>(this, message, value);
         return message;
     }
-    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: UpdateUserResponse): UpdateUserResponse {
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: CrtShSecurityResult): CrtShSecurityResult {
         let message = target ?? this.create(), end = reader.pos + length;
         while (reader.pos < end) {
             let [fieldNo, wireType] = reader.tag();
             switch (fieldNo) {
+                case /* repeated service.CrtShCertificate certificates */ 1:
+                    message.certificates.push(CrtShCertificate.internalBinaryRead(reader, reader.uint32(), options));
+                    break;
+                case /* repeated string subdomains */ 2:
+                    message.subdomains.push(reader.string());
+                    break;
+                case /* repeated string errors */ 3:
+                    message.errors.push(reader.string());
+                    break;
+                case /* repeated string unavailable */ 4:
+                    message.unavailable.push(reader.string());
+                    break;
                 default:
                     let u = options.readUnknownField;
                     if (u === "throw")
@@ -2585,7 +8611,19 @@ class UpdateUserResponse$Type extends MessageType<UpdateUserResponse> {
         }
         return message;
     }
-    internalBinaryWrite(message: UpdateUserResponse, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+    internalBinaryWrite(message: CrtShSecurityResult, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* repeated service.CrtShCertificate certificates = 1; */
+        for (let i = 0; i < message.certificates.length; i++)
+            CrtShCertificate.internalBinaryWrite(message.certificates[i], writer.tag(1, WireType.LengthDelimited).fork(), options).join();
+        /* repeated string subdomains = 2; */
+        for (let i = 0; i < message.subdomains.length; i++)
+            writer.tag(2, WireType.LengthDelimited).string(message.subdomains[i]);
+        /* repeated string errors = 3; */
+        for (let i = 0; i < message.errors.length; i++)
+            writer.tag(3, WireType.LengthDelimited).string(message.errors[i]);
+        /* repeated string unavailable = 4; */
+        for (let i = 0; i < message.unavailable.length; i++)
+            writer.tag(4, WireType.LengthDelimited).string(message.unavailable[i]);
         let u = options.writeUnknownFields;
         if (u !== false)
             (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
@@ -2593,30 +8631,50 @@ class UpdateUserResponse$Type extends MessageType<UpdateUserResponse> {
     }
 }
 /**
- * @generated MessageType for protobuf message service.UpdateUserResponse
+ * @generated MessageType for protobuf message service.CrtShSecurityResult
  */
-export const UpdateUserResponse = new UpdateUserResponse$Type();
+export const CrtShSecurityResult = new CrtShSecurityResult$Type();
 // @generated message type with reflection information, may provide speed optimized methods
-class DeleteUserRequest$Type extends MessageType<DeleteUserRequest> {
+class ChaosSecurityResult$Type extends MessageType<ChaosSecurityResult> {
     constructor() {
-        super("service.DeleteUserRequest", [
-            { no: 1, name: "user_id", kind: "scalar", T: 9 /*ScalarType.STRING*/ }
+        super("service.ChaosSecurityResult", [
+            { no: 1, name: "subdomains", kind: "scalar", repeat: 2 /*RepeatType.UNPACKED*/, T: 9 /*ScalarType.STRING*/ },
+            { no: 2, name: "errors", kind: "scalar", repeat: 2 /*RepeatType.UNPACKED*/, T: 9 /*ScalarType.STRING*/ },
+            { no: 3, name: "cached", kind: "scalar", T: 8 /*ScalarType.BOOL*/ },
+            { no: 4, name: "cache_age_seconds", kind: "scalar", T: 3 /*ScalarType.INT64*/, L: 0 /*LongType.BIGINT*/ },
+            { no: 5, name: "unavailable", kind: "scalar", repeat: 2 /*RepeatType.UNPACKED*/, T: 9 /*ScalarType.STRING*/ }
         ]);
     }
-    create(value?: PartialMessage<DeleteUserRequest>): DeleteUserRequest {
+    create(value?: PartialMessage<ChaosSecurityResult>): ChaosSecurityResult {
         const message = globalThis.Object.create((this.messagePrototype!));
-        message.userId = "";
+        message.subdomains = [];
+        message.errors = [];
+        message.cached = false;
+        message.cacheAgeSeconds = 0n;
+        message.unavailable = [];
         if (value !== undefined)
-            reflectionMergePartial<DeleteUserRequest>(this, message, value);
+            reflectionMergePartial<ChaosSecurityResult>(this, message, value);
         return message;
     }
-    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: DeleteUserRequest): DeleteUserRequest {
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: ChaosSecurityResult): ChaosSecurityResult {
         let message = target ?? this.create(), end = reader.pos + length;
         while (reader.pos < end) {
             let [fieldNo, wireType] = reader.tag();
             switch (fieldNo) {
-                case /* string user_id */ 1:
-                    message.userId = reader.string();
+                case /* repeated string subdomains */ 1:
+                    message.subdomains.push(reader.string());
+                    break;
+                case /* repeated string errors */ 2:
+                    message.errors.push(reader.string());
+                    break;
+                case /* bool cached */ 3:
+                    message.cached = reader.bool();
+                    break;
+                case /* int64 cache_age_seconds */ 4:
+                    message.cacheAgeSeconds = reader.int64().toBigInt();
+                    break;
+                case /* repeated string unavailable */ 5:
+                    message.unavailable.push(reader.string());
                     break;
                 default:
                     let u = options.readUnknownField;
@@ -2629,10 +8687,22 @@ class DeleteUserRequest$Type extends MessageType<DeleteUserRequest> {
         }
         return message;
     }
-    internalBinaryWrite(message: DeleteUserRequest, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
-        /* string user_id = 1; */
-        if (message.userId !== "")
-            writer.tag(1, WireType.LengthDelimited).string(message.userId);
+    internalBinaryWrite(message: ChaosSecurityResult, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* repeated string subdomains = 1; */
+        for (let i = 0; i < message.subdomains.length; i++)
+            writer.tag(1, WireType.LengthDelimited).string(message.subdomains[i]);
+        /* repeated string errors = 2; */
+        for (let i = 0; i < message.errors.length; i++)
+            writer.tag(2, WireType.LengthDelimited).string(message.errors[i]);
+        /* bool cached = 3; */
+        if (message.cached !== false)
+            writer.tag(3, WireType.Varint).bool(message.cached);
+        /* int64 cache_age_seconds = 4; */
+        if (message.cacheAgeSeconds !== 0n)
+            writer.tag(4, WireType.Varint).int64(message.cacheAgeSeconds);
+        /* repeated string unavailable = 5; */
+        for (let i = 0; i < message.unavailable.length; i++)
+            writer.tag(5, WireType.LengthDelimited).string(message.unavailable[i]);
         let u = options.writeUnknownFields;
         if (u !== false)
             (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
@@ -2640,25 +8710,49 @@ class DeleteUserRequest$Type extends MessageType<DeleteUserRequest> {
     }
 }
 /**
- * @generated MessageType for protobuf message service.DeleteUserRequest
+ * @generated MessageType for protobuf message service.ChaosSecurityResult
  */
-export const DeleteUserRequest = new DeleteUserRequest$Type();
+export const ChaosSecurityResult = new ChaosSecurityResult$Type();
 // @generated message type with reflection information, may provide speed optimized methods
-class DeleteUserResponse$Type extends MessageType<DeleteUserResponse> {
+class ShodanScanResult$Type extends MessageType<ShodanScanResult> {
     constructor() {
-        super("service.DeleteUserResponse", []);
+        super("service.ShodanScanResult", [
+            { no: 1, name: "id", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 2, name: "domain", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 3, name: "dns_scan_id", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 4, name: "result", kind: "message", T: () => ShodanSecurityResult },
+            { no: 5, name: "created_at", kind: "message", T: () => Timestamp }
+        ]);
     }
-    create(value?: PartialMessage<DeleteUserResponse>): DeleteUserResponse {
+    create(value?: PartialMessage<ShodanScanResult>): ShodanScanResult {
         const message = globalThis.Object.create((this.messagePrototype!));
+        message.id = "";
+        message.domain = "";
+        message.dnsScanId = "";
         if (value !== undefined)
-            reflectionMergePartial<DeleteUserResponse>(this, message, value);
+            reflectionMergePartial<ShodanScanResult>(this, message, value);
         return message;
     }
-    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: DeleteUserResponse): DeleteUserResponse {
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: ShodanScanResult): ShodanScanResult {
         let message = target ?? this.create(), end = reader.pos + length;
         while (reader.pos < end) {
             let [fieldNo, wireType] = reader.tag();
             switch (fieldNo) {
+                case /* string id */ 1:
+                    message.id = reader.string();
+                    break;
+                case /* string domain */ 2:
+                    message.domain = reader.string();
+                    break;
+                case /* string dns_scan_id */ 3:
+                    message.dnsScanId = reader.string();
+                    break;
+                case /* service.ShodanSecurityResult result */ 4:
+                    message.result = ShodanSecurityResult.internalBinaryRead(reader, reader.uint32(), options, message.result);
+                    break;
+                case /* google.protobuf.Timestamp created_at */ 5:
+                    message.createdAt = Timestamp.internalBinaryRead(reader, reader.uint32(), options, message.createdAt);
+                    break;
                 default:
                     let u = options.readUnknownField;
                     if (u === "throw")
@@ -2670,7 +8764,22 @@ class DeleteUserResponse$Type extends MessageType<DeleteUserResponse> {
         }
         return message;
     }
-    internalBinaryWrite(message: DeleteUserResponse, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+    internalBinaryWrite(message: ShodanScanResult, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* string id = 1; */
+        if (message.id !== "")
+            writer.tag(1, WireType.LengthDelimited).string(message.id);
+        /* string domain = 2; */
+        if (message.domain !== "")
+            writer.tag(2, WireType.LengthDelimited).string(message.domain);
+        /* string dns_scan_id = 3; */
+        if (message.dnsScanId !== "")
+            writer.tag(3, WireType.LengthDelimited).string(message.dnsScanId);
+        /* service.ShodanSecurityResult result = 4; */
+        if (message.result)
+            ShodanSecurityResult.internalBinaryWrite(message.result, writer.tag(4, WireType.LengthDelimited).fork(), options).join();
+        /* google.protobuf.Timestamp created_at = 5; */
+        if (message.createdAt)
+            Timestamp.internalBinaryWrite(message.createdAt, writer.tag(5, WireType.LengthDelimited).fork(), options).join();
         let u = options.writeUnknownFields;
         if (u !== false)
             (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
@@ -2678,25 +8787,46 @@ class DeleteUserResponse$Type extends MessageType<DeleteUserResponse> {
     }
 }
 /**
- * @generated MessageType for protobuf message service.DeleteUserResponse
+ * @generated MessageType for protobuf message service.ShodanScanResult
  */
-export const DeleteUserResponse = new DeleteUserResponse$Type();
+export const ShodanScanResult = new ShodanScanResult$Type();
 // @generated message type with reflection information, may provide speed optimized methods
-class ListUsersRequest$Type extends MessageType<ListUsersRequest> {
+class ShodanLocation$Type extends MessageType<ShodanLocation> {
     constructor() {
-        super("service.ListUsersRequest", []);
+        super("service.ShodanLocation", [
+            { no: 1, name: "city", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 2, name: "country_name", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 3, name: "latitude", kind: "scalar", T: 2 /*ScalarType.FLOAT*/ },
+            { no: 4, name: "longitude", kind: "scalar", T: 2 /*ScalarType.FLOAT*/ }
+        ]);
     }
-    create(value?: PartialMessage<ListUsersRequest>): ListUsersRequest {
+    create(value?: PartialMessage<ShodanLocation>): ShodanLocation {
         const message = globalThis.Object.create((this.messagePrototype!));
+        message.city = "";
+        message.countryName = "";
+        message.latitude = 0;
+        message.longitude = 0;
         if (value !== undefined)
-            reflectionMergePartial<ListUsersRequest>(this, message, value);
+            reflectionMergePartial<ShodanLocation>(this, message, value);
         return message;
     }
-    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: ListUsersRequest): ListUsersRequest {
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: ShodanLocation): ShodanLocation {
         let message = target ?? this.create(), end = reader.pos + length;
         while (reader.pos < end) {
             let [fieldNo, wireType] = reader.tag();
             switch (fieldNo) {
+                case /* string city */ 1:
+                    message.city = reader.string();
+                    break;
+                case /* string country_name */ 2:
+                    message.countryName = reader.string();
+                    break;
+                case /* float latitude */ 3:
+                    message.latitude = reader.float();
+                    break;
+                case /* float longitude */ 4:
+                    message.longitude = reader.float();
+                    break;
                 default:
                     let u = options.readUnknownField;
                     if (u === "throw")
@@ -2708,7 +8838,19 @@ class ListUsersRequest$Type extends MessageType<ListUsersRequest> {
         }
         return message;
     }
-    internalBinaryWrite(message: ListUsersRequest, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+    internalBinaryWrite(message: ShodanLocation, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* string city = 1; */
+        if (message.city !== "")
+            writer.tag(1, WireType.LengthDelimited).string(message.city);
+        /* string country_name = 2; */
+        if (message.countryName !== "")
+            writer.tag(2, WireType.LengthDelimited).string(message.countryName);
+        /* float latitude = 3; */
+        if (message.latitude !== 0)
+            writer.tag(3, WireType.Bit32).float(message.latitude);
+        /* float longitude = 4; */
+        if (message.longitude !== 0)
+            writer.tag(4, WireType.Bit32).float(message.longitude);
         let u = options.writeUnknownFields;
         if (u !== false)
             (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
@@ -2716,30 +8858,43 @@ class ListUsersRequest$Type extends MessageType<ListUsersRequest> {
     }
 }
 /**
- * @generated MessageType for protobuf message service.ListUsersRequest
+ * @generated MessageType for protobuf message service.ShodanLocation
  */
-export const ListUsersRequest = new ListUsersRequest$Type();
+export const ShodanLocation = new ShodanLocation$Type();
 // @generated message type with reflection information, may provide speed optimized methods
-class ListUsersResponse$Type extends MessageType<ListUsersResponse> {
+class ShodanSSL$Type extends MessageType<ShodanSSL> {
     constructor() {
-        super("service.ListUsersResponse", [
-            { no: 1, name: "users", kind: "message", repeat: 2 /*RepeatType.UNPACKED*/, T: () => User }
+        super("service.ShodanSSL", [
+            { no: 1, name: "issuer", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 2, name: "subject", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 3, name: "expires", kind: "message", T: () => Timestamp },
+            { no: 4, name: "not_after", kind: "message", T: () => Timestamp }
         ]);
     }
-    create(value?: PartialMessage<ListUsersResponse>): ListUsersResponse {
+    create(value?: PartialMessage<ShodanSSL>): ShodanSSL {
         const message = globalThis.Object.create((this.messagePrototype!));
-        message.users = [];
+        message.issuer = "";
+        message.subject = "";
         if (value !== undefined)
-            reflectionMergePartial<ListUsersResponse>(this, message, value);
+            reflectionMergePartial<ShodanSSL>(this, message, value);
         return message;
     }
-    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: ListUsersResponse): ListUsersResponse {
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: ShodanSSL): ShodanSSL {
         let message = target ?? this.create(), end = reader.pos + length;
         while (reader.pos < end) {
             let [fieldNo, wireType] = reader.tag();
             switch (fieldNo) {
-                case /* repeated service.User users */ 1:
-                    message.users.push(User.internalBinaryRead(reader, reader.uint32(), options));
+                case /* string issuer */ 1:
+                    message.issuer = reader.string();
+                    break;
+                case /* string subject */ 2:
+                    message.subject = reader.string();
+                    break;
+                case /* google.protobuf.Timestamp expires */ 3:
+                    message.expires = Timestamp.internalBinaryRead(reader, reader.uint32(), options, message.expires);
+                    break;
+                case /* google.protobuf.Timestamp not_after */ 4:
+                    message.notAfter = Timestamp.internalBinaryRead(reader, reader.uint32(), options, message.notAfter);
                     break;
                 default:
                     let u = options.readUnknownField;
@@ -2752,10 +8907,19 @@ class ListUsersResponse$Type extends MessageType<ListUsersResponse> {
         }
         return message;
     }
-    internalBinaryWrite(message: ListUsersResponse, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
-        /* repeated service.User users = 1; */
-        for (let i = 0; i < message.users.length; i++)
-            User.internalBinaryWrite(message.users[i], writer.tag(1, WireType.LengthDelimited).fork(), options).join();
+    internalBinaryWrite(message: ShodanSSL, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* string issuer = 1; */
+        if (message.issuer !== "")
+            writer.tag(1, WireType.LengthDelimited).string(message.issuer);
+        /* string subject = 2; */
+        if (message.subject !== "")
+            writer.tag(2, WireType.LengthDelimited).string(message.subject);
+        /* google.protobuf.Timestamp expires = 3; */
+        if (message.expires)
+            Timestamp.internalBinaryWrite(message.expires, writer.tag(3, WireType.LengthDelimited).fork(), options).join();
+        /* google.protobuf.Timestamp not_after = 4; */
+        if (message.notAfter)
+            Timestamp.internalBinaryWrite(message.notAfter, writer.tag(4, WireType.LengthDelimited).fork(), options).join();
         let u = options.writeUnknownFields;
         if (u !== false)
             (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
@@ -2763,54 +8927,30 @@ class ListUsersResponse$Type extends MessageType<ListUsersResponse> {
     }
 }
 /**
- * @generated MessageType for protobuf message service.ListUsersResponse
+ * @generated MessageType for protobuf message service.ShodanSSL
  */
-export const ListUsersResponse = new ListUsersResponse$Type();
+export const ShodanSSL = new ShodanSSL$Type();
 // @generated message type with reflection information, may provide speed optimized methods
-class User$Type extends MessageType<User> {
+class ShodanMetadata$Type extends MessageType<ShodanMetadata> {
     constructor() {
-        super("service.User", [
-            { no: 1, name: "id", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
-            { no: 2, name: "first_name", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
-            { no: 3, name: "last_name", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
-            { no: 4, name: "email", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
-            { no: 5, name: "is_admin", kind: "scalar", T: 8 /*ScalarType.BOOL*/ },
-            { no: 6, name: "created_at", kind: "message", T: () => Timestamp }
+        super("service.ShodanMetadata", [
+            { no: 1, name: "module", kind: "scalar", T: 9 /*ScalarType.STRING*/ }
         ]);
     }
-    create(value?: PartialMessage<User>): User {
+    create(value?: PartialMessage<ShodanMetadata>): ShodanMetadata {
         const message = globalThis.Object.create((this.messagePrototype!));
-        message.id = "";
-        message.firstName = "";
-        message.lastName = "";
-        message.email = "";
-        message.isAdmin = false;
+        message.module = "";
         if (value !== undefined)
-            reflectionMergePartial<User>(this, message, value);
+            reflectionMergePartial<ShodanMetadata>(this, message, value);
         return message;
     }
-    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: User): User {
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: ShodanMetadata): ShodanMetadata {
         let message = target ?? this.create(), end = reader.pos + length;
         while (reader.pos < end) {
             let [fieldNo, wireType] = reader.tag();
             switch (fieldNo) {
-                case /* string id */ 1:
-                    message.id = reader.string();
-                    break;
-                case /* string first_name */ 2:
-                    message.firstName = reader.string();
-                    break;
-                case /* string last_name */ 3:
-                    message.lastName = reader.string();
-                    break;
-                case /* string email */ 4:
-                    message.email = reader.string();
-                    break;
-                case /* bool is_admin */ 5:
-                    message.isAdmin = reader.bool();
-                    break;
-                case /* google.protobuf.Timestamp created_at */ 6:
-                    message.createdAt = Timestamp.internalBinaryRead(reader, reader.uint32(), options, message.createdAt);
+                case /* string module */ 1:
+                    message.module = reader.string();
                     break;
                 default:
                     let u = options.readUnknownField;
@@ -2823,25 +8963,10 @@ class User$Type extends MessageType<User> {
         }
         return message;
     }
-    internalBinaryWrite(message: User, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
-        /* string id = 1; */
-        if (message.id !== "")
-            writer.tag(1, WireType.LengthDelimited).string(message.id);
-        /* string first_name = 2; */
-        if (message.firstName !== "")
-            writer.tag(2, WireType.LengthDelimited).string(message.firstName);
-        /* string last_name = 3; */
-        if (message.lastName !== "")
-            writer.tag(3, WireType.LengthDelimited).string(message.lastName);
-        /* string email = 4; */
-        if (message.email !== "")
-            writer.tag(4, WireType.LengthDelimited).string(message.email);
-        /* bool is_admin = 5; */
-        if (message.isAdmin !== false)
-            writer.tag(5, WireType.Varint).bool(message.isAdmin);
-        /* google.protobuf.Timestamp created_at = 6; */
-        if (message.createdAt)
-            Timestamp.internalBinaryWrite(message.createdAt, writer.tag(6, WireType.LengthDelimited).fork(), options).join();
+    internalBinaryWrite(message: ShodanMetadata, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* string module = 1; */
+        if (message.module !== "")
+            writer.tag(1, WireType.LengthDelimited).string(message.module);
         let u = options.writeUnknownFields;
         if (u !== false)
             (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
@@ -2849,40 +8974,91 @@ class User$Type extends MessageType<User> {
     }
 }
 /**
- * @generated MessageType for protobuf message service.User
+ * @generated MessageType for protobuf message service.ShodanMetadata
  */
-export const User = new User$Type();
+export const ShodanMetadata = new ShodanMetadata$Type();
 // @generated message type with reflection information, may provide speed optimized methods
-class CreateAPIKeyRequest$Type extends MessageType<CreateAPIKeyRequest> {
+class ShodanHost$Type extends MessageType<ShodanHost> {
     constructor() {
-        super("service.CreateAPIKeyRequest", [
-            { no: 1, name: "user_id", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
-            { no: 2, name: "role", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
-            { no: 3, name: "is_service_key", kind: "scalar", T: 8 /*ScalarType.BOOL*/ }
+        super("service.ShodanHost", [
+            { no: 1, name: "ip", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 2, name: "port", kind: "scalar", T: 5 /*ScalarType.INT32*/ },
+            { no: 3, name: "hostnames", kind: "scalar", repeat: 2 /*RepeatType.UNPACKED*/, T: 9 /*ScalarType.STRING*/ },
+            { no: 4, name: "os", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 5, name: "banner", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 6, name: "tags", kind: "scalar", repeat: 2 /*RepeatType.UNPACKED*/, T: 9 /*ScalarType.STRING*/ },
+            { no: 7, name: "location", kind: "message", T: () => ShodanLocation },
+            { no: 8, name: "ssl", kind: "message", T: () => ShodanSSL },
+            { no: 9, name: "domains", kind: "scalar", repeat: 2 /*RepeatType.UNPACKED*/, T: 9 /*ScalarType.STRING*/ },
+            { no: 10, name: "asn", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 11, name: "org", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 12, name: "isp", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 13, name: "timestamp", kind: "message", T: () => Timestamp },
+            { no: 14, name: "shodan_meta", kind: "message", T: () => ShodanMetadata }
         ]);
     }
-    create(value?: PartialMessage<CreateAPIKeyRequest>): CreateAPIKeyRequest {
+    create(value?: PartialMessage<ShodanHost>): ShodanHost {
         const message = globalThis.Object.create((this.messagePrototype!));
-        message.userId = "";
-        message.role = "";
-        message.isServiceKey = false;
+        message.ip = "";
+        message.port = 0;
+        message.hostnames = [];
+        message.os = "";
+        message.banner = "";
+        message.tags = [];
+        message.domains = [];
+        message.asn = "";
+        message.org = "";
+        message.isp = "";
         if (value !== undefined)
-            reflectionMergePartial<CreateAPIKeyRequest>(this, message, value);
+            reflectionMergePartial<ShodanHost>(this, message, value);
         return message;
     }
-    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: CreateAPIKeyRequest): CreateAPIKeyRequest {
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: ShodanHost): ShodanHost {
         let message = target ?? this.create(), end = reader.pos + length;
         while (reader.pos < end) {
             let [fieldNo, wireType] = reader.tag();
             switch (fieldNo) {
-                case /* string user_id */ 1:
-                    message.userId = reader.string();
+                case /* string ip */ 1:
+                    message.ip = reader.string();
                     break;
-                case /* string role */ 2:
-                    message.role = reader.string();
+                case /* int32 port */ 2:
+                    message.port = reader.int32();
                     break;
-                case /* bool is_service_key */ 3:
-                    message.isServiceKey = reader.bool();
+                case /* repeated string hostnames */ 3:
+                    message.hostnames.push(reader.string());
+                    break;
+                case /* string os */ 4:
+                    message.os = reader.string();
+                    break;
+                case /* string banner */ 5:
+                    message.banner = reader.string();
+                    break;
+                case /* repeated string tags */ 6:
+                    message.tags.push(reader.string());
+                    break;
+                case /* service.ShodanLocation location */ 7:
+                    message.location = ShodanLocation.internalBinaryRead(reader, reader.uint32(), options, message.location);
+                    break;
+                case /* service.ShodanSSL ssl */ 8:
+                    message.ssl = ShodanSSL.internalBinaryRead(reader, reader.uint32(), options, message.ssl);
+                    break;
+                case /* repeated string domains */ 9:
+                    message.domains.push(reader.string());
+                    break;
+                case /* string asn */ 10:
+                    message.asn = reader.string();
+                    break;
+                case /* string org */ 11:
+                    message.org = reader.string();
+                    break;
+                case /* string isp */ 12:
+                    message.isp = reader.string();
+                    break;
+                case /* google.protobuf.Timestamp timestamp */ 13:
+                    message.timestamp = Timestamp.internalBinaryRead(reader, reader.uint32(), options, message.timestamp);
+                    break;
+                case /* service.ShodanMetadata shodan_meta */ 14:
+                    message.shodanMeta = ShodanMetadata.internalBinaryRead(reader, reader.uint32(), options, message.shodanMeta);
                     break;
                 default:
                     let u = options.readUnknownField;
@@ -2895,16 +9071,49 @@ class CreateAPIKeyRequest$Type extends MessageType<CreateAPIKeyRequest> {
         }
         return message;
     }
-    internalBinaryWrite(message: CreateAPIKeyRequest, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
-        /* string user_id = 1; */
-        if (message.userId !== "")
-            writer.tag(1, WireType.LengthDelimited).string(message.userId);
-        /* string role = 2; */
-        if (message.role !== "")
-            writer.tag(2, WireType.LengthDelimited).string(message.role);
-        /* bool is_service_key = 3; */
-        if (message.isServiceKey !== false)
-            writer.tag(3, WireType.Varint).bool(message.isServiceKey);
+    internalBinaryWrite(message: ShodanHost, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* string ip = 1; */
+        if (message.ip !== "")
+            writer.tag(1, WireType.LengthDelimited).string(message.ip);
+        /* int32 port = 2; */
+        if (message.port !== 0)
+            writer.tag(2, WireType.Varint).int32(message.port);
+        /* repeated string hostnames = 3; */
+        for (let i = 0; i < message.hostnames.length; i++)
+            writer.tag(3, WireType.LengthDelimited).string(message.hostnames[i]);
+        /* string os = 4; */
+        if (message.os !== "")
+            writer.tag(4, WireType.LengthDelimited).string(message.os);
+        /* string banner = 5; */
+        if (message.banner !== "")
+            writer.tag(5, WireType.LengthDelimited).string(message.banner);
+        /* repeated string tags = 6; */
+        for (let i = 0; i < message.tags.length; i++)
+            writer.tag(6, WireType.LengthDelimited).string(message.tags[i]);
+        /* service.ShodanLocation location = 7; */
+        if (message.location)
+            ShodanLocation.internalBinaryWrite(message.location, writer.tag(7, WireType.LengthDelimited).fork(), options).join();
+        /* service.ShodanSSL ssl = 8; */
+        if (message.ssl)
+            ShodanSSL.internalBinaryWrite(message.ssl, writer.tag(8, WireType.LengthDelimited).fork(), options).join();
+        /* repeated string domains = 9; */
+        for (let i = 0; i < message.domains.length; i++)
+            writer.tag(9, WireType.LengthDelimited).string(message.domains[i]);
+        /* string asn = 10; */
+        if (message.asn !== "")
+            writer.tag(10, WireType.LengthDelimited).string(message.asn);
+        /* string org = 11; */
+        if (message.org !== "")
+            writer.tag(11, WireType.LengthDelimited).string(message.org);
+        /* string isp = 12; */
+        if (message.isp !== "")
+            writer.tag(12, WireType.LengthDelimited).string(message.isp);
+        /* google.protobuf.Timestamp timestamp = 13; */
+        if (message.timestamp)
+            Timestamp.internalBinaryWrite(message.timestamp, writer.tag(13, WireType.LengthDelimited).fork(), options).join();
+        /* service.ShodanMetadata shodan_meta = 14; */
+        if (message.shodanMeta)
+            ShodanMetadata.internalBinaryWrite(message.shodanMeta, writer.tag(14, WireType.LengthDelimited).fork(), options).join();
         let u = options.writeUnknownFields;
         if (u !== false)
             (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
@@ -2912,44 +9121,50 @@ class CreateAPIKeyRequest$Type extends MessageType<CreateAPIKeyRequest> {
     }
 }
 /**
- * @generated MessageType for protobuf message service.CreateAPIKeyRequest
+ * @generated MessageType for protobuf message service.ShodanHost
  */
-export const CreateAPIKeyRequest = new CreateAPIKeyRequest$Type();
+export const ShodanHost = new ShodanHost$Type();
 // @generated message type with reflection information, may provide speed optimized methods
-class CreateAPIKeyResponse$Type extends MessageType<CreateAPIKeyResponse> {
+class ShodanSecurityResult$Type extends MessageType<ShodanSecurityResult> {
     constructor() {
-        super("service.CreateAPIKeyResponse", [
-            { no: 1, name: "api_key", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
-            { no: 2, name: "role", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
-            { no: 3, name: "is_service_key", kind: "scalar", T: 8 /*ScalarType.BOOL*/ },
-            { no: 4, name: "expires_at", kind: "message", T: () => Timestamp }
+        super("service.ShodanSecurityResult", [
+            { no: 1, name: "hosts", kind: "message", repeat: 2 /*RepeatType.UNPACKED*/, T: () => ShodanHost },
+            { no: 2, name: "errors", kind: "scalar", repeat: 2 /*RepeatType.UNPACKED*/, T: 9 /*ScalarType.STRING*/ },
+            { no: 3, name: "unavailable", kind: "scalar", repeat: 2 /*RepeatType.UNPACKED*/, T: 9 /*ScalarType.STRING*/ },
+            { no: 4, name: "cached", kind: "scalar", T: 8 /*ScalarType.BOOL*/ },
+            { no: 5, name: "cache_age_seconds", kind: "scalar", T: 3 /*ScalarType.INT64*/, L: 0 /*LongType.BIGINT*/ }
         ]);
     }
-    create(value?: PartialMessage<CreateAPIKeyResponse>): CreateAPIKeyResponse {
+    create(value?: PartialMessage<ShodanSecurityResult>): ShodanSecurityResult {
         const message = globalThis.Object.create((this.messagePrototype!));
-        message.apiKey = "";
-        message.role = "";
-        message.isServiceKey = false;
+        message.hosts = [];
+        message.errors = [];
+        message.unavailable = [];
+        message.cached = false;
+        message.cacheAgeSeconds = 0n;
         if (value !== undefined)
-            reflectionMergePartial<CreateAPIKeyResponse>(this, message, value);
+            reflectionMergePartial<ShodanSecurityResult>(this, message, value);
         return message;
     }
-    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: CreateAPIKeyResponse): CreateAPIKeyResponse {
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: ShodanSecurityResult): ShodanSecurityResult {
         let message = target ?? this.create(), end = reader.pos + length;
         while (reader.pos < end) {
             let [fieldNo, wireType] = reader.tag();
             switch (fieldNo) {
-                case /* string api_key */ 1:
-                    message.apiKey = reader.string();
+                case /* repeated service.ShodanHost hosts */ 1:
+                    message.hosts.push(ShodanHost.internalBinaryRead(reader, reader.uint32(), options));
                     break;
-                case /* string role */ 2:
-                    message.role = reader.string();
+                case /* repeated string errors */ 2:
+                    message.errors.push(reader.string());
                     break;
-                case /* bool is_service_key */ 3:
-                    message.isServiceKey = reader.bool();
+                case /* repeated string unavailable */ 3:
+                    message.unavailable.push(reader.string());
                     break;
-                case /* google.protobuf.Timestamp expires_at */ 4:
-                    message.expiresAt = Timestamp.internalBinaryRead(reader, reader.uint32(), options, message.expiresAt);
+                case /* bool cached */ 4:
+                    message.cached = reader.bool();
+                    break;
+                case /* int64 cache_age_seconds */ 5:
+                    message.cacheAgeSeconds = reader.int64().toBigInt();
                     break;
                 default:
                     let u = options.readUnknownField;
@@ -2962,19 +9177,22 @@ class CreateAPIKeyResponse$Type extends MessageType<CreateAPIKeyResponse> {
         }
         return message;
     }
-    internalBinaryWrite(message: CreateAPIKeyResponse, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
-        /* string api_key = 1; */
-        if (message.apiKey !== "")
-            writer.tag(1, WireType.LengthDelimited).string(message.apiKey);
-        /* string role = 2; */
-        if (message.role !== "")
-            writer.tag(2, WireType.LengthDelimited).string(message.role);
-        /* bool is_service_key = 3; */
-        if (message.isServiceKey !== false)
-            writer.tag(3, WireType.Varint).bool(message.isServiceKey);
-        /* google.protobuf.Timestamp expires_at = 4; */
-        if (message.expiresAt)
-            Timestamp.internalBinaryWrite(message.expiresAt, writer.tag(4, WireType.LengthDelimited).fork(), options).join();
+    internalBinaryWrite(message: ShodanSecurityResult, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* repeated service.ShodanHost hosts = 1; */
+        for (let i = 0; i < message.hosts.length; i++)
+            ShodanHost.internalBinaryWrite(message.hosts[i], writer.tag(1, WireType.LengthDelimited).fork(), options).join();
+        /* repeated string errors = 2; */
+        for (let i = 0; i < message.errors.length; i++)
+            writer.tag(2, WireType.LengthDelimited).string(message.errors[i]);
+        /* repeated string unavailable = 3; */
+        for (let i = 0; i < message.unavailable.length; i++)
+            writer.tag(3, WireType.LengthDelimited).string(message.unavailable[i]);
+        /* bool cached = 4; */
+        if (message.cached !== false)
+            writer.tag(4, WireType.Varint).bool(message.cached);
+        /* int64 cache_age_seconds = 5; */
+        if (message.cacheAgeSeconds !== 0n)
+            writer.tag(5, WireType.Varint).int64(message.cacheAgeSeconds);
         let u = options.writeUnknownFields;
         if (u !== false)
             (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
@@ -2982,30 +9200,40 @@ class CreateAPIKeyResponse$Type extends MessageType<CreateAPIKeyResponse> {
     }
 }
 /**
- * @generated MessageType for protobuf message service.CreateAPIKeyResponse
+ * @generated MessageType for protobuf message service.ShodanSecurityResult
  */
-export const CreateAPIKeyResponse = new CreateAPIKeyResponse$Type();
+export const ShodanSecurityResult = new ShodanSecurityResult$Type();
 // @generated message type with reflection information, may provide speed optimized methods
-class RotateAPIKeyRequest$Type extends MessageType<RotateAPIKeyRequest> {
+class ScanOTXRequest$Type extends MessageType<ScanOTXRequest> {
     constructor() {
-        super("service.RotateAPIKeyRequest", [
-            { no: 1, name: "api_key", kind: "scalar", T: 9 /*ScalarType.STRING*/ }
+        super("service.ScanOTXRequest", [
+            { no: 1, name: "domain", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 2, name: "dns_scan_id", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 3, name: "refresh", kind: "scalar", T: 8 /*ScalarType.BOOL*/ }
         ]);
     }
-    create(value?: PartialMessage<RotateAPIKeyRequest>): RotateAPIKeyRequest {
+    create(value?: PartialMessage<ScanOTXRequest>): ScanOTXRequest {
         const message = globalThis.Object.create((this.messagePrototype!));
-        message.apiKey = "";
+        message.domain = "";
+        message.dnsScanId = "";
+        message.refresh = false;
         if (value !== undefined)
-            reflectionMergePartial<RotateAPIKeyRequest>(this, message, value);
+            reflectionMergePartial<ScanOTXRequest>(this, message, value);
         return message;
     }
-    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: RotateAPIKeyRequest): RotateAPIKeyRequest {
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: ScanOTXRequest): ScanOTXRequest {
         let message = target ?? this.create(), end = reader.pos + length;
         while (reader.pos < end) {
             let [fieldNo, wireType] = reader.tag();
             switch (fieldNo) {
-                case /* string api_key */ 1:
-                    message.apiKey = reader.string();
+                case /* string domain */ 1:
+                    message.domain = reader.string();
+                    break;
+                case /* string dns_scan_id */ 2:
+                    message.dnsScanId = reader.string();
+                    break;
+                case /* bool refresh */ 3:
+                    message.refresh = reader.bool();
                     break;
                 default:
                     let u = options.readUnknownField;
@@ -3018,10 +9246,16 @@ class RotateAPIKeyRequest$Type extends MessageType<RotateAPIKeyRequest> {
         }
         return message;
     }
-    internalBinaryWrite(message: RotateAPIKeyRequest, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
-        /* string api_key = 1; */
-        if (message.apiKey !== "")
-            writer.tag(1, WireType.LengthDelimited).string(message.apiKey);
+    internalBinaryWrite(message: ScanOTXRequest, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* string domain = 1; */
+        if (message.domain !== "")
+            writer.tag(1, WireType.LengthDelimited).string(message.domain);
+        /* string dns_scan_id = 2; */
+        if (message.dnsScanId !== "")
+            writer.tag(2, WireType.LengthDelimited).string(message.dnsScanId);
+        /* bool refresh = 3; */
+        if (message.refresh !== false)
+            writer.tag(3, WireType.Varint).bool(message.refresh);
         let u = options.writeUnknownFields;
         if (u !== false)
             (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
@@ -3029,34 +9263,39 @@ class RotateAPIKeyRequest$Type extends MessageType<RotateAPIKeyRequest> {
     }
 }
 /**
- * @generated MessageType for protobuf message service.RotateAPIKeyRequest
+ * @generated MessageType for protobuf message service.ScanOTXRequest
  */
-export const RotateAPIKeyRequest = new RotateAPIKeyRequest$Type();
+export const ScanOTXRequest = new ScanOTXRequest$Type();
 // @generated message type with reflection information, may provide speed optimized methods
-class RotateAPIKeyResponse$Type extends MessageType<RotateAPIKeyResponse> {
+class ScanOTXResponse$Type extends MessageType<ScanOTXResponse> {
     constructor() {
-        super("service.RotateAPIKeyResponse", [
-            { no: 1, name: "new_api_key", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
-            { no: 2, name: "expires_at", kind: "message", T: () => Timestamp }
+        super("service.ScanOTXResponse", [
+            { no: 1, name: "scan_id", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 2, name: "result", kind: "message", T: () => OTXSecurityResult },
+            { no: 3, name: "scan_run_id", kind: "scalar", T: 9 /*ScalarType.STRING*/ }
         ]);
     }
-    create(value?: PartialMessage<RotateAPIKeyResponse>): RotateAPIKeyResponse {
+    create(value?: PartialMessage<ScanOTXResponse>): ScanOTXResponse {
         const message = globalThis.Object.create((this.messagePrototype!));
-        message.newApiKey = "";
+        message.scanId = "";
+        message.scanRunId = "";
         if (value !== undefined)
-            reflectionMergePartial<RotateAPIKeyResponse>(this, message, value);
+            reflectionMergePartial<ScanOTXResponse>(this, message, value);
         return message;
     }
-    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: RotateAPIKeyResponse): RotateAPIKeyResponse {
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: ScanOTXResponse): ScanOTXResponse {
         let message = target ?? this.create(), end = reader.pos + length;
         while (reader.pos < end) {
             let [fieldNo, wireType] = reader.tag();
             switch (fieldNo) {
-                case /* string new_api_key */ 1:
-                    message.newApiKey = reader.string();
+                case /* string scan_id */ 1:
+                    message.scanId = reader.string();
                     break;
-                case /* google.protobuf.Timestamp expires_at */ 2:
-                    message.expiresAt = Timestamp.internalBinaryRead(reader, reader.uint32(), options, message.expiresAt);
+                case /* service.OTXSecurityResult result */ 2:
+                    message.result = OTXSecurityResult.internalBinaryRead(reader, reader.uint32(), options, message.result);
+                    break;
+                case /* string scan_run_id */ 3:
+                    message.scanRunId = reader.string();
                     break;
                 default:
                     let u = options.readUnknownField;
@@ -3069,13 +9308,16 @@ class RotateAPIKeyResponse$Type extends MessageType<RotateAPIKeyResponse> {
         }
         return message;
     }
-    internalBinaryWrite(message: RotateAPIKeyResponse, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
-        /* string new_api_key = 1; */
-        if (message.newApiKey !== "")
-            writer.tag(1, WireType.LengthDelimited).string(message.newApiKey);
-        /* google.protobuf.Timestamp expires_at = 2; */
-        if (message.expiresAt)
-            Timestamp.internalBinaryWrite(message.expiresAt, writer.tag(2, WireType.LengthDelimited).fork(), options).join();
+    internalBinaryWrite(message: ScanOTXResponse, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* string scan_id = 1; */
+        if (message.scanId !== "")
+            writer.tag(1, WireType.LengthDelimited).string(message.scanId);
+        /* service.OTXSecurityResult result = 2; */
+        if (message.result)
+            OTXSecurityResult.internalBinaryWrite(message.result, writer.tag(2, WireType.LengthDelimited).fork(), options).join();
+        /* string scan_run_id = 3; */
+        if (message.scanRunId !== "")
+            writer.tag(3, WireType.LengthDelimited).string(message.scanRunId);
         let u = options.writeUnknownFields;
         if (u !== false)
             (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
@@ -3083,30 +9325,30 @@ class RotateAPIKeyResponse$Type extends MessageType<RotateAPIKeyResponse> {
     }
 }
 /**
- * @generated MessageType for protobuf message service.RotateAPIKeyResponse
+ * @generated MessageType for protobuf message service.ScanOTXResponse
  */
-export const RotateAPIKeyResponse = new RotateAPIKeyResponse$Type();
+export const ScanOTXResponse = new ScanOTXResponse$Type();
 // @generated message type with reflection information, may provide speed optimized methods
-class ActivateAPIKeyRequest$Type extends MessageType<ActivateAPIKeyRequest> {
+class GetOTXScanResultsByDomainRequest$Type extends MessageType<GetOTXScanResultsByDomainRequest> {
     constructor() {
-        super("service.ActivateAPIKeyRequest", [
-            { no: 1, name: "api_key", kind: "scalar", T: 9 /*ScalarType.STRING*/ }
+        super("service.GetOTXScanResultsByDomainRequest", [
+            { no: 1, name: "domain", kind: "scalar", T: 9 /*ScalarType.STRING*/ }
         ]);
     }
-    create(value?: PartialMessage<ActivateAPIKeyRequest>): ActivateAPIKeyRequest {
+    create(value?: PartialMessage<GetOTXScanResultsByDomainRequest>): GetOTXScanResultsByDomainRequest {
         const message = globalThis.Object.create((this.messagePrototype!));
-        message.apiKey = "";
+        message.domain = "";
         if (value !== undefined)
-            reflectionMergePartial<ActivateAPIKeyRequest>(this, message, value);
+            reflectionMergePartial<GetOTXScanResultsByDomainRequest>(this, message, value);
         return message;
     }
-    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: ActivateAPIKeyRequest): ActivateAPIKeyRequest {
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: GetOTXScanResultsByDomainRequest): GetOTXScanResultsByDomainRequest {
         let message = target ?? this.create(), end = reader.pos + length;
         while (reader.pos < end) {
             let [fieldNo, wireType] = reader.tag();
             switch (fieldNo) {
-                case /* string api_key */ 1:
-                    message.apiKey = reader.string();
+                case /* string domain */ 1:
+                    message.domain = reader.string();
                     break;
                 default:
                     let u = options.readUnknownField;
@@ -3119,10 +9361,10 @@ class ActivateAPIKeyRequest$Type extends MessageType<ActivateAPIKeyRequest> {
         }
         return message;
     }
-    internalBinaryWrite(message: ActivateAPIKeyRequest, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
-        /* string api_key = 1; */
-        if (message.apiKey !== "")
-            writer.tag(1, WireType.LengthDelimited).string(message.apiKey);
+    internalBinaryWrite(message: GetOTXScanResultsByDomainRequest, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* string domain = 1; */
+        if (message.domain !== "")
+            writer.tag(1, WireType.LengthDelimited).string(message.domain);
         let u = options.writeUnknownFields;
         if (u !== false)
             (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
@@ -3130,25 +9372,31 @@ class ActivateAPIKeyRequest$Type extends MessageType<ActivateAPIKeyRequest> {
     }
 }
 /**
- * @generated MessageType for protobuf message service.ActivateAPIKeyRequest
+ * @generated MessageType for protobuf message service.GetOTXScanResultsByDomainRequest
  */
-export const ActivateAPIKeyRequest = new ActivateAPIKeyRequest$Type();
+export const GetOTXScanResultsByDomainRequest = new GetOTXScanResultsByDomainRequest$Type();
 // @generated message type with reflection information, may provide speed optimized methods
-class ActivateAPIKeyResponse$Type extends MessageType<ActivateAPIKeyResponse> {
+class GetOTXScanResultsByDomainResponse$Type extends MessageType<GetOTXScanResultsByDomainResponse> {
     constructor() {
-        super("service.ActivateAPIKeyResponse", []);
+        super("service.GetOTXScanResultsByDomainResponse", [
+            { no: 1, name: "results", kind: "message", repeat: 2 /*RepeatType.UNPACKED*/, T: () => OTXScanResult }
+        ]);
     }
-    create(value?: PartialMessage<ActivateAPIKeyResponse>): ActivateAPIKeyResponse {
+    create(value?: PartialMessage<GetOTXScanResultsByDomainResponse>): GetOTXScanResultsByDomainResponse {
         const message = globalThis.Object.create((this.messagePrototype!));
+        message.results = [];
         if (value !== undefined)
-            reflectionMergePartial<ActivateAPIKeyResponse>(this, message, value);
+            reflectionMergePartial<GetOTXScanResultsByDomainResponse>(this, message, value);
         return message;
     }
-    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: ActivateAPIKeyResponse): ActivateAPIKeyResponse {
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: GetOTXScanResultsByDomainResponse): GetOTXScanResultsByDomainResponse {
         let message = target ?? this.create(), end = reader.pos + length;
         while (reader.pos < end) {
             let [fieldNo, wireType] = reader.tag();
             switch (fieldNo) {
+                case /* repeated service.OTXScanResult results */ 1:
+                    message.results.push(OTXScanResult.internalBinaryRead(reader, reader.uint32(), options));
+                    break;
                 default:
                     let u = options.readUnknownField;
                     if (u === "throw")
@@ -3160,7 +9408,10 @@ class ActivateAPIKeyResponse$Type extends MessageType<ActivateAPIKeyResponse> {
         }
         return message;
     }
-    internalBinaryWrite(message: ActivateAPIKeyResponse, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+    internalBinaryWrite(message: GetOTXScanResultsByDomainResponse, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* repeated service.OTXScanResult results = 1; */
+        for (let i = 0; i < message.results.length; i++)
+            OTXScanResult.internalBinaryWrite(message.results[i], writer.tag(1, WireType.LengthDelimited).fork(), options).join();
         let u = options.writeUnknownFields;
         if (u !== false)
             (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
@@ -3168,35 +9419,48 @@ class ActivateAPIKeyResponse$Type extends MessageType<ActivateAPIKeyResponse> {
     }
 }
 /**
- * @generated MessageType for protobuf message service.ActivateAPIKeyResponse
+ * @generated MessageType for protobuf message service.GetOTXScanResultsByDomainResponse
  */
-export const ActivateAPIKeyResponse = new ActivateAPIKeyResponse$Type();
+export const GetOTXScanResultsByDomainResponse = new GetOTXScanResultsByDomainResponse$Type();
 // @generated message type with reflection information, may provide speed optimized methods
-class DeactivateAPIKeyRequest$Type extends MessageType<DeactivateAPIKeyRequest> {
+class OTXScanResult$Type extends MessageType<OTXScanResult> {
     constructor() {
-        super("service.DeactivateAPIKeyRequest", [
-            { no: 1, name: "api_key", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
-            { no: 2, name: "deactivation_message", kind: "scalar", T: 9 /*ScalarType.STRING*/ }
+        super("service.OTXScanResult", [
+            { no: 1, name: "id", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 2, name: "domain", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 3, name: "dns_scan_id", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 4, name: "result", kind: "message", T: () => OTXSecurityResult },
+            { no: 5, name: "created_at", kind: "message", T: () => Timestamp }
         ]);
     }
-    create(value?: PartialMessage<DeactivateAPIKeyRequest>): DeactivateAPIKeyRequest {
+    create(value?: PartialMessage<OTXScanResult>): OTXScanResult {
         const message = globalThis.Object.create((this.messagePrototype!));
-        message.apiKey = "";
-        message.deactivationMessage = "";
+        message.id = "";
+        message.domain = "";
+        message.dnsScanId = "";
         if (value !== undefined)
-            reflectionMergePartial<DeactivateAPIKeyRequest>(this, message, value);
+            reflectionMergePartial<OTXScanResult>(this, message, value);
         return message;
     }
-    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: DeactivateAPIKeyRequest): DeactivateAPIKeyRequest {
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: OTXScanResult): OTXScanResult {
         let message = target ?? this.create(), end = reader.pos + length;
         while (reader.pos < end) {
             let [fieldNo, wireType] = reader.tag();
             switch (fieldNo) {
-                case /* string api_key */ 1:
-                    message.apiKey = reader.string();
+                case /* string id */ 1:
+                    message.id = reader.string();
                     break;
-                case /* string deactivation_message */ 2:
-                    message.deactivationMessage = reader.string();
+                case /* string domain */ 2:
+                    message.domain = reader.string();
+                    break;
+                case /* string dns_scan_id */ 3:
+                    message.dnsScanId = reader.string();
+                    break;
+                case /* service.OTXSecurityResult result */ 4:
+                    message.result = OTXSecurityResult.internalBinaryRead(reader, reader.uint32(), options, message.result);
+                    break;
+                case /* google.protobuf.Timestamp created_at */ 5:
+                    message.createdAt = Timestamp.internalBinaryRead(reader, reader.uint32(), options, message.createdAt);
                     break;
                 default:
                     let u = options.readUnknownField;
@@ -3209,13 +9473,22 @@ class DeactivateAPIKeyRequest$Type extends MessageType<DeactivateAPIKeyRequest>
         }
         return message;
     }
-    internalBinaryWrite(message: DeactivateAPIKeyRequest, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
-        /* string api_key = 1; */
-        if (message.apiKey !== "")
-            writer.tag(1, WireType.LengthDelimited).string(message.apiKey);
-        /* string deactivation_message = 2; */
-        if (message.deactivationMessage !== "")
-            writer.tag(2, WireType.LengthDelimited).string(message.deactivationMessage);
+    internalBinaryWrite(message: OTXScanResult, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* string id = 1; */
+        if (message.id !== "")
+            writer.tag(1, WireType.LengthDelimited).string(message.id);
+        /* string domain = 2; */
+        if (message.domain !== "")
+            writer.tag(2, WireType.LengthDelimited).string(message.domain);
+        /* string dns_scan_id = 3; */
+        if (message.dnsScanId !== "")
+            writer.tag(3, WireType.LengthDelimited).string(message.dnsScanId);
+        /* service.OTXSecurityResult result = 4; */
+        if (message.result)
+            OTXSecurityResult.internalBinaryWrite(message.result, writer.tag(4, WireType.LengthDelimited).fork(), options).join();
+        /* google.protobuf.Timestamp created_at = 5; */
+        if (message.createdAt)
+            Timestamp.internalBinaryWrite(message.createdAt, writer.tag(5, WireType.LengthDelimited).fork(), options).join();
         let u = options.writeUnknownFields;
         if (u !== false)
             (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
@@ -3223,25 +9496,36 @@ class DeactivateAPIKeyRequest$Type extends MessageType<DeactivateAPIKeyRequest>
     }
 }
 /**
- * @generated MessageType for protobuf message service.DeactivateAPIKeyRequest
+ * @generated MessageType for protobuf message service.OTXScanResult
  */
-export const DeactivateAPIKeyRequest = new DeactivateAPIKeyRequest$Type();
+export const OTXScanResult = new OTXScanResult$Type();
 // @generated message type with reflection information, may provide speed optimized methods
-class DeactivateAPIKeyResponse$Type extends MessageType<DeactivateAPIKeyResponse> {
+class OTXGeneralInfo$Type extends MessageType<OTXGeneralInfo> {
     constructor() {
-        super("service.DeactivateAPIKeyResponse", []);
+        super("service.OTXGeneralInfo", [
+            { no: 1, name: "pulse_count", kind: "scalar", T: 5 /*ScalarType.INT32*/ },
+            { no: 2, name: "pulses", kind: "scalar", repeat: 2 /*RepeatType.UNPACKED*/, T: 9 /*ScalarType.STRING*/ }
+        ]);
     }
-    create(value?: PartialMessage<DeactivateAPIKeyResponse>): DeactivateAPIKeyResponse {
+    create(value?: PartialMessage<OTXGeneralInfo>): OTXGeneralInfo {
         const message = globalThis.Object.create((this.messagePrototype!));
+        message.pulseCount = 0;
+        message.pulses = [];
         if (value !== undefined)
-            reflectionMergePartial<DeactivateAPIKeyResponse>(this, message, value);
+            reflectionMergePartial<OTXGeneralInfo>(this, message, value);
         return message;
     }
-    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: DeactivateAPIKeyResponse): DeactivateAPIKeyResponse {
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: OTXGeneralInfo): OTXGeneralInfo {
         let message = target ?? this.create(), end = reader.pos + length;
         while (reader.pos < end) {
             let [fieldNo, wireType] = reader.tag();
             switch (fieldNo) {
+                case /* int32 pulse_count */ 1:
+                    message.pulseCount = reader.int32();
+                    break;
+                case /* repeated string pulses */ 2:
+                    message.pulses.push(reader.string());
+                    break;
                 default:
                     let u = options.readUnknownField;
                     if (u === "throw")
@@ -3253,7 +9537,13 @@ class DeactivateAPIKeyResponse$Type extends MessageType<DeactivateAPIKeyResponse
         }
         return message;
     }
-    internalBinaryWrite(message: DeactivateAPIKeyResponse, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+    internalBinaryWrite(message: OTXGeneralInfo, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* int32 pulse_count = 1; */
+        if (message.pulseCount !== 0)
+            writer.tag(1, WireType.Varint).int32(message.pulseCount);
+        /* repeated string pulses = 2; */
+        for (let i = 0; i < message.pulses.length; i++)
+            writer.tag(2, WireType.LengthDelimited).string(message.pulses[i]);
         let u = options.writeUnknownFields;
         if (u !== false)
             (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
@@ -3261,30 +9551,34 @@ class DeactivateAPIKeyResponse$Type extends MessageType<DeactivateAPIKeyResponse
     }
 }
 /**
- * @generated MessageType for protobuf message service.DeactivateAPIKeyResponse
+ * @generated MessageType for protobuf message service.OTXGeneralInfo
  */
-export const DeactivateAPIKeyResponse = new DeactivateAPIKeyResponse$Type();
+export const OTXGeneralInfo = new OTXGeneralInfo$Type();
 // @generated message type with reflection information, may provide speed optimized methods
-class ListAPIKeysRequest$Type extends MessageType<ListAPIKeysRequest> {
+class OTXMalware$Type extends MessageType<OTXMalware> {
     constructor() {
-        super("service.ListAPIKeysRequest", [
-            { no: 1, name: "user_id", kind: "scalar", T: 9 /*ScalarType.STRING*/ }
+        super("service.OTXMalware", [
+            { no: 1, name: "hash", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 2, name: "datetime", kind: "message", T: () => Timestamp }
         ]);
     }
-    create(value?: PartialMessage<ListAPIKeysRequest>): ListAPIKeysRequest {
+    create(value?: PartialMessage<OTXMalware>): OTXMalware {
         const message = globalThis.Object.create((this.messagePrototype!));
-        message.userId = "";
+        message.hash = "";
         if (value !== undefined)
-            reflectionMergePartial<ListAPIKeysRequest>(this, message, value);
+            reflectionMergePartial<OTXMalware>(this, message, value);
         return message;
     }
-    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: ListAPIKeysRequest): ListAPIKeysRequest {
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: OTXMalware): OTXMalware {
         let message = target ?? this.create(), end = reader.pos + length;
         while (reader.pos < end) {
             let [fieldNo, wireType] = reader.tag();
             switch (fieldNo) {
-                case /* string user_id */ 1:
-                    message.userId = reader.string();
+                case /* string hash */ 1:
+                    message.hash = reader.string();
+                    break;
+                case /* google.protobuf.Timestamp datetime */ 2:
+                    message.datetime = Timestamp.internalBinaryRead(reader, reader.uint32(), options, message.datetime);
                     break;
                 default:
                     let u = options.readUnknownField;
@@ -3297,10 +9591,13 @@ class ListAPIKeysRequest$Type extends MessageType<ListAPIKeysRequest> {
         }
         return message;
     }
-    internalBinaryWrite(message: ListAPIKeysRequest, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
-        /* string user_id = 1; */
-        if (message.userId !== "")
-            writer.tag(1, WireType.LengthDelimited).string(message.userId);
+    internalBinaryWrite(message: OTXMalware, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* string hash = 1; */
+        if (message.hash !== "")
+            writer.tag(1, WireType.LengthDelimited).string(message.hash);
+        /* google.protobuf.Timestamp datetime = 2; */
+        if (message.datetime)
+            Timestamp.internalBinaryWrite(message.datetime, writer.tag(2, WireType.LengthDelimited).fork(), options).join();
         let u = options.writeUnknownFields;
         if (u !== false)
             (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
@@ -3308,30 +9605,34 @@ class ListAPIKeysRequest$Type extends MessageType<ListAPIKeysRequest> {
     }
 }
 /**
- * @generated MessageType for protobuf message service.ListAPIKeysRequest
+ * @generated MessageType for protobuf message service.OTXMalware
  */
-export const ListAPIKeysRequest = new ListAPIKeysRequest$Type();
+export const OTXMalware = new OTXMalware$Type();
 // @generated message type with reflection information, may provide speed optimized methods
-class ListAPIKeysResponse$Type extends MessageType<ListAPIKeysResponse> {
+class OTXURL$Type extends MessageType<OTXURL> {
     constructor() {
-        super("service.ListAPIKeysResponse", [
-            { no: 1, name: "api_keys", kind: "message", repeat: 2 /*RepeatType.UNPACKED*/, T: () => APIKey }
+        super("service.OTXURL", [
+            { no: 1, name: "url", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 2, name: "datetime", kind: "message", T: () => Timestamp }
         ]);
     }
-    create(value?: PartialMessage<ListAPIKeysResponse>): ListAPIKeysResponse {
+    create(value?: PartialMessage<OTXURL>): OTXURL {
         const message = globalThis.Object.create((this.messagePrototype!));
-        message.apiKeys = [];
+        message.url = "";
         if (value !== undefined)
-            reflectionMergePartial<ListAPIKeysResponse>(this, message, value);
+            reflectionMergePartial<OTXURL>(this, message, value);
         return message;
     }
-    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: ListAPIKeysResponse): ListAPIKeysResponse {
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: OTXURL): OTXURL {
         let message = target ?? this.create(), end = reader.pos + length;
         while (reader.pos < end) {
             let [fieldNo, wireType] = reader.tag();
             switch (fieldNo) {
-                case /* repeated service.APIKey api_keys */ 1:
-                    message.apiKeys.push(APIKey.internalBinaryRead(reader, reader.uint32(), options));
+                case /* string url */ 1:
+                    message.url = reader.string();
+                    break;
+                case /* google.protobuf.Timestamp datetime */ 2:
+                    message.datetime = Timestamp.internalBinaryRead(reader, reader.uint32(), options, message.datetime);
                     break;
                 default:
                     let u = options.readUnknownField;
@@ -3344,10 +9645,13 @@ class ListAPIKeysResponse$Type extends MessageType<ListAPIKeysResponse> {
         }
         return message;
     }
-    internalBinaryWrite(message: ListAPIKeysResponse, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
-        /* repeated service.APIKey api_keys = 1; */
-        for (let i = 0; i < message.apiKeys.length; i++)
-            APIKey.internalBinaryWrite(message.apiKeys[i], writer.tag(1, WireType.LengthDelimited).fork(), options).join();
+    internalBinaryWrite(message: OTXURL, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* string url = 1; */
+        if (message.url !== "")
+            writer.tag(1, WireType.LengthDelimited).string(message.url);
+        /* google.protobuf.Timestamp datetime = 2; */
+        if (message.datetime)
+            Timestamp.internalBinaryWrite(message.datetime, writer.tag(2, WireType.LengthDelimited).fork(), options).join();
         let u = options.writeUnknownFields;
         if (u !== false)
             (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
@@ -3355,63 +9659,44 @@ class ListAPIKeysResponse$Type extends MessageType<ListAPIKeysResponse> {
     }
 }
 /**
- * @generated MessageType for protobuf message service.ListAPIKeysResponse
+ * @generated MessageType for protobuf message service.OTXURL
  */
-export const ListAPIKeysResponse = new ListAPIKeysResponse$Type();
+export const OTXURL = new OTXURL$Type();
 // @generated message type with reflection information, may provide speed optimized methods
-class APIKey$Type extends MessageType<APIKey> {
+class OTXPassiveDNS$Type extends MessageType<OTXPassiveDNS> {
     constructor() {
-        super("service.APIKey", [
-            { no: 1, name: "api_key", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
-            { no: 2, name: "user_id", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
-            { no: 3, name: "role", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
-            { no: 4, name: "is_service_key", kind: "scalar", T: 8 /*ScalarType.BOOL*/ },
-            { no: 5, name: "is_active", kind: "scalar", T: 8 /*ScalarType.BOOL*/ },
-            { no: 6, name: "deactivation_message", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
-            { no: 7, name: "created_at", kind: "message", T: () => Timestamp },
-            { no: 8, name: "expires_at", kind: "message", T: () => Timestamp }
+        super("service.OTXPassiveDNS", [
+            { no: 1, name: "address", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 2, name: "hostname", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 3, name: "record", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 4, name: "datetime", kind: "message", T: () => Timestamp }
         ]);
     }
-    create(value?: PartialMessage<APIKey>): APIKey {
+    create(value?: PartialMessage<OTXPassiveDNS>): OTXPassiveDNS {
         const message = globalThis.Object.create((this.messagePrototype!));
-        message.apiKey = "";
-        message.userId = "";
-        message.role = "";
-        message.isServiceKey = false;
-        message.isActive = false;
-        message.deactivationMessage = "";
+        message.address = "";
+        message.hostname = "";
+        message.record = "";
         if (value !== undefined)
-            reflectionMergePartial<APIKey>(this, message, value);
+            reflectionMergePartial<OTXPassiveDNS>(this, message, value);
         return message;
     }
-    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: APIKey): APIKey {
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: OTXPassiveDNS): OTXPassiveDNS {
         let message = target ?? this.create(), end = reader.pos + length;
         while (reader.pos < end) {
             let [fieldNo, wireType] = reader.tag();
             switch (fieldNo) {
-                case /* string api_key */ 1:
-                    message.apiKey = reader.string();
-                    break;
-                case /* string user_id */ 2:
-                    message.userId = reader.string();
-                    break;
-                case /* string role */ 3:
-                    message.role = reader.string();
-                    break;
-                case /* bool is_service_key */ 4:
-                    message.isServiceKey = reader.bool();
-                    break;
-                case /* bool is_active */ 5:
-                    message.isActive = reader.bool();
+                case /* string address */ 1:
+                    message.address = reader.string();
                     break;
-                case /* string deactivation_message */ 6:
-                    message.deactivationMessage = reader.string();
+                case /* string hostname */ 2:
+                    message.hostname = reader.string();
                     break;
-                case /* google.protobuf.Timestamp created_at */ 7:
-                    message.createdAt = Timestamp.internalBinaryRead(reader, reader.uint32(), options, message.createdAt);
+                case /* string record */ 3:
+                    message.record = reader.string();
                     break;
-                case /* google.protobuf.Timestamp expires_at */ 8:
-                    message.expiresAt = Timestamp.internalBinaryRead(reader, reader.uint32(), options, message.expiresAt);
+                case /* google.protobuf.Timestamp datetime */ 4:
+                    message.datetime = Timestamp.internalBinaryRead(reader, reader.uint32(), options, message.datetime);
                     break;
                 default:
                     let u = options.readUnknownField;
@@ -3424,31 +9709,19 @@ class APIKey$Type extends MessageType<APIKey> {
         }
         return message;
     }
-    internalBinaryWrite(message: APIKey, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
-        /* string api_key = 1; */
-        if (message.apiKey !== "")
-            writer.tag(1, WireType.LengthDelimited).string(message.apiKey);
-        /* string user_id = 2; */
-        if (message.userId !== "")
-            writer.tag(2, WireType.LengthDelimited).string(message.userId);
-        /* string role = 3; */
-        if (message.role !== "")
-            writer.tag(3, WireType.LengthDelimited).string(message.role);
-        /* bool is_service_key = 4; */
-        if (message.isServiceKey !== false)
-            writer.tag(4, WireType.Varint).bool(message.isServiceKey);
-        /* bool is_active = 5; */
-        if (message.isActive !== false)
-            writer.tag(5, WireType.Varint).bool(message.isActive);
-        /* string deactivation_message = 6; */
-        if (message.deactivationMessage !== "")
-            writer.tag(6, WireType.LengthDelimited).string(message.deactivationMessage);
-        /* google.protobuf.Timestamp created_at = 7; */
-        if (message.createdAt)
-            Timestamp.internalBinaryWrite(message.createdAt, writer.tag(7, WireType.LengthDelimited).fork(), options).join();
-        /* google.protobuf.Timestamp expires_at = 8; */
-        if (message.expiresAt)
-            Timestamp.internalBinaryWrite(message.expiresAt, writer.tag(8, WireType.LengthDelimited).fork(), options).join();
+    internalBinaryWrite(message: OTXPassiveDNS, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* string address = 1; */
+        if (message.address !== "")
+            writer.tag(1, WireType.LengthDelimited).string(message.address);
+        /* string hostname = 2; */
+        if (message.hostname !== "")
+            writer.tag(2, WireType.LengthDelimited).string(message.hostname);
+        /* string record = 3; */
+        if (message.record !== "")
+            writer.tag(3, WireType.LengthDelimited).string(message.record);
+        /* google.protobuf.Timestamp datetime = 4; */
+        if (message.datetime)
+            Timestamp.internalBinaryWrite(message.datetime, writer.tag(4, WireType.LengthDelimited).fork(), options).join();
         let u = options.writeUnknownFields;
         if (u !== false)
             (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
@@ -3456,40 +9729,69 @@ class APIKey$Type extends MessageType<APIKey> {
     }
 }
 /**
- * @generated MessageType for protobuf message service.APIKey
+ * @generated MessageType for protobuf message service.OTXPassiveDNS
  */
-export const APIKey = new APIKey$Type();
+export const OTXPassiveDNS = new OTXPassiveDNS$Type();
 // @generated message type with reflection information, may provide speed optimized methods
-class ChangePasswordRequest$Type extends MessageType<ChangePasswordRequest> {
+class OTXSecurityResult$Type extends MessageType<OTXSecurityResult> {
     constructor() {
-        super("service.ChangePasswordRequest", [
-            { no: 1, name: "user_id", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
-            { no: 2, name: "old_password", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
-            { no: 3, name: "new_password", kind: "scalar", T: 9 /*ScalarType.STRING*/ }
+        super("service.OTXSecurityResult", [
+            { no: 1, name: "general_info", kind: "message", T: () => OTXGeneralInfo },
+            { no: 2, name: "malware", kind: "message", repeat: 2 /*RepeatType.UNPACKED*/, T: () => OTXMalware },
+            { no: 3, name: "urls", kind: "message", repeat: 2 /*RepeatType.UNPACKED*/, T: () => OTXURL },
+            { no: 4, name: "passive_dns", kind: "message", repeat: 2 /*RepeatType.UNPACKED*/, T: () => OTXPassiveDNS },
+            { no: 5, name: "errors", kind: "scalar", repeat: 2 /*RepeatType.UNPACKED*/, T: 9 /*ScalarType.STRING*/ },
+            { no: 6, name: "ip_reputation", kind: "message", repeat: 2 /*RepeatType.UNPACKED*/, T: () => OTXIPReputation },
+            { no: 7, name: "unavailable", kind: "scalar", repeat: 2 /*RepeatType.UNPACKED*/, T: 9 /*ScalarType.STRING*/ },
+            { no: 8, name: "cached", kind: "scalar", T: 8 /*ScalarType.BOOL*/ },
+            { no: 9, name: "cache_age_seconds", kind: "scalar", T: 3 /*ScalarType.INT64*/, L: 0 /*LongType.BIGINT*/ }
         ]);
     }
-    create(value?: PartialMessage<ChangePasswordRequest>): ChangePasswordRequest {
+    create(value?: PartialMessage<OTXSecurityResult>): OTXSecurityResult {
         const message = globalThis.Object.create((this.messagePrototype!));
-        message.userId = "";
-        message.oldPassword = "";
-        message.newPassword = "";
+        message.malware = [];
+        message.urls = [];
+        message.passiveDns = [];
+        message.errors = [];
+        message.ipReputation = [];
+        message.unavailable = [];
+        message.cached = false;
+        message.cacheAgeSeconds = 0n;
         if (value !== undefined)
-            reflectionMergePartial<ChangePasswordRequest>(this, message, value);
+            reflectionMergePartial<OTXSecurityResult>(this, message, value);
         return message;
     }
-    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: ChangePasswordRequest): ChangePasswordRequest {
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: OTXSecurityResult): OTXSecurityResult {
         let message = target ?? this.create(), end = reader.pos + length;
         while (reader.pos < end) {
             let [fieldNo, wireType] = reader.tag();
             switch (fieldNo) {
-                case /* string user_id */ 1:
-                    message.userId = reader.string();
+                case /* service.OTXGeneralInfo general_info */ 1:
+                    message.generalInfo = OTXGeneralInfo.internalBinaryRead(reader, reader.uint32(), options, message.generalInfo);
                     break;
-                case /* string old_password */ 2:
-                    message.oldPassword = reader.string();
+                case /* repeated service.OTXMalware malware */ 2:
+                    message.malware.push(OTXMalware.internalBinaryRead(reader, reader.uint32(), options));
                     break;
-                case /* string new_password */ 3:
-                    message.newPassword = reader.string();
+                case /* repeated service.OTXURL urls */ 3:
+                    message.urls.push(OTXURL.internalBinaryRead(reader, reader.uint32(), options));
+                    break;
+                case /* repeated service.OTXPassiveDNS passive_dns */ 4:
+                    message.passiveDns.push(OTXPassiveDNS.internalBinaryRead(reader, reader.uint32(), options));
+                    break;
+                case /* repeated string errors */ 5:
+                    message.errors.push(reader.string());
+                    break;
+                case /* repeated service.OTXIPReputation ip_reputation */ 6:
+                    message.ipReputation.push(OTXIPReputation.internalBinaryRead(reader, reader.uint32(), options));
+                    break;
+                case /* repeated string unavailable */ 7:
+                    message.unavailable.push(reader.string());
+                    break;
+                case /* bool cached */ 8:
+                    message.cached = reader.bool();
+                    break;
+                case /* int64 cache_age_seconds */ 9:
+                    message.cacheAgeSeconds = reader.int64().toBigInt();
                     break;
                 default:
                     let u = options.readUnknownField;
@@ -3502,16 +9804,34 @@ class ChangePasswordRequest$Type extends MessageType<ChangePasswordRequest> {
         }
         return message;
     }
-    internalBinaryWrite(message: ChangePasswordRequest, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
-        /* string user_id = 1; */
-        if (message.userId !== "")
-            writer.tag(1, WireType.LengthDelimited).string(message.userId);
-        /* string old_password = 2; */
-        if (message.oldPassword !== "")
-            writer.tag(2, WireType.LengthDelimited).string(message.oldPassword);
-        /* string new_password = 3; */
-        if (message.newPassword !== "")
-            writer.tag(3, WireType.LengthDelimited).string(message.newPassword);
+    internalBinaryWrite(message: OTXSecurityResult, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* service.OTXGeneralInfo general_info = 1; */
+        if (message.generalInfo)
+            OTXGeneralInfo.internalBinaryWrite(message.generalInfo, writer.tag(1, WireType.LengthDelimited).fork(), options).join();
+        /* repeated service.OTXMalware malware = 2; */
+        for (let i = 0; i < message.malware.length; i++)
+            OTXMalware.internalBinaryWrite(message.malware[i], writer.tag(2, WireType.LengthDelimited).fork(), options).join();
+        /* repeated service.OTXURL urls = 3; */
+        for (let i = 0; i < message.urls.length; i++)
+            OTXURL.internalBinaryWrite(message.urls[i], writer.tag(3, WireType.LengthDelimited).fork(), options).join();
+        /* repeated service.OTXPassiveDNS passive_dns = 4; */
+        for (let i = 0; i < message.passiveDns.length; i++)
+            OTXPassiveDNS.internalBinaryWrite(message.passiveDns[i], writer.tag(4, WireType.LengthDelimited).fork(), options).join();
+        /* repeated string errors = 5; */
+        for (let i = 0; i < message.errors.length; i++)
+            writer.tag(5, WireType.LengthDelimited).string(message.errors[i]);
+        /* repeated service.OTXIPReputation ip_reputation = 6; */
+        for (let i = 0; i < message.ipReputation.length; i++)
+            OTXIPReputation.internalBinaryWrite(message.ipReputation[i], writer.tag(6, WireType.LengthDelimited).fork(), options).join();
+        /* repeated string unavailable = 7; */
+        for (let i = 0; i < message.unavailable.length; i++)
+            writer.tag(7, WireType.LengthDelimited).string(message.unavailable[i]);
+        /* bool cached = 8; */
+        if (message.cached !== false)
+            writer.tag(8, WireType.Varint).bool(message.cached);
+        /* int64 cache_age_seconds = 9; */
+        if (message.cacheAgeSeconds !== 0n)
+            writer.tag(9, WireType.Varint).int64(message.cacheAgeSeconds);
         let u = options.writeUnknownFields;
         if (u !== false)
             (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
@@ -3519,25 +9839,46 @@ class ChangePasswordRequest$Type extends MessageType<ChangePasswordRequest> {
     }
 }
 /**
- * @generated MessageType for protobuf message service.ChangePasswordRequest
+ * @generated MessageType for protobuf message service.OTXSecurityResult
  */
-export const ChangePasswordRequest = new ChangePasswordRequest$Type();
+export const OTXSecurityResult = new OTXSecurityResult$Type();
 // @generated message type with reflection information, may provide speed optimized methods
-class ChangePasswordResponse$Type extends MessageType<ChangePasswordResponse> {
+class OTXIPReputation$Type extends MessageType<OTXIPReputation> {
     constructor() {
-        super("service.ChangePasswordResponse", []);
+        super("service.OTXIPReputation", [
+            { no: 1, name: "ip", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 2, name: "pulse_count", kind: "scalar", T: 5 /*ScalarType.INT32*/ },
+            { no: 3, name: "reputation", kind: "scalar", T: 5 /*ScalarType.INT32*/ },
+            { no: 4, name: "country_name", kind: "scalar", T: 9 /*ScalarType.STRING*/ }
+        ]);
     }
-    create(value?: PartialMessage<ChangePasswordResponse>): ChangePasswordResponse {
+    create(value?: PartialMessage<OTXIPReputation>): OTXIPReputation {
         const message = globalThis.Object.create((this.messagePrototype!));
+        message.ip = "";
+        message.pulseCount = 0;
+        message.reputation = 0;
+        message.countryName = "";
         if (value !== undefined)
-            reflectionMergePartial<ChangePasswordResponse>(this, message, value);
+            reflectionMergePartial<OTXIPReputation>(this, message, value);
         return message;
     }
-    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: ChangePasswordResponse): ChangePasswordResponse {
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: OTXIPReputation): OTXIPReputation {
         let message = target ?? this.create(), end = reader.pos + length;
         while (reader.pos < end) {
             let [fieldNo, wireType] = reader.tag();
             switch (fieldNo) {
+                case /* string ip */ 1:
+                    message.ip = reader.string();
+                    break;
+                case /* int32 pulse_count */ 2:
+                    message.pulseCount = reader.int32();
+                    break;
+                case /* int32 reputation */ 3:
+                    message.reputation = reader.int32();
+                    break;
+                case /* string country_name */ 4:
+                    message.countryName = reader.string();
+                    break;
                 default:
                     let u = options.readUnknownField;
                     if (u === "throw")
@@ -3549,7 +9890,19 @@ class ChangePasswordResponse$Type extends MessageType<ChangePasswordResponse> {
         }
         return message;
     }
-    internalBinaryWrite(message: ChangePasswordResponse, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+    internalBinaryWrite(message: OTXIPReputation, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* string ip = 1; */
+        if (message.ip !== "")
+            writer.tag(1, WireType.LengthDelimited).string(message.ip);
+        /* int32 pulse_count = 2; */
+        if (message.pulseCount !== 0)
+            writer.tag(2, WireType.Varint).int32(message.pulseCount);
+        /* int32 reputation = 3; */
+        if (message.reputation !== 0)
+            writer.tag(3, WireType.Varint).int32(message.reputation);
+        /* string country_name = 4; */
+        if (message.countryName !== "")
+            writer.tag(4, WireType.LengthDelimited).string(message.countryName);
         let u = options.writeUnknownFields;
         if (u !== false)
             (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
@@ -3557,35 +9910,35 @@ class ChangePasswordResponse$Type extends MessageType<ChangePasswordResponse> {
     }
 }
 /**
- * @generated MessageType for protobuf message service.ChangePasswordResponse
+ * @generated MessageType for protobuf message service.OTXIPReputation
  */
-export const ChangePasswordResponse = new ChangePasswordResponse$Type();
+export const OTXIPReputation = new OTXIPReputation$Type();
 // @generated message type with reflection information, may provide speed optimized methods
-class LoginRequest$Type extends MessageType<LoginRequest> {
+class ScanWhoisRequest$Type extends MessageType<ScanWhoisRequest> {
     constructor() {
-        super("service.LoginRequest", [
-            { no: 1, name: "email", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
-            { no: 2, name: "password", kind: "scalar", T: 9 /*ScalarType.STRING*/ }
+        super("service.ScanWhoisRequest", [
+            { no: 1, name: "domain", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 2, name: "dns_scan_id", kind: "scalar", T: 9 /*ScalarType.STRING*/ }
         ]);
     }
-    create(value?: PartialMessage<LoginRequest>): LoginRequest {
+    create(value?: PartialMessage<ScanWhoisRequest>): ScanWhoisRequest {
         const message = globalThis.Object.create((this.messagePrototype!));
-        message.email = "";
-        message.password = "";
+        message.domain = "";
+        message.dnsScanId = "";
         if (value !== undefined)
-            reflectionMergePartial<LoginRequest>(this, message, value);
+            reflectionMergePartial<ScanWhoisRequest>(this, message, value);
         return message;
     }
-    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: LoginRequest): LoginRequest {
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: ScanWhoisRequest): ScanWhoisRequest {
         let message = target ?? this.create(), end = reader.pos + length;
         while (reader.pos < end) {
             let [fieldNo, wireType] = reader.tag();
             switch (fieldNo) {
-                case /* string email */ 1:
-                    message.email = reader.string();
+                case /* string domain */ 1:
+                    message.domain = reader.string();
                     break;
-                case /* string password */ 2:
-                    message.password = reader.string();
+                case /* string dns_scan_id */ 2:
+                    message.dnsScanId = reader.string();
                     break;
                 default:
                     let u = options.readUnknownField;
@@ -3598,13 +9951,13 @@ class LoginRequest$Type extends MessageType<LoginRequest> {
         }
         return message;
     }
-    internalBinaryWrite(message: LoginRequest, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
-        /* string email = 1; */
-        if (message.email !== "")
-            writer.tag(1, WireType.LengthDelimited).string(message.email);
-        /* string password = 2; */
-        if (message.password !== "")
-            writer.tag(2, WireType.LengthDelimited).string(message.password);
+    internalBinaryWrite(message: ScanWhoisRequest, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* string domain = 1; */
+        if (message.domain !== "")
+            writer.tag(1, WireType.LengthDelimited).string(message.domain);
+        /* string dns_scan_id = 2; */
+        if (message.dnsScanId !== "")
+            writer.tag(2, WireType.LengthDelimited).string(message.dnsScanId);
         let u = options.writeUnknownFields;
         if (u !== false)
             (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
@@ -3612,50 +9965,39 @@ class LoginRequest$Type extends MessageType<LoginRequest> {
     }
 }
 /**
- * @generated MessageType for protobuf message service.LoginRequest
+ * @generated MessageType for protobuf message service.ScanWhoisRequest
  */
-export const LoginRequest = new LoginRequest$Type();
+export const ScanWhoisRequest = new ScanWhoisRequest$Type();
 // @generated message type with reflection information, may provide speed optimized methods
-class LoginResponse$Type extends MessageType<LoginResponse> {
-    constructor() {
-        super("service.LoginResponse", [
-            { no: 1, name: "user_id", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
-            { no: 2, name: "first_name", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
-            { no: 3, name: "last_name", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
-            { no: 4, name: "is_admin", kind: "scalar", T: 8 /*ScalarType.BOOL*/ },
-            { no: 5, name: "token", kind: "scalar", T: 9 /*ScalarType.STRING*/ }
+class ScanWhoisResponse$Type extends MessageType<ScanWhoisResponse> {
+    constructor() {
+        super("service.ScanWhoisResponse", [
+            { no: 1, name: "scan_id", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 2, name: "result", kind: "message", T: () => WhoisSecurityResult },
+            { no: 3, name: "scan_run_id", kind: "scalar", T: 9 /*ScalarType.STRING*/ }
         ]);
     }
-    create(value?: PartialMessage<LoginResponse>): LoginResponse {
+    create(value?: PartialMessage<ScanWhoisResponse>): ScanWhoisResponse {
         const message = globalThis.Object.create((this.messagePrototype!));
-        message.userId = "";
-        message.firstName = "";
-        message.lastName = "";
-        message.isAdmin = false;
-        message.token = "";
+        message.scanId = "";
+        message.scanRunId = "";
         if (value !== undefined)
-            reflectionMergePartial<LoginResponse>(this, message, value);
+            reflectionMergePartial<ScanWhoisResponse>(this, message, value);
         return message;
     }
-    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: LoginResponse): LoginResponse {
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: ScanWhoisResponse): ScanWhoisResponse {
         let message = target ?? this.create(), end = reader.pos + length;
         while (reader.pos < end) {
             let [fieldNo, wireType] = reader.tag();
             switch (fieldNo) {
-                case /* string user_id */ 1:
-                    message.userId = reader.string();
-                    break;
-                case /* string first_name */ 2:
-                    message.firstName = reader.string();
-                    break;
-                case /* string last_name */ 3:
-                    message.lastName = reader.string();
+                case /* string scan_id */ 1:
+                    message.scanId = reader.string();
                     break;
-                case /* bool is_admin */ 4:
-                    message.isAdmin = reader.bool();
+                case /* service.WhoisSecurityResult result */ 2:
+                    message.result = WhoisSecurityResult.internalBinaryRead(reader, reader.uint32(), options, message.result);
                     break;
-                case /* string token */ 5:
-                    message.token = reader.string();
+                case /* string scan_run_id */ 3:
+                    message.scanRunId = reader.string();
                     break;
                 default:
                     let u = options.readUnknownField;
@@ -3668,22 +10010,16 @@ class LoginResponse$Type extends MessageType<LoginResponse> {
         }
         return message;
     }
-    internalBinaryWrite(message: LoginResponse, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
-        /* string user_id = 1; */
-        if (message.userId !== "")
-            writer.tag(1, WireType.LengthDelimited).string(message.userId);
-        /* string first_name = 2; */
-        if (message.firstName !== "")
-            writer.tag(2, WireType.LengthDelimited).string(message.firstName);
-        /* string last_name = 3; */
-        if (message.lastName !== "")
-            writer.tag(3, WireType.LengthDelimited).string(message.lastName);
-        /* bool is_admin = 4; */
-        if (message.isAdmin !== false)
-            writer.tag(4, WireType.Varint).bool(message.isAdmin);
-        /* string token = 5; */
-        if (message.token !== "")
-            writer.tag(5, WireType.LengthDelimited).string(message.token);
+    internalBinaryWrite(message: ScanWhoisResponse, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* string scan_id = 1; */
+        if (message.scanId !== "")
+            writer.tag(1, WireType.LengthDelimited).string(message.scanId);
+        /* service.WhoisSecurityResult result = 2; */
+        if (message.result)
+            WhoisSecurityResult.internalBinaryWrite(message.result, writer.tag(2, WireType.LengthDelimited).fork(), options).join();
+        /* string scan_run_id = 3; */
+        if (message.scanRunId !== "")
+            writer.tag(3, WireType.LengthDelimited).string(message.scanRunId);
         let u = options.writeUnknownFields;
         if (u !== false)
             (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
@@ -3691,35 +10027,30 @@ class LoginResponse$Type extends MessageType<LoginResponse> {
     }
 }
 /**
- * @generated MessageType for protobuf message service.LoginResponse
+ * @generated MessageType for protobuf message service.ScanWhoisResponse
  */
-export const LoginResponse = new LoginResponse$Type();
+export const ScanWhoisResponse = new ScanWhoisResponse$Type();
 // @generated message type with reflection information, may provide speed optimized methods
-class InviteUserRequest$Type extends MessageType<InviteUserRequest> {
+class GetWhoisScanResultsByDomainRequest$Type extends MessageType<GetWhoisScanResultsByDomainRequest> {
     constructor() {
-        super("service.InviteUserRequest", [
-            { no: 1, name: "email", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
-            { no: 2, name: "is_admin", kind: "scalar", T: 8 /*ScalarType.BOOL*/ }
+        super("service.GetWhoisScanResultsByDomainRequest", [
+            { no: 1, name: "domain", kind: "scalar", T: 9 /*ScalarType.STRING*/ }
         ]);
     }
-    create(value?: PartialMessage<InviteUserRequest>): InviteUserRequest {
+    create(value?: PartialMessage<GetWhoisScanResultsByDomainRequest>): GetWhoisScanResultsByDomainRequest {
         const message = globalThis.Object.create((this.messagePrototype!));
-        message.email = "";
-        message.isAdmin = false;
+        message.domain = "";
         if (value !== undefined)
-            reflectionMergePartial<InviteUserRequest>(this, message, value);
+            reflectionMergePartial<GetWhoisScanResultsByDomainRequest>(this, message, value);
         return message;
     }
-    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: InviteUserRequest): InviteUserRequest {
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: GetWhoisScanResultsByDomainRequest): GetWhoisScanResultsByDomainRequest {
         let message = target ?? this.create(), end = reader.pos + length;
         while (reader.pos < end) {
             let [fieldNo, wireType] = reader.tag();
             switch (fieldNo) {
-                case /* string email */ 1:
-                    message.email = reader.string();
-                    break;
-                case /* bool is_admin */ 2:
-                    message.isAdmin = reader.bool();
+                case /* string domain */ 1:
+                    message.domain = reader.string();
                     break;
                 default:
                     let u = options.readUnknownField;
@@ -3732,13 +10063,10 @@ class InviteUserRequest$Type extends MessageType<InviteUserRequest> {
         }
         return message;
     }
-    internalBinaryWrite(message: InviteUserRequest, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
-        /* string email = 1; */
-        if (message.email !== "")
-            writer.tag(1, WireType.LengthDelimited).string(message.email);
-        /* bool is_admin = 2; */
-        if (message.isAdmin !== false)
-            writer.tag(2, WireType.Varint).bool(message.isAdmin);
+    internalBinaryWrite(message: GetWhoisScanResultsByDomainRequest, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* string domain = 1; */
+        if (message.domain !== "")
+            writer.tag(1, WireType.LengthDelimited).string(message.domain);
         let u = options.writeUnknownFields;
         if (u !== false)
             (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
@@ -3746,39 +10074,30 @@ class InviteUserRequest$Type extends MessageType<InviteUserRequest> {
     }
 }
 /**
- * @generated MessageType for protobuf message service.InviteUserRequest
+ * @generated MessageType for protobuf message service.GetWhoisScanResultsByDomainRequest
  */
-export const InviteUserRequest = new InviteUserRequest$Type();
+export const GetWhoisScanResultsByDomainRequest = new GetWhoisScanResultsByDomainRequest$Type();
 // @generated message type with reflection information, may provide speed optimized methods
-class InviteUserResponse$Type extends MessageType<InviteUserResponse> {
+class GetWhoisScanResultsByDomainResponse$Type extends MessageType<GetWhoisScanResultsByDomainResponse> {
     constructor() {
-        super("service.InviteUserResponse", [
-            { no: 1, name: "invitation_id", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
-            { no: 2, name: "token", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
-            { no: 3, name: "expires_at", kind: "message", T: () => Timestamp }
+        super("service.GetWhoisScanResultsByDomainResponse", [
+            { no: 1, name: "results", kind: "message", repeat: 2 /*RepeatType.UNPACKED*/, T: () => WhoisScanResult }
         ]);
     }
-    create(value?: PartialMessage<InviteUserResponse>): InviteUserResponse {
+    create(value?: PartialMessage<GetWhoisScanResultsByDomainResponse>): GetWhoisScanResultsByDomainResponse {
         const message = globalThis.Object.create((this.messagePrototype!));
-        message.invitationId = "";
-        message.token = "";
+        message.results = [];
         if (value !== undefined)
-            reflectionMergePartial<InviteUserResponse>(this, message, value);
+            reflectionMergePartial<GetWhoisScanResultsByDomainResponse>(this, message, value);
         return message;
     }
-    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: InviteUserResponse): InviteUserResponse {
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: GetWhoisScanResultsByDomainResponse): GetWhoisScanResultsByDomainResponse {
         let message = target ?? this.create(), end = reader.pos + length;
         while (reader.pos < end) {
             let [fieldNo, wireType] = reader.tag();
             switch (fieldNo) {
-                case /* string invitation_id */ 1:
-                    message.invitationId = reader.string();
-                    break;
-                case /* string token */ 2:
-                    message.token = reader.string();
-                    break;
-                case /* google.protobuf.Timestamp expires_at */ 3:
-                    message.expiresAt = Timestamp.internalBinaryRead(reader, reader.uint32(), options, message.expiresAt);
+                case /* repeated service.WhoisScanResult results */ 1:
+                    message.results.push(WhoisScanResult.internalBinaryRead(reader, reader.uint32(), options));
                     break;
                 default:
                     let u = options.readUnknownField;
@@ -3791,16 +10110,10 @@ class InviteUserResponse$Type extends MessageType<InviteUserResponse> {
         }
         return message;
     }
-    internalBinaryWrite(message: InviteUserResponse, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
-        /* string invitation_id = 1; */
-        if (message.invitationId !== "")
-            writer.tag(1, WireType.LengthDelimited).string(message.invitationId);
-        /* string token = 2; */
-        if (message.token !== "")
-            writer.tag(2, WireType.LengthDelimited).string(message.token);
-        /* google.protobuf.Timestamp expires_at = 3; */
-        if (message.expiresAt)
-            Timestamp.internalBinaryWrite(message.expiresAt, writer.tag(3, WireType.LengthDelimited).fork(), options).join();
+    internalBinaryWrite(message: GetWhoisScanResultsByDomainResponse, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* repeated service.WhoisScanResult results = 1; */
+        for (let i = 0; i < message.results.length; i++)
+            WhoisScanResult.internalBinaryWrite(message.results[i], writer.tag(1, WireType.LengthDelimited).fork(), options).join();
         let u = options.writeUnknownFields;
         if (u !== false)
             (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
@@ -3808,30 +10121,48 @@ class InviteUserResponse$Type extends MessageType<InviteUserResponse> {
     }
 }
 /**
- * @generated MessageType for protobuf message service.InviteUserResponse
+ * @generated MessageType for protobuf message service.GetWhoisScanResultsByDomainResponse
  */
-export const InviteUserResponse = new InviteUserResponse$Type();
+export const GetWhoisScanResultsByDomainResponse = new GetWhoisScanResultsByDomainResponse$Type();
 // @generated message type with reflection information, may provide speed optimized methods
-class ValidateInviteRequest$Type extends MessageType<ValidateInviteRequest> {
+class WhoisScanResult$Type extends MessageType<WhoisScanResult> {
     constructor() {
-        super("service.ValidateInviteRequest", [
-            { no: 1, name: "token", kind: "scalar", T: 9 /*ScalarType.STRING*/ }
+        super("service.WhoisScanResult", [
+            { no: 1, name: "id", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 2, name: "domain", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 3, name: "dns_scan_id", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 4, name: "result", kind: "message", T: () => WhoisSecurityResult },
+            { no: 5, name: "created_at", kind: "message", T: () => Timestamp }
         ]);
     }
-    create(value?: PartialMessage<ValidateInviteRequest>): ValidateInviteRequest {
+    create(value?: PartialMessage<WhoisScanResult>): WhoisScanResult {
         const message = globalThis.Object.create((this.messagePrototype!));
-        message.token = "";
+        message.id = "";
+        message.domain = "";
+        message.dnsScanId = "";
         if (value !== undefined)
-            reflectionMergePartial<ValidateInviteRequest>(this, message, value);
+            reflectionMergePartial<WhoisScanResult>(this, message, value);
         return message;
     }
-    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: ValidateInviteRequest): ValidateInviteRequest {
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: WhoisScanResult): WhoisScanResult {
         let message = target ?? this.create(), end = reader.pos + length;
         while (reader.pos < end) {
             let [fieldNo, wireType] = reader.tag();
             switch (fieldNo) {
-                case /* string token */ 1:
-                    message.token = reader.string();
+                case /* string id */ 1:
+                    message.id = reader.string();
+                    break;
+                case /* string domain */ 2:
+                    message.domain = reader.string();
+                    break;
+                case /* string dns_scan_id */ 3:
+                    message.dnsScanId = reader.string();
+                    break;
+                case /* service.WhoisSecurityResult result */ 4:
+                    message.result = WhoisSecurityResult.internalBinaryRead(reader, reader.uint32(), options, message.result);
+                    break;
+                case /* google.protobuf.Timestamp created_at */ 5:
+                    message.createdAt = Timestamp.internalBinaryRead(reader, reader.uint32(), options, message.createdAt);
                     break;
                 default:
                     let u = options.readUnknownField;
@@ -3844,10 +10175,22 @@ class ValidateInviteRequest$Type extends MessageType<ValidateInviteRequest> {
         }
         return message;
     }
-    internalBinaryWrite(message: ValidateInviteRequest, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
-        /* string token = 1; */
-        if (message.token !== "")
-            writer.tag(1, WireType.LengthDelimited).string(message.token);
+    internalBinaryWrite(message: WhoisScanResult, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* string id = 1; */
+        if (message.id !== "")
+            writer.tag(1, WireType.LengthDelimited).string(message.id);
+        /* string domain = 2; */
+        if (message.domain !== "")
+            writer.tag(2, WireType.LengthDelimited).string(message.domain);
+        /* string dns_scan_id = 3; */
+        if (message.dnsScanId !== "")
+            writer.tag(3, WireType.LengthDelimited).string(message.dnsScanId);
+        /* service.WhoisSecurityResult result = 4; */
+        if (message.result)
+            WhoisSecurityResult.internalBinaryWrite(message.result, writer.tag(4, WireType.LengthDelimited).fork(), options).join();
+        /* google.protobuf.Timestamp created_at = 5; */
+        if (message.createdAt)
+            Timestamp.internalBinaryWrite(message.createdAt, writer.tag(5, WireType.LengthDelimited).fork(), options).join();
         let u = options.writeUnknownFields;
         if (u !== false)
             (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
@@ -3855,35 +10198,53 @@ class ValidateInviteRequest$Type extends MessageType<ValidateInviteRequest> {
     }
 }
 /**
- * @generated MessageType for protobuf message service.ValidateInviteRequest
+ * @generated MessageType for protobuf message service.WhoisScanResult
  */
-export const ValidateInviteRequest = new ValidateInviteRequest$Type();
+export const WhoisScanResult = new WhoisScanResult$Type();
 // @generated message type with reflection information, may provide speed optimized methods
-class ValidateInviteResponse$Type extends MessageType<ValidateInviteResponse> {
+class WhoisSecurityResult$Type extends MessageType<WhoisSecurityResult> {
     constructor() {
-        super("service.ValidateInviteResponse", [
-            { no: 1, name: "email", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
-            { no: 2, name: "is_admin", kind: "scalar", T: 8 /*ScalarType.BOOL*/ }
+        super("service.WhoisSecurityResult", [
+            { no: 1, name: "domain", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 2, name: "registrar", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 3, name: "creation_date", kind: "message", T: () => Timestamp },
+            { no: 4, name: "expiry_date", kind: "message", T: () => Timestamp },
+            { no: 5, name: "registrant_name", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 6, name: "errors", kind: "scalar", repeat: 2 /*RepeatType.UNPACKED*/, T: 9 /*ScalarType.STRING*/ }
         ]);
     }
-    create(value?: PartialMessage<ValidateInviteResponse>): ValidateInviteResponse {
+    create(value?: PartialMessage<WhoisSecurityResult>): WhoisSecurityResult {
         const message = globalThis.Object.create((this.messagePrototype!));
-        message.email = "";
-        message.isAdmin = false;
+        message.domain = "";
+        message.registrar = "";
+        message.registrantName = "";
+        message.errors = [];
         if (value !== undefined)
-            reflectionMergePartial<ValidateInviteResponse>(this, message, value);
+            reflectionMergePartial<WhoisSecurityResult>(this, message, value);
         return message;
     }
-    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: ValidateInviteResponse): ValidateInviteResponse {
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: WhoisSecurityResult): WhoisSecurityResult {
         let message = target ?? this.create(), end = reader.pos + length;
         while (reader.pos < end) {
             let [fieldNo, wireType] = reader.tag();
             switch (fieldNo) {
-                case /* string email */ 1:
-                    message.email = reader.string();
+                case /* string domain */ 1:
+                    message.domain = reader.string();
                     break;
-                case /* bool is_admin */ 2:
-                    message.isAdmin = reader.bool();
+                case /* string registrar */ 2:
+                    message.registrar = reader.string();
+                    break;
+                case /* google.protobuf.Timestamp creation_date */ 3:
+                    message.creationDate = Timestamp.internalBinaryRead(reader, reader.uint32(), options, message.creationDate);
+                    break;
+                case /* google.protobuf.Timestamp expiry_date */ 4:
+                    message.expiryDate = Timestamp.internalBinaryRead(reader, reader.uint32(), options, message.expiryDate);
+                    break;
+                case /* string registrant_name */ 5:
+                    message.registrantName = reader.string();
+                    break;
+                case /* repeated string errors */ 6:
+                    message.errors.push(reader.string());
                     break;
                 default:
                     let u = options.readUnknownField;
@@ -3896,13 +10257,25 @@ class ValidateInviteResponse$Type extends MessageType<ValidateInviteResponse> {
         }
         return message;
     }
-    internalBinaryWrite(message: ValidateInviteResponse, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
-        /* string email = 1; */
-        if (message.email !== "")
-            writer.tag(1, WireType.LengthDelimited).string(message.email);
-        /* bool is_admin = 2; */
-        if (message.isAdmin !== false)
-            writer.tag(2, WireType.Varint).bool(message.isAdmin);
+    internalBinaryWrite(message: WhoisSecurityResult, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* string domain = 1; */
+        if (message.domain !== "")
+            writer.tag(1, WireType.LengthDelimited).string(message.domain);
+        /* string registrar = 2; */
+        if (message.registrar !== "")
+            writer.tag(2, WireType.LengthDelimited).string(message.registrar);
+        /* google.protobuf.Timestamp creation_date = 3; */
+        if (message.creationDate)
+            Timestamp.internalBinaryWrite(message.creationDate, writer.tag(3, WireType.LengthDelimited).fork(), options).join();
+        /* google.protobuf.Timestamp expiry_date = 4; */
+        if (message.expiryDate)
+            Timestamp.internalBinaryWrite(message.expiryDate, writer.tag(4, WireType.LengthDelimited).fork(), options).join();
+        /* string registrant_name = 5; */
+        if (message.registrantName !== "")
+            writer.tag(5, WireType.LengthDelimited).string(message.registrantName);
+        /* repeated string errors = 6; */
+        for (let i = 0; i < message.errors.length; i++)
+            writer.tag(6, WireType.LengthDelimited).string(message.errors[i]);
         let u = options.writeUnknownFields;
         if (u !== false)
             (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
@@ -3910,30 +10283,63 @@ class ValidateInviteResponse$Type extends MessageType<ValidateInviteResponse> {
     }
 }
 /**
- * @generated MessageType for protobuf message service.ValidateInviteResponse
+ * @generated MessageType for protobuf message service.WhoisSecurityResult
  */
-export const ValidateInviteResponse = new ValidateInviteResponse$Type();
+export const WhoisSecurityResult = new WhoisSecurityResult$Type();
 // @generated message type with reflection information, may provide speed optimized methods
-class ScanDomainRequest$Type extends MessageType<ScanDomainRequest> {
+class AbuseChIOC$Type extends MessageType<AbuseChIOC> {
     constructor() {
-        super("service.ScanDomainRequest", [
-            { no: 1, name: "domain", kind: "scalar", T: 9 /*ScalarType.STRING*/ }
+        super("service.AbuseChIOC", [
+            { no: 1, name: "ioc_type", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 2, name: "ioc_value", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 3, name: "threat_type", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 4, name: "confidence", kind: "scalar", T: 2 /*ScalarType.FLOAT*/ },
+            { no: 5, name: "first_seen", kind: "message", T: () => Timestamp },
+            { no: 6, name: "last_seen", kind: "message", T: () => Timestamp },
+            { no: 7, name: "malware_alias", kind: "scalar", repeat: 2 /*RepeatType.UNPACKED*/, T: 9 /*ScalarType.STRING*/ },
+            { no: 8, name: "tags", kind: "scalar", repeat: 2 /*RepeatType.UNPACKED*/, T: 9 /*ScalarType.STRING*/ }
         ]);
     }
-    create(value?: PartialMessage<ScanDomainRequest>): ScanDomainRequest {
+    create(value?: PartialMessage<AbuseChIOC>): AbuseChIOC {
         const message = globalThis.Object.create((this.messagePrototype!));
-        message.domain = "";
+        message.iocType = "";
+        message.iocValue = "";
+        message.threatType = "";
+        message.confidence = 0;
+        message.malwareAlias = [];
+        message.tags = [];
         if (value !== undefined)
-            reflectionMergePartial<ScanDomainRequest>(this, message, value);
+            reflectionMergePartial<AbuseChIOC>(this, message, value);
         return message;
     }
-    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: ScanDomainRequest): ScanDomainRequest {
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: AbuseChIOC): AbuseChIOC {
         let message = target ?? this.create(), end = reader.pos + length;
         while (reader.pos < end) {
             let [fieldNo, wireType] = reader.tag();
             switch (fieldNo) {
-                case /* string domain */ 1:
-                    message.domain = reader.string();
+                case /* string ioc_type */ 1:
+                    message.iocType = reader.string();
+                    break;
+                case /* string ioc_value */ 2:
+                    message.iocValue = reader.string();
+                    break;
+                case /* string threat_type */ 3:
+                    message.threatType = reader.string();
+                    break;
+                case /* float confidence */ 4:
+                    message.confidence = reader.float();
+                    break;
+                case /* google.protobuf.Timestamp first_seen */ 5:
+                    message.firstSeen = Timestamp.internalBinaryRead(reader, reader.uint32(), options, message.firstSeen);
+                    break;
+                case /* google.protobuf.Timestamp last_seen */ 6:
+                    message.lastSeen = Timestamp.internalBinaryRead(reader, reader.uint32(), options, message.lastSeen);
+                    break;
+                case /* repeated string malware_alias */ 7:
+                    message.malwareAlias.push(reader.string());
+                    break;
+                case /* repeated string tags */ 8:
+                    message.tags.push(reader.string());
                     break;
                 default:
                     let u = options.readUnknownField;
@@ -3946,10 +10352,31 @@ class ScanDomainRequest$Type extends MessageType<ScanDomainRequest> {
         }
         return message;
     }
-    internalBinaryWrite(message: ScanDomainRequest, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
-        /* string domain = 1; */
-        if (message.domain !== "")
-            writer.tag(1, WireType.LengthDelimited).string(message.domain);
+    internalBinaryWrite(message: AbuseChIOC, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* string ioc_type = 1; */
+        if (message.iocType !== "")
+            writer.tag(1, WireType.LengthDelimited).string(message.iocType);
+        /* string ioc_value = 2; */
+        if (message.iocValue !== "")
+            writer.tag(2, WireType.LengthDelimited).string(message.iocValue);
+        /* string threat_type = 3; */
+        if (message.threatType !== "")
+            writer.tag(3, WireType.LengthDelimited).string(message.threatType);
+        /* float confidence = 4; */
+        if (message.confidence !== 0)
+            writer.tag(4, WireType.Bit32).float(message.confidence);
+        /* google.protobuf.Timestamp first_seen = 5; */
+        if (message.firstSeen)
+            Timestamp.internalBinaryWrite(message.firstSeen, writer.tag(5, WireType.LengthDelimited).fork(), options).join();
+        /* google.protobuf.Timestamp last_seen = 6; */
+        if (message.lastSeen)
+            Timestamp.internalBinaryWrite(message.lastSeen, writer.tag(6, WireType.LengthDelimited).fork(), options).join();
+        /* repeated string malware_alias = 7; */
+        for (let i = 0; i < message.malwareAlias.length; i++)
+            writer.tag(7, WireType.LengthDelimited).string(message.malwareAlias[i]);
+        /* repeated string tags = 8; */
+        for (let i = 0; i < message.tags.length; i++)
+            writer.tag(8, WireType.LengthDelimited).string(message.tags[i]);
         let u = options.writeUnknownFields;
         if (u !== false)
             (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
@@ -3957,34 +10384,40 @@ class ScanDomainRequest$Type extends MessageType<ScanDomainRequest> {
     }
 }
 /**
- * @generated MessageType for protobuf message service.ScanDomainRequest
+ * @generated MessageType for protobuf message service.AbuseChIOC
  */
-export const ScanDomainRequest = new ScanDomainRequest$Type();
+export const AbuseChIOC = new AbuseChIOC$Type();
 // @generated message type with reflection information, may provide speed optimized methods
-class ScanDomainResponse$Type extends MessageType<ScanDomainResponse> {
+class AbuseChSecurityResult$Type extends MessageType<AbuseChSecurityResult> {
     constructor() {
-        super("service.ScanDomainResponse", [
-            { no: 1, name: "scan_id", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
-            { no: 2, name: "result", kind: "message", T: () => DNSSecurityResult }
+        super("service.AbuseChSecurityResult", [
+            { no: 1, name: "iocs", kind: "message", repeat: 2 /*RepeatType.UNPACKED*/, T: () => AbuseChIOC },
+            { no: 2, name: "errors", kind: "scalar", repeat: 2 /*RepeatType.UNPACKED*/, T: 9 /*ScalarType.STRING*/ },
+            { no: 3, name: "unavailable", kind: "scalar", repeat: 2 /*RepeatType.UNPACKED*/, T: 9 /*ScalarType.STRING*/ }
         ]);
     }
-    create(value?: PartialMessage<ScanDomainResponse>): ScanDomainResponse {
+    create(value?: PartialMessage<AbuseChSecurityResult>): AbuseChSecurityResult {
         const message = globalThis.Object.create((this.messagePrototype!));
-        message.scanId = "";
+        message.iocs = [];
+        message.errors = [];
+        message.unavailable = [];
         if (value !== undefined)
-            reflectionMergePartial<ScanDomainResponse>(this, message, value);
+            reflectionMergePartial<AbuseChSecurityResult>(this, message, value);
         return message;
     }
-    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: ScanDomainResponse): ScanDomainResponse {
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: AbuseChSecurityResult): AbuseChSecurityResult {
         let message = target ?? this.create(), end = reader.pos + length;
         while (reader.pos < end) {
             let [fieldNo, wireType] = reader.tag();
             switch (fieldNo) {
-                case /* string scan_id */ 1:
-                    message.scanId = reader.string();
+                case /* repeated service.AbuseChIOC iocs */ 1:
+                    message.iocs.push(AbuseChIOC.internalBinaryRead(reader, reader.uint32(), options));
                     break;
-                case /* service.DNSSecurityResult result */ 2:
-                    message.result = DNSSecurityResult.internalBinaryRead(reader, reader.uint32(), options, message.result);
+                case /* repeated string errors */ 2:
+                    message.errors.push(reader.string());
+                    break;
+                case /* repeated string unavailable */ 3:
+                    message.unavailable.push(reader.string());
                     break;
                 default:
                     let u = options.readUnknownField;
@@ -3997,13 +10430,16 @@ class ScanDomainResponse$Type extends MessageType<ScanDomainResponse> {
         }
         return message;
     }
-    internalBinaryWrite(message: ScanDomainResponse, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
-        /* string scan_id = 1; */
-        if (message.scanId !== "")
-            writer.tag(1, WireType.LengthDelimited).string(message.scanId);
-        /* service.DNSSecurityResult result = 2; */
-        if (message.result)
-            DNSSecurityResult.internalBinaryWrite(message.result, writer.tag(2, WireType.LengthDelimited).fork(), options).join();
+    internalBinaryWrite(message: AbuseChSecurityResult, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* repeated service.AbuseChIOC iocs = 1; */
+        for (let i = 0; i < message.iocs.length; i++)
+            AbuseChIOC.internalBinaryWrite(message.iocs[i], writer.tag(1, WireType.LengthDelimited).fork(), options).join();
+        /* repeated string errors = 2; */
+        for (let i = 0; i < message.errors.length; i++)
+            writer.tag(2, WireType.LengthDelimited).string(message.errors[i]);
+        /* repeated string unavailable = 3; */
+        for (let i = 0; i < message.unavailable.length; i++)
+            writer.tag(3, WireType.LengthDelimited).string(message.unavailable[i]);
         let u = options.writeUnknownFields;
         if (u !== false)
             (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
@@ -4011,24 +10447,26 @@ class ScanDomainResponse$Type extends MessageType<ScanDomainResponse> {
     }
 }
 /**
- * @generated MessageType for protobuf message service.ScanDomainResponse
+ * @generated MessageType for protobuf message service.AbuseChSecurityResult
  */
-export const ScanDomainResponse = new ScanDomainResponse$Type();
+export const AbuseChSecurityResult = new AbuseChSecurityResult$Type();
 // @generated message type with reflection information, may provide speed optimized methods
-class GetDNSScanResultsByDomainRequest$Type extends MessageType<GetDNSScanResultsByDomainRequest> {
+class ScanAbuseChRequest$Type extends MessageType<ScanAbuseChRequest> {
     constructor() {
-        super("service.GetDNSScanResultsByDomainRequest", [
-            { no: 1, name: "domain", kind: "scalar", T: 9 /*ScalarType.STRING*/ }
+        super("service.ScanAbuseChRequest", [
+            { no: 1, name: "domain", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 2, name: "dns_scan_id", kind: "scalar", T: 9 /*ScalarType.STRING*/ }
         ]);
     }
-    create(value?: PartialMessage<GetDNSScanResultsByDomainRequest>): GetDNSScanResultsByDomainRequest {
+    create(value?: PartialMessage<ScanAbuseChRequest>): ScanAbuseChRequest {
         const message = globalThis.Object.create((this.messagePrototype!));
         message.domain = "";
+        message.dnsScanId = "";
         if (value !== undefined)
-            reflectionMergePartial<GetDNSScanResultsByDomainRequest>(this, message, value);
+            reflectionMergePartial<ScanAbuseChRequest>(this, message, value);
         return message;
     }
-    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: GetDNSScanResultsByDomainRequest): GetDNSScanResultsByDomainRequest {
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: ScanAbuseChRequest): ScanAbuseChRequest {
         let message = target ?? this.create(), end = reader.pos + length;
         while (reader.pos < end) {
             let [fieldNo, wireType] = reader.tag();
@@ -4036,6 +10474,9 @@ class GetDNSScanResultsByDomainRequest$Type extends MessageType<GetDNSScanResult
                 case /* string domain */ 1:
                     message.domain = reader.string();
                     break;
+                case /* string dns_scan_id */ 2:
+                    message.dnsScanId = reader.string();
+                    break;
                 default:
                     let u = options.readUnknownField;
                     if (u === "throw")
@@ -4047,10 +10488,13 @@ class GetDNSScanResultsByDomainRequest$Type extends MessageType<GetDNSScanResult
         }
         return message;
     }
-    internalBinaryWrite(message: GetDNSScanResultsByDomainRequest, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+    internalBinaryWrite(message: ScanAbuseChRequest, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
         /* string domain = 1; */
         if (message.domain !== "")
             writer.tag(1, WireType.LengthDelimited).string(message.domain);
+        /* string dns_scan_id = 2; */
+        if (message.dnsScanId !== "")
+            writer.tag(2, WireType.LengthDelimited).string(message.dnsScanId);
         let u = options.writeUnknownFields;
         if (u !== false)
             (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
@@ -4058,30 +10502,39 @@ class GetDNSScanResultsByDomainRequest$Type extends MessageType<GetDNSScanResult
     }
 }
 /**
- * @generated MessageType for protobuf message service.GetDNSScanResultsByDomainRequest
+ * @generated MessageType for protobuf message service.ScanAbuseChRequest
  */
-export const GetDNSScanResultsByDomainRequest = new GetDNSScanResultsByDomainRequest$Type();
+export const ScanAbuseChRequest = new ScanAbuseChRequest$Type();
 // @generated message type with reflection information, may provide speed optimized methods
-class GetDNSScanResultsByDomainResponse$Type extends MessageType<GetDNSScanResultsByDomainResponse> {
+class ScanAbuseChResponse$Type extends MessageType<ScanAbuseChResponse> {
     constructor() {
-        super("service.GetDNSScanResultsByDomainResponse", [
-            { no: 1, name: "results", kind: "message", repeat: 2 /*RepeatType.UNPACKED*/, T: () => DNSScanResult }
+        super("service.ScanAbuseChResponse", [
+            { no: 1, name: "scan_id", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 2, name: "result", kind: "message", T: () => AbuseChSecurityResult },
+            { no: 3, name: "scan_run_id", kind: "scalar", T: 9 /*ScalarType.STRING*/ }
         ]);
     }
-    create(value?: PartialMessage<GetDNSScanResultsByDomainResponse>): GetDNSScanResultsByDomainResponse {
+    create(value?: PartialMessage<ScanAbuseChResponse>): ScanAbuseChResponse {
         const message = globalThis.Object.create((this.messagePrototype!));
-        message.results = [];
+        message.scanId = "";
+        message.scanRunId = "";
         if (value !== undefined)
-            reflectionMergePartial<GetDNSScanResultsByDomainResponse>(this, message, value);
+            reflectionMergePartial<ScanAbuseChResponse>(this, message, value);
         return message;
     }
-    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: GetDNSScanResultsByDomainResponse): GetDNSScanResultsByDomainResponse {
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: ScanAbuseChResponse): ScanAbuseChResponse {
         let message = target ?? this.create(), end = reader.pos + length;
         while (reader.pos < end) {
             let [fieldNo, wireType] = reader.tag();
             switch (fieldNo) {
-                case /* repeated service.DNSScanResult results */ 1:
-                    message.results.push(DNSScanResult.internalBinaryRead(reader, reader.uint32(), options));
+                case /* string scan_id */ 1:
+                    message.scanId = reader.string();
+                    break;
+                case /* service.AbuseChSecurityResult result */ 2:
+                    message.result = AbuseChSecurityResult.internalBinaryRead(reader, reader.uint32(), options, message.result);
+                    break;
+                case /* string scan_run_id */ 3:
+                    message.scanRunId = reader.string();
                     break;
                 default:
                     let u = options.readUnknownField;
@@ -4094,10 +10547,16 @@ class GetDNSScanResultsByDomainResponse$Type extends MessageType<GetDNSScanResul
         }
         return message;
     }
-    internalBinaryWrite(message: GetDNSScanResultsByDomainResponse, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
-        /* repeated service.DNSScanResult results = 1; */
-        for (let i = 0; i < message.results.length; i++)
-            DNSScanResult.internalBinaryWrite(message.results[i], writer.tag(1, WireType.LengthDelimited).fork(), options).join();
+    internalBinaryWrite(message: ScanAbuseChResponse, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* string scan_id = 1; */
+        if (message.scanId !== "")
+            writer.tag(1, WireType.LengthDelimited).string(message.scanId);
+        /* service.AbuseChSecurityResult result = 2; */
+        if (message.result)
+            AbuseChSecurityResult.internalBinaryWrite(message.result, writer.tag(2, WireType.LengthDelimited).fork(), options).join();
+        /* string scan_run_id = 3; */
+        if (message.scanRunId !== "")
+            writer.tag(3, WireType.LengthDelimited).string(message.scanRunId);
         let u = options.writeUnknownFields;
         if (u !== false)
             (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
@@ -4105,30 +10564,30 @@ class GetDNSScanResultsByDomainResponse$Type extends MessageType<GetDNSScanResul
     }
 }
 /**
- * @generated MessageType for protobuf message service.GetDNSScanResultsByDomainResponse
+ * @generated MessageType for protobuf message service.ScanAbuseChResponse
  */
-export const GetDNSScanResultsByDomainResponse = new GetDNSScanResultsByDomainResponse$Type();
+export const ScanAbuseChResponse = new ScanAbuseChResponse$Type();
 // @generated message type with reflection information, may provide speed optimized methods
-class GetDNSScanResultByIDRequest$Type extends MessageType<GetDNSScanResultByIDRequest> {
+class GetAbuseChScanResultsByDomainRequest$Type extends MessageType<GetAbuseChScanResultsByDomainRequest> {
     constructor() {
-        super("service.GetDNSScanResultByIDRequest", [
-            { no: 1, name: "dns_scan_id", kind: "scalar", T: 9 /*ScalarType.STRING*/ }
+        super("service.GetAbuseChScanResultsByDomainRequest", [
+            { no: 1, name: "domain", kind: "scalar", T: 9 /*ScalarType.STRING*/ }
         ]);
     }
-    create(value?: PartialMessage<GetDNSScanResultByIDRequest>): GetDNSScanResultByIDRequest {
+    create(value?: PartialMessage<GetAbuseChScanResultsByDomainRequest>): GetAbuseChScanResultsByDomainRequest {
         const message = globalThis.Object.create((this.messagePrototype!));
-        message.dnsScanId = "";
+        message.domain = "";
         if (value !== undefined)
-            reflectionMergePartial<GetDNSScanResultByIDRequest>(this, message, value);
+            reflectionMergePartial<GetAbuseChScanResultsByDomainRequest>(this, message, value);
         return message;
     }
-    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: GetDNSScanResultByIDRequest): GetDNSScanResultByIDRequest {
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: GetAbuseChScanResultsByDomainRequest): GetAbuseChScanResultsByDomainRequest {
         let message = target ?? this.create(), end = reader.pos + length;
         while (reader.pos < end) {
             let [fieldNo, wireType] = reader.tag();
             switch (fieldNo) {
-                case /* string dns_scan_id */ 1:
-                    message.dnsScanId = reader.string();
+                case /* string domain */ 1:
+                    message.domain = reader.string();
                     break;
                 default:
                     let u = options.readUnknownField;
@@ -4141,10 +10600,10 @@ class GetDNSScanResultByIDRequest$Type extends MessageType<GetDNSScanResultByIDR
         }
         return message;
     }
-    internalBinaryWrite(message: GetDNSScanResultByIDRequest, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
-        /* string dns_scan_id = 1; */
-        if (message.dnsScanId !== "")
-            writer.tag(1, WireType.LengthDelimited).string(message.dnsScanId);
+    internalBinaryWrite(message: GetAbuseChScanResultsByDomainRequest, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* string domain = 1; */
+        if (message.domain !== "")
+            writer.tag(1, WireType.LengthDelimited).string(message.domain);
         let u = options.writeUnknownFields;
         if (u !== false)
             (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
@@ -4152,29 +10611,30 @@ class GetDNSScanResultByIDRequest$Type extends MessageType<GetDNSScanResultByIDR
     }
 }
 /**
- * @generated MessageType for protobuf message service.GetDNSScanResultByIDRequest
+ * @generated MessageType for protobuf message service.GetAbuseChScanResultsByDomainRequest
  */
-export const GetDNSScanResultByIDRequest = new GetDNSScanResultByIDRequest$Type();
+export const GetAbuseChScanResultsByDomainRequest = new GetAbuseChScanResultsByDomainRequest$Type();
 // @generated message type with reflection information, may provide speed optimized methods
-class GetDNSScanResultByIDResponse$Type extends MessageType<GetDNSScanResultByIDResponse> {
+class GetAbuseChScanResultsByDomainResponse$Type extends MessageType<GetAbuseChScanResultsByDomainResponse> {
     constructor() {
-        super("service.GetDNSScanResultByIDResponse", [
-            { no: 1, name: "result", kind: "message", T: () => DNSScanResult }
+        super("service.GetAbuseChScanResultsByDomainResponse", [
+            { no: 1, name: "results", kind: "message", repeat: 2 /*RepeatType.UNPACKED*/, T: () => AbuseChScanResult }
         ]);
     }
-    create(value?: PartialMessage<GetDNSScanResultByIDResponse>): GetDNSScanResultByIDResponse {
+    create(value?: PartialMessage<GetAbuseChScanResultsByDomainResponse>): GetAbuseChScanResultsByDomainResponse {
         const message = globalThis.Object.create((this.messagePrototype!));
+        message.results = [];
         if (value !== undefined)
-            reflectionMergePartial<GetDNSScanResultByIDResponse>(this, message, value);
+            reflectionMergePartial<GetAbuseChScanResultsByDomainResponse>(this, message, value);
         return message;
     }
-    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: GetDNSScanResultByIDResponse): GetDNSScanResultByIDResponse {
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: GetAbuseChScanResultsByDomainResponse): GetAbuseChScanResultsByDomainResponse {
         let message = target ?? this.create(), end = reader.pos + length;
         while (reader.pos < end) {
             let [fieldNo, wireType] = reader.tag();
             switch (fieldNo) {
-                case /* service.DNSScanResult result */ 1:
-                    message.result = DNSScanResult.internalBinaryRead(reader, reader.uint32(), options, message.result);
+                case /* repeated service.AbuseChScanResult results */ 1:
+                    message.results.push(AbuseChScanResult.internalBinaryRead(reader, reader.uint32(), options));
                     break;
                 default:
                     let u = options.readUnknownField;
@@ -4187,10 +10647,10 @@ class GetDNSScanResultByIDResponse$Type extends MessageType<GetDNSScanResultByID
         }
         return message;
     }
-    internalBinaryWrite(message: GetDNSScanResultByIDResponse, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
-        /* service.DNSScanResult result = 1; */
-        if (message.result)
-            DNSScanResult.internalBinaryWrite(message.result, writer.tag(1, WireType.LengthDelimited).fork(), options).join();
+    internalBinaryWrite(message: GetAbuseChScanResultsByDomainResponse, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* repeated service.AbuseChScanResult results = 1; */
+        for (let i = 0; i < message.results.length; i++)
+            AbuseChScanResult.internalBinaryWrite(message.results[i], writer.tag(1, WireType.LengthDelimited).fork(), options).join();
         let u = options.writeUnknownFields;
         if (u !== false)
             (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
@@ -4198,30 +10658,30 @@ class GetDNSScanResultByIDResponse$Type extends MessageType<GetDNSScanResultByID
     }
 }
 /**
- * @generated MessageType for protobuf message service.GetDNSScanResultByIDResponse
+ * @generated MessageType for protobuf message service.GetAbuseChScanResultsByDomainResponse
  */
-export const GetDNSScanResultByIDResponse = new GetDNSScanResultByIDResponse$Type();
+export const GetAbuseChScanResultsByDomainResponse = new GetAbuseChScanResultsByDomainResponse$Type();
 // @generated message type with reflection information, may provide speed optimized methods
-class DNSScanResult$Type extends MessageType<DNSScanResult> {
+class AbuseChScanResult$Type extends MessageType<AbuseChScanResult> {
     constructor() {
-        super("service.DNSScanResult", [
+        super("service.AbuseChScanResult", [
             { no: 1, name: "id", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
             { no: 2, name: "domain", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
             { no: 3, name: "dns_scan_id", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
-            { no: 4, name: "result", kind: "message", T: () => DNSSecurityResult },
+            { no: 4, name: "result", kind: "message", T: () => AbuseChSecurityResult },
             { no: 5, name: "created_at", kind: "message", T: () => Timestamp }
         ]);
     }
-    create(value?: PartialMessage<DNSScanResult>): DNSScanResult {
+    create(value?: PartialMessage<AbuseChScanResult>): AbuseChScanResult {
         const message = globalThis.Object.create((this.messagePrototype!));
         message.id = "";
         message.domain = "";
         message.dnsScanId = "";
         if (value !== undefined)
-            reflectionMergePartial<DNSScanResult>(this, message, value);
+            reflectionMergePartial<AbuseChScanResult>(this, message, value);
         return message;
     }
-    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: DNSScanResult): DNSScanResult {
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: AbuseChScanResult): AbuseChScanResult {
         let message = target ?? this.create(), end = reader.pos + length;
         while (reader.pos < end) {
             let [fieldNo, wireType] = reader.tag();
@@ -4235,8 +10695,8 @@ class DNSScanResult$Type extends MessageType<DNSScanResult> {
                 case /* string dns_scan_id */ 3:
                     message.dnsScanId = reader.string();
                     break;
-                case /* service.DNSSecurityResult result */ 4:
-                    message.result = DNSSecurityResult.internalBinaryRead(reader, reader.uint32(), options, message.result);
+                case /* service.AbuseChSecurityResult result */ 4:
+                    message.result = AbuseChSecurityResult.internalBinaryRead(reader, reader.uint32(), options, message.result);
                     break;
                 case /* google.protobuf.Timestamp created_at */ 5:
                     message.createdAt = Timestamp.internalBinaryRead(reader, reader.uint32(), options, message.createdAt);
@@ -4252,7 +10712,7 @@ class DNSScanResult$Type extends MessageType<DNSScanResult> {
         }
         return message;
     }
-    internalBinaryWrite(message: DNSScanResult, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+    internalBinaryWrite(message: AbuseChScanResult, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
         /* string id = 1; */
         if (message.id !== "")
             writer.tag(1, WireType.LengthDelimited).string(message.id);
@@ -4262,9 +10722,9 @@ class DNSScanResult$Type extends MessageType<DNSScanResult> {
         /* string dns_scan_id = 3; */
         if (message.dnsScanId !== "")
             writer.tag(3, WireType.LengthDelimited).string(message.dnsScanId);
-        /* service.DNSSecurityResult result = 4; */
+        /* service.AbuseChSecurityResult result = 4; */
         if (message.result)
-            DNSSecurityResult.internalBinaryWrite(message.result, writer.tag(4, WireType.LengthDelimited).fork(), options).join();
+            AbuseChSecurityResult.internalBinaryWrite(message.result, writer.tag(4, WireType.LengthDelimited).fork(), options).join();
         /* google.protobuf.Timestamp created_at = 5; */
         if (message.createdAt)
             Timestamp.internalBinaryWrite(message.createdAt, writer.tag(5, WireType.LengthDelimited).fork(), options).join();
@@ -4275,26 +10735,26 @@ class DNSScanResult$Type extends MessageType<DNSScanResult> {
     }
 }
 /**
- * @generated MessageType for protobuf message service.DNSScanResult
+ * @generated MessageType for protobuf message service.AbuseChScanResult
  */
-export const DNSScanResult = new DNSScanResult$Type();
+export const AbuseChScanResult = new AbuseChScanResult$Type();
 // @generated message type with reflection information, may provide speed optimized methods
-class ScanTLSRequest$Type extends MessageType<ScanTLSRequest> {
+class ScanISCRequest$Type extends MessageType<ScanISCRequest> {
     constructor() {
-        super("service.ScanTLSRequest", [
+        super("service.ScanISCRequest", [
             { no: 1, name: "domain", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
             { no: 2, name: "dns_scan_id", kind: "scalar", T: 9 /*ScalarType.STRING*/ }
         ]);
     }
-    create(value?: PartialMessage<ScanTLSRequest>): ScanTLSRequest {
+    create(value?: PartialMessage<ScanISCRequest>): ScanISCRequest {
         const message = globalThis.Object.create((this.messagePrototype!));
         message.domain = "";
         message.dnsScanId = "";
         if (value !== undefined)
-            reflectionMergePartial<ScanTLSRequest>(this, message, value);
+            reflectionMergePartial<ScanISCRequest>(this, message, value);
         return message;
     }
-    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: ScanTLSRequest): ScanTLSRequest {
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: ScanISCRequest): ScanISCRequest {
         let message = target ?? this.create(), end = reader.pos + length;
         while (reader.pos < end) {
             let [fieldNo, wireType] = reader.tag();
@@ -4316,7 +10776,7 @@ class ScanTLSRequest$Type extends MessageType<ScanTLSRequest> {
         }
         return message;
     }
-    internalBinaryWrite(message: ScanTLSRequest, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+    internalBinaryWrite(message: ScanISCRequest, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
         /* string domain = 1; */
         if (message.domain !== "")
             writer.tag(1, WireType.LengthDelimited).string(message.domain);
@@ -4330,25 +10790,27 @@ class ScanTLSRequest$Type extends MessageType<ScanTLSRequest> {
     }
 }
 /**
- * @generated MessageType for protobuf message service.ScanTLSRequest
+ * @generated MessageType for protobuf message service.ScanISCRequest
  */
-export const ScanTLSRequest = new ScanTLSRequest$Type();
+export const ScanISCRequest = new ScanISCRequest$Type();
 // @generated message type with reflection information, may provide speed optimized methods
-class ScanTLSResponse$Type extends MessageType<ScanTLSResponse> {
+class ScanISCResponse$Type extends MessageType<ScanISCResponse> {
     constructor() {
-        super("service.ScanTLSResponse", [
+        super("service.ScanISCResponse", [
             { no: 1, name: "scan_id", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
-            { no: 2, name: "result", kind: "message", T: () => TLSSecurityResult }
+            { no: 2, name: "result", kind: "message", T: () => ISCSecurityResult },
+            { no: 3, name: "scan_run_id", kind: "scalar", T: 9 /*ScalarType.STRING*/ }
         ]);
     }
-    create(value?: PartialMessage<ScanTLSResponse>): ScanTLSResponse {
+    create(value?: PartialMessage<ScanISCResponse>): ScanISCResponse {
         const message = globalThis.Object.create((this.messagePrototype!));
         message.scanId = "";
+        message.scanRunId = "";
         if (value !== undefined)
-            reflectionMergePartial<ScanTLSResponse>(this, message, value);
+            reflectionMergePartial<ScanISCResponse>(this, message, value);
         return message;
     }
-    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: ScanTLSResponse): ScanTLSResponse {
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: ScanISCResponse): ScanISCResponse {
         let message = target ?? this.create(), end = reader.pos + length;
         while (reader.pos < end) {
             let [fieldNo, wireType] = reader.tag();
@@ -4356,8 +10818,11 @@ class ScanTLSResponse$Type extends MessageType<ScanTLSResponse> {
                 case /* string scan_id */ 1:
                     message.scanId = reader.string();
                     break;
-                case /* service.TLSSecurityResult result */ 2:
-                    message.result = TLSSecurityResult.internalBinaryRead(reader, reader.uint32(), options, message.result);
+                case /* service.ISCSecurityResult result */ 2:
+                    message.result = ISCSecurityResult.internalBinaryRead(reader, reader.uint32(), options, message.result);
+                    break;
+                case /* string scan_run_id */ 3:
+                    message.scanRunId = reader.string();
                     break;
                 default:
                     let u = options.readUnknownField;
@@ -4370,13 +10835,16 @@ class ScanTLSResponse$Type extends MessageType<ScanTLSResponse> {
         }
         return message;
     }
-    internalBinaryWrite(message: ScanTLSResponse, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+    internalBinaryWrite(message: ScanISCResponse, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
         /* string scan_id = 1; */
         if (message.scanId !== "")
             writer.tag(1, WireType.LengthDelimited).string(message.scanId);
-        /* service.TLSSecurityResult result = 2; */
+        /* service.ISCSecurityResult result = 2; */
         if (message.result)
-            TLSSecurityResult.internalBinaryWrite(message.result, writer.tag(2, WireType.LengthDelimited).fork(), options).join();
+            ISCSecurityResult.internalBinaryWrite(message.result, writer.tag(2, WireType.LengthDelimited).fork(), options).join();
+        /* string scan_run_id = 3; */
+        if (message.scanRunId !== "")
+            writer.tag(3, WireType.LengthDelimited).string(message.scanRunId);
         let u = options.writeUnknownFields;
         if (u !== false)
             (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
@@ -4384,24 +10852,24 @@ class ScanTLSResponse$Type extends MessageType<ScanTLSResponse> {
     }
 }
 /**
- * @generated MessageType for protobuf message service.ScanTLSResponse
+ * @generated MessageType for protobuf message service.ScanISCResponse
  */
-export const ScanTLSResponse = new ScanTLSResponse$Type();
+export const ScanISCResponse = new ScanISCResponse$Type();
 // @generated message type with reflection information, may provide speed optimized methods
-class GetTLSScanResultsByDomainRequest$Type extends MessageType<GetTLSScanResultsByDomainRequest> {
+class GetISCScanResultsByDomainRequest$Type extends MessageType<GetISCScanResultsByDomainRequest> {
     constructor() {
-        super("service.GetTLSScanResultsByDomainRequest", [
+        super("service.GetISCScanResultsByDomainRequest", [
             { no: 1, name: "domain", kind: "scalar", T: 9 /*ScalarType.STRING*/ }
         ]);
     }
-    create(value?: PartialMessage<GetTLSScanResultsByDomainRequest>): GetTLSScanResultsByDomainRequest {
+    create(value?: PartialMessage<GetISCScanResultsByDomainRequest>): GetISCScanResultsByDomainRequest {
         const message = globalThis.Object.create((this.messagePrototype!));
         message.domain = "";
         if (value !== undefined)
-            reflectionMergePartial<GetTLSScanResultsByDomainRequest>(this, message, value);
+            reflectionMergePartial<GetISCScanResultsByDomainRequest>(this, message, value);
         return message;
     }
-    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: GetTLSScanResultsByDomainRequest): GetTLSScanResultsByDomainRequest {
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: GetISCScanResultsByDomainRequest): GetISCScanResultsByDomainRequest {
         let message = target ?? this.create(), end = reader.pos + length;
         while (reader.pos < end) {
             let [fieldNo, wireType] = reader.tag();
@@ -4420,7 +10888,7 @@ class GetTLSScanResultsByDomainRequest$Type extends MessageType<GetTLSScanResult
         }
         return message;
     }
-    internalBinaryWrite(message: GetTLSScanResultsByDomainRequest, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+    internalBinaryWrite(message: GetISCScanResultsByDomainRequest, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
         /* string domain = 1; */
         if (message.domain !== "")
             writer.tag(1, WireType.LengthDelimited).string(message.domain);
@@ -4431,30 +10899,30 @@ class GetTLSScanResultsByDomainRequest$Type extends MessageType<GetTLSScanResult
     }
 }
 /**
- * @generated MessageType for protobuf message service.GetTLSScanResultsByDomainRequest
+ * @generated MessageType for protobuf message service.GetISCScanResultsByDomainRequest
  */
-export const GetTLSScanResultsByDomainRequest = new GetTLSScanResultsByDomainRequest$Type();
+export const GetISCScanResultsByDomainRequest = new GetISCScanResultsByDomainRequest$Type();
 // @generated message type with reflection information, may provide speed optimized methods
-class GetTLSScanResultsByDomainResponse$Type extends MessageType<GetTLSScanResultsByDomainResponse> {
+class GetISCScanResultsByDomainResponse$Type extends MessageType<GetISCScanResultsByDomainResponse> {
     constructor() {
-        super("service.GetTLSScanResultsByDomainResponse", [
-            { no: 1, name: "results", kind: "message", repeat: 2 /*RepeatType.UNPACKED*/, T: () => TLSScanResult }
+        super("service.GetISCScanResultsByDomainResponse", [
+            { no: 1, name: "results", kind: "message", repeat: 2 /*RepeatType.UNPACKED*/, T: () => ISCScanResult }
         ]);
     }
-    create(value?: PartialMessage<GetTLSScanResultsByDomainResponse>): GetTLSScanResultsByDomainResponse {
+    create(value?: PartialMessage<GetISCScanResultsByDomainResponse>): GetISCScanResultsByDomainResponse {
         const message = globalThis.Object.create((this.messagePrototype!));
         message.results = [];
         if (value !== undefined)
-            reflectionMergePartial<GetTLSScanResultsByDomainResponse>(this, message, value);
+            reflectionMergePartial<GetISCScanResultsByDomainResponse>(this, message, value);
         return message;
     }
-    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: GetTLSScanResultsByDomainResponse): GetTLSScanResultsByDomainResponse {
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: GetISCScanResultsByDomainResponse): GetISCScanResultsByDomainResponse {
         let message = target ?? this.create(), end = reader.pos + length;
         while (reader.pos < end) {
             let [fieldNo, wireType] = reader.tag();
             switch (fieldNo) {
-                case /* repeated service.TLSScanResult results */ 1:
-                    message.results.push(TLSScanResult.internalBinaryRead(reader, reader.uint32(), options));
+                case /* repeated service.ISCScanResult results */ 1:
+                    message.results.push(ISCScanResult.internalBinaryRead(reader, reader.uint32(), options));
                     break;
                 default:
                     let u = options.readUnknownField;
@@ -4467,10 +10935,10 @@ class GetTLSScanResultsByDomainResponse$Type extends MessageType<GetTLSScanResul
         }
         return message;
     }
-    internalBinaryWrite(message: GetTLSScanResultsByDomainResponse, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
-        /* repeated service.TLSScanResult results = 1; */
+    internalBinaryWrite(message: GetISCScanResultsByDomainResponse, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* repeated service.ISCScanResult results = 1; */
         for (let i = 0; i < message.results.length; i++)
-            TLSScanResult.internalBinaryWrite(message.results[i], writer.tag(1, WireType.LengthDelimited).fork(), options).join();
+            ISCScanResult.internalBinaryWrite(message.results[i], writer.tag(1, WireType.LengthDelimited).fork(), options).join();
         let u = options.writeUnknownFields;
         if (u !== false)
             (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
@@ -4478,166 +10946,48 @@ class GetTLSScanResultsByDomainResponse$Type extends MessageType<GetTLSScanResul
     }
 }
 /**
- * @generated MessageType for protobuf message service.GetTLSScanResultsByDomainResponse
+ * @generated MessageType for protobuf message service.GetISCScanResultsByDomainResponse
  */
-export const GetTLSScanResultsByDomainResponse = new GetTLSScanResultsByDomainResponse$Type();
+export const GetISCScanResultsByDomainResponse = new GetISCScanResultsByDomainResponse$Type();
 // @generated message type with reflection information, may provide speed optimized methods
-class TLSScanResult$Type extends MessageType<TLSScanResult> {
+class ISCScanResult$Type extends MessageType<ISCScanResult> {
     constructor() {
-        super("service.TLSScanResult", [
+        super("service.ISCScanResult", [
             { no: 1, name: "id", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
             { no: 2, name: "domain", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
             { no: 3, name: "dns_scan_id", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
-            { no: 4, name: "result", kind: "message", T: () => TLSSecurityResult },
+            { no: 4, name: "result", kind: "message", T: () => ISCSecurityResult },
             { no: 5, name: "created_at", kind: "message", T: () => Timestamp }
         ]);
     }
-    create(value?: PartialMessage<TLSScanResult>): TLSScanResult {
+    create(value?: PartialMessage<ISCScanResult>): ISCScanResult {
         const message = globalThis.Object.create((this.messagePrototype!));
         message.id = "";
         message.domain = "";
         message.dnsScanId = "";
         if (value !== undefined)
-            reflectionMergePartial<TLSScanResult>(this, message, value);
-        return message;
-    }
-    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: TLSScanResult): TLSScanResult {
-        let message = target ?? this.create(), end = reader.pos + length;
-        while (reader.pos < end) {
-            let [fieldNo, wireType] = reader.tag();
-            switch (fieldNo) {
-                case /* string id */ 1:
-                    message.id = reader.string();
-                    break;
-                case /* string domain */ 2:
-                    message.domain = reader.string();
-                    break;
-                case /* string dns_scan_id */ 3:
-                    message.dnsScanId = reader.string();
-                    break;
-                case /* service.TLSSecurityResult result */ 4:
-                    message.result = TLSSecurityResult.internalBinaryRead(reader, reader.uint32(), options, message.result);
-                    break;
-                case /* google.protobuf.Timestamp created_at */ 5:
-                    message.createdAt = Timestamp.internalBinaryRead(reader, reader.uint32(), options, message.createdAt);
-                    break;
-                default:
-                    let u = options.readUnknownField;
-                    if (u === "throw")
-                        throw new globalThis.Error(`Unknown field ${fieldNo} (wire type ${wireType}) for ${this.typeName}`);
-                    let d = reader.skip(wireType);
-                    if (u !== false)
-                        (u === true ? UnknownFieldHandler.onRead : u)(this.typeName, message, fieldNo, wireType, d);
-            }
-        }
-        return message;
-    }
-    internalBinaryWrite(message: TLSScanResult, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
-        /* string id = 1; */
-        if (message.id !== "")
-            writer.tag(1, WireType.LengthDelimited).string(message.id);
-        /* string domain = 2; */
-        if (message.domain !== "")
-            writer.tag(2, WireType.LengthDelimited).string(message.domain);
-        /* string dns_scan_id = 3; */
-        if (message.dnsScanId !== "")
-            writer.tag(3, WireType.LengthDelimited).string(message.dnsScanId);
-        /* service.TLSSecurityResult result = 4; */
-        if (message.result)
-            TLSSecurityResult.internalBinaryWrite(message.result, writer.tag(4, WireType.LengthDelimited).fork(), options).join();
-        /* google.protobuf.Timestamp created_at = 5; */
-        if (message.createdAt)
-            Timestamp.internalBinaryWrite(message.createdAt, writer.tag(5, WireType.LengthDelimited).fork(), options).join();
-        let u = options.writeUnknownFields;
-        if (u !== false)
-            (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
-        return writer;
-    }
-}
-/**
- * @generated MessageType for protobuf message service.TLSScanResult
- */
-export const TLSScanResult = new TLSScanResult$Type();
-// @generated message type with reflection information, may provide speed optimized methods
-class ScanCrtShRequest$Type extends MessageType<ScanCrtShRequest> {
-    constructor() {
-        super("service.ScanCrtShRequest", [
-            { no: 1, name: "domain", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
-            { no: 2, name: "dns_scan_id", kind: "scalar", T: 9 /*ScalarType.STRING*/ }
-        ]);
-    }
-    create(value?: PartialMessage<ScanCrtShRequest>): ScanCrtShRequest {
-        const message = globalThis.Object.create((this.messagePrototype!));
-        message.domain = "";
-        message.dnsScanId = "";
-        if (value !== undefined)
-            reflectionMergePartial<ScanCrtShRequest>(this, message, value);
-        return message;
-    }
-    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: ScanCrtShRequest): ScanCrtShRequest {
-        let message = target ?? this.create(), end = reader.pos + length;
-        while (reader.pos < end) {
-            let [fieldNo, wireType] = reader.tag();
-            switch (fieldNo) {
-                case /* string domain */ 1:
-                    message.domain = reader.string();
-                    break;
-                case /* string dns_scan_id */ 2:
-                    message.dnsScanId = reader.string();
-                    break;
-                default:
-                    let u = options.readUnknownField;
-                    if (u === "throw")
-                        throw new globalThis.Error(`Unknown field ${fieldNo} (wire type ${wireType}) for ${this.typeName}`);
-                    let d = reader.skip(wireType);
-                    if (u !== false)
-                        (u === true ? UnknownFieldHandler.onRead : u)(this.typeName, message, fieldNo, wireType, d);
-            }
-        }
-        return message;
-    }
-    internalBinaryWrite(message: ScanCrtShRequest, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
-        /* string domain = 1; */
-        if (message.domain !== "")
-            writer.tag(1, WireType.LengthDelimited).string(message.domain);
-        /* string dns_scan_id = 2; */
-        if (message.dnsScanId !== "")
-            writer.tag(2, WireType.LengthDelimited).string(message.dnsScanId);
-        let u = options.writeUnknownFields;
-        if (u !== false)
-            (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
-        return writer;
-    }
-}
-/**
- * @generated MessageType for protobuf message service.ScanCrtShRequest
- */
-export const ScanCrtShRequest = new ScanCrtShRequest$Type();
-// @generated message type with reflection information, may provide speed optimized methods
-class ScanCrtShResponse$Type extends MessageType<ScanCrtShResponse> {
-    constructor() {
-        super("service.ScanCrtShResponse", [
-            { no: 1, name: "scan_id", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
-            { no: 2, name: "result", kind: "message", T: () => CrtShSecurityResult }
-        ]);
-    }
-    create(value?: PartialMessage<ScanCrtShResponse>): ScanCrtShResponse {
-        const message = globalThis.Object.create((this.messagePrototype!));
-        message.scanId = "";
-        if (value !== undefined)
-            reflectionMergePartial<ScanCrtShResponse>(this, message, value);
+            reflectionMergePartial<ISCScanResult>(this, message, value);
         return message;
     }
-    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: ScanCrtShResponse): ScanCrtShResponse {
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: ISCScanResult): ISCScanResult {
         let message = target ?? this.create(), end = reader.pos + length;
         while (reader.pos < end) {
             let [fieldNo, wireType] = reader.tag();
             switch (fieldNo) {
-                case /* string scan_id */ 1:
-                    message.scanId = reader.string();
+                case /* string id */ 1:
+                    message.id = reader.string();
                     break;
-                case /* service.CrtShSecurityResult result */ 2:
-                    message.result = CrtShSecurityResult.internalBinaryRead(reader, reader.uint32(), options, message.result);
+                case /* string domain */ 2:
+                    message.domain = reader.string();
+                    break;
+                case /* string dns_scan_id */ 3:
+                    message.dnsScanId = reader.string();
+                    break;
+                case /* service.ISCSecurityResult result */ 4:
+                    message.result = ISCSecurityResult.internalBinaryRead(reader, reader.uint32(), options, message.result);
+                    break;
+                case /* google.protobuf.Timestamp created_at */ 5:
+                    message.createdAt = Timestamp.internalBinaryRead(reader, reader.uint32(), options, message.createdAt);
                     break;
                 default:
                     let u = options.readUnknownField;
@@ -4650,13 +11000,22 @@ class ScanCrtShResponse$Type extends MessageType<ScanCrtShResponse> {
         }
         return message;
     }
-    internalBinaryWrite(message: ScanCrtShResponse, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
-        /* string scan_id = 1; */
-        if (message.scanId !== "")
-            writer.tag(1, WireType.LengthDelimited).string(message.scanId);
-        /* service.CrtShSecurityResult result = 2; */
+    internalBinaryWrite(message: ISCScanResult, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* string id = 1; */
+        if (message.id !== "")
+            writer.tag(1, WireType.LengthDelimited).string(message.id);
+        /* string domain = 2; */
+        if (message.domain !== "")
+            writer.tag(2, WireType.LengthDelimited).string(message.domain);
+        /* string dns_scan_id = 3; */
+        if (message.dnsScanId !== "")
+            writer.tag(3, WireType.LengthDelimited).string(message.dnsScanId);
+        /* service.ISCSecurityResult result = 4; */
         if (message.result)
-            CrtShSecurityResult.internalBinaryWrite(message.result, writer.tag(2, WireType.LengthDelimited).fork(), options).join();
+            ISCSecurityResult.internalBinaryWrite(message.result, writer.tag(4, WireType.LengthDelimited).fork(), options).join();
+        /* google.protobuf.Timestamp created_at = 5; */
+        if (message.createdAt)
+            Timestamp.internalBinaryWrite(message.createdAt, writer.tag(5, WireType.LengthDelimited).fork(), options).join();
         let u = options.writeUnknownFields;
         if (u !== false)
             (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
@@ -4664,30 +11023,44 @@ class ScanCrtShResponse$Type extends MessageType<ScanCrtShResponse> {
     }
 }
 /**
- * @generated MessageType for protobuf message service.ScanCrtShResponse
+ * @generated MessageType for protobuf message service.ISCScanResult
  */
-export const ScanCrtShResponse = new ScanCrtShResponse$Type();
+export const ISCScanResult = new ISCScanResult$Type();
 // @generated message type with reflection information, may provide speed optimized methods
-class GetCrtShScanResultsByDomainRequest$Type extends MessageType<GetCrtShScanResultsByDomainRequest> {
+class ISCIncident$Type extends MessageType<ISCIncident> {
     constructor() {
-        super("service.GetCrtShScanResultsByDomainRequest", [
-            { no: 1, name: "domain", kind: "scalar", T: 9 /*ScalarType.STRING*/ }
+        super("service.ISCIncident", [
+            { no: 1, name: "id", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 2, name: "date", kind: "message", T: () => Timestamp },
+            { no: 3, name: "description", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 4, name: "severity", kind: "scalar", T: 9 /*ScalarType.STRING*/ }
         ]);
     }
-    create(value?: PartialMessage<GetCrtShScanResultsByDomainRequest>): GetCrtShScanResultsByDomainRequest {
+    create(value?: PartialMessage<ISCIncident>): ISCIncident {
         const message = globalThis.Object.create((this.messagePrototype!));
-        message.domain = "";
+        message.id = "";
+        message.description = "";
+        message.severity = "";
         if (value !== undefined)
-            reflectionMergePartial<GetCrtShScanResultsByDomainRequest>(this, message, value);
+            reflectionMergePartial<ISCIncident>(this, message, value);
         return message;
     }
-    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: GetCrtShScanResultsByDomainRequest): GetCrtShScanResultsByDomainRequest {
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: ISCIncident): ISCIncident {
         let message = target ?? this.create(), end = reader.pos + length;
         while (reader.pos < end) {
             let [fieldNo, wireType] = reader.tag();
             switch (fieldNo) {
-                case /* string domain */ 1:
-                    message.domain = reader.string();
+                case /* string id */ 1:
+                    message.id = reader.string();
+                    break;
+                case /* google.protobuf.Timestamp date */ 2:
+                    message.date = Timestamp.internalBinaryRead(reader, reader.uint32(), options, message.date);
+                    break;
+                case /* string description */ 3:
+                    message.description = reader.string();
+                    break;
+                case /* string severity */ 4:
+                    message.severity = reader.string();
                     break;
                 default:
                     let u = options.readUnknownField;
@@ -4700,10 +11073,19 @@ class GetCrtShScanResultsByDomainRequest$Type extends MessageType<GetCrtShScanRe
         }
         return message;
     }
-    internalBinaryWrite(message: GetCrtShScanResultsByDomainRequest, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
-        /* string domain = 1; */
-        if (message.domain !== "")
-            writer.tag(1, WireType.LengthDelimited).string(message.domain);
+    internalBinaryWrite(message: ISCIncident, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* string id = 1; */
+        if (message.id !== "")
+            writer.tag(1, WireType.LengthDelimited).string(message.id);
+        /* google.protobuf.Timestamp date = 2; */
+        if (message.date)
+            Timestamp.internalBinaryWrite(message.date, writer.tag(2, WireType.LengthDelimited).fork(), options).join();
+        /* string description = 3; */
+        if (message.description !== "")
+            writer.tag(3, WireType.LengthDelimited).string(message.description);
+        /* string severity = 4; */
+        if (message.severity !== "")
+            writer.tag(4, WireType.LengthDelimited).string(message.severity);
         let u = options.writeUnknownFields;
         if (u !== false)
             (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
@@ -4711,30 +11093,50 @@ class GetCrtShScanResultsByDomainRequest$Type extends MessageType<GetCrtShScanRe
     }
 }
 /**
- * @generated MessageType for protobuf message service.GetCrtShScanResultsByDomainRequest
+ * @generated MessageType for protobuf message service.ISCIncident
  */
-export const GetCrtShScanResultsByDomainRequest = new GetCrtShScanResultsByDomainRequest$Type();
+export const ISCIncident = new ISCIncident$Type();
 // @generated message type with reflection information, may provide speed optimized methods
-class GetCrtShScanResultsByDomainResponse$Type extends MessageType<GetCrtShScanResultsByDomainResponse> {
+class ISCSecurityResult$Type extends MessageType<ISCSecurityResult> {
     constructor() {
-        super("service.GetCrtShScanResultsByDomainResponse", [
-            { no: 1, name: "results", kind: "message", repeat: 2 /*RepeatType.UNPACKED*/, T: () => CrtShScanResult }
+        super("service.ISCSecurityResult", [
+            { no: 1, name: "incidents", kind: "message", repeat: 2 /*RepeatType.UNPACKED*/, T: () => ISCIncident },
+            { no: 2, name: "overall_risk", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 3, name: "errors", kind: "scalar", repeat: 2 /*RepeatType.UNPACKED*/, T: 9 /*ScalarType.STRING*/ },
+            { no: 4, name: "ip_reputation", kind: "message", repeat: 2 /*RepeatType.UNPACKED*/, T: () => ISCIPReputation },
+            { no: 5, name: "unavailable", kind: "scalar", repeat: 2 /*RepeatType.UNPACKED*/, T: 9 /*ScalarType.STRING*/ }
         ]);
     }
-    create(value?: PartialMessage<GetCrtShScanResultsByDomainResponse>): GetCrtShScanResultsByDomainResponse {
+    create(value?: PartialMessage<ISCSecurityResult>): ISCSecurityResult {
         const message = globalThis.Object.create((this.messagePrototype!));
-        message.results = [];
+        message.incidents = [];
+        message.overallRisk = "";
+        message.errors = [];
+        message.ipReputation = [];
+        message.unavailable = [];
         if (value !== undefined)
-            reflectionMergePartial<GetCrtShScanResultsByDomainResponse>(this, message, value);
+            reflectionMergePartial<ISCSecurityResult>(this, message, value);
         return message;
     }
-    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: GetCrtShScanResultsByDomainResponse): GetCrtShScanResultsByDomainResponse {
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: ISCSecurityResult): ISCSecurityResult {
         let message = target ?? this.create(), end = reader.pos + length;
         while (reader.pos < end) {
             let [fieldNo, wireType] = reader.tag();
             switch (fieldNo) {
-                case /* repeated service.CrtShScanResult results */ 1:
-                    message.results.push(CrtShScanResult.internalBinaryRead(reader, reader.uint32(), options));
+                case /* repeated service.ISCIncident incidents */ 1:
+                    message.incidents.push(ISCIncident.internalBinaryRead(reader, reader.uint32(), options));
+                    break;
+                case /* string overall_risk */ 2:
+                    message.overallRisk = reader.string();
+                    break;
+                case /* repeated string errors */ 3:
+                    message.errors.push(reader.string());
+                    break;
+                case /* repeated service.ISCIPReputation ip_reputation */ 4:
+                    message.ipReputation.push(ISCIPReputation.internalBinaryRead(reader, reader.uint32(), options));
+                    break;
+                case /* repeated string unavailable */ 5:
+                    message.unavailable.push(reader.string());
                     break;
                 default:
                     let u = options.readUnknownField;
@@ -4747,10 +11149,22 @@ class GetCrtShScanResultsByDomainResponse$Type extends MessageType<GetCrtShScanR
         }
         return message;
     }
-    internalBinaryWrite(message: GetCrtShScanResultsByDomainResponse, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
-        /* repeated service.CrtShScanResult results = 1; */
-        for (let i = 0; i < message.results.length; i++)
-            CrtShScanResult.internalBinaryWrite(message.results[i], writer.tag(1, WireType.LengthDelimited).fork(), options).join();
+    internalBinaryWrite(message: ISCSecurityResult, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* repeated service.ISCIncident incidents = 1; */
+        for (let i = 0; i < message.incidents.length; i++)
+            ISCIncident.internalBinaryWrite(message.incidents[i], writer.tag(1, WireType.LengthDelimited).fork(), options).join();
+        /* string overall_risk = 2; */
+        if (message.overallRisk !== "")
+            writer.tag(2, WireType.LengthDelimited).string(message.overallRisk);
+        /* repeated string errors = 3; */
+        for (let i = 0; i < message.errors.length; i++)
+            writer.tag(3, WireType.LengthDelimited).string(message.errors[i]);
+        /* repeated service.ISCIPReputation ip_reputation = 4; */
+        for (let i = 0; i < message.ipReputation.length; i++)
+            ISCIPReputation.internalBinaryWrite(message.ipReputation[i], writer.tag(4, WireType.LengthDelimited).fork(), options).join();
+        /* repeated string unavailable = 5; */
+        for (let i = 0; i < message.unavailable.length; i++)
+            writer.tag(5, WireType.LengthDelimited).string(message.unavailable[i]);
         let u = options.writeUnknownFields;
         if (u !== false)
             (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
@@ -4758,48 +11172,45 @@ class GetCrtShScanResultsByDomainResponse$Type extends MessageType<GetCrtShScanR
     }
 }
 /**
- * @generated MessageType for protobuf message service.GetCrtShScanResultsByDomainResponse
+ * @generated MessageType for protobuf message service.ISCSecurityResult
  */
-export const GetCrtShScanResultsByDomainResponse = new GetCrtShScanResultsByDomainResponse$Type();
+export const ISCSecurityResult = new ISCSecurityResult$Type();
 // @generated message type with reflection information, may provide speed optimized methods
-class CrtShScanResult$Type extends MessageType<CrtShScanResult> {
+class ISCIPReputation$Type extends MessageType<ISCIPReputation> {
     constructor() {
-        super("service.CrtShScanResult", [
-            { no: 1, name: "id", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
-            { no: 2, name: "domain", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
-            { no: 3, name: "dns_scan_id", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
-            { no: 4, name: "result", kind: "message", T: () => CrtShSecurityResult },
-            { no: 5, name: "created_at", kind: "message", T: () => Timestamp }
+        super("service.ISCIPReputation", [
+            { no: 1, name: "ip", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 2, name: "count", kind: "scalar", T: 5 /*ScalarType.INT32*/ },
+            { no: 3, name: "attacks", kind: "scalar", T: 5 /*ScalarType.INT32*/ },
+            { no: 4, name: "as_name", kind: "scalar", T: 9 /*ScalarType.STRING*/ }
         ]);
     }
-    create(value?: PartialMessage<CrtShScanResult>): CrtShScanResult {
+    create(value?: PartialMessage<ISCIPReputation>): ISCIPReputation {
         const message = globalThis.Object.create((this.messagePrototype!));
-        message.id = "";
-        message.domain = "";
-        message.dnsScanId = "";
+        message.ip = "";
+        message.count = 0;
+        message.attacks = 0;
+        message.asName = "";
         if (value !== undefined)
-            reflectionMergePartial<CrtShScanResult>(this, message, value);
+            reflectionMergePartial<ISCIPReputation>(this, message, value);
         return message;
     }
-    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: CrtShScanResult): CrtShScanResult {
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: ISCIPReputation): ISCIPReputation {
         let message = target ?? this.create(), end = reader.pos + length;
         while (reader.pos < end) {
             let [fieldNo, wireType] = reader.tag();
             switch (fieldNo) {
-                case /* string id */ 1:
-                    message.id = reader.string();
-                    break;
-                case /* string domain */ 2:
-                    message.domain = reader.string();
+                case /* string ip */ 1:
+                    message.ip = reader.string();
                     break;
-                case /* string dns_scan_id */ 3:
-                    message.dnsScanId = reader.string();
+                case /* int32 count */ 2:
+                    message.count = reader.int32();
                     break;
-                case /* service.CrtShSecurityResult result */ 4:
-                    message.result = CrtShSecurityResult.internalBinaryRead(reader, reader.uint32(), options, message.result);
+                case /* int32 attacks */ 3:
+                    message.attacks = reader.int32();
                     break;
-                case /* google.protobuf.Timestamp created_at */ 5:
-                    message.createdAt = Timestamp.internalBinaryRead(reader, reader.uint32(), options, message.createdAt);
+                case /* string as_name */ 4:
+                    message.asName = reader.string();
                     break;
                 default:
                     let u = options.readUnknownField;
@@ -4812,22 +11223,19 @@ class CrtShScanResult$Type extends MessageType<CrtShScanResult> {
         }
         return message;
     }
-    internalBinaryWrite(message: CrtShScanResult, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
-        /* string id = 1; */
-        if (message.id !== "")
-            writer.tag(1, WireType.LengthDelimited).string(message.id);
-        /* string domain = 2; */
-        if (message.domain !== "")
-            writer.tag(2, WireType.LengthDelimited).string(message.domain);
-        /* string dns_scan_id = 3; */
-        if (message.dnsScanId !== "")
-            writer.tag(3, WireType.LengthDelimited).string(message.dnsScanId);
-        /* service.CrtShSecurityResult result = 4; */
-        if (message.result)
-            CrtShSecurityResult.internalBinaryWrite(message.result, writer.tag(4, WireType.LengthDelimited).fork(), options).join();
-        /* google.protobuf.Timestamp created_at = 5; */
-        if (message.createdAt)
-            Timestamp.internalBinaryWrite(message.createdAt, writer.tag(5, WireType.LengthDelimited).fork(), options).join();
+    internalBinaryWrite(message: ISCIPReputation, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* string ip = 1; */
+        if (message.ip !== "")
+            writer.tag(1, WireType.LengthDelimited).string(message.ip);
+        /* int32 count = 2; */
+        if (message.count !== 0)
+            writer.tag(2, WireType.Varint).int32(message.count);
+        /* int32 attacks = 3; */
+        if (message.attacks !== 0)
+            writer.tag(3, WireType.Varint).int32(message.attacks);
+        /* string as_name = 4; */
+        if (message.asName !== "")
+            writer.tag(4, WireType.LengthDelimited).string(message.asName);
         let u = options.writeUnknownFields;
         if (u !== false)
             (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
@@ -4835,36 +11243,25 @@ class CrtShScanResult$Type extends MessageType<CrtShScanResult> {
     }
 }
 /**
- * @generated MessageType for protobuf message service.CrtShScanResult
+ * @generated MessageType for protobuf message service.ISCIPReputation
  */
-export const CrtShScanResult = new CrtShScanResult$Type();
+export const ISCIPReputation = new ISCIPReputation$Type();
 // @generated message type with reflection information, may provide speed optimized methods
-class ScanChaosRequest$Type extends MessageType<ScanChaosRequest> {
+class ListPluginsRequest$Type extends MessageType<ListPluginsRequest> {
     constructor() {
-        super("service.ScanChaosRequest", [
-            { no: 1, name: "domain", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
-            { no: 2, name: "dns_scan_id", kind: "scalar", T: 9 /*ScalarType.STRING*/ }
-        ]);
+        super("service.ListPluginsRequest", []);
     }
-    create(value?: PartialMessage<ScanChaosRequest>): ScanChaosRequest {
+    create(value?: PartialMessage<ListPluginsRequest>): ListPluginsRequest {
         const message = globalThis.Object.create((this.messagePrototype!));
-        message.domain = "";
-        message.dnsScanId = "";
         if (value !== undefined)
-            reflectionMergePartial<ScanChaosRequest>(this, message, value);
+            reflectionMergePartial<ListPluginsRequest>(this, message, value);
         return message;
     }
-    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: ScanChaosRequest): ScanChaosRequest {
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: ListPluginsRequest): ListPluginsRequest {
         let message = target ?? this.create(), end = reader.pos + length;
         while (reader.pos < end) {
             let [fieldNo, wireType] = reader.tag();
             switch (fieldNo) {
-                case /* string domain */ 1:
-                    message.domain = reader.string();
-                    break;
-                case /* string dns_scan_id */ 2:
-                    message.dnsScanId = reader.string();
-                    break;
                 default:
                     let u = options.readUnknownField;
                     if (u === "throw")
@@ -4876,13 +11273,7 @@ class ScanChaosRequest$Type extends MessageType<ScanChaosRequest> {
         }
         return message;
     }
-    internalBinaryWrite(message: ScanChaosRequest, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
-        /* string domain = 1; */
-        if (message.domain !== "")
-            writer.tag(1, WireType.LengthDelimited).string(message.domain);
-        /* string dns_scan_id = 2; */
-        if (message.dnsScanId !== "")
-            writer.tag(2, WireType.LengthDelimited).string(message.dnsScanId);
+    internalBinaryWrite(message: ListPluginsRequest, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
         let u = options.writeUnknownFields;
         if (u !== false)
             (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
@@ -4890,34 +11281,30 @@ class ScanChaosRequest$Type extends MessageType<ScanChaosRequest> {
     }
 }
 /**
- * @generated MessageType for protobuf message service.ScanChaosRequest
+ * @generated MessageType for protobuf message service.ListPluginsRequest
  */
-export const ScanChaosRequest = new ScanChaosRequest$Type();
+export const ListPluginsRequest = new ListPluginsRequest$Type();
 // @generated message type with reflection information, may provide speed optimized methods
-class ScanChaosResponse$Type extends MessageType<ScanChaosResponse> {
+class ListPluginsResponse$Type extends MessageType<ListPluginsResponse> {
     constructor() {
-        super("service.ScanChaosResponse", [
-            { no: 1, name: "scan_id", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
-            { no: 2, name: "result", kind: "message", T: () => ChaosSecurityResult }
+        super("service.ListPluginsResponse", [
+            { no: 1, name: "plugins", kind: "message", repeat: 2 /*RepeatType.UNPACKED*/, T: () => PluginInfo }
         ]);
     }
-    create(value?: PartialMessage<ScanChaosResponse>): ScanChaosResponse {
+    create(value?: PartialMessage<ListPluginsResponse>): ListPluginsResponse {
         const message = globalThis.Object.create((this.messagePrototype!));
-        message.scanId = "";
+        message.plugins = [];
         if (value !== undefined)
-            reflectionMergePartial<ScanChaosResponse>(this, message, value);
+            reflectionMergePartial<ListPluginsResponse>(this, message, value);
         return message;
     }
-    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: ScanChaosResponse): ScanChaosResponse {
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: ListPluginsResponse): ListPluginsResponse {
         let message = target ?? this.create(), end = reader.pos + length;
         while (reader.pos < end) {
             let [fieldNo, wireType] = reader.tag();
             switch (fieldNo) {
-                case /* string scan_id */ 1:
-                    message.scanId = reader.string();
-                    break;
-                case /* service.ChaosSecurityResult result */ 2:
-                    message.result = ChaosSecurityResult.internalBinaryRead(reader, reader.uint32(), options, message.result);
+                case /* repeated service.PluginInfo plugins */ 1:
+                    message.plugins.push(PluginInfo.internalBinaryRead(reader, reader.uint32(), options));
                     break;
                 default:
                     let u = options.readUnknownField;
@@ -4930,13 +11317,10 @@ class ScanChaosResponse$Type extends MessageType<ScanChaosResponse> {
         }
         return message;
     }
-    internalBinaryWrite(message: ScanChaosResponse, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
-        /* string scan_id = 1; */
-        if (message.scanId !== "")
-            writer.tag(1, WireType.LengthDelimited).string(message.scanId);
-        /* service.ChaosSecurityResult result = 2; */
-        if (message.result)
-            ChaosSecurityResult.internalBinaryWrite(message.result, writer.tag(2, WireType.LengthDelimited).fork(), options).join();
+    internalBinaryWrite(message: ListPluginsResponse, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* repeated service.PluginInfo plugins = 1; */
+        for (let i = 0; i < message.plugins.length; i++)
+            PluginInfo.internalBinaryWrite(message.plugins[i], writer.tag(1, WireType.LengthDelimited).fork(), options).join();
         let u = options.writeUnknownFields;
         if (u !== false)
             (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
@@ -4944,30 +11328,90 @@ class ScanChaosResponse$Type extends MessageType<ScanChaosResponse> {
     }
 }
 /**
- * @generated MessageType for protobuf message service.ScanChaosResponse
+ * @generated MessageType for protobuf message service.ListPluginsResponse
  */
-export const ScanChaosResponse = new ScanChaosResponse$Type();
+export const ListPluginsResponse = new ListPluginsResponse$Type();
 // @generated message type with reflection information, may provide speed optimized methods
-class GetChaosScanResultsByDomainRequest$Type extends MessageType<GetChaosScanResultsByDomainRequest> {
+class PluginInfo$Type extends MessageType<PluginInfo> {
     constructor() {
-        super("service.GetChaosScanResultsByDomainRequest", [
-            { no: 1, name: "domain", kind: "scalar", T: 9 /*ScalarType.STRING*/ }
+        super("service.PluginInfo", [
+            { no: 1, name: "name", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 2, name: "version", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 3, name: "description", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 4, name: "required_config", kind: "scalar", repeat: 2 /*RepeatType.UNPACKED*/, T: 9 /*ScalarType.STRING*/ },
+            { no: 5, name: "passive", kind: "scalar", T: 8 /*ScalarType.BOOL*/ },
+            { no: 6, name: "dependencies", kind: "scalar", repeat: 2 /*RepeatType.UNPACKED*/, T: 9 /*ScalarType.STRING*/ },
+            { no: 7, name: "loaded", kind: "scalar", T: 8 /*ScalarType.BOOL*/ },
+            { no: 8, name: "configured", kind: "scalar", T: 8 /*ScalarType.BOOL*/ },
+            { no: 9, name: "missing_config", kind: "scalar", repeat: 2 /*RepeatType.UNPACKED*/, T: 9 /*ScalarType.STRING*/ },
+            { no: 10, name: "external", kind: "scalar", T: 8 /*ScalarType.BOOL*/ },
+            { no: 11, name: "healthy", kind: "scalar", T: 8 /*ScalarType.BOOL*/ },
+            { no: 12, name: "health_message", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 13, name: "enabled", kind: "scalar", T: 8 /*ScalarType.BOOL*/ }
         ]);
     }
-    create(value?: PartialMessage<GetChaosScanResultsByDomainRequest>): GetChaosScanResultsByDomainRequest {
+    create(value?: PartialMessage<PluginInfo>): PluginInfo {
         const message = globalThis.Object.create((this.messagePrototype!));
-        message.domain = "";
+        message.name = "";
+        message.version = "";
+        message.description = "";
+        message.requiredConfig = [];
+        message.passive = false;
+        message.dependencies = [];
+        message.loaded = false;
+        message.configured = false;
+        message.missingConfig = [];
+        message.external = false;
+        message.healthy = false;
+        message.healthMessage = "";
+        message.enabled = false;
         if (value !== undefined)
-            reflectionMergePartial<GetChaosScanResultsByDomainRequest>(this, message, value);
+            reflectionMergePartial<PluginInfo>(this, message, value);
         return message;
     }
-    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: GetChaosScanResultsByDomainRequest): GetChaosScanResultsByDomainRequest {
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: PluginInfo): PluginInfo {
         let message = target ?? this.create(), end = reader.pos + length;
         while (reader.pos < end) {
             let [fieldNo, wireType] = reader.tag();
             switch (fieldNo) {
-                case /* string domain */ 1:
-                    message.domain = reader.string();
+                case /* string name */ 1:
+                    message.name = reader.string();
+                    break;
+                case /* string version */ 2:
+                    message.version = reader.string();
+                    break;
+                case /* string description */ 3:
+                    message.description = reader.string();
+                    break;
+                case /* repeated string required_config */ 4:
+                    message.requiredConfig.push(reader.string());
+                    break;
+                case /* bool passive */ 5:
+                    message.passive = reader.bool();
+                    break;
+                case /* repeated string dependencies */ 6:
+                    message.dependencies.push(reader.string());
+                    break;
+                case /* bool loaded */ 7:
+                    message.loaded = reader.bool();
+                    break;
+                case /* bool configured */ 8:
+                    message.configured = reader.bool();
+                    break;
+                case /* repeated string missing_config */ 9:
+                    message.missingConfig.push(reader.string());
+                    break;
+                case /* bool external */ 10:
+                    message.external = reader.bool();
+                    break;
+                case /* bool healthy */ 11:
+                    message.healthy = reader.bool();
+                    break;
+                case /* string health_message */ 12:
+                    message.healthMessage = reader.string();
+                    break;
+                case /* bool enabled */ 13:
+                    message.enabled = reader.bool();
                     break;
                 default:
                     let u = options.readUnknownField;
@@ -4980,10 +11424,46 @@ class GetChaosScanResultsByDomainRequest$Type extends MessageType<GetChaosScanRe
         }
         return message;
     }
-    internalBinaryWrite(message: GetChaosScanResultsByDomainRequest, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
-        /* string domain = 1; */
-        if (message.domain !== "")
-            writer.tag(1, WireType.LengthDelimited).string(message.domain);
+    internalBinaryWrite(message: PluginInfo, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* string name = 1; */
+        if (message.name !== "")
+            writer.tag(1, WireType.LengthDelimited).string(message.name);
+        /* string version = 2; */
+        if (message.version !== "")
+            writer.tag(2, WireType.LengthDelimited).string(message.version);
+        /* string description = 3; */
+        if (message.description !== "")
+            writer.tag(3, WireType.LengthDelimited).string(message.description);
+        /* repeated string required_config = 4; */
+        for (let i = 0; i < message.requiredConfig.length; i++)
+            writer.tag(4, WireType.LengthDelimited).string(message.requiredConfig[i]);
+        /* bool passive = 5; */
+        if (message.passive !== false)
+            writer.tag(5, WireType.Varint).bool(message.passive);
+        /* repeated string dependencies = 6; */
+        for (let i = 0; i < message.dependencies.length; i++)
+            writer.tag(6, WireType.LengthDelimited).string(message.dependencies[i]);
+        /* bool loaded = 7; */
+        if (message.loaded !== false)
+            writer.tag(7, WireType.Varint).bool(message.loaded);
+        /* bool configured = 8; */
+        if (message.configured !== false)
+            writer.tag(8, WireType.Varint).bool(message.configured);
+        /* repeated string missing_config = 9; */
+        for (let i = 0; i < message.missingConfig.length; i++)
+            writer.tag(9, WireType.LengthDelimited).string(message.missingConfig[i]);
+        /* bool external = 10; */
+        if (message.external !== false)
+            writer.tag(10, WireType.Varint).bool(message.external);
+        /* bool healthy = 11; */
+        if (message.healthy !== false)
+            writer.tag(11, WireType.Varint).bool(message.healthy);
+        /* string health_message = 12; */
+        if (message.healthMessage !== "")
+            writer.tag(12, WireType.LengthDelimited).string(message.healthMessage);
+        /* bool enabled = 13; */
+        if (message.enabled !== false)
+            writer.tag(13, WireType.Varint).bool(message.enabled);
         let u = options.writeUnknownFields;
         if (u !== false)
             (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
@@ -4991,30 +11471,40 @@ class GetChaosScanResultsByDomainRequest$Type extends MessageType<GetChaosScanRe
     }
 }
 /**
- * @generated MessageType for protobuf message service.GetChaosScanResultsByDomainRequest
+ * @generated MessageType for protobuf message service.PluginInfo
  */
-export const GetChaosScanResultsByDomainRequest = new GetChaosScanResultsByDomainRequest$Type();
+export const PluginInfo = new PluginInfo$Type();
 // @generated message type with reflection information, may provide speed optimized methods
-class GetChaosScanResultsByDomainResponse$Type extends MessageType<GetChaosScanResultsByDomainResponse> {
+class SubmitScanJobRequest$Type extends MessageType<SubmitScanJobRequest> {
     constructor() {
-        super("service.GetChaosScanResultsByDomainResponse", [
-            { no: 1, name: "results", kind: "message", repeat: 2 /*RepeatType.UNPACKED*/, T: () => ChaosScanResult }
+        super("service.SubmitScanJobRequest", [
+            { no: 1, name: "domain", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 2, name: "profile", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 3, name: "refresh", kind: "scalar", T: 8 /*ScalarType.BOOL*/ }
         ]);
     }
-    create(value?: PartialMessage<GetChaosScanResultsByDomainResponse>): GetChaosScanResultsByDomainResponse {
+    create(value?: PartialMessage<SubmitScanJobRequest>): SubmitScanJobRequest {
         const message = globalThis.Object.create((this.messagePrototype!));
-        message.results = [];
+        message.domain = "";
+        message.profile = "";
+        message.refresh = false;
         if (value !== undefined)
-            reflectionMergePartial<GetChaosScanResultsByDomainResponse>(this, message, value);
+            reflectionMergePartial<SubmitScanJobRequest>(this, message, value);
         return message;
     }
-    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: GetChaosScanResultsByDomainResponse): GetChaosScanResultsByDomainResponse {
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: SubmitScanJobRequest): SubmitScanJobRequest {
         let message = target ?? this.create(), end = reader.pos + length;
         while (reader.pos < end) {
             let [fieldNo, wireType] = reader.tag();
             switch (fieldNo) {
-                case /* repeated service.ChaosScanResult results */ 1:
-                    message.results.push(ChaosScanResult.internalBinaryRead(reader, reader.uint32(), options));
+                case /* string domain */ 1:
+                    message.domain = reader.string();
+                    break;
+                case /* string profile */ 2:
+                    message.profile = reader.string();
+                    break;
+                case /* bool refresh */ 3:
+                    message.refresh = reader.bool();
                     break;
                 default:
                     let u = options.readUnknownField;
@@ -5027,10 +11517,16 @@ class GetChaosScanResultsByDomainResponse$Type extends MessageType<GetChaosScanR
         }
         return message;
     }
-    internalBinaryWrite(message: GetChaosScanResultsByDomainResponse, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
-        /* repeated service.ChaosScanResult results = 1; */
-        for (let i = 0; i < message.results.length; i++)
-            ChaosScanResult.internalBinaryWrite(message.results[i], writer.tag(1, WireType.LengthDelimited).fork(), options).join();
+    internalBinaryWrite(message: SubmitScanJobRequest, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* string domain = 1; */
+        if (message.domain !== "")
+            writer.tag(1, WireType.LengthDelimited).string(message.domain);
+        /* string profile = 2; */
+        if (message.profile !== "")
+            writer.tag(2, WireType.LengthDelimited).string(message.profile);
+        /* bool refresh = 3; */
+        if (message.refresh !== false)
+            writer.tag(3, WireType.Varint).bool(message.refresh);
         let u = options.writeUnknownFields;
         if (u !== false)
             (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
@@ -5038,48 +11534,29 @@ class GetChaosScanResultsByDomainResponse$Type extends MessageType<GetChaosScanR
     }
 }
 /**
- * @generated MessageType for protobuf message service.GetChaosScanResultsByDomainResponse
+ * @generated MessageType for protobuf message service.SubmitScanJobRequest
  */
-export const GetChaosScanResultsByDomainResponse = new GetChaosScanResultsByDomainResponse$Type();
+export const SubmitScanJobRequest = new SubmitScanJobRequest$Type();
 // @generated message type with reflection information, may provide speed optimized methods
-class ChaosScanResult$Type extends MessageType<ChaosScanResult> {
+class SubmitScanJobResponse$Type extends MessageType<SubmitScanJobResponse> {
     constructor() {
-        super("service.ChaosScanResult", [
-            { no: 1, name: "id", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
-            { no: 2, name: "domain", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
-            { no: 3, name: "dns_scan_id", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
-            { no: 4, name: "result", kind: "message", T: () => ChaosSecurityResult },
-            { no: 5, name: "created_at", kind: "message", T: () => Timestamp }
+        super("service.SubmitScanJobResponse", [
+            { no: 1, name: "job", kind: "message", T: () => ScanJob }
         ]);
     }
-    create(value?: PartialMessage<ChaosScanResult>): ChaosScanResult {
+    create(value?: PartialMessage<SubmitScanJobResponse>): SubmitScanJobResponse {
         const message = globalThis.Object.create((this.messagePrototype!));
-        message.id = "";
-        message.domain = "";
-        message.dnsScanId = "";
         if (value !== undefined)
-            reflectionMergePartial<ChaosScanResult>(this, message, value);
+            reflectionMergePartial<SubmitScanJobResponse>(this, message, value);
         return message;
     }
-    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: ChaosScanResult): ChaosScanResult {
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: SubmitScanJobResponse): SubmitScanJobResponse {
         let message = target ?? this.create(), end = reader.pos + length;
         while (reader.pos < end) {
             let [fieldNo, wireType] = reader.tag();
             switch (fieldNo) {
-                case /* string id */ 1:
-                    message.id = reader.string();
-                    break;
-                case /* string domain */ 2:
-                    message.domain = reader.string();
-                    break;
-                case /* string dns_scan_id */ 3:
-                    message.dnsScanId = reader.string();
-                    break;
-                case /* service.ChaosSecurityResult result */ 4:
-                    message.result = ChaosSecurityResult.internalBinaryRead(reader, reader.uint32(), options, message.result);
-                    break;
-                case /* google.protobuf.Timestamp created_at */ 5:
-                    message.createdAt = Timestamp.internalBinaryRead(reader, reader.uint32(), options, message.createdAt);
+                case /* service.ScanJob job */ 1:
+                    message.job = ScanJob.internalBinaryRead(reader, reader.uint32(), options, message.job);
                     break;
                 default:
                     let u = options.readUnknownField;
@@ -5092,22 +11569,10 @@ class ChaosScanResult$Type extends MessageType<ChaosScanResult> {
         }
         return message;
     }
-    internalBinaryWrite(message: ChaosScanResult, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
-        /* string id = 1; */
-        if (message.id !== "")
-            writer.tag(1, WireType.LengthDelimited).string(message.id);
-        /* string domain = 2; */
-        if (message.domain !== "")
-            writer.tag(2, WireType.LengthDelimited).string(message.domain);
-        /* string dns_scan_id = 3; */
-        if (message.dnsScanId !== "")
-            writer.tag(3, WireType.LengthDelimited).string(message.dnsScanId);
-        /* service.ChaosSecurityResult result = 4; */
-        if (message.result)
-            ChaosSecurityResult.internalBinaryWrite(message.result, writer.tag(4, WireType.LengthDelimited).fork(), options).join();
-        /* google.protobuf.Timestamp created_at = 5; */
-        if (message.createdAt)
-            Timestamp.internalBinaryWrite(message.createdAt, writer.tag(5, WireType.LengthDelimited).fork(), options).join();
+    internalBinaryWrite(message: SubmitScanJobResponse, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* service.ScanJob job = 1; */
+        if (message.job)
+            ScanJob.internalBinaryWrite(message.job, writer.tag(1, WireType.LengthDelimited).fork(), options).join();
         let u = options.writeUnknownFields;
         if (u !== false)
             (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
@@ -5115,35 +11580,30 @@ class ChaosScanResult$Type extends MessageType<ChaosScanResult> {
     }
 }
 /**
- * @generated MessageType for protobuf message service.ChaosScanResult
+ * @generated MessageType for protobuf message service.SubmitScanJobResponse
  */
-export const ChaosScanResult = new ChaosScanResult$Type();
+export const SubmitScanJobResponse = new SubmitScanJobResponse$Type();
 // @generated message type with reflection information, may provide speed optimized methods
-class ScanShodanRequest$Type extends MessageType<ScanShodanRequest> {
+class GetScanJobRequest$Type extends MessageType<GetScanJobRequest> {
     constructor() {
-        super("service.ScanShodanRequest", [
-            { no: 1, name: "domain", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
-            { no: 2, name: "dns_scan_id", kind: "scalar", T: 9 /*ScalarType.STRING*/ }
+        super("service.GetScanJobRequest", [
+            { no: 1, name: "job_id", kind: "scalar", T: 9 /*ScalarType.STRING*/ }
         ]);
     }
-    create(value?: PartialMessage<ScanShodanRequest>): ScanShodanRequest {
+    create(value?: PartialMessage<GetScanJobRequest>): GetScanJobRequest {
         const message = globalThis.Object.create((this.messagePrototype!));
-        message.domain = "";
-        message.dnsScanId = "";
+        message.jobId = "";
         if (value !== undefined)
-            reflectionMergePartial<ScanShodanRequest>(this, message, value);
+            reflectionMergePartial<GetScanJobRequest>(this, message, value);
         return message;
     }
-    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: ScanShodanRequest): ScanShodanRequest {
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: GetScanJobRequest): GetScanJobRequest {
         let message = target ?? this.create(), end = reader.pos + length;
         while (reader.pos < end) {
             let [fieldNo, wireType] = reader.tag();
             switch (fieldNo) {
-                case /* string domain */ 1:
-                    message.domain = reader.string();
-                    break;
-                case /* string dns_scan_id */ 2:
-                    message.dnsScanId = reader.string();
+                case /* string job_id */ 1:
+                    message.jobId = reader.string();
                     break;
                 default:
                     let u = options.readUnknownField;
@@ -5156,13 +11616,10 @@ class ScanShodanRequest$Type extends MessageType<ScanShodanRequest> {
         }
         return message;
     }
-    internalBinaryWrite(message: ScanShodanRequest, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
-        /* string domain = 1; */
-        if (message.domain !== "")
-            writer.tag(1, WireType.LengthDelimited).string(message.domain);
-        /* string dns_scan_id = 2; */
-        if (message.dnsScanId !== "")
-            writer.tag(2, WireType.LengthDelimited).string(message.dnsScanId);
+    internalBinaryWrite(message: GetScanJobRequest, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* string job_id = 1; */
+        if (message.jobId !== "")
+            writer.tag(1, WireType.LengthDelimited).string(message.jobId);
         let u = options.writeUnknownFields;
         if (u !== false)
             (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
@@ -5170,34 +11627,29 @@ class ScanShodanRequest$Type extends MessageType<ScanShodanRequest> {
     }
 }
 /**
- * @generated MessageType for protobuf message service.ScanShodanRequest
+ * @generated MessageType for protobuf message service.GetScanJobRequest
  */
-export const ScanShodanRequest = new ScanShodanRequest$Type();
+export const GetScanJobRequest = new GetScanJobRequest$Type();
 // @generated message type with reflection information, may provide speed optimized methods
-class ScanShodanResponse$Type extends MessageType<ScanShodanResponse> {
+class GetScanJobResponse$Type extends MessageType<GetScanJobResponse> {
     constructor() {
-        super("service.ScanShodanResponse", [
-            { no: 1, name: "scan_id", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
-            { no: 2, name: "result", kind: "message", T: () => ShodanSecurityResult }
+        super("service.GetScanJobResponse", [
+            { no: 1, name: "job", kind: "message", T: () => ScanJob }
         ]);
     }
-    create(value?: PartialMessage<ScanShodanResponse>): ScanShodanResponse {
+    create(value?: PartialMessage<GetScanJobResponse>): GetScanJobResponse {
         const message = globalThis.Object.create((this.messagePrototype!));
-        message.scanId = "";
         if (value !== undefined)
-            reflectionMergePartial<ScanShodanResponse>(this, message, value);
+            reflectionMergePartial<GetScanJobResponse>(this, message, value);
         return message;
     }
-    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: ScanShodanResponse): ScanShodanResponse {
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: GetScanJobResponse): GetScanJobResponse {
         let message = target ?? this.create(), end = reader.pos + length;
         while (reader.pos < end) {
             let [fieldNo, wireType] = reader.tag();
             switch (fieldNo) {
-                case /* string scan_id */ 1:
-                    message.scanId = reader.string();
-                    break;
-                case /* service.ShodanSecurityResult result */ 2:
-                    message.result = ShodanSecurityResult.internalBinaryRead(reader, reader.uint32(), options, message.result);
+                case /* service.ScanJob job */ 1:
+                    message.job = ScanJob.internalBinaryRead(reader, reader.uint32(), options, message.job);
                     break;
                 default:
                     let u = options.readUnknownField;
@@ -5210,13 +11662,10 @@ class ScanShodanResponse$Type extends MessageType<ScanShodanResponse> {
         }
         return message;
     }
-    internalBinaryWrite(message: ScanShodanResponse, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
-        /* string scan_id = 1; */
-        if (message.scanId !== "")
-            writer.tag(1, WireType.LengthDelimited).string(message.scanId);
-        /* service.ShodanSecurityResult result = 2; */
-        if (message.result)
-            ShodanSecurityResult.internalBinaryWrite(message.result, writer.tag(2, WireType.LengthDelimited).fork(), options).join();
+    internalBinaryWrite(message: GetScanJobResponse, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* service.ScanJob job = 1; */
+        if (message.job)
+            ScanJob.internalBinaryWrite(message.job, writer.tag(1, WireType.LengthDelimited).fork(), options).join();
         let u = options.writeUnknownFields;
         if (u !== false)
             (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
@@ -5224,30 +11673,30 @@ class ScanShodanResponse$Type extends MessageType<ScanShodanResponse> {
     }
 }
 /**
- * @generated MessageType for protobuf message service.ScanShodanResponse
+ * @generated MessageType for protobuf message service.GetScanJobResponse
  */
-export const ScanShodanResponse = new ScanShodanResponse$Type();
+export const GetScanJobResponse = new GetScanJobResponse$Type();
 // @generated message type with reflection information, may provide speed optimized methods
-class GetShodanScanResultsByDomainRequest$Type extends MessageType<GetShodanScanResultsByDomainRequest> {
+class ListScanJobsRequest$Type extends MessageType<ListScanJobsRequest> {
     constructor() {
-        super("service.GetShodanScanResultsByDomainRequest", [
-            { no: 1, name: "domain", kind: "scalar", T: 9 /*ScalarType.STRING*/ }
+        super("service.ListScanJobsRequest", [
+            { no: 1, name: "limit", kind: "scalar", T: 5 /*ScalarType.INT32*/ }
         ]);
     }
-    create(value?: PartialMessage<GetShodanScanResultsByDomainRequest>): GetShodanScanResultsByDomainRequest {
+    create(value?: PartialMessage<ListScanJobsRequest>): ListScanJobsRequest {
         const message = globalThis.Object.create((this.messagePrototype!));
-        message.domain = "";
+        message.limit = 0;
         if (value !== undefined)
-            reflectionMergePartial<GetShodanScanResultsByDomainRequest>(this, message, value);
+            reflectionMergePartial<ListScanJobsRequest>(this, message, value);
         return message;
     }
-    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: GetShodanScanResultsByDomainRequest): GetShodanScanResultsByDomainRequest {
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: ListScanJobsRequest): ListScanJobsRequest {
         let message = target ?? this.create(), end = reader.pos + length;
         while (reader.pos < end) {
             let [fieldNo, wireType] = reader.tag();
             switch (fieldNo) {
-                case /* string domain */ 1:
-                    message.domain = reader.string();
+                case /* int32 limit */ 1:
+                    message.limit = reader.int32();
                     break;
                 default:
                     let u = options.readUnknownField;
@@ -5260,10 +11709,10 @@ class GetShodanScanResultsByDomainRequest$Type extends MessageType<GetShodanScan
         }
         return message;
     }
-    internalBinaryWrite(message: GetShodanScanResultsByDomainRequest, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
-        /* string domain = 1; */
-        if (message.domain !== "")
-            writer.tag(1, WireType.LengthDelimited).string(message.domain);
+    internalBinaryWrite(message: ListScanJobsRequest, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* int32 limit = 1; */
+        if (message.limit !== 0)
+            writer.tag(1, WireType.Varint).int32(message.limit);
         let u = options.writeUnknownFields;
         if (u !== false)
             (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
@@ -5271,30 +11720,30 @@ class GetShodanScanResultsByDomainRequest$Type extends MessageType<GetShodanScan
     }
 }
 /**
- * @generated MessageType for protobuf message service.GetShodanScanResultsByDomainRequest
+ * @generated MessageType for protobuf message service.ListScanJobsRequest
  */
-export const GetShodanScanResultsByDomainRequest = new GetShodanScanResultsByDomainRequest$Type();
+export const ListScanJobsRequest = new ListScanJobsRequest$Type();
 // @generated message type with reflection information, may provide speed optimized methods
-class GetShodanScanResultsByDomainResponse$Type extends MessageType<GetShodanScanResultsByDomainResponse> {
+class ListScanJobsResponse$Type extends MessageType<ListScanJobsResponse> {
     constructor() {
-        super("service.GetShodanScanResultsByDomainResponse", [
-            { no: 1, name: "results", kind: "message", repeat: 2 /*RepeatType.UNPACKED*/, T: () => ShodanScanResult }
+        super("service.ListScanJobsResponse", [
+            { no: 1, name: "jobs", kind: "message", repeat: 2 /*RepeatType.UNPACKED*/, T: () => ScanJob }
         ]);
     }
-    create(value?: PartialMessage<GetShodanScanResultsByDomainResponse>): GetShodanScanResultsByDomainResponse {
+    create(value?: PartialMessage<ListScanJobsResponse>): ListScanJobsResponse {
         const message = globalThis.Object.create((this.messagePrototype!));
-        message.results = [];
+        message.jobs = [];
         if (value !== undefined)
-            reflectionMergePartial<GetShodanScanResultsByDomainResponse>(this, message, value);
+            reflectionMergePartial<ListScanJobsResponse>(this, message, value);
         return message;
     }
-    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: GetShodanScanResultsByDomainResponse): GetShodanScanResultsByDomainResponse {
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: ListScanJobsResponse): ListScanJobsResponse {
         let message = target ?? this.create(), end = reader.pos + length;
         while (reader.pos < end) {
             let [fieldNo, wireType] = reader.tag();
             switch (fieldNo) {
-                case /* repeated service.ShodanScanResult results */ 1:
-                    message.results.push(ShodanScanResult.internalBinaryRead(reader, reader.uint32(), options));
+                case /* repeated service.ScanJob jobs */ 1:
+                    message.jobs.push(ScanJob.internalBinaryRead(reader, reader.uint32(), options));
                     break;
                 default:
                     let u = options.readUnknownField;
@@ -5307,10 +11756,10 @@ class GetShodanScanResultsByDomainResponse$Type extends MessageType<GetShodanSca
         }
         return message;
     }
-    internalBinaryWrite(message: GetShodanScanResultsByDomainResponse, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
-        /* repeated service.ShodanScanResult results = 1; */
-        for (let i = 0; i < message.results.length; i++)
-            ShodanScanResult.internalBinaryWrite(message.results[i], writer.tag(1, WireType.LengthDelimited).fork(), options).join();
+    internalBinaryWrite(message: ListScanJobsResponse, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* repeated service.ScanJob jobs = 1; */
+        for (let i = 0; i < message.jobs.length; i++)
+            ScanJob.internalBinaryWrite(message.jobs[i], writer.tag(1, WireType.LengthDelimited).fork(), options).join();
         let u = options.writeUnknownFields;
         if (u !== false)
             (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
@@ -5318,110 +11767,76 @@ class GetShodanScanResultsByDomainResponse$Type extends MessageType<GetShodanSca
     }
 }
 /**
- * @generated MessageType for protobuf message service.GetShodanScanResultsByDomainResponse
+ * @generated MessageType for protobuf message service.ListScanJobsResponse
  */
-export const GetShodanScanResultsByDomainResponse = new GetShodanScanResultsByDomainResponse$Type();
+export const ListScanJobsResponse = new ListScanJobsResponse$Type();
 // @generated message type with reflection information, may provide speed optimized methods
-class DNSSecurityResult$Type extends MessageType<DNSSecurityResult> {
+class CancelScanJobRequest$Type extends MessageType<CancelScanJobRequest> {
     constructor() {
-        super("service.DNSSecurityResult", [
-            { no: 1, name: "spf_record", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
-            { no: 2, name: "spf_valid", kind: "scalar", T: 8 /*ScalarType.BOOL*/ },
-            { no: 3, name: "spf_policy", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
-            { no: 4, name: "dkim_record", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
-            { no: 5, name: "dkim_valid", kind: "scalar", T: 8 /*ScalarType.BOOL*/ },
-            { no: 6, name: "dkim_validation_error", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
-            { no: 7, name: "dmarc_record", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
-            { no: 8, name: "dmarc_policy", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
-            { no: 9, name: "dmarc_valid", kind: "scalar", T: 8 /*ScalarType.BOOL*/ },
-            { no: 10, name: "dmarc_validation_error", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
-            { no: 11, name: "dnssec_enabled", kind: "scalar", T: 8 /*ScalarType.BOOL*/ },
-            { no: 12, name: "dnssec_valid", kind: "scalar", T: 8 /*ScalarType.BOOL*/ },
-            { no: 13, name: "dnssec_validation_error", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
-            { no: 14, name: "ip_addresses", kind: "scalar", repeat: 2 /*RepeatType.UNPACKED*/, T: 9 /*ScalarType.STRING*/ },
-            { no: 15, name: "mx_records", kind: "scalar", repeat: 2 /*RepeatType.UNPACKED*/, T: 9 /*ScalarType.STRING*/ },
-            { no: 16, name: "ns_records", kind: "scalar", repeat: 2 /*RepeatType.UNPACKED*/, T: 9 /*ScalarType.STRING*/ },
-            { no: 17, name: "errors", kind: "scalar", repeat: 2 /*RepeatType.UNPACKED*/, T: 9 /*ScalarType.STRING*/ }
+        super("service.CancelScanJobRequest", [
+            { no: 1, name: "job_id", kind: "scalar", T: 9 /*ScalarType.STRING*/ }
         ]);
     }
-    create(value?: PartialMessage<DNSSecurityResult>): DNSSecurityResult {
+    create(value?: PartialMessage<CancelScanJobRequest>): CancelScanJobRequest {
         const message = globalThis.Object.create((this.messagePrototype!));
-        message.spfRecord = "";
-        message.spfValid = false;
-        message.spfPolicy = "";
-        message.dkimRecord = "";
-        message.dkimValid = false;
-        message.dkimValidationError = "";
-        message.dmarcRecord = "";
-        message.dmarcPolicy = "";
-        message.dmarcValid = false;
-        message.dmarcValidationError = "";
-        message.dnssecEnabled = false;
-        message.dnssecValid = false;
-        message.dnssecValidationError = "";
-        message.ipAddresses = [];
-        message.mxRecords = [];
-        message.nsRecords = [];
-        message.errors = [];
+        message.jobId = "";
         if (value !== undefined)
-            reflectionMergePartial<DNSSecurityResult>(this, message, value);
+            reflectionMergePartial<CancelScanJobRequest>(this, message, value);
         return message;
     }
-    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: DNSSecurityResult): DNSSecurityResult {
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: CancelScanJobRequest): CancelScanJobRequest {
         let message = target ?? this.create(), end = reader.pos + length;
         while (reader.pos < end) {
             let [fieldNo, wireType] = reader.tag();
             switch (fieldNo) {
-                case /* string spf_record */ 1:
-                    message.spfRecord = reader.string();
-                    break;
-                case /* bool spf_valid */ 2:
-                    message.spfValid = reader.bool();
-                    break;
-                case /* string spf_policy */ 3:
-                    message.spfPolicy = reader.string();
-                    break;
-                case /* string dkim_record */ 4:
-                    message.dkimRecord = reader.string();
-                    break;
-                case /* bool dkim_valid */ 5:
-                    message.dkimValid = reader.bool();
-                    break;
-                case /* string dkim_validation_error */ 6:
-                    message.dkimValidationError = reader.string();
-                    break;
-                case /* string dmarc_record */ 7:
-                    message.dmarcRecord = reader.string();
-                    break;
-                case /* string dmarc_policy */ 8:
-                    message.dmarcPolicy = reader.string();
-                    break;
-                case /* bool dmarc_valid */ 9:
-                    message.dmarcValid = reader.bool();
-                    break;
-                case /* string dmarc_validation_error */ 10:
-                    message.dmarcValidationError = reader.string();
-                    break;
-                case /* bool dnssec_enabled */ 11:
-                    message.dnssecEnabled = reader.bool();
-                    break;
-                case /* bool dnssec_valid */ 12:
-                    message.dnssecValid = reader.bool();
-                    break;
-                case /* string dnssec_validation_error */ 13:
-                    message.dnssecValidationError = reader.string();
-                    break;
-                case /* repeated string ip_addresses */ 14:
-                    message.ipAddresses.push(reader.string());
+                case /* string job_id */ 1:
+                    message.jobId = reader.string();
                     break;
-                case /* repeated string mx_records */ 15:
-                    message.mxRecords.push(reader.string());
-                    break;
-                case /* repeated string ns_records */ 16:
-                    message.nsRecords.push(reader.string());
-                    break;
-                case /* repeated string errors */ 17:
-                    message.errors.push(reader.string());
+                default:
+                    let u = options.readUnknownField;
+                    if (u === "throw")
+                        throw new globalThis.Error(`Unknown field ${fieldNo} (wire type ${wireType}) for ${this.typeName}`);
+                    let d = reader.skip(wireType);
+                    if (u !== false)
+                        (u === true ? UnknownFieldHandler.onRead : u)(this.typeName, message, fieldNo, wireType, d);
+            }
+        }
+        return message;
+    }
+    internalBinaryWrite(message: CancelScanJobRequest, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* string job_id = 1; */
+        if (message.jobId !== "")
+            writer.tag(1, WireType.LengthDelimited).string(message.jobId);
+        let u = options.writeUnknownFields;
+        if (u !== false)
+            (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
+        return writer;
+    }
+}
+/**
+ * @generated MessageType for protobuf message service.CancelScanJobRequest
+ */
+export const CancelScanJobRequest = new CancelScanJobRequest$Type();
+// @generated message type with reflection information, may provide speed optimized methods
+class CancelScanJobResponse$Type extends MessageType<CancelScanJobResponse> {
+    constructor() {
+        super("service.CancelScanJobResponse", [
+            { no: 1, name: "job", kind: "message", T: () => ScanJob }
+        ]);
+    }
+    create(value?: PartialMessage<CancelScanJobResponse>): CancelScanJobResponse {
+        const message = globalThis.Object.create((this.messagePrototype!));
+        if (value !== undefined)
+            reflectionMergePartial<CancelScanJobResponse>(this, message, value);
+        return message;
+    }
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: CancelScanJobResponse): CancelScanJobResponse {
+        let message = target ?? this.create(), end = reader.pos + length;
+        while (reader.pos < end) {
+            let [fieldNo, wireType] = reader.tag();
+            switch (fieldNo) {
+                case /* service.ScanJob job */ 1:
+                    message.job = ScanJob.internalBinaryRead(reader, reader.uint32(), options, message.job);
                     break;
                 default:
                     let u = options.readUnknownField;
@@ -5434,58 +11849,10 @@ class DNSSecurityResult$Type extends MessageType<DNSSecurityResult> {
         }
         return message;
     }
-    internalBinaryWrite(message: DNSSecurityResult, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
-        /* string spf_record = 1; */
-        if (message.spfRecord !== "")
-            writer.tag(1, WireType.LengthDelimited).string(message.spfRecord);
-        /* bool spf_valid = 2; */
-        if (message.spfValid !== false)
-            writer.tag(2, WireType.Varint).bool(message.spfValid);
-        /* string spf_policy = 3; */
-        if (message.spfPolicy !== "")
-            writer.tag(3, WireType.LengthDelimited).string(message.spfPolicy);
-        /* string dkim_record = 4; */
-        if (message.dkimRecord !== "")
-            writer.tag(4, WireType.LengthDelimited).string(message.dkimRecord);
-        /* bool dkim_valid = 5; */
-        if (message.dkimValid !== false)
-            writer.tag(5, WireType.Varint).bool(message.dkimValid);
-        /* string dkim_validation_error = 6; */
-        if (message.dkimValidationError !== "")
-            writer.tag(6, WireType.LengthDelimited).string(message.dkimValidationError);
-        /* string dmarc_record = 7; */
-        if (message.dmarcRecord !== "")
-            writer.tag(7, WireType.LengthDelimited).string(message.dmarcRecord);
-        /* string dmarc_policy = 8; */
-        if (message.dmarcPolicy !== "")
-            writer.tag(8, WireType.LengthDelimited).string(message.dmarcPolicy);
-        /* bool dmarc_valid = 9; */
-        if (message.dmarcValid !== false)
-            writer.tag(9, WireType.Varint).bool(message.dmarcValid);
-        /* string dmarc_validation_error = 10; */
-        if (message.dmarcValidationError !== "")
-            writer.tag(10, WireType.LengthDelimited).string(message.dmarcValidationError);
-        /* bool dnssec_enabled = 11; */
-        if (message.dnssecEnabled !== false)
-            writer.tag(11, WireType.Varint).bool(message.dnssecEnabled);
-        /* bool dnssec_valid = 12; */
-        if (message.dnssecValid !== false)
-            writer.tag(12, WireType.Varint).bool(message.dnssecValid);
-        /* string dnssec_validation_error = 13; */
-        if (message.dnssecValidationError !== "")
-            writer.tag(13, WireType.LengthDelimited).string(message.dnssecValidationError);
-        /* repeated string ip_addresses = 14; */
-        for (let i = 0; i < message.ipAddresses.length; i++)
-            writer.tag(14, WireType.LengthDelimited).string(message.ipAddresses[i]);
-        /* repeated string mx_records = 15; */
-        for (let i = 0; i < message.mxRecords.length; i++)
-            writer.tag(15, WireType.LengthDelimited).string(message.mxRecords[i]);
-        /* repeated string ns_records = 16; */
-        for (let i = 0; i < message.nsRecords.length; i++)
-            writer.tag(16, WireType.LengthDelimited).string(message.nsRecords[i]);
-        /* repeated string errors = 17; */
-        for (let i = 0; i < message.errors.length; i++)
-            writer.tag(17, WireType.LengthDelimited).string(message.errors[i]);
+    internalBinaryWrite(message: CancelScanJobResponse, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* service.ScanJob job = 1; */
+        if (message.job)
+            ScanJob.internalBinaryWrite(message.job, writer.tag(1, WireType.LengthDelimited).fork(), options).join();
         let u = options.writeUnknownFields;
         if (u !== false)
             (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
@@ -5493,83 +11860,102 @@ class DNSSecurityResult$Type extends MessageType<DNSSecurityResult> {
     }
 }
 /**
- * @generated MessageType for protobuf message service.DNSSecurityResult
+ * @generated MessageType for protobuf message service.CancelScanJobResponse
  */
-export const DNSSecurityResult = new DNSSecurityResult$Type();
+export const CancelScanJobResponse = new CancelScanJobResponse$Type();
 // @generated message type with reflection information, may provide speed optimized methods
-class TLSSecurityResult$Type extends MessageType<TLSSecurityResult> {
+class ScanJob$Type extends MessageType<ScanJob> {
     constructor() {
-        super("service.TLSSecurityResult", [
-            { no: 1, name: "tls_version", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
-            { no: 2, name: "cipher_suite", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
-            { no: 3, name: "hsts_header", kind: "scalar", T: 8 /*ScalarType.BOOL*/ },
-            { no: 4, name: "certificate_valid", kind: "scalar", T: 8 /*ScalarType.BOOL*/ },
-            { no: 5, name: "cert_issuer", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
-            { no: 6, name: "cert_subject", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
-            { no: 7, name: "cert_not_before", kind: "message", T: () => Timestamp },
-            { no: 8, name: "cert_not_after", kind: "message", T: () => Timestamp },
-            { no: 9, name: "cert_dns_names", kind: "scalar", repeat: 2 /*RepeatType.UNPACKED*/, T: 9 /*ScalarType.STRING*/ },
-            { no: 10, name: "cert_key_strength", kind: "scalar", T: 5 /*ScalarType.INT32*/ },
-            { no: 11, name: "cert_signature_algorithm", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
-            { no: 12, name: "errors", kind: "scalar", repeat: 2 /*RepeatType.UNPACKED*/, T: 9 /*ScalarType.STRING*/ }
+        super("service.ScanJob", [
+            { no: 1, name: "job_id", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 2, name: "domain", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 3, name: "status", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 4, name: "report_id", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 5, name: "error", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 6, name: "cancel_requested", kind: "scalar", T: 8 /*ScalarType.BOOL*/ },
+            { no: 7, name: "plugins", kind: "message", repeat: 2 /*RepeatType.UNPACKED*/, T: () => ScanJobPlugin },
+            { no: 8, name: "created_at", kind: "message", T: () => Timestamp },
+            { no: 9, name: "started_at", kind: "message", T: () => Timestamp },
+            { no: 10, name: "finished_at", kind: "message", T: () => Timestamp },
+            { no: 11, name: "parent_job_id", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 12, name: "scan_run_id", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 13, name: "batch_id", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 14, name: "tags", kind: "scalar", repeat: 2 /*RepeatType.UNPACKED*/, T: 9 /*ScalarType.STRING*/ },
+            { no: 15, name: "profile", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 16, name: "refresh", kind: "scalar", T: 8 /*ScalarType.BOOL*/ }
         ]);
     }
-    create(value?: PartialMessage<TLSSecurityResult>): TLSSecurityResult {
+    create(value?: PartialMessage<ScanJob>): ScanJob {
         const message = globalThis.Object.create((this.messagePrototype!));
-        message.tlsVersion = "";
-        message.cipherSuite = "";
-        message.hstsHeader = false;
-        message.certificateValid = false;
-        message.certIssuer = "";
-        message.certSubject = "";
-        message.certDnsNames = [];
-        message.certKeyStrength = 0;
-        message.certSignatureAlgorithm = "";
-        message.errors = [];
+        message.jobId = "";
+        message.domain = "";
+        message.status = "";
+        message.reportId = "";
+        message.error = "";
+        message.cancelRequested = false;
+        message.plugins = [];
+        message.parentJobId = "";
+        message.scanRunId = "";
+        message.batchId = "";
+        message.tags = [];
+        message.profile = "";
+        message.refresh = false;
         if (value !== undefined)
-            reflectionMergePartial<TLSSecurityResult>(this, message, value);
+            reflectionMergePartial<ScanJob>(this, message, value);
         return message;
     }
-    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: TLSSecurityResult): TLSSecurityResult {
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: ScanJob): ScanJob {
         let message = target ?? this.create(), end = reader.pos + length;
         while (reader.pos < end) {
             let [fieldNo, wireType] = reader.tag();
             switch (fieldNo) {
-                case /* string tls_version */ 1:
-                    message.tlsVersion = reader.string();
+                case /* string job_id */ 1:
+                    message.jobId = reader.string();
                     break;
-                case /* string cipher_suite */ 2:
-                    message.cipherSuite = reader.string();
+                case /* string domain */ 2:
+                    message.domain = reader.string();
                     break;
-                case /* bool hsts_header */ 3:
-                    message.hstsHeader = reader.bool();
+                case /* string status */ 3:
+                    message.status = reader.string();
                     break;
-                case /* bool certificate_valid */ 4:
-                    message.certificateValid = reader.bool();
+                case /* string report_id */ 4:
+                    message.reportId = reader.string();
                     break;
-                case /* string cert_issuer */ 5:
-                    message.certIssuer = reader.string();
+                case /* string error */ 5:
+                    message.error = reader.string();
                     break;
-                case /* string cert_subject */ 6:
-                    message.certSubject = reader.string();
+                case /* bool cancel_requested */ 6:
+                    message.cancelRequested = reader.bool();
                     break;
-                case /* google.protobuf.Timestamp cert_not_before */ 7:
-                    message.certNotBefore = Timestamp.internalBinaryRead(reader, reader.uint32(), options, message.certNotBefore);
+                case /* repeated service.ScanJobPlugin plugins */ 7:
+                    message.plugins.push(ScanJobPlugin.internalBinaryRead(reader, reader.uint32(), options));
                     break;
-                case /* google.protobuf.Timestamp cert_not_after */ 8:
-                    message.certNotAfter = Timestamp.internalBinaryRead(reader, reader.uint32(), options, message.certNotAfter);
+                case /* google.protobuf.Timestamp created_at */ 8:
+                    message.createdAt = Timestamp.internalBinaryRead(reader, reader.uint32(), options, message.createdAt);
                     break;
-                case /* repeated string cert_dns_names */ 9:
-                    message.certDnsNames.push(reader.string());
+                case /* google.protobuf.Timestamp started_at */ 9:
+                    message.startedAt = Timestamp.internalBinaryRead(reader, reader.uint32(), options, message.startedAt);
                     break;
-                case /* int32 cert_key_strength */ 10:
-                    message.certKeyStrength = reader.int32();
+                case /* google.protobuf.Timestamp finished_at */ 10:
+                    message.finishedAt = Timestamp.internalBinaryRead(reader, reader.uint32(), options, message.finishedAt);
                     break;
-                case /* string cert_signature_algorithm */ 11:
-                    message.certSignatureAlgorithm = reader.string();
+                case /* string parent_job_id */ 11:
+                    message.parentJobId = reader.string();
                     break;
-                case /* repeated string errors */ 12:
-                    message.errors.push(reader.string());
+                case /* string scan_run_id */ 12:
+                    message.scanRunId = reader.string();
+                    break;
+                case /* string batch_id */ 13:
+                    message.batchId = reader.string();
+                    break;
+                case /* repeated string tags */ 14:
+                    message.tags.push(reader.string());
+                    break;
+                case /* string profile */ 15:
+                    message.profile = reader.string();
+                    break;
+                case /* bool refresh */ 16:
+                    message.refresh = reader.bool();
                     break;
                 default:
                     let u = options.readUnknownField;
@@ -5582,43 +11968,55 @@ class TLSSecurityResult$Type extends MessageType<TLSSecurityResult> {
         }
         return message;
     }
-    internalBinaryWrite(message: TLSSecurityResult, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
-        /* string tls_version = 1; */
-        if (message.tlsVersion !== "")
-            writer.tag(1, WireType.LengthDelimited).string(message.tlsVersion);
-        /* string cipher_suite = 2; */
-        if (message.cipherSuite !== "")
-            writer.tag(2, WireType.LengthDelimited).string(message.cipherSuite);
-        /* bool hsts_header = 3; */
-        if (message.hstsHeader !== false)
-            writer.tag(3, WireType.Varint).bool(message.hstsHeader);
-        /* bool certificate_valid = 4; */
-        if (message.certificateValid !== false)
-            writer.tag(4, WireType.Varint).bool(message.certificateValid);
-        /* string cert_issuer = 5; */
-        if (message.certIssuer !== "")
-            writer.tag(5, WireType.LengthDelimited).string(message.certIssuer);
-        /* string cert_subject = 6; */
-        if (message.certSubject !== "")
-            writer.tag(6, WireType.LengthDelimited).string(message.certSubject);
-        /* google.protobuf.Timestamp cert_not_before = 7; */
-        if (message.certNotBefore)
-            Timestamp.internalBinaryWrite(message.certNotBefore, writer.tag(7, WireType.LengthDelimited).fork(), options).join();
-        /* google.protobuf.Timestamp cert_not_after = 8; */
-        if (message.certNotAfter)
-            Timestamp.internalBinaryWrite(message.certNotAfter, writer.tag(8, WireType.LengthDelimited).fork(), options).join();
-        /* repeated string cert_dns_names = 9; */
-        for (let i = 0; i < message.certDnsNames.length; i++)
-            writer.tag(9, WireType.LengthDelimited).string(message.certDnsNames[i]);
-        /* int32 cert_key_strength = 10; */
-        if (message.certKeyStrength !== 0)
-            writer.tag(10, WireType.Varint).int32(message.certKeyStrength);
-        /* string cert_signature_algorithm = 11; */
-        if (message.certSignatureAlgorithm !== "")
-            writer.tag(11, WireType.LengthDelimited).string(message.certSignatureAlgorithm);
-        /* repeated string errors = 12; */
-        for (let i = 0; i < message.errors.length; i++)
-            writer.tag(12, WireType.LengthDelimited).string(message.errors[i]);
+    internalBinaryWrite(message: ScanJob, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* string job_id = 1; */
+        if (message.jobId !== "")
+            writer.tag(1, WireType.LengthDelimited).string(message.jobId);
+        /* string domain = 2; */
+        if (message.domain !== "")
+            writer.tag(2, WireType.LengthDelimited).string(message.domain);
+        /* string status = 3; */
+        if (message.status !== "")
+            writer.tag(3, WireType.LengthDelimited).string(message.status);
+        /* string report_id = 4; */
+        if (message.reportId !== "")
+            writer.tag(4, WireType.LengthDelimited).string(message.reportId);
+        /* string error = 5; */
+        if (message.error !== "")
+            writer.tag(5, WireType.LengthDelimited).string(message.error);
+        /* bool cancel_requested = 6; */
+        if (message.cancelRequested !== false)
+            writer.tag(6, WireType.Varint).bool(message.cancelRequested);
+        /* repeated service.ScanJobPlugin plugins = 7; */
+        for (let i = 0; i < message.plugins.length; i++)
+            ScanJobPlugin.internalBinaryWrite(message.plugins[i], writer.tag(7, WireType.LengthDelimited).fork(), options).join();
+        /* google.protobuf.Timestamp created_at = 8; */
+        if (message.createdAt)
+            Timestamp.internalBinaryWrite(message.createdAt, writer.tag(8, WireType.LengthDelimited).fork(), options).join();
+        /* google.protobuf.Timestamp started_at = 9; */
+        if (message.startedAt)
+            Timestamp.internalBinaryWrite(message.startedAt, writer.tag(9, WireType.LengthDelimited).fork(), options).join();
+        /* google.protobuf.Timestamp finished_at = 10; */
+        if (message.finishedAt)
+            Timestamp.internalBinaryWrite(message.finishedAt, writer.tag(10, WireType.LengthDelimited).fork(), options).join();
+        /* string parent_job_id = 11; */
+        if (message.parentJobId !== "")
+            writer.tag(11, WireType.LengthDelimited).string(message.parentJobId);
+        /* string scan_run_id = 12; */
+        if (message.scanRunId !== "")
+            writer.tag(12, WireType.LengthDelimited).string(message.scanRunId);
+        /* string batch_id = 13; */
+        if (message.batchId !== "")
+            writer.tag(13, WireType.LengthDelimited).string(message.batchId);
+        /* repeated string tags = 14; */
+        for (let i = 0; i < message.tags.length; i++)
+            writer.tag(14, WireType.LengthDelimited).string(message.tags[i]);
+        /* string profile = 15; */
+        if (message.profile !== "")
+            writer.tag(15, WireType.LengthDelimited).string(message.profile);
+        /* bool refresh = 16; */
+        if (message.refresh !== false)
+            writer.tag(16, WireType.Varint).bool(message.refresh);
         let u = options.writeUnknownFields;
         if (u !== false)
             (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
@@ -5626,100 +12024,92 @@ class TLSSecurityResult$Type extends MessageType<TLSSecurityResult> {
     }
 }
 /**
- * @generated MessageType for protobuf message service.TLSSecurityResult
+ * @generated MessageType for protobuf message service.ScanJob
  */
-export const TLSSecurityResult = new TLSSecurityResult$Type();
+export const ScanJob = new ScanJob$Type();
 // @generated message type with reflection information, may provide speed optimized methods
-class CrtShCertificate$Type extends MessageType<CrtShCertificate> {
+class ScanJobPlugin$Type extends MessageType<ScanJobPlugin> {
     constructor() {
-        super("service.CrtShCertificate", [
-            { no: 1, name: "id", kind: "scalar", T: 3 /*ScalarType.INT64*/, L: 0 /*LongType.BIGINT*/ },
-            { no: 2, name: "common_name", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
-            { no: 3, name: "issuer", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
-            { no: 4, name: "not_before", kind: "message", T: () => Timestamp },
-            { no: 5, name: "not_after", kind: "message", T: () => Timestamp },
-            { no: 6, name: "serial_number", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
-            { no: 7, name: "dns_names", kind: "scalar", repeat: 2 /*RepeatType.UNPACKED*/, T: 9 /*ScalarType.STRING*/ },
-            { no: 8, name: "signature_algorithm", kind: "scalar", T: 9 /*ScalarType.STRING*/ }
+        super("service.ScanJobPlugin", [
+            { no: 1, name: "plugin", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 2, name: "status", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 3, name: "result_id", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 4, name: "error", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 5, name: "started_at", kind: "message", T: () => Timestamp },
+            { no: 6, name: "finished_at", kind: "message", T: () => Timestamp },
+            { no: 7, name: "timed_out", kind: "scalar", T: 8 /*ScalarType.BOOL*/ }
         ]);
     }
-    create(value?: PartialMessage<CrtShCertificate>): CrtShCertificate {
+    create(value?: PartialMessage<ScanJobPlugin>): ScanJobPlugin {
         const message = globalThis.Object.create((this.messagePrototype!));
-        message.id = 0n;
-        message.commonName = "";
-        message.issuer = "";
-        message.serialNumber = "";
-        message.dnsNames = [];
-        message.signatureAlgorithm = "";
+        message.plugin = "";
+        message.status = "";
+        message.resultId = "";
+        message.error = "";
+        message.timedOut = false;
         if (value !== undefined)
-            reflectionMergePartial<CrtShCertificate>(this, message, value);
+            reflectionMergePartial<ScanJobPlugin>(this, message, value);
         return message;
     }
-    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: CrtShCertificate): CrtShCertificate {
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: ScanJobPlugin): ScanJobPlugin {
         let message = target ?? this.create(), end = reader.pos + length;
         while (reader.pos < end) {
             let [fieldNo, wireType] = reader.tag();
             switch (fieldNo) {
-                case /* int64 id */ 1:
-                    message.id = reader.int64().toBigInt();
-                    break;
-                case /* string common_name */ 2:
-                    message.commonName = reader.string();
+                case /* string plugin */ 1:
+                    message.plugin = reader.string();
                     break;
-                case /* string issuer */ 3:
-                    message.issuer = reader.string();
+                case /* string status */ 2:
+                    message.status = reader.string();
                     break;
-                case /* google.protobuf.Timestamp not_before */ 4:
-                    message.notBefore = Timestamp.internalBinaryRead(reader, reader.uint32(), options, message.notBefore);
+                case /* string result_id */ 3:
+                    message.resultId = reader.string();
                     break;
-                case /* google.protobuf.Timestamp not_after */ 5:
-                    message.notAfter = Timestamp.internalBinaryRead(reader, reader.uint32(), options, message.notAfter);
+                case /* string error */ 4:
+                    message.error = reader.string();
                     break;
-                case /* string serial_number */ 6:
-                    message.serialNumber = reader.string();
+                case /* google.protobuf.Timestamp started_at */ 5:
+                    message.startedAt = Timestamp.internalBinaryRead(reader, reader.uint32(), options, message.startedAt);
                     break;
-                case /* repeated string dns_names */ 7:
-                    message.dnsNames.push(reader.string());
+                case /* google.protobuf.Timestamp finished_at */ 6:
+                    message.finishedAt = Timestamp.internalBinaryRead(reader, reader.uint32(), options, message.finishedAt);
                     break;
-                case /* string signature_algorithm */ 8:
-                    message.signatureAlgorithm = reader.string();
+                case /* bool timed_out */ 7:
+                    message.timedOut = reader.bool();
                     break;
                 default:
-                    let u = options.readUnknownField;
-                    if (u === "throw")
-                        throw new globalThis.Error(`Unknown field ${fieldNo} (wire type ${wireType}) for ${this.typeName}`);
-                    let d = reader.skip(wireType);
-                    if (u !== false)
-                        (u === true ? UnknownFieldHandler.onRead : u)(this.typeName, message, fieldNo, wireType, d);
-            }
-        }
-        return message;
-    }
-    internalBinaryWrite(message: CrtShCertificate, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
-        /* int64 id = 1; */
-        if (message.id !== 0n)
-            writer.tag(1, WireType.Varint).int64(message.id);
-        /* string common_name = 2; */
-        if (message.commonName !== "")
-            writer.tag(2, WireType.LengthDelimited).string(message.commonName);
-        /* string issuer = 3; */
-        if (message.issuer !== "")
-            writer.tag(3, WireType.LengthDelimited).string(message.issuer);
-        /* google.protobuf.Timestamp not_before = 4; */
-        if (message.notBefore)
-            Timestamp.internalBinaryWrite(message.notBefore, writer.tag(4, WireType.LengthDelimited).fork(), options).join();
-        /* google.protobuf.Timestamp not_after = 5; */
-        if (message.notAfter)
-            Timestamp.internalBinaryWrite(message.notAfter, writer.tag(5, WireType.LengthDelimited).fork(), options).join();
-        /* string serial_number = 6; */
-        if (message.serialNumber !== "")
-            writer.tag(6, WireType.LengthDelimited).string(message.serialNumber);
-        /* repeated string dns_names = 7; */
-        for (let i = 0; i < message.dnsNames.length; i++)
-            writer.tag(7, WireType.LengthDelimited).string(message.dnsNames[i]);
-        /* string signature_algorithm = 8; */
-        if (message.signatureAlgorithm !== "")
-            writer.tag(8, WireType.LengthDelimited).string(message.signatureAlgorithm);
+                    let u = options.readUnknownField;
+                    if (u === "throw")
+                        throw new globalThis.Error(`Unknown field ${fieldNo} (wire type ${wireType}) for ${this.typeName}`);
+                    let d = reader.skip(wireType);
+                    if (u !== false)
+                        (u === true ? UnknownFieldHandler.onRead : u)(this.typeName, message, fieldNo, wireType, d);
+            }
+        }
+        return message;
+    }
+    internalBinaryWrite(message: ScanJobPlugin, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* string plugin = 1; */
+        if (message.plugin !== "")
+            writer.tag(1, WireType.LengthDelimited).string(message.plugin);
+        /* string status = 2; */
+        if (message.status !== "")
+            writer.tag(2, WireType.LengthDelimited).string(message.status);
+        /* string result_id = 3; */
+        if (message.resultId !== "")
+            writer.tag(3, WireType.LengthDelimited).string(message.resultId);
+        /* string error = 4; */
+        if (message.error !== "")
+            writer.tag(4, WireType.LengthDelimited).string(message.error);
+        /* google.protobuf.Timestamp started_at = 5; */
+        if (message.startedAt)
+            Timestamp.internalBinaryWrite(message.startedAt, writer.tag(5, WireType.LengthDelimited).fork(), options).join();
+        /* google.protobuf.Timestamp finished_at = 6; */
+        if (message.finishedAt)
+            Timestamp.internalBinaryWrite(message.finishedAt, writer.tag(6, WireType.LengthDelimited).fork(), options).join();
+        /* bool timed_out = 7; */
+        if (message.timedOut !== false)
+            writer.tag(7, WireType.Varint).bool(message.timedOut);
         let u = options.writeUnknownFields;
         if (u !== false)
             (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
@@ -5727,40 +12117,30 @@ class CrtShCertificate$Type extends MessageType<CrtShCertificate> {
     }
 }
 /**
- * @generated MessageType for protobuf message service.CrtShCertificate
+ * @generated MessageType for protobuf message service.ScanJobPlugin
  */
-export const CrtShCertificate = new CrtShCertificate$Type();
+export const ScanJobPlugin = new ScanJobPlugin$Type();
 // @generated message type with reflection information, may provide speed optimized methods
-class CrtShSecurityResult$Type extends MessageType<CrtShSecurityResult> {
+class GetScanRunRequest$Type extends MessageType<GetScanRunRequest> {
     constructor() {
-        super("service.CrtShSecurityResult", [
-            { no: 1, name: "certificates", kind: "message", repeat: 2 /*RepeatType.UNPACKED*/, T: () => CrtShCertificate },
-            { no: 2, name: "subdomains", kind: "scalar", repeat: 2 /*RepeatType.UNPACKED*/, T: 9 /*ScalarType.STRING*/ },
-            { no: 3, name: "errors", kind: "scalar", repeat: 2 /*RepeatType.UNPACKED*/, T: 9 /*ScalarType.STRING*/ }
+        super("service.GetScanRunRequest", [
+            { no: 1, name: "scan_run_id", kind: "scalar", T: 9 /*ScalarType.STRING*/ }
         ]);
     }
-    create(value?: PartialMessage<CrtShSecurityResult>): CrtShSecurityResult {
+    create(value?: PartialMessage<GetScanRunRequest>): GetScanRunRequest {
         const message = globalThis.Object.create((this.messagePrototype!));
-        message.certificates = [];
-        message.subdomains = [];
-        message.errors = [];
+        message.scanRunId = "";
         if (value !== undefined)
-            reflectionMergePartial<CrtShSecurityResult>(this, message, value);
+            reflectionMergePartial<GetScanRunRequest>(this, message, value);
         return message;
     }
-    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: CrtShSecurityResult): CrtShSecurityResult {
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: GetScanRunRequest): GetScanRunRequest {
         let message = target ?? this.create(), end = reader.pos + length;
         while (reader.pos < end) {
             let [fieldNo, wireType] = reader.tag();
             switch (fieldNo) {
-                case /* repeated service.CrtShCertificate certificates */ 1:
-                    message.certificates.push(CrtShCertificate.internalBinaryRead(reader, reader.uint32(), options));
-                    break;
-                case /* repeated string subdomains */ 2:
-                    message.subdomains.push(reader.string());
-                    break;
-                case /* repeated string errors */ 3:
-                    message.errors.push(reader.string());
+                case /* string scan_run_id */ 1:
+                    message.scanRunId = reader.string();
                     break;
                 default:
                     let u = options.readUnknownField;
@@ -5773,16 +12153,10 @@ class CrtShSecurityResult$Type extends MessageType<CrtShSecurityResult> {
         }
         return message;
     }
-    internalBinaryWrite(message: CrtShSecurityResult, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
-        /* repeated service.CrtShCertificate certificates = 1; */
-        for (let i = 0; i < message.certificates.length; i++)
-            CrtShCertificate.internalBinaryWrite(message.certificates[i], writer.tag(1, WireType.LengthDelimited).fork(), options).join();
-        /* repeated string subdomains = 2; */
-        for (let i = 0; i < message.subdomains.length; i++)
-            writer.tag(2, WireType.LengthDelimited).string(message.subdomains[i]);
-        /* repeated string errors = 3; */
-        for (let i = 0; i < message.errors.length; i++)
-            writer.tag(3, WireType.LengthDelimited).string(message.errors[i]);
+    internalBinaryWrite(message: GetScanRunRequest, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* string scan_run_id = 1; */
+        if (message.scanRunId !== "")
+            writer.tag(1, WireType.LengthDelimited).string(message.scanRunId);
         let u = options.writeUnknownFields;
         if (u !== false)
             (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
@@ -5790,35 +12164,29 @@ class CrtShSecurityResult$Type extends MessageType<CrtShSecurityResult> {
     }
 }
 /**
- * @generated MessageType for protobuf message service.CrtShSecurityResult
+ * @generated MessageType for protobuf message service.GetScanRunRequest
  */
-export const CrtShSecurityResult = new CrtShSecurityResult$Type();
+export const GetScanRunRequest = new GetScanRunRequest$Type();
 // @generated message type with reflection information, may provide speed optimized methods
-class ChaosSecurityResult$Type extends MessageType<ChaosSecurityResult> {
+class GetScanRunResponse$Type extends MessageType<GetScanRunResponse> {
     constructor() {
-        super("service.ChaosSecurityResult", [
-            { no: 1, name: "subdomains", kind: "scalar", repeat: 2 /*RepeatType.UNPACKED*/, T: 9 /*ScalarType.STRING*/ },
-            { no: 2, name: "errors", kind: "scalar", repeat: 2 /*RepeatType.UNPACKED*/, T: 9 /*ScalarType.STRING*/ }
+        super("service.GetScanRunResponse", [
+            { no: 1, name: "run", kind: "message", T: () => ScanRun }
         ]);
     }
-    create(value?: PartialMessage<ChaosSecurityResult>): ChaosSecurityResult {
+    create(value?: PartialMessage<GetScanRunResponse>): GetScanRunResponse {
         const message = globalThis.Object.create((this.messagePrototype!));
-        message.subdomains = [];
-        message.errors = [];
         if (value !== undefined)
-            reflectionMergePartial<ChaosSecurityResult>(this, message, value);
+            reflectionMergePartial<GetScanRunResponse>(this, message, value);
         return message;
     }
-    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: ChaosSecurityResult): ChaosSecurityResult {
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: GetScanRunResponse): GetScanRunResponse {
         let message = target ?? this.create(), end = reader.pos + length;
         while (reader.pos < end) {
             let [fieldNo, wireType] = reader.tag();
             switch (fieldNo) {
-                case /* repeated string subdomains */ 1:
-                    message.subdomains.push(reader.string());
-                    break;
-                case /* repeated string errors */ 2:
-                    message.errors.push(reader.string());
+                case /* service.ScanRun run */ 1:
+                    message.run = ScanRun.internalBinaryRead(reader, reader.uint32(), options, message.run);
                     break;
                 default:
                     let u = options.readUnknownField;
@@ -5831,13 +12199,10 @@ class ChaosSecurityResult$Type extends MessageType<ChaosSecurityResult> {
         }
         return message;
     }
-    internalBinaryWrite(message: ChaosSecurityResult, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
-        /* repeated string subdomains = 1; */
-        for (let i = 0; i < message.subdomains.length; i++)
-            writer.tag(1, WireType.LengthDelimited).string(message.subdomains[i]);
-        /* repeated string errors = 2; */
-        for (let i = 0; i < message.errors.length; i++)
-            writer.tag(2, WireType.LengthDelimited).string(message.errors[i]);
+    internalBinaryWrite(message: GetScanRunResponse, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* service.ScanRun run = 1; */
+        if (message.run)
+            ScanRun.internalBinaryWrite(message.run, writer.tag(1, WireType.LengthDelimited).fork(), options).join();
         let u = options.writeUnknownFields;
         if (u !== false)
             (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
@@ -5845,30 +12210,38 @@ class ChaosSecurityResult$Type extends MessageType<ChaosSecurityResult> {
     }
 }
 /**
- * @generated MessageType for protobuf message service.ChaosSecurityResult
+ * @generated MessageType for protobuf message service.GetScanRunResponse
  */
-export const ChaosSecurityResult = new ChaosSecurityResult$Type();
+export const GetScanRunResponse = new GetScanRunResponse$Type();
 // @generated message type with reflection information, may provide speed optimized methods
-class ShodanScanResult$Type extends MessageType<ShodanScanResult> {
+class ScanRun$Type extends MessageType<ScanRun> {
     constructor() {
-        super("service.ShodanScanResult", [
+        super("service.ScanRun", [
             { no: 1, name: "id", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
             { no: 2, name: "domain", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
-            { no: 3, name: "dns_scan_id", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
-            { no: 4, name: "result", kind: "message", T: () => ShodanSecurityResult },
-            { no: 5, name: "created_at", kind: "message", T: () => Timestamp }
+            { no: 3, name: "requested_by", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 4, name: "profile", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 5, name: "status", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 6, name: "error", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 7, name: "started_at", kind: "message", T: () => Timestamp },
+            { no: 8, name: "finished_at", kind: "message", T: () => Timestamp },
+            { no: 9, name: "results", kind: "message", repeat: 2 /*RepeatType.UNPACKED*/, T: () => ScanRunResult }
         ]);
     }
-    create(value?: PartialMessage<ShodanScanResult>): ShodanScanResult {
+    create(value?: PartialMessage<ScanRun>): ScanRun {
         const message = globalThis.Object.create((this.messagePrototype!));
         message.id = "";
         message.domain = "";
-        message.dnsScanId = "";
+        message.requestedBy = "";
+        message.profile = "";
+        message.status = "";
+        message.error = "";
+        message.results = [];
         if (value !== undefined)
-            reflectionMergePartial<ShodanScanResult>(this, message, value);
+            reflectionMergePartial<ScanRun>(this, message, value);
         return message;
     }
-    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: ShodanScanResult): ShodanScanResult {
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: ScanRun): ScanRun {
         let message = target ?? this.create(), end = reader.pos + length;
         while (reader.pos < end) {
             let [fieldNo, wireType] = reader.tag();
@@ -5879,14 +12252,26 @@ class ShodanScanResult$Type extends MessageType<ShodanScanResult> {
                 case /* string domain */ 2:
                     message.domain = reader.string();
                     break;
-                case /* string dns_scan_id */ 3:
-                    message.dnsScanId = reader.string();
+                case /* string requested_by */ 3:
+                    message.requestedBy = reader.string();
                     break;
-                case /* service.ShodanSecurityResult result */ 4:
-                    message.result = ShodanSecurityResult.internalBinaryRead(reader, reader.uint32(), options, message.result);
+                case /* string profile */ 4:
+                    message.profile = reader.string();
                     break;
-                case /* google.protobuf.Timestamp created_at */ 5:
-                    message.createdAt = Timestamp.internalBinaryRead(reader, reader.uint32(), options, message.createdAt);
+                case /* string status */ 5:
+                    message.status = reader.string();
+                    break;
+                case /* string error */ 6:
+                    message.error = reader.string();
+                    break;
+                case /* google.protobuf.Timestamp started_at */ 7:
+                    message.startedAt = Timestamp.internalBinaryRead(reader, reader.uint32(), options, message.startedAt);
+                    break;
+                case /* google.protobuf.Timestamp finished_at */ 8:
+                    message.finishedAt = Timestamp.internalBinaryRead(reader, reader.uint32(), options, message.finishedAt);
+                    break;
+                case /* repeated service.ScanRunResult results */ 9:
+                    message.results.push(ScanRunResult.internalBinaryRead(reader, reader.uint32(), options));
                     break;
                 default:
                     let u = options.readUnknownField;
@@ -5899,22 +12284,34 @@ class ShodanScanResult$Type extends MessageType<ShodanScanResult> {
         }
         return message;
     }
-    internalBinaryWrite(message: ShodanScanResult, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+    internalBinaryWrite(message: ScanRun, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
         /* string id = 1; */
         if (message.id !== "")
             writer.tag(1, WireType.LengthDelimited).string(message.id);
         /* string domain = 2; */
         if (message.domain !== "")
             writer.tag(2, WireType.LengthDelimited).string(message.domain);
-        /* string dns_scan_id = 3; */
-        if (message.dnsScanId !== "")
-            writer.tag(3, WireType.LengthDelimited).string(message.dnsScanId);
-        /* service.ShodanSecurityResult result = 4; */
-        if (message.result)
-            ShodanSecurityResult.internalBinaryWrite(message.result, writer.tag(4, WireType.LengthDelimited).fork(), options).join();
-        /* google.protobuf.Timestamp created_at = 5; */
-        if (message.createdAt)
-            Timestamp.internalBinaryWrite(message.createdAt, writer.tag(5, WireType.LengthDelimited).fork(), options).join();
+        /* string requested_by = 3; */
+        if (message.requestedBy !== "")
+            writer.tag(3, WireType.LengthDelimited).string(message.requestedBy);
+        /* string profile = 4; */
+        if (message.profile !== "")
+            writer.tag(4, WireType.LengthDelimited).string(message.profile);
+        /* string status = 5; */
+        if (message.status !== "")
+            writer.tag(5, WireType.LengthDelimited).string(message.status);
+        /* string error = 6; */
+        if (message.error !== "")
+            writer.tag(6, WireType.LengthDelimited).string(message.error);
+        /* google.protobuf.Timestamp started_at = 7; */
+        if (message.startedAt)
+            Timestamp.internalBinaryWrite(message.startedAt, writer.tag(7, WireType.LengthDelimited).fork(), options).join();
+        /* google.protobuf.Timestamp finished_at = 8; */
+        if (message.finishedAt)
+            Timestamp.internalBinaryWrite(message.finishedAt, writer.tag(8, WireType.LengthDelimited).fork(), options).join();
+        /* repeated service.ScanRunResult results = 9; */
+        for (let i = 0; i < message.results.length; i++)
+            ScanRunResult.internalBinaryWrite(message.results[i], writer.tag(9, WireType.LengthDelimited).fork(), options).join();
         let u = options.writeUnknownFields;
         if (u !== false)
             (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
@@ -5922,45 +12319,79 @@ class ShodanScanResult$Type extends MessageType<ShodanScanResult> {
     }
 }
 /**
- * @generated MessageType for protobuf message service.ShodanScanResult
+ * @generated MessageType for protobuf message service.ScanRun
  */
-export const ShodanScanResult = new ShodanScanResult$Type();
+export const ScanRun = new ScanRun$Type();
 // @generated message type with reflection information, may provide speed optimized methods
-class ShodanLocation$Type extends MessageType<ShodanLocation> {
+class ScanRunResult$Type extends MessageType<ScanRunResult> {
     constructor() {
-        super("service.ShodanLocation", [
-            { no: 1, name: "city", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
-            { no: 2, name: "country_name", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
-            { no: 3, name: "latitude", kind: "scalar", T: 2 /*ScalarType.FLOAT*/ },
-            { no: 4, name: "longitude", kind: "scalar", T: 2 /*ScalarType.FLOAT*/ }
+        super("service.ScanRunResult", [
+            { no: 1, name: "plugin", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 2, name: "result_id", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 3, name: "created_at", kind: "message", T: () => Timestamp },
+            { no: 4, name: "dns_result", kind: "message", T: () => DNSSecurityResult },
+            { no: 5, name: "tls_result", kind: "message", T: () => TLSSecurityResult },
+            { no: 6, name: "crtsh_result", kind: "message", T: () => CrtShSecurityResult },
+            { no: 7, name: "chaos_result", kind: "message", T: () => ChaosSecurityResult },
+            { no: 8, name: "shodan_result", kind: "message", T: () => ShodanSecurityResult },
+            { no: 9, name: "otx_result", kind: "message", T: () => OTXSecurityResult },
+            { no: 10, name: "whois_result", kind: "message", T: () => WhoisSecurityResult },
+            { no: 11, name: "abusech_result", kind: "message", T: () => AbuseChSecurityResult },
+            { no: 12, name: "isc_result", kind: "message", T: () => ISCSecurityResult },
+            { no: 13, name: "external_result", kind: "message", T: () => ExternalSecurityResult }
         ]);
     }
-    create(value?: PartialMessage<ShodanLocation>): ShodanLocation {
+    create(value?: PartialMessage<ScanRunResult>): ScanRunResult {
         const message = globalThis.Object.create((this.messagePrototype!));
-        message.city = "";
-        message.countryName = "";
-        message.latitude = 0;
-        message.longitude = 0;
+        message.plugin = "";
+        message.resultId = "";
         if (value !== undefined)
-            reflectionMergePartial<ShodanLocation>(this, message, value);
+            reflectionMergePartial<ScanRunResult>(this, message, value);
         return message;
     }
-    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: ShodanLocation): ShodanLocation {
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: ScanRunResult): ScanRunResult {
         let message = target ?? this.create(), end = reader.pos + length;
         while (reader.pos < end) {
             let [fieldNo, wireType] = reader.tag();
             switch (fieldNo) {
-                case /* string city */ 1:
-                    message.city = reader.string();
+                case /* string plugin */ 1:
+                    message.plugin = reader.string();
                     break;
-                case /* string country_name */ 2:
-                    message.countryName = reader.string();
+                case /* string result_id */ 2:
+                    message.resultId = reader.string();
                     break;
-                case /* float latitude */ 3:
-                    message.latitude = reader.float();
+                case /* google.protobuf.Timestamp created_at */ 3:
+                    message.createdAt = Timestamp.internalBinaryRead(reader, reader.uint32(), options, message.createdAt);
                     break;
-                case /* float longitude */ 4:
-                    message.longitude = reader.float();
+                case /* service.DNSSecurityResult dns_result */ 4:
+                    message.dnsResult = DNSSecurityResult.internalBinaryRead(reader, reader.uint32(), options, message.dnsResult);
+                    break;
+                case /* service.TLSSecurityResult tls_result */ 5:
+                    message.tlsResult = TLSSecurityResult.internalBinaryRead(reader, reader.uint32(), options, message.tlsResult);
+                    break;
+                case /* service.CrtShSecurityResult crtsh_result */ 6:
+                    message.crtshResult = CrtShSecurityResult.internalBinaryRead(reader, reader.uint32(), options, message.crtshResult);
+                    break;
+                case /* service.ChaosSecurityResult chaos_result */ 7:
+                    message.chaosResult = ChaosSecurityResult.internalBinaryRead(reader, reader.uint32(), options, message.chaosResult);
+                    break;
+                case /* service.ShodanSecurityResult shodan_result */ 8:
+                    message.shodanResult = ShodanSecurityResult.internalBinaryRead(reader, reader.uint32(), options, message.shodanResult);
+                    break;
+                case /* service.OTXSecurityResult otx_result */ 9:
+                    message.otxResult = OTXSecurityResult.internalBinaryRead(reader, reader.uint32(), options, message.otxResult);
+                    break;
+                case /* service.WhoisSecurityResult whois_result */ 10:
+                    message.whoisResult = WhoisSecurityResult.internalBinaryRead(reader, reader.uint32(), options, message.whoisResult);
+                    break;
+                case /* service.AbuseChSecurityResult abusech_result */ 11:
+                    message.abusechResult = AbuseChSecurityResult.internalBinaryRead(reader, reader.uint32(), options, message.abusechResult);
+                    break;
+                case /* service.ISCSecurityResult isc_result */ 12:
+                    message.iscResult = ISCSecurityResult.internalBinaryRead(reader, reader.uint32(), options, message.iscResult);
+                    break;
+                case /* service.ExternalSecurityResult external_result */ 13:
+                    message.externalResult = ExternalSecurityResult.internalBinaryRead(reader, reader.uint32(), options, message.externalResult);
                     break;
                 default:
                     let u = options.readUnknownField;
@@ -5973,19 +12404,46 @@ class ShodanLocation$Type extends MessageType<ShodanLocation> {
         }
         return message;
     }
-    internalBinaryWrite(message: ShodanLocation, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
-        /* string city = 1; */
-        if (message.city !== "")
-            writer.tag(1, WireType.LengthDelimited).string(message.city);
-        /* string country_name = 2; */
-        if (message.countryName !== "")
-            writer.tag(2, WireType.LengthDelimited).string(message.countryName);
-        /* float latitude = 3; */
-        if (message.latitude !== 0)
-            writer.tag(3, WireType.Bit32).float(message.latitude);
-        /* float longitude = 4; */
-        if (message.longitude !== 0)
-            writer.tag(4, WireType.Bit32).float(message.longitude);
+    internalBinaryWrite(message: ScanRunResult, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* string plugin = 1; */
+        if (message.plugin !== "")
+            writer.tag(1, WireType.LengthDelimited).string(message.plugin);
+        /* string result_id = 2; */
+        if (message.resultId !== "")
+            writer.tag(2, WireType.LengthDelimited).string(message.resultId);
+        /* google.protobuf.Timestamp created_at = 3; */
+        if (message.createdAt)
+            Timestamp.internalBinaryWrite(message.createdAt, writer.tag(3, WireType.LengthDelimited).fork(), options).join();
+        /* service.DNSSecurityResult dns_result = 4; */
+        if (message.dnsResult)
+            DNSSecurityResult.internalBinaryWrite(message.dnsResult, writer.tag(4, WireType.LengthDelimited).fork(), options).join();
+        /* service.TLSSecurityResult tls_result = 5; */
+        if (message.tlsResult)
+            TLSSecurityResult.internalBinaryWrite(message.tlsResult, writer.tag(5, WireType.LengthDelimited).fork(), options).join();
+        /* service.CrtShSecurityResult crtsh_result = 6; */
+        if (message.crtshResult)
+            CrtShSecurityResult.internalBinaryWrite(message.crtshResult, writer.tag(6, WireType.LengthDelimited).fork(), options).join();
+        /* service.ChaosSecurityResult chaos_result = 7; */
+        if (message.chaosResult)
+            ChaosSecurityResult.internalBinaryWrite(message.chaosResult, writer.tag(7, WireType.LengthDelimited).fork(), options).join();
+        /* service.ShodanSecurityResult shodan_result = 8; */
+        if (message.shodanResult)
+            ShodanSecurityResult.internalBinaryWrite(message.shodanResult, writer.tag(8, WireType.LengthDelimited).fork(), options).join();
+        /* service.OTXSecurityResult otx_result = 9; */
+        if (message.otxResult)
+            OTXSecurityResult.internalBinaryWrite(message.otxResult, writer.tag(9, WireType.LengthDelimited).fork(), options).join();
+        /* service.WhoisSecurityResult whois_result = 10; */
+        if (message.whoisResult)
+            WhoisSecurityResult.internalBinaryWrite(message.whoisResult, writer.tag(10, WireType.LengthDelimited).fork(), options).join();
+        /* service.AbuseChSecurityResult abusech_result = 11; */
+        if (message.abusechResult)
+            AbuseChSecurityResult.internalBinaryWrite(message.abusechResult, writer.tag(11, WireType.LengthDelimited).fork(), options).join();
+        /* service.ISCSecurityResult isc_result = 12; */
+        if (message.iscResult)
+            ISCSecurityResult.internalBinaryWrite(message.iscResult, writer.tag(12, WireType.LengthDelimited).fork(), options).join();
+        /* service.ExternalSecurityResult external_result = 13; */
+        if (message.externalResult)
+            ExternalSecurityResult.internalBinaryWrite(message.externalResult, writer.tag(13, WireType.LengthDelimited).fork(), options).join();
         let u = options.writeUnknownFields;
         if (u !== false)
             (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
@@ -5993,43 +12451,35 @@ class ShodanLocation$Type extends MessageType<ShodanLocation> {
     }
 }
 /**
- * @generated MessageType for protobuf message service.ShodanLocation
+ * @generated MessageType for protobuf message service.ScanRunResult
  */
-export const ShodanLocation = new ShodanLocation$Type();
+export const ScanRunResult = new ScanRunResult$Type();
 // @generated message type with reflection information, may provide speed optimized methods
-class ShodanSSL$Type extends MessageType<ShodanSSL> {
+class ExternalSecurityResult$Type extends MessageType<ExternalSecurityResult> {
     constructor() {
-        super("service.ShodanSSL", [
-            { no: 1, name: "issuer", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
-            { no: 2, name: "subject", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
-            { no: 3, name: "expires", kind: "message", T: () => Timestamp },
-            { no: 4, name: "not_after", kind: "message", T: () => Timestamp }
+        super("service.ExternalSecurityResult", [
+            { no: 1, name: "plugin", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 2, name: "result", kind: "scalar", T: 9 /*ScalarType.STRING*/ }
         ]);
     }
-    create(value?: PartialMessage<ShodanSSL>): ShodanSSL {
+    create(value?: PartialMessage<ExternalSecurityResult>): ExternalSecurityResult {
         const message = globalThis.Object.create((this.messagePrototype!));
-        message.issuer = "";
-        message.subject = "";
+        message.plugin = "";
+        message.result = "";
         if (value !== undefined)
-            reflectionMergePartial<ShodanSSL>(this, message, value);
+            reflectionMergePartial<ExternalSecurityResult>(this, message, value);
         return message;
     }
-    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: ShodanSSL): ShodanSSL {
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: ExternalSecurityResult): ExternalSecurityResult {
         let message = target ?? this.create(), end = reader.pos + length;
         while (reader.pos < end) {
             let [fieldNo, wireType] = reader.tag();
             switch (fieldNo) {
-                case /* string issuer */ 1:
-                    message.issuer = reader.string();
-                    break;
-                case /* string subject */ 2:
-                    message.subject = reader.string();
+                case /* string plugin */ 1:
+                    message.plugin = reader.string();
                     break;
-                case /* google.protobuf.Timestamp expires */ 3:
-                    message.expires = Timestamp.internalBinaryRead(reader, reader.uint32(), options, message.expires);
-                    break;
-                case /* google.protobuf.Timestamp not_after */ 4:
-                    message.notAfter = Timestamp.internalBinaryRead(reader, reader.uint32(), options, message.notAfter);
+                case /* string result */ 2:
+                    message.result = reader.string();
                     break;
                 default:
                     let u = options.readUnknownField;
@@ -6042,19 +12492,13 @@ class ShodanSSL$Type extends MessageType<ShodanSSL> {
         }
         return message;
     }
-    internalBinaryWrite(message: ShodanSSL, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
-        /* string issuer = 1; */
-        if (message.issuer !== "")
-            writer.tag(1, WireType.LengthDelimited).string(message.issuer);
-        /* string subject = 2; */
-        if (message.subject !== "")
-            writer.tag(2, WireType.LengthDelimited).string(message.subject);
-        /* google.protobuf.Timestamp expires = 3; */
-        if (message.expires)
-            Timestamp.internalBinaryWrite(message.expires, writer.tag(3, WireType.LengthDelimited).fork(), options).join();
-        /* google.protobuf.Timestamp not_after = 4; */
-        if (message.notAfter)
-            Timestamp.internalBinaryWrite(message.notAfter, writer.tag(4, WireType.LengthDelimited).fork(), options).join();
+    internalBinaryWrite(message: ExternalSecurityResult, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* string plugin = 1; */
+        if (message.plugin !== "")
+            writer.tag(1, WireType.LengthDelimited).string(message.plugin);
+        /* string result = 2; */
+        if (message.result !== "")
+            writer.tag(2, WireType.LengthDelimited).string(message.result);
         let u = options.writeUnknownFields;
         if (u !== false)
             (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
@@ -6062,30 +12506,45 @@ class ShodanSSL$Type extends MessageType<ShodanSSL> {
     }
 }
 /**
- * @generated MessageType for protobuf message service.ShodanSSL
+ * @generated MessageType for protobuf message service.ExternalSecurityResult
  */
-export const ShodanSSL = new ShodanSSL$Type();
+export const ExternalSecurityResult = new ExternalSecurityResult$Type();
 // @generated message type with reflection information, may provide speed optimized methods
-class ShodanMetadata$Type extends MessageType<ShodanMetadata> {
+class CreateScanScheduleRequest$Type extends MessageType<CreateScanScheduleRequest> {
     constructor() {
-        super("service.ShodanMetadata", [
-            { no: 1, name: "module", kind: "scalar", T: 9 /*ScalarType.STRING*/ }
+        super("service.CreateScanScheduleRequest", [
+            { no: 1, name: "domain", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 2, name: "cron", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 3, name: "interval_seconds", kind: "scalar", T: 3 /*ScalarType.INT64*/, L: 0 /*LongType.BIGINT*/ },
+            { no: 4, name: "profile", kind: "scalar", T: 9 /*ScalarType.STRING*/ }
         ]);
     }
-    create(value?: PartialMessage<ShodanMetadata>): ShodanMetadata {
+    create(value?: PartialMessage<CreateScanScheduleRequest>): CreateScanScheduleRequest {
         const message = globalThis.Object.create((this.messagePrototype!));
-        message.module = "";
+        message.domain = "";
+        message.cron = "";
+        message.intervalSeconds = 0n;
+        message.profile = "";
         if (value !== undefined)
-            reflectionMergePartial<ShodanMetadata>(this, message, value);
+            reflectionMergePartial<CreateScanScheduleRequest>(this, message, value);
         return message;
     }
-    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: ShodanMetadata): ShodanMetadata {
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: CreateScanScheduleRequest): CreateScanScheduleRequest {
         let message = target ?? this.create(), end = reader.pos + length;
         while (reader.pos < end) {
             let [fieldNo, wireType] = reader.tag();
             switch (fieldNo) {
-                case /* string module */ 1:
-                    message.module = reader.string();
+                case /* string domain */ 1:
+                    message.domain = reader.string();
+                    break;
+                case /* string cron */ 2:
+                    message.cron = reader.string();
+                    break;
+                case /* int64 interval_seconds */ 3:
+                    message.intervalSeconds = reader.int64().toBigInt();
+                    break;
+                case /* string profile */ 4:
+                    message.profile = reader.string();
                     break;
                 default:
                     let u = options.readUnknownField;
@@ -6098,10 +12557,19 @@ class ShodanMetadata$Type extends MessageType<ShodanMetadata> {
         }
         return message;
     }
-    internalBinaryWrite(message: ShodanMetadata, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
-        /* string module = 1; */
-        if (message.module !== "")
-            writer.tag(1, WireType.LengthDelimited).string(message.module);
+    internalBinaryWrite(message: CreateScanScheduleRequest, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* string domain = 1; */
+        if (message.domain !== "")
+            writer.tag(1, WireType.LengthDelimited).string(message.domain);
+        /* string cron = 2; */
+        if (message.cron !== "")
+            writer.tag(2, WireType.LengthDelimited).string(message.cron);
+        /* int64 interval_seconds = 3; */
+        if (message.intervalSeconds !== 0n)
+            writer.tag(3, WireType.Varint).int64(message.intervalSeconds);
+        /* string profile = 4; */
+        if (message.profile !== "")
+            writer.tag(4, WireType.LengthDelimited).string(message.profile);
         let u = options.writeUnknownFields;
         if (u !== false)
             (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
@@ -6109,91 +12577,91 @@ class ShodanMetadata$Type extends MessageType<ShodanMetadata> {
     }
 }
 /**
- * @generated MessageType for protobuf message service.ShodanMetadata
+ * @generated MessageType for protobuf message service.CreateScanScheduleRequest
  */
-export const ShodanMetadata = new ShodanMetadata$Type();
+export const CreateScanScheduleRequest = new CreateScanScheduleRequest$Type();
 // @generated message type with reflection information, may provide speed optimized methods
-class ShodanHost$Type extends MessageType<ShodanHost> {
+class CreateScanScheduleResponse$Type extends MessageType<CreateScanScheduleResponse> {
     constructor() {
-        super("service.ShodanHost", [
-            { no: 1, name: "ip", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
-            { no: 2, name: "port", kind: "scalar", T: 5 /*ScalarType.INT32*/ },
-            { no: 3, name: "hostnames", kind: "scalar", repeat: 2 /*RepeatType.UNPACKED*/, T: 9 /*ScalarType.STRING*/ },
-            { no: 4, name: "os", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
-            { no: 5, name: "banner", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
-            { no: 6, name: "tags", kind: "scalar", repeat: 2 /*RepeatType.UNPACKED*/, T: 9 /*ScalarType.STRING*/ },
-            { no: 7, name: "location", kind: "message", T: () => ShodanLocation },
-            { no: 8, name: "ssl", kind: "message", T: () => ShodanSSL },
-            { no: 9, name: "domains", kind: "scalar", repeat: 2 /*RepeatType.UNPACKED*/, T: 9 /*ScalarType.STRING*/ },
-            { no: 10, name: "asn", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
-            { no: 11, name: "org", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
-            { no: 12, name: "isp", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
-            { no: 13, name: "timestamp", kind: "message", T: () => Timestamp },
-            { no: 14, name: "shodan_meta", kind: "message", T: () => ShodanMetadata }
+        super("service.CreateScanScheduleResponse", [
+            { no: 1, name: "schedule", kind: "message", T: () => ScanSchedule }
         ]);
     }
-    create(value?: PartialMessage<ShodanHost>): ShodanHost {
+    create(value?: PartialMessage<CreateScanScheduleResponse>): CreateScanScheduleResponse {
         const message = globalThis.Object.create((this.messagePrototype!));
-        message.ip = "";
-        message.port = 0;
-        message.hostnames = [];
-        message.os = "";
-        message.banner = "";
-        message.tags = [];
-        message.domains = [];
-        message.asn = "";
-        message.org = "";
-        message.isp = "";
         if (value !== undefined)
-            reflectionMergePartial<ShodanHost>(this, message, value);
+            reflectionMergePartial<CreateScanScheduleResponse>(this, message, value);
+        return message;
+    }
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: CreateScanScheduleResponse): CreateScanScheduleResponse {
+        let message = target ?? this.create(), end = reader.pos + length;
+        while (reader.pos < end) {
+            let [fieldNo, wireType] = reader.tag();
+            switch (fieldNo) {
+                case /* service.ScanSchedule schedule */ 1:
+                    message.schedule = ScanSchedule.internalBinaryRead(reader, reader.uint32(), options, message.schedule);
+                    break;
+                default:
+                    let u = options.readUnknownField;
+                    if (u === "throw")
+                        throw new globalThis.Error(`Unknown field ${fieldNo} (wire type ${wireType}) for ${this.typeName}`);
+                    let d = reader.skip(wireType);
+                    if (u !== false)
+                        (u === true ? UnknownFieldHandler.onRead : u)(this.typeName, message, fieldNo, wireType, d);
+            }
+        }
+        return message;
+    }
+    internalBinaryWrite(message: CreateScanScheduleResponse, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* service.ScanSchedule schedule = 1; */
+        if (message.schedule)
+            ScanSchedule.internalBinaryWrite(message.schedule, writer.tag(1, WireType.LengthDelimited).fork(), options).join();
+        let u = options.writeUnknownFields;
+        if (u !== false)
+            (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
+        return writer;
+    }
+}
+/**
+ * @generated MessageType for protobuf message service.CreateScanScheduleResponse
+ */
+export const CreateScanScheduleResponse = new CreateScanScheduleResponse$Type();
+// @generated message type with reflection information, may provide speed optimized methods
+class UpdateScanScheduleRequest$Type extends MessageType<UpdateScanScheduleRequest> {
+    constructor() {
+        super("service.UpdateScanScheduleRequest", [
+            { no: 1, name: "schedule_id", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 2, name: "cron", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 3, name: "interval_seconds", kind: "scalar", T: 3 /*ScalarType.INT64*/, L: 0 /*LongType.BIGINT*/ },
+            { no: 4, name: "profile", kind: "scalar", T: 9 /*ScalarType.STRING*/ }
+        ]);
+    }
+    create(value?: PartialMessage<UpdateScanScheduleRequest>): UpdateScanScheduleRequest {
+        const message = globalThis.Object.create((this.messagePrototype!));
+        message.scheduleId = "";
+        message.cron = "";
+        message.intervalSeconds = 0n;
+        message.profile = "";
+        if (value !== undefined)
+            reflectionMergePartial<UpdateScanScheduleRequest>(this, message, value);
         return message;
     }
-    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: ShodanHost): ShodanHost {
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: UpdateScanScheduleRequest): UpdateScanScheduleRequest {
         let message = target ?? this.create(), end = reader.pos + length;
         while (reader.pos < end) {
             let [fieldNo, wireType] = reader.tag();
             switch (fieldNo) {
-                case /* string ip */ 1:
-                    message.ip = reader.string();
-                    break;
-                case /* int32 port */ 2:
-                    message.port = reader.int32();
-                    break;
-                case /* repeated string hostnames */ 3:
-                    message.hostnames.push(reader.string());
-                    break;
-                case /* string os */ 4:
-                    message.os = reader.string();
-                    break;
-                case /* string banner */ 5:
-                    message.banner = reader.string();
-                    break;
-                case /* repeated string tags */ 6:
-                    message.tags.push(reader.string());
-                    break;
-                case /* service.ShodanLocation location */ 7:
-                    message.location = ShodanLocation.internalBinaryRead(reader, reader.uint32(), options, message.location);
-                    break;
-                case /* service.ShodanSSL ssl */ 8:
-                    message.ssl = ShodanSSL.internalBinaryRead(reader, reader.uint32(), options, message.ssl);
-                    break;
-                case /* repeated string domains */ 9:
-                    message.domains.push(reader.string());
-                    break;
-                case /* string asn */ 10:
-                    message.asn = reader.string();
-                    break;
-                case /* string org */ 11:
-                    message.org = reader.string();
+                case /* string schedule_id */ 1:
+                    message.scheduleId = reader.string();
                     break;
-                case /* string isp */ 12:
-                    message.isp = reader.string();
+                case /* string cron */ 2:
+                    message.cron = reader.string();
                     break;
-                case /* google.protobuf.Timestamp timestamp */ 13:
-                    message.timestamp = Timestamp.internalBinaryRead(reader, reader.uint32(), options, message.timestamp);
+                case /* int64 interval_seconds */ 3:
+                    message.intervalSeconds = reader.int64().toBigInt();
                     break;
-                case /* service.ShodanMetadata shodan_meta */ 14:
-                    message.shodanMeta = ShodanMetadata.internalBinaryRead(reader, reader.uint32(), options, message.shodanMeta);
+                case /* string profile */ 4:
+                    message.profile = reader.string();
                     break;
                 default:
                     let u = options.readUnknownField;
@@ -6206,49 +12674,19 @@ class ShodanHost$Type extends MessageType<ShodanHost> {
         }
         return message;
     }
-    internalBinaryWrite(message: ShodanHost, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
-        /* string ip = 1; */
-        if (message.ip !== "")
-            writer.tag(1, WireType.LengthDelimited).string(message.ip);
-        /* int32 port = 2; */
-        if (message.port !== 0)
-            writer.tag(2, WireType.Varint).int32(message.port);
-        /* repeated string hostnames = 3; */
-        for (let i = 0; i < message.hostnames.length; i++)
-            writer.tag(3, WireType.LengthDelimited).string(message.hostnames[i]);
-        /* string os = 4; */
-        if (message.os !== "")
-            writer.tag(4, WireType.LengthDelimited).string(message.os);
-        /* string banner = 5; */
-        if (message.banner !== "")
-            writer.tag(5, WireType.LengthDelimited).string(message.banner);
-        /* repeated string tags = 6; */
-        for (let i = 0; i < message.tags.length; i++)
-            writer.tag(6, WireType.LengthDelimited).string(message.tags[i]);
-        /* service.ShodanLocation location = 7; */
-        if (message.location)
-            ShodanLocation.internalBinaryWrite(message.location, writer.tag(7, WireType.LengthDelimited).fork(), options).join();
-        /* service.ShodanSSL ssl = 8; */
-        if (message.ssl)
-            ShodanSSL.internalBinaryWrite(message.ssl, writer.tag(8, WireType.LengthDelimited).fork(), options).join();
-        /* repeated string domains = 9; */
-        for (let i = 0; i < message.domains.length; i++)
-            writer.tag(9, WireType.LengthDelimited).string(message.domains[i]);
-        /* string asn = 10; */
-        if (message.asn !== "")
-            writer.tag(10, WireType.LengthDelimited).string(message.asn);
-        /* string org = 11; */
-        if (message.org !== "")
-            writer.tag(11, WireType.LengthDelimited).string(message.org);
-        /* string isp = 12; */
-        if (message.isp !== "")
-            writer.tag(12, WireType.LengthDelimited).string(message.isp);
-        /* google.protobuf.Timestamp timestamp = 13; */
-        if (message.timestamp)
-            Timestamp.internalBinaryWrite(message.timestamp, writer.tag(13, WireType.LengthDelimited).fork(), options).join();
-        /* service.ShodanMetadata shodan_meta = 14; */
-        if (message.shodanMeta)
-            ShodanMetadata.internalBinaryWrite(message.shodanMeta, writer.tag(14, WireType.LengthDelimited).fork(), options).join();
+    internalBinaryWrite(message: UpdateScanScheduleRequest, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* string schedule_id = 1; */
+        if (message.scheduleId !== "")
+            writer.tag(1, WireType.LengthDelimited).string(message.scheduleId);
+        /* string cron = 2; */
+        if (message.cron !== "")
+            writer.tag(2, WireType.LengthDelimited).string(message.cron);
+        /* int64 interval_seconds = 3; */
+        if (message.intervalSeconds !== 0n)
+            writer.tag(3, WireType.Varint).int64(message.intervalSeconds);
+        /* string profile = 4; */
+        if (message.profile !== "")
+            writer.tag(4, WireType.LengthDelimited).string(message.profile);
         let u = options.writeUnknownFields;
         if (u !== false)
             (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
@@ -6256,35 +12694,29 @@ class ShodanHost$Type extends MessageType<ShodanHost> {
     }
 }
 /**
- * @generated MessageType for protobuf message service.ShodanHost
+ * @generated MessageType for protobuf message service.UpdateScanScheduleRequest
  */
-export const ShodanHost = new ShodanHost$Type();
+export const UpdateScanScheduleRequest = new UpdateScanScheduleRequest$Type();
 // @generated message type with reflection information, may provide speed optimized methods
-class ShodanSecurityResult$Type extends MessageType<ShodanSecurityResult> {
+class UpdateScanScheduleResponse$Type extends MessageType<UpdateScanScheduleResponse> {
     constructor() {
-        super("service.ShodanSecurityResult", [
-            { no: 1, name: "hosts", kind: "message", repeat: 2 /*RepeatType.UNPACKED*/, T: () => ShodanHost },
-            { no: 2, name: "errors", kind: "scalar", repeat: 2 /*RepeatType.UNPACKED*/, T: 9 /*ScalarType.STRING*/ }
+        super("service.UpdateScanScheduleResponse", [
+            { no: 1, name: "schedule", kind: "message", T: () => ScanSchedule }
         ]);
     }
-    create(value?: PartialMessage<ShodanSecurityResult>): ShodanSecurityResult {
+    create(value?: PartialMessage<UpdateScanScheduleResponse>): UpdateScanScheduleResponse {
         const message = globalThis.Object.create((this.messagePrototype!));
-        message.hosts = [];
-        message.errors = [];
         if (value !== undefined)
-            reflectionMergePartial<ShodanSecurityResult>(this, message, value);
+            reflectionMergePartial<UpdateScanScheduleResponse>(this, message, value);
         return message;
     }
-    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: ShodanSecurityResult): ShodanSecurityResult {
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: UpdateScanScheduleResponse): UpdateScanScheduleResponse {
         let message = target ?? this.create(), end = reader.pos + length;
         while (reader.pos < end) {
             let [fieldNo, wireType] = reader.tag();
             switch (fieldNo) {
-                case /* repeated service.ShodanHost hosts */ 1:
-                    message.hosts.push(ShodanHost.internalBinaryRead(reader, reader.uint32(), options));
-                    break;
-                case /* repeated string errors */ 2:
-                    message.errors.push(reader.string());
+                case /* service.ScanSchedule schedule */ 1:
+                    message.schedule = ScanSchedule.internalBinaryRead(reader, reader.uint32(), options, message.schedule);
                     break;
                 default:
                     let u = options.readUnknownField;
@@ -6297,13 +12729,10 @@ class ShodanSecurityResult$Type extends MessageType<ShodanSecurityResult> {
         }
         return message;
     }
-    internalBinaryWrite(message: ShodanSecurityResult, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
-        /* repeated service.ShodanHost hosts = 1; */
-        for (let i = 0; i < message.hosts.length; i++)
-            ShodanHost.internalBinaryWrite(message.hosts[i], writer.tag(1, WireType.LengthDelimited).fork(), options).join();
-        /* repeated string errors = 2; */
-        for (let i = 0; i < message.errors.length; i++)
-            writer.tag(2, WireType.LengthDelimited).string(message.errors[i]);
+    internalBinaryWrite(message: UpdateScanScheduleResponse, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* service.ScanSchedule schedule = 1; */
+        if (message.schedule)
+            ScanSchedule.internalBinaryWrite(message.schedule, writer.tag(1, WireType.LengthDelimited).fork(), options).join();
         let u = options.writeUnknownFields;
         if (u !== false)
             (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
@@ -6311,35 +12740,35 @@ class ShodanSecurityResult$Type extends MessageType<ShodanSecurityResult> {
     }
 }
 /**
- * @generated MessageType for protobuf message service.ShodanSecurityResult
+ * @generated MessageType for protobuf message service.UpdateScanScheduleResponse
  */
-export const ShodanSecurityResult = new ShodanSecurityResult$Type();
+export const UpdateScanScheduleResponse = new UpdateScanScheduleResponse$Type();
 // @generated message type with reflection information, may provide speed optimized methods
-class ScanOTXRequest$Type extends MessageType<ScanOTXRequest> {
+class PauseScanScheduleRequest$Type extends MessageType<PauseScanScheduleRequest> {
     constructor() {
-        super("service.ScanOTXRequest", [
-            { no: 1, name: "domain", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
-            { no: 2, name: "dns_scan_id", kind: "scalar", T: 9 /*ScalarType.STRING*/ }
+        super("service.PauseScanScheduleRequest", [
+            { no: 1, name: "schedule_id", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 2, name: "paused", kind: "scalar", T: 8 /*ScalarType.BOOL*/ }
         ]);
     }
-    create(value?: PartialMessage<ScanOTXRequest>): ScanOTXRequest {
+    create(value?: PartialMessage<PauseScanScheduleRequest>): PauseScanScheduleRequest {
         const message = globalThis.Object.create((this.messagePrototype!));
-        message.domain = "";
-        message.dnsScanId = "";
+        message.scheduleId = "";
+        message.paused = false;
         if (value !== undefined)
-            reflectionMergePartial<ScanOTXRequest>(this, message, value);
+            reflectionMergePartial<PauseScanScheduleRequest>(this, message, value);
         return message;
     }
-    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: ScanOTXRequest): ScanOTXRequest {
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: PauseScanScheduleRequest): PauseScanScheduleRequest {
         let message = target ?? this.create(), end = reader.pos + length;
         while (reader.pos < end) {
             let [fieldNo, wireType] = reader.tag();
             switch (fieldNo) {
-                case /* string domain */ 1:
-                    message.domain = reader.string();
+                case /* string schedule_id */ 1:
+                    message.scheduleId = reader.string();
                     break;
-                case /* string dns_scan_id */ 2:
-                    message.dnsScanId = reader.string();
+                case /* bool paused */ 2:
+                    message.paused = reader.bool();
                     break;
                 default:
                     let u = options.readUnknownField;
@@ -6352,13 +12781,13 @@ class ScanOTXRequest$Type extends MessageType<ScanOTXRequest> {
         }
         return message;
     }
-    internalBinaryWrite(message: ScanOTXRequest, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
-        /* string domain = 1; */
-        if (message.domain !== "")
-            writer.tag(1, WireType.LengthDelimited).string(message.domain);
-        /* string dns_scan_id = 2; */
-        if (message.dnsScanId !== "")
-            writer.tag(2, WireType.LengthDelimited).string(message.dnsScanId);
+    internalBinaryWrite(message: PauseScanScheduleRequest, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* string schedule_id = 1; */
+        if (message.scheduleId !== "")
+            writer.tag(1, WireType.LengthDelimited).string(message.scheduleId);
+        /* bool paused = 2; */
+        if (message.paused !== false)
+            writer.tag(2, WireType.Varint).bool(message.paused);
         let u = options.writeUnknownFields;
         if (u !== false)
             (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
@@ -6366,34 +12795,29 @@ class ScanOTXRequest$Type extends MessageType<ScanOTXRequest> {
     }
 }
 /**
- * @generated MessageType for protobuf message service.ScanOTXRequest
+ * @generated MessageType for protobuf message service.PauseScanScheduleRequest
  */
-export const ScanOTXRequest = new ScanOTXRequest$Type();
+export const PauseScanScheduleRequest = new PauseScanScheduleRequest$Type();
 // @generated message type with reflection information, may provide speed optimized methods
-class ScanOTXResponse$Type extends MessageType<ScanOTXResponse> {
+class PauseScanScheduleResponse$Type extends MessageType<PauseScanScheduleResponse> {
     constructor() {
-        super("service.ScanOTXResponse", [
-            { no: 1, name: "scan_id", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
-            { no: 2, name: "result", kind: "message", T: () => OTXSecurityResult }
+        super("service.PauseScanScheduleResponse", [
+            { no: 1, name: "schedule", kind: "message", T: () => ScanSchedule }
         ]);
     }
-    create(value?: PartialMessage<ScanOTXResponse>): ScanOTXResponse {
+    create(value?: PartialMessage<PauseScanScheduleResponse>): PauseScanScheduleResponse {
         const message = globalThis.Object.create((this.messagePrototype!));
-        message.scanId = "";
         if (value !== undefined)
-            reflectionMergePartial<ScanOTXResponse>(this, message, value);
+            reflectionMergePartial<PauseScanScheduleResponse>(this, message, value);
         return message;
     }
-    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: ScanOTXResponse): ScanOTXResponse {
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: PauseScanScheduleResponse): PauseScanScheduleResponse {
         let message = target ?? this.create(), end = reader.pos + length;
         while (reader.pos < end) {
             let [fieldNo, wireType] = reader.tag();
             switch (fieldNo) {
-                case /* string scan_id */ 1:
-                    message.scanId = reader.string();
-                    break;
-                case /* service.OTXSecurityResult result */ 2:
-                    message.result = OTXSecurityResult.internalBinaryRead(reader, reader.uint32(), options, message.result);
+                case /* service.ScanSchedule schedule */ 1:
+                    message.schedule = ScanSchedule.internalBinaryRead(reader, reader.uint32(), options, message.schedule);
                     break;
                 default:
                     let u = options.readUnknownField;
@@ -6406,13 +12830,10 @@ class ScanOTXResponse$Type extends MessageType<ScanOTXResponse> {
         }
         return message;
     }
-    internalBinaryWrite(message: ScanOTXResponse, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
-        /* string scan_id = 1; */
-        if (message.scanId !== "")
-            writer.tag(1, WireType.LengthDelimited).string(message.scanId);
-        /* service.OTXSecurityResult result = 2; */
-        if (message.result)
-            OTXSecurityResult.internalBinaryWrite(message.result, writer.tag(2, WireType.LengthDelimited).fork(), options).join();
+    internalBinaryWrite(message: PauseScanScheduleResponse, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* service.ScanSchedule schedule = 1; */
+        if (message.schedule)
+            ScanSchedule.internalBinaryWrite(message.schedule, writer.tag(1, WireType.LengthDelimited).fork(), options).join();
         let u = options.writeUnknownFields;
         if (u !== false)
             (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
@@ -6420,31 +12841,25 @@ class ScanOTXResponse$Type extends MessageType<ScanOTXResponse> {
     }
 }
 /**
- * @generated MessageType for protobuf message service.ScanOTXResponse
+ * @generated MessageType for protobuf message service.PauseScanScheduleResponse
  */
-export const ScanOTXResponse = new ScanOTXResponse$Type();
+export const PauseScanScheduleResponse = new PauseScanScheduleResponse$Type();
 // @generated message type with reflection information, may provide speed optimized methods
-class GetOTXScanResultsByDomainRequest$Type extends MessageType<GetOTXScanResultsByDomainRequest> {
+class ListScanSchedulesRequest$Type extends MessageType<ListScanSchedulesRequest> {
     constructor() {
-        super("service.GetOTXScanResultsByDomainRequest", [
-            { no: 1, name: "domain", kind: "scalar", T: 9 /*ScalarType.STRING*/ }
-        ]);
+        super("service.ListScanSchedulesRequest", []);
     }
-    create(value?: PartialMessage<GetOTXScanResultsByDomainRequest>): GetOTXScanResultsByDomainRequest {
+    create(value?: PartialMessage<ListScanSchedulesRequest>): ListScanSchedulesRequest {
         const message = globalThis.Object.create((this.messagePrototype!));
-        message.domain = "";
         if (value !== undefined)
-            reflectionMergePartial<GetOTXScanResultsByDomainRequest>(this, message, value);
+            reflectionMergePartial<ListScanSchedulesRequest>(this, message, value);
         return message;
     }
-    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: GetOTXScanResultsByDomainRequest): GetOTXScanResultsByDomainRequest {
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: ListScanSchedulesRequest): ListScanSchedulesRequest {
         let message = target ?? this.create(), end = reader.pos + length;
         while (reader.pos < end) {
             let [fieldNo, wireType] = reader.tag();
             switch (fieldNo) {
-                case /* string domain */ 1:
-                    message.domain = reader.string();
-                    break;
                 default:
                     let u = options.readUnknownField;
                     if (u === "throw")
@@ -6456,10 +12871,7 @@ class GetOTXScanResultsByDomainRequest$Type extends MessageType<GetOTXScanResult
         }
         return message;
     }
-    internalBinaryWrite(message: GetOTXScanResultsByDomainRequest, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
-        /* string domain = 1; */
-        if (message.domain !== "")
-            writer.tag(1, WireType.LengthDelimited).string(message.domain);
+    internalBinaryWrite(message: ListScanSchedulesRequest, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
         let u = options.writeUnknownFields;
         if (u !== false)
             (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
@@ -6467,30 +12879,30 @@ class GetOTXScanResultsByDomainRequest$Type extends MessageType<GetOTXScanResult
     }
 }
 /**
- * @generated MessageType for protobuf message service.GetOTXScanResultsByDomainRequest
+ * @generated MessageType for protobuf message service.ListScanSchedulesRequest
  */
-export const GetOTXScanResultsByDomainRequest = new GetOTXScanResultsByDomainRequest$Type();
+export const ListScanSchedulesRequest = new ListScanSchedulesRequest$Type();
 // @generated message type with reflection information, may provide speed optimized methods
-class GetOTXScanResultsByDomainResponse$Type extends MessageType<GetOTXScanResultsByDomainResponse> {
+class ListScanSchedulesResponse$Type extends MessageType<ListScanSchedulesResponse> {
     constructor() {
-        super("service.GetOTXScanResultsByDomainResponse", [
-            { no: 1, name: "results", kind: "message", repeat: 2 /*RepeatType.UNPACKED*/, T: () => OTXScanResult }
+        super("service.ListScanSchedulesResponse", [
+            { no: 1, name: "schedules", kind: "message", repeat: 2 /*RepeatType.UNPACKED*/, T: () => ScanSchedule }
         ]);
     }
-    create(value?: PartialMessage<GetOTXScanResultsByDomainResponse>): GetOTXScanResultsByDomainResponse {
+    create(value?: PartialMessage<ListScanSchedulesResponse>): ListScanSchedulesResponse {
         const message = globalThis.Object.create((this.messagePrototype!));
-        message.results = [];
+        message.schedules = [];
         if (value !== undefined)
-            reflectionMergePartial<GetOTXScanResultsByDomainResponse>(this, message, value);
+            reflectionMergePartial<ListScanSchedulesResponse>(this, message, value);
         return message;
     }
-    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: GetOTXScanResultsByDomainResponse): GetOTXScanResultsByDomainResponse {
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: ListScanSchedulesResponse): ListScanSchedulesResponse {
         let message = target ?? this.create(), end = reader.pos + length;
         while (reader.pos < end) {
             let [fieldNo, wireType] = reader.tag();
             switch (fieldNo) {
-                case /* repeated service.OTXScanResult results */ 1:
-                    message.results.push(OTXScanResult.internalBinaryRead(reader, reader.uint32(), options));
+                case /* repeated service.ScanSchedule schedules */ 1:
+                    message.schedules.push(ScanSchedule.internalBinaryRead(reader, reader.uint32(), options));
                     break;
                 default:
                     let u = options.readUnknownField;
@@ -6503,10 +12915,10 @@ class GetOTXScanResultsByDomainResponse$Type extends MessageType<GetOTXScanResul
         }
         return message;
     }
-    internalBinaryWrite(message: GetOTXScanResultsByDomainResponse, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
-        /* repeated service.OTXScanResult results = 1; */
-        for (let i = 0; i < message.results.length; i++)
-            OTXScanResult.internalBinaryWrite(message.results[i], writer.tag(1, WireType.LengthDelimited).fork(), options).join();
+    internalBinaryWrite(message: ListScanSchedulesResponse, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* repeated service.ScanSchedule schedules = 1; */
+        for (let i = 0; i < message.schedules.length; i++)
+            ScanSchedule.internalBinaryWrite(message.schedules[i], writer.tag(1, WireType.LengthDelimited).fork(), options).join();
         let u = options.writeUnknownFields;
         if (u !== false)
             (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
@@ -6514,48 +12926,184 @@ class GetOTXScanResultsByDomainResponse$Type extends MessageType<GetOTXScanResul
     }
 }
 /**
- * @generated MessageType for protobuf message service.GetOTXScanResultsByDomainResponse
+ * @generated MessageType for protobuf message service.ListScanSchedulesResponse
  */
-export const GetOTXScanResultsByDomainResponse = new GetOTXScanResultsByDomainResponse$Type();
+export const ListScanSchedulesResponse = new ListScanSchedulesResponse$Type();
 // @generated message type with reflection information, may provide speed optimized methods
-class OTXScanResult$Type extends MessageType<OTXScanResult> {
+class ScanSchedule$Type extends MessageType<ScanSchedule> {
     constructor() {
-        super("service.OTXScanResult", [
-            { no: 1, name: "id", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+        super("service.ScanSchedule", [
+            { no: 1, name: "schedule_id", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
             { no: 2, name: "domain", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
-            { no: 3, name: "dns_scan_id", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
-            { no: 4, name: "result", kind: "message", T: () => OTXSecurityResult },
-            { no: 5, name: "created_at", kind: "message", T: () => Timestamp }
+            { no: 3, name: "cron", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 4, name: "interval_seconds", kind: "scalar", T: 3 /*ScalarType.INT64*/, L: 0 /*LongType.BIGINT*/ },
+            { no: 5, name: "profile", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 6, name: "owner_id", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 7, name: "paused", kind: "scalar", T: 8 /*ScalarType.BOOL*/ },
+            { no: 8, name: "next_run_at", kind: "message", T: () => Timestamp },
+            { no: 9, name: "last_run_at", kind: "message", T: () => Timestamp },
+            { no: 10, name: "last_job_id", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 11, name: "last_error", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 12, name: "created_at", kind: "message", T: () => Timestamp },
+            { no: 13, name: "updated_at", kind: "message", T: () => Timestamp }
         ]);
     }
-    create(value?: PartialMessage<OTXScanResult>): OTXScanResult {
+    create(value?: PartialMessage<ScanSchedule>): ScanSchedule {
         const message = globalThis.Object.create((this.messagePrototype!));
-        message.id = "";
+        message.scheduleId = "";
         message.domain = "";
-        message.dnsScanId = "";
+        message.cron = "";
+        message.intervalSeconds = 0n;
+        message.profile = "";
+        message.ownerId = "";
+        message.paused = false;
+        message.lastJobId = "";
+        message.lastError = "";
         if (value !== undefined)
-            reflectionMergePartial<OTXScanResult>(this, message, value);
+            reflectionMergePartial<ScanSchedule>(this, message, value);
         return message;
     }
-    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: OTXScanResult): OTXScanResult {
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: ScanSchedule): ScanSchedule {
         let message = target ?? this.create(), end = reader.pos + length;
         while (reader.pos < end) {
             let [fieldNo, wireType] = reader.tag();
             switch (fieldNo) {
-                case /* string id */ 1:
-                    message.id = reader.string();
+                case /* string schedule_id */ 1:
+                    message.scheduleId = reader.string();
                     break;
                 case /* string domain */ 2:
                     message.domain = reader.string();
                     break;
-                case /* string dns_scan_id */ 3:
-                    message.dnsScanId = reader.string();
+                case /* string cron */ 3:
+                    message.cron = reader.string();
+                    break;
+                case /* int64 interval_seconds */ 4:
+                    message.intervalSeconds = reader.int64().toBigInt();
+                    break;
+                case /* string profile */ 5:
+                    message.profile = reader.string();
+                    break;
+                case /* string owner_id */ 6:
+                    message.ownerId = reader.string();
+                    break;
+                case /* bool paused */ 7:
+                    message.paused = reader.bool();
+                    break;
+                case /* google.protobuf.Timestamp next_run_at */ 8:
+                    message.nextRunAt = Timestamp.internalBinaryRead(reader, reader.uint32(), options, message.nextRunAt);
+                    break;
+                case /* google.protobuf.Timestamp last_run_at */ 9:
+                    message.lastRunAt = Timestamp.internalBinaryRead(reader, reader.uint32(), options, message.lastRunAt);
+                    break;
+                case /* string last_job_id */ 10:
+                    message.lastJobId = reader.string();
+                    break;
+                case /* string last_error */ 11:
+                    message.lastError = reader.string();
+                    break;
+                case /* google.protobuf.Timestamp created_at */ 12:
+                    message.createdAt = Timestamp.internalBinaryRead(reader, reader.uint32(), options, message.createdAt);
+                    break;
+                case /* google.protobuf.Timestamp updated_at */ 13:
+                    message.updatedAt = Timestamp.internalBinaryRead(reader, reader.uint32(), options, message.updatedAt);
+                    break;
+                default:
+                    let u = options.readUnknownField;
+                    if (u === "throw")
+                        throw new globalThis.Error(`Unknown field ${fieldNo} (wire type ${wireType}) for ${this.typeName}`);
+                    let d = reader.skip(wireType);
+                    if (u !== false)
+                        (u === true ? UnknownFieldHandler.onRead : u)(this.typeName, message, fieldNo, wireType, d);
+            }
+        }
+        return message;
+    }
+    internalBinaryWrite(message: ScanSchedule, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* string schedule_id = 1; */
+        if (message.scheduleId !== "")
+            writer.tag(1, WireType.LengthDelimited).string(message.scheduleId);
+        /* string domain = 2; */
+        if (message.domain !== "")
+            writer.tag(2, WireType.LengthDelimited).string(message.domain);
+        /* string cron = 3; */
+        if (message.cron !== "")
+            writer.tag(3, WireType.LengthDelimited).string(message.cron);
+        /* int64 interval_seconds = 4; */
+        if (message.intervalSeconds !== 0n)
+            writer.tag(4, WireType.Varint).int64(message.intervalSeconds);
+        /* string profile = 5; */
+        if (message.profile !== "")
+            writer.tag(5, WireType.LengthDelimited).string(message.profile);
+        /* string owner_id = 6; */
+        if (message.ownerId !== "")
+            writer.tag(6, WireType.LengthDelimited).string(message.ownerId);
+        /* bool paused = 7; */
+        if (message.paused !== false)
+            writer.tag(7, WireType.Varint).bool(message.paused);
+        /* google.protobuf.Timestamp next_run_at = 8; */
+        if (message.nextRunAt)
+            Timestamp.internalBinaryWrite(message.nextRunAt, writer.tag(8, WireType.LengthDelimited).fork(), options).join();
+        /* google.protobuf.Timestamp last_run_at = 9; */
+        if (message.lastRunAt)
+            Timestamp.internalBinaryWrite(message.lastRunAt, writer.tag(9, WireType.LengthDelimited).fork(), options).join();
+        /* string last_job_id = 10; */
+        if (message.lastJobId !== "")
+            writer.tag(10, WireType.LengthDelimited).string(message.lastJobId);
+        /* string last_error = 11; */
+        if (message.lastError !== "")
+            writer.tag(11, WireType.LengthDelimited).string(message.lastError);
+        /* google.protobuf.Timestamp created_at = 12; */
+        if (message.createdAt)
+            Timestamp.internalBinaryWrite(message.createdAt, writer.tag(12, WireType.LengthDelimited).fork(), options).join();
+        /* google.protobuf.Timestamp updated_at = 13; */
+        if (message.updatedAt)
+            Timestamp.internalBinaryWrite(message.updatedAt, writer.tag(13, WireType.LengthDelimited).fork(), options).join();
+        let u = options.writeUnknownFields;
+        if (u !== false)
+            (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
+        return writer;
+    }
+}
+/**
+ * @generated MessageType for protobuf message service.ScanSchedule
+ */
+export const ScanSchedule = new ScanSchedule$Type();
+// @generated message type with reflection information, may provide speed optimized methods
+class BulkScanRequest$Type extends MessageType<BulkScanRequest> {
+    constructor() {
+        super("service.BulkScanRequest", [
+            { no: 1, name: "domains", kind: "scalar", repeat: 2 /*RepeatType.UNPACKED*/, T: 9 /*ScalarType.STRING*/ },
+            { no: 2, name: "csv", kind: "scalar", T: 12 /*ScalarType.BYTES*/ },
+            { no: 3, name: "tags", kind: "scalar", repeat: 2 /*RepeatType.UNPACKED*/, T: 9 /*ScalarType.STRING*/ },
+            { no: 4, name: "profile", kind: "scalar", T: 9 /*ScalarType.STRING*/ }
+        ]);
+    }
+    create(value?: PartialMessage<BulkScanRequest>): BulkScanRequest {
+        const message = globalThis.Object.create((this.messagePrototype!));
+        message.domains = [];
+        message.csv = new Uint8Array(0);
+        message.tags = [];
+        message.profile = "";
+        if (value !== undefined)
+            reflectionMergePartial<BulkScanRequest>(this, message, value);
+        return message;
+    }
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: BulkScanRequest): BulkScanRequest {
+        let message = target ?? this.create(), end = reader.pos + length;
+        while (reader.pos < end) {
+            let [fieldNo, wireType] = reader.tag();
+            switch (fieldNo) {
+                case /* repeated string domains */ 1:
+                    message.domains.push(reader.string());
+                    break;
+                case /* bytes csv */ 2:
+                    message.csv = reader.bytes();
                     break;
-                case /* service.OTXSecurityResult result */ 4:
-                    message.result = OTXSecurityResult.internalBinaryRead(reader, reader.uint32(), options, message.result);
+                case /* repeated string tags */ 3:
+                    message.tags.push(reader.string());
                     break;
-                case /* google.protobuf.Timestamp created_at */ 5:
-                    message.createdAt = Timestamp.internalBinaryRead(reader, reader.uint32(), options, message.createdAt);
+                case /* string profile */ 4:
+                    message.profile = reader.string();
                     break;
                 default:
                     let u = options.readUnknownField;
@@ -6568,22 +13116,19 @@ class OTXScanResult$Type extends MessageType<OTXScanResult> {
         }
         return message;
     }
-    internalBinaryWrite(message: OTXScanResult, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
-        /* string id = 1; */
-        if (message.id !== "")
-            writer.tag(1, WireType.LengthDelimited).string(message.id);
-        /* string domain = 2; */
-        if (message.domain !== "")
-            writer.tag(2, WireType.LengthDelimited).string(message.domain);
-        /* string dns_scan_id = 3; */
-        if (message.dnsScanId !== "")
-            writer.tag(3, WireType.LengthDelimited).string(message.dnsScanId);
-        /* service.OTXSecurityResult result = 4; */
-        if (message.result)
-            OTXSecurityResult.internalBinaryWrite(message.result, writer.tag(4, WireType.LengthDelimited).fork(), options).join();
-        /* google.protobuf.Timestamp created_at = 5; */
-        if (message.createdAt)
-            Timestamp.internalBinaryWrite(message.createdAt, writer.tag(5, WireType.LengthDelimited).fork(), options).join();
+    internalBinaryWrite(message: BulkScanRequest, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* repeated string domains = 1; */
+        for (let i = 0; i < message.domains.length; i++)
+            writer.tag(1, WireType.LengthDelimited).string(message.domains[i]);
+        /* bytes csv = 2; */
+        if (message.csv.length)
+            writer.tag(2, WireType.LengthDelimited).bytes(message.csv);
+        /* repeated string tags = 3; */
+        for (let i = 0; i < message.tags.length; i++)
+            writer.tag(3, WireType.LengthDelimited).string(message.tags[i]);
+        /* string profile = 4; */
+        if (message.profile !== "")
+            writer.tag(4, WireType.LengthDelimited).string(message.profile);
         let u = options.writeUnknownFields;
         if (u !== false)
             (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
@@ -6591,35 +13136,39 @@ class OTXScanResult$Type extends MessageType<OTXScanResult> {
     }
 }
 /**
- * @generated MessageType for protobuf message service.OTXScanResult
+ * @generated MessageType for protobuf message service.BulkScanRequest
  */
-export const OTXScanResult = new OTXScanResult$Type();
+export const BulkScanRequest = new BulkScanRequest$Type();
 // @generated message type with reflection information, may provide speed optimized methods
-class OTXGeneralInfo$Type extends MessageType<OTXGeneralInfo> {
+class BulkScanResponse$Type extends MessageType<BulkScanResponse> {
     constructor() {
-        super("service.OTXGeneralInfo", [
-            { no: 1, name: "pulse_count", kind: "scalar", T: 5 /*ScalarType.INT32*/ },
-            { no: 2, name: "pulses", kind: "scalar", repeat: 2 /*RepeatType.UNPACKED*/, T: 9 /*ScalarType.STRING*/ }
+        super("service.BulkScanResponse", [
+            { no: 1, name: "batch", kind: "message", T: () => ScanBatch },
+            { no: 2, name: "rejected", kind: "message", repeat: 2 /*RepeatType.UNPACKED*/, T: () => BulkScanRejected },
+            { no: 3, name: "duplicates", kind: "scalar", T: 5 /*ScalarType.INT32*/ }
         ]);
     }
-    create(value?: PartialMessage<OTXGeneralInfo>): OTXGeneralInfo {
+    create(value?: PartialMessage<BulkScanResponse>): BulkScanResponse {
         const message = globalThis.Object.create((this.messagePrototype!));
-        message.pulseCount = 0;
-        message.pulses = [];
+        message.rejected = [];
+        message.duplicates = 0;
         if (value !== undefined)
-            reflectionMergePartial<OTXGeneralInfo>(this, message, value);
+            reflectionMergePartial<BulkScanResponse>(this, message, value);
         return message;
     }
-    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: OTXGeneralInfo): OTXGeneralInfo {
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: BulkScanResponse): BulkScanResponse {
         let message = target ?? this.create(), end = reader.pos + length;
         while (reader.pos < end) {
             let [fieldNo, wireType] = reader.tag();
             switch (fieldNo) {
-                case /* int32 pulse_count */ 1:
-                    message.pulseCount = reader.int32();
+                case /* service.ScanBatch batch */ 1:
+                    message.batch = ScanBatch.internalBinaryRead(reader, reader.uint32(), options, message.batch);
                     break;
-                case /* repeated string pulses */ 2:
-                    message.pulses.push(reader.string());
+                case /* repeated service.BulkScanRejected rejected */ 2:
+                    message.rejected.push(BulkScanRejected.internalBinaryRead(reader, reader.uint32(), options));
+                    break;
+                case /* int32 duplicates */ 3:
+                    message.duplicates = reader.int32();
                     break;
                 default:
                     let u = options.readUnknownField;
@@ -6632,13 +13181,16 @@ class OTXGeneralInfo$Type extends MessageType<OTXGeneralInfo> {
         }
         return message;
     }
-    internalBinaryWrite(message: OTXGeneralInfo, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
-        /* int32 pulse_count = 1; */
-        if (message.pulseCount !== 0)
-            writer.tag(1, WireType.Varint).int32(message.pulseCount);
-        /* repeated string pulses = 2; */
-        for (let i = 0; i < message.pulses.length; i++)
-            writer.tag(2, WireType.LengthDelimited).string(message.pulses[i]);
+    internalBinaryWrite(message: BulkScanResponse, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* service.ScanBatch batch = 1; */
+        if (message.batch)
+            ScanBatch.internalBinaryWrite(message.batch, writer.tag(1, WireType.LengthDelimited).fork(), options).join();
+        /* repeated service.BulkScanRejected rejected = 2; */
+        for (let i = 0; i < message.rejected.length; i++)
+            BulkScanRejected.internalBinaryWrite(message.rejected[i], writer.tag(2, WireType.LengthDelimited).fork(), options).join();
+        /* int32 duplicates = 3; */
+        if (message.duplicates !== 0)
+            writer.tag(3, WireType.Varint).int32(message.duplicates);
         let u = options.writeUnknownFields;
         if (u !== false)
             (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
@@ -6646,34 +13198,35 @@ class OTXGeneralInfo$Type extends MessageType<OTXGeneralInfo> {
     }
 }
 /**
- * @generated MessageType for protobuf message service.OTXGeneralInfo
+ * @generated MessageType for protobuf message service.BulkScanResponse
  */
-export const OTXGeneralInfo = new OTXGeneralInfo$Type();
+export const BulkScanResponse = new BulkScanResponse$Type();
 // @generated message type with reflection information, may provide speed optimized methods
-class OTXMalware$Type extends MessageType<OTXMalware> {
+class BulkScanRejected$Type extends MessageType<BulkScanRejected> {
     constructor() {
-        super("service.OTXMalware", [
-            { no: 1, name: "hash", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
-            { no: 2, name: "datetime", kind: "message", T: () => Timestamp }
+        super("service.BulkScanRejected", [
+            { no: 1, name: "input", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 2, name: "reason", kind: "scalar", T: 9 /*ScalarType.STRING*/ }
         ]);
     }
-    create(value?: PartialMessage<OTXMalware>): OTXMalware {
+    create(value?: PartialMessage<BulkScanRejected>): BulkScanRejected {
         const message = globalThis.Object.create((this.messagePrototype!));
-        message.hash = "";
+        message.input = "";
+        message.reason = "";
         if (value !== undefined)
-            reflectionMergePartial<OTXMalware>(this, message, value);
+            reflectionMergePartial<BulkScanRejected>(this, message, value);
         return message;
     }
-    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: OTXMalware): OTXMalware {
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: BulkScanRejected): BulkScanRejected {
         let message = target ?? this.create(), end = reader.pos + length;
         while (reader.pos < end) {
             let [fieldNo, wireType] = reader.tag();
             switch (fieldNo) {
-                case /* string hash */ 1:
-                    message.hash = reader.string();
+                case /* string input */ 1:
+                    message.input = reader.string();
                     break;
-                case /* google.protobuf.Timestamp datetime */ 2:
-                    message.datetime = Timestamp.internalBinaryRead(reader, reader.uint32(), options, message.datetime);
+                case /* string reason */ 2:
+                    message.reason = reader.string();
                     break;
                 default:
                     let u = options.readUnknownField;
@@ -6686,13 +13239,13 @@ class OTXMalware$Type extends MessageType<OTXMalware> {
         }
         return message;
     }
-    internalBinaryWrite(message: OTXMalware, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
-        /* string hash = 1; */
-        if (message.hash !== "")
-            writer.tag(1, WireType.LengthDelimited).string(message.hash);
-        /* google.protobuf.Timestamp datetime = 2; */
-        if (message.datetime)
-            Timestamp.internalBinaryWrite(message.datetime, writer.tag(2, WireType.LengthDelimited).fork(), options).join();
+    internalBinaryWrite(message: BulkScanRejected, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* string input = 1; */
+        if (message.input !== "")
+            writer.tag(1, WireType.LengthDelimited).string(message.input);
+        /* string reason = 2; */
+        if (message.reason !== "")
+            writer.tag(2, WireType.LengthDelimited).string(message.reason);
         let u = options.writeUnknownFields;
         if (u !== false)
             (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
@@ -6700,34 +13253,30 @@ class OTXMalware$Type extends MessageType<OTXMalware> {
     }
 }
 /**
- * @generated MessageType for protobuf message service.OTXMalware
+ * @generated MessageType for protobuf message service.BulkScanRejected
  */
-export const OTXMalware = new OTXMalware$Type();
+export const BulkScanRejected = new BulkScanRejected$Type();
 // @generated message type with reflection information, may provide speed optimized methods
-class OTXURL$Type extends MessageType<OTXURL> {
+class GetScanBatchRequest$Type extends MessageType<GetScanBatchRequest> {
     constructor() {
-        super("service.OTXURL", [
-            { no: 1, name: "url", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
-            { no: 2, name: "datetime", kind: "message", T: () => Timestamp }
+        super("service.GetScanBatchRequest", [
+            { no: 1, name: "batch_id", kind: "scalar", T: 9 /*ScalarType.STRING*/ }
         ]);
     }
-    create(value?: PartialMessage<OTXURL>): OTXURL {
+    create(value?: PartialMessage<GetScanBatchRequest>): GetScanBatchRequest {
         const message = globalThis.Object.create((this.messagePrototype!));
-        message.url = "";
+        message.batchId = "";
         if (value !== undefined)
-            reflectionMergePartial<OTXURL>(this, message, value);
+            reflectionMergePartial<GetScanBatchRequest>(this, message, value);
         return message;
     }
-    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: OTXURL): OTXURL {
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: GetScanBatchRequest): GetScanBatchRequest {
         let message = target ?? this.create(), end = reader.pos + length;
         while (reader.pos < end) {
             let [fieldNo, wireType] = reader.tag();
             switch (fieldNo) {
-                case /* string url */ 1:
-                    message.url = reader.string();
-                    break;
-                case /* google.protobuf.Timestamp datetime */ 2:
-                    message.datetime = Timestamp.internalBinaryRead(reader, reader.uint32(), options, message.datetime);
+                case /* string batch_id */ 1:
+                    message.batchId = reader.string();
                     break;
                 default:
                     let u = options.readUnknownField;
@@ -6740,13 +13289,10 @@ class OTXURL$Type extends MessageType<OTXURL> {
         }
         return message;
     }
-    internalBinaryWrite(message: OTXURL, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
-        /* string url = 1; */
-        if (message.url !== "")
-            writer.tag(1, WireType.LengthDelimited).string(message.url);
-        /* google.protobuf.Timestamp datetime = 2; */
-        if (message.datetime)
-            Timestamp.internalBinaryWrite(message.datetime, writer.tag(2, WireType.LengthDelimited).fork(), options).join();
+    internalBinaryWrite(message: GetScanBatchRequest, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* string batch_id = 1; */
+        if (message.batchId !== "")
+            writer.tag(1, WireType.LengthDelimited).string(message.batchId);
         let u = options.writeUnknownFields;
         if (u !== false)
             (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
@@ -6754,44 +13300,29 @@ class OTXURL$Type extends MessageType<OTXURL> {
     }
 }
 /**
- * @generated MessageType for protobuf message service.OTXURL
+ * @generated MessageType for protobuf message service.GetScanBatchRequest
  */
-export const OTXURL = new OTXURL$Type();
+export const GetScanBatchRequest = new GetScanBatchRequest$Type();
 // @generated message type with reflection information, may provide speed optimized methods
-class OTXPassiveDNS$Type extends MessageType<OTXPassiveDNS> {
+class GetScanBatchResponse$Type extends MessageType<GetScanBatchResponse> {
     constructor() {
-        super("service.OTXPassiveDNS", [
-            { no: 1, name: "address", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
-            { no: 2, name: "hostname", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
-            { no: 3, name: "record", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
-            { no: 4, name: "datetime", kind: "message", T: () => Timestamp }
+        super("service.GetScanBatchResponse", [
+            { no: 1, name: "batch", kind: "message", T: () => ScanBatch }
         ]);
     }
-    create(value?: PartialMessage<OTXPassiveDNS>): OTXPassiveDNS {
+    create(value?: PartialMessage<GetScanBatchResponse>): GetScanBatchResponse {
         const message = globalThis.Object.create((this.messagePrototype!));
-        message.address = "";
-        message.hostname = "";
-        message.record = "";
         if (value !== undefined)
-            reflectionMergePartial<OTXPassiveDNS>(this, message, value);
+            reflectionMergePartial<GetScanBatchResponse>(this, message, value);
         return message;
     }
-    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: OTXPassiveDNS): OTXPassiveDNS {
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: GetScanBatchResponse): GetScanBatchResponse {
         let message = target ?? this.create(), end = reader.pos + length;
         while (reader.pos < end) {
             let [fieldNo, wireType] = reader.tag();
             switch (fieldNo) {
-                case /* string address */ 1:
-                    message.address = reader.string();
-                    break;
-                case /* string hostname */ 2:
-                    message.hostname = reader.string();
-                    break;
-                case /* string record */ 3:
-                    message.record = reader.string();
-                    break;
-                case /* google.protobuf.Timestamp datetime */ 4:
-                    message.datetime = Timestamp.internalBinaryRead(reader, reader.uint32(), options, message.datetime);
+                case /* service.ScanBatch batch */ 1:
+                    message.batch = ScanBatch.internalBinaryRead(reader, reader.uint32(), options, message.batch);
                     break;
                 default:
                     let u = options.readUnknownField;
@@ -6804,19 +13335,10 @@ class OTXPassiveDNS$Type extends MessageType<OTXPassiveDNS> {
         }
         return message;
     }
-    internalBinaryWrite(message: OTXPassiveDNS, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
-        /* string address = 1; */
-        if (message.address !== "")
-            writer.tag(1, WireType.LengthDelimited).string(message.address);
-        /* string hostname = 2; */
-        if (message.hostname !== "")
-            writer.tag(2, WireType.LengthDelimited).string(message.hostname);
-        /* string record = 3; */
-        if (message.record !== "")
-            writer.tag(3, WireType.LengthDelimited).string(message.record);
-        /* google.protobuf.Timestamp datetime = 4; */
-        if (message.datetime)
-            Timestamp.internalBinaryWrite(message.datetime, writer.tag(4, WireType.LengthDelimited).fork(), options).join();
+    internalBinaryWrite(message: GetScanBatchResponse, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* service.ScanBatch batch = 1; */
+        if (message.batch)
+            ScanBatch.internalBinaryWrite(message.batch, writer.tag(1, WireType.LengthDelimited).fork(), options).join();
         let u = options.writeUnknownFields;
         if (u !== false)
             (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
@@ -6824,49 +13346,73 @@ class OTXPassiveDNS$Type extends MessageType<OTXPassiveDNS> {
     }
 }
 /**
- * @generated MessageType for protobuf message service.OTXPassiveDNS
+ * @generated MessageType for protobuf message service.GetScanBatchResponse
  */
-export const OTXPassiveDNS = new OTXPassiveDNS$Type();
+export const GetScanBatchResponse = new GetScanBatchResponse$Type();
 // @generated message type with reflection information, may provide speed optimized methods
-class OTXSecurityResult$Type extends MessageType<OTXSecurityResult> {
+class ScanBatch$Type extends MessageType<ScanBatch> {
     constructor() {
-        super("service.OTXSecurityResult", [
-            { no: 1, name: "general_info", kind: "message", T: () => OTXGeneralInfo },
-            { no: 2, name: "malware", kind: "message", repeat: 2 /*RepeatType.UNPACKED*/, T: () => OTXMalware },
-            { no: 3, name: "urls", kind: "message", repeat: 2 /*RepeatType.UNPACKED*/, T: () => OTXURL },
-            { no: 4, name: "passive_dns", kind: "message", repeat: 2 /*RepeatType.UNPACKED*/, T: () => OTXPassiveDNS },
-            { no: 5, name: "errors", kind: "scalar", repeat: 2 /*RepeatType.UNPACKED*/, T: 9 /*ScalarType.STRING*/ }
+        super("service.ScanBatch", [
+            { no: 1, name: "batch_id", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 2, name: "total", kind: "scalar", T: 5 /*ScalarType.INT32*/ },
+            { no: 3, name: "queued", kind: "scalar", T: 5 /*ScalarType.INT32*/ },
+            { no: 4, name: "running", kind: "scalar", T: 5 /*ScalarType.INT32*/ },
+            { no: 5, name: "succeeded", kind: "scalar", T: 5 /*ScalarType.INT32*/ },
+            { no: 6, name: "failed", kind: "scalar", T: 5 /*ScalarType.INT32*/ },
+            { no: 7, name: "cancelled", kind: "scalar", T: 5 /*ScalarType.INT32*/ },
+            { no: 8, name: "done", kind: "scalar", T: 8 /*ScalarType.BOOL*/ },
+            { no: 9, name: "summary", kind: "message", T: () => ScanBatchSummary },
+            { no: 10, name: "created_at", kind: "message", T: () => Timestamp }
         ]);
     }
-    create(value?: PartialMessage<OTXSecurityResult>): OTXSecurityResult {
+    create(value?: PartialMessage<ScanBatch>): ScanBatch {
         const message = globalThis.Object.create((this.messagePrototype!));
-        message.malware = [];
-        message.urls = [];
-        message.passiveDns = [];
-        message.errors = [];
+        message.batchId = "";
+        message.total = 0;
+        message.queued = 0;
+        message.running = 0;
+        message.succeeded = 0;
+        message.failed = 0;
+        message.cancelled = 0;
+        message.done = false;
         if (value !== undefined)
-            reflectionMergePartial<OTXSecurityResult>(this, message, value);
+            reflectionMergePartial<ScanBatch>(this, message, value);
         return message;
     }
-    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: OTXSecurityResult): OTXSecurityResult {
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: ScanBatch): ScanBatch {
         let message = target ?? this.create(), end = reader.pos + length;
         while (reader.pos < end) {
             let [fieldNo, wireType] = reader.tag();
             switch (fieldNo) {
-                case /* service.OTXGeneralInfo general_info */ 1:
-                    message.generalInfo = OTXGeneralInfo.internalBinaryRead(reader, reader.uint32(), options, message.generalInfo);
+                case /* string batch_id */ 1:
+                    message.batchId = reader.string();
                     break;
-                case /* repeated service.OTXMalware malware */ 2:
-                    message.malware.push(OTXMalware.internalBinaryRead(reader, reader.uint32(), options));
+                case /* int32 total */ 2:
+                    message.total = reader.int32();
                     break;
-                case /* repeated service.OTXURL urls */ 3:
-                    message.urls.push(OTXURL.internalBinaryRead(reader, reader.uint32(), options));
+                case /* int32 queued */ 3:
+                    message.queued = reader.int32();
                     break;
-                case /* repeated service.OTXPassiveDNS passive_dns */ 4:
-                    message.passiveDns.push(OTXPassiveDNS.internalBinaryRead(reader, reader.uint32(), options));
+                case /* int32 running */ 4:
+                    message.running = reader.int32();
                     break;
-                case /* repeated string errors */ 5:
-                    message.errors.push(reader.string());
+                case /* int32 succeeded */ 5:
+                    message.succeeded = reader.int32();
+                    break;
+                case /* int32 failed */ 6:
+                    message.failed = reader.int32();
+                    break;
+                case /* int32 cancelled */ 7:
+                    message.cancelled = reader.int32();
+                    break;
+                case /* bool done */ 8:
+                    message.done = reader.bool();
+                    break;
+                case /* service.ScanBatchSummary summary */ 9:
+                    message.summary = ScanBatchSummary.internalBinaryRead(reader, reader.uint32(), options, message.summary);
+                    break;
+                case /* google.protobuf.Timestamp created_at */ 10:
+                    message.createdAt = Timestamp.internalBinaryRead(reader, reader.uint32(), options, message.createdAt);
                     break;
                 default:
                     let u = options.readUnknownField;
@@ -6879,22 +13425,37 @@ class OTXSecurityResult$Type extends MessageType<OTXSecurityResult> {
         }
         return message;
     }
-    internalBinaryWrite(message: OTXSecurityResult, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
-        /* service.OTXGeneralInfo general_info = 1; */
-        if (message.generalInfo)
-            OTXGeneralInfo.internalBinaryWrite(message.generalInfo, writer.tag(1, WireType.LengthDelimited).fork(), options).join();
-        /* repeated service.OTXMalware malware = 2; */
-        for (let i = 0; i < message.malware.length; i++)
-            OTXMalware.internalBinaryWrite(message.malware[i], writer.tag(2, WireType.LengthDelimited).fork(), options).join();
-        /* repeated service.OTXURL urls = 3; */
-        for (let i = 0; i < message.urls.length; i++)
-            OTXURL.internalBinaryWrite(message.urls[i], writer.tag(3, WireType.LengthDelimited).fork(), options).join();
-        /* repeated service.OTXPassiveDNS passive_dns = 4; */
-        for (let i = 0; i < message.passiveDns.length; i++)
-            OTXPassiveDNS.internalBinaryWrite(message.passiveDns[i], writer.tag(4, WireType.LengthDelimited).fork(), options).join();
-        /* repeated string errors = 5; */
-        for (let i = 0; i < message.errors.length; i++)
-            writer.tag(5, WireType.LengthDelimited).string(message.errors[i]);
+    internalBinaryWrite(message: ScanBatch, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* string batch_id = 1; */
+        if (message.batchId !== "")
+            writer.tag(1, WireType.LengthDelimited).string(message.batchId);
+        /* int32 total = 2; */
+        if (message.total !== 0)
+            writer.tag(2, WireType.Varint).int32(message.total);
+        /* int32 queued = 3; */
+        if (message.queued !== 0)
+            writer.tag(3, WireType.Varint).int32(message.queued);
+        /* int32 running = 4; */
+        if (message.running !== 0)
+            writer.tag(4, WireType.Varint).int32(message.running);
+        /* int32 succeeded = 5; */
+        if (message.succeeded !== 0)
+            writer.tag(5, WireType.Varint).int32(message.succeeded);
+        /* int32 failed = 6; */
+        if (message.failed !== 0)
+            writer.tag(6, WireType.Varint).int32(message.failed);
+        /* int32 cancelled = 7; */
+        if (message.cancelled !== 0)
+            writer.tag(7, WireType.Varint).int32(message.cancelled);
+        /* bool done = 8; */
+        if (message.done !== false)
+            writer.tag(8, WireType.Varint).bool(message.done);
+        /* service.ScanBatchSummary summary = 9; */
+        if (message.summary)
+            ScanBatchSummary.internalBinaryWrite(message.summary, writer.tag(9, WireType.LengthDelimited).fork(), options).join();
+        /* google.protobuf.Timestamp created_at = 10; */
+        if (message.createdAt)
+            Timestamp.internalBinaryWrite(message.createdAt, writer.tag(10, WireType.LengthDelimited).fork(), options).join();
         let u = options.writeUnknownFields;
         if (u !== false)
             (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
@@ -6902,35 +13463,55 @@ class OTXSecurityResult$Type extends MessageType<OTXSecurityResult> {
     }
 }
 /**
- * @generated MessageType for protobuf message service.OTXSecurityResult
+ * @generated MessageType for protobuf message service.ScanBatch
  */
-export const OTXSecurityResult = new OTXSecurityResult$Type();
+export const ScanBatch = new ScanBatch$Type();
 // @generated message type with reflection information, may provide speed optimized methods
-class ScanWhoisRequest$Type extends MessageType<ScanWhoisRequest> {
+class ScanBatchSummary$Type extends MessageType<ScanBatchSummary> {
     constructor() {
-        super("service.ScanWhoisRequest", [
-            { no: 1, name: "domain", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
-            { no: 2, name: "dns_scan_id", kind: "scalar", T: 9 /*ScalarType.STRING*/ }
+        super("service.ScanBatchSummary", [
+            { no: 1, name: "reports", kind: "scalar", T: 5 /*ScalarType.INT32*/ },
+            { no: 2, name: "average_score", kind: "scalar", T: 1 /*ScalarType.DOUBLE*/ },
+            { no: 3, name: "min_score", kind: "scalar", T: 5 /*ScalarType.INT32*/ },
+            { no: 4, name: "max_score", kind: "scalar", T: 5 /*ScalarType.INT32*/ },
+            { no: 5, name: "risk_tiers", kind: "message", repeat: 2 /*RepeatType.UNPACKED*/, T: () => RiskTierCount },
+            { no: 6, name: "failed_domains", kind: "message", repeat: 2 /*RepeatType.UNPACKED*/, T: () => ScanBatchFailure }
         ]);
     }
-    create(value?: PartialMessage<ScanWhoisRequest>): ScanWhoisRequest {
+    create(value?: PartialMessage<ScanBatchSummary>): ScanBatchSummary {
         const message = globalThis.Object.create((this.messagePrototype!));
-        message.domain = "";
-        message.dnsScanId = "";
+        message.reports = 0;
+        message.averageScore = 0;
+        message.minScore = 0;
+        message.maxScore = 0;
+        message.riskTiers = [];
+        message.failedDomains = [];
         if (value !== undefined)
-            reflectionMergePartial<ScanWhoisRequest>(this, message, value);
+            reflectionMergePartial<ScanBatchSummary>(this, message, value);
         return message;
     }
-    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: ScanWhoisRequest): ScanWhoisRequest {
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: ScanBatchSummary): ScanBatchSummary {
         let message = target ?? this.create(), end = reader.pos + length;
         while (reader.pos < end) {
             let [fieldNo, wireType] = reader.tag();
             switch (fieldNo) {
-                case /* string domain */ 1:
-                    message.domain = reader.string();
+                case /* int32 reports */ 1:
+                    message.reports = reader.int32();
                     break;
-                case /* string dns_scan_id */ 2:
-                    message.dnsScanId = reader.string();
+                case /* double average_score */ 2:
+                    message.averageScore = reader.double();
+                    break;
+                case /* int32 min_score */ 3:
+                    message.minScore = reader.int32();
+                    break;
+                case /* int32 max_score */ 4:
+                    message.maxScore = reader.int32();
+                    break;
+                case /* repeated service.RiskTierCount risk_tiers */ 5:
+                    message.riskTiers.push(RiskTierCount.internalBinaryRead(reader, reader.uint32(), options));
+                    break;
+                case /* repeated service.ScanBatchFailure failed_domains */ 6:
+                    message.failedDomains.push(ScanBatchFailure.internalBinaryRead(reader, reader.uint32(), options));
                     break;
                 default:
                     let u = options.readUnknownField;
@@ -6943,13 +13524,25 @@ class ScanWhoisRequest$Type extends MessageType<ScanWhoisRequest> {
         }
         return message;
     }
-    internalBinaryWrite(message: ScanWhoisRequest, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
-        /* string domain = 1; */
-        if (message.domain !== "")
-            writer.tag(1, WireType.LengthDelimited).string(message.domain);
-        /* string dns_scan_id = 2; */
-        if (message.dnsScanId !== "")
-            writer.tag(2, WireType.LengthDelimited).string(message.dnsScanId);
+    internalBinaryWrite(message: ScanBatchSummary, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* int32 reports = 1; */
+        if (message.reports !== 0)
+            writer.tag(1, WireType.Varint).int32(message.reports);
+        /* double average_score = 2; */
+        if (message.averageScore !== 0)
+            writer.tag(2, WireType.Bit64).double(message.averageScore);
+        /* int32 min_score = 3; */
+        if (message.minScore !== 0)
+            writer.tag(3, WireType.Varint).int32(message.minScore);
+        /* int32 max_score = 4; */
+        if (message.maxScore !== 0)
+            writer.tag(4, WireType.Varint).int32(message.maxScore);
+        /* repeated service.RiskTierCount risk_tiers = 5; */
+        for (let i = 0; i < message.riskTiers.length; i++)
+            RiskTierCount.internalBinaryWrite(message.riskTiers[i], writer.tag(5, WireType.LengthDelimited).fork(), options).join();
+        /* repeated service.ScanBatchFailure failed_domains = 6; */
+        for (let i = 0; i < message.failedDomains.length; i++)
+            ScanBatchFailure.internalBinaryWrite(message.failedDomains[i], writer.tag(6, WireType.LengthDelimited).fork(), options).join();
         let u = options.writeUnknownFields;
         if (u !== false)
             (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
@@ -6957,34 +13550,35 @@ class ScanWhoisRequest$Type extends MessageType<ScanWhoisRequest> {
     }
 }
 /**
- * @generated MessageType for protobuf message service.ScanWhoisRequest
+ * @generated MessageType for protobuf message service.ScanBatchSummary
  */
-export const ScanWhoisRequest = new ScanWhoisRequest$Type();
+export const ScanBatchSummary = new ScanBatchSummary$Type();
 // @generated message type with reflection information, may provide speed optimized methods
-class ScanWhoisResponse$Type extends MessageType<ScanWhoisResponse> {
+class RiskTierCount$Type extends MessageType<RiskTierCount> {
     constructor() {
-        super("service.ScanWhoisResponse", [
-            { no: 1, name: "scan_id", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
-            { no: 2, name: "result", kind: "message", T: () => WhoisSecurityResult }
+        super("service.RiskTierCount", [
+            { no: 1, name: "risk_tier", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 2, name: "count", kind: "scalar", T: 5 /*ScalarType.INT32*/ }
         ]);
     }
-    create(value?: PartialMessage<ScanWhoisResponse>): ScanWhoisResponse {
+    create(value?: PartialMessage<RiskTierCount>): RiskTierCount {
         const message = globalThis.Object.create((this.messagePrototype!));
-        message.scanId = "";
+        message.riskTier = "";
+        message.count = 0;
         if (value !== undefined)
-            reflectionMergePartial<ScanWhoisResponse>(this, message, value);
+            reflectionMergePartial<RiskTierCount>(this, message, value);
         return message;
     }
-    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: ScanWhoisResponse): ScanWhoisResponse {
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: RiskTierCount): RiskTierCount {
         let message = target ?? this.create(), end = reader.pos + length;
         while (reader.pos < end) {
             let [fieldNo, wireType] = reader.tag();
             switch (fieldNo) {
-                case /* string scan_id */ 1:
-                    message.scanId = reader.string();
+                case /* string risk_tier */ 1:
+                    message.riskTier = reader.string();
                     break;
-                case /* service.WhoisSecurityResult result */ 2:
-                    message.result = WhoisSecurityResult.internalBinaryRead(reader, reader.uint32(), options, message.result);
+                case /* int32 count */ 2:
+                    message.count = reader.int32();
                     break;
                 default:
                     let u = options.readUnknownField;
@@ -6997,13 +13591,13 @@ class ScanWhoisResponse$Type extends MessageType<ScanWhoisResponse> {
         }
         return message;
     }
-    internalBinaryWrite(message: ScanWhoisResponse, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
-        /* string scan_id = 1; */
-        if (message.scanId !== "")
-            writer.tag(1, WireType.LengthDelimited).string(message.scanId);
-        /* service.WhoisSecurityResult result = 2; */
-        if (message.result)
-            WhoisSecurityResult.internalBinaryWrite(message.result, writer.tag(2, WireType.LengthDelimited).fork(), options).join();
+    internalBinaryWrite(message: RiskTierCount, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* string risk_tier = 1; */
+        if (message.riskTier !== "")
+            writer.tag(1, WireType.LengthDelimited).string(message.riskTier);
+        /* int32 count = 2; */
+        if (message.count !== 0)
+            writer.tag(2, WireType.Varint).int32(message.count);
         let u = options.writeUnknownFields;
         if (u !== false)
             (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
@@ -7011,24 +13605,28 @@ class ScanWhoisResponse$Type extends MessageType<ScanWhoisResponse> {
     }
 }
 /**
- * @generated MessageType for protobuf message service.ScanWhoisResponse
+ * @generated MessageType for protobuf message service.RiskTierCount
  */
-export const ScanWhoisResponse = new ScanWhoisResponse$Type();
+export const RiskTierCount = new RiskTierCount$Type();
 // @generated message type with reflection information, may provide speed optimized methods
-class GetWhoisScanResultsByDomainRequest$Type extends MessageType<GetWhoisScanResultsByDomainRequest> {
+class ScanBatchFailure$Type extends MessageType<ScanBatchFailure> {
     constructor() {
-        super("service.GetWhoisScanResultsByDomainRequest", [
-            { no: 1, name: "domain", kind: "scalar", T: 9 /*ScalarType.STRING*/ }
+        super("service.ScanBatchFailure", [
+            { no: 1, name: "domain", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 2, name: "job_id", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 3, name: "error", kind: "scalar", T: 9 /*ScalarType.STRING*/ }
         ]);
     }
-    create(value?: PartialMessage<GetWhoisScanResultsByDomainRequest>): GetWhoisScanResultsByDomainRequest {
+    create(value?: PartialMessage<ScanBatchFailure>): ScanBatchFailure {
         const message = globalThis.Object.create((this.messagePrototype!));
         message.domain = "";
+        message.jobId = "";
+        message.error = "";
         if (value !== undefined)
-            reflectionMergePartial<GetWhoisScanResultsByDomainRequest>(this, message, value);
+            reflectionMergePartial<ScanBatchFailure>(this, message, value);
         return message;
     }
-    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: GetWhoisScanResultsByDomainRequest): GetWhoisScanResultsByDomainRequest {
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: ScanBatchFailure): ScanBatchFailure {
         let message = target ?? this.create(), end = reader.pos + length;
         while (reader.pos < end) {
             let [fieldNo, wireType] = reader.tag();
@@ -7036,6 +13634,12 @@ class GetWhoisScanResultsByDomainRequest$Type extends MessageType<GetWhoisScanRe
                 case /* string domain */ 1:
                     message.domain = reader.string();
                     break;
+                case /* string job_id */ 2:
+                    message.jobId = reader.string();
+                    break;
+                case /* string error */ 3:
+                    message.error = reader.string();
+                    break;
                 default:
                     let u = options.readUnknownField;
                     if (u === "throw")
@@ -7047,10 +13651,16 @@ class GetWhoisScanResultsByDomainRequest$Type extends MessageType<GetWhoisScanRe
         }
         return message;
     }
-    internalBinaryWrite(message: GetWhoisScanResultsByDomainRequest, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+    internalBinaryWrite(message: ScanBatchFailure, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
         /* string domain = 1; */
         if (message.domain !== "")
             writer.tag(1, WireType.LengthDelimited).string(message.domain);
+        /* string job_id = 2; */
+        if (message.jobId !== "")
+            writer.tag(2, WireType.LengthDelimited).string(message.jobId);
+        /* string error = 3; */
+        if (message.error !== "")
+            writer.tag(3, WireType.LengthDelimited).string(message.error);
         let u = options.writeUnknownFields;
         if (u !== false)
             (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
@@ -7058,30 +13668,68 @@ class GetWhoisScanResultsByDomainRequest$Type extends MessageType<GetWhoisScanRe
     }
 }
 /**
- * @generated MessageType for protobuf message service.GetWhoisScanResultsByDomainRequest
+ * @generated MessageType for protobuf message service.ScanBatchFailure
  */
-export const GetWhoisScanResultsByDomainRequest = new GetWhoisScanResultsByDomainRequest$Type();
+export const ScanBatchFailure = new ScanBatchFailure$Type();
 // @generated message type with reflection information, may provide speed optimized methods
-class GetWhoisScanResultsByDomainResponse$Type extends MessageType<GetWhoisScanResultsByDomainResponse> {
+class ScanProfile$Type extends MessageType<ScanProfile> {
     constructor() {
-        super("service.GetWhoisScanResultsByDomainResponse", [
-            { no: 1, name: "results", kind: "message", repeat: 2 /*RepeatType.UNPACKED*/, T: () => WhoisScanResult }
+        super("service.ScanProfile", [
+            { no: 1, name: "name", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 2, name: "description", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 3, name: "plugins", kind: "scalar", repeat: 2 /*RepeatType.UNPACKED*/, T: 9 /*ScalarType.STRING*/ },
+            { no: 4, name: "passive_only", kind: "scalar", T: 8 /*ScalarType.BOOL*/ },
+            { no: 5, name: "options", kind: "message", repeat: 2 /*RepeatType.UNPACKED*/, T: () => ScanProfileOption },
+            { no: 6, name: "timeouts", kind: "message", repeat: 2 /*RepeatType.UNPACKED*/, T: () => ScanProfileTimeout },
+            { no: 7, name: "source", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 8, name: "created_at", kind: "message", T: () => Timestamp },
+            { no: 9, name: "updated_at", kind: "message", T: () => Timestamp }
         ]);
     }
-    create(value?: PartialMessage<GetWhoisScanResultsByDomainResponse>): GetWhoisScanResultsByDomainResponse {
+    create(value?: PartialMessage<ScanProfile>): ScanProfile {
         const message = globalThis.Object.create((this.messagePrototype!));
-        message.results = [];
+        message.name = "";
+        message.description = "";
+        message.plugins = [];
+        message.passiveOnly = false;
+        message.options = [];
+        message.timeouts = [];
+        message.source = "";
         if (value !== undefined)
-            reflectionMergePartial<GetWhoisScanResultsByDomainResponse>(this, message, value);
+            reflectionMergePartial<ScanProfile>(this, message, value);
         return message;
     }
-    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: GetWhoisScanResultsByDomainResponse): GetWhoisScanResultsByDomainResponse {
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: ScanProfile): ScanProfile {
         let message = target ?? this.create(), end = reader.pos + length;
         while (reader.pos < end) {
             let [fieldNo, wireType] = reader.tag();
             switch (fieldNo) {
-                case /* repeated service.WhoisScanResult results */ 1:
-                    message.results.push(WhoisScanResult.internalBinaryRead(reader, reader.uint32(), options));
+                case /* string name */ 1:
+                    message.name = reader.string();
+                    break;
+                case /* string description */ 2:
+                    message.description = reader.string();
+                    break;
+                case /* repeated string plugins */ 3:
+                    message.plugins.push(reader.string());
+                    break;
+                case /* bool passive_only */ 4:
+                    message.passiveOnly = reader.bool();
+                    break;
+                case /* repeated service.ScanProfileOption options */ 5:
+                    message.options.push(ScanProfileOption.internalBinaryRead(reader, reader.uint32(), options));
+                    break;
+                case /* repeated service.ScanProfileTimeout timeouts */ 6:
+                    message.timeouts.push(ScanProfileTimeout.internalBinaryRead(reader, reader.uint32(), options));
+                    break;
+                case /* string source */ 7:
+                    message.source = reader.string();
+                    break;
+                case /* google.protobuf.Timestamp created_at */ 8:
+                    message.createdAt = Timestamp.internalBinaryRead(reader, reader.uint32(), options, message.createdAt);
+                    break;
+                case /* google.protobuf.Timestamp updated_at */ 9:
+                    message.updatedAt = Timestamp.internalBinaryRead(reader, reader.uint32(), options, message.updatedAt);
                     break;
                 default:
                     let u = options.readUnknownField;
@@ -7094,10 +13742,34 @@ class GetWhoisScanResultsByDomainResponse$Type extends MessageType<GetWhoisScanR
         }
         return message;
     }
-    internalBinaryWrite(message: GetWhoisScanResultsByDomainResponse, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
-        /* repeated service.WhoisScanResult results = 1; */
-        for (let i = 0; i < message.results.length; i++)
-            WhoisScanResult.internalBinaryWrite(message.results[i], writer.tag(1, WireType.LengthDelimited).fork(), options).join();
+    internalBinaryWrite(message: ScanProfile, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* string name = 1; */
+        if (message.name !== "")
+            writer.tag(1, WireType.LengthDelimited).string(message.name);
+        /* string description = 2; */
+        if (message.description !== "")
+            writer.tag(2, WireType.LengthDelimited).string(message.description);
+        /* repeated string plugins = 3; */
+        for (let i = 0; i < message.plugins.length; i++)
+            writer.tag(3, WireType.LengthDelimited).string(message.plugins[i]);
+        /* bool passive_only = 4; */
+        if (message.passiveOnly !== false)
+            writer.tag(4, WireType.Varint).bool(message.passiveOnly);
+        /* repeated service.ScanProfileOption options = 5; */
+        for (let i = 0; i < message.options.length; i++)
+            ScanProfileOption.internalBinaryWrite(message.options[i], writer.tag(5, WireType.LengthDelimited).fork(), options).join();
+        /* repeated service.ScanProfileTimeout timeouts = 6; */
+        for (let i = 0; i < message.timeouts.length; i++)
+            ScanProfileTimeout.internalBinaryWrite(message.timeouts[i], writer.tag(6, WireType.LengthDelimited).fork(), options).join();
+        /* string source = 7; */
+        if (message.source !== "")
+            writer.tag(7, WireType.LengthDelimited).string(message.source);
+        /* google.protobuf.Timestamp created_at = 8; */
+        if (message.createdAt)
+            Timestamp.internalBinaryWrite(message.createdAt, writer.tag(8, WireType.LengthDelimited).fork(), options).join();
+        /* google.protobuf.Timestamp updated_at = 9; */
+        if (message.updatedAt)
+            Timestamp.internalBinaryWrite(message.updatedAt, writer.tag(9, WireType.LengthDelimited).fork(), options).join();
         let u = options.writeUnknownFields;
         if (u !== false)
             (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
@@ -7105,48 +13777,40 @@ class GetWhoisScanResultsByDomainResponse$Type extends MessageType<GetWhoisScanR
     }
 }
 /**
- * @generated MessageType for protobuf message service.GetWhoisScanResultsByDomainResponse
+ * @generated MessageType for protobuf message service.ScanProfile
  */
-export const GetWhoisScanResultsByDomainResponse = new GetWhoisScanResultsByDomainResponse$Type();
+export const ScanProfile = new ScanProfile$Type();
 // @generated message type with reflection information, may provide speed optimized methods
-class WhoisScanResult$Type extends MessageType<WhoisScanResult> {
+class ScanProfileOption$Type extends MessageType<ScanProfileOption> {
     constructor() {
-        super("service.WhoisScanResult", [
-            { no: 1, name: "id", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
-            { no: 2, name: "domain", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
-            { no: 3, name: "dns_scan_id", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
-            { no: 4, name: "result", kind: "message", T: () => WhoisSecurityResult },
-            { no: 5, name: "created_at", kind: "message", T: () => Timestamp }
+        super("service.ScanProfileOption", [
+            { no: 1, name: "plugin", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 2, name: "key", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 3, name: "value", kind: "scalar", T: 9 /*ScalarType.STRING*/ }
         ]);
     }
-    create(value?: PartialMessage<WhoisScanResult>): WhoisScanResult {
+    create(value?: PartialMessage<ScanProfileOption>): ScanProfileOption {
         const message = globalThis.Object.create((this.messagePrototype!));
-        message.id = "";
-        message.domain = "";
-        message.dnsScanId = "";
+        message.plugin = "";
+        message.key = "";
+        message.value = "";
         if (value !== undefined)
-            reflectionMergePartial<WhoisScanResult>(this, message, value);
+            reflectionMergePartial<ScanProfileOption>(this, message, value);
         return message;
     }
-    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: WhoisScanResult): WhoisScanResult {
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: ScanProfileOption): ScanProfileOption {
         let message = target ?? this.create(), end = reader.pos + length;
         while (reader.pos < end) {
             let [fieldNo, wireType] = reader.tag();
             switch (fieldNo) {
-                case /* string id */ 1:
-                    message.id = reader.string();
-                    break;
-                case /* string domain */ 2:
-                    message.domain = reader.string();
-                    break;
-                case /* string dns_scan_id */ 3:
-                    message.dnsScanId = reader.string();
+                case /* string plugin */ 1:
+                    message.plugin = reader.string();
                     break;
-                case /* service.WhoisSecurityResult result */ 4:
-                    message.result = WhoisSecurityResult.internalBinaryRead(reader, reader.uint32(), options, message.result);
+                case /* string key */ 2:
+                    message.key = reader.string();
                     break;
-                case /* google.protobuf.Timestamp created_at */ 5:
-                    message.createdAt = Timestamp.internalBinaryRead(reader, reader.uint32(), options, message.createdAt);
+                case /* string value */ 3:
+                    message.value = reader.string();
                     break;
                 default:
                     let u = options.readUnknownField;
@@ -7159,22 +13823,16 @@ class WhoisScanResult$Type extends MessageType<WhoisScanResult> {
         }
         return message;
     }
-    internalBinaryWrite(message: WhoisScanResult, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
-        /* string id = 1; */
-        if (message.id !== "")
-            writer.tag(1, WireType.LengthDelimited).string(message.id);
-        /* string domain = 2; */
-        if (message.domain !== "")
-            writer.tag(2, WireType.LengthDelimited).string(message.domain);
-        /* string dns_scan_id = 3; */
-        if (message.dnsScanId !== "")
-            writer.tag(3, WireType.LengthDelimited).string(message.dnsScanId);
-        /* service.WhoisSecurityResult result = 4; */
-        if (message.result)
-            WhoisSecurityResult.internalBinaryWrite(message.result, writer.tag(4, WireType.LengthDelimited).fork(), options).join();
-        /* google.protobuf.Timestamp created_at = 5; */
-        if (message.createdAt)
-            Timestamp.internalBinaryWrite(message.createdAt, writer.tag(5, WireType.LengthDelimited).fork(), options).join();
+    internalBinaryWrite(message: ScanProfileOption, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* string plugin = 1; */
+        if (message.plugin !== "")
+            writer.tag(1, WireType.LengthDelimited).string(message.plugin);
+        /* string key = 2; */
+        if (message.key !== "")
+            writer.tag(2, WireType.LengthDelimited).string(message.key);
+        /* string value = 3; */
+        if (message.value !== "")
+            writer.tag(3, WireType.LengthDelimited).string(message.value);
         let u = options.writeUnknownFields;
         if (u !== false)
             (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
@@ -7182,53 +13840,35 @@ class WhoisScanResult$Type extends MessageType<WhoisScanResult> {
     }
 }
 /**
- * @generated MessageType for protobuf message service.WhoisScanResult
+ * @generated MessageType for protobuf message service.ScanProfileOption
  */
-export const WhoisScanResult = new WhoisScanResult$Type();
+export const ScanProfileOption = new ScanProfileOption$Type();
 // @generated message type with reflection information, may provide speed optimized methods
-class WhoisSecurityResult$Type extends MessageType<WhoisSecurityResult> {
+class ScanProfileTimeout$Type extends MessageType<ScanProfileTimeout> {
     constructor() {
-        super("service.WhoisSecurityResult", [
-            { no: 1, name: "domain", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
-            { no: 2, name: "registrar", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
-            { no: 3, name: "creation_date", kind: "message", T: () => Timestamp },
-            { no: 4, name: "expiry_date", kind: "message", T: () => Timestamp },
-            { no: 5, name: "registrant_name", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
-            { no: 6, name: "errors", kind: "scalar", repeat: 2 /*RepeatType.UNPACKED*/, T: 9 /*ScalarType.STRING*/ }
+        super("service.ScanProfileTimeout", [
+            { no: 1, name: "plugin", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 2, name: "seconds", kind: "scalar", T: 5 /*ScalarType.INT32*/ }
         ]);
     }
-    create(value?: PartialMessage<WhoisSecurityResult>): WhoisSecurityResult {
+    create(value?: PartialMessage<ScanProfileTimeout>): ScanProfileTimeout {
         const message = globalThis.Object.create((this.messagePrototype!));
-        message.domain = "";
-        message.registrar = "";
-        message.registrantName = "";
-        message.errors = [];
+        message.plugin = "";
+        message.seconds = 0;
         if (value !== undefined)
-            reflectionMergePartial<WhoisSecurityResult>(this, message, value);
+            reflectionMergePartial<ScanProfileTimeout>(this, message, value);
         return message;
     }
-    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: WhoisSecurityResult): WhoisSecurityResult {
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: ScanProfileTimeout): ScanProfileTimeout {
         let message = target ?? this.create(), end = reader.pos + length;
         while (reader.pos < end) {
             let [fieldNo, wireType] = reader.tag();
             switch (fieldNo) {
-                case /* string domain */ 1:
-                    message.domain = reader.string();
-                    break;
-                case /* string registrar */ 2:
-                    message.registrar = reader.string();
-                    break;
-                case /* google.protobuf.Timestamp creation_date */ 3:
-                    message.creationDate = Timestamp.internalBinaryRead(reader, reader.uint32(), options, message.creationDate);
-                    break;
-                case /* google.protobuf.Timestamp expiry_date */ 4:
-                    message.expiryDate = Timestamp.internalBinaryRead(reader, reader.uint32(), options, message.expiryDate);
+                case /* string plugin */ 1:
+                    message.plugin = reader.string();
                     break;
-                case /* string registrant_name */ 5:
-                    message.registrantName = reader.string();
-                    break;
-                case /* repeated string errors */ 6:
-                    message.errors.push(reader.string());
+                case /* int32 seconds */ 2:
+                    message.seconds = reader.int32();
                     break;
                 default:
                     let u = options.readUnknownField;
@@ -7241,25 +13881,13 @@ class WhoisSecurityResult$Type extends MessageType<WhoisSecurityResult> {
         }
         return message;
     }
-    internalBinaryWrite(message: WhoisSecurityResult, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
-        /* string domain = 1; */
-        if (message.domain !== "")
-            writer.tag(1, WireType.LengthDelimited).string(message.domain);
-        /* string registrar = 2; */
-        if (message.registrar !== "")
-            writer.tag(2, WireType.LengthDelimited).string(message.registrar);
-        /* google.protobuf.Timestamp creation_date = 3; */
-        if (message.creationDate)
-            Timestamp.internalBinaryWrite(message.creationDate, writer.tag(3, WireType.LengthDelimited).fork(), options).join();
-        /* google.protobuf.Timestamp expiry_date = 4; */
-        if (message.expiryDate)
-            Timestamp.internalBinaryWrite(message.expiryDate, writer.tag(4, WireType.LengthDelimited).fork(), options).join();
-        /* string registrant_name = 5; */
-        if (message.registrantName !== "")
-            writer.tag(5, WireType.LengthDelimited).string(message.registrantName);
-        /* repeated string errors = 6; */
-        for (let i = 0; i < message.errors.length; i++)
-            writer.tag(6, WireType.LengthDelimited).string(message.errors[i]);
+    internalBinaryWrite(message: ScanProfileTimeout, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* string plugin = 1; */
+        if (message.plugin !== "")
+            writer.tag(1, WireType.LengthDelimited).string(message.plugin);
+        /* int32 seconds = 2; */
+        if (message.seconds !== 0)
+            writer.tag(2, WireType.Varint).int32(message.seconds);
         let u = options.writeUnknownFields;
         if (u !== false)
             (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
@@ -7267,63 +13895,29 @@ class WhoisSecurityResult$Type extends MessageType<WhoisSecurityResult> {
     }
 }
 /**
- * @generated MessageType for protobuf message service.WhoisSecurityResult
+ * @generated MessageType for protobuf message service.ScanProfileTimeout
  */
-export const WhoisSecurityResult = new WhoisSecurityResult$Type();
+export const ScanProfileTimeout = new ScanProfileTimeout$Type();
 // @generated message type with reflection information, may provide speed optimized methods
-class AbuseChIOC$Type extends MessageType<AbuseChIOC> {
+class SaveScanProfileRequest$Type extends MessageType<SaveScanProfileRequest> {
     constructor() {
-        super("service.AbuseChIOC", [
-            { no: 1, name: "ioc_type", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
-            { no: 2, name: "ioc_value", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
-            { no: 3, name: "threat_type", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
-            { no: 4, name: "confidence", kind: "scalar", T: 2 /*ScalarType.FLOAT*/ },
-            { no: 5, name: "first_seen", kind: "message", T: () => Timestamp },
-            { no: 6, name: "last_seen", kind: "message", T: () => Timestamp },
-            { no: 7, name: "malware_alias", kind: "scalar", repeat: 2 /*RepeatType.UNPACKED*/, T: 9 /*ScalarType.STRING*/ },
-            { no: 8, name: "tags", kind: "scalar", repeat: 2 /*RepeatType.UNPACKED*/, T: 9 /*ScalarType.STRING*/ }
+        super("service.SaveScanProfileRequest", [
+            { no: 1, name: "profile", kind: "message", T: () => ScanProfile }
         ]);
     }
-    create(value?: PartialMessage<AbuseChIOC>): AbuseChIOC {
+    create(value?: PartialMessage<SaveScanProfileRequest>): SaveScanProfileRequest {
         const message = globalThis.Object.create((this.messagePrototype!));
-        message.iocType = "";
-        message.iocValue = "";
-        message.threatType = "";
-        message.confidence = 0;
-        message.malwareAlias = [];
-        message.tags = [];
         if (value !== undefined)
-            reflectionMergePartial<AbuseChIOC>(this, message, value);
+            reflectionMergePartial<SaveScanProfileRequest>(this, message, value);
         return message;
     }
-    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: AbuseChIOC): AbuseChIOC {
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: SaveScanProfileRequest): SaveScanProfileRequest {
         let message = target ?? this.create(), end = reader.pos + length;
         while (reader.pos < end) {
             let [fieldNo, wireType] = reader.tag();
             switch (fieldNo) {
-                case /* string ioc_type */ 1:
-                    message.iocType = reader.string();
-                    break;
-                case /* string ioc_value */ 2:
-                    message.iocValue = reader.string();
-                    break;
-                case /* string threat_type */ 3:
-                    message.threatType = reader.string();
-                    break;
-                case /* float confidence */ 4:
-                    message.confidence = reader.float();
-                    break;
-                case /* google.protobuf.Timestamp first_seen */ 5:
-                    message.firstSeen = Timestamp.internalBinaryRead(reader, reader.uint32(), options, message.firstSeen);
-                    break;
-                case /* google.protobuf.Timestamp last_seen */ 6:
-                    message.lastSeen = Timestamp.internalBinaryRead(reader, reader.uint32(), options, message.lastSeen);
-                    break;
-                case /* repeated string malware_alias */ 7:
-                    message.malwareAlias.push(reader.string());
-                    break;
-                case /* repeated string tags */ 8:
-                    message.tags.push(reader.string());
+                case /* service.ScanProfile profile */ 1:
+                    message.profile = ScanProfile.internalBinaryRead(reader, reader.uint32(), options, message.profile);
                     break;
                 default:
                     let u = options.readUnknownField;
@@ -7336,31 +13930,10 @@ class AbuseChIOC$Type extends MessageType<AbuseChIOC> {
         }
         return message;
     }
-    internalBinaryWrite(message: AbuseChIOC, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
-        /* string ioc_type = 1; */
-        if (message.iocType !== "")
-            writer.tag(1, WireType.LengthDelimited).string(message.iocType);
-        /* string ioc_value = 2; */
-        if (message.iocValue !== "")
-            writer.tag(2, WireType.LengthDelimited).string(message.iocValue);
-        /* string threat_type = 3; */
-        if (message.threatType !== "")
-            writer.tag(3, WireType.LengthDelimited).string(message.threatType);
-        /* float confidence = 4; */
-        if (message.confidence !== 0)
-            writer.tag(4, WireType.Bit32).float(message.confidence);
-        /* google.protobuf.Timestamp first_seen = 5; */
-        if (message.firstSeen)
-            Timestamp.internalBinaryWrite(message.firstSeen, writer.tag(5, WireType.LengthDelimited).fork(), options).join();
-        /* google.protobuf.Timestamp last_seen = 6; */
-        if (message.lastSeen)
-            Timestamp.internalBinaryWrite(message.lastSeen, writer.tag(6, WireType.LengthDelimited).fork(), options).join();
-        /* repeated string malware_alias = 7; */
-        for (let i = 0; i < message.malwareAlias.length; i++)
-            writer.tag(7, WireType.LengthDelimited).string(message.malwareAlias[i]);
-        /* repeated string tags = 8; */
-        for (let i = 0; i < message.tags.length; i++)
-            writer.tag(8, WireType.LengthDelimited).string(message.tags[i]);
+    internalBinaryWrite(message: SaveScanProfileRequest, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* service.ScanProfile profile = 1; */
+        if (message.profile)
+            ScanProfile.internalBinaryWrite(message.profile, writer.tag(1, WireType.LengthDelimited).fork(), options).join();
         let u = options.writeUnknownFields;
         if (u !== false)
             (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
@@ -7368,35 +13941,29 @@ class AbuseChIOC$Type extends MessageType<AbuseChIOC> {
     }
 }
 /**
- * @generated MessageType for protobuf message service.AbuseChIOC
+ * @generated MessageType for protobuf message service.SaveScanProfileRequest
  */
-export const AbuseChIOC = new AbuseChIOC$Type();
+export const SaveScanProfileRequest = new SaveScanProfileRequest$Type();
 // @generated message type with reflection information, may provide speed optimized methods
-class AbuseChSecurityResult$Type extends MessageType<AbuseChSecurityResult> {
+class SaveScanProfileResponse$Type extends MessageType<SaveScanProfileResponse> {
     constructor() {
-        super("service.AbuseChSecurityResult", [
-            { no: 1, name: "iocs", kind: "message", repeat: 2 /*RepeatType.UNPACKED*/, T: () => AbuseChIOC },
-            { no: 2, name: "errors", kind: "scalar", repeat: 2 /*RepeatType.UNPACKED*/, T: 9 /*ScalarType.STRING*/ }
+        super("service.SaveScanProfileResponse", [
+            { no: 1, name: "profile", kind: "message", T: () => ScanProfile }
         ]);
     }
-    create(value?: PartialMessage<AbuseChSecurityResult>): AbuseChSecurityResult {
+    create(value?: PartialMessage<SaveScanProfileResponse>): SaveScanProfileResponse {
         const message = globalThis.Object.create((this.messagePrototype!));
-        message.iocs = [];
-        message.errors = [];
         if (value !== undefined)
-            reflectionMergePartial<AbuseChSecurityResult>(this, message, value);
+            reflectionMergePartial<SaveScanProfileResponse>(this, message, value);
         return message;
     }
-    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: AbuseChSecurityResult): AbuseChSecurityResult {
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: SaveScanProfileResponse): SaveScanProfileResponse {
         let message = target ?? this.create(), end = reader.pos + length;
         while (reader.pos < end) {
             let [fieldNo, wireType] = reader.tag();
             switch (fieldNo) {
-                case /* repeated service.AbuseChIOC iocs */ 1:
-                    message.iocs.push(AbuseChIOC.internalBinaryRead(reader, reader.uint32(), options));
-                    break;
-                case /* repeated string errors */ 2:
-                    message.errors.push(reader.string());
+                case /* service.ScanProfile profile */ 1:
+                    message.profile = ScanProfile.internalBinaryRead(reader, reader.uint32(), options, message.profile);
                     break;
                 default:
                     let u = options.readUnknownField;
@@ -7409,13 +13976,10 @@ class AbuseChSecurityResult$Type extends MessageType<AbuseChSecurityResult> {
         }
         return message;
     }
-    internalBinaryWrite(message: AbuseChSecurityResult, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
-        /* repeated service.AbuseChIOC iocs = 1; */
-        for (let i = 0; i < message.iocs.length; i++)
-            AbuseChIOC.internalBinaryWrite(message.iocs[i], writer.tag(1, WireType.LengthDelimited).fork(), options).join();
-        /* repeated string errors = 2; */
-        for (let i = 0; i < message.errors.length; i++)
-            writer.tag(2, WireType.LengthDelimited).string(message.errors[i]);
+    internalBinaryWrite(message: SaveScanProfileResponse, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* service.ScanProfile profile = 1; */
+        if (message.profile)
+            ScanProfile.internalBinaryWrite(message.profile, writer.tag(1, WireType.LengthDelimited).fork(), options).join();
         let u = options.writeUnknownFields;
         if (u !== false)
             (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
@@ -7423,36 +13987,25 @@ class AbuseChSecurityResult$Type extends MessageType<AbuseChSecurityResult> {
     }
 }
 /**
- * @generated MessageType for protobuf message service.AbuseChSecurityResult
+ * @generated MessageType for protobuf message service.SaveScanProfileResponse
  */
-export const AbuseChSecurityResult = new AbuseChSecurityResult$Type();
+export const SaveScanProfileResponse = new SaveScanProfileResponse$Type();
 // @generated message type with reflection information, may provide speed optimized methods
-class ScanAbuseChRequest$Type extends MessageType<ScanAbuseChRequest> {
+class ListScanProfilesRequest$Type extends MessageType<ListScanProfilesRequest> {
     constructor() {
-        super("service.ScanAbuseChRequest", [
-            { no: 1, name: "domain", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
-            { no: 2, name: "dns_scan_id", kind: "scalar", T: 9 /*ScalarType.STRING*/ }
-        ]);
+        super("service.ListScanProfilesRequest", []);
     }
-    create(value?: PartialMessage<ScanAbuseChRequest>): ScanAbuseChRequest {
+    create(value?: PartialMessage<ListScanProfilesRequest>): ListScanProfilesRequest {
         const message = globalThis.Object.create((this.messagePrototype!));
-        message.domain = "";
-        message.dnsScanId = "";
         if (value !== undefined)
-            reflectionMergePartial<ScanAbuseChRequest>(this, message, value);
+            reflectionMergePartial<ListScanProfilesRequest>(this, message, value);
         return message;
     }
-    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: ScanAbuseChRequest): ScanAbuseChRequest {
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: ListScanProfilesRequest): ListScanProfilesRequest {
         let message = target ?? this.create(), end = reader.pos + length;
         while (reader.pos < end) {
             let [fieldNo, wireType] = reader.tag();
             switch (fieldNo) {
-                case /* string domain */ 1:
-                    message.domain = reader.string();
-                    break;
-                case /* string dns_scan_id */ 2:
-                    message.dnsScanId = reader.string();
-                    break;
                 default:
                     let u = options.readUnknownField;
                     if (u === "throw")
@@ -7464,13 +14017,7 @@ class ScanAbuseChRequest$Type extends MessageType<ScanAbuseChRequest> {
         }
         return message;
     }
-    internalBinaryWrite(message: ScanAbuseChRequest, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
-        /* string domain = 1; */
-        if (message.domain !== "")
-            writer.tag(1, WireType.LengthDelimited).string(message.domain);
-        /* string dns_scan_id = 2; */
-        if (message.dnsScanId !== "")
-            writer.tag(2, WireType.LengthDelimited).string(message.dnsScanId);
+    internalBinaryWrite(message: ListScanProfilesRequest, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
         let u = options.writeUnknownFields;
         if (u !== false)
             (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
@@ -7478,34 +14025,30 @@ class ScanAbuseChRequest$Type extends MessageType<ScanAbuseChRequest> {
     }
 }
 /**
- * @generated MessageType for protobuf message service.ScanAbuseChRequest
+ * @generated MessageType for protobuf message service.ListScanProfilesRequest
  */
-export const ScanAbuseChRequest = new ScanAbuseChRequest$Type();
+export const ListScanProfilesRequest = new ListScanProfilesRequest$Type();
 // @generated message type with reflection information, may provide speed optimized methods
-class ScanAbuseChResponse$Type extends MessageType<ScanAbuseChResponse> {
+class ListScanProfilesResponse$Type extends MessageType<ListScanProfilesResponse> {
     constructor() {
-        super("service.ScanAbuseChResponse", [
-            { no: 1, name: "scan_id", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
-            { no: 2, name: "result", kind: "message", T: () => AbuseChSecurityResult }
+        super("service.ListScanProfilesResponse", [
+            { no: 1, name: "profiles", kind: "message", repeat: 2 /*RepeatType.UNPACKED*/, T: () => ScanProfile }
         ]);
     }
-    create(value?: PartialMessage<ScanAbuseChResponse>): ScanAbuseChResponse {
+    create(value?: PartialMessage<ListScanProfilesResponse>): ListScanProfilesResponse {
         const message = globalThis.Object.create((this.messagePrototype!));
-        message.scanId = "";
+        message.profiles = [];
         if (value !== undefined)
-            reflectionMergePartial<ScanAbuseChResponse>(this, message, value);
+            reflectionMergePartial<ListScanProfilesResponse>(this, message, value);
         return message;
     }
-    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: ScanAbuseChResponse): ScanAbuseChResponse {
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: ListScanProfilesResponse): ListScanProfilesResponse {
         let message = target ?? this.create(), end = reader.pos + length;
         while (reader.pos < end) {
             let [fieldNo, wireType] = reader.tag();
             switch (fieldNo) {
-                case /* string scan_id */ 1:
-                    message.scanId = reader.string();
-                    break;
-                case /* service.AbuseChSecurityResult result */ 2:
-                    message.result = AbuseChSecurityResult.internalBinaryRead(reader, reader.uint32(), options, message.result);
+                case /* repeated service.ScanProfile profiles */ 1:
+                    message.profiles.push(ScanProfile.internalBinaryRead(reader, reader.uint32(), options));
                     break;
                 default:
                     let u = options.readUnknownField;
@@ -7518,13 +14061,10 @@ class ScanAbuseChResponse$Type extends MessageType<ScanAbuseChResponse> {
         }
         return message;
     }
-    internalBinaryWrite(message: ScanAbuseChResponse, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
-        /* string scan_id = 1; */
-        if (message.scanId !== "")
-            writer.tag(1, WireType.LengthDelimited).string(message.scanId);
-        /* service.AbuseChSecurityResult result = 2; */
-        if (message.result)
-            AbuseChSecurityResult.internalBinaryWrite(message.result, writer.tag(2, WireType.LengthDelimited).fork(), options).join();
+    internalBinaryWrite(message: ListScanProfilesResponse, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* repeated service.ScanProfile profiles = 1; */
+        for (let i = 0; i < message.profiles.length; i++)
+            ScanProfile.internalBinaryWrite(message.profiles[i], writer.tag(1, WireType.LengthDelimited).fork(), options).join();
         let u = options.writeUnknownFields;
         if (u !== false)
             (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
@@ -7532,30 +14072,30 @@ class ScanAbuseChResponse$Type extends MessageType<ScanAbuseChResponse> {
     }
 }
 /**
- * @generated MessageType for protobuf message service.ScanAbuseChResponse
+ * @generated MessageType for protobuf message service.ListScanProfilesResponse
  */
-export const ScanAbuseChResponse = new ScanAbuseChResponse$Type();
+export const ListScanProfilesResponse = new ListScanProfilesResponse$Type();
 // @generated message type with reflection information, may provide speed optimized methods
-class GetAbuseChScanResultsByDomainRequest$Type extends MessageType<GetAbuseChScanResultsByDomainRequest> {
+class DeleteScanProfileRequest$Type extends MessageType<DeleteScanProfileRequest> {
     constructor() {
-        super("service.GetAbuseChScanResultsByDomainRequest", [
-            { no: 1, name: "domain", kind: "scalar", T: 9 /*ScalarType.STRING*/ }
+        super("service.DeleteScanProfileRequest", [
+            { no: 1, name: "name", kind: "scalar", T: 9 /*ScalarType.STRING*/ }
         ]);
     }
-    create(value?: PartialMessage<GetAbuseChScanResultsByDomainRequest>): GetAbuseChScanResultsByDomainRequest {
+    create(value?: PartialMessage<DeleteScanProfileRequest>): DeleteScanProfileRequest {
         const message = globalThis.Object.create((this.messagePrototype!));
-        message.domain = "";
+        message.name = "";
         if (value !== undefined)
-            reflectionMergePartial<GetAbuseChScanResultsByDomainRequest>(this, message, value);
+            reflectionMergePartial<DeleteScanProfileRequest>(this, message, value);
         return message;
     }
-    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: GetAbuseChScanResultsByDomainRequest): GetAbuseChScanResultsByDomainRequest {
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: DeleteScanProfileRequest): DeleteScanProfileRequest {
         let message = target ?? this.create(), end = reader.pos + length;
         while (reader.pos < end) {
             let [fieldNo, wireType] = reader.tag();
             switch (fieldNo) {
-                case /* string domain */ 1:
-                    message.domain = reader.string();
+                case /* string name */ 1:
+                    message.name = reader.string();
                     break;
                 default:
                     let u = options.readUnknownField;
@@ -7568,10 +14108,10 @@ class GetAbuseChScanResultsByDomainRequest$Type extends MessageType<GetAbuseChSc
         }
         return message;
     }
-    internalBinaryWrite(message: GetAbuseChScanResultsByDomainRequest, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
-        /* string domain = 1; */
-        if (message.domain !== "")
-            writer.tag(1, WireType.LengthDelimited).string(message.domain);
+    internalBinaryWrite(message: DeleteScanProfileRequest, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* string name = 1; */
+        if (message.name !== "")
+            writer.tag(1, WireType.LengthDelimited).string(message.name);
         let u = options.writeUnknownFields;
         if (u !== false)
             (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
@@ -7579,31 +14119,25 @@ class GetAbuseChScanResultsByDomainRequest$Type extends MessageType<GetAbuseChSc
     }
 }
 /**
- * @generated MessageType for protobuf message service.GetAbuseChScanResultsByDomainRequest
+ * @generated MessageType for protobuf message service.DeleteScanProfileRequest
  */
-export const GetAbuseChScanResultsByDomainRequest = new GetAbuseChScanResultsByDomainRequest$Type();
+export const DeleteScanProfileRequest = new DeleteScanProfileRequest$Type();
 // @generated message type with reflection information, may provide speed optimized methods
-class GetAbuseChScanResultsByDomainResponse$Type extends MessageType<GetAbuseChScanResultsByDomainResponse> {
+class DeleteScanProfileResponse$Type extends MessageType<DeleteScanProfileResponse> {
     constructor() {
-        super("service.GetAbuseChScanResultsByDomainResponse", [
-            { no: 1, name: "results", kind: "message", repeat: 2 /*RepeatType.UNPACKED*/, T: () => AbuseChScanResult }
-        ]);
+        super("service.DeleteScanProfileResponse", []);
     }
-    create(value?: PartialMessage<GetAbuseChScanResultsByDomainResponse>): GetAbuseChScanResultsByDomainResponse {
+    create(value?: PartialMessage<DeleteScanProfileResponse>): DeleteScanProfileResponse {
         const message = globalThis.Object.create((this.messagePrototype!));
-        message.results = [];
         if (value !== undefined)
-            reflectionMergePartial<GetAbuseChScanResultsByDomainResponse>(this, message, value);
+            reflectionMergePartial<DeleteScanProfileResponse>(this, message, value);
         return message;
     }
-    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: GetAbuseChScanResultsByDomainResponse): GetAbuseChScanResultsByDomainResponse {
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: DeleteScanProfileResponse): DeleteScanProfileResponse {
         let message = target ?? this.create(), end = reader.pos + length;
         while (reader.pos < end) {
             let [fieldNo, wireType] = reader.tag();
             switch (fieldNo) {
-                case /* repeated service.AbuseChScanResult results */ 1:
-                    message.results.push(AbuseChScanResult.internalBinaryRead(reader, reader.uint32(), options));
-                    break;
                 default:
                     let u = options.readUnknownField;
                     if (u === "throw")
@@ -7615,10 +14149,7 @@ class GetAbuseChScanResultsByDomainResponse$Type extends MessageType<GetAbuseChS
         }
         return message;
     }
-    internalBinaryWrite(message: GetAbuseChScanResultsByDomainResponse, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
-        /* repeated service.AbuseChScanResult results = 1; */
-        for (let i = 0; i < message.results.length; i++)
-            AbuseChScanResult.internalBinaryWrite(message.results[i], writer.tag(1, WireType.LengthDelimited).fork(), options).join();
+    internalBinaryWrite(message: DeleteScanProfileResponse, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
         let u = options.writeUnknownFields;
         if (u !== false)
             (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
@@ -7626,48 +14157,30 @@ class GetAbuseChScanResultsByDomainResponse$Type extends MessageType<GetAbuseChS
     }
 }
 /**
- * @generated MessageType for protobuf message service.GetAbuseChScanResultsByDomainResponse
+ * @generated MessageType for protobuf message service.DeleteScanProfileResponse
  */
-export const GetAbuseChScanResultsByDomainResponse = new GetAbuseChScanResultsByDomainResponse$Type();
+export const DeleteScanProfileResponse = new DeleteScanProfileResponse$Type();
 // @generated message type with reflection information, may provide speed optimized methods
-class AbuseChScanResult$Type extends MessageType<AbuseChScanResult> {
+class GetProviderUsageRequest$Type extends MessageType<GetProviderUsageRequest> {
     constructor() {
-        super("service.AbuseChScanResult", [
-            { no: 1, name: "id", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
-            { no: 2, name: "domain", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
-            { no: 3, name: "dns_scan_id", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
-            { no: 4, name: "result", kind: "message", T: () => AbuseChSecurityResult },
-            { no: 5, name: "created_at", kind: "message", T: () => Timestamp }
+        super("service.GetProviderUsageRequest", [
+            { no: 1, name: "provider", kind: "scalar", T: 9 /*ScalarType.STRING*/ }
         ]);
     }
-    create(value?: PartialMessage<AbuseChScanResult>): AbuseChScanResult {
+    create(value?: PartialMessage<GetProviderUsageRequest>): GetProviderUsageRequest {
         const message = globalThis.Object.create((this.messagePrototype!));
-        message.id = "";
-        message.domain = "";
-        message.dnsScanId = "";
+        message.provider = "";
         if (value !== undefined)
-            reflectionMergePartial<AbuseChScanResult>(this, message, value);
+            reflectionMergePartial<GetProviderUsageRequest>(this, message, value);
         return message;
     }
-    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: AbuseChScanResult): AbuseChScanResult {
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: GetProviderUsageRequest): GetProviderUsageRequest {
         let message = target ?? this.create(), end = reader.pos + length;
         while (reader.pos < end) {
             let [fieldNo, wireType] = reader.tag();
             switch (fieldNo) {
-                case /* string id */ 1:
-                    message.id = reader.string();
-                    break;
-                case /* string domain */ 2:
-                    message.domain = reader.string();
-                    break;
-                case /* string dns_scan_id */ 3:
-                    message.dnsScanId = reader.string();
-                    break;
-                case /* service.AbuseChSecurityResult result */ 4:
-                    message.result = AbuseChSecurityResult.internalBinaryRead(reader, reader.uint32(), options, message.result);
-                    break;
-                case /* google.protobuf.Timestamp created_at */ 5:
-                    message.createdAt = Timestamp.internalBinaryRead(reader, reader.uint32(), options, message.createdAt);
+                case /* string provider */ 1:
+                    message.provider = reader.string();
                     break;
                 default:
                     let u = options.readUnknownField;
@@ -7680,22 +14193,10 @@ class AbuseChScanResult$Type extends MessageType<AbuseChScanResult> {
         }
         return message;
     }
-    internalBinaryWrite(message: AbuseChScanResult, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
-        /* string id = 1; */
-        if (message.id !== "")
-            writer.tag(1, WireType.LengthDelimited).string(message.id);
-        /* string domain = 2; */
-        if (message.domain !== "")
-            writer.tag(2, WireType.LengthDelimited).string(message.domain);
-        /* string dns_scan_id = 3; */
-        if (message.dnsScanId !== "")
-            writer.tag(3, WireType.LengthDelimited).string(message.dnsScanId);
-        /* service.AbuseChSecurityResult result = 4; */
-        if (message.result)
-            AbuseChSecurityResult.internalBinaryWrite(message.result, writer.tag(4, WireType.LengthDelimited).fork(), options).join();
-        /* google.protobuf.Timestamp created_at = 5; */
-        if (message.createdAt)
-            Timestamp.internalBinaryWrite(message.createdAt, writer.tag(5, WireType.LengthDelimited).fork(), options).join();
+    internalBinaryWrite(message: GetProviderUsageRequest, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* string provider = 1; */
+        if (message.provider !== "")
+            writer.tag(1, WireType.LengthDelimited).string(message.provider);
         let u = options.writeUnknownFields;
         if (u !== false)
             (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
@@ -7703,35 +14204,30 @@ class AbuseChScanResult$Type extends MessageType<AbuseChScanResult> {
     }
 }
 /**
- * @generated MessageType for protobuf message service.AbuseChScanResult
+ * @generated MessageType for protobuf message service.GetProviderUsageRequest
  */
-export const AbuseChScanResult = new AbuseChScanResult$Type();
+export const GetProviderUsageRequest = new GetProviderUsageRequest$Type();
 // @generated message type with reflection information, may provide speed optimized methods
-class ScanISCRequest$Type extends MessageType<ScanISCRequest> {
+class GetProviderUsageResponse$Type extends MessageType<GetProviderUsageResponse> {
     constructor() {
-        super("service.ScanISCRequest", [
-            { no: 1, name: "domain", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
-            { no: 2, name: "dns_scan_id", kind: "scalar", T: 9 /*ScalarType.STRING*/ }
+        super("service.GetProviderUsageResponse", [
+            { no: 1, name: "providers", kind: "message", repeat: 2 /*RepeatType.UNPACKED*/, T: () => ProviderUsage }
         ]);
     }
-    create(value?: PartialMessage<ScanISCRequest>): ScanISCRequest {
+    create(value?: PartialMessage<GetProviderUsageResponse>): GetProviderUsageResponse {
         const message = globalThis.Object.create((this.messagePrototype!));
-        message.domain = "";
-        message.dnsScanId = "";
+        message.providers = [];
         if (value !== undefined)
-            reflectionMergePartial<ScanISCRequest>(this, message, value);
+            reflectionMergePartial<GetProviderUsageResponse>(this, message, value);
         return message;
     }
-    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: ScanISCRequest): ScanISCRequest {
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: GetProviderUsageResponse): GetProviderUsageResponse {
         let message = target ?? this.create(), end = reader.pos + length;
         while (reader.pos < end) {
             let [fieldNo, wireType] = reader.tag();
             switch (fieldNo) {
-                case /* string domain */ 1:
-                    message.domain = reader.string();
-                    break;
-                case /* string dns_scan_id */ 2:
-                    message.dnsScanId = reader.string();
+                case /* repeated service.ProviderUsage providers */ 1:
+                    message.providers.push(ProviderUsage.internalBinaryRead(reader, reader.uint32(), options));
                     break;
                 default:
                     let u = options.readUnknownField;
@@ -7744,13 +14240,10 @@ class ScanISCRequest$Type extends MessageType<ScanISCRequest> {
         }
         return message;
     }
-    internalBinaryWrite(message: ScanISCRequest, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
-        /* string domain = 1; */
-        if (message.domain !== "")
-            writer.tag(1, WireType.LengthDelimited).string(message.domain);
-        /* string dns_scan_id = 2; */
-        if (message.dnsScanId !== "")
-            writer.tag(2, WireType.LengthDelimited).string(message.dnsScanId);
+    internalBinaryWrite(message: GetProviderUsageResponse, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* repeated service.ProviderUsage providers = 1; */
+        for (let i = 0; i < message.providers.length; i++)
+            ProviderUsage.internalBinaryWrite(message.providers[i], writer.tag(1, WireType.LengthDelimited).fork(), options).join();
         let u = options.writeUnknownFields;
         if (u !== false)
             (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
@@ -7758,34 +14251,65 @@ class ScanISCRequest$Type extends MessageType<ScanISCRequest> {
     }
 }
 /**
- * @generated MessageType for protobuf message service.ScanISCRequest
+ * @generated MessageType for protobuf message service.GetProviderUsageResponse
  */
-export const ScanISCRequest = new ScanISCRequest$Type();
+export const GetProviderUsageResponse = new GetProviderUsageResponse$Type();
 // @generated message type with reflection information, may provide speed optimized methods
-class ScanISCResponse$Type extends MessageType<ScanISCResponse> {
+class ProviderUsage$Type extends MessageType<ProviderUsage> {
     constructor() {
-        super("service.ScanISCResponse", [
-            { no: 1, name: "scan_id", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
-            { no: 2, name: "result", kind: "message", T: () => ISCSecurityResult }
+        super("service.ProviderUsage", [
+            { no: 1, name: "provider", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 2, name: "daily_calls", kind: "scalar", T: 5 /*ScalarType.INT32*/ },
+            { no: 3, name: "daily_limit", kind: "scalar", T: 5 /*ScalarType.INT32*/ },
+            { no: 4, name: "daily_remaining", kind: "scalar", T: 5 /*ScalarType.INT32*/ },
+            { no: 5, name: "monthly_calls", kind: "scalar", T: 5 /*ScalarType.INT32*/ },
+            { no: 6, name: "monthly_limit", kind: "scalar", T: 5 /*ScalarType.INT32*/ },
+            { no: 7, name: "monthly_remaining", kind: "scalar", T: 5 /*ScalarType.INT32*/ },
+            { no: 8, name: "exhausted", kind: "scalar", T: 8 /*ScalarType.BOOL*/ }
         ]);
     }
-    create(value?: PartialMessage<ScanISCResponse>): ScanISCResponse {
+    create(value?: PartialMessage<ProviderUsage>): ProviderUsage {
         const message = globalThis.Object.create((this.messagePrototype!));
-        message.scanId = "";
+        message.provider = "";
+        message.dailyCalls = 0;
+        message.dailyLimit = 0;
+        message.dailyRemaining = 0;
+        message.monthlyCalls = 0;
+        message.monthlyLimit = 0;
+        message.monthlyRemaining = 0;
+        message.exhausted = false;
         if (value !== undefined)
-            reflectionMergePartial<ScanISCResponse>(this, message, value);
+            reflectionMergePartial<ProviderUsage>(this, message, value);
         return message;
     }
-    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: ScanISCResponse): ScanISCResponse {
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: ProviderUsage): ProviderUsage {
         let message = target ?? this.create(), end = reader.pos + length;
         while (reader.pos < end) {
             let [fieldNo, wireType] = reader.tag();
             switch (fieldNo) {
-                case /* string scan_id */ 1:
-                    message.scanId = reader.string();
+                case /* string provider */ 1:
+                    message.provider = reader.string();
                     break;
-                case /* service.ISCSecurityResult result */ 2:
-                    message.result = ISCSecurityResult.internalBinaryRead(reader, reader.uint32(), options, message.result);
+                case /* int32 daily_calls */ 2:
+                    message.dailyCalls = reader.int32();
+                    break;
+                case /* int32 daily_limit */ 3:
+                    message.dailyLimit = reader.int32();
+                    break;
+                case /* int32 daily_remaining */ 4:
+                    message.dailyRemaining = reader.int32();
+                    break;
+                case /* int32 monthly_calls */ 5:
+                    message.monthlyCalls = reader.int32();
+                    break;
+                case /* int32 monthly_limit */ 6:
+                    message.monthlyLimit = reader.int32();
+                    break;
+                case /* int32 monthly_remaining */ 7:
+                    message.monthlyRemaining = reader.int32();
+                    break;
+                case /* bool exhausted */ 8:
+                    message.exhausted = reader.bool();
                     break;
                 default:
                     let u = options.readUnknownField;
@@ -7798,13 +14322,31 @@ class ScanISCResponse$Type extends MessageType<ScanISCResponse> {
         }
         return message;
     }
-    internalBinaryWrite(message: ScanISCResponse, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
-        /* string scan_id = 1; */
-        if (message.scanId !== "")
-            writer.tag(1, WireType.LengthDelimited).string(message.scanId);
-        /* service.ISCSecurityResult result = 2; */
-        if (message.result)
-            ISCSecurityResult.internalBinaryWrite(message.result, writer.tag(2, WireType.LengthDelimited).fork(), options).join();
+    internalBinaryWrite(message: ProviderUsage, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* string provider = 1; */
+        if (message.provider !== "")
+            writer.tag(1, WireType.LengthDelimited).string(message.provider);
+        /* int32 daily_calls = 2; */
+        if (message.dailyCalls !== 0)
+            writer.tag(2, WireType.Varint).int32(message.dailyCalls);
+        /* int32 daily_limit = 3; */
+        if (message.dailyLimit !== 0)
+            writer.tag(3, WireType.Varint).int32(message.dailyLimit);
+        /* int32 daily_remaining = 4; */
+        if (message.dailyRemaining !== 0)
+            writer.tag(4, WireType.Varint).int32(message.dailyRemaining);
+        /* int32 monthly_calls = 5; */
+        if (message.monthlyCalls !== 0)
+            writer.tag(5, WireType.Varint).int32(message.monthlyCalls);
+        /* int32 monthly_limit = 6; */
+        if (message.monthlyLimit !== 0)
+            writer.tag(6, WireType.Varint).int32(message.monthlyLimit);
+        /* int32 monthly_remaining = 7; */
+        if (message.monthlyRemaining !== 0)
+            writer.tag(7, WireType.Varint).int32(message.monthlyRemaining);
+        /* bool exhausted = 8; */
+        if (message.exhausted !== false)
+            writer.tag(8, WireType.Varint).bool(message.exhausted);
         let u = options.writeUnknownFields;
         if (u !== false)
             (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
@@ -7812,30 +14354,30 @@ class ScanISCResponse$Type extends MessageType<ScanISCResponse> {
     }
 }
 /**
- * @generated MessageType for protobuf message service.ScanISCResponse
+ * @generated MessageType for protobuf message service.ProviderUsage
  */
-export const ScanISCResponse = new ScanISCResponse$Type();
+export const ProviderUsage = new ProviderUsage$Type();
 // @generated message type with reflection information, may provide speed optimized methods
-class GetISCScanResultsByDomainRequest$Type extends MessageType<GetISCScanResultsByDomainRequest> {
+class GetProviderHealthRequest$Type extends MessageType<GetProviderHealthRequest> {
     constructor() {
-        super("service.GetISCScanResultsByDomainRequest", [
-            { no: 1, name: "domain", kind: "scalar", T: 9 /*ScalarType.STRING*/ }
+        super("service.GetProviderHealthRequest", [
+            { no: 1, name: "provider", kind: "scalar", T: 9 /*ScalarType.STRING*/ }
         ]);
     }
-    create(value?: PartialMessage<GetISCScanResultsByDomainRequest>): GetISCScanResultsByDomainRequest {
+    create(value?: PartialMessage<GetProviderHealthRequest>): GetProviderHealthRequest {
         const message = globalThis.Object.create((this.messagePrototype!));
-        message.domain = "";
+        message.provider = "";
         if (value !== undefined)
-            reflectionMergePartial<GetISCScanResultsByDomainRequest>(this, message, value);
+            reflectionMergePartial<GetProviderHealthRequest>(this, message, value);
         return message;
     }
-    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: GetISCScanResultsByDomainRequest): GetISCScanResultsByDomainRequest {
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: GetProviderHealthRequest): GetProviderHealthRequest {
         let message = target ?? this.create(), end = reader.pos + length;
         while (reader.pos < end) {
             let [fieldNo, wireType] = reader.tag();
             switch (fieldNo) {
-                case /* string domain */ 1:
-                    message.domain = reader.string();
+                case /* string provider */ 1:
+                    message.provider = reader.string();
                     break;
                 default:
                     let u = options.readUnknownField;
@@ -7848,10 +14390,10 @@ class GetISCScanResultsByDomainRequest$Type extends MessageType<GetISCScanResult
         }
         return message;
     }
-    internalBinaryWrite(message: GetISCScanResultsByDomainRequest, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
-        /* string domain = 1; */
-        if (message.domain !== "")
-            writer.tag(1, WireType.LengthDelimited).string(message.domain);
+    internalBinaryWrite(message: GetProviderHealthRequest, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* string provider = 1; */
+        if (message.provider !== "")
+            writer.tag(1, WireType.LengthDelimited).string(message.provider);
         let u = options.writeUnknownFields;
         if (u !== false)
             (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
@@ -7859,30 +14401,30 @@ class GetISCScanResultsByDomainRequest$Type extends MessageType<GetISCScanResult
     }
 }
 /**
- * @generated MessageType for protobuf message service.GetISCScanResultsByDomainRequest
+ * @generated MessageType for protobuf message service.GetProviderHealthRequest
  */
-export const GetISCScanResultsByDomainRequest = new GetISCScanResultsByDomainRequest$Type();
+export const GetProviderHealthRequest = new GetProviderHealthRequest$Type();
 // @generated message type with reflection information, may provide speed optimized methods
-class GetISCScanResultsByDomainResponse$Type extends MessageType<GetISCScanResultsByDomainResponse> {
+class GetProviderHealthResponse$Type extends MessageType<GetProviderHealthResponse> {
     constructor() {
-        super("service.GetISCScanResultsByDomainResponse", [
-            { no: 1, name: "results", kind: "message", repeat: 2 /*RepeatType.UNPACKED*/, T: () => ISCScanResult }
+        super("service.GetProviderHealthResponse", [
+            { no: 1, name: "providers", kind: "message", repeat: 2 /*RepeatType.UNPACKED*/, T: () => ProviderHealth }
         ]);
     }
-    create(value?: PartialMessage<GetISCScanResultsByDomainResponse>): GetISCScanResultsByDomainResponse {
+    create(value?: PartialMessage<GetProviderHealthResponse>): GetProviderHealthResponse {
         const message = globalThis.Object.create((this.messagePrototype!));
-        message.results = [];
+        message.providers = [];
         if (value !== undefined)
-            reflectionMergePartial<GetISCScanResultsByDomainResponse>(this, message, value);
+            reflectionMergePartial<GetProviderHealthResponse>(this, message, value);
         return message;
     }
-    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: GetISCScanResultsByDomainResponse): GetISCScanResultsByDomainResponse {
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: GetProviderHealthResponse): GetProviderHealthResponse {
         let message = target ?? this.create(), end = reader.pos + length;
         while (reader.pos < end) {
             let [fieldNo, wireType] = reader.tag();
             switch (fieldNo) {
-                case /* repeated service.ISCScanResult results */ 1:
-                    message.results.push(ISCScanResult.internalBinaryRead(reader, reader.uint32(), options));
+                case /* repeated service.ProviderHealth providers */ 1:
+                    message.providers.push(ProviderHealth.internalBinaryRead(reader, reader.uint32(), options));
                     break;
                 default:
                     let u = options.readUnknownField;
@@ -7895,10 +14437,10 @@ class GetISCScanResultsByDomainResponse$Type extends MessageType<GetISCScanResul
         }
         return message;
     }
-    internalBinaryWrite(message: GetISCScanResultsByDomainResponse, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
-        /* repeated service.ISCScanResult results = 1; */
-        for (let i = 0; i < message.results.length; i++)
-            ISCScanResult.internalBinaryWrite(message.results[i], writer.tag(1, WireType.LengthDelimited).fork(), options).join();
+    internalBinaryWrite(message: GetProviderHealthResponse, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* repeated service.ProviderHealth providers = 1; */
+        for (let i = 0; i < message.providers.length; i++)
+            ProviderHealth.internalBinaryWrite(message.providers[i], writer.tag(1, WireType.LengthDelimited).fork(), options).join();
         let u = options.writeUnknownFields;
         if (u !== false)
             (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
@@ -7906,48 +14448,53 @@ class GetISCScanResultsByDomainResponse$Type extends MessageType<GetISCScanResul
     }
 }
 /**
- * @generated MessageType for protobuf message service.GetISCScanResultsByDomainResponse
+ * @generated MessageType for protobuf message service.GetProviderHealthResponse
  */
-export const GetISCScanResultsByDomainResponse = new GetISCScanResultsByDomainResponse$Type();
+export const GetProviderHealthResponse = new GetProviderHealthResponse$Type();
 // @generated message type with reflection information, may provide speed optimized methods
-class ISCScanResult$Type extends MessageType<ISCScanResult> {
+class ProviderHealth$Type extends MessageType<ProviderHealth> {
     constructor() {
-        super("service.ISCScanResult", [
-            { no: 1, name: "id", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
-            { no: 2, name: "domain", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
-            { no: 3, name: "dns_scan_id", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
-            { no: 4, name: "result", kind: "message", T: () => ISCSecurityResult },
-            { no: 5, name: "created_at", kind: "message", T: () => Timestamp }
+        super("service.ProviderHealth", [
+            { no: 1, name: "provider", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 2, name: "state", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 3, name: "consecutive_failures", kind: "scalar", T: 5 /*ScalarType.INT32*/ },
+            { no: 4, name: "opened_at", kind: "message", T: () => Timestamp },
+            { no: 5, name: "retry_at", kind: "message", T: () => Timestamp },
+            { no: 6, name: "last_error", kind: "scalar", T: 9 /*ScalarType.STRING*/ }
         ]);
     }
-    create(value?: PartialMessage<ISCScanResult>): ISCScanResult {
+    create(value?: PartialMessage<ProviderHealth>): ProviderHealth {
         const message = globalThis.Object.create((this.messagePrototype!));
-        message.id = "";
-        message.domain = "";
-        message.dnsScanId = "";
+        message.provider = "";
+        message.state = "";
+        message.consecutiveFailures = 0;
+        message.lastError = "";
         if (value !== undefined)
-            reflectionMergePartial<ISCScanResult>(this, message, value);
+            reflectionMergePartial<ProviderHealth>(this, message, value);
         return message;
     }
-    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: ISCScanResult): ISCScanResult {
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: ProviderHealth): ProviderHealth {
         let message = target ?? this.create(), end = reader.pos + length;
         while (reader.pos < end) {
             let [fieldNo, wireType] = reader.tag();
             switch (fieldNo) {
-                case /* string id */ 1:
-                    message.id = reader.string();
+                case /* string provider */ 1:
+                    message.provider = reader.string();
                     break;
-                case /* string domain */ 2:
-                    message.domain = reader.string();
+                case /* string state */ 2:
+                    message.state = reader.string();
                     break;
-                case /* string dns_scan_id */ 3:
-                    message.dnsScanId = reader.string();
+                case /* int32 consecutive_failures */ 3:
+                    message.consecutiveFailures = reader.int32();
                     break;
-                case /* service.ISCSecurityResult result */ 4:
-                    message.result = ISCSecurityResult.internalBinaryRead(reader, reader.uint32(), options, message.result);
+                case /* google.protobuf.Timestamp opened_at */ 4:
+                    message.openedAt = Timestamp.internalBinaryRead(reader, reader.uint32(), options, message.openedAt);
                     break;
-                case /* google.protobuf.Timestamp created_at */ 5:
-                    message.createdAt = Timestamp.internalBinaryRead(reader, reader.uint32(), options, message.createdAt);
+                case /* google.protobuf.Timestamp retry_at */ 5:
+                    message.retryAt = Timestamp.internalBinaryRead(reader, reader.uint32(), options, message.retryAt);
+                    break;
+                case /* string last_error */ 6:
+                    message.lastError = reader.string();
                     break;
                 default:
                     let u = options.readUnknownField;
@@ -7960,22 +14507,25 @@ class ISCScanResult$Type extends MessageType<ISCScanResult> {
         }
         return message;
     }
-    internalBinaryWrite(message: ISCScanResult, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
-        /* string id = 1; */
-        if (message.id !== "")
-            writer.tag(1, WireType.LengthDelimited).string(message.id);
-        /* string domain = 2; */
-        if (message.domain !== "")
-            writer.tag(2, WireType.LengthDelimited).string(message.domain);
-        /* string dns_scan_id = 3; */
-        if (message.dnsScanId !== "")
-            writer.tag(3, WireType.LengthDelimited).string(message.dnsScanId);
-        /* service.ISCSecurityResult result = 4; */
-        if (message.result)
-            ISCSecurityResult.internalBinaryWrite(message.result, writer.tag(4, WireType.LengthDelimited).fork(), options).join();
-        /* google.protobuf.Timestamp created_at = 5; */
-        if (message.createdAt)
-            Timestamp.internalBinaryWrite(message.createdAt, writer.tag(5, WireType.LengthDelimited).fork(), options).join();
+    internalBinaryWrite(message: ProviderHealth, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* string provider = 1; */
+        if (message.provider !== "")
+            writer.tag(1, WireType.LengthDelimited).string(message.provider);
+        /* string state = 2; */
+        if (message.state !== "")
+            writer.tag(2, WireType.LengthDelimited).string(message.state);
+        /* int32 consecutive_failures = 3; */
+        if (message.consecutiveFailures !== 0)
+            writer.tag(3, WireType.Varint).int32(message.consecutiveFailures);
+        /* google.protobuf.Timestamp opened_at = 4; */
+        if (message.openedAt)
+            Timestamp.internalBinaryWrite(message.openedAt, writer.tag(4, WireType.LengthDelimited).fork(), options).join();
+        /* google.protobuf.Timestamp retry_at = 5; */
+        if (message.retryAt)
+            Timestamp.internalBinaryWrite(message.retryAt, writer.tag(5, WireType.LengthDelimited).fork(), options).join();
+        /* string last_error = 6; */
+        if (message.lastError !== "")
+            writer.tag(6, WireType.LengthDelimited).string(message.lastError);
         let u = options.writeUnknownFields;
         if (u !== false)
             (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
@@ -7983,45 +14533,25 @@ class ISCScanResult$Type extends MessageType<ISCScanResult> {
     }
 }
 /**
- * @generated MessageType for protobuf message service.ISCScanResult
+ * @generated MessageType for protobuf message service.ProviderHealth
  */
-export const ISCScanResult = new ISCScanResult$Type();
+export const ProviderHealth = new ProviderHealth$Type();
 // @generated message type with reflection information, may provide speed optimized methods
-class ISCIncident$Type extends MessageType<ISCIncident> {
+class DescribeConfigRequest$Type extends MessageType<DescribeConfigRequest> {
     constructor() {
-        super("service.ISCIncident", [
-            { no: 1, name: "id", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
-            { no: 2, name: "date", kind: "message", T: () => Timestamp },
-            { no: 3, name: "description", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
-            { no: 4, name: "severity", kind: "scalar", T: 9 /*ScalarType.STRING*/ }
-        ]);
+        super("service.DescribeConfigRequest", []);
     }
-    create(value?: PartialMessage<ISCIncident>): ISCIncident {
+    create(value?: PartialMessage<DescribeConfigRequest>): DescribeConfigRequest {
         const message = globalThis.Object.create((this.messagePrototype!));
-        message.id = "";
-        message.description = "";
-        message.severity = "";
         if (value !== undefined)
-            reflectionMergePartial<ISCIncident>(this, message, value);
+            reflectionMergePartial<DescribeConfigRequest>(this, message, value);
         return message;
     }
-    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: ISCIncident): ISCIncident {
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: DescribeConfigRequest): DescribeConfigRequest {
         let message = target ?? this.create(), end = reader.pos + length;
         while (reader.pos < end) {
             let [fieldNo, wireType] = reader.tag();
             switch (fieldNo) {
-                case /* string id */ 1:
-                    message.id = reader.string();
-                    break;
-                case /* google.protobuf.Timestamp date */ 2:
-                    message.date = Timestamp.internalBinaryRead(reader, reader.uint32(), options, message.date);
-                    break;
-                case /* string description */ 3:
-                    message.description = reader.string();
-                    break;
-                case /* string severity */ 4:
-                    message.severity = reader.string();
-                    break;
                 default:
                     let u = options.readUnknownField;
                     if (u === "throw")
@@ -8033,19 +14563,7 @@ class ISCIncident$Type extends MessageType<ISCIncident> {
         }
         return message;
     }
-    internalBinaryWrite(message: ISCIncident, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
-        /* string id = 1; */
-        if (message.id !== "")
-            writer.tag(1, WireType.LengthDelimited).string(message.id);
-        /* google.protobuf.Timestamp date = 2; */
-        if (message.date)
-            Timestamp.internalBinaryWrite(message.date, writer.tag(2, WireType.LengthDelimited).fork(), options).join();
-        /* string description = 3; */
-        if (message.description !== "")
-            writer.tag(3, WireType.LengthDelimited).string(message.description);
-        /* string severity = 4; */
-        if (message.severity !== "")
-            writer.tag(4, WireType.LengthDelimited).string(message.severity);
+    internalBinaryWrite(message: DescribeConfigRequest, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
         let u = options.writeUnknownFields;
         if (u !== false)
             (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
@@ -8053,40 +14571,53 @@ class ISCIncident$Type extends MessageType<ISCIncident> {
     }
 }
 /**
- * @generated MessageType for protobuf message service.ISCIncident
+ * @generated MessageType for protobuf message service.DescribeConfigRequest
  */
-export const ISCIncident = new ISCIncident$Type();
+export const DescribeConfigRequest = new DescribeConfigRequest$Type();
 // @generated message type with reflection information, may provide speed optimized methods
-class ISCSecurityResult$Type extends MessageType<ISCSecurityResult> {
+class DescribeConfigResponse$Type extends MessageType<DescribeConfigResponse> {
     constructor() {
-        super("service.ISCSecurityResult", [
-            { no: 1, name: "incidents", kind: "message", repeat: 2 /*RepeatType.UNPACKED*/, T: () => ISCIncident },
-            { no: 2, name: "overall_risk", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
-            { no: 3, name: "errors", kind: "scalar", repeat: 2 /*RepeatType.UNPACKED*/, T: 9 /*ScalarType.STRING*/ }
+        super("service.DescribeConfigResponse", [
+            { no: 1, name: "version", kind: "scalar", T: 5 /*ScalarType.INT32*/ },
+            { no: 2, name: "checksum", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 3, name: "loaded_at", kind: "message", T: () => Timestamp },
+            { no: 4, name: "path", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 5, name: "last_error", kind: "scalar", T: 9 /*ScalarType.STRING*/ },
+            { no: 6, name: "failed_at", kind: "message", T: () => Timestamp }
         ]);
     }
-    create(value?: PartialMessage<ISCSecurityResult>): ISCSecurityResult {
+    create(value?: PartialMessage<DescribeConfigResponse>): DescribeConfigResponse {
         const message = globalThis.Object.create((this.messagePrototype!));
-        message.incidents = [];
-        message.overallRisk = "";
-        message.errors = [];
+        message.version = 0;
+        message.checksum = "";
+        message.path = "";
+        message.lastError = "";
         if (value !== undefined)
-            reflectionMergePartial<ISCSecurityResult>(this, message, value);
+            reflectionMergePartial<DescribeConfigResponse>(this, message, value);
         return message;
     }
-    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: ISCSecurityResult): ISCSecurityResult {
+    internalBinaryRead(reader: IBinaryReader, length: number, options: BinaryReadOptions, target?: DescribeConfigResponse): DescribeConfigResponse {
         let message = target ?? this.create(), end = reader.pos + length;
         while (reader.pos < end) {
             let [fieldNo, wireType] = reader.tag();
             switch (fieldNo) {
-                case /* repeated service.ISCIncident incidents */ 1:
-                    message.incidents.push(ISCIncident.internalBinaryRead(reader, reader.uint32(), options));
+                case /* int32 version */ 1:
+                    message.version = reader.int32();
                     break;
-                case /* string overall_risk */ 2:
-                    message.overallRisk = reader.string();
+                case /* string checksum */ 2:
+                    message.checksum = reader.string();
                     break;
-                case /* repeated string errors */ 3:
-                    message.errors.push(reader.string());
+                case /* google.protobuf.Timestamp loaded_at */ 3:
+                    message.loadedAt = Timestamp.internalBinaryRead(reader, reader.uint32(), options, message.loadedAt);
+                    break;
+                case /* string path */ 4:
+                    message.path = reader.string();
+                    break;
+                case /* string last_error */ 5:
+                    message.lastError = reader.string();
+                    break;
+                case /* google.protobuf.Timestamp failed_at */ 6:
+                    message.failedAt = Timestamp.internalBinaryRead(reader, reader.uint32(), options, message.failedAt);
                     break;
                 default:
                     let u = options.readUnknownField;
@@ -8099,16 +14630,25 @@ class ISCSecurityResult$Type extends MessageType<ISCSecurityResult> {
         }
         return message;
     }
-    internalBinaryWrite(message: ISCSecurityResult, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
-        /* repeated service.ISCIncident incidents = 1; */
-        for (let i = 0; i < message.incidents.length; i++)
-            ISCIncident.internalBinaryWrite(message.incidents[i], writer.tag(1, WireType.LengthDelimited).fork(), options).join();
-        /* string overall_risk = 2; */
-        if (message.overallRisk !== "")
-            writer.tag(2, WireType.LengthDelimited).string(message.overallRisk);
-        /* repeated string errors = 3; */
-        for (let i = 0; i < message.errors.length; i++)
-            writer.tag(3, WireType.LengthDelimited).string(message.errors[i]);
+    internalBinaryWrite(message: DescribeConfigResponse, writer: IBinaryWriter, options: BinaryWriteOptions): IBinaryWriter {
+        /* int32 version = 1; */
+        if (message.version !== 0)
+            writer.tag(1, WireType.Varint).int32(message.version);
+        /* string checksum = 2; */
+        if (message.checksum !== "")
+            writer.tag(2, WireType.LengthDelimited).string(message.checksum);
+        /* google.protobuf.Timestamp loaded_at = 3; */
+        if (message.loadedAt)
+            Timestamp.internalBinaryWrite(message.loadedAt, writer.tag(3, WireType.LengthDelimited).fork(), options).join();
+        /* string path = 4; */
+        if (message.path !== "")
+            writer.tag(4, WireType.LengthDelimited).string(message.path);
+        /* string last_error = 5; */
+        if (message.lastError !== "")
+            writer.tag(5, WireType.LengthDelimited).string(message.lastError);
+        /* google.protobuf.Timestamp failed_at = 6; */
+        if (message.failedAt)
+            Timestamp.internalBinaryWrite(message.failedAt, writer.tag(6, WireType.LengthDelimited).fork(), options).join();
         let u = options.writeUnknownFields;
         if (u !== false)
             (u == true ? UnknownFieldHandler.onWrite : u)(this.typeName, message, writer);
@@ -8116,9 +14656,9 @@ class ISCSecurityResult$Type extends MessageType<ISCSecurityResult> {
     }
 }
 /**
- * @generated MessageType for protobuf message service.ISCSecurityResult
+ * @generated MessageType for protobuf message service.DescribeConfigResponse
  */
-export const ISCSecurityResult = new ISCSecurityResult$Type();
+export const DescribeConfigResponse = new DescribeConfigResponse$Type();
 /**
  * @generated ServiceType for protobuf service service.AuthService
  */
@@ -8165,13 +14705,32 @@ export const ScanService = new ServiceType("service.ScanService", [
     { name: "GetWhoisScanResultsByDomain", options: {}, I: GetWhoisScanResultsByDomainRequest, O: GetWhoisScanResultsByDomainResponse },
     { name: "GetAbuseChScanResultsByDomain", options: {}, I: GetAbuseChScanResultsByDomainRequest, O: GetAbuseChScanResultsByDomainResponse },
     { name: "GetISCScanResultsByDomain", options: {}, I: GetISCScanResultsByDomainRequest, O: GetISCScanResultsByDomainResponse },
-    { name: "GetDNSScanResultByID", options: {}, I: GetDNSScanResultByIDRequest, O: GetDNSScanResultByIDResponse }
+    { name: "GetDNSScanResultByID", options: {}, I: GetDNSScanResultByIDRequest, O: GetDNSScanResultByIDResponse },
+    { name: "ListPlugins", options: {}, I: ListPluginsRequest, O: ListPluginsResponse },
+    { name: "SubmitScanJob", options: {}, I: SubmitScanJobRequest, O: SubmitScanJobResponse },
+    { name: "GetScanJob", options: {}, I: GetScanJobRequest, O: GetScanJobResponse },
+    { name: "ListScanJobs", options: {}, I: ListScanJobsRequest, O: ListScanJobsResponse },
+    { name: "CancelScanJob", options: {}, I: CancelScanJobRequest, O: CancelScanJobResponse },
+    { name: "GetScanRun", options: {}, I: GetScanRunRequest, O: GetScanRunResponse },
+    { name: "CreateScanSchedule", options: {}, I: CreateScanScheduleRequest, O: CreateScanScheduleResponse },
+    { name: "UpdateScanSchedule", options: {}, I: UpdateScanScheduleRequest, O: UpdateScanScheduleResponse },
+    { name: "PauseScanSchedule", options: {}, I: PauseScanScheduleRequest, O: PauseScanScheduleResponse },
+    { name: "ListScanSchedules", options: {}, I: ListScanSchedulesRequest, O: ListScanSchedulesResponse },
+    { name: "BulkScan", options: {}, I: BulkScanRequest, O: BulkScanResponse },
+    { name: "GetScanBatch", options: {}, I: GetScanBatchRequest, O: GetScanBatchResponse },
+    { name: "SaveScanProfile", options: {}, I: SaveScanProfileRequest, O: SaveScanProfileResponse },
+    { name: "ListScanProfiles", options: {}, I: ListScanProfilesRequest, O: ListScanProfilesResponse },
+    { name: "DeleteScanProfile", options: {}, I: DeleteScanProfileRequest, O: DeleteScanProfileResponse },
+    { name: "GetProviderUsage", options: {}, I: GetProviderUsageRequest, O: GetProviderUsageResponse },
+    { name: "GetProviderHealth", options: {}, I: GetProviderHealthRequest, O: GetProviderHealthResponse },
+    { name: "DescribeConfig", options: {}, I: DescribeConfigRequest, O: DescribeConfigResponse }
 ]);
 /**
  * @generated ServiceType for protobuf service service.ReportService
  */
 export const ReportService = new ServiceType("service.ReportService", [
     { name: "GenerateReport", options: {}, I: GenerateReportRequest, O: GenerateReportResponse },
+    { name: "GenerateReportStream", serverStreaming: true, options: {}, I: GenerateReportRequest, O: ReportProgressEvent },
     { name: "ListReports", options: {}, I: ListReportsRequest, O: ListReportsResponse },
     { name: "GetReportById", options: {}, I: GetReportByIdRequest, O: GetReportByIdResponse },
     { name: "CalculateRiskScore", options: {}, I: CalculateRiskScoreRequest, O: CalculateRiskScoreResponse }